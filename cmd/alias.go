@@ -0,0 +1,529 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/atomicfile"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// aliasNamePattern is what an alias may be called
+var aliasNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// aliasPlaceholderPattern matches the positional placeholders $1, $2, ...
+var aliasPlaceholderPattern = regexp.MustCompile(`\$(\d+)`)
+
+// aliasConfigPath is the config file aliases are read from and saved to:
+// --config when given, otherwise ~/.linear-cli.yaml
+func aliasConfigPath(configFlag string) (string, error) {
+	if configFlag != "" {
+		return configFlag, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".linear-cli.yaml"), nil
+}
+
+// loadAliases reads the aliases section of a config file. A missing file
+// has no aliases.
+func loadAliases(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cfg struct {
+		Aliases map[string]string `yaml:"aliases"`
+	}
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if cfg.Aliases == nil {
+		cfg.Aliases = map[string]string{}
+	}
+	return cfg.Aliases, nil
+}
+
+// builtinCommandNames lists the top-level command names and their aliases,
+// which user aliases can't take
+func builtinCommandNames() map[string]bool {
+	names := map[string]bool{"help": true, "completion": true}
+	for _, c := range rootCmd.Commands() {
+		names[c.Name()] = true
+		for _, a := range c.Aliases {
+			names[a] = true
+		}
+	}
+	return names
+}
+
+// splitAliasWords splits an alias expansion into words the way a shell
+// would: on spaces, with single and double quotes grouping and a backslash
+// escaping the next character outside single quotes
+func splitAliasWords(s string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			word.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			word.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in %q", s)
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
+
+// expandAliasTemplate fills an alias's words with the arguments given after
+// it. $1, $2, ... are replaced by single arguments and $@ by all of them;
+// without $@, the arguments past the highest $N are appended, as git does.
+func expandAliasTemplate(words, args []string) ([]string, error) {
+	var expanded []string
+	used := 0
+	all := false
+	for _, word := range words {
+		if word == "$@" {
+			expanded = append(expanded, args...)
+			all = true
+			continue
+		}
+		var missing error
+		word = aliasPlaceholderPattern.ReplaceAllStringFunc(word, func(m string) string {
+			n, _ := strconv.Atoi(m[1:])
+			if n < 1 || n > len(args) {
+				missing = fmt.Errorf("needs at least %d argument(s), got %d", n, len(args))
+				return m
+			}
+			used = max(used, n)
+			return args[n-1]
+		})
+		if missing != nil {
+			return nil, missing
+		}
+		expanded = append(expanded, word)
+	}
+	if !all {
+		expanded = append(expanded, args[used:]...)
+	}
+	return expanded, nil
+}
+
+// aliasPosition finds the command word in args, skipping the global flags
+// before it; -1 when there is none
+func aliasPosition(args []string) int {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return -1
+		}
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			return i
+		}
+		if strings.Contains(arg, "=") {
+			continue
+		}
+		flag := rootCmd.PersistentFlags().Lookup(strings.TrimLeft(arg, "-"))
+		if !strings.HasPrefix(arg, "--") && len(arg) == 2 {
+			flag = rootCmd.PersistentFlags().ShorthandLookup(arg[1:])
+		}
+		if flag != nil && flag.Value.Type() != "bool" {
+			i++
+		}
+	}
+	return -1
+}
+
+// configFlagValue returns --config from the global flags in args, before
+// cobra has parsed them
+func configFlagValue(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if v, ok := strings.CutPrefix(arg, "--config="); ok {
+			return v
+		}
+		if arg == "--config" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
+
+// expandAliases replaces a user-defined alias at the start of the command
+// line with its expansion. Built-in commands always win, and the expansion
+// is not expanded again. With --debug the resulting command line is
+// printed on stderr.
+func expandAliases(args []string) ([]string, error) {
+	i := aliasPosition(args)
+	if i < 0 || builtinCommandNames()[args[i]] {
+		return args, nil
+	}
+	path, err := aliasConfigPath(configFlagValue(args))
+	if err != nil {
+		return args, nil
+	}
+	aliases, err := loadAliases(path)
+	if err != nil {
+		// viper reports a broken config file; leave the args alone
+		return args, nil
+	}
+	name := args[i]
+	expansion, ok := aliases[name]
+	if !ok {
+		return args, nil
+	}
+
+	words, err := splitAliasWords(expansion)
+	if err == nil && len(words) == 0 {
+		err = fmt.Errorf("its expansion is empty")
+	}
+	if err == nil && !builtinCommandNames()[words[0]] {
+		err = fmt.Errorf("it expands to '%s', which is not a linear-cli command (aliases can't use other aliases)", words[0])
+	}
+	if err == nil {
+		words, err = expandAliasTemplate(words, args[i+1:])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("alias '%s': %v", name, err)
+	}
+
+	expanded := append(append([]string{}, args[:i]...), words...)
+	for _, arg := range expanded {
+		if arg == "--" {
+			break
+		}
+		if arg == "--debug" || arg == "--debug=true" {
+			fmt.Fprintf(os.Stderr, "debug: alias '%s' expands to: linear-cli %s\n", name, quoteArgs(expanded))
+			break
+		}
+	}
+	return expanded, nil
+}
+
+// quoteArgs joins args into a command line, quoting those that need it
+func quoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"\\$") {
+			arg = strconv.Quote(arg)
+		}
+		quoted[i] = arg
+	}
+	return strings.Join(quoted, " ")
+}
+
+// editAliases changes the aliases section of the config file under its
+// lock, keeping the rest of the file, comments included. edit returns
+// false to leave the file unchanged.
+func editAliases(path string, edit func(aliases *yaml.Node) (bool, error)) error {
+	unlock, err := atomicfile.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	perm := os.FileMode(0o644)
+	var doc yaml.Node
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if info, statErr := os.Stat(path); statErr == nil {
+			perm = info.Mode().Perm()
+		}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+	case !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
+	if doc.Kind == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s is not a YAML mapping", path)
+	}
+
+	var aliases *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == "aliases" {
+			aliases = root.Content[i+1]
+		}
+	}
+	if aliases == nil {
+		aliases = &yaml.Node{Kind: yaml.MappingNode}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: "aliases"}, aliases)
+	}
+	if aliases.Kind != yaml.MappingNode {
+		return fmt.Errorf("'aliases' in %s is not a mapping", path)
+	}
+
+	changed, err := edit(aliases)
+	if err != nil || !changed {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	return atomicfile.Write(path, buf.Bytes(), perm)
+}
+
+// validateAlias checks a new alias: a plain name that isn't a built-in
+// command, expanding to a built-in command
+func validateAlias(name, expansion string) error {
+	builtins := builtinCommandNames()
+	if !aliasNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid alias name '%s': use letters, digits, '-' and '_', starting with a letter", name)
+	}
+	if builtins[name] {
+		return fmt.Errorf("'%s' is a built-in command; pick another alias name", name)
+	}
+	words, err := splitAliasWords(expansion)
+	if err != nil {
+		return err
+	}
+	if len(words) == 0 {
+		return fmt.Errorf("the expansion is empty")
+	}
+	if !builtins[words[0]] {
+		return fmt.Errorf("the expansion must start with a linear-cli command, not '%s' (aliases can't use other aliases)", words[0])
+	}
+	return nil
+}
+
+var aliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage command aliases",
+	Long: `Manage shortcuts for command lines you type often. Aliases live under
+'aliases' in the config file (~/.linear-cli.yaml, or --config):
+
+  aliases:
+    wip: issue list --team ENG --assignee me --state-type started
+    show: issue get $1 --comments
+
+Running 'linear-cli wip --sort updated' runs the expansion with the extra
+arguments appended. $1, $2, ... insert single arguments and $@ all of them;
+arguments past the highest $N are appended unless $@ is used. Words are split
+like a shell would, so quote arguments containing spaces.
+
+An alias can't be named after a built-in command and must expand to one, so
+aliases never refer to other aliases. --debug prints the expanded command line.
+
+Examples:
+  linear-cli alias set wip 'issue list --team ENG --assignee me --state-type started'
+  linear-cli alias set show 'issue get $1 --comments'
+  linear-cli alias list
+  linear-cli alias remove wip`,
+}
+
+var aliasListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List aliases",
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		path, err := aliasConfigPath(cfgFile)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		aliases, err := loadAliases(path)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read aliases: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		names := make([]string, 0, len(aliases))
+		for name := range aliases {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		if jsonOut {
+			rows := make([]map[string]string, len(names))
+			for i, name := range names {
+				rows[i] = map[string]string{"name": name, "expansion": aliases[name]}
+			}
+			output.JSON(rows)
+			return
+		}
+		if len(names) == 0 {
+			output.Info(fmt.Sprintf("No aliases in %s", path), plaintext, jsonOut)
+			return
+		}
+
+		rows := make([][]string, len(names))
+		for i, name := range names {
+			rows[i] = []string{name, aliases[name]}
+		}
+		output.Table(output.TableData{Headers: []string{"Alias", "Expansion"}, Rows: rows}, plaintext, jsonOut)
+		if !plaintext {
+			fmt.Printf("\n%s %d aliases\n", color.New(color.FgGreen).Sprint("✓"), len(names))
+		}
+	},
+}
+
+var aliasSetCmd = &cobra.Command{
+	Use:     "set NAME EXPANSION",
+	Aliases: []string{"add"},
+	Short:   "Create or change an alias",
+	Long: `Create or change an alias. Quote the expansion so its flags aren't read as
+flags of 'alias set'.
+
+Examples:
+  linear-cli alias set wip 'issue list --team ENG --assignee me --state-type started'
+  linear-cli alias set done 'issue update $1 --state Done'`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		name, expansion := args[0], strings.TrimSpace(args[1])
+
+		if err := validateAlias(name, expansion); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		path, err := aliasConfigPath(cfgFile)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		action := "created"
+		err = editAliases(path, func(aliases *yaml.Node) (bool, error) {
+			for i := 0; i+1 < len(aliases.Content); i += 2 {
+				if aliases.Content[i].Value == name {
+					action = "updated"
+					aliases.Content[i+1] = &yaml.Node{Kind: yaml.ScalarNode, Value: expansion}
+					return true, nil
+				}
+			}
+			aliases.Content = append(aliases.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: name},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: expansion})
+			return true, nil
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to save alias: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{"success": true, "name": name, "expansion": expansion, "action": action})
+		} else if plaintext {
+			fmt.Printf("Alias %s %s: %s\n", name, action, expansion)
+		} else {
+			fmt.Printf("%s Alias %s %s: %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(name),
+				action, expansion)
+		}
+	},
+}
+
+var aliasRemoveCmd = &cobra.Command{
+	Use:     "remove NAME",
+	Aliases: []string{"rm", "delete"},
+	Short:   "Remove an alias",
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		name := args[0]
+
+		path, err := aliasConfigPath(cfgFile)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		found := false
+		err = editAliases(path, func(aliases *yaml.Node) (bool, error) {
+			for i := 0; i+1 < len(aliases.Content); i += 2 {
+				if aliases.Content[i].Value == name {
+					found = true
+					aliases.Content = append(aliases.Content[:i], aliases.Content[i+2:]...)
+					return true, nil
+				}
+			}
+			return false, nil
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to remove alias: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if !found {
+			output.Error(fmt.Sprintf("No alias named '%s'", name), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{"success": true, "name": name, "action": "removed"})
+		} else if plaintext {
+			fmt.Printf("Removed alias %s\n", name)
+		} else {
+			fmt.Printf("%s Removed alias %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(name))
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(aliasCmd)
+	aliasCmd.AddCommand(aliasListCmd)
+	aliasCmd.AddCommand(aliasSetCmd)
+	aliasCmd.AddCommand(aliasRemoveCmd)
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitAliasWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"issue list --team ENG", []string{"issue", "list", "--team", "ENG"}},
+		{`issue create --title "Fix the  login" --label 'a b'`, []string{"issue", "create", "--title", "Fix the  login", "--label", "a b"}},
+		{`issue get $1 --description ""`, []string{"issue", "get", "$1", "--description", ""}},
+		{`comment create $1 --body it\'s`, []string{"comment", "create", "$1", "--body", "it's"}},
+		{"  ", nil},
+	}
+	for _, tt := range tests {
+		got, err := splitAliasWords(tt.in)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitAliasWords(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := splitAliasWords(`issue list --title "open`); err == nil {
+		t.Error("an unterminated quote should fail")
+	}
+}
+
+func TestExpandAliasTemplate(t *testing.T) {
+	tests := []struct {
+		words, args, want []string
+		wantErr           bool
+	}{
+		{words: []string{"issue", "list"}, args: []string{"--sort", "updated"}, want: []string{"issue", "list", "--sort", "updated"}},
+		{words: []string{"issue", "get", "$1", "--comments"}, args: []string{"ENG-1", "--json"}, want: []string{"issue", "get", "ENG-1", "--comments", "--json"}},
+		{words: []string{"issue", "update", "$2", "--state=$1"}, args: []string{"Done", "ENG-1"}, want: []string{"issue", "update", "ENG-1", "--state=Done"}},
+		{words: []string{"issue", "list", "--team", "$1", "$@"}, args: []string{"ENG", "-l", "5"}, want: []string{"issue", "list", "--team", "ENG", "ENG", "-l", "5"}},
+		{words: []string{"issue", "get", "$1"}, args: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := expandAliasTemplate(tt.words, tt.args)
+		if (err != nil) != tt.wantErr || (!tt.wantErr && !reflect.DeepEqual(got, tt.want)) {
+			t.Errorf("expandAliasTemplate(%q, %q) = %q, %v; want %q", tt.words, tt.args, got, err, tt.want)
+		}
+	}
+}
+
+func TestAliasPosition(t *testing.T) {
+	tests := []struct {
+		args []string
+		want int
+	}{
+		{[]string{"wip"}, 0},
+		{[]string{"--json", "wip"}, 1},
+		{[]string{"--config", "x.yaml", "-p", "wip"}, 3},
+		{[]string{"--progress=none", "wip", "--limit", "3"}, 1},
+		{[]string{"--json"}, -1},
+		{[]string{"--", "wip"}, -1},
+	}
+	for _, tt := range tests {
+		if got := aliasPosition(tt.args); got != tt.want {
+			t.Errorf("aliasPosition(%q) = %d, want %d", tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestHermeticAliases(t *testing.T) {
+	s := newMockLinear(t)
+	s.Fixtures("testdata/linearmock/teams")
+	config := filepath.Join(os.Getenv("HOME"), ".linear-cli.yaml")
+	if err := os.WriteFile(config, []byte("# my settings\nutc: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := runMocked(t, "alias", "set", "teams-json", "team list --json"); r.Exit != 0 {
+		t.Fatalf("alias set exited %d: %s", r.Exit, r.Stderr)
+	}
+	data, _ := os.ReadFile(config)
+	if got := string(data); got != "# my settings\nutc: true\naliases:\n  teams-json: team list --json\n" {
+		t.Errorf("config after alias set:\n%s", got)
+	}
+	if info, _ := os.Stat(config); info.Mode().Perm() != 0o600 {
+		t.Errorf("config mode changed to %v", info.Mode().Perm())
+	}
+
+	r := runMocked(t, "teams-json", "--debug")
+	if r.Exit != 0 || !strings.HasPrefix(r.Stdout, "[") {
+		t.Fatalf("alias run exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if !strings.Contains(r.Stderr, "debug: alias 'teams-json' expands to: linear-cli team list --json --debug") {
+		t.Errorf("--debug didn't show the expansion: %s", r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Teams" {
+		t.Errorf("operations = %s", got)
+	}
+
+	// Built-in names and aliases that reference aliases are refused
+	for _, args := range [][]string{
+		{"alias", "set", "issue", "team list"},
+		{"alias", "set", "gql", "team list"},
+		{"alias", "set", "again", "teams-json --plaintext"},
+		{"alias", "set", "bad name", "team list"},
+		{"alias", "remove", "nope"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+
+	// A hand-edited alias that points at another alias isn't expanded again
+	_ = os.WriteFile(config, []byte("aliases:\n  a: team list\n  b: a --json\n"), 0o600)
+	if r := runMocked(t, "b"); r.Exit != 1 || !strings.Contains(r.Stderr, "aliases can't use other aliases") {
+		t.Errorf("nested alias exited %d: %s", r.Exit, r.Stderr)
+	}
+
+	r = runMocked(t, "alias", "list", "--plaintext")
+	if r.Exit != 0 || r.Stdout != "Alias\tExpansion\na\tteam list\nb\ta --json\n" {
+		t.Errorf("alias list exited %d:\n%s", r.Exit, r.Stdout)
+	}
+	if r := runMocked(t, "alias", "remove", "b"); r.Exit != 0 {
+		t.Errorf("alias remove exited %d: %s", r.Exit, r.Stderr)
+	}
+	if data, _ := os.ReadFile(config); string(data) != "aliases:\n  a: team list\n" {
+		t.Errorf("config after remove:\n%s", data)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/fatih/color"
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -40,17 +42,24 @@ var attachmentListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		limit, _ := cmd.Flags().GetInt("limit")
 
-		attachments, err := client.GetIssueAttachments(context.Background(), args[0], limit, "")
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		attachments, err := client.GetIssueAttachments(context.Background(), issue.ID, limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch attachments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, attachments.PageInfo, len(attachments.Nodes))
 
 		if len(attachments.Nodes) == 0 {
 			output.Info("No attachments found", plaintext, jsonOut)
@@ -63,7 +72,7 @@ var attachmentListCmd = &cobra.Command{
 		}
 
 		if plaintext {
-			fmt.Printf("# Attachments for %s\n", args[0])
+			fmt.Printf("# Attachments for %s\n", issue.Identifier)
 			for _, att := range attachments.Nodes {
 				fmt.Printf("## %s\n", att.Title)
 				fmt.Printf("- **ID**: %s\n", att.ID)
@@ -79,10 +88,10 @@ var attachmentListCmd = &cobra.Command{
 				} else if att.ExternalUserCreator != nil {
 					fmt.Printf("- **Creator**: %s (external)\n", att.ExternalUserCreator.Name)
 				}
-				fmt.Printf("- **Created**: %s\n", att.CreatedAt.Format("2006-01-02 15:04"))
-				fmt.Printf("- **Updated**: %s\n", att.UpdatedAt.Format("2006-01-02 15:04"))
+				fmt.Printf("- **Created**: %s\n", output.FormatTime(att.CreatedAt, output.DateTimeShort))
+				fmt.Printf("- **Updated**: %s\n", output.FormatTime(att.UpdatedAt, output.DateTimeShort))
 				if att.ArchivedAt != nil {
-					fmt.Printf("- **Archived**: %s\n", att.ArchivedAt.Format("2006-01-02 15:04"))
+					fmt.Printf("- **Archived**: %s\n", output.FormatTime(*att.ArchivedAt, output.DateTimeShort))
 				}
 				fmt.Println()
 			}
@@ -109,7 +118,7 @@ var attachmentListCmd = &cobra.Command{
 				truncateString(att.URL, 45),
 				sourceType,
 				creator,
-				att.CreatedAt.Format("2006-01-02"),
+				output.FormatTime(att.CreatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 			}
 		}
 
@@ -128,7 +137,15 @@ var attachmentCreateCmd = &cobra.Command{
 	Use:     "create [issue-id]",
 	Aliases: []string{"new"},
 	Short:   "Create an attachment on an issue",
-	Long:    `Attach a URL to an issue with a title and optional subtitle.`,
+	Long: `Attach a URL to an issue with a title and optional subtitle.
+
+GitHub pull request and issue URLs, Figma files, and Google Docs get a title
+(e.g. "org/repo#123"), subtitle, and metadata derived from the URL unless
+--title, --subtitle, or --metadata are given.
+
+Examples:
+  linear-cli issue attachment create LIN-123 --url "https://example.com/spec" --title "Spec Doc"
+  linear-cli issue attachment create LIN-123 --url "https://github.com/org/repo/pull/42"`,
 	Args:    cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -137,7 +154,7 @@ var attachmentCreateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -147,14 +164,14 @@ var attachmentCreateCmd = &cobra.Command{
 
 		if urlFlag == "" {
 			output.Error("URL is required (--url)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
-		// Resolve issue ID (could be identifier like LIN-123)
-		issue, err := client.GetIssue(context.Background(), args[0])
+		// Resolve issue ID (identifier like LIN-123, UUID, or URL)
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to resolve issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -162,6 +179,13 @@ var attachmentCreateCmd = &cobra.Command{
 			"url":     urlFlag,
 		}
 
+		if info, ok := utils.DescribeLink(urlFlag); ok {
+			if title == "" {
+				title = info.Title
+			}
+			input["subtitle"] = info.Subtitle
+			input["metadata"] = info.Metadata
+		}
 		if title != "" {
 			input["title"] = title
 		}
@@ -178,7 +202,7 @@ var attachmentCreateCmd = &cobra.Command{
 			var metadata map[string]interface{}
 			if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
 				output.Error(fmt.Sprintf("Invalid metadata JSON: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["metadata"] = metadata
 		}
@@ -198,8 +222,9 @@ var attachmentCreateCmd = &cobra.Command{
 		attachment, err := client.CreateAttachment(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create attachment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "attachment", attachment.ID, attachment.Title, &journal.Inverse{Action: "delete"})
 
 		if jsonOut {
 			output.JSON(attachment)
@@ -218,7 +243,15 @@ var attachmentLinkCmd = &cobra.Command{
 	Use:   "link [issue-id]",
 	Short: "Smart link a URL to an issue",
 	Long: `Smart link that auto-detects the URL type (GitHub PR, Slack thread, Notion page, etc.)
-and creates the appropriate rich attachment.`,
+and creates the appropriate rich attachment.
+
+GitHub pull request and issue URLs, Figma files, and Google Docs are titled from
+the URL (e.g. "org/repo#123" with subtitle "pull request") unless --title is
+given. A subtitle or metadata already set by a Linear integration is kept.
+
+Examples:
+  linear-cli issue attachment link LIN-123 --url "https://github.com/org/repo/pull/42"
+  linear-cli issue attachment link LIN-123 --url "https://www.figma.com/design/KEY/Checkout-Flow"`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -227,7 +260,7 @@ and creates the appropriate rich attachment.`,
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -237,20 +270,39 @@ and creates the appropriate rich attachment.`,
 
 		if urlFlag == "" {
 			output.Error("URL is required (--url)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Resolve issue ID
-		issue, err := client.GetIssue(context.Background(), args[0])
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to resolve issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		info, known := utils.DescribeLink(urlFlag)
+		if known && title == "" {
+			title = info.Title
 		}
 
 		attachment, err := client.LinkURL(context.Background(), issue.ID, urlFlag, title)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to link URL: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		recordOperation(cmd, "attachment", attachment.ID, attachment.Title, &journal.Inverse{Action: "delete"})
+
+		// attachmentLinkURL takes no subtitle or metadata, so fill in whatever
+		// the integration left empty
+		if known {
+			if input := linkDetailsInput(attachment, info); len(input) > 0 {
+				updated, err := client.UpdateAttachment(context.Background(), attachment.ID, input)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: linked, but failed to set subtitle: %v\n", err)
+				} else {
+					attachment = updated
+				}
+			}
 		}
 
 		if jsonOut {
@@ -279,7 +331,7 @@ var attachmentUpdateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -302,21 +354,22 @@ var attachmentUpdateCmd = &cobra.Command{
 			var metadata map[string]interface{}
 			if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
 				output.Error(fmt.Sprintf("Invalid metadata JSON: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["metadata"] = metadata
 		}
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use --title, --subtitle, --icon-url, or --metadata.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		attachment, err := client.UpdateAttachment(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update attachment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "attachment", attachment.ID, attachment.Title, nil)
 
 		if jsonOut {
 			output.JSON(attachment)
@@ -343,15 +396,16 @@ var attachmentDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		err = client.DeleteAttachment(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete attachment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "attachment", args[0], "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{
@@ -380,7 +434,7 @@ var attachmentUploadCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -389,14 +443,14 @@ var attachmentUploadCmd = &cobra.Command{
 		filePaths, _ := cmd.Flags().GetStringArray("file")
 		if len(filePaths) == 0 {
 			output.Error("At least one file is required (--file)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Resolve issue ID
-		issue, err := client.GetIssue(context.Background(), args[0])
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to resolve issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		// Process each file
@@ -460,6 +514,7 @@ var attachmentUploadCmd = &cobra.Command{
 				output.Error(fmt.Sprintf("Failed to create attachment: %v", err), plaintext, jsonOut)
 				continue
 			}
+			recordOperation(cmd, "attachment", attachment.ID, attachment.Title, &journal.Inverse{Action: "delete"})
 
 			if jsonOut {
 				output.JSON(attachment)
@@ -475,6 +530,19 @@ var attachmentUploadCmd = &cobra.Command{
 	},
 }
 
+// linkDetailsInput returns the subtitle and metadata from info that the
+// linked attachment does not already have
+func linkDetailsInput(attachment *api.Attachment, info utils.LinkInfo) map[string]interface{} {
+	input := map[string]interface{}{}
+	if attachment.Subtitle == nil || *attachment.Subtitle == "" {
+		input["subtitle"] = info.Subtitle
+	}
+	if len(attachment.Metadata) == 0 && len(info.Metadata) > 0 {
+		input["metadata"] = info.Metadata
+	}
+	return input
+}
+
 func init() {
 	issueCmd.AddCommand(attachmentCmd)
 	attachmentCmd.AddCommand(attachmentListCmd)
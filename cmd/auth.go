@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
@@ -47,7 +48,7 @@ var loginCmd = &cobra.Command{
 		err := auth.Login(plaintext, jsonOut)
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if !plaintext && !jsonOut {
@@ -66,7 +67,11 @@ var loginCmd = &cobra.Command{
 var statusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Check authentication status",
-	Long:  `Check if you are currently authenticated with Linear.`,
+	Long: `Check if you are currently authenticated with Linear.
+
+Reports the auth method (Personal API Key or OAuth), workspace, admin status
+and, for OAuth tokens, the granted scopes and expiry. A warning is shown when
+the token expires within 7 days or only has read scope.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -83,7 +88,7 @@ var statusCmd = &cobra.Command{
 			} else {
 				fmt.Println("Not authenticated")
 			}
-			os.Exit(1)
+			exit(1)
 		}
 
 		authSource := auth.GetAuthSource()
@@ -94,20 +99,107 @@ var statusCmd = &cobra.Command{
 			sourceLabel = "LINCTL_API_KEY env var"
 		}
 
+		caps, err := auth.GetCapabilities()
+		if err != nil {
+			caps = &auth.Capabilities{Method: auth.MethodAPIKey}
+		}
+		methodLabel := "Personal API Key"
+		if caps.Method == auth.MethodOAuth {
+			methodLabel = "OAuth"
+		}
+
+		// Workspace lookup is best-effort
+		var workspace *api.Organization
+		if authHeader, err := auth.GetAuthHeader(); err == nil {
+			workspace, _ = api.NewClient(authHeader).GetOrganization(context.Background())
+		}
+		rateLimit := api.LatestRateLimit()
+
+		now := time.Now()
+		var warnings []string
+		if caps.ExpiresAt != nil {
+			if caps.ExpiresAt.Before(now) {
+				warnings = append(warnings, fmt.Sprintf("token expired on %s", output.FormatTime(*caps.ExpiresAt, output.DateOnly)))
+			} else if caps.ExpiringSoon(now) {
+				warnings = append(warnings, fmt.Sprintf("token expires in %s (%s)",
+					caps.ExpiresIn(now).Round(time.Hour), output.FormatTime(*caps.ExpiresAt, output.DateTimeShort)))
+			}
+		}
+		if caps.ReadOnly() {
+			warnings = append(warnings, "token is read-only; mutating commands will be rejected")
+		}
+
 		if jsonOut {
-			output.JSON(map[string]interface{}{
+			result := map[string]interface{}{
 				"authenticated": true,
 				"user":          user,
 				"auth_source":   authSource,
-			})
+				"auth_method":   caps.Method,
+				"admin":         user.Admin,
+				"scopes":        caps.Scopes,
+				"read_only":     caps.ReadOnly(),
+				"expires_at":    caps.ExpiresAt,
+				"expiring_soon": caps.ExpiringSoon(now),
+				"warnings":      warnings,
+			}
+			if d := caps.ExpiresIn(now); d != nil {
+				result["expires_in_seconds"] = int64(d.Seconds())
+			}
+			if workspace != nil {
+				result["workspace"] = workspace
+			}
+			if rateLimit != nil {
+				result["rate_limit"] = rateLimit
+			}
+			output.JSON(result)
 		} else if plaintext {
 			fmt.Printf("Authenticated as: %s (%s)\n", user.Name, user.Email)
 			fmt.Printf("Auth source: %s\n", sourceLabel)
+			fmt.Printf("Auth method: %s\n", methodLabel)
+			if workspace != nil {
+				fmt.Printf("Workspace: %s (%s)\n", workspace.Name, workspace.URLKey)
+			}
+			fmt.Printf("Admin: %t\n", user.Admin)
+			if len(caps.Scopes) > 0 {
+				fmt.Printf("Scopes: %s\n", strings.Join(caps.Scopes, ", "))
+			}
+			if caps.ExpiresAt != nil {
+				fmt.Printf("Expires: %s\n", output.FormatTime(*caps.ExpiresAt, output.DateTimeShort))
+			}
+			if summary := rateLimitSummary(rateLimit, now); summary != "" {
+				fmt.Printf("Rate limit: %s\n", summary)
+			}
+			for _, w := range warnings {
+				fmt.Printf("Warning: %s\n", w)
+			}
 		} else {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Authenticated"))
 			fmt.Printf("User: %s\n", color.New(color.FgCyan).Sprint(user.Name))
 			fmt.Printf("Email: %s\n", color.New(color.FgCyan).Sprint(user.Email))
 			fmt.Printf("Source: %s\n", color.New(color.FgYellow).Sprint(sourceLabel))
+			fmt.Printf("Method: %s\n", color.New(color.FgYellow).Sprint(methodLabel))
+			if workspace != nil {
+				fmt.Printf("Workspace: %s (%s)\n",
+					color.New(color.FgCyan).Sprint(workspace.Name),
+					workspace.URLKey)
+			}
+			if user.Admin {
+				fmt.Printf("Admin: %s\n", color.New(color.FgGreen).Sprint("yes"))
+			} else {
+				fmt.Printf("Admin: %s\n", "no")
+			}
+			if len(caps.Scopes) > 0 {
+				fmt.Printf("Scopes: %s\n", strings.Join(caps.Scopes, ", "))
+			}
+			if caps.ExpiresAt != nil {
+				fmt.Printf("Expires: %s\n", output.FormatTime(*caps.ExpiresAt, output.DateTimeShort))
+			}
+			if summary := rateLimitSummary(rateLimit, now); summary != "" {
+				fmt.Printf("Rate limit: %s\n", summary)
+			}
+			for _, w := range warnings {
+				fmt.Fprintf(os.Stderr, "%s %s\n", color.New(color.FgYellow).Sprint("⚠️"), w)
+			}
 		}
 	},
 }
@@ -115,30 +207,137 @@ var statusCmd = &cobra.Command{
 var logoutCmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Logout from Linear",
-	Long:  `Clear stored Linear credentials.`,
+	Long: `Clear stored Linear credentials.
+
+Deletes the credential file (~/.linear-cli-auth.json, and the legacy
+~/.linctl-auth.json if present), overwriting it first, and clears state
+derived from the account: the 'inbox watch' seen list. Everything removed is
+listed.
+
+A Personal API Key keeps working until it is revoked in Linear's settings; the
+page is printed as a reminder. An OAuth token can be revoked right away with
+--revoke. Credentials in LINEAR_API_KEY or LINCTL_API_KEY can't be removed
+here; a warning says so when one is set.
+
+Examples:
+  linear-cli auth logout
+  linear-cli auth logout --revoke --json`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		revoke, _ := cmd.Flags().GetBool("revoke")
 
-		err := auth.Logout()
+		removed, err := auth.Logout()
 		if err != nil {
 			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		result := logoutResult{Removed: removed.Removed, Method: removed.Method()}
+		if err := clearAccountState(&result); err != nil {
+			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
+			exit(1)
 		}
 
-		if jsonOut {
-			output.JSON(map[string]interface{}{
-				"status":  "success",
-				"message": "Successfully logged out",
-			})
-		} else if plaintext {
-			fmt.Println("Successfully logged out")
-		} else {
-			fmt.Println(color.New(color.FgGreen).Sprint("✅ Successfully logged out"))
+		switch result.Method {
+		case auth.MethodOAuth:
+			if revoke {
+				result.Revoked = true
+				if err := auth.RevokeToken(context.Background(), removed.Token()); err != nil {
+					result.Revoked = false
+					result.RevokeError = err.Error()
+				}
+			} else {
+				result.Notes = append(result.Notes, "the OAuth token stays valid until it expires; pass --revoke to revoke it now")
+			}
+		case auth.MethodAPIKey:
+			result.Notes = append(result.Notes, "the Personal API Key stays valid until you revoke it at "+auth.APIKeySettingsURL)
+		}
+		for _, env := range []string{"LINEAR_API_KEY", "LINCTL_API_KEY"} {
+			if os.Getenv(env) != "" {
+				result.Notes = append(result.Notes, env+" is still set; unset it to stop using that key")
+			}
+		}
+
+		printLogoutResult(result, plaintext, jsonOut)
+		if result.RevokeError != "" {
+			exit(1)
 		}
 	},
 }
 
+// logoutResult is what auth logout removed and revoked
+type logoutResult struct {
+	Removed     []string `json:"removed"`
+	Method      string   `json:"method,omitempty"`
+	Revoked     bool     `json:"revoked"`
+	RevokeError string   `json:"revoke_error,omitempty"`
+	Notes       []string `json:"notes,omitempty"`
+}
+
+// clearAccountState removes the files derived from the signed-out account,
+// adding each one that existed to the result
+func clearAccountState(result *logoutResult) error {
+	seenPath, err := inboxSeenPath()
+	if err != nil {
+		return err
+	}
+	for _, path := range []string{seenPath} {
+		err := os.Remove(path)
+		if err == nil {
+			result.Removed = append(result.Removed, path)
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func printLogoutResult(result logoutResult, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			logoutResult
+		}{"success", "Successfully logged out", result})
+		return
+	}
+
+	if plaintext {
+		fmt.Println("Successfully logged out")
+		for _, path := range result.Removed {
+			fmt.Printf("Removed: %s\n", path)
+		}
+		if len(result.Removed) == 0 {
+			fmt.Println("No stored credentials to remove")
+		}
+		if result.Revoked {
+			fmt.Println("Revoked the OAuth token")
+		} else if result.RevokeError != "" {
+			fmt.Printf("Could not revoke the OAuth token: %s\n", result.RevokeError)
+		}
+		for _, note := range result.Notes {
+			fmt.Printf("Note: %s\n", note)
+		}
+		return
+	}
+
+	fmt.Println(color.New(color.FgGreen).Sprint("✅ Successfully logged out"))
+	for _, path := range result.Removed {
+		fmt.Printf("  Removed %s\n", color.New(color.FgCyan).Sprint(path))
+	}
+	if len(result.Removed) == 0 {
+		fmt.Println("  No stored credentials to remove")
+	}
+	if result.Revoked {
+		fmt.Printf("  %s Revoked the OAuth token\n", color.New(color.FgGreen).Sprint("✓"))
+	} else if result.RevokeError != "" {
+		fmt.Fprintf(os.Stderr, "%s Could not revoke the OAuth token: %s\n", color.New(color.FgRed).Sprint("✗"), result.RevokeError)
+	}
+	for _, note := range result.Notes {
+		fmt.Fprintf(os.Stderr, "%s %s\n", color.New(color.FgYellow).Sprint("⚠️"), note)
+	}
+}
+
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Show current user",
@@ -159,14 +358,14 @@ var rateLimitCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		rl, err := client.GetRateLimit(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get rate limit: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -222,6 +421,8 @@ func init() {
 	authCmd.AddCommand(logoutCmd)
 	authCmd.AddCommand(rateLimitCmd)
 
+	logoutCmd.Flags().Bool("revoke", false, "Also revoke a stored OAuth token with Linear")
+
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
 }
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+)
+
+func TestHermeticAuthLogout(t *testing.T) {
+	newMockLinear(t)
+	t.Setenv("LINEAR_API_KEY", "")
+	home, _ := os.UserHomeDir()
+	credential := filepath.Join(home, ".linear-cli-auth.json")
+	seen, _ := inboxSeenPath()
+	if err := os.MkdirAll(filepath.Dir(seen), 0700); err != nil {
+		t.Fatal(err)
+	}
+	for path, content := range map[string]string{credential: `{"access_token":"lin_oauth_abc"}`, seen: `{}`} {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	revoked := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revoked = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+	defer func(previous string) { auth.RevokeURL = previous }(auth.RevokeURL)
+	auth.RevokeURL = srv.URL
+
+	r := runMocked(t, "auth", "logout", "--revoke", "--json")
+	var got logoutResult
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &got) != nil {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if !got.Revoked || revoked != "Bearer lin_oauth_abc" || got.Method != auth.MethodOAuth ||
+		strings.Join(got.Removed, ",") != credential+","+seen {
+		t.Errorf("logout = %s (revoke header %q)", r.Stdout, revoked)
+	}
+
+	// auth status then reports being signed out, not a missing file
+	r = runMocked(t, "auth", "status", "--json")
+	if r.Exit != 1 || !strings.Contains(r.Stdout, `"authenticated": false`) || strings.Contains(r.Stdout, "no such file") {
+		t.Errorf("status exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	// A Personal API Key can only be revoked in Linear's settings
+	if err := os.WriteFile(credential, []byte(`{"api_key":"lin_api_abc"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	r = runMocked(t, "auth", "logout", "--revoke", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "Removed: "+credential) || !strings.Contains(r.Stdout, auth.APIKeySettingsURL) {
+		t.Errorf("API key logout exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/roboalchemist/linear-cli/pkg/repoconfig"
+	"github.com/spf13/cobra"
+)
+
+// addAutoTeamFlag registers --no-auto-team on commands that pick up the
+// repo-local directory → team mapping
+func addAutoTeamFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("no-auto-team", false, "Don't infer --team/--project from the repo's .linear-cli.yaml path mapping")
+}
+
+// resolveAutoTeam finds the repo-local mapping for dir. It returns nil when
+// --team was given explicitly, --no-auto-team is set, or nothing matches.
+func resolveAutoTeam(cmd *cobra.Command, dir string) (*repoconfig.Mapping, error) {
+	if cmd.Flags().Changed("team") {
+		return nil, nil
+	}
+	if disabled, _ := cmd.Flags().GetBool("no-auto-team"); disabled {
+		return nil, nil
+	}
+
+	cfg, err := repoconfig.Find(dir)
+	if err != nil || cfg == nil {
+		return nil, err
+	}
+	mapping, err := cfg.Resolve(dir)
+	if err != nil || mapping == nil || mapping.Team == "" {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+// applyAutoTeam fills --team (and --project, when the command has it and it
+// wasn't given) from the working directory's mapping, and says so on stderr
+func applyAutoTeam(cmd *cobra.Command) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return
+	}
+	mapping, err := resolveAutoTeam(cmd, dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring %s: %v\n", repoconfig.FileName, err)
+		return
+	}
+	if mapping == nil {
+		return
+	}
+
+	_ = cmd.Flags().Set("team", mapping.Team)
+	applied := fmt.Sprintf("team %s", mapping.Team)
+	if mapping.Project != "" && cmd.Flags().Lookup("project") != nil && !cmd.Flags().Changed("project") {
+		_ = cmd.Flags().Set("project", mapping.Project)
+		applied += fmt.Sprintf(", project %s", mapping.Project)
+	}
+	fmt.Fprintf(os.Stderr, "Using %s from %s mapping for %s (--no-auto-team to disable)\n", applied, repoconfig.FileName, mapping.Path)
+}
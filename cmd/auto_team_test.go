@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newAutoTeamTestCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().StringP("team", "t", "", "")
+	cmd.Flags().String("project", "", "")
+	addAutoTeamFlag(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("failed to parse flags %v: %v", args, err)
+	}
+	return cmd
+}
+
+func TestResolveAutoTeamPrecedence(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := "paths:\n  services/api:\n    team: API\n"
+	if err := os.WriteFile(filepath.Join(root, ".linear-cli.yaml"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	dir := filepath.Join(root, "services", "api")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := resolveAutoTeam(newAutoTeamTestCmd(t), dir)
+	if err != nil || m == nil || m.Team != "API" {
+		t.Fatalf("mapping not applied: %+v, %v", m, err)
+	}
+
+	if m, _ := resolveAutoTeam(newAutoTeamTestCmd(t, "--team", "OPS"), dir); m != nil {
+		t.Errorf("explicit --team should win, got mapping %+v", m)
+	}
+
+	if m, _ := resolveAutoTeam(newAutoTeamTestCmd(t, "--no-auto-team"), dir); m != nil {
+		t.Errorf("--no-auto-team should disable mapping, got %+v", m)
+	}
+
+	if m, _ := resolveAutoTeam(newAutoTeamTestCmd(t), root); m != nil {
+		t.Errorf("unmapped directory should not match, got %+v", m)
+	}
+}
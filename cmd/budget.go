@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// exitRequestBudget is the exit code when a command stops because the
+// --max-requests budget ran out, so scripts can tell it from a failure
+const exitRequestBudget = 4
+
+// Exit codes for commands that fail because Linear refused the API key, its
+// permissions, or the request rate, so scripts can react without parsing
+// the message
+const (
+	exitAuthentication = 6
+	exitForbidden      = 7
+	exitRateLimited    = 8
+)
+
+var (
+	// serving is set while 'serve --stdio' runs. The request budget then
+	// covers the whole session instead of being reset per request.
+	serving bool
+	// commandRequestsStart is the request count when the current command
+	// began, so --verbose reports that command's requests only
+	commandRequestsStart int64
+)
+
+// applyRequestBudget arms the --max-requests cap for the command about to run
+func applyRequestBudget() {
+	if serving {
+		commandRequestsStart = api.RequestsMade()
+		return
+	}
+	api.SetRequestBudget(viper.GetInt("max_requests"))
+	commandRequestsStart = 0
+}
+
+// exitStatus maps a failing command's exit code to exitRequestBudget when
+// the failure was the request budget running out, to exitReadOnly when
+// read-only mode refused a mutation, or to the exit code for the last API
+// error when Linear rejected the key, its permissions, or the request rate
+func exitStatus(code int) int {
+	switch {
+	case code == 0:
+		return code
+	case api.RequestBudgetExceeded():
+		return exitRequestBudget
+	case api.ReadOnlyRefused():
+		return exitReadOnly
+	}
+
+	var apiErr *api.APIError
+	if errors.As(api.LastAPIError(), &apiErr) {
+		switch {
+		case apiErr.HasCode("AUTHENTICATION_ERROR"):
+			return exitAuthentication
+		case apiErr.HasCode("FORBIDDEN"):
+			return exitForbidden
+		case api.IsRateLimited(apiErr):
+			return exitRateLimited
+		}
+	}
+	return code
+}
+
+// reportAPIUsage writes the command's request count and the remaining rate
+// limit to w when --verbose is set
+func reportAPIUsage(w io.Writer) {
+	if !viper.GetBool("verbose") {
+		return
+	}
+	line := fmt.Sprintf("api: %d requests", api.RequestsMade()-commandRequestsStart)
+	if summary := rateLimitSummary(api.LatestRateLimit(), time.Now()); summary != "" {
+		line += "; " + summary
+	}
+	fmt.Fprintln(w, line)
+}
+
+// rateLimitSummary describes the remaining hourly request allowance, or ""
+// when no response has carried rate-limit headers yet
+func rateLimitSummary(rl *api.RateLimit, now time.Time) string {
+	if rl == nil || rl.RequestLimit == 0 {
+		return ""
+	}
+	summary := fmt.Sprintf("%d of %d requests remaining this hour", rl.RequestRemaining, rl.RequestLimit)
+	if !rl.RequestReset.IsZero() {
+		summary += fmt.Sprintf(" (resets in %s)", rl.RequestReset.Sub(now).Round(time.Second))
+	}
+	return summary
+}
+
+// finishCommand runs after every command, successful or not
+func finishCommand() {
+	reportAPIUsage(os.Stderr)
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func TestCountLabelIssuesStopsAtBudget(t *testing.T) {
+	var hits atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Write([]byte(`{"data":{"issueLabel":{"issues":{"nodes":[{"id":"i"}],"pageInfo":{"hasNextPage":false}}}}}`))
+	}))
+	defer server.Close()
+	api.SetRequestBudget(4)
+	defer api.SetRequestBudget(0)
+
+	labels := make([]api.Label, 12)
+	for i := range labels {
+		labels[i] = api.Label{ID: "l", Name: "label"}
+	}
+	err := countLabelIssues(api.NewClientWithURL(server.URL, "key"), labels)
+
+	var budgetErr *api.BudgetExceededError
+	if !errors.As(err, &budgetErr) {
+		t.Fatalf("countLabelIssues error = %v, want budget exceeded", err)
+	}
+	if hits.Load() != 4 {
+		t.Errorf("server saw %d requests, want 4", hits.Load())
+	}
+	counted := 0
+	for _, l := range labels {
+		if l.IssueCount != nil {
+			counted++
+		}
+	}
+	if counted != 4 {
+		t.Errorf("%d labels counted, want 4", counted)
+	}
+	if got := exitStatus(1); got != exitRequestBudget {
+		t.Errorf("exitStatus(1) = %d, want %d", got, exitRequestBudget)
+	}
+}
+
+func TestExitStatusWithoutBudget(t *testing.T) {
+	api.SetRequestBudget(0)
+	if exitStatus(1) != 1 || exitStatus(0) != 0 {
+		t.Error("exitStatus should pass codes through when no budget is exceeded")
+	}
+}
+
+func TestReportAPIUsage(t *testing.T) {
+	var buf bytes.Buffer
+	viper.Set("verbose", false)
+	reportAPIUsage(&buf)
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q without --verbose", buf.String())
+	}
+
+	viper.Set("verbose", true)
+	defer viper.Set("verbose", false)
+	reportAPIUsage(&buf)
+	if !strings.HasPrefix(buf.String(), "api: ") {
+		t.Errorf("verbose output = %q", buf.String())
+	}
+}
+
+func TestRateLimitSummary(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	rl := &api.RateLimit{RequestLimit: 1500, RequestRemaining: 1432, RequestReset: now.Add(42 * time.Minute)}
+	want := "1432 of 1500 requests remaining this hour (resets in 42m0s)"
+	if got := rateLimitSummary(rl, now); got != want {
+		t.Errorf("rateLimitSummary = %q, want %q", got, want)
+	}
+	if got := rateLimitSummary(nil, now); got != "" {
+		t.Errorf("rateLimitSummary(nil) = %q", got)
+	}
+}
+
+func TestHermeticAPIErrorExitCodes(t *testing.T) {
+	tests := []struct {
+		status int
+		code   string
+		want   int
+	}{
+		{401, "AUTHENTICATION_ERROR", exitAuthentication},
+		{403, "FORBIDDEN", exitForbidden},
+		{400, "RATELIMITED", exitRateLimited},
+		{400, "INVALID_INPUT", 1},
+	}
+	for _, tt := range tests {
+		s := newMockLinear(t)
+		s.Error("Teams", tt.status, "refused", tt.code)
+		if r := runMocked(t, "team", "list", "--plaintext"); r.Exit != tt.want {
+			t.Errorf("%s: exited %d, want %d", tt.code, r.Exit, tt.want)
+		}
+	}
+
+	// A later command doesn't inherit an earlier one's error
+	s := newMockLinear(t)
+	s.Error("Teams", 401, "refused", "AUTHENTICATION_ERROR")
+	runMocked(t, "team", "list")
+	if r := runMocked(t, "issue", "get", "bad id", "--plaintext"); r.Exit != 1 {
+		t.Errorf("a failure without an API error exited %d, want 1", r.Exit)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -36,45 +37,42 @@ var commentListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueID := issue.Identifier
+
 		// Get limit
 		limit, _ := cmd.Flags().GetInt("limit")
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		// Get comments
-		comments, err := client.GetIssueComments(context.Background(), issueID, limit, "", orderBy)
+		comments, err := client.GetIssueComments(context.Background(), issue.ID, limit, "", orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, comments.PageInfo, len(comments.Nodes))
 
 		// Handle output
 		if jsonOut {
@@ -86,12 +84,12 @@ var commentListCmd = &cobra.Command{
 				}
 				fmt.Printf("ID: %s\n", comment.ID)
 				fmt.Printf("Author: %s\n", getCommentAuthor(&comment))
-				fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("Date: %s\n", output.FormatTime(comment.CreatedAt, output.DateTime))
 				if comment.EditedAt != nil {
-					fmt.Printf("Edited: %s\n", comment.EditedAt.Format("2006-01-02 15:04:05"))
+					fmt.Printf("Edited: %s\n", output.FormatTime(*comment.EditedAt, output.DateTime))
 				}
 				if comment.ResolvedAt != nil {
-					fmt.Printf("Resolved: %s", comment.ResolvedAt.Format("2006-01-02 15:04:05"))
+					fmt.Printf("Resolved: %s", output.FormatTime(*comment.ResolvedAt, output.DateTime))
 					if comment.ResolvingUser != nil {
 						fmt.Printf(" by %s", safeUserName(comment.ResolvingUser))
 					}
@@ -179,40 +177,78 @@ Use --body-file - to read from stdin.
 Threaded comments:
   Use --parent to reply to an existing comment, creating a threaded conversation.
 
+File attachments:
+  Use --attach (repeatable) to upload files and link them under an "Attachments:"
+  line at the end of the comment. Images are embedded; other files become links.
+  If any upload fails, no comment is created.
+
 Examples:
   linear-cli issue comment create LIN-123 --body "Fixed the bug"
   linear-cli issue comment create LIN-123 --body-file comment.md
   linear-cli issue comment create LIN-123 --body "Reply" --parent COMMENT-UUID
   linear-cli issue comment create LIN-123 --body "Note" --quoted-text "Original text"
+  linear-cli issue comment create LIN-123 --body "Crash log" --attach app.log --attach screen.png
   cat notes.md | linear-cli issue comment create LIN-123 --body-file -`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueID := issue.Identifier
+
 		// Resolve comment body from --body or --body-file
 		bodyFlag, _ := cmd.Flags().GetString("body")
 		filePath, _ := cmd.Flags().GetString("body-file")
 		body, err := resolveBodyFromFlags(bodyFlag, cmd.Flags().Changed("body"), filePath, "body", "body-file")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
-		if body == "" {
+		attachPaths, _ := cmd.Flags().GetStringArray("attach")
+		if body == "" && len(attachPaths) == 0 {
 			output.Error("Comment body is required (--body or --body-file)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			input := map[string]interface{}{"issueId": issue.ID, "body": body}
+			if len(attachPaths) > 0 {
+				input["attach"] = attachPaths
+			}
+			if parentID, _ := cmd.Flags().GetString("parent"); parentID != "" {
+				input["parentId"] = parentID
+			}
+			printDryRun("comment on "+issueID, input, "body", plaintext, jsonOut)
+			return
+		}
+
+		// Upload attachments before creating the comment so a failed upload
+		// leaves nothing behind that points at it
+		uploads, err := readCommentAttachments(attachPaths)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if err := uploadCommentAttachments(context.Background(), client, uploads); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
+		body = appendAttachmentLinks(body, uploads)
 
 		// Build options
 		opts := &api.CommentCreateOptions{}
@@ -227,23 +263,32 @@ Examples:
 		}
 
 		// Create comment
-		comment, err := client.CreateComment(context.Background(), issueID, body, opts)
+		comment, err := client.CreateComment(context.Background(), issue.ID, body, opts)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "comment", comment.ID, "", &journal.Inverse{Action: "delete"})
 
 		// Handle output
+		if printCreated(cmd, createdEntity{Type: "comment", ID: comment.ID, Name: issue.Identifier, URL: commentURL(comment, issue.URL)}) {
+			return
+		}
 		if jsonOut {
-			output.JSON(comment)
+			if len(uploads) > 0 {
+				output.JSON(map[string]interface{}{
+					"comment":     comment,
+					"attachments": uploads,
+				})
+			} else {
+				output.JSON(comment)
+			}
 		} else if plaintext {
 			fmt.Printf("Created comment on %s\n", issueID)
 			fmt.Printf("ID: %s\n", comment.ID)
 			fmt.Printf("Author: %s\n", getCommentAuthor(comment))
-			fmt.Printf("Date: %s\n", comment.CreatedAt.Format("2006-01-02 15:04:05"))
-			if comment.URL != "" {
-				fmt.Printf("URL: %s\n", comment.URL)
-			}
+			fmt.Printf("Date: %s\n", output.FormatTime(comment.CreatedAt, output.DateTime))
+			printResultURL(commentURL(comment, issue.URL), plaintext)
 			if comment.ParentID != nil && *comment.ParentID != "" {
 				fmt.Printf("Reply to: %s\n", *comment.ParentID)
 			}
@@ -256,6 +301,7 @@ Examples:
 					color.New(color.FgWhite, color.Faint).Sprint("↳"),
 					color.New(color.FgWhite, color.Faint).Sprint(*comment.ParentID))
 			}
+			printResultURL(commentURL(comment, issue.URL), plaintext)
 			fmt.Printf("\n%s\n", comment.Body)
 		}
 	},
@@ -351,6 +397,10 @@ Use --body-file - to read from stdin.
 Resolution:
   Use --resolve to mark a comment as resolved (e.g., feedback addressed).
   Use --unresolve to clear the resolution status.
+  Linear resolves whole threads, so resolving a reply does nothing visible.
+  Given a reply, --resolve and --unresolve act on its thread's root comment
+  instead, with a note on stderr; --body and --quoted-text still edit the
+  reply itself.
 
 Examples:
   linear-cli issue comment update COMMENT-ID --body "Updated text"
@@ -361,13 +411,13 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 		commentID := args[0]
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -383,7 +433,7 @@ Examples:
 			body, err := resolveBodyFromFlags(bodyFlag, cmd.Flags().Changed("body"), filePath, "body", "body-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			opts.Body = &body
 			hasChanges = true
@@ -399,7 +449,7 @@ Examples:
 
 		if resolve && unresolve {
 			output.Error("Cannot use both --resolve and --unresolve", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if resolve {
@@ -407,7 +457,7 @@ Examples:
 			viewer, err := client.GetViewer(context.Background())
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			opts.ResolvingUserID = &viewer.ID
 			hasChanges = true
@@ -425,65 +475,186 @@ Examples:
 
 		if !hasChanges {
 			output.Error("No changes specified. Use --body, --resolve, --unresolve, or --quoted-text", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		// Resolution belongs to the thread, so on a reply it goes to the root
+		if resolve || unresolve {
+			target, err := client.GetComment(context.Background(), commentID)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get comment: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			rootID, err := commentThreadRoot(client, target)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+			if rootID != target.ID {
+				verb := "resolving"
+				if unresolve {
+					verb = "unresolving"
+				}
+				fmt.Fprintf(os.Stderr, "Note: %s is a reply; %s its thread's root comment %s instead\n", target.ID, verb, rootID)
+				rootOpts := &api.CommentUpdateOptions{ResolvingUserID: opts.ResolvingUserID, DoNotSubscribe: opts.DoNotSubscribe}
+				root, err := client.UpdateComment(context.Background(), rootID, rootOpts)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to update root comment %s: %v", rootID, err), plaintext, jsonOut)
+					exit(1)
+				}
+				recordOperation(cmd, "comment", root.ID, "", nil)
+				opts.ResolvingUserID = nil
+				if opts.Body == nil && opts.QuotedText == nil {
+					printUpdatedComment(cmd, root, plaintext, jsonOut)
+					return
+				}
+			}
 		}
 
 		comment, err := client.UpdateComment(context.Background(), commentID, opts)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "comment", comment.ID, "", nil)
+		printUpdatedComment(cmd, comment, plaintext, jsonOut)
+	},
+}
 
-		if jsonOut {
-			output.JSON(comment)
-		} else if plaintext {
-			fmt.Println("Updated comment")
-			fmt.Printf("ID: %s\n", comment.ID)
-			if comment.EditedAt != nil {
-				fmt.Printf("Edited at: %s\n", comment.EditedAt.Format("2006-01-02 15:04:05"))
-			}
-			if comment.ResolvedAt != nil {
-				fmt.Printf("Resolved at: %s\n", comment.ResolvedAt.Format("2006-01-02 15:04:05"))
-				if comment.ResolvingUser != nil {
-					fmt.Printf("Resolved by: %s\n", safeUserName(comment.ResolvingUser))
-				}
-			}
-		} else {
-			output.Success("Updated comment", plaintext, jsonOut)
-			if comment.ResolvedAt != nil {
-				fmt.Printf("   %s Resolved by %s\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					color.New(color.FgCyan).Sprint(safeUserName(comment.ResolvingUser)))
+// printUpdatedComment prints the result of comment update
+func printUpdatedComment(cmd *cobra.Command, comment *api.Comment, plaintext, jsonOut bool) {
+	if urlOnly(cmd) {
+		printURLOnly(comment.URL, "comment")
+	} else if jsonOut {
+		output.JSON(comment)
+	} else if plaintext {
+		fmt.Println("Updated comment")
+		fmt.Printf("ID: %s\n", comment.ID)
+		if comment.EditedAt != nil {
+			fmt.Printf("Edited at: %s\n", output.FormatTime(*comment.EditedAt, output.DateTime))
+		}
+		if comment.ResolvedAt != nil {
+			fmt.Printf("Resolved at: %s\n", output.FormatTime(*comment.ResolvedAt, output.DateTime))
+			if comment.ResolvingUser != nil {
+				fmt.Printf("Resolved by: %s\n", safeUserName(comment.ResolvingUser))
 			}
 		}
-	},
+		printResultURL(comment.URL, plaintext)
+	} else {
+		output.Success("Updated comment", plaintext, jsonOut)
+		if comment.ResolvedAt != nil {
+			fmt.Printf("   %s Resolved by %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan).Sprint(safeUserName(comment.ResolvingUser)))
+		}
+		printResultURL(comment.URL, plaintext)
+	}
+}
+
+// commentURL is the comment's own URL, or one built from its issue's URL
+// when the API left it out
+func commentURL(comment *api.Comment, issueURL string) string {
+	if comment.URL != "" {
+		return comment.URL
+	}
+	return constructCommentURL(issueURL, comment.ID)
 }
 
 var commentDeleteCmd = &cobra.Command{
 	Use:     "delete COMMENT-ID",
 	Aliases: []string{"rm"},
 	Short:   "Delete a comment",
-	Long:    `Delete an existing comment.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Delete an existing comment.
+
+A comment with replies isn't deleted unless you say what happens to them:
+  --with-replies     Delete the whole thread below it too, replies first, with
+                     a result per comment. A comment whose reply couldn't be
+                     deleted is kept.
+  --orphan-replies   Delete only the comment and leave its replies behind.
+
+Use --dry-run to list what would be deleted without deleting anything.
+
+Examples:
+  linear-cli issue comment delete COMMENT-ID
+  linear-cli issue comment delete COMMENT-ID --with-replies --dry-run
+  linear-cli issue comment delete COMMENT-ID --with-replies
+  linear-cli issue comment delete COMMENT-ID --orphan-replies`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		commentID := args[0]
+		withReplies, _ := cmd.Flags().GetBool("with-replies")
+		orphanReplies, _ := cmd.Flags().GetBool("orphan-replies")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		if withReplies && orphanReplies {
+			output.Error("Cannot use both --with-replies and --orphan-replies", plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
-		err = client.DeleteComment(context.Background(), commentID)
+		comment, err := client.GetComment(context.Background(), commentID)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to delete comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to get comment: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		replies := 0
+		if comment.Children != nil {
+			replies = len(comment.Children.Nodes)
+		}
+		if replies > 0 && !withReplies && !orphanReplies {
+			output.Error(fmt.Sprintf("Comment %s has %d direct replies; use --with-replies to delete the thread or --orphan-replies to delete only this comment", comment.ID, replies), plaintext, jsonOut)
+			exit(1)
+		}
+
+		thread := []threadComment{{Comment: *comment}}
+		if withReplies {
+			thread, err = commentThreadBottomUp(client, comment)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		if len(thread) == 1 && !dryRun {
+			if err := client.DeleteComment(context.Background(), comment.ID); err != nil {
+				output.Error(fmt.Sprintf("Failed to delete comment: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			recordOperation(cmd, "comment", comment.ID, "", nil)
+			output.Success("Deleted comment", plaintext, jsonOut)
+			return
+		}
+
+		summary := commentDeleteSummary{Comment: comment.ID, Replies: len(thread) - 1, DryRun: dryRun}
+		summary.Results = deleteCommentThread(client, thread, comment.ID, dryRun, func(id string) {
+			recordOperation(cmd, "comment", id, "", nil)
+		})
+		for _, r := range summary.Results {
+			switch r.Status {
+			case "deleted":
+				summary.Deleted++
+			case "failed":
+				summary.Failed++
+			}
 		}
 
-		output.Success("Deleted comment", plaintext, jsonOut)
+		if jsonOut {
+			output.JSON(summary)
+		} else {
+			printCommentDeleteSummary(summary, plaintext)
+		}
+		if summary.Failed > 0 {
+			exit(1)
+		}
 	},
 }
 
@@ -500,17 +671,27 @@ func init() {
 	commentListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 
 	// Create command flags
-	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required unless --body-file is used)")
-	commentCreateCmd.Flags().String("body-file", "", "Read body from a markdown file (use - for stdin)")
+	commentCreateCmd.Flags().StringP("body", "b", "", "Comment body (required unless --body-file or --attach is used)")
+	commentCreateCmd.Flags().String("body-file", "", "Read body from a markdown file or https:// URL (use - for stdin)")
 	commentCreateCmd.Flags().String("parent", "", "Parent comment ID (for threaded replies)")
 	commentCreateCmd.Flags().String("quoted-text", "", "Text being quoted or referenced")
 	commentCreateCmd.Flags().Bool("do-not-subscribe", false, "Don't subscribe to the issue after commenting")
+	commentCreateCmd.Flags().StringArray("attach", nil, "Upload a file and link it in the comment (repeatable; images are embedded)")
+	addURLOnlyFlag(commentCreateCmd)
+	addPorcelainFlag(commentCreateCmd)
+	addDryRunFlag(commentCreateCmd)
 
 	// Update command flags
 	commentUpdateCmd.Flags().StringP("body", "b", "", "New comment body")
-	commentUpdateCmd.Flags().String("body-file", "", "Read body from a markdown file (use - for stdin)")
+	commentUpdateCmd.Flags().String("body-file", "", "Read body from a markdown file or https:// URL (use - for stdin)")
 	commentUpdateCmd.Flags().String("quoted-text", "", "Text being quoted or referenced")
 	commentUpdateCmd.Flags().Bool("resolve", false, "Mark the comment as resolved")
 	commentUpdateCmd.Flags().Bool("unresolve", false, "Clear the resolution status")
 	commentUpdateCmd.Flags().Bool("do-not-subscribe", false, "Don't subscribe to the issue")
+	addURLOnlyFlag(commentUpdateCmd)
+
+	// Delete command flags
+	commentDeleteCmd.Flags().Bool("with-replies", false, "Also delete every reply below the comment, replies first")
+	commentDeleteCmd.Flags().Bool("orphan-replies", false, "Delete the comment even though it has replies, leaving them behind")
+	commentDeleteCmd.Flags().Bool("dry-run", false, "List what would be deleted without deleting anything")
 }
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// commentUpload is one file uploaded for 'comment create --attach'
+type commentUpload struct {
+	Path        string `json:"path"`
+	Filename    string `json:"filename"`
+	ContentType string `json:"contentType"`
+	AssetURL    string `json:"assetUrl"`
+	Image       bool   `json:"image"`
+
+	data []byte
+}
+
+// readCommentAttachments reads every file up front so a missing or unreadable
+// file fails before anything is uploaded
+func readCommentAttachments(paths []string) ([]*commentUpload, error) {
+	uploads := make([]*commentUpload, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		contentType, image := sniffUploadType(filepath.Base(path), data)
+		uploads = append(uploads, &commentUpload{
+			Path:        path,
+			Filename:    filepath.Base(path),
+			ContentType: contentType,
+			Image:       image,
+			data:        data,
+		})
+	}
+	return uploads, nil
+}
+
+// sniffUploadType picks the upload content type from the file extension,
+// falling back to the file's contents, and reports whether the file should be
+// embedded as an image. Only contents that sniff as an image (or SVG, which
+// sniffs as text) are embedded, so a mislabelled file becomes a plain link.
+func sniffUploadType(filename string, data []byte) (contentType string, image bool) {
+	sniffed := http.DetectContentType(data)
+	contentType = detectContentType(filename)
+	if contentType == "" {
+		contentType = sniffed
+	}
+	image = strings.HasPrefix(sniffed, "image/") || (contentType == "image/svg+xml" && strings.HasPrefix(sniffed, "text/"))
+	return contentType, image
+}
+
+// uploadCommentAttachments uploads each file, stopping at the first failure
+func uploadCommentAttachments(ctx context.Context, client *api.Client, uploads []*commentUpload) error {
+	for _, u := range uploads {
+		file, err := client.FileUpload(ctx, u.Filename, u.ContentType, len(u.data), false)
+		if err != nil {
+			return fmt.Errorf("failed to get upload URL for %s: %w", u.Path, err)
+		}
+		if err := client.UploadFileToURL(ctx, file.UploadURL, file.Headers, u.data, u.ContentType); err != nil {
+			return fmt.Errorf("failed to upload %s: %w", u.Path, err)
+		}
+		u.AssetURL = file.AssetURL
+	}
+	return nil
+}
+
+// appendAttachmentLinks adds an "Attachments:" section to body with an image
+// embed or a link for each uploaded file. An empty body gets just the section.
+func appendAttachmentLinks(body string, uploads []*commentUpload) string {
+	if len(uploads) == 0 {
+		return body
+	}
+	var b strings.Builder
+	if body = strings.TrimRight(body, "\n"); body != "" {
+		b.WriteString(body)
+		b.WriteString("\n\n")
+	}
+	b.WriteString("Attachments:")
+	for _, u := range uploads {
+		name := strings.NewReplacer("[", "\\[", "]", "\\]").Replace(u.Filename)
+		if u.Image {
+			fmt.Fprintf(&b, "\n- ![%s](%s)", name, u.AssetURL)
+		} else {
+			fmt.Fprintf(&b, "\n- [%s](%s)", name, u.AssetURL)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// pngHeader is enough of a PNG file for content sniffing
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestSniffUploadType(t *testing.T) {
+	tests := []struct {
+		filename  string
+		data      []byte
+		wantType  string
+		wantImage bool
+	}{
+		{"screen.png", pngHeader, "image/png", true},
+		{"screen", pngHeader, "image/png", true},
+		{"app.log", []byte("2024-01-01 ERROR boom\n"), "text/plain; charset=utf-8", false},
+		{"notes.txt", []byte("hello"), "text/plain", false},
+		{"fake.png", []byte("not really a png"), "image/png", false},
+		{"logo.svg", []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`), "image/svg+xml", true},
+	}
+	for _, tt := range tests {
+		gotType, gotImage := sniffUploadType(tt.filename, tt.data)
+		if gotType != tt.wantType || gotImage != tt.wantImage {
+			t.Errorf("sniffUploadType(%q) = %q, %v; want %q, %v", tt.filename, gotType, gotImage, tt.wantType, tt.wantImage)
+		}
+	}
+}
+
+func TestAppendAttachmentLinks(t *testing.T) {
+	uploads := []*commentUpload{
+		{Filename: "app.log", AssetURL: "https://uploads.linear.app/a"},
+		{Filename: "screen[1].png", AssetURL: "https://uploads.linear.app/b", Image: true},
+	}
+	want := "Crash log\n\nAttachments:\n- [app.log](https://uploads.linear.app/a)\n- ![screen\\[1\\].png](https://uploads.linear.app/b)"
+	if got := appendAttachmentLinks("Crash log\n", uploads); got != want {
+		t.Errorf("appendAttachmentLinks =\n%s\nwant\n%s", got, want)
+	}
+	if got := appendAttachmentLinks("", uploads[:1]); got != "Attachments:\n- [app.log](https://uploads.linear.app/a)" {
+		t.Errorf("empty body: got %q", got)
+	}
+	if got := appendAttachmentLinks("body", nil); got != "body" {
+		t.Errorf("no uploads: got %q", got)
+	}
+}
+
+func TestReadCommentAttachmentsMissingFile(t *testing.T) {
+	dir := t.TempDir()
+	present := filepath.Join(dir, "app.log")
+	if err := os.WriteFile(present, []byte("log"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := readCommentAttachments([]string{present, filepath.Join(dir, "missing.log")})
+	if err == nil || !strings.Contains(err.Error(), "missing.log") {
+		t.Errorf("got %v, want an error naming missing.log", err)
+	}
+}
+
+func TestUploadCommentAttachmentsStopsOnFailure(t *testing.T) {
+	var puts int
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			puts++
+			if puts == 2 {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			return
+		}
+		w.Write([]byte(`{"data":{"fileUpload":{"success":true,"uploadFile":{"uploadUrl":"` + server.URL + `/put","assetUrl":"https://uploads.linear.app/asset"}}}}`))
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	uploads := []*commentUpload{
+		{Path: "a.log", Filename: "a.log", ContentType: "text/plain", data: []byte("a")},
+		{Path: "b.log", Filename: "b.log", ContentType: "text/plain", data: []byte("b")},
+		{Path: "c.log", Filename: "c.log", ContentType: "text/plain", data: []byte("c")},
+	}
+	err := uploadCommentAttachments(context.Background(), client, uploads)
+	if err == nil || !strings.Contains(err.Error(), "b.log") {
+		t.Fatalf("got %v, want an error naming b.log", err)
+	}
+	if puts != 2 {
+		t.Errorf("made %d uploads, want 2 (stop after the failure)", puts)
+	}
+	if uploads[0].AssetURL != "https://uploads.linear.app/asset" {
+		t.Errorf("first upload asset URL = %q", uploads[0].AssetURL)
+	}
+}
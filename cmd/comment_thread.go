@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+)
+
+// commentDeleteResult is one comment of a comment delete. Status is
+// "deleted", "failed", "skipped" when one of its replies couldn't be
+// deleted, or "planned" under --dry-run.
+type commentDeleteResult struct {
+	ID      string `json:"id"`
+	Author  string `json:"author"`
+	Preview string `json:"preview"`
+	Reply   bool   `json:"reply"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// commentDeleteSummary is the JSON output of comment delete
+type commentDeleteSummary struct {
+	Comment string                `json:"comment"`
+	Replies int                   `json:"replies"`
+	DryRun  bool                  `json:"dryRun"`
+	Deleted int                   `json:"deleted"`
+	Failed  int                   `json:"failed"`
+	Results []commentDeleteResult `json:"results"`
+}
+
+// threadComment is a comment to delete, with the IDs of its direct replies
+type threadComment struct {
+	api.Comment
+	replyIDs []string
+}
+
+// commentThreadBottomUp returns comment and every reply below it, replies
+// before the comment they answer, so deleting in order never leaves a reply
+// without its parent
+func commentThreadBottomUp(client *api.Client, comment *api.Comment) ([]threadComment, error) {
+	var thread []threadComment
+	var replyIDs []string
+	if comment.Children != nil {
+		if comment.Children.PageInfo.HasNextPage {
+			return nil, fmt.Errorf("comment %s has more than %d replies; delete some first", comment.ID, len(comment.Children.Nodes))
+		}
+		for _, reply := range comment.Children.Nodes {
+			replyIDs = append(replyIDs, reply.ID)
+			if reply.Children != nil && len(reply.Children.Nodes) > 0 {
+				full, err := client.GetComment(context.Background(), reply.ID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to fetch reply %s: %w", reply.ID, err)
+				}
+				below, err := commentThreadBottomUp(client, full)
+				if err != nil {
+					return nil, err
+				}
+				thread = append(thread, below...)
+				continue
+			}
+			thread = append(thread, threadComment{Comment: reply})
+		}
+	}
+	return append(thread, threadComment{Comment: *comment, replyIDs: replyIDs}), nil
+}
+
+// deleteCommentThread deletes the comments in order. A comment whose reply
+// wasn't deleted is skipped, so nothing is orphaned by a failure.
+func deleteCommentThread(client *api.Client, thread []threadComment, rootID string, dryRun bool, deleted func(id string)) []commentDeleteResult {
+	kept := map[string]bool{}
+	results := make([]commentDeleteResult, len(thread))
+	for i, c := range thread {
+		r := commentDeleteResult{ID: c.ID, Author: safeUserName(c.User), Preview: commentPreview(c.Body), Reply: c.ID != rootID}
+		switch {
+		case dryRun:
+			r.Status = "planned"
+		case anyKept(c.replyIDs, kept):
+			r.Status = "skipped"
+			kept[c.ID] = true
+		default:
+			if err := client.DeleteComment(context.Background(), c.ID); err != nil {
+				r.Status = "failed"
+				r.Error = err.Error()
+				kept[c.ID] = true
+			} else {
+				r.Status = "deleted"
+				deleted(c.ID)
+			}
+		}
+		results[i] = r
+	}
+	return results
+}
+
+func anyKept(ids []string, kept map[string]bool) bool {
+	for _, id := range ids {
+		if kept[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// commentThreadRoot returns the comment a thread starts with: comment itself
+// unless it is a reply
+func commentThreadRoot(client *api.Client, comment *api.Comment) (string, error) {
+	for comment.Parent != nil {
+		if comment.Parent.ParentID == nil || *comment.Parent.ParentID == "" {
+			return comment.Parent.ID, nil
+		}
+		parent, err := client.GetComment(context.Background(), comment.Parent.ID)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch parent comment %s: %w", comment.Parent.ID, err)
+		}
+		comment = parent
+	}
+	return comment.ID, nil
+}
+
+// commentPreview is the first line of a comment body, shortened
+func commentPreview(body string) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	return truncateString(line, 60)
+}
+
+// printCommentDeleteSummary lists what comment delete did, or would do
+func printCommentDeleteSummary(s commentDeleteSummary, plaintext bool) {
+	for _, r := range s.Results {
+		what := "comment"
+		if r.Reply {
+			what = "reply"
+		}
+		line := fmt.Sprintf("%s %s by %s: %q", what, r.ID, r.Author, r.Preview)
+		if plaintext {
+			line = fmt.Sprintf("- %s: %s", line, r.Status)
+			if r.Error != "" {
+				line += " (" + r.Error + ")"
+			}
+			fmt.Println(line)
+			continue
+		}
+		switch r.Status {
+		case "planned":
+			fmt.Printf("  %s would delete %s\n", color.New(color.FgCyan).Sprint("→"), line)
+		case "deleted":
+			fmt.Printf("  %s deleted %s\n", color.New(color.FgGreen).Sprint("✓"), line)
+		case "skipped":
+			fmt.Printf("  %s kept %s: a reply couldn't be deleted\n", color.New(color.FgYellow).Sprint("-"), line)
+		default:
+			fmt.Printf("  %s %s: %s\n", color.New(color.FgRed).Sprint("✗"), line, r.Error)
+		}
+	}
+	switch {
+	case s.DryRun:
+		fmt.Printf("\nDry run: would delete %d comments; nothing was changed\n", len(s.Results))
+	case s.Failed > 0:
+		fmt.Printf("\nDeleted %d of %d comments; %d failed\n", s.Deleted, len(s.Results), s.Failed)
+	default:
+		fmt.Printf("\nDeleted %d comments\n", s.Deleted)
+	}
+}
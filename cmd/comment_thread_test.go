@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// stubCommentThread serves a thread: c1 with replies r1 and r3, and r2
+// replying to r1
+func stubCommentThread(s *linearmock.Server) {
+	user := `"user":{"id":"u1","name":"Ada"}`
+	s.DataFor("Comment", map[string]interface{}{"id": "c1"}, `{"comment":{"id":"c1","body":"Root","createdAt":"2026-01-02T00:00:00Z",`+user+`,"parent":null,
+		"children":{"nodes":[
+			{"id":"r1","body":"First reply","createdAt":"2026-01-02T00:00:00Z",`+user+`,"children":{"nodes":[{"id":"r2"}]}},
+			{"id":"r3","body":"Second reply","createdAt":"2026-01-02T00:00:00Z",`+user+`,"children":{"nodes":[]}}
+		],"pageInfo":{"hasNextPage":false}}}}`)
+	s.DataFor("Comment", map[string]interface{}{"id": "r1"}, `{"comment":{"id":"r1","body":"First reply","createdAt":"2026-01-02T00:00:00Z",`+user+`,"parentId":"c1","parent":{"id":"c1","parentId":null},
+		"children":{"nodes":[
+			{"id":"r2","body":"Nested reply","createdAt":"2026-01-02T00:00:00Z",`+user+`,"children":{"nodes":[]}}
+		],"pageInfo":{"hasNextPage":false}}}}`)
+	s.DataFor("Comment", map[string]interface{}{"id": "r2"}, `{"comment":{"id":"r2","body":"Nested reply","createdAt":"2026-01-02T00:00:00Z",`+user+`,"parentId":"r1","parent":{"id":"r1","parentId":"c1"},
+		"children":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("DeleteComment", `{"commentDelete":{"success":true}}`)
+}
+
+func TestCommentDeleteRefusesWithReplies(t *testing.T) {
+	s := newMockLinear(t)
+	stubCommentThread(s)
+
+	r := runMocked(t, "issue", "comment", "delete", "c1", "--json")
+	if r.Exit != 1 || !strings.Contains(r.Stdout, "2 direct replies") {
+		t.Fatalf("delete of a comment with replies exited %d: %s", r.Exit, r.Stdout)
+	}
+	for _, op := range s.Operations() {
+		if op == "DeleteComment" {
+			t.Fatalf("deleted without --with-replies: %v", s.Operations())
+		}
+	}
+
+	r = runMocked(t, "issue", "comment", "delete", "c1", "--with-replies", "--orphan-replies")
+	if r.Exit != 1 {
+		t.Errorf("--with-replies with --orphan-replies exited %d", r.Exit)
+	}
+
+	s.Reset()
+	stubCommentThread(s)
+	r = runMocked(t, "issue", "comment", "delete", "c1", "--orphan-replies", "--json")
+	if r.Exit != 0 || strings.Join(s.Operations(), ",") != "Comment,DeleteComment" {
+		t.Errorf("--orphan-replies exited %d after %v: %s", r.Exit, s.Operations(), r.Stdout)
+	}
+}
+
+func TestCommentDeleteWithReplies(t *testing.T) {
+	s := newMockLinear(t)
+	stubCommentThread(s)
+
+	r := runMocked(t, "issue", "comment", "delete", "c1", "--with-replies", "--dry-run", "--json")
+	var summary commentDeleteSummary
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &summary) != nil {
+		t.Fatalf("dry run exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	var order []string
+	for _, res := range summary.Results {
+		order = append(order, res.ID+":"+res.Status)
+	}
+	if got := strings.Join(order, ","); got != "r2:planned,r1:planned,r3:planned,c1:planned" || !summary.DryRun || summary.Replies != 3 {
+		t.Errorf("dry run = %s, %+v", got, summary)
+	}
+	for _, op := range s.Operations() {
+		if op == "DeleteComment" {
+			t.Fatalf("dry run deleted: %v", s.Operations())
+		}
+	}
+
+	// r2 fails, so r1 above it is kept, and so is the root
+	s.Add(linearmock.Stub{Operation: "DeleteComment", Variables: map[string]interface{}{"id": "r2"},
+		Response: json.RawMessage(`{"errors":[{"message":"Forbidden"}]}`)})
+	r = runMocked(t, "issue", "comment", "delete", "c1", "--with-replies", "--json")
+	summary = commentDeleteSummary{}
+	if r.Exit != 1 || json.Unmarshal([]byte(r.Stdout), &summary) != nil {
+		t.Fatalf("delete with a failure exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	order = nil
+	for _, res := range summary.Results {
+		order = append(order, res.ID+":"+res.Status)
+	}
+	if got := strings.Join(order, ","); got != "r2:failed,r1:skipped,r3:deleted,c1:skipped" || summary.Deleted != 1 || summary.Failed != 1 {
+		t.Errorf("delete = %s, %+v", got, summary)
+	}
+}
+
+func TestCommentResolveReplyTargetsRoot(t *testing.T) {
+	s := newMockLinear(t)
+	stubCommentThread(s)
+	s.Data("Me", `{"viewer":{"id":"u1","name":"Ada"}}`)
+	s.Data("UpdateComment", `{"commentUpdate":{"comment":{"id":"c1","body":"Root","createdAt":"2026-01-02T00:00:00Z","resolvedAt":"2026-01-03T00:00:00Z","resolvingUser":{"id":"u1","name":"Ada"}}}}`)
+
+	r := runMocked(t, "issue", "comment", "update", "r2", "--resolve", "--json")
+	if r.Exit != 0 || !strings.Contains(r.Stderr, "r2 is a reply") || !strings.Contains(r.Stdout, `"id": "c1"`) {
+		t.Fatalf("resolve of a reply exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	var updated []string
+	for _, req := range s.Requests() {
+		if req.Operation == "UpdateComment" {
+			updated = append(updated, req.Variables["id"].(string))
+		}
+	}
+	if strings.Join(updated, ",") != "c1" {
+		t.Errorf("updated %v, want only the root c1", updated)
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// compactInitialsWidth is the width of the initials column
+const compactInitialsWidth = 2
+
+// compactMinTitle is the narrowest title worth keeping the initials column
+// for; narrower panes drop the initials first
+const compactMinTitle = 8
+
+// compactItem is one line of --compact output
+type compactItem struct {
+	Icon      string       // one-column state glyph
+	IconColor *color.Color // nil for no color
+	Ref       string       // issue identifier, or "" for projects
+	Title     string
+	Owner     string // assignee or lead name, "" for nobody
+}
+
+// issueCompactItem is an issue's --compact line: state glyph, identifier,
+// title, and assignee
+func issueCompactItem(issue api.Issue) compactItem {
+	style := issueStateStyle(issue.State)
+	item := compactItem{Icon: style.Icon, IconColor: style.Color(), Ref: issue.Identifier, Title: issue.Title}
+	if issue.Assignee != nil {
+		item.Owner = issue.Assignee.Name
+	}
+	return item
+}
+
+// issueCompactItems is issueCompactItem for each issue
+func issueCompactItems(issues []api.Issue) []compactItem {
+	items := make([]compactItem, len(issues))
+	for i, issue := range issues {
+		items[i] = issueCompactItem(issue)
+	}
+	return items
+}
+
+// projectCompactItem is a project's --compact line: state glyph, name, and
+// lead
+func projectCompactItem(project api.Project) compactItem {
+	style := styleFor("project state", projectStateStyles, project.State)
+	item := compactItem{Icon: style.Icon, IconColor: style.Color(), Title: project.Name}
+	if project.Lead != nil {
+		item.Owner = project.Lead.Name
+	}
+	return item
+}
+
+// compactLines renders items one line each, at most width columns wide:
+// colored state glyph, identifier, the title cut to fit, and the owner's
+// initials against the right edge. Identifiers are padded to the longest so
+// titles line up. When the width runs short the initials go first, then
+// the title.
+func compactLines(items []compactItem, width int) []string {
+	refWidth := 0
+	for _, item := range items {
+		refWidth = max(refWidth, output.TextWidth(item.Ref))
+	}
+	// Glyph and space, then identifier and space
+	lead := 2
+	if refWidth > 0 {
+		lead += refWidth + 1
+	}
+	titleWidth := width - lead - 1 - compactInitialsWidth
+	showInitials := titleWidth >= compactMinTitle
+	if !showInitials {
+		titleWidth = width - lead
+	}
+
+	refColor := color.New(color.FgCyan)
+	initialsColor := color.New(color.FgWhite, color.Faint)
+	lines := make([]string, len(items))
+	for i, item := range items {
+		icon := item.Icon
+		if item.IconColor != nil {
+			icon = item.IconColor.Sprint(icon)
+		}
+		if titleWidth < 1 {
+			// No room for a title: as much of the identifier as fits
+			lines[i] = icon + " " + refColor.Sprint(output.TruncateWidth(item.Ref, width-2))
+			continue
+		}
+		line := icon + " "
+		if refWidth > 0 {
+			line += refColor.Sprint(output.PadWidth(item.Ref, refWidth)) + " "
+		}
+		title := output.TruncateWidth(singleLine(item.Title), titleWidth)
+		if owner := initials(item.Owner); showInitials && owner != "" {
+			pad := strings.Repeat(" ", compactInitialsWidth-output.TextWidth(owner))
+			line += output.PadWidth(title, titleWidth) + " " + pad + initialsColor.Sprint(owner)
+		} else {
+			line += title
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+// singleLine turns line breaks, tabs, and other control characters into
+// spaces so a title takes one line
+func singleLine(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return ' '
+		}
+		return r
+	}, s)
+}
+
+// initials returns up to two uppercase initials of a name, the first
+// letters of its first and last words ("Ada Lovelace" is "AL"), or one
+// when two wide ones wouldn't fit the column
+func initials(name string) string {
+	var firsts []rune
+	for _, word := range strings.Fields(name) {
+		for _, r := range word {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				firsts = append(firsts, unicode.ToUpper(r))
+				break
+			}
+		}
+	}
+	switch len(firsts) {
+	case 0:
+		return ""
+	case 1:
+		return string(firsts[0])
+	}
+	pair := string([]rune{firsts[0], firsts[len(firsts)-1]})
+	if output.TextWidth(pair) > compactInitialsWidth {
+		return string(firsts[0])
+	}
+	return pair
+}
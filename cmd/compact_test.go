@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// compactIssues covers what makes a compact line hard: long and wide
+// titles, emoji at the cut, line breaks, and missing or odd assignees
+func compactIssues() []api.Issue {
+	state := func(name, typ string) *api.State { return &api.State{Name: name, Type: typ} }
+	user := func(name string) *api.User { return &api.User{Name: name} }
+	return []api.Issue{
+		{Identifier: "ENG-7", Title: "Checkout returns 500 for carts with more than 100 items", State: state("In Progress", "started"), Assignee: user("Ada Lovelace")},
+		{Identifier: "ENG-1204", Title: "Pager storm 🔥🔥 from the 👩‍💻 on-call rotation", State: state("Todo", "unstarted"), Assignee: user("grace")},
+		{Identifier: "OPS-31", Title: "データベースのフェイルオーバーが遅い", State: state("Triage", "triage")},
+		{Identifier: "ENG-88", Title: "Rotate keys\nbefore Friday", State: state("Done", "completed"), Assignee: user("Linus B. Torvalds")},
+		{Identifier: "ENG-90", Title: "Flaky deploy", State: state("Canceled", "canceled"), Assignee: user("李 小龙")},
+	}
+}
+
+func TestCompactGolden(t *testing.T) {
+	prev := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = prev }()
+
+	issues := compactIssues()
+	items := issueCompactItems(issues)
+	for _, width := range []int{24, 40, 50, 60} {
+		t.Run(fmt.Sprint(width), func(t *testing.T) {
+			lines := compactLines(items, width)
+			for _, line := range lines {
+				if w := output.TextWidth(line); w > width {
+					t.Errorf("%q is %d columns, more than %d", line, w, width)
+				}
+			}
+			checkGolden(t, fmt.Sprintf("compact_issues_%d", width), []byte(strings.Join(lines, "\n")+"\n"))
+		})
+	}
+
+	projects := []api.Project{
+		{Name: "Checkout rewrite for the holiday season", State: "started", Lead: &api.User{Name: "Ada Lovelace"}},
+		{Name: "Status page", State: "paused"},
+		{Name: "Q3 cleanup", State: "completed", Lead: &api.User{Name: "Grace Hopper"}},
+	}
+	var projectItems []compactItem
+	for _, p := range projects {
+		projectItems = append(projectItems, projectCompactItem(p))
+	}
+	checkGolden(t, "compact_projects_40", []byte(strings.Join(compactLines(projectItems, 40), "\n")+"\n"))
+}
+
+func TestInitials(t *testing.T) {
+	tests := map[string]string{
+		"Ada Lovelace":      "AL",
+		"Linus B. Torvalds": "LT",
+		"grace":             "G",
+		"(contractor) jo":   "CJ",
+		"李 小龙":              "李",
+		"":                  "",
+	}
+	for name, want := range tests {
+		if got := initials(name); got != want {
+			t.Errorf("initials(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -22,6 +24,7 @@ var cycleCmd = &cobra.Command{
 Examples:
   linear-cli cycle list --team ROB             # List cycles for a team
   linear-cli cycle list --team ROB --active    # Show only the active cycle
+  linear-cli cycle list --team ROB --upcoming  # Cycles that haven't started, with scope
   linear-cli cycle get CYCLE-ID                # Get cycle details with issues`,
 }
 
@@ -29,7 +32,21 @@ var cycleListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List cycles",
-	Long:    `List cycles, optionally filtered by team.`,
+	Long: `List cycles, optionally filtered by team, newest start date first.
+
+Each cycle shows how full it is: completed vs total estimate points, or issue
+counts when the team doesn't use estimates. --active, --upcoming, and --past
+pick cycles by their start and end dates relative to now. --number N (with
+--team) finds one cycle by its number.
+
+The API can't order cycles by start date, so only the fetched cycles are
+sorted; when more match, stderr says so.
+
+Examples:
+  linear-cli cycle list --team ROB --upcoming
+  linear-cli cycle list --team ROB --past --limit 5
+  linear-cli cycle list --team ROB --number 42 --json
+  linear-cli cycle list --team ROB --asc       # Oldest start date first`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -37,34 +54,66 @@ var cycleListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		limit, _ := cmd.Flags().GetInt("limit")
 		teamKey, _ := cmd.Flags().GetString("team")
 		activeOnly, _ := cmd.Flags().GetBool("active")
+		upcoming, _ := cmd.Flags().GetBool("upcoming")
+		past, _ := cmd.Flags().GetBool("past")
+		asc, _ := cmd.Flags().GetBool("asc")
 
 		filter := map[string]interface{}{}
 		if teamKey != "" {
-			filter["team"] = map[string]interface{}{
-				"key": map[string]interface{}{"eq": teamKey},
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
+			teamKey = team.Key
+			filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}
 		}
-		if activeOnly {
-			now := time.Now().Format(time.RFC3339)
+		now := time.Now().Format(time.RFC3339)
+		switch {
+		case activeOnly:
 			filter["startsAt"] = map[string]interface{}{"lte": now}
 			filter["endsAt"] = map[string]interface{}{"gte": now}
+		case upcoming:
+			filter["startsAt"] = map[string]interface{}{"gt": now}
+		case past:
+			filter["endsAt"] = map[string]interface{}{"lt": now}
+		}
+		number := -1
+		if cmd.Flags().Changed("number") {
+			number, _ = cmd.Flags().GetInt("number")
+			if teamKey == "" {
+				output.Error("--number needs --team: cycle numbers are only unique within a team", plaintext, jsonOut)
+				exit(1)
+			}
+			filter["number"] = map[string]interface{}{"eq": number}
 		}
 
 		cycles, err := client.GetCycles(context.Background(), filter, limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list cycles: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		warnIfTruncated(cmd, cycles.PageInfo, len(cycles.Nodes))
+		if number >= 0 && len(cycles.Nodes) == 0 {
+			output.Error(fmt.Sprintf("Team %s has no cycle %d", teamKey, number), plaintext, jsonOut)
+			exit(1)
 		}
+		sortCyclesByStart(cycles.Nodes, asc)
+		warnSortedCycleWindow(cycles.PageInfo, len(cycles.Nodes))
 
 		if jsonOut {
-			output.JSON(cycles.Nodes)
+			rows := make([]cycleListRow, len(cycles.Nodes))
+			for i := range cycles.Nodes {
+				rows[i] = cycleListRow{&cycles.Nodes[i], cycleScopeOf(cycles.Nodes[i])}
+			}
+			output.JSON(rows)
 			return
 		}
 
@@ -79,20 +128,22 @@ var cycleListCmd = &cobra.Command{
 
 		if plaintext {
 			fmt.Println("# Cycles")
-			fmt.Println("Number\tName\tTeam\tStarts\tEnds\tProgress\tStatus")
+			fmt.Println("Number\tName\tTeam\tStarts\tEnds\tProgress\tStatus\tCompleted\tScope\tUnit")
 			for _, c := range cycles.Nodes {
 				teamName := ""
 				if c.Team != nil {
 					teamName = c.Team.Key
 				}
 				status := getCycleStatus(c)
-				fmt.Printf("%d\t%s\t%s\t%s\t%s\t%.0f%%\t%s\n",
+				scope := cycleScopeOf(c)
+				fmt.Printf("%d\t%s\t%s\t%s\t%s\t%.0f%%\t%s\t%s\t%s\t%s\n",
 					c.Number, c.Name, teamName,
 					formatDateShort(c.StartsAt), formatDateShort(c.EndsAt),
-					c.Progress*100, status)
+					c.Progress*100, status,
+					formatPoints(scope.Completed), formatPoints(scope.Total), scope.Unit)
 			}
 		} else {
-			headers := []string{"#", "Name", "Team", "Starts", "Ends", "Progress", "Status"}
+			headers := []string{"#", "Name", "Team", "Starts", "Ends", "Scope", "Progress", "Status"}
 			rows := [][]string{}
 
 			for _, c := range cycles.Nodes {
@@ -129,6 +180,7 @@ var cycleListCmd = &cobra.Command{
 					teamName,
 					formatDateShort(c.StartsAt),
 					formatDateShort(c.EndsAt),
+					cycleScopeOf(c).String(),
 					progressStr,
 					status,
 				})
@@ -156,7 +208,7 @@ var cycleGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -164,7 +216,7 @@ var cycleGetCmd = &cobra.Command{
 		cycle, err := client.GetCycle(context.Background(), cycleID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get cycle: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -183,12 +235,12 @@ var cycleGetCmd = &cobra.Command{
 			fmt.Printf("Ends: %s\n", formatDateShort(cycle.EndsAt))
 			fmt.Printf("Progress: %.0f%%\n", cycle.Progress*100)
 			if cycle.CompletedAt != nil {
-				fmt.Printf("Completed: %s\n", cycle.CompletedAt.Format("2006-01-02"))
+				fmt.Printf("Completed: %s\n", output.FormatTime(*cycle.CompletedAt, output.DateOnly))
 			}
-			fmt.Printf("Created: %s\n", cycle.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("Updated: %s\n", cycle.UpdatedAt.Format("2006-01-02"))
+			fmt.Printf("Created: %s\n", output.FormatTime(cycle.CreatedAt, output.DateOnly))
+			fmt.Printf("Updated: %s\n", output.FormatTime(cycle.UpdatedAt, output.DateOnly))
 			if cycle.ArchivedAt != nil {
-				fmt.Printf("Archived: %s\n", cycle.ArchivedAt.Format("2006-01-02"))
+				fmt.Printf("Archived: %s\n", output.FormatTime(*cycle.ArchivedAt, output.DateOnly))
 			}
 			if len(cycle.ScopeHistory) > 0 {
 				fmt.Printf("Scope History: %v\n", cycle.ScopeHistory)
@@ -232,13 +284,13 @@ var cycleGetCmd = &cobra.Command{
 			fmt.Printf("   Progress: %s\n",
 				color.New(color.FgGreen).Sprintf("%.0f%%", cycle.Progress*100))
 			if cycle.CompletedAt != nil {
-				fmt.Printf("   Completed: %s\n", cycle.CompletedAt.Format("2006-01-02"))
+				fmt.Printf("   Completed: %s\n", output.FormatTime(*cycle.CompletedAt, output.DateOnly))
 			}
 			fmt.Printf("   Created: %s | Updated: %s\n",
-				cycle.CreatedAt.Format("2006-01-02"),
-				cycle.UpdatedAt.Format("2006-01-02"))
+				output.FormatTime(cycle.CreatedAt, output.DateOnly),
+				output.FormatTime(cycle.UpdatedAt, output.DateOnly))
 			if cycle.ArchivedAt != nil {
-				fmt.Printf("   Archived: %s\n", cycle.ArchivedAt.Format("2006-01-02"))
+				fmt.Printf("   Archived: %s\n", output.FormatTime(*cycle.ArchivedAt, output.DateOnly))
 			}
 
 			if cycle.Issues != nil && len(cycle.Issues.Nodes) > 0 {
@@ -270,17 +322,75 @@ var cycleGetCmd = &cobra.Command{
 	},
 }
 
-// formatDateShort parses an RFC3339 date and returns YYYY-MM-DD
+// formatDateShort parses an RFC3339 date and returns YYYY-MM-DD in the display timezone
 func formatDateShort(dateStr string) string {
 	t, err := time.Parse(time.RFC3339, dateStr)
 	if err != nil {
-		// Try date-only format
-		t, err = time.Parse("2006-01-02", dateStr)
-		if err != nil {
-			return dateStr
+		// Date-only values are calendar dates and must not be shifted by
+		// timezone; anything else unparseable is shown verbatim
+		return dateStr
+	}
+	return output.FormatTime(t, output.DateOnly)
+}
+
+// cycleScope is how full a cycle is: estimate points when the team uses
+// estimates, issue counts otherwise
+type cycleScope struct {
+	Completed float64 `json:"completed"`
+	Total     float64 `json:"total"`
+	Unit      string  `json:"unit"` // "points" or "issues"
+}
+
+// cycleListRow is a cycle as cycle list --json prints it
+type cycleListRow struct {
+	*api.Cycle
+	Scope cycleScope `json:"scope"`
+}
+
+// cycleScopeOf reads the latest entry of the cycle's scope histories. Teams
+// without estimates, and cycles whose issues are all unestimated, are
+// measured in issues.
+func cycleScopeOf(c api.Cycle) cycleScope {
+	last := func(history []float64) float64 {
+		if len(history) == 0 {
+			return 0
 		}
+		return history[len(history)-1]
+	}
+	estimated := c.Team == nil || c.Team.IssueEstimationType != "notUsed"
+	if total := last(c.ScopeHistory); estimated && total > 0 {
+		return cycleScope{Completed: last(c.CompletedScopeHistory), Total: total, Unit: "points"}
 	}
-	return t.Format("2006-01-02")
+	return cycleScope{Completed: last(c.CompletedIssueCountHistory), Total: last(c.IssueCountHistory), Unit: "issues"}
+}
+
+// String formats the scope as "8/20 pts" or "3/7 issues"
+func (s cycleScope) String() string {
+	unit := s.Unit
+	if unit == "points" {
+		unit = "pts"
+	}
+	return fmt.Sprintf("%s/%s %s", formatPoints(s.Completed), formatPoints(s.Total), unit)
+}
+
+// sortCyclesByStart orders cycles by start date, newest first unless asc
+func sortCyclesByStart(cycles []api.Cycle, asc bool) {
+	sort.SliceStable(cycles, func(i, j int) bool {
+		if asc {
+			return cycles[i].StartsAt < cycles[j].StartsAt
+		}
+		return cycles[i].StartsAt > cycles[j].StartsAt
+	})
+}
+
+// warnSortedCycleWindow notes on stderr that start-date order only covers
+// the fetched cycles when more matched: the API can only order cycles by
+// creation or update time, so the newest-starting cycle may be unfetched
+func warnSortedCycleWindow(pageInfo api.PageInfo, shown int) {
+	if !pageInfo.HasNextPage {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Note: sorted the %d fetched cycles by start date; more match, raise --limit or narrow with --team, --active, --upcoming, or --past to sort every one\n", shown)
 }
 
 // getCycleStatus returns a human-readable status for a cycle
@@ -313,19 +423,23 @@ Examples:
   linear-cli cycle create --team TEAM-ID --name "Sprint 1" --starts 2026-02-10 --ends 2026-02-24`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
 		teamID, _ := cmd.Flags().GetString("team-id")
 		name, _ := cmd.Flags().GetString("name")
-		description, _ := cmd.Flags().GetString("description")
+		description, _, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 		starts, _ := cmd.Flags().GetString("starts")
 		ends, _ := cmd.Flags().GetString("ends")
 		completedAt, _ := cmd.Flags().GetString("completed-at")
@@ -348,9 +462,17 @@ Examples:
 		cycle, err := client.CreateCycle(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create cycle: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "cycle", cycle.ID, cycle.Name, &journal.Inverse{Action: "archive"})
 
+		cycleName := cycle.Name
+		if cycleName == "" {
+			cycleName = fmt.Sprintf("Cycle %d", cycle.Number)
+		}
+		if printCreated(cmd, createdEntity{Type: "cycle", ID: cycle.ID, Name: cycleName}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(cycle)
 		} else {
@@ -378,7 +500,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -387,8 +509,12 @@ Examples:
 			n, _ := cmd.Flags().GetString("name")
 			input["name"] = n
 		}
-		if cmd.Flags().Changed("description") {
-			d, _ := cmd.Flags().GetString("description")
+		d, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input["description"] = d
 		}
 		if cmd.Flags().Changed("starts") {
@@ -409,14 +535,15 @@ Examples:
 		}
 		if len(input) == 0 {
 			output.Error("No fields to update. Use --name, --description, --starts, --ends, or --completed-at.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		cycle, err := client.UpdateCycle(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update cycle: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "cycle", cycle.ID, cycle.Name, nil)
 
 		if jsonOut {
 			output.JSON(cycle)
@@ -428,31 +555,281 @@ Examples:
 
 var cycleArchiveCmd = &cobra.Command{
 	Use:     "archive CYCLE-ID",
-	Aliases: []string{"delete", "rm"},
+	Aliases: []string{"rm"},
 	Short:   "Archive a cycle",
-	Long:    `Archive a cycle. Archived cycles are hidden from the default list.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Archive a cycle. Archived cycles are hidden from the default list.
+
+A cycle that still has open (not completed or canceled) issues is not
+archived unless --force is given or you confirm at the prompt; the issues
+that would be stranded are listed. --move-open-to moves them first, either
+to the team's next cycle or out of any cycle (backlog).
+
+Examples:
+  linear-cli cycle archive CYCLE-ID
+  linear-cli cycle archive CYCLE-ID --move-open-to next
+  linear-cli cycle archive CYCLE-ID --move-open-to backlog
+  linear-cli cycle archive CYCLE-ID --force`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		force, _ := cmd.Flags().GetBool("force")
+		moveTo, _ := cmd.Flags().GetString("move-open-to")
+
+		if moveTo != "" && moveTo != "next" && moveTo != "backlog" {
+			output.Error(fmt.Sprintf("Invalid --move-open-to value '%s'. Must be one of: next, backlog", moveTo), plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
-		err = client.ArchiveCycle(context.Background(), args[0])
+
+		cycle, err := client.GetCycle(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		open, err := fetchCycleIssues(client, cycle.ID, openIssueFilter())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle issues: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		var moves []cycleMoveResult
+		if len(open) > 0 {
+			switch {
+			case moveTo != "":
+				moves = moveCycleIssues(cmd, client, cycle, open, moveTo, plaintext, jsonOut)
+				for _, m := range moves {
+					if m.Error != "" {
+						if jsonOut {
+							output.JSON(map[string]interface{}{
+								"error": "Not archiving cycle: some open issues could not be moved",
+								"moves": moves,
+							})
+						} else {
+							output.Error("Not archiving cycle: some open issues could not be moved", plaintext, jsonOut)
+						}
+						exit(1)
+					}
+				}
+			case force:
+			case !jsonOut && stdinIsTerminal():
+				printStrandedIssues(open)
+				if !confirmPrompt("Archive anyway?") {
+					fmt.Fprintln(os.Stderr, "Aborted.")
+					exit(1)
+				}
+			default:
+				msg := fmt.Sprintf("Cycle has %d open issue(s); use --force or --move-open-to next|backlog", len(open))
+				if jsonOut {
+					output.JSON(map[string]interface{}{
+						"error":      msg,
+						"openIssues": cycleIssueRefs(open),
+					})
+				} else {
+					printStrandedIssues(open)
+					output.Error(msg, plaintext, jsonOut)
+				}
+				exit(1)
+			}
+		}
+
+		err = client.ArchiveCycle(context.Background(), cycle.ID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to archive cycle: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "cycle", cycle.ID, "", nil)
 
+		if jsonOut && moves != nil {
+			output.JSON(map[string]interface{}{"success": true, "id": cycle.ID, "action": "archived", "moves": moves})
+			return
+		}
 		output.Success("Archived cycle", plaintext, jsonOut)
 	},
 }
 
+var cycleDeleteCmd = &cobra.Command{
+	Use:   "delete CYCLE-ID",
+	Short: "Permanently delete an empty cycle",
+	Long: `Permanently delete a cycle. Only cycles with no issues can be deleted;
+use 'cycle archive' for cycles that have issues.
+
+Examples:
+  linear-cli cycle delete CYCLE-ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		cycle, err := client.GetCycle(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		issues, err := fetchCycleIssues(client, cycle.ID, nil)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get cycle issues: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if len(issues) > 0 {
+			output.Error(fmt.Sprintf("Cycle has %d issue(s) and cannot be deleted; use 'cycle archive' instead", len(issues)), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if err := client.DeleteCycle(context.Background(), cycle.ID); err != nil {
+			output.Error(fmt.Sprintf("Failed to delete cycle: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "cycle", cycle.ID, "", nil)
+
+		output.Success("Deleted cycle", plaintext, jsonOut)
+	},
+}
+
+// cycleMoveResult reports where one open issue went during cycle archive
+type cycleMoveResult struct {
+	Identifier string `json:"identifier"`
+	To         string `json:"to"`
+	Error      string `json:"error,omitempty"`
+}
+
+// openIssueFilter matches issues that are not completed or canceled
+func openIssueFilter() map[string]interface{} {
+	return map[string]interface{}{
+		"state": map[string]interface{}{
+			"type": map[string]interface{}{"nin": []string{"completed", "canceled"}},
+		},
+	}
+}
+
+// fetchCycleIssues pages through a cycle's issues matching filter
+func fetchCycleIssues(client *api.Client, cycleID string, filter map[string]interface{}) ([]api.Issue, error) {
+	var all []api.Issue
+	after := ""
+	for {
+		page, err := client.GetCycleIssues(context.Background(), cycleID, filter, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// cycleIssueRefs reduces issues to identifier/title/state for JSON errors
+func cycleIssueRefs(issues []api.Issue) []map[string]string {
+	refs := make([]map[string]string, 0, len(issues))
+	for _, issue := range issues {
+		refs = append(refs, map[string]string{
+			"identifier": issue.Identifier,
+			"title":      issue.Title,
+			"state":      issueStateName(&issue),
+		})
+	}
+	return refs
+}
+
+// printStrandedIssues lists the open issues an archive would leave behind
+func printStrandedIssues(issues []api.Issue) {
+	fmt.Fprintf(os.Stderr, "%d open issue(s) would be stranded in the archived cycle:\n", len(issues))
+	for _, issue := range issues {
+		fmt.Fprintf(os.Stderr, "  %s (%s) %s\n", issue.Identifier, issueStateName(&issue), issue.Title)
+	}
+}
+
+// moveCycleIssues moves open issues to the team's next cycle ("next") or out
+// of any cycle ("backlog"), reporting each move as it happens
+func moveCycleIssues(cmd *cobra.Command, client *api.Client, cycle *api.Cycle, issues []api.Issue, moveTo string, plaintext, jsonOut bool) []cycleMoveResult {
+	var targetID interface{}
+	target := "no cycle"
+	if moveTo == "next" {
+		next, err := findNextCycle(client, cycle)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		targetID = next.ID
+		target = fmt.Sprintf("cycle %d", next.Number)
+		if next.Name != "" {
+			target = fmt.Sprintf("cycle %d (%s)", next.Number, next.Name)
+		}
+	}
+
+	results := make([]cycleMoveResult, 0, len(issues))
+	progress := newProgress("Moving open issues", len(issues))
+	defer progress.Finish()
+	for _, issue := range issues {
+		result := cycleMoveResult{Identifier: issue.Identifier, To: target}
+		_, err := client.UpdateIssue(context.Background(), issue.ID, map[string]interface{}{"cycleId": targetID})
+		if err != nil {
+			result.Error = err.Error()
+		} else {
+			recordOperation(cmd, "issue", issue.ID, issue.Identifier,
+				&journal.Inverse{Action: "update", Input: map[string]interface{}{"cycleId": cycle.ID}})
+		}
+		results = append(results, result)
+
+		progress.Clear()
+		if jsonOut {
+			progress.Step(result.Identifier)
+			continue
+		}
+		if plaintext {
+			if result.Error != "" {
+				fmt.Printf("%s\tfailed: %s\n", result.Identifier, result.Error)
+			} else {
+				fmt.Printf("%s\tmoved to %s\n", result.Identifier, result.To)
+			}
+		} else if result.Error != "" {
+			fmt.Printf("%s %s: %s\n", color.New(color.FgRed).Sprint("✗"), result.Identifier, result.Error)
+		} else {
+			fmt.Printf("%s %s moved to %s\n", color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan).Sprint(result.Identifier), result.To)
+		}
+		progress.Step(result.Identifier)
+	}
+	return results
+}
+
+// findNextCycle returns the team's upcoming cycle after the given one
+func findNextCycle(client *api.Client, cycle *api.Cycle) (*api.Cycle, error) {
+	if cycle.Team == nil {
+		return nil, fmt.Errorf("cycle %d has no team", cycle.Number)
+	}
+	filter := map[string]interface{}{
+		"team":   map[string]interface{}{"id": map[string]interface{}{"eq": cycle.Team.ID}},
+		"isNext": map[string]interface{}{"eq": true},
+	}
+	cycles, err := client.GetCycles(context.Background(), filter, 1, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the next cycle for team %s: %v", cycle.Team.Key, err)
+	}
+	if len(cycles.Nodes) == 0 || cycles.Nodes[0].ID == cycle.ID {
+		return nil, fmt.Errorf("team %s has no upcoming cycle; create one or use --move-open-to backlog", cycle.Team.Key)
+	}
+	return &cycles.Nodes[0], nil
+}
+
 func init() {
 	rootCmd.AddCommand(cycleCmd)
 	cycleCmd.AddCommand(cycleListCmd)
@@ -460,26 +837,39 @@ func init() {
 	cycleCmd.AddCommand(cycleCreateCmd)
 	cycleCmd.AddCommand(cycleUpdateCmd)
 	cycleCmd.AddCommand(cycleArchiveCmd)
+	cycleCmd.AddCommand(cycleDeleteCmd)
+
+	// Archive flags
+	cycleArchiveCmd.Flags().Bool("force", false, "Archive even if the cycle still has open issues")
+	cycleArchiveCmd.Flags().String("move-open-to", "", "Move open issues before archiving: next (team's next cycle) or backlog (no cycle)")
 
 	// Update flags
 	cycleUpdateCmd.Flags().String("name", "", "New cycle name")
 	cycleUpdateCmd.Flags().String("description", "", "New description")
+	cycleUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	cycleUpdateCmd.Flags().String("starts", "", "New start date YYYY-MM-DD")
 	cycleUpdateCmd.Flags().String("ends", "", "New end date YYYY-MM-DD")
 	cycleUpdateCmd.Flags().String("completed-at", "", "Completion date YYYY-MM-DD (or 'none' to clear)")
 
 	// List flags
 	cycleListCmd.Flags().IntP("limit", "l", 25, "Maximum number of cycles to return")
-	cycleListCmd.Flags().StringP("team", "t", "", "Filter by team key (e.g., ROB)")
+	cycleListCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID (e.g., ROB)")
 	cycleListCmd.Flags().Bool("active", false, "Show only the active cycle")
+	cycleListCmd.Flags().Bool("upcoming", false, "Show only cycles that haven't started yet")
+	cycleListCmd.Flags().Bool("past", false, "Show only cycles that have ended")
+	cycleListCmd.Flags().Int("number", 0, "Find the cycle with this number (requires --team)")
+	cycleListCmd.Flags().Bool("asc", false, "Oldest start date first")
+	cycleListCmd.MarkFlagsMutuallyExclusive("active", "upcoming", "past")
 
 	// Create flags
 	cycleCreateCmd.Flags().String("team-id", "", "Team ID (required)")
 	cycleCreateCmd.Flags().String("name", "", "Cycle name")
 	cycleCreateCmd.Flags().StringP("description", "d", "", "Cycle description")
+	cycleCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	cycleCreateCmd.Flags().String("starts", "", "Start date YYYY-MM-DD (required)")
 	cycleCreateCmd.Flags().String("ends", "", "End date YYYY-MM-DD (required)")
 	cycleCreateCmd.Flags().String("completed-at", "", "Completion date YYYY-MM-DD (for completed cycles)")
+	addPorcelainFlag(cycleCreateCmd)
 	_ = cycleCreateCmd.MarkFlagRequired("team-id")
 	_ = cycleCreateCmd.MarkFlagRequired("starts")
 	_ = cycleCreateCmd.MarkFlagRequired("ends")
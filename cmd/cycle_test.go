@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+const cycleListFixture = `{"cycles":{"nodes":[
+	{"id":"c41","number":41,"name":"Sprint 41","startsAt":"2026-02-02T00:00:00Z","endsAt":"2026-02-16T00:00:00Z","progress":1,"isPast":true,
+	 "scopeHistory":[10,12],"completedScopeHistory":[0,12],"issueCountHistory":[4,5],"completedIssueCountHistory":[0,5],
+	 "team":{"id":"t1","key":"ENG","name":"Engineering","issueEstimationType":"fibonacci"}},
+	{"id":"c43","number":43,"name":"Sprint 43","startsAt":"2026-03-02T00:00:00Z","endsAt":"2026-03-16T00:00:00Z","progress":0,"isFuture":true,
+	 "scopeHistory":[0],"completedScopeHistory":[0],"issueCountHistory":[3],"completedIssueCountHistory":[0],
+	 "team":{"id":"t1","key":"ENG","name":"Engineering","issueEstimationType":"fibonacci"}},
+	{"id":"c42","number":42,"name":"Sprint 42","startsAt":"2026-02-16T00:00:00Z","endsAt":"2026-03-02T00:00:00Z","progress":0.4,"isActive":true,
+	 "scopeHistory":[18,20],"completedScopeHistory":[2,8],"issueCountHistory":[6,7],"completedIssueCountHistory":[1,3],
+	 "team":{"id":"t1","key":"ENG","name":"Engineering","issueEstimationType":"fibonacci"}}
+],"pageInfo":{"hasNextPage":false}}}`
+
+func TestHermeticCycleList(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Cycles", cycleListFixture)
+
+	r := runMocked(t, "cycle", "list", "--team", "ENG", "--plaintext")
+	lines := strings.Split(strings.TrimSpace(r.Stdout), "\n")
+	if r.Exit != 0 || len(lines) != 5 {
+		t.Fatalf("cycle list exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	// Newest start first; unestimated cycles fall back to issue counts
+	for i, want := range []string{
+		"Number\tName\tTeam\tStarts\tEnds\tProgress\tStatus\tCompleted\tScope\tUnit",
+		"43\tSprint 43\tENG\t2026-03-02\t2026-03-16\t0%\tupcoming\t0\t3\tissues",
+		"42\tSprint 42\tENG\t2026-02-16\t2026-03-02\t40%\tactive\t8\t20\tpoints",
+		"41\tSprint 41\tENG\t2026-02-02\t2026-02-16\t100%\tpast\t12\t12\tpoints",
+	} {
+		if lines[i+1] != want {
+			t.Errorf("line %d = %q, want %q", i+1, lines[i+1], want)
+		}
+	}
+
+	r = runMocked(t, "cycle", "list", "--team", "ENG", "--asc", "--json")
+	var rows []map[string]interface{}
+	if err := json.Unmarshal([]byte(r.Stdout), &rows); err != nil || len(rows) != 3 {
+		t.Fatalf("cycle list --json: %v\n%s", err, r.Stdout)
+	}
+	if rows[0]["number"] != float64(41) || rows[2]["number"] != float64(43) {
+		t.Errorf("--asc order: %v, %v, %v", rows[0]["number"], rows[1]["number"], rows[2]["number"])
+	}
+	if scope := rows[1]["scope"].(map[string]interface{}); scope["completed"] != float64(8) || scope["total"] != float64(20) || scope["unit"] != "points" {
+		t.Errorf("scope = %v", scope)
+	}
+	if strings.Contains(r.Stderr, "Note: sorted") {
+		t.Errorf("every cycle was fetched, yet stderr says %q", r.Stderr)
+	}
+
+	// Only the fetched window is in start-date order when more cycles match
+	s.Data("Cycles", strings.Replace(cycleListFixture, `"hasNextPage":false`, `"hasNextPage":true,"endCursor":"c1"`, 1))
+	r = runMocked(t, "cycle", "list", "--team", "ENG", "--limit", "3", "--json")
+	if r.Exit != 0 || !strings.Contains(r.Stderr, "Note: sorted the 3 fetched cycles by start date; more match") {
+		t.Errorf("windowed cycle list exited %d, stderr %q", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticCycleListFilters(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Cycles", cycleListFixture)
+
+	for flag, field := range map[string]string{"--upcoming": "startsAt", "--past": "endsAt"} {
+		s.Reset()
+		if r := runMocked(t, "cycle", "list", flag, "--json"); r.Exit != 0 {
+			t.Fatalf("cycle list %s exited %d: %s", flag, r.Exit, r.Stderr)
+		}
+		filter := s.Requests()[0].Variables["filter"].(map[string]interface{})
+		if len(filter) != 1 || filter[field] == nil {
+			t.Errorf("cycle list %s sent filter %v", flag, filter)
+		}
+	}
+
+	s.Reset()
+	if r := runMocked(t, "cycle", "list", "--team", "ENG", "--number", "42", "--json"); r.Exit != 0 {
+		t.Fatalf("cycle list --number exited %d: %s", r.Exit, r.Stderr)
+	}
+	// --team is resolved first, and cycles are filtered by the team's ID
+	reqs := s.Requests()
+	if len(reqs) != 2 || reqs[0].Operation != "Team" {
+		t.Fatalf("cycle list --team sent %v, want Team then Cycles", s.Operations())
+	}
+	filter := reqs[1].Variables["filter"].(map[string]interface{})
+	if number := filter["number"].(map[string]interface{}); number["eq"] != float64(42) {
+		t.Errorf("--number sent filter %v", filter)
+	}
+	if team := filter["team"].(map[string]interface{}); team["id"] == nil {
+		t.Errorf("--team sent filter %v, want the team's ID", filter)
+	}
+
+	if r := runMocked(t, "cycle", "list", "--number", "42"); r.Exit != 1 || !strings.Contains(r.Stderr, "--number needs --team") {
+		t.Errorf("--number without --team exited %d: %s", r.Exit, r.Stderr)
+	}
+	s.Data("Cycles", `{"cycles":{"nodes":[],"pageInfo":{"hasNextPage":false}}}`)
+	if r := runMocked(t, "cycle", "list", "--team", "ENG", "--number", "99"); r.Exit != 1 || !strings.Contains(r.Stderr, "Team ENG has no cycle 99") {
+		t.Errorf("missing cycle exited %d: %s", r.Exit, r.Stderr)
+	}
+	if r := runMocked(t, "cycle", "list", "--past", "--upcoming"); r.Exit == 0 {
+		t.Errorf("--past with --upcoming succeeded")
+	}
+}
+
+const (
+	archiveCycleFixture = `{"cycle":{"id":"c42","number":42,"name":"Sprint 42","team":{"id":"t1","key":"ENG","name":"Engineering"}}}`
+	openCycleIssues     = `{"cycle":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","title":"Login","state":{"name":"Todo","type":"unstarted"}},
+		{"id":"i2","identifier":"ENG-2","title":"Logout","state":{"name":"In Progress","type":"started"}}
+	],"pageInfo":{"hasNextPage":false}}}}`
+	noCycleIssues = `{"cycle":{"issues":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}`
+)
+
+func TestHermeticCycleArchiveOpenIssueGuard(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Cycle", archiveCycleFixture)
+	s.Data("CycleIssues", openCycleIssues)
+	s.Data("ArchiveCycle", `{"cycleArchive":{"success":true}}`)
+
+	r := runMocked(t, "cycle", "archive", "c42", "--json")
+	var refused struct {
+		Error      string
+		OpenIssues []map[string]string `json:"openIssues"`
+	}
+	if r.Exit != 1 || json.Unmarshal([]byte(r.Stdout), &refused) != nil || len(refused.OpenIssues) != 2 ||
+		!strings.Contains(refused.Error, "2 open issue(s)") || refused.OpenIssues[1]["identifier"] != "ENG-2" {
+		t.Errorf("archive with open issues exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	r = runMocked(t, "cycle", "archive", "c42", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "ENG-1 (Todo) Login") {
+		t.Errorf("archive --plaintext exited %d, want the stranded issues listed:\n%s", r.Exit, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "ArchiveCycle" {
+			t.Fatal("a refused archive still archived the cycle")
+		}
+	}
+
+	r = runMocked(t, "cycle", "archive", "c42", "--force", "--plaintext")
+	if r.Exit != 0 || s.Operations()[len(s.Operations())-1] != "ArchiveCycle" {
+		t.Errorf("archive --force exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	r = runMocked(t, "cycle", "archive", "c42", "--move-open-to", "sideways")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "Must be one of: next, backlog") {
+		t.Errorf("--move-open-to sideways exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticCycleArchiveMoveOpenTo(t *testing.T) {
+	tests := []struct {
+		to      string
+		cycleID interface{}
+		target  string
+	}{
+		{"next", "c43", "cycle 43 (Sprint 43)"},
+		{"backlog", nil, "no cycle"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.to, func(t *testing.T) {
+			s := newMockLinear(t)
+			s.Data("Cycle", archiveCycleFixture)
+			s.Data("CycleIssues", openCycleIssues)
+			s.Data("Cycles", `{"cycles":{"nodes":[{"id":"c43","number":43,"name":"Sprint 43"}],"pageInfo":{"hasNextPage":false}}}`)
+			s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1"}}}`)
+			s.Data("ArchiveCycle", `{"cycleArchive":{"success":true}}`)
+
+			r := runMocked(t, "cycle", "archive", "c42", "--move-open-to", tt.to, "--plaintext")
+			if r.Exit != 0 || !strings.Contains(r.Stdout, "ENG-1\tmoved to "+tt.target+"\nENG-2\tmoved to "+tt.target+"\n") {
+				t.Fatalf("--move-open-to %s exited %d:\n%s%s", tt.to, r.Exit, r.Stdout, r.Stderr)
+			}
+			moved := 0
+			for _, req := range s.Requests() {
+				if req.Operation != "UpdateIssue" {
+					continue
+				}
+				moved++
+				input, _ := req.Variables["input"].(map[string]interface{})
+				if cycleID, ok := input["cycleId"]; !ok || cycleID != tt.cycleID {
+					t.Errorf("moved %v with cycleId %v, want %v", req.Variables["id"], input["cycleId"], tt.cycleID)
+				}
+			}
+			if ops := s.Operations(); moved != 2 || ops[len(ops)-1] != "ArchiveCycle" {
+				t.Errorf("operations = %v, want both moves, then the archive", ops)
+			}
+		})
+	}
+}
+
+func TestHermeticCycleArchiveFailedMove(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Cycle", archiveCycleFixture)
+	s.Data("CycleIssues", openCycleIssues)
+	s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1"}}}`)
+	s.Add(linearmock.Stub{Operation: "UpdateIssue", Variables: map[string]interface{}{"id": "i2"},
+		Response: json.RawMessage(`{"errors":[{"message":"Issue is locked"}]}`)})
+
+	r := runMocked(t, "cycle", "archive", "c42", "--move-open-to", "backlog", "--json")
+	var resp struct {
+		Error string
+		Moves []cycleMoveResult
+	}
+	if r.Exit != 1 || json.Unmarshal([]byte(r.Stdout), &resp) != nil || len(resp.Moves) != 2 {
+		t.Fatalf("a failed move exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if resp.Moves[0].Error != "" || !strings.Contains(resp.Moves[1].Error, "Issue is locked") || !strings.Contains(resp.Error, "could not be moved") {
+		t.Errorf("moves = %+v, error %q", resp.Moves, resp.Error)
+	}
+	for _, op := range s.Operations() {
+		if op == "ArchiveCycle" {
+			t.Error("archived the cycle although an issue could not be moved")
+		}
+	}
+}
+
+func TestHermeticCycleArchiveNoNextCycle(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Cycle", archiveCycleFixture)
+	s.Data("CycleIssues", openCycleIssues)
+	s.Data("Cycles", `{"cycles":{"nodes":[],"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "cycle", "archive", "c42", "--move-open-to", "next")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "team ENG has no upcoming cycle") {
+		t.Errorf("--move-open-to next without a next cycle exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticCycleDelete(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Cycle", archiveCycleFixture)
+	s.Data("CycleIssues", openCycleIssues)
+	s.Data("DeleteCycle", `{"cycleDelete":{"success":true}}`)
+
+	r := runMocked(t, "cycle", "delete", "c42")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "Cycle has 2 issue(s) and cannot be deleted; use 'cycle archive' instead") {
+		t.Errorf("delete with issues exited %d: %s", r.Exit, r.Stderr)
+	}
+	// Every issue counts, not only open ones
+	for _, req := range s.Requests() {
+		if req.Operation == "DeleteCycle" {
+			t.Fatal("deleted a cycle that has issues")
+		}
+		if req.Operation == "CycleIssues" && req.Variables["filter"] != nil {
+			t.Errorf("delete checked issues with filter %v, want all of them", req.Variables["filter"])
+		}
+	}
+
+	s.Data("CycleIssues", noCycleIssues)
+	r = runMocked(t, "cycle", "delete", "c42", "--plaintext")
+	if ops := s.Operations(); r.Exit != 0 || ops[len(ops)-1] != "DeleteCycle" {
+		t.Errorf("delete of an empty cycle exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
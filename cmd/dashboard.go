@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// minWatchInterval keeps a forgotten dashboard from eating the rate limit
+const minWatchInterval = 10 * time.Second
+
+// resizeCheckInterval is how often --watch looks for a resized terminal
+// between polls
+const resizeCheckInterval = time.Second
+
+// ANSI sequences a dashboard repaints with. Each frame moves the cursor home
+// and overwrites the last one line by line, clearing what's left of each
+// line and below the frame, so the screen is never blank in between.
+const (
+	ansiClearScreen = "\x1b[2J"
+	ansiHome        = "\x1b[H"
+	ansiClearLine   = "\x1b[K"
+	ansiClearBelow  = "\x1b[J"
+	ansiHideCursor  = "\x1b[?25l"
+	ansiShowCursor  = "\x1b[?25h"
+)
+
+// terminalSize measures the terminal a dashboard draws on. Tests swap it.
+var terminalSize = output.TerminalSize
+
+// listDashboard is how a list shows with --compact and --watch
+type listDashboard struct {
+	Compact  bool
+	Watch    bool
+	Interval time.Duration
+
+	Title string // what the --watch header calls the list, e.g. a view's name
+	Noun  string // what the items are, e.g. "issues"
+}
+
+// addDashboardFlags registers --compact, --watch, and --interval
+func addDashboardFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("compact", false, "One line per item (state, identifier, title, assignee initials) sized to the terminal, with no headers")
+	cmd.Flags().BoolP("watch", "w", false, "Repaint the list in place every --interval seconds until Ctrl-C")
+	cmd.Flags().Int("interval", 30, "Seconds between refreshes with --watch (at least 10)")
+}
+
+// dashboardFromFlags reads --compact, --watch, and --interval. Neither mode
+// combines with --json or the flags that print something other than a
+// list.
+func dashboardFromFlags(cmd *cobra.Command) (listDashboard, error) {
+	d := listDashboard{}
+	d.Compact, _ = cmd.Flags().GetBool("compact")
+	d.Watch, _ = cmd.Flags().GetBool("watch")
+	interval, _ := cmd.Flags().GetInt("interval")
+	d.Interval = time.Duration(interval) * time.Second
+
+	if cmd.Flags().Changed("interval") && !d.Watch {
+		return d, fmt.Errorf("--interval needs --watch")
+	}
+	if !d.enabled() {
+		return d, nil
+	}
+	mode := "--compact"
+	if d.Watch {
+		mode = "--watch"
+	}
+	if d.Watch && d.Interval < minWatchInterval {
+		return d, fmt.Errorf("--interval must be at least %d seconds", int(minWatchInterval.Seconds()))
+	}
+	if viper.GetBool("json") {
+		return d, fmt.Errorf("%s can't be combined with --json", mode)
+	}
+	for _, name := range []string{"ids-only", "count", "sample", "shuffle", "group-by", "save-snapshot"} {
+		if cmd.Flags().Lookup(name) != nil && cmd.Flags().Changed(name) {
+			return d, fmt.Errorf("%s can't be combined with --%s", mode, name)
+		}
+	}
+	return d, nil
+}
+
+// enabled reports whether the list shows as a dashboard instead of the
+// usual table
+func (d listDashboard) enabled() bool {
+	return d.Compact || d.Watch
+}
+
+// show prints fetch's items once in --compact lines, or with --watch
+// repaints them every interval until Ctrl-C. A failed first fetch is fatal;
+// later failures are shown above the last good list until a fetch works.
+func (d listDashboard) show(fetch func(ctx context.Context) ([]compactItem, error), plaintext bool) {
+	if plaintext {
+		color.NoColor = true
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	items, err := fetch(ctx)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch %s: %v", d.Noun, err), plaintext, false)
+		exit(1)
+	}
+	if !d.Watch {
+		width, _ := terminalSize()
+		for _, line := range compactLines(items, width) {
+			fmt.Println(line)
+		}
+		return
+	}
+	d.watch(ctx, os.Stdout, items, fetch)
+}
+
+// watch repaints items on out every interval, and sooner when the terminal
+// is resized, until ctx is canceled
+func (d listDashboard) watch(ctx context.Context, out io.Writer, items []compactItem, fetch func(ctx context.Context) ([]compactItem, error)) {
+	poll := time.NewTicker(d.Interval)
+	defer poll.Stop()
+	resize := time.NewTicker(resizeCheckInterval)
+	defer resize.Stop()
+
+	updated := time.Now()
+	var failure error
+	width, height := terminalSize()
+	paint := func() {
+		io.WriteString(out, paintFrame(d.frame(items, failure, updated, width, height)))
+	}
+	io.WriteString(out, ansiHideCursor+ansiClearScreen)
+	paint()
+	for {
+		select {
+		case <-ctx.Done():
+			io.WriteString(out, ansiShowCursor+"\n")
+			return
+		case <-resize.C:
+			w, h := terminalSize()
+			if w == width && h == height {
+				continue
+			}
+			width, height = w, h
+		case <-poll.C:
+			next, err := fetch(ctx)
+			if ctx.Err() != nil {
+				continue
+			}
+			if err != nil {
+				failure = err
+			} else {
+				items, failure, updated = next, nil, time.Now()
+			}
+			width, height = terminalSize()
+		}
+		paint()
+	}
+}
+
+// frame lays out one --watch screen of width by height: a header (unless
+// --compact), the last fetch error if any, and the compact lines, with the
+// overflow summed up on the last line
+func (d listDashboard) frame(items []compactItem, failure error, updated time.Time, width, height int) []string {
+	var lines []string
+	if !d.Compact {
+		header := fmt.Sprintf("%d %s · %s", len(items), d.Noun, updated.In(output.Location()).Format("15:04:05"))
+		if d.Title != "" {
+			header = d.Title + " · " + header
+		}
+		lines = append(lines, color.New(color.Bold).Sprint(output.TruncateWidth(header, width)))
+	}
+	if failure != nil {
+		message := fmt.Sprintf("! Refresh failed, retrying: %v", failure)
+		lines = append(lines, color.New(color.FgRed).Sprint(output.TruncateWidth(singleLine(message), width)))
+	}
+	body := compactLines(items, width)
+	if room := height - len(lines); len(body) > room {
+		more := len(body) - room + 1
+		body = append(body[:max(room-1, 0)], color.New(color.FgWhite, color.Faint).Sprint(output.TruncateWidth(fmt.Sprintf("… %d more", more), width)))
+	}
+	return append(lines, body...)
+}
+
+// paintFrame is the output that replaces the previous frame with lines:
+// cursor home, each line followed by a clear to its end, and a clear below
+// the last. The last line has no newline, so a full-height frame doesn't
+// scroll.
+func paintFrame(lines []string) string {
+	return ansiHome + strings.Join(lines, ansiClearLine+"\n") + ansiClearLine + ansiClearBelow
+}
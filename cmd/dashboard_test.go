@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+func TestDashboardFrame(t *testing.T) {
+	prev := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = prev }()
+
+	items := issueCompactItems(compactIssues())
+	updated := time.Date(2026, 10, 16, 9, 5, 0, 0, output.Location())
+	d := listDashboard{Watch: true, Title: "On fire", Noun: "issues"}
+
+	got := d.frame(items, nil, updated, 40, 10)
+	if len(got) != 6 || got[0] != "On fire · 5 issues · 09:05:00" || got[1] != "◐ ENG-7    Checkout returns 500 for…  AL" {
+		t.Errorf("frame =\n%s", strings.Join(got, "\n"))
+	}
+
+	// Too many lines for the pane: the last one says how many are hidden
+	got = d.frame(items, errors.New("connection reset"), updated, 40, 5)
+	want := []string{
+		"On fire · 5 issues · 09:05:00",
+		"! Refresh failed, retrying: connection…",
+		"◐ ENG-7    Checkout returns 500 for…  AL",
+		"○ ENG-1204 Pager storm 🔥🔥 from the…  G",
+		"… 3 more",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("frame =\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	d.Title = ""
+	if got := d.frame(nil, nil, updated, 40, 5); len(got) != 1 || got[0] != "0 issues · 09:05:00" {
+		t.Errorf("untitled frame = %q", got)
+	}
+
+	d.Compact = true
+	if got := d.frame(items[:1], nil, updated, 40, 5); len(got) != 1 || !strings.HasPrefix(got[0], "◐ ENG-7") {
+		t.Errorf("--compact frame has a header: %q", got)
+	}
+}
+
+func TestPaintFrame(t *testing.T) {
+	got := paintFrame([]string{"one", "two"})
+	if want := "\x1b[Hone\x1b[K\ntwo\x1b[K\x1b[J"; got != want {
+		t.Errorf("paintFrame = %q, want %q", got, want)
+	}
+	if strings.Contains(got, ansiClearScreen) {
+		t.Error("repaint clears the whole screen")
+	}
+}
+
+func TestDashboardWatch(t *testing.T) {
+	prevSize := terminalSize
+	width := 40
+	terminalSize = func() (int, int) { return width, 10 }
+	defer func() { terminalSize = prevSize }()
+
+	polls := 0
+	fetch := func(ctx context.Context) ([]compactItem, error) {
+		polls++
+		if polls == 2 {
+			width = 30 // the pane was resized between polls
+		}
+		return []compactItem{{Icon: "○", Ref: "ENG-1", Title: fmt.Sprintf("poll %d", polls)}}, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 150*time.Millisecond)
+	defer cancel()
+	var out bytes.Buffer
+	d := listDashboard{Watch: true, Compact: true, Interval: 20 * time.Millisecond}
+	d.watch(ctx, &out, []compactItem{{Icon: "○", Ref: "ENG-1", Title: "first"}}, fetch)
+
+	got := out.String()
+	if strings.Count(got, ansiClearScreen) != 1 || !strings.HasPrefix(got, ansiHideCursor+ansiClearScreen) {
+		t.Errorf("the screen should be cleared once, at the start: %q", got)
+	}
+	if polls < 2 || strings.Count(got, ansiHome) != polls+1 || !strings.Contains(got, "poll 2") {
+		t.Errorf("%d polls painted as %q", polls, got)
+	}
+	if !strings.HasSuffix(got, ansiShowCursor+"\n") {
+		t.Errorf("the cursor isn't restored: %q", got)
+	}
+}
+
+func TestCompactIssueList(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Issues", `{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-7","title":"Checkout returns 500 for carts with more than 100 items","state":{"name":"In Progress","type":"started"},"assignee":{"name":"Ada Lovelace"}},
+		{"id":"i2","identifier":"ENG-1204","title":"Flaky deploy","state":{"name":"Todo","type":"unstarted"}}
+	],"pageInfo":{"hasNextPage":false}}}`)
+	prevSize := terminalSize
+	terminalSize = func() (int, int) { return 40, 24 }
+	defer func() { terminalSize = prevSize }()
+
+	r := runMocked(t, "issue", "list", "--compact")
+	want := "◐ ENG-7    Checkout returns 500 for…  AL\n○ ENG-1204 Flaky deploy\n"
+	if r.Exit != 0 || r.Stdout != want {
+		t.Errorf("issue list --compact exited %d: stdout %q, want %q (stderr %q)", r.Exit, r.Stdout, want, r.Stderr)
+	}
+
+	for _, tt := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"issue", "list", "--compact", "--json"}, "--compact can't be combined with --json"},
+		{[]string{"issue", "list", "--watch", "--interval", "5"}, "--interval must be at least 10 seconds"},
+		{[]string{"issue", "list", "--compact", "--interval", "60"}, "--interval needs --watch"},
+		{[]string{"issue", "list", "--watch", "--count"}, "--watch can't be combined with --count"},
+	} {
+		r := runMocked(t, tt.args...)
+		if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, tt.want) {
+			t.Errorf("%s exited %d: %s%s, want %q", strings.Join(tt.args, " "), r.Exit, r.Stdout, r.Stderr, tt.want)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// descriptionPreviewLines is how many lines of the edited description the
+// success message shows
+const descriptionPreviewLines = 2
+
+// descriptionEdit is an --append-description or --prepend-description:
+// text added to the current description instead of replacing it
+type descriptionEdit struct {
+	Text    string
+	Prepend bool
+}
+
+// addDescriptionEditFlags registers --append-description,
+// --append-description-file, and --prepend-description on an update command.
+// None of them combine with --description or --description-file.
+func addDescriptionEditFlags(cmd *cobra.Command) {
+	cmd.Flags().String("append-description", "", "Add text to the end of the current description, after a blank line")
+	cmd.Flags().String("append-description-file", "", "Append the contents of a markdown file or https:// URL (use - for stdin)")
+	cmd.Flags().String("prepend-description", "", "Add text to the start of the current description, before a blank line")
+	cmd.MarkFlagsMutuallyExclusive("append-description", "append-description-file", "prepend-description")
+	for _, edit := range []string{"append-description", "append-description-file", "prepend-description"} {
+		cmd.MarkFlagsMutuallyExclusive("description", edit)
+		cmd.MarkFlagsMutuallyExclusive("description-file", edit)
+	}
+}
+
+// descriptionEditFromFlags reads the append/prepend flags. ok is false when
+// none was given.
+func descriptionEditFromFlags(cmd *cobra.Command) (edit descriptionEdit, ok bool, err error) {
+	if cmd.Flags().Changed("prepend-description") {
+		text, _ := cmd.Flags().GetString("prepend-description")
+		edit = descriptionEdit{Text: text, Prepend: true}
+	} else if text, given, err := proseFromFlags(cmd, "append-description"); err != nil {
+		return edit, false, err
+	} else if given {
+		edit = descriptionEdit{Text: text}
+	} else {
+		return edit, false, nil
+	}
+
+	edit.Text = strings.Trim(edit.Text, "\n")
+	if strings.TrimSpace(edit.Text) == "" {
+		return edit, false, fmt.Errorf("the text to add to the description is empty")
+	}
+	return edit, true, nil
+}
+
+// apply joins the text to current, separated by a blank line
+func (e descriptionEdit) apply(current string) string {
+	current = strings.Trim(current, "\n")
+	if strings.TrimSpace(current) == "" {
+		return e.Text
+	}
+	if e.Prepend {
+		return e.Text + "\n\n" + current
+	}
+	return current + "\n\n" + e.Text
+}
+
+// readDescription fetches an entity's description and when it last changed
+type readDescription func(ctx context.Context) (description string, updatedAt time.Time, err error)
+
+// mergeDescription applies edit to the description read as of updatedAt.
+// It reads the entity again right before the write; if it changed in
+// between, the edit is applied to the newer description, once. A second
+// change is reported instead of risking someone's edit being overwritten.
+func mergeDescription(ctx context.Context, description string, updatedAt time.Time, read readDescription, edit descriptionEdit) (string, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		merged := edit.apply(description)
+		latest, latestAt, err := read(ctx)
+		if err != nil {
+			return "", err
+		}
+		if latestAt.Equal(updatedAt) {
+			return merged, nil
+		}
+		description, updatedAt = latest, latestAt
+	}
+	return "", fmt.Errorf("the description kept changing while it was being updated; try again")
+}
+
+// printDescriptionPreview shows the edited end of a description, diff
+// style: the last lines after an append, the first after a prepend, with
+// added lines marked +
+func printDescriptionPreview(edit descriptionEdit, merged string, plaintext bool) {
+	nonEmpty := func(text string) []string {
+		var lines []string
+		for _, line := range strings.Split(text, "\n") {
+			if strings.TrimSpace(line) != "" {
+				lines = append(lines, line)
+			}
+		}
+		return lines
+	}
+	lines := nonEmpty(merged)
+	addedCount := len(nonEmpty(edit.Text))
+
+	// Keep the edited end; added marks which of the kept lines are new
+	verb := "appended"
+	added := func(i int) bool { return i >= len(lines)-addedCount }
+	if edit.Prepend {
+		verb = "prepended"
+		added = func(i int) bool { return i < addedCount }
+		if len(lines) > descriptionPreviewLines {
+			lines = lines[:descriptionPreviewLines]
+		}
+	} else if len(lines) > descriptionPreviewLines {
+		lines = lines[len(lines)-descriptionPreviewLines:]
+	}
+
+	fmt.Printf("  Description (%s):\n", verb)
+	for i, line := range lines {
+		text := truncateString(line, 100)
+		if !added(i) {
+			fmt.Printf("    %s\n", text)
+			continue
+		}
+		if !plaintext {
+			text = color.New(color.FgGreen).Sprint(text)
+		}
+		fmt.Printf("  + %s\n", text)
+	}
+}
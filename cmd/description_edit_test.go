@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDescriptionEditApply(t *testing.T) {
+	for _, tt := range []struct {
+		edit    descriptionEdit
+		current string
+		want    string
+	}{
+		{descriptionEdit{Text: "Shipped."}, "Plan.\n", "Plan.\n\nShipped."},
+		{descriptionEdit{Text: "TL;DR", Prepend: true}, "Plan.", "TL;DR\n\nPlan."},
+		{descriptionEdit{Text: "Shipped."}, "", "Shipped."},
+		{descriptionEdit{Text: "TL;DR", Prepend: true}, "\n  \n", "TL;DR"},
+	} {
+		if got := tt.edit.apply(tt.current); got != tt.want {
+			t.Errorf("%+v.apply(%q) = %q, want %q", tt.edit, tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestMergeDescriptionConflicts(t *testing.T) {
+	t0 := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	edit := descriptionEdit{Text: "Shipped."}
+
+	// reads returns each version in turn; "text@N" was updated N seconds
+	// after t0
+	reads := func(versions ...string) readDescription {
+		n := 0
+		return func(context.Context) (string, time.Time, error) {
+			text, secs, _ := strings.Cut(versions[n], "@")
+			n++
+			var offset int
+			fmt.Sscan(secs, &offset)
+			return text, t0.Add(time.Duration(offset) * time.Second), nil
+		}
+	}
+	unchanged := func(context.Context) (string, time.Time, error) { return "Plan.", t0, nil }
+
+	if got, err := mergeDescription(context.Background(), "Plan.", t0, unchanged, edit); err != nil || got != "Plan.\n\nShipped." {
+		t.Errorf("unchanged: %q, %v", got, err)
+	}
+
+	// A concurrent edit is picked up and the text is added to it instead
+	got, err := mergeDescription(context.Background(), "Plan.", t0, reads("Plan, revised.@1", "Plan, revised.@1"), edit)
+	if err != nil || got != "Plan, revised.\n\nShipped." {
+		t.Errorf("changed once: %q, %v", got, err)
+	}
+
+	if _, err := mergeDescription(context.Background(), "Plan.", t0, reads("v2@1", "v3@2"), edit); err == nil || !strings.Contains(err.Error(), "kept changing") {
+		t.Errorf("changed twice: %v", err)
+	}
+
+	fail := func(context.Context) (string, time.Time, error) { return "", time.Time{}, fmt.Errorf("boom") }
+	if _, err := mergeDescription(context.Background(), "Plan.", t0, fail, edit); err == nil || err.Error() != "boom" {
+		t.Errorf("read error: %v", err)
+	}
+}
+
+func TestHermeticProjectAppendDescription(t *testing.T) {
+	s := newMockLinear(t)
+	project := mockFixture(t, "project_get")
+	s.Data("Project", `{"project":`+project+`}`)
+	s.Data("ProjectUpdate", `{"projectUpdate":{"project":`+project+`}}`)
+
+	r := runMocked(t, "project", "update", "proj-1", "--append-description", "Shipped in v2.", "--plaintext")
+	reqs := s.Requests()
+	if r.Exit != 0 || len(reqs) != 3 || reqs[2].Operation != "ProjectUpdate" {
+		t.Fatalf("append exited %d after %v: %s", r.Exit, s.Operations(), r.Stderr)
+	}
+	want := "Rebuild checkout.\n\n# Goals\n- Faster\n\n```sh\n# not a heading\n```\n\nShipped in v2."
+	if got := reqs[2].Variables["input"].(map[string]interface{})["description"]; got != want {
+		t.Errorf("sent description %q, want %q", got, want)
+	}
+	if !strings.Contains(r.Stdout, "Description (appended):\n    ```\n  + Shipped in v2.\n") {
+		t.Errorf("preview missing:\n%s", r.Stdout)
+	}
+
+	s.Reset()
+	r = runMocked(t, "project", "update", "proj-1", "--prepend-description", "TL;DR: faster checkout", "--plaintext")
+	if reqs := s.Requests(); r.Exit != 0 || len(reqs) != 3 {
+		t.Fatalf("prepend exited %d after %v: %s", r.Exit, s.Operations(), r.Stderr)
+	} else if got := reqs[2].Variables["input"].(map[string]interface{})["description"].(string); !strings.HasPrefix(got, "TL;DR: faster checkout\n\nRebuild checkout.") {
+		t.Errorf("sent description %q", got)
+	}
+	if !strings.Contains(r.Stdout, "Description (prepended):\n  + TL;DR: faster checkout\n    Rebuild checkout.\n") {
+		t.Errorf("preview missing:\n%s", r.Stdout)
+	}
+
+	r = runMocked(t, "project", "update", "proj-1", "--description", "x", "--append-description", "y")
+	if r.Exit == 0 || !strings.Contains(r.Stderr, "none of the others can be") {
+		t.Errorf("--description with --append-description exited %d: %s", r.Exit, r.Stderr)
+	}
+}
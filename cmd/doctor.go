@@ -0,0 +1,415 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// exitDoctorWarnings is the doctor exit code when checks warned but none failed
+const exitDoctorWarnings = 2
+
+const (
+	// doctorAPITimeout bounds the authentication check
+	doctorAPITimeout = 10 * time.Second
+	// latestReleaseURL is queried by doctor --check-update
+	latestReleaseURL = "https://api.github.com/repos/roboalchemist/linear-cli/releases/latest"
+)
+
+// doctorStatus is a check result, ordered from best to worst
+type doctorStatus int
+
+const (
+	doctorPass doctorStatus = iota
+	doctorWarn
+	doctorFail
+)
+
+func (s doctorStatus) String() string {
+	switch s {
+	case doctorPass:
+		return "pass"
+	case doctorWarn:
+		return "warn"
+	}
+	return "fail"
+}
+
+func (s doctorStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// doctorCheck is one line of the doctor checklist
+type doctorCheck struct {
+	Name    string       `json:"name"`
+	Status  doctorStatus `json:"status"`
+	Message string       `json:"message"`
+	Hint    string       `json:"hint,omitempty"`
+}
+
+// doctorFS is the filesystem access the checks need, so tests can fake it
+type doctorFS interface {
+	Stat(name string) (fs.FileInfo, error)
+	ReadFile(name string) ([]byte, error)
+	MkdirAll(path string, perm fs.FileMode) error
+	WriteFile(name string, data []byte, perm fs.FileMode) error
+	Remove(name string) error
+}
+
+// osFS is doctorFS backed by the real filesystem
+type osFS struct{}
+
+func (osFS) Stat(name string) (fs.FileInfo, error)        { return os.Stat(name) }
+func (osFS) ReadFile(name string) ([]byte, error)         { return os.ReadFile(name) }
+func (osFS) MkdirAll(path string, perm fs.FileMode) error { return os.MkdirAll(path, perm) }
+func (osFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	return os.WriteFile(name, data, perm)
+}
+func (osFS) Remove(name string) error { return os.Remove(name) }
+
+// knownConfigKeys are the settings read from ~/.linear-cli.yaml
+var knownConfigKeys = []string{
+	"aliases",
+	"branch_pattern",
+	"check_duplicates",
+	"json",
+	"json_envelope",
+	"max_requests",
+	"no_color",
+	"no_normalize",
+	"no_onboard",
+	"parallel",
+	"plaintext",
+	"progress",
+	"project_list",
+	"read_only",
+	"strict_schema",
+	"utc",
+	"verbose",
+}
+
+// checkCredentials looks for credentials and warns when the auth file can
+// be read by other users. envSource names the env var in use, if any.
+func checkCredentials(fsys doctorFS, path, envSource string) doctorCheck {
+	c := doctorCheck{Name: "credentials"}
+	info, err := fsys.Stat(path)
+	switch {
+	case err != nil && envSource != "":
+		c.Message = "using " + envSource
+		return c
+	case errors.Is(err, fs.ErrNotExist):
+		c.Status = doctorFail
+		c.Message = "no credentials: " + path + " does not exist and LINEAR_API_KEY is not set"
+		c.Hint = "run 'linear-cli auth' or export LINEAR_API_KEY"
+		return c
+	case err != nil:
+		c.Status = doctorFail
+		c.Message = fmt.Sprintf("cannot read %s: %v", path, err)
+		c.Hint = "check the file's ownership and permissions"
+		return c
+	}
+
+	c.Message = path
+	if envSource != "" {
+		c.Message = fmt.Sprintf("using %s (%s is also present)", envSource, path)
+	}
+	if mode := info.Mode().Perm(); mode&0o004 != 0 {
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("%s is world-readable (mode %04o)", path, mode)
+		c.Hint = "chmod 600 " + path
+	}
+	return c
+}
+
+// checkAPI confirms the API is reachable and the credentials are accepted
+func checkAPI(client *api.Client, timeout time.Duration) doctorCheck {
+	c := doctorCheck{Name: "api"}
+	if client == nil {
+		c.Status = doctorFail
+		c.Message = "skipped: no credentials"
+		c.Hint = "fix the credentials check first"
+		return c
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		c.Status = doctorFail
+		c.Message = err.Error()
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && apiErr.HasCode("AUTHENTICATION_ERROR") {
+			c.Hint = "the API key was rejected; run 'linear-cli auth' to log in again"
+		} else {
+			c.Hint = "check your network connection and any HTTPS proxy settings"
+		}
+		return c
+	}
+	c.Message = fmt.Sprintf("authenticated as %s <%s>", viewer.Name, viewer.Email)
+	return c
+}
+
+// checkRateLimit reports the remaining hourly request allowance
+func checkRateLimit(rl *api.RateLimit, now time.Time) doctorCheck {
+	c := doctorCheck{Name: "rate-limit"}
+	summary := rateLimitSummary(rl, now)
+	if summary == "" {
+		c.Status = doctorWarn
+		c.Message = "no rate-limit headers seen"
+		c.Hint = "the API check must succeed for rate limits to be known"
+		return c
+	}
+	c.Message = summary
+	switch {
+	case rl.RequestRemaining == 0:
+		c.Status = doctorFail
+		c.Hint = "wait for the window to reset before running more commands"
+	case rl.RequestRemaining*10 < rl.RequestLimit:
+		c.Status = doctorWarn
+		c.Hint = "under 10% left; use --max-requests to cap scripts"
+	}
+	return c
+}
+
+// checkConfigFile validates the YAML config and flags keys linear-cli doesn't read
+func checkConfigFile(fsys doctorFS, path string) doctorCheck {
+	c := doctorCheck{Name: "config"}
+	data, err := fsys.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		c.Message = "no config file at " + path + " (using defaults)"
+		return c
+	}
+	if err != nil {
+		c.Status = doctorFail
+		c.Message = fmt.Sprintf("cannot read %s: %v", path, err)
+		c.Hint = "check the file's ownership and permissions"
+		return c
+	}
+
+	var settings map[string]interface{}
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		c.Status = doctorFail
+		c.Message = fmt.Sprintf("%s is not valid YAML: %v", path, err)
+		c.Hint = "fix the syntax or move the file aside"
+		return c
+	}
+
+	known := map[string]bool{}
+	for _, k := range knownConfigKeys {
+		known[k] = true
+	}
+	var unknown []string
+	for k := range settings {
+		if !known[k] {
+			unknown = append(unknown, k)
+		}
+	}
+	sort.Strings(unknown)
+	c.Message = path
+	if len(unknown) > 0 {
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("%s has unknown keys: %s", path, strings.Join(unknown, ", "))
+		c.Hint = "known keys: " + strings.Join(knownConfigKeys, ", ")
+	}
+	return c
+}
+
+// checkStateDir makes sure the directory holding the undo journal and view
+// snapshots can be written, by creating and removing a probe file
+func checkStateDir(fsys doctorFS, dir string) doctorCheck {
+	c := doctorCheck{Name: "state-dir", Message: dir}
+	probe := filepath.Join(dir, ".doctor-probe")
+	err := fsys.MkdirAll(dir, 0700)
+	if err == nil {
+		err = fsys.WriteFile(probe, []byte("ok"), 0600)
+	}
+	if err != nil {
+		c.Status = doctorFail
+		c.Message = fmt.Sprintf("%s is not writable: %v", dir, err)
+		c.Hint = "fix its permissions or set XDG_STATE_HOME to a writable directory; undo history and view snapshots need it"
+		return c
+	}
+	_ = fsys.Remove(probe)
+	return c
+}
+
+// checkLatestVersion compares current with the newest GitHub release
+func checkLatestVersion(client *http.Client, url, current string) doctorCheck {
+	c := doctorCheck{Name: "version"}
+	resp, err := client.Get(url)
+	if err != nil {
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("could not check for updates: %v", err)
+		return c
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("could not check for updates: HTTP %d", resp.StatusCode)
+		return c
+	}
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&release); err != nil || release.TagName == "" {
+		c.Status = doctorWarn
+		c.Message = "could not check for updates: unexpected release response"
+		return c
+	}
+
+	latest := strings.TrimPrefix(release.TagName, "v")
+	switch {
+	case current == "dev":
+		c.Status = doctorWarn
+		c.Message = "development build; latest release is " + latest
+	case strings.TrimPrefix(current, "v") != latest:
+		c.Status = doctorWarn
+		c.Message = fmt.Sprintf("version %s; latest release is %s", current, latest)
+		c.Hint = "brew upgrade linear-cli, or go install github.com/roboalchemist/linear-cli@latest"
+	default:
+		c.Message = fmt.Sprintf("version %s is the latest release", current)
+	}
+	return c
+}
+
+// worstStatus returns the most severe status among checks
+func worstStatus(checks []doctorCheck) doctorStatus {
+	worst := doctorPass
+	for _, c := range checks {
+		if c.Status > worst {
+			worst = c.Status
+		}
+	}
+	return worst
+}
+
+// configFilePath returns the config file doctor validates
+func configFilePath() string {
+	if cfgFile != "" {
+		return cfgFile
+	}
+	if used := viper.ConfigFileUsed(); used != "" {
+		return used
+	}
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".linear-cli.yaml")
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check credentials, API access, config, and local state",
+	Long: `Run a checklist of common setup problems, in order: credentials file
+presence and permissions, API reachability and authentication, rate-limit
+headroom, config file syntax and unknown keys, and whether the state directory
+(undo history, view snapshots) is writable. --check-update also compares this
+binary with the latest release.
+
+Each check prints pass, warn, or fail with a hint on how to fix it. The exit
+code is 0 when everything passes, 2 when there are only warnings, and 1 when
+any check fails.
+
+Examples:
+  linear-cli doctor
+  linear-cli doctor --check-update
+  linear-cli doctor --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		fsys := osFS{}
+
+		authPath, err := auth.ConfigPath()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to locate auth file: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		envSource := ""
+		if source := auth.GetAuthSource(); strings.HasPrefix(source, "env:") {
+			envSource = strings.TrimPrefix(source, "env:")
+		}
+
+		checks := []doctorCheck{checkCredentials(fsys, authPath, envSource)}
+
+		var client *api.Client
+		if authHeader, err := auth.GetAuthHeader(); err == nil {
+			client = api.NewClient(authHeader)
+		}
+		checks = append(checks, checkAPI(client, doctorAPITimeout))
+		checks = append(checks, checkRateLimit(api.LatestRateLimit(), time.Now()))
+		checks = append(checks, checkConfigFile(fsys, configFilePath()))
+
+		stateDir := ""
+		if journalPath, err := journal.Path(); err == nil {
+			stateDir = filepath.Dir(journalPath)
+		}
+		checks = append(checks, checkStateDir(fsys, stateDir))
+
+		if checkUpdate, _ := cmd.Flags().GetBool("check-update"); checkUpdate {
+			checks = append(checks, checkLatestVersion(&http.Client{Timeout: 5 * time.Second}, latestReleaseURL, version))
+		}
+
+		worst := worstStatus(checks)
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"status": worst,
+				"checks": checks,
+			})
+		} else {
+			writeDoctorChecks(os.Stdout, checks, plaintext)
+		}
+
+		switch worst {
+		case doctorFail:
+			exit(1)
+		case doctorWarn:
+			exit(exitDoctorWarnings)
+		}
+	},
+}
+
+// writeDoctorChecks prints the checklist, one check per line with its hint below
+func writeDoctorChecks(w io.Writer, checks []doctorCheck, plaintext bool) {
+	for _, c := range checks {
+		if plaintext {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", c.Status, c.Name, c.Message)
+			if c.Hint != "" {
+				fmt.Fprintf(w, "\t\thint: %s\n", c.Hint)
+			}
+			continue
+		}
+		mark := color.New(color.FgGreen).Sprint("✓")
+		switch c.Status {
+		case doctorWarn:
+			mark = color.New(color.FgYellow).Sprint("⚠")
+		case doctorFail:
+			mark = color.New(color.FgRed).Sprint("✗")
+		}
+		fmt.Fprintf(w, "%s %-11s %s\n", mark, c.Name, c.Message)
+		if c.Hint != "" {
+			fmt.Fprintf(w, "  %s %s\n", color.New(color.Faint).Sprint("→"), c.Hint)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.Flags().Bool("check-update", false, "Also compare this binary with the latest GitHub release")
+}
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// fakeFS is an in-memory doctorFS; failWrites makes every write fail
+type fakeFS struct {
+	files      fstest.MapFS
+	failWrites bool
+	removed    []string
+}
+
+func (f *fakeFS) Stat(name string) (fs.FileInfo, error) {
+	return f.files.Stat(strings.TrimPrefix(name, "/"))
+}
+
+func (f *fakeFS) ReadFile(name string) ([]byte, error) {
+	return f.files.ReadFile(strings.TrimPrefix(name, "/"))
+}
+
+func (f *fakeFS) MkdirAll(path string, perm fs.FileMode) error {
+	if f.failWrites {
+		return fs.ErrPermission
+	}
+	return nil
+}
+
+func (f *fakeFS) WriteFile(name string, data []byte, perm fs.FileMode) error {
+	if f.failWrites {
+		return fs.ErrPermission
+	}
+	f.files[strings.TrimPrefix(name, "/")] = &fstest.MapFile{Data: data, Mode: perm}
+	return nil
+}
+
+func (f *fakeFS) Remove(name string) error {
+	f.removed = append(f.removed, name)
+	delete(f.files, strings.TrimPrefix(name, "/"))
+	return nil
+}
+
+func TestCheckCredentials(t *testing.T) {
+	const path = "/home/me/.linear-cli-auth.json"
+	tests := []struct {
+		name      string
+		files     fstest.MapFS
+		envSource string
+		want      doctorStatus
+		wantHint  string
+	}{
+		{"private file", fstest.MapFS{"home/me/.linear-cli-auth.json": {Mode: 0600}}, "", doctorPass, ""},
+		{"world-readable", fstest.MapFS{"home/me/.linear-cli-auth.json": {Mode: 0644}}, "", doctorWarn, "chmod 600 " + path},
+		{"missing", fstest.MapFS{}, "", doctorFail, "linear-cli auth"},
+		{"env only", fstest.MapFS{}, "LINEAR_API_KEY", doctorPass, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkCredentials(&fakeFS{files: tt.files}, path, tt.envSource)
+			if got.Status != tt.want {
+				t.Errorf("status = %s, want %s (%s)", got.Status, tt.want, got.Message)
+			}
+			if !strings.Contains(got.Hint, tt.wantHint) || (tt.wantHint == "") != (got.Hint == "") {
+				t.Errorf("hint = %q, want %q", got.Hint, tt.wantHint)
+			}
+		})
+	}
+}
+
+func TestCheckAPI(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		want     doctorStatus
+		wantText string
+	}{
+		{"ok", `{"data":{"viewer":{"id":"u1","name":"Ada","email":"ada@example.com"}}}`, doctorPass, "ada@example.com"},
+		{"rejected key", `{"errors":[{"message":"Authentication required","extensions":{"code":"AUTHENTICATION_ERROR"}}]}`, doctorFail, "log in again"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			got := checkAPI(api.NewClientWithURL(server.URL, "key"), time.Second)
+			if got.Status != tt.want {
+				t.Fatalf("status = %s, want %s (%s)", got.Status, tt.want, got.Message)
+			}
+			if !strings.Contains(got.Message+got.Hint, tt.wantText) {
+				t.Errorf("check = %+v, want it to mention %q", got, tt.wantText)
+			}
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close()
+		got := checkAPI(api.NewClientWithURL(server.URL, "key"), time.Second)
+		if got.Status != doctorFail || !strings.Contains(got.Hint, "network") {
+			t.Errorf("check = %+v, want a network failure", got)
+		}
+	})
+
+	t.Run("no credentials", func(t *testing.T) {
+		if got := checkAPI(nil, time.Second); got.Status != doctorFail {
+			t.Errorf("status = %s, want fail", got.Status)
+		}
+	})
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		rl   *api.RateLimit
+		want doctorStatus
+	}{
+		{"plenty", &api.RateLimit{RequestLimit: 1500, RequestRemaining: 1200}, doctorPass},
+		{"low", &api.RateLimit{RequestLimit: 1500, RequestRemaining: 100}, doctorWarn},
+		{"exhausted", &api.RateLimit{RequestLimit: 1500, RequestRemaining: 0, RequestReset: now.Add(20 * time.Minute)}, doctorFail},
+		{"unknown", nil, doctorWarn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkRateLimit(tt.rl, now); got.Status != tt.want {
+				t.Errorf("status = %s, want %s (%s)", got.Status, tt.want, got.Message)
+			}
+		})
+	}
+}
+
+func TestCheckConfigFile(t *testing.T) {
+	const path = "/home/me/.linear-cli.yaml"
+	tests := []struct {
+		name     string
+		content  string // "" means no file
+		want     doctorStatus
+		wantText string
+	}{
+		{"missing", "", doctorPass, "using defaults"},
+		{"known keys", "plaintext: true\nmax_requests: 50\n", doctorPass, ""},
+		{"unknown keys", "plaintext: true\ncolour: false\napi_key: x\n", doctorWarn, "api_key, colour"},
+		{"bad yaml", "plaintext: [true\n", doctorFail, "not valid YAML"},
+		{"bound flags", "read_only: true\nno_color: true\nno_onboard: true\nno_normalize: true\nparallel: 8\n", doctorPass, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := fstest.MapFS{}
+			if tt.content != "" {
+				files["home/me/.linear-cli.yaml"] = &fstest.MapFile{Data: []byte(tt.content)}
+			}
+			got := checkConfigFile(&fakeFS{files: files}, path)
+			if got.Status != tt.want {
+				t.Fatalf("status = %s, want %s (%s)", got.Status, tt.want, got.Message)
+			}
+			if !strings.Contains(got.Message, tt.wantText) {
+				t.Errorf("message = %q, want it to contain %q", got.Message, tt.wantText)
+			}
+		})
+	}
+}
+
+// TestKnownConfigKeysCoverRootFlags keeps doctor from calling a global flag
+// bound into the config file an unknown key
+func TestKnownConfigKeysCoverRootFlags(t *testing.T) {
+	known := map[string]bool{}
+	for _, k := range knownConfigKeys {
+		known[k] = true
+	}
+	for _, key := range viper.AllKeys() {
+		flag := rootCmd.PersistentFlags().Lookup(strings.ReplaceAll(key, "_", "-"))
+		if flag != nil && !known[key] {
+			t.Errorf("config key %s is bound to --%s but missing from knownConfigKeys", key, flag.Name)
+		}
+	}
+}
+
+func TestCheckStateDir(t *testing.T) {
+	fsys := &fakeFS{files: fstest.MapFS{}}
+	if got := checkStateDir(fsys, "/state/linear-cli"); got.Status != doctorPass {
+		t.Errorf("writable dir: status = %s (%s)", got.Status, got.Message)
+	}
+	if len(fsys.removed) != 1 || len(fsys.files) != 0 {
+		t.Errorf("probe file not cleaned up: removed %v, left %v", fsys.removed, fsys.files)
+	}
+
+	got := checkStateDir(&fakeFS{files: fstest.MapFS{}, failWrites: true}, "/state/linear-cli")
+	if got.Status != doctorFail || got.Hint == "" {
+		t.Errorf("read-only dir: check = %+v, want fail with a hint", got)
+	}
+}
+
+func TestCheckLatestVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"tag_name":"v1.4.0"}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		current string
+		want    doctorStatus
+	}{
+		{"1.4.0", doctorPass},
+		{"v1.4.0", doctorPass},
+		{"1.3.2", doctorWarn},
+		{"dev", doctorWarn},
+	}
+	for _, tt := range tests {
+		if got := checkLatestVersion(server.Client(), server.URL, tt.current); got.Status != tt.want {
+			t.Errorf("version %s: status = %s, want %s (%s)", tt.current, got.Status, tt.want, got.Message)
+		}
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer failing.Close()
+	if got := checkLatestVersion(failing.Client(), failing.URL, "1.4.0"); got.Status != doctorWarn {
+		t.Errorf("failed lookup: status = %s, want warn", got.Status)
+	}
+}
+
+func TestWorstStatus(t *testing.T) {
+	checks := []doctorCheck{{Status: doctorPass}, {Status: doctorWarn}}
+	if got := worstStatus(checks); got != doctorWarn {
+		t.Errorf("worstStatus = %s, want warn", got)
+	}
+	checks = append(checks, doctorCheck{Status: doctorFail})
+	if got := worstStatus(checks); got != doctorFail {
+		t.Errorf("worstStatus = %s, want fail", got)
+	}
+	if got := worstStatus(nil); got != doctorPass {
+		t.Errorf("worstStatus(nil) = %s, want pass", got)
+	}
+}
@@ -3,11 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
@@ -27,6 +27,7 @@ Examples:
   linear-cli document search "spec"                 # Search documents
   linear-cli document create --title "My Doc"       # Create a document
   linear-cli document update DOC-ID --title "New"   # Update a document
+  linear-cli document move DOC-ID --to-team ENG     # Move a document to a team
   linear-cli document delete DOC-ID                 # Delete a document`,
 }
 
@@ -42,12 +43,12 @@ var documentListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		filter := buildDocumentFilter(cmd)
+		filter := buildDocumentFilter(cmd, client)
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
@@ -55,32 +56,26 @@ var documentListCmd = &cobra.Command{
 		}
 
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		docs, err := client.GetDocuments(context.Background(), filter, limit, "", orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch documents: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, docs.PageInfo, len(docs.Nodes))
 
-		renderDocumentCollection(docs, plaintext, jsonOut, "No documents found", "documents", "# Documents")
+		renderDocumentCollection(docs, plaintext, jsonOut, "No documents found", "documents", "# Documents", nil)
 	},
 }
 
-func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string) {
+// renderDocumentCollection prints documents as JSON, markdown, or a table.
+// matcher, when set, highlights search terms in result snippets.
+func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string, matcher *documentMatcher) {
 	if len(docs.Nodes) == 0 {
 		output.Info(emptyMessage, plaintext, jsonOut)
 		return
@@ -125,10 +120,13 @@ func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, empt
 			if doc.Creator != nil {
 				fmt.Printf("- **Creator**: %s\n", doc.Creator.Name)
 			}
-			fmt.Printf("- **Updated**: %s\n", doc.UpdatedAt.Format("2006-01-02"))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(doc.UpdatedAt, output.DateOnly))
 			if doc.URL != "" {
 				fmt.Printf("- **URL**: %s\n", doc.URL)
 			}
+			if doc.Snippet != "" && matcher != nil {
+				fmt.Printf("- **Snippet**: %s\n", matcher.highlight(doc.Snippet, func(s string) string { return "**" + s + "**" }))
+			}
 			fmt.Println()
 		}
 		fmt.Printf("\nTotal: %d %s\n", len(docs.Nodes), summaryLabel)
@@ -136,6 +134,15 @@ func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, empt
 	}
 
 	headers := []string{"Title", "Project", "Team", "Creator", "Updated", "URL"}
+	withSnippets := false
+	for _, doc := range docs.Nodes {
+		if doc.Snippet != "" && matcher != nil {
+			withSnippets = true
+		}
+	}
+	if withSnippets {
+		headers = append(headers, "Match")
+	}
 	rows := make([][]string, len(docs.Nodes))
 
 	for i, doc := range docs.Nodes {
@@ -164,9 +171,13 @@ func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, empt
 			project,
 			team,
 			creator,
-			doc.UpdatedAt.Format("2006-01-02"),
+			output.FormatTime(doc.UpdatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 			doc.URL,
 		}
+		if withSnippets {
+			bold := color.New(color.Bold).SprintFunc()
+			rows[i] = append(rows[i], matcher.highlight(truncateString(doc.Snippet, 60), func(s string) string { return bold(s) }))
+		}
 	}
 
 	tableData := output.TableData{
@@ -180,38 +191,46 @@ func renderDocumentCollection(docs *api.Documents, plaintext, jsonOut bool, empt
 		color.New(color.FgGreen).Sprint("✓"),
 		len(docs.Nodes),
 		summaryLabel)
-
-	if docs.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
-			color.New(color.FgYellow).Sprint("ℹ️"))
-	}
 }
 
 var documentGetCmd = &cobra.Command{
 	Use:     "get [document-id]",
 	Aliases: []string{"show"},
 	Short:   "Get document details",
-	Long:    `Get detailed information about a specific document, including its full content.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a specific document, including its full content.
+
+--fields trims --json output to the given comma-separated dot-paths, e.g.
+creator.name; arrays are filtered element by element. Paths that aren't in
+the output are left out, or fail the command with --strict-fields.
+
+Examples:
+  linear-cli document get DOC-ID
+  linear-cli document get DOC-ID --json --fields title,url,updatedAt`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		fields, err := jsonFieldsFromFlags(cmd, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		doc, err := client.GetDocument(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch document: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
-			output.JSON(doc)
+			fields.print(doc, plaintext, jsonOut)
 			return
 		}
 
@@ -226,8 +245,8 @@ var documentGetCmd = &cobra.Command{
 			if doc.UpdatedBy != nil {
 				fmt.Printf("- **Updated by**: %s (%s)\n", doc.UpdatedBy.Name, doc.UpdatedBy.Email)
 			}
-			fmt.Printf("- **Created**: %s\n", doc.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", doc.UpdatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(doc.CreatedAt, output.DateTime))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(doc.UpdatedAt, output.DateTime))
 			if doc.Project != nil {
 				fmt.Printf("- **Project**: %s\n", doc.Project.Name)
 			}
@@ -286,8 +305,8 @@ var documentGetCmd = &cobra.Command{
 				color.New(color.FgCyan).Sprint(doc.UpdatedBy.Name))
 		}
 
-		fmt.Printf("Created: %s\n", doc.CreatedAt.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated: %s\n", doc.UpdatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Created: %s\n", output.FormatTime(doc.CreatedAt, output.DateTime))
+		fmt.Printf("Updated: %s\n", output.FormatTime(doc.UpdatedAt, output.DateTime))
 
 		if doc.Project != nil {
 			fmt.Printf("Project: %s\n",
@@ -349,10 +368,18 @@ var documentSearchCmd = &cobra.Command{
 	Short:   "Search documents by keyword",
 	Long: `Perform a full-text search across Linear documents.
 
+--in narrows results to documents whose title (or content) contains every
+search term, checked client-side; the default, both, keeps all of Linear's
+matches. --snippets adds the first line containing a term to each result,
+with terms highlighted (bold in the table, **term** in plaintext, a plain
+"snippet" field in JSON). Document content is only fetched when --in content
+or --snippets needs it.
+
 Examples:
   linear-cli document search "spec"
   linear-cli document search "onboarding" --team ENG
-  linear-cli document search "design" --json`,
+  linear-cli document search "rate limit" --in content --snippets
+  linear-cli document search "design" --in title --json`,
 	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -361,13 +388,20 @@ Examples:
 		query := strings.TrimSpace(strings.Join(args, " "))
 		if query == "" {
 			output.Error("Search query is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
+		searchIn, _ := cmd.Flags().GetString("in")
+		if err := validateSearchIn(searchIn); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		snippets, _ := cmd.Flags().GetBool("snippets")
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -378,32 +412,39 @@ Examples:
 		}
 
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
-		teamID, _ := cmd.Flags().GetString("team")
+		teamID := ""
+		if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			teamID = team.ID
+		}
 		includeComments, _ := cmd.Flags().GetBool("include-comments")
 
-		docs, err := client.SearchDocuments(context.Background(), query, limit, "", orderBy, teamID, includeComments)
+		withContent := snippets || searchIn == "content"
+		docs, err := client.SearchDocuments(context.Background(), query, limit, "", orderBy, teamID, includeComments, withContent)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to search documents: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, docs.PageInfo, len(docs.Nodes))
+
+		matcher := newDocumentMatcher(query)
+		docs.Nodes = applyDocumentMatches(docs.Nodes, matcher, searchIn, snippets)
 
 		emptyMsg := fmt.Sprintf("No documents found matching %q", query)
-		renderDocumentCollection(docs, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
+		if searchIn != "both" {
+			emptyMsg = fmt.Sprintf("No documents found matching %q in %s", query, searchIn)
+		}
+		renderDocumentCollection(docs, plaintext, jsonOut, emptyMsg, "matches", "# Search Results", matcher)
 	},
 }
 
@@ -422,12 +463,12 @@ Examples:
   cat doc.md | linear-cli document create --title "My Doc" --content-file -`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -438,7 +479,7 @@ Examples:
 		content, err := resolveBodyFromFlags(contentFlag, cmd.Flags().Changed("content"), filePath, "content", "content-file")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 		projectID, _ := cmd.Flags().GetString("project")
 		issueID, _ := cmd.Flags().GetString("issue")
@@ -451,12 +492,12 @@ Examples:
 
 		if title == "" {
 			output.Error("Title is required (--title)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if projectID == "" && teamKey == "" {
 			output.Error("Either --team or --project is required to create a document.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -480,8 +521,8 @@ Examples:
 			// Resolve team key to ID
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			input["teamId"] = team.ID
 		}
@@ -497,8 +538,9 @@ Examples:
 		doc, err := client.CreateDocument(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create document: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "document", doc.ID, doc.Title, &journal.Inverse{Action: "delete"})
 
 		// Link entities via update (workaround for documentCreate API limitation)
 		linkInput := make(map[string]interface{})
@@ -518,26 +560,24 @@ Examples:
 			doc, err = client.UpdateDocument(context.Background(), doc.ID, linkInput)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to link document: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
+		if printCreated(cmd, createdEntity{Type: "document", ID: doc.ID, Name: doc.Title, URL: doc.URL}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(doc)
 		} else if plaintext {
 			fmt.Printf("Created document: %s\n", doc.Title)
 			fmt.Printf("ID: %s\n", doc.ID)
-			if doc.URL != "" {
-				fmt.Printf("URL: %s\n", doc.URL)
-			}
+			printResultURL(doc.URL, plaintext)
 		} else {
 			fmt.Printf("%s Created document: %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgWhite, color.Bold).Sprint(doc.Title))
-			if doc.URL != "" {
-				fmt.Printf("  URL: %s\n",
-					color.New(color.FgBlue, color.Underline).Sprint(doc.URL))
-			}
+			printResultURL(doc.URL, plaintext)
 		}
 	},
 }
@@ -559,12 +599,12 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -582,7 +622,7 @@ Examples:
 			content, err := resolveBodyFromFlags(contentFlag, cmd.Flags().Changed("content"), filePath, "content", "content-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["content"] = content
 		}
@@ -624,31 +664,36 @@ Examples:
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		// Capture current values so the update can be undone
+		var inverse *journal.Inverse
+		if before, err := client.GetDocument(context.Background(), args[0]); err == nil {
+			inverse = restoreInverse(before, input)
 		}
 
 		doc, err := client.UpdateDocument(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update document: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "document", doc.ID, doc.Title, inverse)
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(doc.URL, "document")
+		} else if jsonOut {
 			output.JSON(doc)
 		} else if plaintext {
 			fmt.Printf("Updated document: %s\n", doc.Title)
 			fmt.Printf("ID: %s\n", doc.ID)
-			if doc.URL != "" {
-				fmt.Printf("URL: %s\n", doc.URL)
-			}
+			printResultURL(doc.URL, plaintext)
 		} else {
 			fmt.Printf("%s Updated document: %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgWhite, color.Bold).Sprint(doc.Title))
 			fmt.Printf("  ID: %s\n", color.New(color.FgWhite, color.Faint).Sprint(doc.ID))
-			if doc.URL != "" {
-				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(doc.URL))
-			}
+			printResultURL(doc.URL, plaintext)
 		}
 	},
 }
@@ -665,7 +710,7 @@ var documentDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -673,8 +718,9 @@ var documentDeleteCmd = &cobra.Command{
 		err = client.DeleteDocument(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete document: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "document", args[0], "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{"success": true, "id": args[0], "action": "deleted"})
@@ -688,7 +734,9 @@ var documentDeleteCmd = &cobra.Command{
 	},
 }
 
-func buildDocumentFilter(cmd *cobra.Command) map[string]interface{} {
+func buildDocumentFilter(cmd *cobra.Command, client *api.Client) map[string]interface{} {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
 	filter := make(map[string]interface{})
 
 	if projectID, _ := cmd.Flags().GetString("project"); projectID != "" {
@@ -700,16 +748,19 @@ func buildDocumentFilter(cmd *cobra.Command) map[string]interface{} {
 	}
 
 	if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
-		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}}
+		team, err := client.GetTeam(context.Background(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+			exit(1)
+		}
+		filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}
 	}
 
 	newerThan, _ := cmd.Flags().GetString("newer-than")
 	createdAt, err := utils.ParseTimeExpression(newerThan)
 	if err != nil {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
 		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 	if createdAt != "" {
 		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
@@ -730,21 +781,25 @@ func init() {
 	// List command flags
 	documentListCmd.Flags().String("project", "", "Filter by project ID")
 	documentListCmd.Flags().String("issue", "", "Filter by issue ID")
-	documentListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	documentListCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID")
 	documentListCmd.Flags().IntP("limit", "l", 50, "Maximum number of documents to return")
 	documentListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	documentListCmd.Flags().StringP("newer-than", "n", "", "Show documents created after this time (default: 6_months_ago, use 'all_time' for no filter)")
 
+	addFieldsFlags(documentGetCmd)
+
 	// Search command flags
-	documentSearchCmd.Flags().StringP("team", "t", "", "Filter by team ID")
+	documentSearchCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID")
 	documentSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of results to return")
 	documentSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	documentSearchCmd.Flags().Bool("include-comments", false, "Include document comments in search")
+	documentSearchCmd.Flags().String("in", "both", "Where every term must match: title, content, or both (Linear's own matching)")
+	documentSearchCmd.Flags().Bool("snippets", false, "Show the first matching line of each result, with terms highlighted")
 
 	// Create command flags
 	documentCreateCmd.Flags().String("title", "", "Document title (required)")
 	documentCreateCmd.Flags().String("content", "", "Document content (markdown)")
-	documentCreateCmd.Flags().String("content-file", "", "Read content from a markdown file (use - for stdin)")
+	documentCreateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
 	documentCreateCmd.Flags().String("project", "", "Project ID to associate with")
 	documentCreateCmd.Flags().String("issue", "", "Issue ID to associate with")
 	documentCreateCmd.Flags().StringP("team", "t", "", "Team key to associate with")
@@ -753,12 +808,14 @@ func init() {
 	documentCreateCmd.Flags().String("release", "", "Release ID to associate with")
 	documentCreateCmd.Flags().String("icon", "", "Document icon (emoji)")
 	documentCreateCmd.Flags().String("color", "", "Document icon color (hex)")
+	addURLOnlyFlag(documentCreateCmd)
+	addPorcelainFlag(documentCreateCmd)
 	_ = documentCreateCmd.MarkFlagRequired("title")
 
 	// Update command flags
 	documentUpdateCmd.Flags().String("title", "", "New title for the document")
 	documentUpdateCmd.Flags().String("content", "", "New content for the document (markdown)")
-	documentUpdateCmd.Flags().String("content-file", "", "Read content from a markdown file (use - for stdin)")
+	documentUpdateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
 	documentUpdateCmd.Flags().String("icon", "", "New icon (emoji)")
 	documentUpdateCmd.Flags().String("color", "", "New icon color (hex)")
 	documentUpdateCmd.Flags().String("project", "", "Project ID to associate with")
@@ -766,6 +823,7 @@ func init() {
 	documentUpdateCmd.Flags().String("initiative", "", "Initiative ID to associate with")
 	documentUpdateCmd.Flags().String("cycle", "", "Cycle ID to associate with")
 	documentUpdateCmd.Flags().String("release", "", "Release ID to associate with")
+	addURLOnlyFlag(documentUpdateCmd)
 
 	// Delete has no extra flags
 }
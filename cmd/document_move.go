@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// documentParent is one thing a document hangs off: a project, team, issue,
+// initiative, cycle, or release
+type documentParent struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (p documentParent) String() string {
+	return p.Type + " " + p.Name
+}
+
+// documentParents lists a document's parents, project and team first
+func documentParents(doc *api.Document) []documentParent {
+	parents := []documentParent{}
+	if doc.Project != nil {
+		parents = append(parents, documentParent{"project", doc.Project.ID, doc.Project.Name})
+	}
+	if doc.Team != nil {
+		parents = append(parents, documentParent{"team", doc.Team.ID, doc.Team.Key})
+	}
+	if doc.Issue != nil {
+		parents = append(parents, documentParent{"issue", doc.Issue.ID, doc.Issue.Identifier})
+	}
+	if doc.Initiative != nil {
+		parents = append(parents, documentParent{"initiative", doc.Initiative.ID, doc.Initiative.Name})
+	}
+	if doc.Cycle != nil {
+		name := doc.Cycle.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", doc.Cycle.Number)
+		}
+		parents = append(parents, documentParent{"cycle", doc.Cycle.ID, name})
+	}
+	if doc.Release != nil {
+		parents = append(parents, documentParent{"release", doc.Release.ID, doc.Release.Name})
+	}
+	return parents
+}
+
+// formatDocumentParents renders parents for display, or "none"
+func formatDocumentParents(parents []documentParent) string {
+	if len(parents) == 0 {
+		return "none"
+	}
+	names := make([]string, len(parents))
+	for i, p := range parents {
+		names[i] = p.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// leavesDocumentOrphaned reports whether applying input to doc would leave
+// it with no parent at all
+func leavesDocumentOrphaned(doc *api.Document, input map[string]interface{}) bool {
+	for _, p := range documentParents(doc) {
+		value, changed := input[p.Type+"Id"]
+		if !changed || value != nil {
+			return false
+		}
+	}
+	for _, value := range input {
+		if value != nil {
+			return false
+		}
+	}
+	return true
+}
+
+var documentMoveCmd = &cobra.Command{
+	Use:   "move DOC-ID",
+	Short: "Move a document to another project or team",
+	Long: `Move a document under a different project or team. --to-project takes a
+project ID, slug, URL, or name; --to-team takes a team key, name, or ID. The
+target is checked before anything changes, and moving to one clears the other.
+
+'none' removes a document from its project or team without a new home. A
+document is never left with no parent at all unless --detach is passed;
+--detach on its own removes both its project and its team.
+
+Examples:
+  linear-cli document move DOC-ID --to-project "Mobile App"
+  linear-cli document move DOC-ID --to-team ENG
+  linear-cli document move DOC-ID --detach`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		toProject, _ := cmd.Flags().GetString("to-project")
+		toTeam, _ := cmd.Flags().GetString("to-team")
+		detach, _ := cmd.Flags().GetBool("detach")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		before, err := client.GetDocument(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch document: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		input := map[string]interface{}{}
+		resolver := newRefResolver(client)
+		switch {
+		case strings.EqualFold(toProject, "none"):
+			input["projectId"] = nil
+		case toProject != "":
+			projectID := resolver.project(toProject)
+			resolver.report(plaintext, jsonOut)
+			input["projectId"] = projectID
+			input["teamId"] = nil
+		}
+		switch {
+		case strings.EqualFold(toTeam, "none"):
+			input["teamId"] = nil
+		case toTeam != "":
+			team := resolver.team(toTeam)
+			resolver.report(plaintext, jsonOut)
+			input["teamId"] = team.ID
+			input["projectId"] = nil
+		}
+		if detach {
+			for _, key := range []string{"projectId", "teamId"} {
+				if value, ok := input[key]; ok && value != nil {
+					output.Error("--detach cannot be combined with a target; use it alone or with 'none'", plaintext, jsonOut)
+					exit(1)
+				}
+				input[key] = nil
+			}
+		} else if leavesDocumentOrphaned(before, input) {
+			output.Error(fmt.Sprintf("This would leave %q with no parent; pass --detach to allow it", before.Title), plaintext, jsonOut)
+			exit(1)
+		}
+
+		doc, err := client.UpdateDocument(context.Background(), before.ID, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to move document: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "document", doc.ID, doc.Title, restoreInverse(before, input))
+
+		from, to := documentParents(before), documentParents(doc)
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"document": doc,
+				"from":     from,
+				"to":       to,
+			})
+		} else if plaintext {
+			fmt.Printf("Moved document: %s\n", doc.Title)
+			fmt.Printf("From: %s\n", formatDocumentParents(from))
+			fmt.Printf("To: %s\n", formatDocumentParents(to))
+		} else {
+			fmt.Printf("%s Moved document: %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgWhite, color.Bold).Sprint(doc.Title))
+			fmt.Printf("  From: %s\n", color.New(color.Faint).Sprint(formatDocumentParents(from)))
+			fmt.Printf("  To:   %s\n", color.New(color.FgCyan).Sprint(formatDocumentParents(to)))
+		}
+	},
+}
+
+func init() {
+	documentCmd.AddCommand(documentMoveCmd)
+
+	documentMoveCmd.Flags().String("to-project", "", "Project to move the document to (ID, slug, URL, or name), or 'none'")
+	documentMoveCmd.Flags().String("to-team", "", "Team to move the document to (key, name, or ID), or 'none'")
+	documentMoveCmd.Flags().Bool("detach", false, "Allow the document to be left with no project or team")
+	documentMoveCmd.MarkFlagsOneRequired("to-project", "to-team", "detach")
+	documentMoveCmd.MarkFlagsMutuallyExclusive("to-project", "to-team")
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestDocumentParents(t *testing.T) {
+	doc := &api.Document{
+		Project: &api.Project{ID: "p1", Name: "Alpha"},
+		Team:    &api.Team{ID: "t1", Key: "ENG", Name: "Engineering"},
+		Cycle:   &api.Cycle{ID: "c1", Number: 7},
+	}
+	if got, want := formatDocumentParents(documentParents(doc)), "project Alpha, team ENG, cycle #7"; got != want {
+		t.Errorf("formatDocumentParents = %q, want %q", got, want)
+	}
+	if got := formatDocumentParents(documentParents(&api.Document{})); got != "none" {
+		t.Errorf("no parents: got %q, want none", got)
+	}
+}
+
+func TestLeavesDocumentOrphaned(t *testing.T) {
+	inProject := &api.Document{Project: &api.Project{ID: "p1", Name: "Alpha"}}
+	inProjectAndIssue := &api.Document{
+		Project: &api.Project{ID: "p1", Name: "Alpha"},
+		Issue:   &api.Issue{ID: "i1", Identifier: "ENG-1"},
+	}
+	tests := []struct {
+		name  string
+		doc   *api.Document
+		input map[string]interface{}
+		want  bool
+	}{
+		{"move to team", inProject, map[string]interface{}{"teamId": "t1", "projectId": nil}, false},
+		{"move to project", inProject, map[string]interface{}{"projectId": "p2", "teamId": nil}, false},
+		{"clear only parent", inProject, map[string]interface{}{"projectId": nil}, true},
+		{"clear project and team", inProject, map[string]interface{}{"projectId": nil, "teamId": nil}, true},
+		{"issue parent remains", inProjectAndIssue, map[string]interface{}{"projectId": nil, "teamId": nil}, false},
+		{"clear team it isn't in", inProject, map[string]interface{}{"teamId": nil}, false},
+	}
+	for _, tt := range tests {
+		if got := leavesDocumentOrphaned(tt.doc, tt.input); got != tt.want {
+			t.Errorf("%s: leavesDocumentOrphaned = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
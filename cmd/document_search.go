@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+const (
+	// snippetMaxRunes bounds a snippet; longer lines are cut around the match
+	snippetMaxRunes = 160
+	// snippetLeadRunes is how much text is kept before the match when cutting
+	snippetLeadRunes = 50
+)
+
+// documentMatcher finds search terms in documents, case-insensitively
+type documentMatcher struct {
+	terms []*regexp.Regexp
+	any   *regexp.Regexp
+}
+
+// newDocumentMatcher splits query into whitespace-separated terms
+func newDocumentMatcher(query string) *documentMatcher {
+	m := &documentMatcher{}
+	var quoted []string
+	for _, term := range strings.Fields(query) {
+		q := regexp.QuoteMeta(term)
+		quoted = append(quoted, q)
+		m.terms = append(m.terms, regexp.MustCompile("(?i)"+q))
+	}
+	m.any = regexp.MustCompile("(?i)" + strings.Join(quoted, "|"))
+	return m
+}
+
+// matchesAll reports whether every term occurs in s
+func (m *documentMatcher) matchesAll(s string) bool {
+	for _, re := range m.terms {
+		if !re.MatchString(s) {
+			return false
+		}
+	}
+	return len(m.terms) > 0
+}
+
+// matches reports whether doc matches every term in the given place:
+// "title", "content", or "both" (the server's own match is trusted)
+func (m *documentMatcher) matches(doc api.Document, in string) bool {
+	switch in {
+	case "title":
+		return m.matchesAll(doc.Title)
+	case "content":
+		return m.matchesAll(doc.Content)
+	}
+	return true
+}
+
+// snippet returns the first line that contains a term: from the content
+// unless in is "title", falling back to the title when in is "both"
+func (m *documentMatcher) snippet(doc api.Document, in string) string {
+	if in != "title" {
+		for _, line := range strings.Split(doc.Content, "\n") {
+			if loc := m.any.FindStringIndex(line); loc != nil {
+				return snippetWindow(line, loc[0])
+			}
+		}
+	}
+	if in != "content" {
+		if loc := m.any.FindStringIndex(doc.Title); loc != nil {
+			return snippetWindow(doc.Title, loc[0])
+		}
+	}
+	return ""
+}
+
+// highlight wraps every term occurrence in s with mark
+func (m *documentMatcher) highlight(s string, mark func(string) string) string {
+	return m.any.ReplaceAllStringFunc(s, mark)
+}
+
+// snippetWindow trims line to snippetMaxRunes, keeping the match at byte
+// offset matchAt in view and marking cuts with an ellipsis
+func snippetWindow(line string, matchAt int) string {
+	trimmed := strings.TrimLeft(line, " \t")
+	matchAt -= len(line) - len(trimmed)
+	line = strings.TrimSpace(trimmed)
+
+	runes := []rune(line)
+	if len(runes) <= snippetMaxRunes {
+		return line
+	}
+	start := utf8.RuneCountInString(line[:max(matchAt, 0)]) - snippetLeadRunes
+	if start < 0 {
+		start = 0
+	}
+	end := start + snippetMaxRunes
+	if end > len(runes) {
+		end = len(runes)
+		start = end - snippetMaxRunes
+	}
+
+	snippet := strings.TrimSpace(string(runes[start:end]))
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+// applyDocumentMatches filters docs to those matching in the requested place
+// and, with snippets, fills in each document's snippet. Content is dropped
+// afterwards: it was only fetched for matching.
+func applyDocumentMatches(docs []api.Document, m *documentMatcher, in string, snippets bool) []api.Document {
+	kept := []api.Document{}
+	for _, doc := range docs {
+		if !m.matches(doc, in) {
+			continue
+		}
+		if snippets {
+			doc.Snippet = m.snippet(doc, in)
+		}
+		doc.Content = ""
+		kept = append(kept, doc)
+	}
+	return kept
+}
+
+// validateSearchIn checks the document search --in value
+func validateSearchIn(in string) error {
+	switch in {
+	case "title", "content", "both":
+		return nil
+	}
+	return fmt.Errorf("invalid --in value %q: use title, content, or both", in)
+}
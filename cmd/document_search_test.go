@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestDocumentMatcherSnippet(t *testing.T) {
+	doc := api.Document{
+		Title:   "Rate Limits",
+		Content: "# Overview\nNothing here.\n  Each API key gets 1500 REQUESTS per hour.\nMore requests later.",
+	}
+	m := newDocumentMatcher("requests hour")
+
+	if got, want := m.snippet(doc, "both"), "Each API key gets 1500 REQUESTS per hour."; got != want {
+		t.Errorf("snippet(both) = %q, want %q", got, want)
+	}
+	bold := func(s string) string { return "**" + s + "**" }
+	if got, want := m.highlight(m.snippet(doc, "both"), bold), "Each API key gets 1500 **REQUESTS** per **hour**."; got != want {
+		t.Errorf("highlight = %q, want %q", got, want)
+	}
+	if got := m.snippet(doc, "title"); got != "" {
+		t.Errorf("snippet(title) = %q, want none", got)
+	}
+	if got := newDocumentMatcher("rate").snippet(doc, "both"); got != "Rate Limits" {
+		t.Errorf("title fallback = %q", got)
+	}
+}
+
+func TestDocumentMatcherMatches(t *testing.T) {
+	doc := api.Document{Title: "Onboarding guide", Content: "Welcome to the TEAM"}
+	tests := []struct {
+		query, in string
+		want      bool
+	}{
+		{"onboarding", "title", true},
+		{"onboarding team", "title", false},
+		{"welcome team", "content", true},
+		{"onboarding", "content", false},
+		{"anything", "both", true},
+	}
+	for _, tt := range tests {
+		if got := newDocumentMatcher(tt.query).matches(doc, tt.in); got != tt.want {
+			t.Errorf("matches(%q, %s) = %v, want %v", tt.query, tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSnippetWindow(t *testing.T) {
+	line := strings.Repeat("a", 100) + " needle " + strings.Repeat("b", 200)
+	got := snippetWindow(line, 101)
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") || !strings.Contains(got, "needle") {
+		t.Errorf("snippetWindow = %q", got)
+	}
+	if short := snippetWindow("  short line ", 2); short != "short line" {
+		t.Errorf("short snippet = %q", short)
+	}
+}
+
+func TestApplyDocumentMatches(t *testing.T) {
+	docs := []api.Document{
+		{Title: "Spec", Content: "the spec body"},
+		{Title: "Notes", Content: "mentions spec once"},
+	}
+	got := applyDocumentMatches(docs, newDocumentMatcher("spec"), "title", true)
+	if len(got) != 1 || got[0].Title != "Spec" || got[0].Snippet != "Spec" || got[0].Content != "" {
+		t.Errorf("applyDocumentMatches = %+v", got)
+	}
+	if err := validateSearchIn("body"); err == nil {
+		t.Error("validateSearchIn accepted an unknown value")
+	}
+}
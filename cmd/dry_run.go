@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addDryRunFlag registers --dry-run on a create or update command
+func addDryRunFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("dry-run", false, "Show what would be sent, with the normalized description, without changing anything")
+}
+
+// printDryRun shows the input a mutation would have been sent with. The
+// prose field (e.g. "description") is printed in full after the others so
+// its normalized form can be checked line by line.
+func printDryRun(action string, input map[string]interface{}, proseField string, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"dryRun": true,
+			"action": action,
+			"input":  input,
+		})
+		return
+	}
+
+	output.Info("Would "+action+" (dry run, nothing was sent)", plaintext, jsonOut)
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		if key != proseField {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, _ := json.Marshal(input[key])
+		fmt.Printf("  %s: %s\n", key, value)
+	}
+
+	prose, ok := input[proseField].(string)
+	if !ok {
+		return
+	}
+	header := fmt.Sprintf("--- %s ---", proseField)
+	if !plaintext {
+		header = color.New(color.FgWhite, color.Faint).Sprint(header)
+	}
+	fmt.Println(header)
+	fmt.Print(prose)
+	if !strings.HasSuffix(prose, "\n") {
+		fmt.Println()
+	}
+}
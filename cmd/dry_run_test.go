@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestProseNormalizationDryRun(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Issue", `{"issue":`+mockFixture(t, "issue_get")+`}`)
+
+	notes := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(notes, []byte("Steps:  \r\n\t- open <b>settings</b>\r\n\r\n\r\n\r\n\r\nDone\r\n\r\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	r := runMocked(t, "issue", "create", "--title", "Fix settings", "--team", "ENG", "--description-file", notes, "--dry-run", "--json")
+	var got struct {
+		DryRun bool                   `json:"dryRun"`
+		Input  map[string]interface{} `json:"input"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil || r.Exit != 0 || !got.DryRun {
+		t.Fatalf("issue create --dry-run exited %d: %s (%v)", r.Exit, r.Stdout, err)
+	}
+	if want := "Steps:\n    - open <b>settings</b>\n\n\nDone\n"; got.Input["description"] != want {
+		t.Errorf("description = %q, want %q", got.Input["description"], want)
+	}
+	if !strings.Contains(r.Stderr, "--description-file contains raw HTML that Linear will strip: <b>") {
+		t.Errorf("no HTML warning on stderr: %q", r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "CreateIssue" {
+			t.Errorf("--dry-run sent CreateIssue")
+		}
+	}
+
+	r = runMocked(t, "issue", "comment", "create", "ENG-42", "--body", "LGTM  \r\n", "--dry-run", "--plaintext")
+	if r.Exit != 0 || !strings.HasPrefix(r.Stdout, "Would comment on ENG-42 (dry run, nothing was sent)\n") || !strings.HasSuffix(r.Stdout, "\n--- body ---\nLGTM\n") {
+		t.Errorf("comment create --dry-run exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	defer resetCommandFlags(rootCmd)
+	r = runMocked(t, "issue", "update", "ENG-42", "--description", "a\r\nb  ", "--dry-run", "--no-normalize", "--json")
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil || got.Input["description"] != "a\r\nb  " {
+		t.Errorf("--no-normalize sent %q (%v)", got.Input["description"], err)
+	}
+	for _, op := range s.Operations() {
+		if op == "CreateComment" || op == "UpdateIssue" {
+			t.Errorf("--dry-run sent %s", op)
+		}
+	}
+}
@@ -0,0 +1,459 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// explainValue is one accepted value of an enum and what it means
+type explainValue struct {
+	Value   string `json:"value"`
+	Meaning string `json:"meaning"`
+}
+
+// explainExample is a worked example: a filter and a command that uses it
+type explainExample struct {
+	Title   string                 `json:"title"`
+	Command string                 `json:"command"`
+	Filter  map[string]interface{} `json:"filter,omitempty"`
+}
+
+// explainTopic is one 'linear-cli explain' topic. Values are generated from
+// the tables the commands validate against, so help can't drift from
+// behavior; the meanings below only describe them.
+type explainTopic struct {
+	Name     string           `json:"topic"`
+	Summary  string           `json:"summary"`
+	Values   []explainValue   `json:"values,omitempty"`
+	Examples []explainExample `json:"examples,omitempty"`
+	Notes    []string         `json:"notes,omitempty"`
+}
+
+var stateTypeMeanings = []explainValue{
+	{"triage", "new issues waiting to be accepted into the team"},
+	{"backlog", "accepted but not planned"},
+	{"unstarted", "planned, work not begun (e.g. Todo)"},
+	{"started", "in progress or in review"},
+	{"completed", "done"},
+	{"canceled", "won't be done, including duplicates"},
+}
+
+var projectStateMeanings = []explainValue{
+	{"backlog", "an idea, not yet planned"},
+	{"planned", "scheduled, work not begun (project create's default)"},
+	{"started", "in progress"},
+	{"paused", "on hold"},
+	{"completed", "finished; hidden from project list unless --include-completed"},
+	{"canceled", "abandoned; hidden from project list unless --include-completed"},
+}
+
+var healthMeanings = []explainValue{
+	{"onTrack", "expected to hit its target"},
+	{"atRisk", "may miss its target"},
+	{"offTrack", "will miss its target without changes"},
+}
+
+var initiativeStatusMeanings = []explainValue{
+	{"Planned", "not started"},
+	{"Active", "in progress; the default for initiative list"},
+	{"Completed", "finished; hidden from initiative list unless --include-completed"},
+}
+
+// explainTopicNames lists the topics in the order 'explain' shows them
+var explainTopicNames = []string{
+	"priority",
+	"state-types",
+	"project-states",
+	"health",
+	"initiative-status",
+	"sort",
+	"time-expressions",
+	"issue-filter",
+	"project-filter",
+}
+
+// explainEnum lists keys in the order of meanings, with their meanings; keys
+// the table doesn't describe come last with no meaning
+func explainEnum(keys []string, meanings []explainValue) []explainValue {
+	present := map[string]bool{}
+	for _, k := range keys {
+		present[k] = true
+	}
+	var values []explainValue
+	described := map[string]bool{}
+	for _, m := range meanings {
+		if present[m.Value] {
+			values = append(values, m)
+			described[m.Value] = true
+		}
+	}
+	var rest []string
+	for _, k := range keys {
+		if !described[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+	for _, k := range rest {
+		values = append(values, explainValue{Value: k})
+	}
+	return values
+}
+
+// styleKeys returns the values a style table accepts
+func styleKeys(styles map[string]valueStyle) []string {
+	keys := make([]string, 0, len(styles))
+	for k := range styles {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// priorityValues lists the priorities priorityToString knows
+func priorityValues() []explainValue {
+	var values []explainValue
+	for p := 0; priorityToString(p) != "Unknown"; p++ {
+		values = append(values, explainValue{Value: fmt.Sprintf("%d", p), Meaning: priorityToString(p)})
+	}
+	return values
+}
+
+// filterExample builds an example whose command previews the issue filter
+func filterExample(title string, filter map[string]interface{}) explainExample {
+	data, _ := json.Marshal(filter)
+	return explainExample{
+		Title:   title,
+		Command: fmt.Sprintf("linear-cli view preview --filter-json '%s'", data),
+		Filter:  filter,
+	}
+}
+
+// projectFilterExample builds an example whose command saves the project
+// filter as a view; view preview only runs issue filters
+func projectFilterExample(title, viewName string, filter map[string]interface{}) explainExample {
+	data, _ := json.Marshal(filter)
+	return explainExample{
+		Title:   title,
+		Command: fmt.Sprintf("linear-cli view create --model project --name \"%s\" --filter-json '%s'", viewName, data),
+		Filter:  filter,
+	}
+}
+
+// mustIssueFilter builds an example filter with the same code as issue list
+func mustIssueFilter(opts issueFilterOptions) map[string]interface{} {
+	filter, err := buildIssueFilter(opts)
+	if err != nil {
+		panic(err)
+	}
+	return filter
+}
+
+// explainTopicByName builds a topic; now fixes the dates in its examples
+func explainTopicByName(name string, now time.Time) (*explainTopic, bool) {
+	switch name {
+	case "priority":
+		return &explainTopic{
+			Name:    name,
+			Summary: "Issue priority, as accepted by --priority",
+			Values:  priorityValues(),
+			Examples: []explainExample{
+				{Title: "Urgent open issues", Command: "linear-cli issue list --priority 1"},
+				{Title: "Raise an issue to high", Command: "linear-cli issue update ENG-123 --priority 2"},
+				filterExample("Urgent or high", map[string]interface{}{"priority": map[string]interface{}{"in": []int{1, 2}}}),
+			},
+			Notes: []string{"0 means no priority, so {\"priority\": {\"lte\": 2}} also matches unprioritized issues."},
+		}, true
+	case "state-types":
+		return &explainTopic{
+			Name:    name,
+			Summary: "Workflow state types. Every team names its own states, but each has one of these types.",
+			Values:  explainEnum(styleKeys(issueStateTypeStyles), stateTypeMeanings),
+			Examples: []explainExample{
+				filterExample("In progress, whatever the team calls it", map[string]interface{}{"state": map[string]interface{}{"type": map[string]interface{}{"eq": "started"}}}),
+				{Title: "Move matching issues to a milestone by state type", Command: "linear-cli project milestone assign MILESTONE-ID --state-type unstarted,started"},
+			},
+			Notes: []string{"issue list hides completed and canceled issues unless --include-completed or --state is given."},
+		}, true
+	case "project-states":
+		return &explainTopic{
+			Name:    name,
+			Summary: "Project states, as accepted by project create/update --state and project list --state",
+			Values:  explainEnum(styleKeys(projectStateStyles), projectStateMeanings),
+			Examples: []explainExample{
+				{Title: "Paused projects", Command: "linear-cli project list --state paused"},
+				{Title: "Start a project", Command: "linear-cli project update PROJECT-ID --state started"},
+			},
+		}, true
+	case "health":
+		var spellings []string
+		for k := range initiativeHealthValues {
+			spellings = append(spellings, k)
+		}
+		sort.Strings(spellings)
+		return &explainTopic{
+			Name:    name,
+			Summary: "Project, status update, and initiative health",
+			Values:  explainEnum(styleKeys(healthStyles), healthMeanings),
+			Examples: []explainExample{
+				{Title: "Post a status update", Command: "linear-cli project status create PROJECT-ID --body \"Blocked on review\" --health atRisk"},
+				{Title: "Initiatives at risk", Command: "linear-cli initiative list --health atRisk"},
+				projectFilterExample("At risk or off track", "At risk", map[string]interface{}{"health": map[string]interface{}{"in": riskHealthOrder}}),
+			},
+			Notes: []string{"initiative list --health also accepts " + strings.Join(spellings, ", ") + " in any case, with _, - or spaces."},
+		}, true
+	case "initiative-status":
+		return &explainTopic{
+			Name:    name,
+			Summary: "Initiative status, as accepted by initiative list/create/update --status",
+			Values:  explainEnum(styleKeys(initiativeStatusStyles), initiativeStatusMeanings),
+			Examples: []explainExample{
+				{Title: "Planned initiatives", Command: "linear-cli initiative list --status Planned"},
+				{Title: "Close an initiative", Command: "linear-cli initiative update INITIATIVE-ID --status Completed"},
+			},
+		}, true
+	case "sort":
+		values := make([]explainValue, len(sortOptions))
+		for i, opt := range sortOptions {
+			meaning := opt.Meaning
+			if len(opt.Aliases) > 0 {
+				meaning += " (also " + strings.Join(opt.Aliases, ", ") + ")"
+			}
+			values[i] = explainValue{Value: opt.Name, Meaning: meaning}
+		}
+		for _, key := range issueSortKeys {
+			values = append(values, explainValue{Value: key.Name, Meaning: key.Meaning + " (issue lists only, sorted after fetching)"})
+		}
+		return &explainTopic{
+			Name:    name,
+			Summary: "--sort values of the list commands",
+			Values:  values,
+			Examples: []explainExample{
+				{Title: "Recently updated issues", Command: "linear-cli issue list --sort updated"},
+				{Title: "Every open issue, most urgent first", Command: "linear-cli issue list --team ENG --sort priority --all"},
+				{Title: "A project's issues, soonest due last", Command: "linear-cli project issues PROJECT-ID --sort due --desc"},
+			},
+			Notes: []string{"issue list, project issues, and view run take --asc/--desc; client-side sorts only order the fetched issues unless --all is given."},
+		}, true
+	case "time-expressions":
+		var values []explainValue
+		for _, unit := range utils.TimeExpressionUnits {
+			values = append(values, explainValue{Value: "N_" + unit + "s_ago", Meaning: "N " + unit + "s before now (\"1_" + unit + "_ago\" also works)"})
+		}
+		values = append(values,
+			explainValue{Value: "YYYY-MM-DD", Meaning: "midnight UTC on that date"},
+			explainValue{Value: "RFC3339", Meaning: "an exact timestamp, e.g. 2024-01-15T10:00:00-05:00"},
+			explainValue{Value: "all_time", Meaning: "no time filter"},
+		)
+		var examples []explainExample
+		for _, expr := range []string{"3_weeks_ago", "all_time"} {
+			resolved, _ := utils.ParseTimeExpression(expr)
+			if resolved == "" {
+				resolved = "no filter"
+			}
+			examples = append(examples, explainExample{
+				Title:   fmt.Sprintf("%s (now: %s)", expr, resolved),
+				Command: "linear-cli issue list --newer-than " + expr,
+			})
+		}
+		return &explainTopic{
+			Name:     name,
+			Summary:  "Time expressions for --newer-than, --older-than, --active-since, and --filter-newer-than",
+			Values:   values,
+			Examples: examples,
+			Notes: []string{
+				"Spaces or dashes can stand in for underscores (\"3 weeks ago\"), and case is ignored.",
+				"Lists default to 6_months_ago; future dates and negative amounts are rejected.",
+			},
+		}, true
+	case "issue-filter":
+		breached := map[string]interface{}{}
+		applyBreachedFilter(breached, now)
+		notSnoozed := map[string]interface{}{}
+		applySnoozedFilter(notSnoozed, now)
+		return &explainTopic{
+			Name:    name,
+			Summary: "IssueFilter JSON for --filter-json (view create/update/preview). These are the filters the issue list flags build.",
+			Examples: []explainExample{
+				filterExample("My open issues (issue list --assignee me)", mustIssueFilter(issueFilterOptions{Assignee: "me", Priority: -1})),
+				filterExample("Urgent issues in one team, any state (--team ENG --priority 1 --include-completed)", mustIssueFilter(issueFilterOptions{Team: "ENG", Priority: 1, IncludeCompleted: true})),
+				filterExample("Several teams by key (--team ENG,OPS matches their IDs)", map[string]interface{}{"team": map[string]interface{}{"key": map[string]interface{}{"in": []string{"ENG", "OPS"}}}}),
+				filterExample("Labeled Bug or Regression", mustIssueFilter(issueFilterOptions{Labels: []string{"Bug", "Regression"}, Priority: -1, IncludeCompleted: true})),
+				filterExample("In a named state (--state \"In Review\")", mustIssueFilter(issueFilterOptions{State: "In Review", Priority: -1})),
+				filterExample("Open and past due (--breached)", breached),
+				filterExample("Not currently snoozed (issue list's default)", notSnoozed),
+				filterExample("Top-level issues only (--top-level)", map[string]interface{}{"parent": map[string]interface{}{"null": true}}),
+				filterExample("Either condition", map[string]interface{}{"or": []interface{}{
+					map[string]interface{}{"labels": map[string]interface{}{"some": map[string]interface{}{"name": map[string]interface{}{"eq": "Bug"}}}},
+					map[string]interface{}{"priority": map[string]interface{}{"eq": 1}},
+				}}),
+			},
+			Notes: []string{
+				"Comparators: eq, neq, in, nin, lt, lte, gt, gte, null, contains, containsIgnoreCase, eqIgnoreCase.",
+				"Combine conditions with and/or arrays; relations (labels, subscribers) use some/every/none.",
+				"See 'explain state-types', 'explain priority', and 'explain time-expressions' for values.",
+			},
+		}, true
+	case "project-filter":
+		return &explainTopic{
+			Name:    name,
+			Summary: "ProjectFilter JSON for --filter-json with --model project",
+			Examples: []explainExample{
+				projectFilterExample("Active projects (project list's default)", "Active projects", map[string]interface{}{"state": map[string]interface{}{"nin": []string{"completed", "canceled"}}}),
+				projectFilterExample("At risk or off track", "At risk", map[string]interface{}{"health": map[string]interface{}{"in": riskHealthOrder}}),
+				projectFilterExample("Led by me", "My projects", map[string]interface{}{"lead": map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}}),
+				projectFilterExample("Accessible to a team (project list --team)", "Team projects", map[string]interface{}{"accessibleTeams": map[string]interface{}{"some": map[string]interface{}{"id": map[string]interface{}{"eq": "TEAM-UUID"}}}}),
+				projectFilterExample("Due by year end", "Due this year", map[string]interface{}{"targetDate": map[string]interface{}{"lte": fmt.Sprintf("%d-12-31", now.Year())}}),
+			},
+			Notes: []string{"See 'explain project-states' and 'explain health' for values."},
+		}, true
+	}
+	return nil, false
+}
+
+var explainCmd = &cobra.Command{
+	Use:   "explain [TOPIC]",
+	Short: "Explain enum values and filter JSON, offline",
+	Long: `Show the valid values of an enum, what they mean, and examples to copy.
+Works without authentication or network access. Without a topic, lists the
+topics.
+
+Topics: priority, state-types, project-states, health, initiative-status,
+sort, time-expressions, issue-filter, project-filter
+
+Examples:
+  linear-cli explain
+  linear-cli explain priority
+  linear-cli explain issue-filter --json`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		now := time.Now()
+
+		if len(args) == 0 {
+			renderExplainTopics(now, plaintext, jsonOut)
+			return
+		}
+
+		topic, ok := explainTopicByName(strings.ToLower(args[0]), now)
+		if !ok {
+			msg := fmt.Sprintf("Unknown topic '%s'. Topics: %s", args[0], strings.Join(explainTopicNames, ", "))
+			if suggestions := utils.Suggest(args[0], explainTopicNames, 1); len(suggestions) > 0 {
+				msg = fmt.Sprintf("Unknown topic '%s'; did you mean '%s'? Topics: %s", args[0], suggestions[0], strings.Join(explainTopicNames, ", "))
+			}
+			output.Error(msg, plaintext, jsonOut)
+			exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(topic)
+			return
+		}
+		renderExplainTopic(topic, plaintext)
+	},
+}
+
+// renderExplainTopics lists the topics with their summaries
+func renderExplainTopics(now time.Time, plaintext, jsonOut bool) {
+	topics := make([]explainTopic, len(explainTopicNames))
+	for i, name := range explainTopicNames {
+		t, _ := explainTopicByName(name, now)
+		topics[i] = explainTopic{Name: t.Name, Summary: t.Summary}
+	}
+	if jsonOut {
+		output.JSON(topics)
+		return
+	}
+	if plaintext {
+		fmt.Println("# Topics")
+		for _, t := range topics {
+			fmt.Printf("- **%s**: %s\n", t.Name, t.Summary)
+		}
+		return
+	}
+	for _, t := range topics {
+		fmt.Printf("  %-18s %s\n", color.New(color.FgCyan, color.Bold).Sprint(t.Name), t.Summary)
+	}
+	fmt.Printf("\nRun 'linear-cli explain TOPIC' for values and examples\n")
+}
+
+// renderExplainTopic prints a topic as markdown (plaintext) or colored text
+func renderExplainTopic(t *explainTopic, plaintext bool) {
+	heading := func(s string) string {
+		if plaintext {
+			return "## " + s
+		}
+		return color.New(color.FgCyan, color.Bold).Sprint(s)
+	}
+
+	if plaintext {
+		fmt.Printf("# %s\n\n%s\n", t.Name, t.Summary)
+	} else {
+		fmt.Printf("%s\n%s\n", color.New(color.Bold).Sprint(t.Name), t.Summary)
+	}
+
+	if len(t.Values) > 0 {
+		fmt.Printf("\n%s\n\n", heading("Values"))
+		width := 0
+		for _, v := range t.Values {
+			if len(v.Value) > width {
+				width = len(v.Value)
+			}
+		}
+		for _, v := range t.Values {
+			if plaintext {
+				fmt.Printf("- `%s`: %s\n", v.Value, v.Meaning)
+			} else {
+				fmt.Printf("  %s  %s\n", color.New(color.FgGreen).Sprintf("%-*s", width, v.Value), v.Meaning)
+			}
+		}
+	}
+
+	if len(t.Examples) > 0 {
+		fmt.Printf("\n%s\n", heading("Examples"))
+		for _, ex := range t.Examples {
+			if plaintext {
+				fmt.Printf("\n### %s\n\n```bash\n%s\n```\n", ex.Title, ex.Command)
+			} else {
+				fmt.Printf("\n  %s\n  %s\n", color.New(color.Bold).Sprint(ex.Title), ex.Command)
+			}
+			if ex.Filter != nil {
+				data, _ := json.MarshalIndent(ex.Filter, "", "  ")
+				if plaintext {
+					fmt.Printf("\n```json\n%s\n```\n", data)
+				} else {
+					fmt.Printf("%s\n", color.New(color.Faint).Sprint(indentLines(string(data), "  ")))
+				}
+			}
+		}
+	}
+
+	if len(t.Notes) > 0 {
+		fmt.Printf("\n%s\n\n", heading("Notes"))
+		for _, n := range t.Notes {
+			if plaintext {
+				fmt.Printf("- %s\n", n)
+			} else {
+				fmt.Printf("  %s\n", n)
+			}
+		}
+	}
+}
+
+// indentLines prefixes every line of s
+func indentLines(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+// explainMeaning returns the meaning a topic gives value, or "" if it has none
+func explainMeaning(t *testing.T, topic, value string) string {
+	t.Helper()
+	tp, ok := explainTopicByName(topic, time.Now())
+	if !ok {
+		t.Fatalf("missing explain topic %s", topic)
+	}
+	for _, v := range tp.Values {
+		if v.Value == value {
+			return v.Meaning
+		}
+	}
+	return ""
+}
+
+// TestExplainCoversValidationTables checks that every value the commands
+// validate against is explained, so a value added to a table without a
+// meaning fails here rather than showing up blank in help
+func TestExplainCoversValidationTables(t *testing.T) {
+	tables := map[string][]string{
+		"state-types":       styleKeys(issueStateTypeStyles),
+		"project-states":    styleKeys(projectStateStyles),
+		"health":            styleKeys(healthStyles),
+		"initiative-status": styleKeys(initiativeStatusStyles),
+	}
+	for k := range stateTypeOrder {
+		tables["state-types"] = append(tables["state-types"], k)
+	}
+	for _, v := range initiativeHealthValues {
+		tables["health"] = append(tables["health"], v)
+	}
+	for _, opt := range sortOptions {
+		tables["sort"] = append(tables["sort"], opt.Name)
+	}
+	for _, key := range issueSortKeys {
+		tables["sort"] = append(tables["sort"], key.Name)
+	}
+	for p := 0; priorityToString(p) != "Unknown"; p++ {
+		tables["priority"] = append(tables["priority"], fmt.Sprintf("%d", p))
+	}
+	for _, unit := range utils.TimeExpressionUnits {
+		tables["time-expressions"] = append(tables["time-expressions"], "N_"+unit+"s_ago")
+	}
+
+	for topic, values := range tables {
+		for _, v := range values {
+			if explainMeaning(t, topic, v) == "" {
+				t.Errorf("explain %s has no meaning for %q", topic, v)
+			}
+		}
+	}
+
+	for _, name := range explainTopicNames {
+		if _, ok := explainTopicByName(name, time.Now()); !ok {
+			t.Errorf("topic %s is listed but not defined", name)
+		}
+	}
+}
+
+func TestExplainFilterExamples(t *testing.T) {
+	for _, topic := range []string{"issue-filter", "project-filter"} {
+		tp, _ := explainTopicByName(topic, time.Now())
+		if len(tp.Examples) == 0 {
+			t.Fatalf("explain %s has no examples", topic)
+		}
+		for _, ex := range tp.Examples {
+			if ex.Filter == nil {
+				t.Errorf("%s example %q has no filter", topic, ex.Title)
+				continue
+			}
+			data, err := json.Marshal(ex.Filter)
+			if err != nil {
+				t.Errorf("%s example %q: %v", topic, ex.Title, err)
+				continue
+			}
+			if !strings.Contains(ex.Command, "'"+string(data)+"'") {
+				t.Errorf("%s example %q command doesn't carry its filter: %s", topic, ex.Title, ex.Command)
+			}
+		}
+	}
+}
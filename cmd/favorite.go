@@ -3,13 +3,13 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"sort"
 	"strings"
 
 	"github.com/fatih/color"
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -45,7 +45,7 @@ var favoriteListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -56,8 +56,9 @@ var favoriteListCmd = &cobra.Command{
 		favorites, err := client.GetFavorites(context.Background(), limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, favorites.PageInfo, len(favorites.Nodes))
 
 		if len(favorites.Nodes) == 0 {
 			output.Info("No favorites found", plaintext, jsonOut)
@@ -219,60 +220,6 @@ func printFavoriteItem(f api.Favorite, indent string) {
 		color.New(color.FgWhite, color.Faint).Sprint(f.ID))
 }
 
-func getTypeColor(favType string) *color.Color {
-	switch favType {
-	case "issue":
-		return color.New(color.FgCyan)
-	case "project":
-		return color.New(color.FgMagenta)
-	case "cycle":
-		return color.New(color.FgBlue)
-	case "customView", "predefinedViewIssues", "predefinedViewProjects":
-		return color.New(color.FgGreen)
-	case "document":
-		return color.New(color.FgWhite)
-	case "initiative":
-		return color.New(color.FgRed)
-	case "label", "projectLabel":
-		return color.New(color.FgYellow)
-	case "user":
-		return color.New(color.FgCyan)
-	case "folder":
-		return color.New(color.FgYellow)
-	case "pullRequest":
-		return color.New(color.FgMagenta)
-	default:
-		return color.New(color.FgWhite)
-	}
-}
-
-func getTypeIcon(favType string) string {
-	switch favType {
-	case "issue":
-		return "📋"
-	case "project":
-		return "📊"
-	case "cycle":
-		return "🔄"
-	case "customView", "predefinedViewIssues", "predefinedViewProjects":
-		return "👁"
-	case "document":
-		return "📄"
-	case "initiative":
-		return "🎯"
-	case "label", "projectLabel":
-		return "🏷"
-	case "user":
-		return "👤"
-	case "folder":
-		return "📁"
-	case "pullRequest":
-		return "🔀"
-	default:
-		return "⭐"
-	}
-}
-
 func getFavoriteDetail(f *api.Favorite) string {
 	switch {
 	case f.Issue != nil:
@@ -329,7 +276,7 @@ Examples:
   linear-cli favorite add --initiative INIT-ID --initiative-tab projects
   linear-cli favorite add --label LABEL-ID
   linear-cli favorite add --project-label PROJ-LABEL-ID
-  linear-cli favorite add --user USER-ID
+  linear-cli favorite add --user ada@example.com
   linear-cli favorite add --predefined-view-type myIssues
   linear-cli favorite add --predefined-view-type activeIssues --predefined-view-team TEAM-ID
   linear-cli favorite add --folder "My Folder"
@@ -341,7 +288,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -402,28 +349,23 @@ Examples:
 
 		if entityCount == 0 {
 			output.Error("Must specify exactly one entity type: --issue, --project, --view, --cycle, --document, --initiative, --label, --project-label, --user, --predefined-view-type, or --folder", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 		if entityCount > 1 {
 			output.Error("Specify only one entity type at a time", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := make(map[string]interface{})
 
-		// Resolve issue identifier to ID if needed
+		// Resolve the issue identifier, UUID, or URL to its ID
 		if issueID != "" {
-			// Check if it looks like an identifier (contains hyphen, not a UUID)
-			if strings.Contains(issueID, "-") && !isUUID(issueID) {
-				issue, err := client.GetIssue(context.Background(), issueID)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to find issue %s: %v", issueID, err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["issueId"] = issue.ID
-			} else {
-				input["issueId"] = issueID
+			issue, err := resolveIssueRef(context.Background(), client, issueID)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
 			}
+			input["issueId"] = issue.ID
 		}
 
 		if projectID != "" {
@@ -447,6 +389,14 @@ Examples:
 		if projectLabelID != "" {
 			input["projectLabelId"] = projectLabelID
 		}
+		if userID != "" && !isUUID(userID) {
+			user, err := newUserLookup(client).find(userID)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut, err)
+				exit(1)
+			}
+			userID = user.ID
+		}
 		if userID != "" {
 			input["userId"] = userID
 		}
@@ -475,8 +425,9 @@ Examples:
 		favorite, err := client.CreateFavorite(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create favorite: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "favorite", favorite.ID, "", &journal.Inverse{Action: "delete"})
 
 		if jsonOut {
 			output.JSON(favorite)
@@ -533,7 +484,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -555,14 +506,15 @@ Examples:
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use --sort-order, --parent, or --folder-name.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		favorite, err := client.UpdateFavorite(context.Background(), favoriteID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update favorite: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "favorite", favorite.ID, "", nil)
 
 		if jsonOut {
 			output.JSON(favorite)
@@ -601,7 +553,7 @@ var favoriteRemoveCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -609,8 +561,9 @@ var favoriteRemoveCmd = &cobra.Command{
 		err = client.DeleteFavorite(context.Background(), favoriteID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to remove favorite: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "favorite", favoriteID, "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{"success": true, "id": favoriteID, "action": "deleted"})
@@ -638,7 +591,7 @@ var favoriteGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -646,7 +599,7 @@ var favoriteGetCmd = &cobra.Command{
 		favorite, err := client.GetFavorite(context.Background(), favoriteID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get favorite: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -680,7 +633,7 @@ var favoriteGetCmd = &cobra.Command{
 			if favorite.URL != "" {
 				fmt.Printf("- **URL**: %s\n", favorite.URL)
 			}
-			fmt.Printf("- **Created**: %s\n", favorite.CreatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(favorite.CreatedAt, output.DateTime))
 			if favorite.Parent != nil {
 				fmt.Printf("- **Parent**: %s (%s)\n", favorite.Parent.Title, favorite.Parent.ID)
 			}
@@ -763,7 +716,7 @@ var favoriteGetCmd = &cobra.Command{
 			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("URL:"), favorite.URL)
 		}
 
-		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), favorite.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), output.FormatTime(favorite.CreatedAt, output.DateTime))
 
 		if favorite.Parent != nil {
 			fmt.Printf("%s %s (%s)\n",
@@ -869,7 +822,7 @@ func init() {
 	favoriteAddCmd.Flags().String("initiative", "", "Initiative ID")
 	favoriteAddCmd.Flags().String("label", "", "Issue label ID")
 	favoriteAddCmd.Flags().String("project-label", "", "Project label ID")
-	favoriteAddCmd.Flags().String("user", "", "User ID")
+	favoriteAddCmd.Flags().String("user", "", "User ID, email, or name")
 	favoriteAddCmd.Flags().String("folder", "", "Create a folder with this name")
 	favoriteAddCmd.Flags().String("predefined-view-type", "", "Predefined view type (e.g., myIssues, activeIssues)")
 	favoriteAddCmd.Flags().String("predefined-view-team", "", "Team ID for predefined view")
@@ -1,20 +1,45 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
 )
 
+// maxRemoteContentSize caps how much is read from an https:// content URL
+const maxRemoteContentSize = 1 << 20
+
+// contentHTTPClient fetches https:// content URLs
+var contentHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
 // readContentFromFile reads the entire content of a file and returns it as a string.
-// If path is "-", it reads from stdin.
+// If path is "-", it reads from stdin; if it is an https:// URL, it is fetched.
+// A leading UTF-8 BOM is dropped and CRLF line endings become LF.
 func readContentFromFile(path string) (string, error) {
 	var data []byte
 	var err error
 
-	if path == "-" {
+	switch {
+	case path == "-":
 		data, err = io.ReadAll(os.Stdin)
-	} else {
+	case strings.HasPrefix(path, "https://"):
+		data, err = fetchContentURL(path)
+		if err != nil {
+			return "", err
+		}
+	case strings.HasPrefix(path, "http://"):
+		return "", fmt.Errorf("refusing to fetch '%s': only https:// URLs are supported", path)
+	default:
 		data, err = os.ReadFile(path)
 	}
 
@@ -22,13 +47,51 @@ func readContentFromFile(path string) (string, error) {
 		return "", fmt.Errorf("failed to read file '%s': %w", path, err)
 	}
 
-	return string(data), nil
+	return normalizeContent(string(data)), nil
+}
+
+// fetchContentURL downloads url, failing on non-2xx responses and bodies
+// larger than maxRemoteContentSize
+func fetchContentURL(url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(context.Background(), "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL '%s': %w", url, err)
+	}
+
+	resp, err := contentHTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch '%s': %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("failed to fetch '%s': HTTP %d", url, resp.StatusCode)
+	}
+	if resp.ContentLength > maxRemoteContentSize {
+		return nil, fmt.Errorf("content at '%s' is %d bytes, over the %d byte limit", url, resp.ContentLength, maxRemoteContentSize)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteContentSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read '%s': %w", url, err)
+	}
+	if len(data) > maxRemoteContentSize {
+		return nil, fmt.Errorf("content at '%s' is over the %d byte limit", url, maxRemoteContentSize)
+	}
+	return data, nil
+}
+
+// normalizeContent strips a UTF-8 BOM and converts CRLF line endings to LF
+func normalizeContent(s string) string {
+	s = strings.TrimPrefix(s, "\ufeff")
+	return strings.ReplaceAll(s, "\r\n", "\n")
 }
 
 // resolveBodyFromFlags resolves the text content from either a direct string flag or a file flag.
 // flagName is the name of the direct text flag (e.g. "body", "description", "content").
 // fileFlagName is the name of the file flag (e.g. "body-file", "description-file", "content-file").
-// Returns the resolved text and any error.
+// The file flag accepts a path, "-" for stdin, or an https:// URL.
+// Returns the resolved text, passed through prepareProse, and any error.
 func resolveBodyFromFlags(flagValue string, flagChanged bool, filePath string, flagName string, fileFlagName string) (string, error) {
 	if flagChanged && filePath != "" {
 		return "", fmt.Errorf("cannot use both --%s and --%s", flagName, fileFlagName)
@@ -39,8 +102,74 @@ func resolveBodyFromFlags(flagValue string, flagChanged bool, filePath string, f
 		if err != nil {
 			return "", err
 		}
-		return content, nil
+		return prepareProse(content, fileFlagName), nil
+	}
+
+	return prepareProse(flagValue, flagName), nil
+}
+
+// prepareProse normalizes prose for Linear (see utils.NormalizeMarkdown)
+// unless --no-normalize is set, and warns on stderr about raw HTML, which
+// Linear strips. flagName names the flag in the warning.
+func prepareProse(text string, flagName string) string {
+	if text == "" {
+		return text
+	}
+	if tags := utils.DetectHTML(text); len(tags) > 0 {
+		fmt.Fprintf(os.Stderr, "Warning: --%s contains raw HTML that Linear will strip: %s\n", flagName, strings.Join(tags, ", "))
 	}
+	if viper.GetBool("no_normalize") {
+		return text
+	}
+	return utils.NormalizeMarkdown(text)
+}
+
+// proseFromFlags resolves the prose flag name (e.g. "description") and its
+// name-file sibling on cmd. ok is false when neither flag was given.
+func proseFromFlags(cmd *cobra.Command, name string) (text string, ok bool, err error) {
+	filePath, _ := cmd.Flags().GetString(name + "-file")
+	changed := cmd.Flags().Changed(name)
+	if !changed && filePath == "" {
+		return "", false, nil
+	}
+	value, _ := cmd.Flags().GetString(name)
+	text, err = resolveBodyFromFlags(value, changed, filePath, name, name+"-file")
+	return text, err == nil, err
+}
 
-	return flagValue, nil
+// stdinFlags lists the flags given on cmd that read from stdin ("-")
+func stdinFlags(cmd *cobra.Command) []string {
+	var names []string
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if strings.HasSuffix(f.Name, "-file") && f.Value.String() == "-" {
+			names = append(names, "--"+f.Name)
+		}
+	})
+	return names
+}
+
+// routeStdin runs before every command. It applies --stdin-as by pointing
+// the named prose flag's -file sibling at stdin, then makes sure no more
+// than one flag reads stdin, since the first would leave nothing for the rest.
+func routeStdin(cmd *cobra.Command) error {
+	if target, _ := cmd.Flags().GetString("stdin-as"); target != "" {
+		fileFlag := target + "-file"
+		if cmd.Flags().Lookup(target) == nil || cmd.Flags().Lookup(fileFlag) == nil {
+			return fmt.Errorf("--stdin-as %s: '%s' has no --%s flag", target, cmd.CommandPath(), target)
+		}
+		if cmd.Flags().Changed(target) || cmd.Flags().Changed(fileFlag) {
+			return fmt.Errorf("--stdin-as %s cannot be combined with --%s or --%s", target, target, fileFlag)
+		}
+		if stdinIsTerminal() {
+			return fmt.Errorf("--stdin-as %s needs piped input, but stdin is a terminal", target)
+		}
+		if err := cmd.Flags().Set(fileFlag, "-"); err != nil {
+			return err
+		}
+	}
+
+	if names := stdinFlags(cmd); len(names) > 1 {
+		return fmt.Errorf("only one flag can read stdin, but %s are all set to -", strings.Join(names, ", "))
+	}
+	return nil
 }
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// withContentServer points contentHTTPClient at a TLS test server
+func withContentServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewTLSServer(handler)
+	orig := contentHTTPClient
+	contentHTTPClient = srv.Client()
+	t.Cleanup(func() {
+		contentHTTPClient = orig
+		srv.Close()
+	})
+	return srv
+}
+
+func TestReadContentFromURL(t *testing.T) {
+	srv := withContentServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/doc.md":
+			w.Write([]byte("\ufeff# Title\r\n\r\nBody\r\n"))
+		case "/big.md":
+			w.Write([]byte(strings.Repeat("x", maxRemoteContentSize+1)))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	got, err := readContentFromFile(srv.URL + "/doc.md")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "# Title\n\nBody\n" {
+		t.Errorf("content = %q", got)
+	}
+
+	if _, err := readContentFromFile(srv.URL + "/missing.md"); err == nil || !strings.Contains(err.Error(), "HTTP 404") {
+		t.Errorf("expected HTTP 404 error, got %v", err)
+	}
+
+	if _, err := readContentFromFile(srv.URL + "/big.md"); err == nil || !strings.Contains(err.Error(), "byte limit") {
+		t.Errorf("expected size limit error, got %v", err)
+	}
+}
+
+func TestReadContentFromPlainHTTPRejected(t *testing.T) {
+	if _, err := readContentFromFile("http://example.com/doc.md"); err == nil || !strings.Contains(err.Error(), "https://") {
+		t.Errorf("expected https-only error, got %v", err)
+	}
+}
+
+func TestReadContentFromFileStripsBOM(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(path, []byte("\ufeffline one\r\nline two"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := readContentFromFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "line one\nline two" {
+		t.Errorf("content = %q", got)
+	}
+}
+
+func TestResolveBodyFromFlags(t *testing.T) {
+	if _, err := resolveBodyFromFlags("inline", true, "notes.md", "body", "body-file"); err == nil || err.Error() != "cannot use both --body and --body-file" {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+
+	got, err := resolveBodyFromFlags("inline\r\nvalue  \r\n", true, "", "body", "body-file")
+	if err != nil || got != "inline\nvalue\n" {
+		t.Errorf("inline value should be normalized, got %q, %v", got, err)
+	}
+
+	viper.Set("no_normalize", true)
+	defer viper.Set("no_normalize", false)
+	got, err = resolveBodyFromFlags("inline\r\n", true, "", "body", "body-file")
+	if err != nil || got != "inline\r\n" {
+		t.Errorf("with --no-normalize the inline value should pass through unchanged, got %q, %v", got, err)
+	}
+}
+
+// withStdin points os.Stdin at a pipe holding content
+func withStdin(t *testing.T, content string) {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte(content))
+	w.Close()
+	orig := os.Stdin
+	os.Stdin = r
+	t.Cleanup(func() {
+		os.Stdin = orig
+		r.Close()
+	})
+}
+
+// parseCommand finds args' command under rootCmd and parses the remaining flags
+func parseCommand(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	resetCommandFlags(rootCmd)
+	c, rest, err := rootCmd.Find(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.ParseFlags(rest); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestProseFlagsReadFileAndStdin(t *testing.T) {
+	defer resetCommandFlags(rootCmd)
+	path := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(path, []byte("from file\r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var walk func(c *cobra.Command)
+	covered := 0
+	walk = func(c *cobra.Command) {
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+		for _, name := range []string{"description", "body", "content"} {
+			if c.LocalFlags().Lookup(name) == nil {
+				continue
+			}
+			if c.LocalFlags().Lookup(name+"-file") == nil {
+				t.Errorf("%s has --%s but no --%s-file", c.CommandPath(), name, name)
+				continue
+			}
+			covered++
+			args := strings.Fields(strings.TrimPrefix(c.CommandPath(), "linear-cli "))
+			t.Run(strings.Join(args, "_")+"_"+name, func(t *testing.T) {
+				cases := []struct {
+					flags []string
+					want  string
+				}{
+					{[]string{"--" + name + "-file", path}, "from file\n"},
+					{[]string{"--" + name + "-file", "-"}, "from stdin\n"},
+					{[]string{"--stdin-as", name}, "from stdin\n"},
+				}
+				for _, tc := range cases {
+					withStdin(t, "from stdin\r\n")
+					cmd := parseCommand(t, append(args, tc.flags...)...)
+					if err := routeStdin(cmd); err != nil {
+						t.Fatalf("%v: %v", tc.flags, err)
+					}
+					got, ok, err := proseFromFlags(cmd, name)
+					if err != nil || !ok || got != tc.want {
+						t.Errorf("%v: got %q, %v, %v; want %q", tc.flags, got, ok, err, tc.want)
+					}
+				}
+			})
+		}
+	}
+	walk(rootCmd)
+	if covered < 20 {
+		t.Errorf("only %d prose flags found; command tree not walked?", covered)
+	}
+}
+
+func TestRouteStdinRejectsConflicts(t *testing.T) {
+	defer resetCommandFlags(rootCmd)
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"project", "create", "--description-file", "-", "--milestones-file", "-"}, "only one flag can read stdin"},
+		{[]string{"template", "create", "--stdin-as", "description", "--data-file", "-"}, "only one flag can read stdin"},
+		{[]string{"issue", "create", "--stdin-as", "description", "--description", "x"}, "cannot be combined"},
+		{[]string{"issue", "list", "--stdin-as", "description"}, "has no --description flag"},
+		{[]string{"issue", "comment", "create", "--stdin-as", "description"}, "has no --description flag"},
+	}
+	for _, tt := range tests {
+		withStdin(t, "piped")
+		err := routeStdin(parseCommand(t, tt.args...))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: error = %v, want %q", tt.args, err, tt.want)
+		}
+	}
+}
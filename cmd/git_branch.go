@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultBranchPattern finds an issue identifier such as rob-123 in a branch
+// name; the first capture group (or the whole match) is the identifier
+const defaultBranchPattern = `(?i)([a-z]+-\d+)`
+
+// branchNameMaxLen caps branch names built from issue titles
+const branchNameMaxLen = 60
+
+// gitRunner runs a git subcommand and returns its trimmed stdout. Tests swap
+// in a fake so they don't need a real repository.
+type gitRunner func(args ...string) (string, error)
+
+var runGit gitRunner = execGit
+
+// execGit runs git from the working directory
+func execGit(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	c := exec.Command("git", args...)
+	c.Stdout = &stdout
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return "", fmt.Errorf("git is not installed or not on PATH")
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", args[0], msg)
+		}
+		return "", fmt.Errorf("git %s: %w", args[0], err)
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// issueFromBranch extracts an issue identifier from the current git branch
+func issueFromBranch(run gitRunner, pattern string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid branch pattern %q: %v", pattern, err)
+	}
+	branch, err := run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("cannot read the current git branch: %w", err)
+	}
+	if branch == "HEAD" {
+		return "", fmt.Errorf("HEAD is detached, so there is no branch to read an issue from; pass the issue ID instead")
+	}
+
+	m := re.FindStringSubmatch(branch)
+	if m == nil {
+		return "", fmt.Errorf("no issue identifier in branch %q (pattern %s); pass the issue ID instead", branch, pattern)
+	}
+	id := m[0]
+	if len(m) > 1 && m[1] != "" {
+		id = m[1]
+	}
+	return strings.ToUpper(id), nil
+}
+
+// issueArg returns the issue reference a command should act on: its argument,
+// or with --from-branch the identifier in the current git branch
+func issueArg(cmd *cobra.Command, args []string) (string, error) {
+	fromBranch, _ := cmd.Flags().GetBool("from-branch")
+	if !fromBranch {
+		if len(args) == 0 {
+			return "", fmt.Errorf("an issue ID is required (or use --from-branch)")
+		}
+		return args[0], nil
+	}
+	if len(args) > 0 {
+		return "", fmt.Errorf("give either an issue ID or --from-branch, not both")
+	}
+	pattern := viper.GetString("branch_pattern")
+	if cmd.Flags().Changed("branch-pattern") || pattern == "" {
+		pattern, _ = cmd.Flags().GetString("branch-pattern")
+	}
+	return issueFromBranch(runGit, pattern)
+}
+
+// addFromBranchFlags registers --from-branch and --branch-pattern on cmd
+func addFromBranchFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("from-branch", false, "Use the issue identifier in the current git branch name")
+	cmd.Flags().String("branch-pattern", defaultBranchPattern, "Regex that finds the identifier in the branch name (config: branch_pattern)")
+}
+
+var branchSlugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// issueBranchName returns the git branch name Linear suggests for an issue.
+// Linear's own branchName (what "copy git branch name" copies) is used when
+// present; otherwise the name is built the same way: the lowercased
+// identifier followed by the slugified title.
+func issueBranchName(issue *api.Issue) string {
+	if issue.BranchName != "" {
+		return issue.BranchName
+	}
+	name := strings.ToLower(issue.Identifier)
+	if slug := strings.Trim(branchSlugPattern.ReplaceAllString(strings.ToLower(issue.Title), "-"), "-"); slug != "" {
+		name += "-" + slug
+	}
+	if len(name) > branchNameMaxLen {
+		name = strings.TrimRight(name[:branchNameMaxLen], "-")
+	}
+	return name
+}
+
+// checkoutBranch switches to branch, creating it first if it doesn't exist.
+// It reports whether the branch was created.
+func checkoutBranch(run gitRunner, branch string) (bool, error) {
+	if _, err := run("rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err == nil {
+		_, err := run("checkout", branch)
+		return false, err
+	}
+	_, err := run("checkout", "-b", branch)
+	return err == nil, err
+}
+
+var issueBranchCmd = &cobra.Command{
+	Use:   "branch ISSUE-ID",
+	Short: "Print the git branch name for an issue",
+	Long: `Print the git branch name Linear suggests for an issue, the same name the
+web UI's "copy git branch name" gives. With --checkout, switch to that
+branch, creating it if needed.
+
+Examples:
+  linear-cli issue branch ROB-123
+  linear-cli issue branch ROB-123 --checkout
+  git checkout -b "$(linear-cli issue branch ROB-123)"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		branch := issueBranchName(issue)
+
+		checkout, _ := cmd.Flags().GetBool("checkout")
+		created := false
+		if checkout {
+			created, err = checkoutBranch(runGit, branch)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to check out %s: %v", branch, err), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"issue":      issue.Identifier,
+				"branch":     branch,
+				"checkedOut": checkout,
+				"created":    created,
+			})
+		} else if !checkout || plaintext {
+			fmt.Println(branch)
+		} else {
+			verb := "Switched to"
+			if created {
+				verb = "Created and switched to"
+			}
+			fmt.Printf("%s %s %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				verb,
+				color.New(color.FgCyan, color.Bold).Sprint(branch))
+		}
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueBranchCmd)
+	issueBranchCmd.Flags().Bool("checkout", false, "Switch to the branch, creating it if it doesn't exist")
+
+	addFromBranchFlags(issueGetCmd)
+	addFromBranchFlags(issueStartCmd)
+	addFromBranchFlags(issueDoneCmd)
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// fakeGit answers git commands from a map keyed by the joined arguments
+func fakeGit(responses map[string]string, calls *[]string) gitRunner {
+	return func(args ...string) (string, error) {
+		key := strings.Join(args, " ")
+		if calls != nil {
+			*calls = append(*calls, key)
+		}
+		if out, ok := responses[key]; ok {
+			return out, nil
+		}
+		return "", errors.New("exit status 1")
+	}
+}
+
+func TestIssueFromBranch(t *testing.T) {
+	tests := []struct {
+		branch  string
+		pattern string
+		want    string
+	}{
+		{"rob-123-fix-thing", defaultBranchPattern, "ROB-123"},
+		{"feature/ENG-7-login", defaultBranchPattern, "ENG-7"},
+		{"alice/rob-42-fix", defaultBranchPattern, "ROB-42"},
+		{"fix-thing-for-ROB-9", `(?i)\b([a-z]+-\d+)$`, "ROB-9"},
+		{"ROB-5", `ROB-\d+`, "ROB-5"},
+	}
+	for _, tt := range tests {
+		run := fakeGit(map[string]string{"rev-parse --abbrev-ref HEAD": tt.branch}, nil)
+		got, err := issueFromBranch(run, tt.pattern)
+		if err != nil {
+			t.Errorf("issueFromBranch(%q) error: %v", tt.branch, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("issueFromBranch(%q) = %q, want %q", tt.branch, got, tt.want)
+		}
+	}
+}
+
+func TestIssueFromBranchErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		run     gitRunner
+		pattern string
+		want    string
+	}{
+		{"no identifier", fakeGit(map[string]string{"rev-parse --abbrev-ref HEAD": "main"}, nil), defaultBranchPattern, `no issue identifier in branch "main"`},
+		{"detached", fakeGit(map[string]string{"rev-parse --abbrev-ref HEAD": "HEAD"}, nil), defaultBranchPattern, "detached"},
+		{"no git", func(...string) (string, error) { return "", errors.New("git is not installed or not on PATH") }, defaultBranchPattern, "git is not installed"},
+		{"bad pattern", fakeGit(nil, nil), "([a-z", "invalid branch pattern"},
+	}
+	for _, tt := range tests {
+		_, err := issueFromBranch(tt.run, tt.pattern)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: got %v, want an error containing %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestIssueBranchName(t *testing.T) {
+	tests := []struct {
+		issue api.Issue
+		want  string
+	}{
+		{api.Issue{Identifier: "ROB-123", Title: "Fix thing", BranchName: "alice/rob-123-fix-thing"}, "alice/rob-123-fix-thing"},
+		{api.Issue{Identifier: "ROB-123", Title: "Fix the login (again!) — on Safari"}, "rob-123-fix-the-login-again-on-safari"},
+		{api.Issue{Identifier: "ROB-1", Title: "???"}, "rob-1"},
+		{api.Issue{Identifier: "ROB-2", Title: strings.Repeat("word ", 30)}, "rob-2-word-word-word-word-word-word-word-word-word-word-word"},
+	}
+	for _, tt := range tests {
+		got := issueBranchName(&tt.issue)
+		if got != tt.want {
+			t.Errorf("issueBranchName(%q) = %q, want %q", tt.issue.Title, got, tt.want)
+		}
+		if len(got) > branchNameMaxLen && tt.issue.BranchName == "" {
+			t.Errorf("issueBranchName(%q) is %d characters, want at most %d", tt.issue.Title, len(got), branchNameMaxLen)
+		}
+	}
+}
+
+func TestCheckoutBranch(t *testing.T) {
+	var calls []string
+	run := fakeGit(map[string]string{"checkout -b rob-1-fix": ""}, &calls)
+	created, err := checkoutBranch(run, "rob-1-fix")
+	if err != nil || !created {
+		t.Fatalf("new branch: created=%v err=%v", created, err)
+	}
+	if got := calls[len(calls)-1]; got != "checkout -b rob-1-fix" {
+		t.Errorf("last git call = %q, want checkout -b", got)
+	}
+
+	calls = nil
+	run = fakeGit(map[string]string{"rev-parse --verify --quiet refs/heads/rob-1-fix": "abc123", "checkout rob-1-fix": ""}, &calls)
+	created, err = checkoutBranch(run, "rob-1-fix")
+	if err != nil || created {
+		t.Fatalf("existing branch: created=%v err=%v", created, err)
+	}
+	if got := calls[len(calls)-1]; got != "checkout rob-1-fix" {
+		t.Errorf("last git call = %q, want checkout", got)
+	}
+}
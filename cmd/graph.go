@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// graphFetchConcurrency bounds the number of issues fetched at once per frontier
+const graphFetchConcurrency = 8
+
+// graphNode is one issue in the relation graph
+type graphNode struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state,omitempty"`
+	StateType  string `json:"stateType,omitempty"`
+	Depth      int    `json:"depth"`
+}
+
+// graphEdge is a directed relation between two issues, keyed by identifier.
+// "blocks" points from the blocker, "parent" points from parent to child.
+type graphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// issueGraph is the result of walking an issue's relations
+type issueGraph struct {
+	Root      string      `json:"root"`
+	Nodes     []graphNode `json:"nodes"`
+	Edges     []graphEdge `json:"edges"`
+	Truncated bool        `json:"truncated"`
+	// Unfetched lists issues whose relations could not be loaded
+	Unfetched []string `json:"unfetched,omitempty"`
+}
+
+// relationFetcher loads an issue with its parent, children, and relations
+type relationFetcher func(ctx context.Context, id string) (*api.Issue, error)
+
+var issueGraphCmd = &cobra.Command{
+	Use:   "graph ISSUE-ID",
+	Short: "Render an issue's relation graph as DOT or Mermaid",
+	Long: `Walk an issue's blocks/blocked-by and parent/child relations breadth-first
+and print the resulting graph as Graphviz DOT or a Mermaid flowchart.
+
+Nodes are labeled "IDENT: title" and colored by workflow state type. Edges
+are labeled "blocks" (blocker -> blocked) or "parent" (parent -> child).
+Each issue appears once no matter how many paths lead to it, so cycles are
+safe. Traversal stops at --depth hops from the root or once --max-nodes
+issues have been collected.
+
+Examples:
+  linear-cli issue graph ROB-123
+  linear-cli issue graph ROB-123 --depth 3 | dot -Tpng -o graph.png
+  linear-cli issue graph ROB-123 --format mermaid
+  linear-cli issue graph ROB-123 --max-nodes 50 --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		depth, _ := cmd.Flags().GetInt("depth")
+		maxNodes, _ := cmd.Flags().GetInt("max-nodes")
+		format, _ := cmd.Flags().GetString("format")
+
+		format = strings.ToLower(format)
+		if format != "dot" && format != "mermaid" {
+			output.Error(fmt.Sprintf("Invalid format '%s'. Must be one of: dot, mermaid", format), plaintext, jsonOut)
+			exit(1)
+		}
+		if depth < 0 {
+			output.Error("--depth must be 0 or greater", plaintext, jsonOut)
+			exit(1)
+		}
+		if maxNodes < 1 {
+			output.Error("--max-nodes must be at least 1", plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		graph, err := buildIssueGraph(context.Background(), client.GetIssueRelations, args[0], depth, maxNodes)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if len(graph.Unfetched) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: could not load relations for %s\n", strings.Join(graph.Unfetched, ", "))
+		}
+		if graph.Truncated {
+			fmt.Fprintf(os.Stderr, "Warning: graph truncated at %d nodes (raise --max-nodes to see more)\n", maxNodes)
+		}
+
+		if jsonOut {
+			output.JSON(graph)
+			return
+		}
+
+		if format == "mermaid" {
+			fmt.Print(renderGraphMermaid(graph))
+		} else {
+			fmt.Print(renderGraphDOT(graph))
+		}
+	},
+}
+
+// buildIssueGraph walks relations breadth-first from root. Each frontier is
+// fetched concurrently; nodes at maxDepth are included but not expanded.
+func buildIssueGraph(ctx context.Context, fetch relationFetcher, root string, maxDepth, maxNodes int) (*issueGraph, error) {
+	rootIssue, err := fetch(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &issueGraph{Root: rootIssue.Identifier, Nodes: []graphNode{}, Edges: []graphEdge{}}
+	visited := map[string]bool{rootIssue.ID: true}
+	seenEdges := make(map[graphEdge]bool)
+	graph.Nodes = append(graph.Nodes, newGraphNode(rootIssue, 0))
+
+	addEdge := func(e graphEdge) {
+		if !seenEdges[e] {
+			seenEdges[e] = true
+			graph.Edges = append(graph.Edges, e)
+		}
+	}
+
+	frontier := []*api.Issue{rootIssue}
+	for depth := 0; depth < maxDepth && len(frontier) > 0; depth++ {
+		var next []string
+		for _, issue := range frontier {
+			for _, n := range issueGraphNeighbors(issue) {
+				if !visited[n.issue.ID] {
+					if len(graph.Nodes) >= maxNodes {
+						graph.Truncated = true
+						continue
+					}
+					visited[n.issue.ID] = true
+					graph.Nodes = append(graph.Nodes, newGraphNode(n.issue, depth+1))
+					next = append(next, n.issue.ID)
+				}
+				addEdge(n.edge)
+			}
+		}
+
+		// The last level is labeled from relation data; don't fetch it
+		if depth+1 >= maxDepth {
+			break
+		}
+		frontier = fetchGraphFrontier(ctx, fetch, next, graph)
+	}
+
+	return graph, nil
+}
+
+// fetchGraphFrontier loads a level of the graph concurrently, keeping the
+// input order. Issues that fail to load are recorded in graph.Unfetched.
+func fetchGraphFrontier(ctx context.Context, fetch relationFetcher, ids []string, graph *issueGraph) []*api.Issue {
+	results := make([]*api.Issue, len(ids))
+	errs := make([]error, len(ids))
+	sem := make(chan struct{}, graphFetchConcurrency)
+	var wg sync.WaitGroup
+
+	for i, id := range ids {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i], errs[i] = fetch(ctx, id)
+		}(i, id)
+	}
+	wg.Wait()
+
+	labels := make(map[string]string, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		labels[n.ID] = n.Identifier
+	}
+
+	var frontier []*api.Issue
+	for i, issue := range results {
+		if errs[i] != nil || issue == nil {
+			graph.Unfetched = append(graph.Unfetched, labels[ids[i]])
+			continue
+		}
+		frontier = append(frontier, issue)
+	}
+	return frontier
+}
+
+type graphNeighbor struct {
+	issue *api.Issue
+	edge  graphEdge
+}
+
+// issueGraphNeighbors returns the blocks/blocked-by and parent/child
+// neighbors of an issue along with the edge connecting them
+func issueGraphNeighbors(issue *api.Issue) []graphNeighbor {
+	var neighbors []graphNeighbor
+	add := func(other *api.Issue, from, to, relType string) {
+		if other == nil || other.ID == "" {
+			return
+		}
+		neighbors = append(neighbors, graphNeighbor{issue: other, edge: graphEdge{From: from, To: to, Type: relType}})
+	}
+
+	if issue.Parent != nil {
+		add(issue.Parent, issue.Parent.Identifier, issue.Identifier, "parent")
+	}
+	if issue.Children != nil {
+		for i := range issue.Children.Nodes {
+			child := &issue.Children.Nodes[i]
+			add(child, issue.Identifier, child.Identifier, "parent")
+		}
+	}
+	if issue.Relations != nil {
+		for _, rel := range issue.Relations.Nodes {
+			if rel.RelatedIssue == nil {
+				continue
+			}
+			switch normalizeRelationType(rel.Type) {
+			case "blocks":
+				add(rel.RelatedIssue, issue.Identifier, rel.RelatedIssue.Identifier, "blocks")
+			case "blocked-by":
+				add(rel.RelatedIssue, rel.RelatedIssue.Identifier, issue.Identifier, "blocks")
+			}
+		}
+	}
+	if issue.InverseRelations != nil {
+		for _, rel := range issue.InverseRelations.Nodes {
+			if rel.Issue == nil {
+				continue
+			}
+			switch normalizeRelationType(rel.Type) {
+			case "blocks":
+				add(rel.Issue, rel.Issue.Identifier, issue.Identifier, "blocks")
+			case "blocked-by":
+				add(rel.Issue, issue.Identifier, rel.Issue.Identifier, "blocks")
+			}
+		}
+	}
+	return neighbors
+}
+
+func newGraphNode(issue *api.Issue, depth int) graphNode {
+	node := graphNode{
+		ID:         issue.ID,
+		Identifier: issue.Identifier,
+		Title:      issue.Title,
+		Depth:      depth,
+	}
+	if issue.State != nil {
+		node.State = issue.State.Name
+		node.StateType = issue.State.Type
+	}
+	return node
+}
+
+// graphNodeFill returns the fill color for a node's state type
+func graphNodeFill(node graphNode) string {
+	return styleFor("issue state type", issueStateTypeStyles, node.StateType).Fill
+}
+
+// renderGraphDOT renders the graph as a Graphviz digraph
+func renderGraphDOT(graph *issueGraph) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(graph.Root))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=\"rounded,filled\", fontname=\"Helvetica\"];\n")
+	b.WriteString("  edge [fontname=\"Helvetica\", fontsize=10];\n")
+	for _, n := range graph.Nodes {
+		attrs := fmt.Sprintf("label=%s, fillcolor=%s", dotQuote(n.Identifier+": "+n.Title), dotQuote(graphNodeFill(n)))
+		if n.Identifier == graph.Root {
+			attrs += ", penwidth=2"
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.Identifier), attrs)
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", dotQuote(e.From), dotQuote(e.To), dotQuote(e.Type))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotQuote returns s as a double-quoted DOT string
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+var mermaidIDPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// renderGraphMermaid renders the graph as a Mermaid flowchart
+func renderGraphMermaid(graph *issueGraph) string {
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+
+	classes := make(map[string]string)
+	var classOrder []string
+	for _, n := range graph.Nodes {
+		fmt.Fprintf(&b, "  %s[\"%s\"]\n", mermaidID(n.Identifier), mermaidLabel(n.Identifier+": "+n.Title))
+		class := n.StateType
+		if class == "" {
+			class = "unknown"
+		}
+		if _, ok := classes[class]; !ok {
+			classes[class] = graphNodeFill(n)
+			classOrder = append(classOrder, class)
+		}
+	}
+	for _, e := range graph.Edges {
+		fmt.Fprintf(&b, "  %s -->|%s| %s\n", mermaidID(e.From), e.Type, mermaidID(e.To))
+	}
+	for _, class := range classOrder {
+		fmt.Fprintf(&b, "  classDef %s fill:%s,stroke:#333\n", mermaidID(class), classes[class])
+	}
+	for _, n := range graph.Nodes {
+		class := n.StateType
+		if class == "" {
+			class = "unknown"
+		}
+		fmt.Fprintf(&b, "  class %s %s\n", mermaidID(n.Identifier), mermaidID(class))
+	}
+	return b.String()
+}
+
+// mermaidID turns an identifier like ROB-123 into a valid Mermaid node ID
+func mermaidID(s string) string {
+	return mermaidIDPattern.ReplaceAllString(s, "_")
+}
+
+// mermaidLabel escapes text for use inside a quoted Mermaid label
+func mermaidLabel(s string) string {
+	s = strings.ReplaceAll(s, `"`, "#quot;")
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func init() {
+	issueCmd.AddCommand(issueGraphCmd)
+
+	issueGraphCmd.Flags().Int("depth", 2, "Maximum number of hops from the root issue")
+	issueGraphCmd.Flags().Int("max-nodes", 100, "Stop adding issues once the graph has this many nodes")
+	issueGraphCmd.Flags().String("format", "dot", "Output format: dot, mermaid")
+}
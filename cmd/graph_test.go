@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// fakeGraph serves issues by ID (or identifier) and records fetches
+type fakeGraph struct {
+	mu      sync.Mutex
+	issues  map[string]*api.Issue
+	fetched []string
+}
+
+func (f *fakeGraph) fetch(ctx context.Context, id string) (*api.Issue, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fetched = append(f.fetched, id)
+	for _, issue := range f.issues {
+		if issue.ID == id || issue.Identifier == id {
+			return issue, nil
+		}
+	}
+	return nil, fmt.Errorf("issue %s not found", id)
+}
+
+func graphTestIssue(n int, stateType string) *api.Issue {
+	return &api.Issue{
+		ID:         fmt.Sprintf("id-%d", n),
+		Identifier: fmt.Sprintf("ROB-%d", n),
+		Title:      fmt.Sprintf("Issue %d", n),
+		State:      &api.State{Name: stateType, Type: stateType},
+	}
+}
+
+func blocksRel(to *api.Issue) api.IssueRelation {
+	return api.IssueRelation{Type: "blocks", RelatedIssue: to}
+}
+
+// newFakeGraph builds a cycle ROB-1 blocks ROB-2 blocks ROB-3 blocks ROB-1,
+// plus ROB-1 as parent of ROB-4 and ROB-5 blocked-by ROB-3
+func newFakeGraph() *fakeGraph {
+	i1, i2, i3, i4, i5 := graphTestIssue(1, "started"), graphTestIssue(2, "unstarted"),
+		graphTestIssue(3, "completed"), graphTestIssue(4, "backlog"), graphTestIssue(5, "canceled")
+
+	// Relation targets are shallow copies, as returned by the API
+	ref := func(i *api.Issue) *api.Issue {
+		c := *i
+		return &c
+	}
+
+	i1.Relations = &api.IssueRelations{Nodes: []api.IssueRelation{blocksRel(ref(i2))}}
+	i1.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{{Type: "blocks", Issue: ref(i3)}}}
+	i1.Children = &api.Issues{Nodes: []api.Issue{*ref(i4)}}
+	i2.Relations = &api.IssueRelations{Nodes: []api.IssueRelation{blocksRel(ref(i3))}}
+	i2.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{{Type: "blocks", Issue: ref(i1)}}}
+	i3.Relations = &api.IssueRelations{Nodes: []api.IssueRelation{blocksRel(ref(i1)), blocksRel(ref(i5))}}
+	i3.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{{Type: "blocks", Issue: ref(i2)}}}
+	i4.Parent = ref(i1)
+	i5.InverseRelations = &api.IssueRelations{Nodes: []api.IssueRelation{{Type: "blocks", Issue: ref(i3)}}}
+
+	return &fakeGraph{issues: map[string]*api.Issue{"1": i1, "2": i2, "3": i3, "4": i4, "5": i5}}
+}
+
+func graphIdentifiers(g *issueGraph) []string {
+	var ids []string
+	for _, n := range g.Nodes {
+		ids = append(ids, fmt.Sprintf("%s@%d", n.Identifier, n.Depth))
+	}
+	return ids
+}
+
+func graphEdgeStrings(g *issueGraph) []string {
+	var edges []string
+	for _, e := range g.Edges {
+		edges = append(edges, fmt.Sprintf("%s-%s->%s", e.From, e.Type, e.To))
+	}
+	return edges
+}
+
+func TestBuildIssueGraphHandlesCycles(t *testing.T) {
+	fake := newFakeGraph()
+	g, err := buildIssueGraph(context.Background(), fake.fetch, "ROB-1", 5, 100)
+	if err != nil {
+		t.Fatalf("buildIssueGraph returned error: %v", err)
+	}
+
+	if got := strings.Join(graphIdentifiers(g), " "); got != "ROB-1@0 ROB-4@1 ROB-2@1 ROB-3@1 ROB-5@2" {
+		t.Errorf("nodes = %s", got)
+	}
+	wantEdges := "ROB-1-parent->ROB-4 ROB-1-blocks->ROB-2 ROB-3-blocks->ROB-1 ROB-2-blocks->ROB-3 ROB-3-blocks->ROB-5"
+	if got := strings.Join(graphEdgeStrings(g), " "); got != wantEdges {
+		t.Errorf("edges = %s\nwant %s", got, wantEdges)
+	}
+	if g.Truncated {
+		t.Error("graph should not be truncated")
+	}
+
+	// Every issue is fetched at most once
+	seen := make(map[string]bool)
+	for _, id := range fake.fetched {
+		if seen[id] {
+			t.Errorf("issue %s fetched more than once", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestBuildIssueGraphDepth(t *testing.T) {
+	fake := newFakeGraph()
+	g, err := buildIssueGraph(context.Background(), fake.fetch, "ROB-1", 1, 100)
+	if err != nil {
+		t.Fatalf("buildIssueGraph returned error: %v", err)
+	}
+	if got := strings.Join(graphIdentifiers(g), " "); got != "ROB-1@0 ROB-4@1 ROB-2@1 ROB-3@1" {
+		t.Errorf("nodes = %s", got)
+	}
+	if len(fake.fetched) != 1 {
+		t.Errorf("depth 1 should only fetch the root, fetched %v", fake.fetched)
+	}
+
+	g, err = buildIssueGraph(context.Background(), newFakeGraph().fetch, "ROB-1", 0, 100)
+	if err != nil {
+		t.Fatalf("buildIssueGraph returned error: %v", err)
+	}
+	if len(g.Nodes) != 1 || len(g.Edges) != 0 {
+		t.Errorf("depth 0 should yield only the root, got %d nodes, %d edges", len(g.Nodes), len(g.Edges))
+	}
+}
+
+func TestBuildIssueGraphMaxNodes(t *testing.T) {
+	g, err := buildIssueGraph(context.Background(), newFakeGraph().fetch, "ROB-1", 5, 2)
+	if err != nil {
+		t.Fatalf("buildIssueGraph returned error: %v", err)
+	}
+	if got := strings.Join(graphIdentifiers(g), " "); got != "ROB-1@0 ROB-4@1" {
+		t.Errorf("nodes = %s", got)
+	}
+	if !g.Truncated {
+		t.Error("graph should be truncated")
+	}
+	for _, e := range g.Edges {
+		if e.From != "ROB-1" && e.From != "ROB-4" || e.To != "ROB-1" && e.To != "ROB-4" {
+			t.Errorf("edge %+v references a node outside the graph", e)
+		}
+	}
+}
+
+func TestBuildIssueGraphRootError(t *testing.T) {
+	if _, err := buildIssueGraph(context.Background(), newFakeGraph().fetch, "ROB-99", 2, 100); err == nil {
+		t.Fatal("expected error for missing root issue")
+	}
+}
+
+func TestRenderGraphDOT(t *testing.T) {
+	g := &issueGraph{
+		Root: "ROB-1",
+		Nodes: []graphNode{
+			{Identifier: "ROB-1", Title: `Fix "quoted" \ path`, StateType: "started"},
+			{Identifier: "ROB-2", Title: "Follow-up", StateType: "completed"},
+		},
+		Edges: []graphEdge{{From: "ROB-1", To: "ROB-2", Type: "blocks"}},
+	}
+	got := renderGraphDOT(g)
+
+	for _, want := range []string{
+		"digraph \"ROB-1\" {\n",
+		`"ROB-1" [label="ROB-1: Fix \"quoted\" \\ path", fillcolor="#dbeafe", penwidth=2];`,
+		`"ROB-2" [label="ROB-2: Follow-up", fillcolor="#dcfce7"];`,
+		`"ROB-1" -> "ROB-2" [label="blocks"];`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("DOT output missing %q\n%s", want, got)
+		}
+	}
+	if !strings.HasSuffix(got, "}\n") {
+		t.Errorf("DOT output not closed:\n%s", got)
+	}
+}
+
+func TestRenderGraphMermaid(t *testing.T) {
+	g := &issueGraph{
+		Root: "ROB-1",
+		Nodes: []graphNode{
+			{Identifier: "ROB-1", Title: `Say "hi"`, StateType: "started"},
+			{Identifier: "ROB-2", Title: "Child"},
+		},
+		Edges: []graphEdge{{From: "ROB-1", To: "ROB-2", Type: "parent"}},
+	}
+	got := renderGraphMermaid(g)
+
+	for _, want := range []string{
+		"flowchart LR\n",
+		`  ROB_1["ROB-1: Say #quot;hi#quot;"]`,
+		"  ROB_1 -->|parent| ROB_2\n",
+		"  classDef started fill:#dbeafe,stroke:#333\n",
+		"  classDef unknown fill:#ffffff,stroke:#333\n",
+		"  class ROB_2 unknown\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Mermaid output missing %q\n%s", want, got)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
@@ -39,7 +38,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -51,7 +50,7 @@ Examples:
 		if varsStr != "" {
 			if err := json.Unmarshal([]byte(varsStr), &variables); err != nil {
 				output.Error(fmt.Sprintf("Failed to parse variables JSON: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
@@ -59,7 +58,7 @@ Examples:
 		data, err := client.ExecuteRaw(context.Background(), query, variables)
 		if err != nil {
 			output.Error(fmt.Sprintf("GraphQL request failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Pretty-print the raw JSON response
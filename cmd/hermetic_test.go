@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// newMockLinear starts a mock Linear API that every command talks to until
+// the test ends, with throwaway credentials, config, and journal
+func newMockLinear(t *testing.T) *linearmock.Server {
+	t.Helper()
+	t.Setenv("LINEAR_API_KEY", "lin_api_hermetic")
+	t.Setenv("LINCTL_API_KEY", "")
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+	s := linearmock.New(t)
+	s.Install()
+	return s
+}
+
+// requestsFor returns the requests the server got for operation, in order
+func requestsFor(s *linearmock.Server, operation string) []linearmock.Request {
+	var reqs []linearmock.Request
+	for _, req := range s.Requests() {
+		if req.Operation == operation {
+			reqs = append(reqs, req)
+		}
+	}
+	return reqs
+}
+
+// runMocked runs a command in-process, as 'serve --stdio' does, and returns
+// its output and exit code
+func runMocked(t *testing.T, args ...string) serveResponse {
+	t.Helper()
+	return runServeCommand(serveRequest{Command: args})
+}
+
+func TestHermeticTeamList(t *testing.T) {
+	s := newMockLinear(t)
+	s.Fixtures("testdata/linearmock/teams")
+
+	r := runMocked(t, "team", "list", "--json")
+	if r.Exit != 0 {
+		t.Fatalf("team list --json exited %d: %s", r.Exit, r.Stderr)
+	}
+	var teams []map[string]interface{}
+	if err := json.Unmarshal([]byte(r.Stdout), &teams); err != nil || len(teams) != 2 || teams[0]["key"] != "ENG" {
+		t.Errorf("team list --json = %s (%v)", r.Stdout, err)
+	}
+
+	r = runMocked(t, "team", "list", "--plaintext")
+	want := "Key\tName\tDescription\tPrivate\tCycles\tTriage\tTimezone\tIssues\n" +
+		"ENG\tEngineering\tProduct engineering and the platform the app ru...\tfalse\tYes\tYes\tAmerica/New_York\t412\n" +
+		"OPS\tOperations\t\ttrue\tNo\tNo\tEurope/London\t37\n"
+	if r.Exit != 0 || r.Stdout != want {
+		t.Errorf("team list --plaintext exited %d:\n%s\nwant:\n%s", r.Exit, r.Stdout, want)
+	}
+
+	r = runMocked(t, "team", "list")
+	for _, part := range []string{"Engineering", "Operations", "412", "2 teams"} {
+		if !strings.Contains(r.Stdout, part) {
+			t.Errorf("team list table is missing %q:\n%s", part, r.Stdout)
+		}
+	}
+
+	if got := strings.Join(s.Operations(), ","); got != "Teams,Teams,Teams" {
+		t.Errorf("operations = %s, want one Teams query per run", got)
+	}
+}
+
+// mockFixture returns a testdata/*.json fixture as JSON text
+func mockFixture(t *testing.T, name string) string {
+	t.Helper()
+	var v json.RawMessage
+	loadFixture(t, name, &v)
+	return string(v)
+}
+
+func TestHermeticProjectCRUD(t *testing.T) {
+	s := newMockLinear(t)
+	project := mockFixture(t, "project_get")
+	s.Data("CreateProject", `{"projectCreate":{"project":`+project+`}}`)
+	s.Data("Project", `{"project":`+project+`}`)
+	s.Data("ProjectUpdate", `{"projectUpdate":{"project":`+project+`}}`)
+	s.Data("ArchiveProject", `{"projectArchive":{"success":true}}`)
+
+	r := runMocked(t, "project", "create", "--name", "Checkout v2", "--team-ids", "team-eng", "--state", "started", "--json")
+	var created map[string]interface{}
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &created) != nil || created["slugId"] == "" {
+		t.Fatalf("project create exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	input := s.Requests()[0].Variables["input"].(map[string]interface{})
+	if input["name"] != "Checkout v2" || input["state"] != "started" || len(input["teamIds"].([]interface{})) != 1 {
+		t.Errorf("projectCreate input = %v", input)
+	}
+
+	r = runMocked(t, "project", "get", "proj-1", "--plaintext", "--utc")
+	for _, line := range []string{"# Checkout \\*v2\\* \\[beta\\]", "- **State**: started", "- **Progress**: 42%", "- **Created**: 2026-01-02 10:00:00"} {
+		if !strings.Contains(r.Stdout, line+"\n") {
+			t.Errorf("project get --plaintext is missing %q:\n%s", line, r.Stdout)
+		}
+	}
+	r = runMocked(t, "project", "get", "proj-1")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "Checkout *v2* [beta]") {
+		t.Errorf("project get exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	s.Reset()
+	r = runMocked(t, "project", "update", "proj-1", "--state", "paused", "--json")
+	reqs := s.Requests()
+	if r.Exit != 0 || len(reqs) != 2 || reqs[1].Operation != "ProjectUpdate" {
+		t.Fatalf("project update exited %d after %v: %s", r.Exit, s.Operations(), r.Stderr)
+	}
+	if input := reqs[1].Variables["input"].(map[string]interface{}); input["state"] != "paused" || len(input) != 1 {
+		t.Errorf("projectUpdate input = %v, want only the state", input)
+	}
+
+	r = runMocked(t, "project", "archive", "proj-1", "--json")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, `"status": "success"`) {
+		t.Errorf("project archive exited %d: %s", r.Exit, r.Stdout)
+	}
+}
+
+func TestHermeticIssueCreateUpdate(t *testing.T) {
+	s := newMockLinear(t)
+	issue := mockFixture(t, "issue_get")
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("CreateIssue", `{"issueCreate":{"issue":`+issue+`}}`)
+	s.Data("Issue", `{"issue":`+issue+`}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"issue":`+issue+`}}`)
+
+	r := runMocked(t, "issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--priority", "2", "--json")
+	var created map[string]interface{}
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &created) != nil || created["identifier"] != "ENG-42" {
+		t.Fatalf("issue create exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Team,CreateIssue" {
+		t.Errorf("issue create operations = %s", got)
+	}
+	input := s.Requests()[1].Variables["input"].(map[string]interface{})
+	if input["title"] != "Fix parse_date" || input["teamId"] != "team-eng" || input["priority"] != float64(2) {
+		t.Errorf("issueCreate input = %v", input)
+	}
+
+	r = runMocked(t, "issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--plaintext")
+	if r.Exit != 0 || r.Stdout != "Created issue ENG-42: Fix `parse_date` for *all* locales\nURL: https://linear.app/acme/issue/ENG-42\n" {
+		t.Errorf("issue create --plaintext exited %d: %q", r.Exit, r.Stdout)
+	}
+
+	s.Reset()
+	r = runMocked(t, "issue", "update", "ENG-42", "--priority", "1")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "Updated issue ENG-42") || !strings.Contains(r.Stdout, "State: In Progress") {
+		t.Errorf("issue update exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	reqs := s.Requests()
+	if len(reqs) != 2 || reqs[1].Variables["id"] != "a1b2c3d4-0000-4000-8000-000000000001" {
+		t.Fatalf("issue update requests = %+v, want the issue looked up then updated by UUID", reqs)
+	}
+	if input := reqs[1].Variables["input"].(map[string]interface{}); input["priority"] != float64(1) || len(input) != 1 {
+		t.Errorf("issueUpdate input = %v, want only the priority", input)
+	}
+}
+
+// TestHermeticCreateRetry loses the answer to a create that went through:
+// the resent create reuses its ID, so Linear refuses it rather than making
+// a second issue, and the command fetches and reports the first one
+func TestHermeticCreateRetry(t *testing.T) {
+	s := newMockLinear(t)
+	issue := mockFixture(t, "issue_get")
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Add(linearmock.Stub{Operation: "CreateIssue", Response: json.RawMessage(`{"errors":[{"message":"Entity already exists"}]}`)})
+	s.Add(linearmock.Stub{Operation: "CreateIssue", Drop: true, Times: 1})
+	s.Data("Issue", `{"issue":`+issue+`}`)
+
+	r := runMocked(t, "issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--json")
+	var created map[string]interface{}
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &created) != nil || created["identifier"] != "ENG-42" {
+		t.Fatalf("issue create exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	reqs := s.Requests()
+	if got := strings.Join(s.Operations(), ","); got != "Team,CreateIssue,CreateIssue,Issue" {
+		t.Fatalf("operations = %s", got)
+	}
+	first := reqs[1].Variables["input"].(map[string]interface{})
+	if id, _ := first["id"].(string); id == "" || mustJSON(t, reqs[2].Variables) != mustJSON(t, reqs[1].Variables) || reqs[3].Variables["id"] != id {
+		t.Errorf("resent create didn't reuse its ID: %v then %v, fetched %v", reqs[1].Variables, reqs[2].Variables, reqs[3].Variables)
+	}
+}
+
+func TestHermeticErrors(t *testing.T) {
+	s := newMockLinear(t)
+	s.Error("Issue", 200, "Entity not found: Issue", "")
+	s.Error("Teams", 401, "Authentication required, not authenticated", "AUTHENTICATION_ERROR")
+
+	r := runMocked(t, "issue", "get", "ENG-999", "--json")
+	var resp map[string]string
+	if r.Exit != 1 || json.Unmarshal([]byte(r.Stdout), &resp) != nil || resp["error"] != "issue not found: ENG-999" {
+		t.Errorf("issue get of a missing issue exited %d: %s", r.Exit, r.Stdout)
+	}
+
+	r = runMocked(t, "team", "list", "--plaintext")
+	if r.Exit != exitAuthentication || r.Stdout != "" || !strings.Contains(r.Stderr, "Error: ") || !strings.Contains(r.Stderr, "LINEAR_API_KEY") {
+		t.Errorf("team list with bad credentials exited %d: stdout %q, stderr %q", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	// Without credentials the command fails before contacting the API
+	t.Setenv("LINEAR_API_KEY", "")
+	s.Reset()
+	r = runMocked(t, "team", "list")
+	if r.Exit != 1 || len(s.Requests()) != 0 {
+		t.Errorf("team list without credentials exited %d after %v", r.Exit, s.Operations())
+	}
+}
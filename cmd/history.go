@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// recordOperation appends a successful mutating command to the operation journal.
+// Journal failures are reported as warnings and never fail the command itself.
+func recordOperation(cmd *cobra.Command, entityType, entityID, label string, inverse *journal.Inverse) {
+	entry := journal.Entry{
+		Command:    cmd.CommandPath(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Label:      label,
+		Inverse:    inverse,
+	}
+	if err := journal.Append(entry); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to record operation in history: %v\n", err)
+	}
+}
+
+// restoreInverse captures the current values of the fields an update is about
+// to change so the update can be reverted. Returns nil when any field cannot
+// be captured, in which case the operation is recorded as not undoable.
+func restoreInverse(current interface{}, input map[string]interface{}) *journal.Inverse {
+	prior := priorValues(current, input)
+	if prior == nil {
+		return nil
+	}
+	return &journal.Inverse{Action: "update", Input: prior}
+}
+
+// priorValues maps each input key to the entity's current value for that field.
+// Keys ending in "Id" are read from the nested object's id, and keys ending in
+// "Ids" from the nested connection's node ids.
+func priorValues(current interface{}, input map[string]interface{}) map[string]interface{} {
+	data, err := json.Marshal(current)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	prior := make(map[string]interface{}, len(input))
+	for key := range input {
+		if value, ok := fields[key]; ok {
+			prior[key] = value
+			continue
+		}
+		if strings.HasSuffix(key, "Ids") {
+			conn, ok := fields[strings.TrimSuffix(key, "Ids")+"s"].(map[string]interface{})
+			if !ok {
+				return nil
+			}
+			ids := []string{}
+			nodes, _ := conn["nodes"].([]interface{})
+			for _, node := range nodes {
+				if m, ok := node.(map[string]interface{}); ok {
+					if id, ok := m["id"].(string); ok {
+						ids = append(ids, id)
+					}
+				}
+			}
+			prior[key] = ids
+			continue
+		}
+		if strings.HasSuffix(key, "Id") {
+			if value, ok := fields[strings.TrimSuffix(key, "Id")]; ok {
+				if m, ok := value.(map[string]interface{}); ok {
+					prior[key] = m["id"]
+				} else {
+					prior[key] = nil
+				}
+				continue
+			}
+		}
+		return nil
+	}
+	return prior
+}
+
+// issueUpdateInverse captures prior values for an issue update. Label changes
+// are inverted by swapping added and removed labels, limited to the labels
+// whose membership actually changes.
+func issueUpdateInverse(issue *api.Issue, input map[string]interface{}) *journal.Inverse {
+	fields := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		if k != "addedLabelIds" && k != "removedLabelIds" {
+			fields[k] = v
+		}
+	}
+	prior := priorValues(issue, fields)
+	if prior == nil {
+		return nil
+	}
+
+	current := make(map[string]bool)
+	if issue.Labels != nil {
+		for _, l := range issue.Labels.Nodes {
+			current[l.ID] = true
+		}
+	}
+	if added, ok := input["addedLabelIds"].([]string); ok {
+		var toRemove []string
+		for _, id := range added {
+			if !current[id] {
+				toRemove = append(toRemove, id)
+			}
+		}
+		if len(toRemove) > 0 {
+			prior["removedLabelIds"] = toRemove
+		}
+	}
+	if removed, ok := input["removedLabelIds"].([]string); ok {
+		var toAdd []string
+		for _, id := range removed {
+			if current[id] {
+				toAdd = append(toAdd, id)
+			}
+		}
+		if len(toAdd) > 0 {
+			prior["addedLabelIds"] = toAdd
+		}
+	}
+	return &journal.Inverse{Action: "update", Input: prior}
+}
+
+// describeInverse returns a short human-readable description of an inverse operation
+func describeInverse(inv *journal.Inverse) string {
+	if inv == nil {
+		return "cannot be undone"
+	}
+	if inv.Action == "update" {
+		keys := make([]string, 0, len(inv.Input))
+		for k := range inv.Input {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		return "restore " + strings.Join(keys, ", ")
+	}
+	return inv.Action
+}
+
+func entryDisplayName(e journal.Entry) string {
+	if e.Label != "" {
+		return e.Label
+	}
+	return e.EntityID
+}
+
+// applyInverse performs the inverse operation recorded for a journal entry
+func applyInverse(ctx context.Context, client *api.Client, e *journal.Entry) error {
+	inv := e.Inverse
+	id := e.EntityID
+
+	switch inv.Action {
+	case "unarchive":
+		switch e.EntityType {
+		case "issue":
+			return client.UnarchiveIssue(ctx, id)
+		case "project":
+			return client.UnarchiveProject(ctx, id)
+		case "notification":
+			return client.UnarchiveNotification(ctx, id)
+		}
+	case "archive":
+		switch e.EntityType {
+		case "issue":
+			_, err := client.ArchiveIssue(ctx, id)
+			return err
+		case "project":
+			return client.ArchiveProject(ctx, id)
+		case "cycle":
+			return client.ArchiveCycle(ctx, id)
+		case "project-update":
+			return client.ArchiveProjectUpdate(ctx, id)
+		case "notification":
+			return client.ArchiveNotification(ctx, id)
+		}
+	case "delete":
+		switch e.EntityType {
+		case "label":
+			return client.DeleteLabel(ctx, id)
+		case "comment":
+			return client.DeleteComment(ctx, id)
+		case "milestone":
+			return client.DeleteProjectMilestone(ctx, id)
+		case "document":
+			return client.DeleteDocument(ctx, id)
+		case "relation":
+			return client.DeleteIssueRelation(ctx, id)
+		case "attachment":
+			return client.DeleteAttachment(ctx, id)
+		case "view":
+			return client.DeleteCustomView(ctx, id)
+		case "initiative":
+			return client.DeleteInitiative(ctx, id)
+		case "favorite":
+			return client.DeleteFavorite(ctx, id)
+		case "template":
+			return client.DeleteTemplate(ctx, id)
+		}
+	case "update":
+		var err error
+		switch e.EntityType {
+		case "issue":
+			_, err = client.UpdateIssue(ctx, id, inv.Input)
+		case "project":
+			_, err = client.UpdateProject(ctx, id, inv.Input)
+		case "label":
+			_, err = client.UpdateLabel(ctx, id, inv.Input)
+		case "milestone":
+			_, err = client.UpdateProjectMilestone(ctx, id, inv.Input)
+		case "document":
+			_, err = client.UpdateDocument(ctx, id, inv.Input)
+		case "template":
+			_, err = client.UpdateTemplate(ctx, id, inv.Input)
+		case "favorite":
+			_, err = client.UpdateFavorite(ctx, id, inv.Input)
+		default:
+			return fmt.Errorf("restoring %s fields is not supported", e.EntityType)
+		}
+		return err
+	}
+	return fmt.Errorf("no inverse '%s' for %s", inv.Action, e.EntityType)
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List recent operations",
+	Long: `List recent mutating operations recorded in the operation journal.
+
+The journal is stored in ~/.local/state/linear-cli/history.jsonl (or
+$XDG_STATE_HOME/linear-cli/history.jsonl) and keeps the most recent
+500 operations.
+
+Examples:
+  linear-cli history
+  linear-cli history --limit 50 --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		entries, err := journal.Load()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read history: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		// Newest first
+		recent := make([]journal.Entry, 0, len(entries))
+		for i := len(entries) - 1; i >= 0 && (limit <= 0 || len(recent) < limit); i-- {
+			recent = append(recent, entries[i])
+		}
+
+		if jsonOut {
+			output.JSON(recent)
+			return
+		}
+
+		if len(recent) == 0 {
+			output.Info("No operations recorded yet", plaintext, jsonOut)
+			return
+		}
+
+		headers := []string{"When", "Command", "Entity", "ID", "Undo"}
+		rows := [][]string{}
+		for _, e := range recent {
+			undo := "no"
+			switch {
+			case e.UndoOf != "":
+				undo = "-"
+			case journal.IsUndone(entries, e.ID):
+				undo = "undone"
+			case e.Inverse != nil:
+				undo = e.Inverse.Action
+			}
+			when := formatTimeAgo(e.Timestamp)
+			if plaintext {
+				when = output.FormatTime(e.Timestamp, output.DateTime)
+			} else if undo != "no" && undo != "-" && undo != "undone" {
+				undo = color.New(color.FgGreen).Sprint(undo)
+			}
+			rows = append(rows, []string{
+				when,
+				e.Command,
+				e.EntityType,
+				entryDisplayName(e),
+				undo,
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+	},
+}
+
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Undo the last recorded operation",
+	Long: `Revert the most recent operation recorded in the operation journal.
+
+Archives are reverted by unarchiving, simple updates by restoring the
+previous field values, and creations by deleting (or archiving) the created
+entity. Permanent deletes cannot be undone.
+
+Examples:
+  linear-cli undo
+  linear-cli undo --dry-run`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		entries, err := journal.Load()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read history: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		target := journal.LastUndoable(entries)
+		if target == nil {
+			output.Info("Nothing to undo", plaintext, jsonOut)
+			return
+		}
+
+		name := entryDisplayName(*target)
+		if target.Inverse == nil {
+			output.Error(fmt.Sprintf("Last operation '%s' on %s %s cannot be undone", target.Command, target.EntityType, name), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if dryRun {
+			if jsonOut {
+				output.JSON(map[string]interface{}{
+					"dryRun":    true,
+					"operation": target,
+				})
+			} else {
+				output.Info(fmt.Sprintf("Would undo '%s' on %s %s: %s", target.Command, target.EntityType, name, describeInverse(target.Inverse)), plaintext, jsonOut)
+			}
+			return
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		if err := applyInverse(context.Background(), client, target); err != nil {
+			output.Error(fmt.Sprintf("Failed to undo '%s' on %s %s: %v", target.Command, target.EntityType, name, err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if err := journal.Append(journal.Entry{
+			Command:    cmd.CommandPath(),
+			EntityType: target.EntityType,
+			EntityID:   target.EntityID,
+			Label:      target.Label,
+			UndoOf:     target.ID,
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to record undo in history: %v\n", err)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"success":   true,
+				"undone":    target,
+				"performed": target.Inverse.Action,
+			})
+		} else {
+			output.Success(fmt.Sprintf("Undid '%s' on %s %s (%s)", target.Command, target.EntityType, name, describeInverse(target.Inverse)), plaintext, jsonOut)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	rootCmd.AddCommand(undoCmd)
+
+	historyCmd.Flags().IntP("limit", "l", 20, "Maximum number of operations to show (0 for all)")
+	undoCmd.Flags().Bool("dry-run", false, "Show what would be undone without changing anything")
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bufio"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// modulePath is the one import path the tree builds under
+const modulePath = "github.com/roboalchemist/linear-cli"
+
+// staleModulePaths are import paths from before the fork; importing one
+// compiles against a second copy of pkg/api and pkg/auth
+var staleModulePaths = []string{
+	"github.com/dorkitude/linctl",
+	"github.com/dorkitude/linear-cli",
+}
+
+func TestGoModHasSingleModulePath(t *testing.T) {
+	f, err := os.Open(filepath.Join("..", "go.mod"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	module := ""
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if rest, ok := strings.CutPrefix(line, "module "); ok {
+			module = strings.TrimSpace(rest)
+		}
+		if strings.HasPrefix(line, "replace ") || line == "replace (" {
+			t.Errorf("go.mod has a replace directive: %q", line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if module != modulePath {
+		t.Errorf("go.mod module = %q, want %q", module, modulePath)
+	}
+}
+
+func TestNoStaleModuleImports(t *testing.T) {
+	fset := token.NewFileSet()
+	err := filepath.WalkDir("..", func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if name := d.Name(); path != ".." && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			return err
+		}
+		for _, imp := range file.Imports {
+			importPath, _ := strconv.Unquote(imp.Path.Value)
+			for _, stale := range staleModulePaths {
+				if importPath == stale || strings.HasPrefix(importPath, stale+"/") {
+					t.Errorf("%s imports %q; use %s instead", fset.Position(imp.Pos()), importPath, modulePath)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
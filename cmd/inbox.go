@@ -3,14 +3,15 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/fatih/color"
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -30,7 +31,8 @@ Examples:
   linear-cli inbox --unread           # Show only unread notifications
   linear-cli inbox --all              # Include archived notifications
   linear-cli inbox --json             # Output as JSON
-  linear-cli inbox --plaintext        # Output as plaintext`,
+  linear-cli inbox --plaintext        # Output as plaintext
+  linear-cli inbox watch --notify     # Announce new notifications as they arrive`,
 	Run: runInboxList,
 }
 
@@ -42,7 +44,7 @@ func runInboxList(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	// Create API client
@@ -57,8 +59,9 @@ func runInboxList(cmd *cobra.Command, args []string) {
 	notifications, err := client.GetNotifications(context.Background(), limit, "", includeArchived)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to get notifications: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	warnIfTruncated(cmd, notifications.PageInfo, len(notifications.Nodes))
 
 	// Filter to unread if requested
 	filteredNotifications := notifications.Nodes
@@ -90,16 +93,7 @@ func runInboxList(cmd *cobra.Command, args []string) {
 	} else if plaintext {
 		fmt.Println("Type\tIssue\tTitle\tActor\tTeam\tTime\tRead")
 		for _, n := range filteredNotifications {
-			issueID := ""
-			title := n.Title
-			if n.Issue != nil {
-				issueID = n.Issue.Identifier
-				if title == "" {
-					title = n.Issue.Title
-				}
-			} else if n.Project != nil {
-				issueID = n.Project.Name
-			}
+			issueID, title := notificationSubject(n)
 			actorName := ""
 			if n.Actor != nil {
 				actorName = n.Actor.Name
@@ -282,7 +276,7 @@ func formatRelativeTime(t time.Time) string {
 		}
 		return fmt.Sprintf("%d days ago", days)
 	default:
-		return t.Format("2006-01-02")
+		return output.FormatTime(t, output.DateOnly)
 	}
 }
 
@@ -307,7 +301,7 @@ func runInboxRead(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	client := api.NewClient(authHeader)
@@ -319,8 +313,9 @@ func runInboxRead(cmd *cobra.Command, args []string) {
 		err = client.MarkAllNotificationsRead(context.Background(), time.Now())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to mark notifications as read: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "notification", "all", "", nil)
 		if jsonOut {
 			output.JSON(map[string]interface{}{"success": true, "message": "All notifications marked as read"})
 		} else if plaintext {
@@ -333,7 +328,7 @@ func runInboxRead(cmd *cobra.Command, args []string) {
 
 	if len(args) == 0 {
 		output.Error("Notification ID required (or use --all)", plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	notificationID := args[0]
@@ -345,8 +340,9 @@ func runInboxRead(cmd *cobra.Command, args []string) {
 	notification, err := client.UpdateNotification(context.Background(), notificationID, input)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to mark notification as read: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	recordOperation(cmd, "notification", notificationID, "", nil)
 
 	if jsonOut {
 		output.JSON(notification)
@@ -378,7 +374,7 @@ func runInboxUnread(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	client := api.NewClient(authHeader)
@@ -391,8 +387,9 @@ func runInboxUnread(cmd *cobra.Command, args []string) {
 	notification, err := client.UpdateNotification(context.Background(), notificationID, input)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to mark notification as unread: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	recordOperation(cmd, "notification", notificationID, "", nil)
 
 	if jsonOut {
 		output.JSON(notification)
@@ -415,7 +412,9 @@ Duration can be specified as:
   - 1h, 2h, etc. for hours
   - 1d, 2d, etc. for days
   - 1w for a week
+  - 90m, 1h30m, etc.
   - tomorrow (snooze until 9am tomorrow)
+  - a date (YYYY-MM-DD, 9am) or date and time (YYYY-MM-DDTHH:MM)
 
 Examples:
   linear-cli inbox snooze abc123 1h       # Snooze for 1 hour
@@ -432,50 +431,17 @@ func runInboxSnooze(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	client := api.NewClient(authHeader)
 	notificationID := args[0]
 	durationStr := args[1]
 
-	// Parse the duration
-	var snoozeUntil time.Time
-	switch {
-	case durationStr == "tomorrow":
-		// Snooze until 9am tomorrow
-		now := time.Now()
-		tomorrow := now.AddDate(0, 0, 1)
-		snoozeUntil = time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 9, 0, 0, 0, now.Location())
-	case strings.HasSuffix(durationStr, "w"):
-		weeks, err := parseIntFromSuffix(durationStr, "w")
-		if err != nil {
-			output.Error(fmt.Sprintf("Invalid duration: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
-		snoozeUntil = time.Now().AddDate(0, 0, weeks*7)
-	case strings.HasSuffix(durationStr, "d"):
-		days, err := parseIntFromSuffix(durationStr, "d")
-		if err != nil {
-			output.Error(fmt.Sprintf("Invalid duration: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
-		snoozeUntil = time.Now().AddDate(0, 0, days)
-	case strings.HasSuffix(durationStr, "h"):
-		hours, err := parseIntFromSuffix(durationStr, "h")
-		if err != nil {
-			output.Error(fmt.Sprintf("Invalid duration: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
-		snoozeUntil = time.Now().Add(time.Duration(hours) * time.Hour)
-	default:
-		// Try parsing as a Go duration
-		duration, err := time.ParseDuration(durationStr)
-		if err != nil {
-			output.Error(fmt.Sprintf("Invalid duration format: %s", durationStr), plaintext, jsonOut)
-			os.Exit(1)
-		}
-		snoozeUntil = time.Now().Add(duration)
+	snoozeUntil, err := utils.ParseFutureTime(durationStr, time.Now())
+	if err != nil {
+		output.Error(fmt.Sprintf("Invalid duration: %v", err), plaintext, jsonOut)
+		exit(1)
 	}
 
 	input := api.NotificationUpdateInput{
@@ -485,8 +451,9 @@ func runInboxSnooze(cmd *cobra.Command, args []string) {
 	notification, err := client.UpdateNotification(context.Background(), notificationID, input)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to snooze notification: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	recordOperation(cmd, "notification", notificationID, "", nil)
 
 	if jsonOut {
 		output.JSON(notification)
@@ -500,16 +467,6 @@ func runInboxSnooze(cmd *cobra.Command, args []string) {
 	}
 }
 
-// parseIntFromSuffix parses an integer from a string with a suffix (e.g., "2d" -> 2)
-func parseIntFromSuffix(s, suffix string) (int, error) {
-	numStr := strings.TrimSuffix(s, suffix)
-	var num int
-	_, err := fmt.Sscanf(numStr, "%d", &num)
-	if err != nil {
-		return 0, fmt.Errorf("could not parse number from %s", s)
-	}
-	return num, nil
-}
 
 // inboxArchiveCmd archives a notification
 var inboxArchiveCmd = &cobra.Command{
@@ -530,7 +487,7 @@ func runInboxArchive(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	client := api.NewClient(authHeader)
@@ -539,8 +496,9 @@ func runInboxArchive(cmd *cobra.Command, args []string) {
 	err = client.ArchiveNotification(context.Background(), notificationID)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to archive notification: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	recordOperation(cmd, "notification", notificationID, "", &journal.Inverse{Action: "unarchive"})
 
 	if jsonOut {
 		output.JSON(map[string]interface{}{"success": true, "id": notificationID})
@@ -572,7 +530,7 @@ func runInboxUnarchive(cmd *cobra.Command, args []string) {
 	authHeader, err := auth.GetAuthHeader()
 	if err != nil {
 		output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
 
 	client := api.NewClient(authHeader)
@@ -581,8 +539,9 @@ func runInboxUnarchive(cmd *cobra.Command, args []string) {
 	err = client.UnarchiveNotification(context.Background(), notificationID)
 	if err != nil {
 		output.Error(fmt.Sprintf("Failed to unarchive notification: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
+	recordOperation(cmd, "notification", notificationID, "", &journal.Inverse{Action: "archive"})
 
 	if jsonOut {
 		output.JSON(map[string]interface{}{"success": true, "id": notificationID})
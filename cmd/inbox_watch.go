@@ -0,0 +1,382 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/atomicfile"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// minInboxWatchInterval keeps a forgotten watcher from eating the rate limit
+const minInboxWatchInterval = 10 * time.Second
+
+// inboxSeenRetention is how long a seen notification ID is remembered after
+// it was last unread
+const inboxSeenRetention = 30 * 24 * time.Hour
+
+// desktopNotifier shows a native desktop notification. Tests swap in a fake
+// so they don't pop up real notifications.
+type desktopNotifier func(title, body string) error
+
+var notifyDesktop desktopNotifier = execDesktopNotify
+
+// execDesktopNotify shows a notification with osascript on macOS,
+// PowerShell on Windows, and notify-send elsewhere. The text is passed
+// through the environment so it needs no quoting.
+func execDesktopNotify(title, body string) error {
+	var c *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		c = exec.Command("osascript", "-e",
+			`display notification (system attribute "LINEAR_CLI_NOTIFY_BODY") with title (system attribute "LINEAR_CLI_NOTIFY_TITLE")`)
+	case "windows":
+		c = exec.Command("powershell", "-NoProfile", "-Command", `
+[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$t = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$x = $t.GetElementsByTagName('text')
+$x.Item(0).AppendChild($t.CreateTextNode($env:LINEAR_CLI_NOTIFY_TITLE)) > $null
+$x.Item(1).AppendChild($t.CreateTextNode($env:LINEAR_CLI_NOTIFY_BODY)) > $null
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier('linear-cli').Show([Windows.UI.Notifications.ToastNotification]::new($t))`)
+	default:
+		c = exec.Command("notify-send", "--app-name=linear-cli", title, body)
+	}
+	c.Env = append(os.Environ(), "LINEAR_CLI_NOTIFY_TITLE="+title, "LINEAR_CLI_NOTIFY_BODY="+body)
+	if out, err := c.CombinedOutput(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("%s is not installed or not on PATH", c.Args[0])
+		}
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%s: %s", c.Args[0], msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// inboxSeenState is the watcher's memory of announced notifications, kept
+// across restarts
+type inboxSeenState struct {
+	Seen map[string]time.Time `json:"seen"` // notification ID → last time it was unread
+}
+
+// inboxSeenPath returns the seen-state file, next to the undo journal
+func inboxSeenPath() (string, error) {
+	journalPath, err := journal.Path()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(journalPath), "inbox-seen.json"), nil
+}
+
+// loadInboxSeen reads the seen state. ok is false when there is none yet.
+func loadInboxSeen(path string) (state *inboxSeenState, ok bool, err error) {
+	state = &inboxSeenState{Seen: map[string]time.Time{}}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, false, fmt.Errorf("%s is corrupt (delete it to start over): %w", path, err)
+	}
+	if state.Seen == nil {
+		state.Seen = map[string]time.Time{}
+	}
+	return state, true, nil
+}
+
+// save writes the seen state, forgetting IDs not unread within the retention
+func (s *inboxSeenState) save(path string, now time.Time) error {
+	for id, last := range s.Seen {
+		if now.Sub(last) > inboxSeenRetention {
+			delete(s.Seen, id)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return atomicfile.WriteFile(path, data, 0600)
+}
+
+// notificationSubject returns what a notification is about: the issue
+// identifier or project name, and a title falling back to the issue's
+func notificationSubject(n api.Notification) (ref, title string) {
+	title = n.Title
+	if n.Issue != nil {
+		ref = n.Issue.Identifier
+		if title == "" {
+			title = n.Issue.Title
+		}
+	} else if n.Project != nil {
+		ref = n.Project.Name
+	}
+	return ref, title
+}
+
+// inboxWatcher announces unread notifications it hasn't seen before
+type inboxWatcher struct {
+	client    *api.Client
+	limit     int
+	types     []string // raw types or their short names; empty for all
+	teams     []string // team keys; empty for all
+	notify    bool
+	plaintext bool
+	jsonOut   bool
+
+	path       string
+	seen       *inboxSeenState
+	seeded     bool // false until the first poll on a fresh state file
+	notifyWarn bool
+}
+
+// matches reports whether a notification passes --filter-type and --team
+func (w *inboxWatcher) matches(n api.Notification) bool {
+	if len(w.types) > 0 {
+		ok := false
+		for _, t := range w.types {
+			if strings.EqualFold(t, n.Type) || strings.EqualFold(t, formatNotificationType(n.Type)) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(w.teams) > 0 {
+		if n.Team == nil {
+			return false
+		}
+		ok := false
+		for _, key := range w.teams {
+			if strings.EqualFold(key, n.Team.Key) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// poll fetches unread notifications, announces the new ones oldest first,
+// and saves the seen state. The first poll with no state file only records
+// what is already unread.
+func (w *inboxWatcher) poll(ctx context.Context, now time.Time) (int, error) {
+	notifications, err := w.client.GetNotifications(ctx, w.limit, "", false)
+	if err != nil {
+		return 0, err
+	}
+
+	var fresh []api.Notification
+	for _, n := range notifications.Nodes {
+		if n.ReadAt != nil || (n.SnoozedUntilAt != nil && n.SnoozedUntilAt.After(now)) {
+			continue
+		}
+		_, known := w.seen.Seen[n.ID]
+		w.seen.Seen[n.ID] = now
+		if !known && w.seeded && w.matches(n) {
+			fresh = append(fresh, n)
+		}
+	}
+	if !w.seeded {
+		w.seeded = true
+		if !w.jsonOut {
+			fmt.Fprintf(os.Stderr, "Watching your inbox; %d unread notifications were already there (see 'linear-cli inbox --unread')\n", len(w.seen.Seen))
+		}
+	}
+
+	for i := len(fresh) - 1; i >= 0; i-- {
+		w.announce(fresh[i])
+	}
+	return len(fresh), w.seen.save(w.path, now)
+}
+
+// announce prints one new notification and, with --notify, shows it on the
+// desktop
+func (w *inboxWatcher) announce(n api.Notification) {
+	ref, title := notificationSubject(n)
+	actor, team := "", ""
+	if n.Actor != nil {
+		actor = n.Actor.Name
+	}
+	if n.Team != nil {
+		team = n.Team.Key
+	}
+
+	switch {
+	case w.jsonOut:
+		data, _ := json.Marshal(n)
+		fmt.Println(string(data))
+	case w.plaintext:
+		fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			output.FormatTime(n.CreatedAt, output.DateTimeShort), formatNotificationType(n.Type), ref, title, actor, team, n.ID)
+	default:
+		line := fmt.Sprintf("%s %s", color.New(color.FgWhite).Sprint(n.CreatedAt.In(output.Location()).Format("15:04")), formatNotificationTypeColored(n.Type))
+		if ref != "" {
+			line += " " + color.New(color.FgCyan).Sprint(ref)
+		}
+		line += " " + title
+		if actor != "" {
+			line += color.New(color.FgWhite).Sprintf(" — %s", actor)
+		}
+		fmt.Println(line)
+	}
+
+	if !w.notify {
+		return
+	}
+	heading := formatNotificationType(n.Type)
+	if actor != "" {
+		heading = fmt.Sprintf("%s (%s)", heading, actor)
+	}
+	body := strings.TrimSpace(ref + " " + title)
+	if err := notifyDesktop("Linear: "+heading, body); err != nil && !w.notifyWarn {
+		w.notifyWarn = true
+		fmt.Fprintf(os.Stderr, "Warning: desktop notification failed: %v\n", err)
+	}
+}
+
+// watch polls every interval until ctx is canceled, then saves the seen
+// state. Failed polls after the first are reported and retried.
+func (w *inboxWatcher) watch(ctx context.Context, interval time.Duration) error {
+	if _, err := w.poll(ctx, time.Now()); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return w.seen.save(w.path, time.Now())
+		case <-ticker.C:
+			if _, err := w.poll(ctx, time.Now()); err != nil && ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "Warning: checking the inbox failed, retrying in %s: %v\n", interval, err)
+			}
+		}
+	}
+}
+
+var inboxWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Print new notifications as they arrive",
+	Long: `Poll your unread notifications and print each new one as it arrives.
+
+Notification IDs already announced are kept in
+~/.local/state/linear-cli/inbox-seen.json (or $XDG_STATE_HOME/linear-cli),
+so restarting the watcher doesn't repeat them. The first run records what is
+already unread without printing it. Ctrl-C stops watching.
+
+--notify also shows a desktop notification for each one, using osascript on
+macOS, PowerShell on Windows, and notify-send on Linux. --filter-type takes
+raw notification types (issueMention) or their short names (mentioned);
+--team keeps issue notifications of those teams. Both narrow what is
+announced; everything else is still marked as seen.
+
+With --json each new notification is printed as one JSON object per line.
+
+Examples:
+  linear-cli inbox watch
+  linear-cli inbox watch --interval 30 --notify
+  linear-cli inbox watch --filter-type mentioned,assigned --team ENG
+  linear-cli inbox watch --once   # Check once and exit, e.g. from cron`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		interval, _ := cmd.Flags().GetInt("interval")
+		if time.Duration(interval)*time.Second < minInboxWatchInterval {
+			output.Error(fmt.Sprintf("--interval must be at least %d seconds", int(minInboxWatchInterval.Seconds())), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		path, err := inboxSeenPath()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to locate the inbox state file: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		seen, seeded, err := loadInboxSeen(path)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read the inbox state file: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		w := &inboxWatcher{
+			client:    api.NewClient(authHeader),
+			plaintext: plaintext,
+			jsonOut:   jsonOut,
+			path:      path,
+			seen:      seen,
+			seeded:    seeded,
+		}
+		w.limit, _ = cmd.Flags().GetInt("limit")
+		w.types, _ = cmd.Flags().GetStringSlice("filter-type")
+		teams, err := resolveTeamFlags(w.client, cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		w.teams = teamKeys(teams)
+		w.notify, _ = cmd.Flags().GetBool("notify")
+
+		if once, _ := cmd.Flags().GetBool("once"); once {
+			if _, err := w.poll(context.Background(), time.Now()); err != nil {
+				output.Error(fmt.Sprintf("Failed to get notifications: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			return
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		if err := w.watch(ctx, time.Duration(interval)*time.Second); err != nil {
+			output.Error(fmt.Sprintf("Failed to get notifications: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+	},
+}
+
+func init() {
+	inboxCmd.AddCommand(inboxWatchCmd)
+	inboxWatchCmd.Flags().Int("interval", 60, "Seconds between checks (at least 10)")
+	inboxWatchCmd.Flags().IntP("limit", "l", 50, "Most recent notifications to check each time")
+	inboxWatchCmd.Flags().Bool("notify", false, "Also show a desktop notification for each new one")
+	inboxWatchCmd.Flags().StringSlice("filter-type", nil, "Only announce these types, e.g. mentioned,assigned or issueMention")
+	inboxWatchCmd.Flags().StringSliceP("team", "t", nil, "Only announce notifications from these teams (key, name, or ID)")
+	inboxWatchCmd.Flags().Bool("once", false, "Check once and exit instead of polling")
+}
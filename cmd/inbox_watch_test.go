@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// inboxFixture builds a Notifications response, newest first as the API
+// returns them. Each entry is "ID TYPE TEAM ISSUE"; a trailing "read" marks
+// it read.
+func inboxFixture(entries ...string) string {
+	var nodes []string
+	for i, e := range entries {
+		f := strings.Fields(e)
+		readAt := "null"
+		if len(f) > 4 && f[4] == "read" {
+			readAt = `"2026-03-01T12:00:00Z"`
+		}
+		nodes = append(nodes, fmt.Sprintf(`{"id":%q,"type":%q,"createdAt":"2026-03-01T%02d:00:00Z","updatedAt":"2026-03-01T12:00:00Z","readAt":%s,
+			"actor":{"id":"u1","name":"Jane Doe"},"title":"","team":{"id":"t","key":%q},"issue":{"id":"i","identifier":%q,"title":"Title of %s"}}`,
+			f[0], f[1], 12-i, readAt, f[2], f[3], f[3]))
+	}
+	return `{"notifications":{"nodes":[` + strings.Join(nodes, ",") + `],"pageInfo":{"hasNextPage":false}}}`
+}
+
+func TestHermeticInboxWatch(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-ops","key":"OPS","name":"Operations"}}`)
+	var notified []string
+	notifyDesktop = func(title, body string) error {
+		notified = append(notified, title+": "+body)
+		return nil
+	}
+	t.Cleanup(func() { notifyDesktop = execDesktopNotify })
+
+	// The first run only records what is already unread
+	s.Data("Notifications", inboxFixture("n1 issueAssignedToYou ENG ENG-1", "n0 issueNewComment ENG ENG-0 read"))
+	r := runMocked(t, "inbox", "watch", "--once", "--notify", "--plaintext")
+	if r.Exit != 0 || r.Stdout != "" || !strings.Contains(r.Stderr, "1 unread notifications were already there") {
+		t.Fatalf("first watch exited %d: stdout %q, stderr %q", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	// New arrivals are printed oldest first; a restart doesn't repeat them
+	s.Data("Notifications", inboxFixture("n3 issueMention ENG ENG-3", "n2 issueNewComment OPS OPS-2", "n1 issueAssignedToYou ENG ENG-1"))
+	r = runMocked(t, "inbox", "watch", "--once", "--notify", "--plaintext")
+	lines := strings.Split(strings.TrimSpace(r.Stdout), "\n")
+	if r.Exit != 0 || len(lines) != 2 || !strings.Contains(lines[0], "comment\tOPS-2\tTitle of OPS-2\tJane Doe\tOPS\tn2") || !strings.Contains(lines[1], "mentioned\tENG-3") {
+		t.Errorf("second watch exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(notified, "|"); got != "Linear: comment (Jane Doe): OPS-2 Title of OPS-2|Linear: mentioned (Jane Doe): ENG-3 Title of ENG-3" {
+		t.Errorf("desktop notifications = %s", got)
+	}
+	r = runMocked(t, "inbox", "watch", "--once", "--plaintext")
+	if r.Exit != 0 || r.Stdout != "" {
+		t.Errorf("restarted watch repeated notifications: %q", r.Stdout)
+	}
+
+	// Filters narrow what is announced, but everything is marked seen
+	s.Data("Notifications", inboxFixture("n5 issueMention OPS OPS-5", "n4 issueAssignedToYou ENG ENG-4", "n3 issueMention ENG ENG-3"))
+	r = runMocked(t, "inbox", "watch", "--once", "--team", "ops", "--filter-type", "issueMention", "--json")
+	if r.Exit != 0 || strings.Count(r.Stdout, "\n") != 1 || !strings.Contains(r.Stdout, `"id":"n5"`) {
+		t.Errorf("filtered watch exited %d: %s", r.Exit, r.Stdout)
+	}
+	r = runMocked(t, "inbox", "watch", "--once", "--json")
+	if r.Exit != 0 || r.Stdout != "" {
+		t.Errorf("filtered-out notifications were announced later: %s", r.Stdout)
+	}
+
+	if r := runMocked(t, "inbox", "watch", "--interval", "5"); r.Exit != 1 || !strings.Contains(r.Stderr, "at least 10 seconds") {
+		t.Errorf("--interval 5 exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestInboxWatchStopsAndSaves(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Notifications", inboxFixture("n1 issueMention ENG ENG-1"))
+	path, err := inboxSeenPath()
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen, _, _ := loadInboxSeen(path)
+	w := &inboxWatcher{client: api.NewClient("lin_api_hermetic"), limit: 50, jsonOut: true, path: path, seen: seen}
+
+	// Stop the watcher, as Ctrl-C does, once it has polled twice
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- w.watch(ctx, 10*time.Millisecond) }()
+	for deadline := time.Now().Add(5 * time.Second); len(s.Requests()) < 2 && time.Now().Before(deadline); {
+		time.Sleep(5 * time.Millisecond)
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatalf("watch: %v", err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Fatalf("seen state wasn't saved: %v", err)
+	}
+	reloaded, ok, err := loadInboxSeen(path)
+	if err != nil || !ok || len(reloaded.Seen) != 1 {
+		t.Errorf("reloaded state = %+v, %v, %v", reloaded, ok, err)
+	}
+
+	// IDs unread within the retention are kept; older ones are forgotten
+	reloaded.Seen["old"] = time.Now().Add(-inboxSeenRetention - time.Hour)
+	if err := reloaded.save(path, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := reloaded.Seen["old"]; ok || len(reloaded.Seen) != 1 {
+		t.Errorf("after save, seen = %v", reloaded.Seen)
+	}
+}
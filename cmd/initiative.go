@@ -3,11 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -32,20 +35,37 @@ var initiativeListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List initiatives",
-	Long:    `List Linear initiatives with optional filtering.`,
+	Long: `List Linear initiatives with optional filtering.
+
+Use --tree to show sub-initiatives indented under their parents. Initiatives
+whose parent is not in the result (filtered out or beyond --limit) are shown
+at the top level with a marker.
+
+Examples:
+  linear-cli initiative list --owner me
+  linear-cli initiative list --health atRisk --include-completed
+  linear-cli initiative list --tree
+  linear-cli initiative list --tree --json`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		descriptionLines, _ := cmd.Flags().GetInt("description-lines")
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		filter := make(map[string]interface{})
+		owner, _ := cmd.Flags().GetString("owner")
+		health, _ := cmd.Flags().GetString("health")
+		filter, err := buildInitiativeFilter(owner, health)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid filter: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
 		if status, _ := cmd.Flags().GetString("status"); status != "" {
 			filter["status"] = map[string]interface{}{"eq": status}
 		}
@@ -62,32 +82,29 @@ var initiativeListCmd = &cobra.Command{
 		limit, _ := cmd.Flags().GetInt("limit")
 
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		initiatives, err := client.GetInitiatives(context.Background(), filter, limit, "", orderBy, includeCompleted)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch initiatives: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, initiatives.PageInfo, len(initiatives.Nodes))
 
 		if len(initiatives.Nodes) == 0 {
 			output.Info("No initiatives found", plaintext, jsonOut)
 			return
 		}
 
+		if tree, _ := cmd.Flags().GetBool("tree"); tree {
+			renderInitiativeTree(buildInitiativeTree(initiatives.Nodes), len(initiatives.Nodes), plaintext, jsonOut)
+			return
+		}
+
 		if jsonOut {
 			output.JSON(initiatives.Nodes)
 			return
@@ -108,11 +125,9 @@ var initiativeListCmd = &cobra.Command{
 				if init.TargetDate != nil {
 					fmt.Printf("- **Target Date**: %s\n", *init.TargetDate)
 				}
-				fmt.Printf("- **Created**: %s\n", init.CreatedAt.Format("2006-01-02"))
+				fmt.Printf("- **Created**: %s\n", output.FormatTime(init.CreatedAt, output.DateOnly))
 				fmt.Printf("- **URL**: %s\n", init.URL)
-				if init.Description != "" {
-					fmt.Printf("- **Description**: %s\n", init.Description)
-				}
+				printDescriptionExcerpt(init.Description, descriptionLines)
 				fmt.Println()
 			}
 			fmt.Printf("\nTotal: %d initiatives\n", len(initiatives.Nodes))
@@ -128,20 +143,7 @@ var initiativeListCmd = &cobra.Command{
 				owner = init.Owner.Name
 			}
 
-			statusColor := color.New(color.FgWhite)
-			switch init.Status {
-			case "Planned":
-				statusColor = color.New(color.FgCyan)
-			case "Active":
-				statusColor = color.New(color.FgBlue)
-			case "Completed":
-				statusColor = color.New(color.FgGreen)
-			}
-
-			healthStr := ""
-			if init.Health != "" {
-				healthStr = init.Health
-			}
+			healthStr := formatHealth(init.Health)
 
 			targetDate := ""
 			if init.TargetDate != nil {
@@ -150,7 +152,7 @@ var initiativeListCmd = &cobra.Command{
 
 			rows[i] = []string{
 				truncateString(init.Name, 30),
-				statusColor.Sprint(init.Status),
+				initiativeStatusText(init.Status),
 				healthStr,
 				owner,
 				targetDate,
@@ -166,121 +168,303 @@ var initiativeListCmd = &cobra.Command{
 		fmt.Printf("\n%s %d initiatives\n",
 			color.New(color.FgGreen).Sprint("✓"),
 			len(initiatives.Nodes))
+	},
+}
+
+// initiativeHealthValues maps accepted --health spellings to API values
+var initiativeHealthValues = map[string]string{
+	"ontrack":  "onTrack",
+	"atrisk":   "atRisk",
+	"offtrack": "offTrack",
+}
+
+// buildInitiativeFilter composes the owner and health filters for initiative list
+func buildInitiativeFilter(owner, health string) (map[string]interface{}, error) {
+	filter := make(map[string]interface{})
+
+	switch {
+	case owner == "":
+	case strings.EqualFold(owner, "me"):
+		filter["owner"] = map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}
+	case strings.Contains(owner, "@"):
+		filter["owner"] = map[string]interface{}{"email": map[string]interface{}{"eq": owner}}
+	default:
+		filter["owner"] = map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": owner}}
+	}
+
+	if health != "" {
+		key := strings.ToLower(strings.NewReplacer("_", "", "-", "", " ", "").Replace(health))
+		value, ok := initiativeHealthValues[key]
+		if !ok {
+			return nil, fmt.Errorf("health must be one of onTrack, atRisk, offTrack (got %q)", health)
+		}
+		filter["health"] = map[string]interface{}{"eq": value}
+	}
+
+	return filter, nil
+}
+
+// initiativeTreeNode is an initiative with its sub-initiatives from the same
+// result set. SubInitiatives shadows the embedded field so JSON nests children.
+type initiativeTreeNode struct {
+	api.Initiative
+	Orphan         bool                  `json:"orphan,omitempty"`
+	SubInitiatives []*initiativeTreeNode `json:"subInitiatives"`
+}
+
+// buildInitiativeTree nests initiatives under their parents, keeping the
+// input order. Initiatives whose parent is not in the list become roots
+// marked as orphans.
+func buildInitiativeTree(initiatives []api.Initiative) []*initiativeTreeNode {
+	nodes := make(map[string]*initiativeTreeNode, len(initiatives))
+	for _, init := range initiatives {
+		nodes[init.ID] = &initiativeTreeNode{Initiative: init, SubInitiatives: []*initiativeTreeNode{}}
+	}
+
+	var roots []*initiativeTreeNode
+	for _, init := range initiatives {
+		node := nodes[init.ID]
+		if init.ParentInitiative == nil {
+			roots = append(roots, node)
+			continue
+		}
+		parent, ok := nodes[init.ParentInitiative.ID]
+		if !ok || parent == node {
+			node.Orphan = true
+			roots = append(roots, node)
+			continue
+		}
+		parent.SubInitiatives = append(parent.SubInitiatives, node)
+	}
+	return roots
+}
+
+func renderInitiativeTree(roots []*initiativeTreeNode, total int, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(roots)
+		return
+	}
+
+	if plaintext {
+		fmt.Println("# Initiatives")
+		var walk func(nodes []*initiativeTreeNode, depth int)
+		walk = func(nodes []*initiativeTreeNode, depth int) {
+			for _, node := range nodes {
+				line := fmt.Sprintf("%s- %s [%s]", strings.Repeat("  ", depth), node.Name, node.Status)
+				if node.Health != "" {
+					line += fmt.Sprintf(" (%s)", node.Health)
+				}
+				line += " " + node.ID
+				if node.Orphan {
+					line += fmt.Sprintf(" (parent not listed: %s)", node.ParentInitiative.Name)
+				}
+				fmt.Println(line)
+				walk(node.SubInitiatives, depth+1)
+			}
+		}
+		walk(roots, 0)
+		fmt.Printf("\nTotal: %d initiatives\n", total)
+		return
+	}
+
+	headers := []string{"Name", "Status", "Health", "Owner", "Target Date", "URL"}
+	rows := [][]string{}
+	var walk func(nodes []*initiativeTreeNode, depth int)
+	walk = func(nodes []*initiativeTreeNode, depth int) {
+		for _, node := range nodes {
+			name := node.Name
+			if depth > 0 {
+				name = strings.Repeat("  ", depth-1) + "└ " + name
+			}
+			if node.Orphan {
+				name = "↑ " + name
+			}
+
+			owner := color.New(color.FgYellow).Sprint("Unassigned")
+			if node.Owner != nil {
+				owner = node.Owner.Name
+			}
+			targetDate := ""
+			if node.TargetDate != nil {
+				targetDate = *node.TargetDate
+			}
 
-		if initiatives.PageInfo.HasNextPage {
-			fmt.Printf("%s Use --limit to see more results\n",
+			rows = append(rows, []string{
+				truncateString(name, 40),
+				initiativeStatusText(node.Status),
+				formatHealth(node.Health),
+				owner,
+				targetDate,
+				node.URL,
+			})
+			walk(node.SubInitiatives, depth+1)
+		}
+	}
+	walk(roots, 0)
+
+	output.Table(output.TableData{
+		Headers: headers,
+		Rows:    rows,
+	}, false, false)
+
+	fmt.Printf("\n%s %d initiatives\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		total)
+	for _, root := range roots {
+		if root.Orphan {
+			fmt.Printf("%s ↑ marks sub-initiatives whose parent is not in this list\n",
 				color.New(color.FgYellow).Sprint("ℹ️"))
+			break
 		}
-	},
+	}
+}
+
+// renderInitiativeMarkdown writes an initiative as a markdown document.
+// Sections always appear in the same order; with full, empty sections say "None".
+func renderInitiativeMarkdown(w io.Writer, initiative *api.Initiative, full bool) {
+	d := &markdownDoc{w: w, full: full}
+	d.title(escapeMarkdown(initiative.Name))
+
+	d.section("Description", initiative.Description != "", func() {
+		d.body(initiative.Description, 2)
+	})
+	d.section("Content", initiative.Content != "", func() {
+		d.body(initiative.Content, 2)
+	})
+
+	d.section("Core Details", true, func() {
+		d.field("ID", initiative.ID)
+		d.field("Status", initiative.Status)
+		if initiative.Health != "" {
+			d.field("Health", initiative.Health)
+		}
+		if initiative.TargetDate != nil {
+			d.field("Target Date", *initiative.TargetDate)
+		}
+		if initiative.TargetDateResolution != "" {
+			d.field("Target Date Resolution", initiative.TargetDateResolution)
+		}
+		if initiative.Icon != nil && *initiative.Icon != "" {
+			d.field("Icon", *initiative.Icon)
+		}
+		d.field("Color", initiative.Color)
+	})
+
+	d.section("People", true, func() {
+		if initiative.Owner != nil {
+			d.field("Owner", fmt.Sprintf("%s (%s)", escapeMarkdown(initiative.Owner.Name), initiative.Owner.Email))
+		} else {
+			d.field("Owner", "Unassigned")
+		}
+		if initiative.Creator != nil {
+			d.field("Creator", fmt.Sprintf("%s (%s)", escapeMarkdown(initiative.Creator.Name), initiative.Creator.Email))
+		}
+	})
+
+	d.section("Timeline", true, func() {
+		d.field("Created", output.FormatTime(initiative.CreatedAt, output.DateTime))
+		d.field("Updated", output.FormatTime(initiative.UpdatedAt, output.DateTime))
+		if initiative.StartedAt != nil {
+			d.field("Started", output.FormatTime(*initiative.StartedAt, output.DateTime))
+		}
+		if initiative.CompletedAt != nil {
+			d.field("Completed", output.FormatTime(*initiative.CompletedAt, output.DateTime))
+		}
+		if initiative.ArchivedAt != nil {
+			d.field("Archived", output.FormatTime(*initiative.ArchivedAt, output.DateTime))
+		}
+		if initiative.HealthUpdatedAt != nil {
+			d.field("Health Updated", output.FormatTime(*initiative.HealthUpdatedAt, output.DateTime))
+		}
+	})
+
+	d.section("URL", true, func() {
+		d.printf("- %s\n", initiative.URL)
+	})
+
+	d.section("Parent Initiative", initiative.ParentInitiative != nil, func() {
+		d.printf("- %s (%s)\n", escapeMarkdown(initiative.ParentInitiative.Name), initiative.ParentInitiative.Status)
+	})
+
+	d.section("Sub-Initiatives", initiative.SubInitiatives != nil && len(initiative.SubInitiatives.Nodes) > 0, func() {
+		for _, sub := range initiative.SubInitiatives.Nodes {
+			d.printf("- %s (%s", escapeMarkdown(sub.Name), sub.Status)
+			if sub.Health != "" {
+				d.printf(", %s", sub.Health)
+			}
+			d.printf(")\n")
+		}
+	})
+
+	d.section("Linked Projects", initiative.Projects != nil && len(initiative.Projects.Nodes) > 0, func() {
+		for _, proj := range initiative.Projects.Nodes {
+			d.printf("- %s (%s, %.0f%%)\n", escapeMarkdown(proj.Name), proj.State, proj.Progress*100)
+		}
+	})
 }
 
 var initiativeGetCmd = &cobra.Command{
 	Use:     "get [initiative-id]",
 	Aliases: []string{"show"},
 	Short:   "Get initiative details",
-	Long:    `Get detailed information about a specific initiative.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a specific initiative.
+
+With --plaintext the initiative is printed as a markdown document with sections
+in a fixed order. Add --full to include every section, with "None" for
+sections that have no data.
+
+Every linked project is listed; --projects-limit N stops after N. --rollup
+also fetches each project's issues and totals them, e.g. "42/118 issues
+complete, 310/720 points" (canceled issues are not counted). A project whose
+issues can't be fetched is marked with the error and left out of the total.
+
+Examples:
+  linear-cli initiative get INITIATIVE-ID
+  linear-cli initiative get INITIATIVE-ID -p --full
+  linear-cli initiative get INITIATIVE-ID --rollup
+  linear-cli initiative get INITIATIVE-ID --rollup --projects-limit 20 --json`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		full, _ := cmd.Flags().GetBool("full")
+		projectsLimit, _ := cmd.Flags().GetInt("projects-limit")
+		withRollup, _ := cmd.Flags().GetBool("rollup")
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		initiative, err := client.GetInitiative(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		if err := fetchInitiativeProjects(client, initiative, projectsLimit); err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch the initiative's projects: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		var rollup *initiativeRollup
+		if withRollup {
+			rollup = rollupInitiative(client, initiative.Projects.Nodes)
 		}
 
 		if jsonOut {
-			output.JSON(initiative)
+			output.JSON(struct {
+				*api.Initiative
+				Rollup *initiativeRollup `json:"rollup,omitempty"`
+			}{initiative, rollup})
 			return
 		}
 
 		if plaintext {
-			fmt.Printf("# %s\n\n", initiative.Name)
-
-			if initiative.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", initiative.Description)
-			}
-
-			if initiative.Content != "" {
-				fmt.Printf("## Content\n%s\n\n", initiative.Content)
-			}
-
-			fmt.Printf("## Core Details\n")
-			fmt.Printf("- **ID**: %s\n", initiative.ID)
-			fmt.Printf("- **Status**: %s\n", initiative.Status)
-			if initiative.Health != "" {
-				fmt.Printf("- **Health**: %s\n", initiative.Health)
-			}
-			if initiative.TargetDate != nil {
-				fmt.Printf("- **Target Date**: %s\n", *initiative.TargetDate)
-			}
-			if initiative.TargetDateResolution != "" {
-				fmt.Printf("- **Target Date Resolution**: %s\n", initiative.TargetDateResolution)
-			}
-			if initiative.Icon != nil && *initiative.Icon != "" {
-				fmt.Printf("- **Icon**: %s\n", *initiative.Icon)
-			}
-			fmt.Printf("- **Color**: %s\n", initiative.Color)
-
-			fmt.Printf("\n## People\n")
-			if initiative.Owner != nil {
-				fmt.Printf("- **Owner**: %s (%s)\n", initiative.Owner.Name, initiative.Owner.Email)
-			} else {
-				fmt.Printf("- **Owner**: Unassigned\n")
-			}
-			if initiative.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", initiative.Creator.Name, initiative.Creator.Email)
-			}
-
-			fmt.Printf("\n## Timeline\n")
-			fmt.Printf("- **Created**: %s\n", initiative.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", initiative.UpdatedAt.Format("2006-01-02 15:04:05"))
-			if initiative.StartedAt != nil {
-				fmt.Printf("- **Started**: %s\n", initiative.StartedAt.Format("2006-01-02 15:04:05"))
-			}
-			if initiative.CompletedAt != nil {
-				fmt.Printf("- **Completed**: %s\n", initiative.CompletedAt.Format("2006-01-02 15:04:05"))
-			}
-			if initiative.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", initiative.ArchivedAt.Format("2006-01-02 15:04:05"))
-			}
-			if initiative.HealthUpdatedAt != nil {
-				fmt.Printf("- **Health Updated**: %s\n", initiative.HealthUpdatedAt.Format("2006-01-02 15:04:05"))
-			}
-
-			fmt.Printf("\n## URL\n- %s\n", initiative.URL)
-
-			if initiative.ParentInitiative != nil {
-				fmt.Printf("\n## Parent Initiative\n")
-				fmt.Printf("- %s (%s)\n", initiative.ParentInitiative.Name, initiative.ParentInitiative.Status)
-			}
-
-			if initiative.SubInitiatives != nil && len(initiative.SubInitiatives.Nodes) > 0 {
-				fmt.Printf("\n## Sub-Initiatives\n")
-				for _, sub := range initiative.SubInitiatives.Nodes {
-					fmt.Printf("- %s [%s]", sub.Name, sub.Status)
-					if sub.Health != "" {
-						fmt.Printf(" (%s)", sub.Health)
-					}
-					fmt.Println()
-				}
-			}
-
-			if initiative.Projects != nil && len(initiative.Projects.Nodes) > 0 {
-				fmt.Printf("\n## Linked Projects\n")
-				for _, proj := range initiative.Projects.Nodes {
-					fmt.Printf("- %s [%s] %.0f%%\n", proj.Name, proj.State, proj.Progress*100)
-				}
+			renderInitiativeMarkdown(os.Stdout, initiative, full)
+			if rollup != nil {
+				printRollupPlaintext(rollup)
 			}
-
 			return
 		}
 
@@ -295,19 +479,10 @@ var initiativeGetCmd = &cobra.Command{
 			fmt.Printf("\n%s\n", initiative.Description)
 		}
 
-		statusColor := color.New(color.FgWhite)
-		switch initiative.Status {
-		case "Planned":
-			statusColor = color.New(color.FgCyan)
-		case "Active":
-			statusColor = color.New(color.FgBlue)
-		case "Completed":
-			statusColor = color.New(color.FgGreen)
-		}
-		fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("Status:"), statusColor.Sprint(initiative.Status))
+		fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("Status:"), initiativeStatusText(initiative.Status))
 
 		if initiative.Health != "" {
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Health:"), initiative.Health)
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Health:"), formatHealth(initiative.Health))
 		}
 
 		if initiative.Owner != nil {
@@ -322,13 +497,13 @@ var initiativeGetCmd = &cobra.Command{
 		}
 
 		fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Timeline:"))
-		fmt.Printf("  Created: %s\n", initiative.CreatedAt.Format("2006-01-02"))
-		fmt.Printf("  Updated: %s\n", initiative.UpdatedAt.Format("2006-01-02"))
+		fmt.Printf("  Created: %s\n", output.FormatTime(initiative.CreatedAt, output.DateOnly))
+		fmt.Printf("  Updated: %s\n", output.FormatTime(initiative.UpdatedAt, output.DateOnly))
 		if initiative.StartedAt != nil {
-			fmt.Printf("  Started: %s\n", initiative.StartedAt.Format("2006-01-02"))
+			fmt.Printf("  Started: %s\n", output.FormatTime(*initiative.StartedAt, output.DateOnly))
 		}
 		if initiative.CompletedAt != nil {
-			fmt.Printf("  Completed: %s\n", initiative.CompletedAt.Format("2006-01-02"))
+			fmt.Printf("  Completed: %s\n", output.FormatTime(*initiative.CompletedAt, output.DateOnly))
 		}
 
 		if initiative.URL != "" {
@@ -368,6 +543,9 @@ var initiativeGetCmd = &cobra.Command{
 					progressColor.Sprintf("%.0f%%", proj.Progress*100))
 			}
 		}
+		if rollup != nil {
+			printRollupRich(rollup)
+		}
 
 		fmt.Println()
 	},
@@ -388,12 +566,12 @@ Examples:
   linear-cli initiative create --name "Q1 Goals" --description-file initiative-brief.md`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -401,7 +579,7 @@ Examples:
 		name, _ := cmd.Flags().GetString("name")
 		if name == "" {
 			output.Error("Name is required (--name)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -415,7 +593,7 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
@@ -443,8 +621,12 @@ Examples:
 			tdr, _ := cmd.Flags().GetString("target-date-resolution")
 			input["targetDateResolution"] = tdr
 		}
-		if cmd.Flags().Changed("content") {
-			content, _ := cmd.Flags().GetString("content")
+		content, hasContent, err := proseFromFlags(cmd, "content")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasContent {
 			input["content"] = content
 		}
 		if cmd.Flags().Changed("owner") {
@@ -454,27 +636,16 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = viewer.ID
 			case "none", "unassigned", "":
 				// Don't set ownerId
 			default:
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				foundUser, err := newUserLookup(client).find(owner)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if strings.EqualFold(user.Email, owner) || strings.EqualFold(user.Name, owner) {
-						foundUser = &user
-						break
-					}
-				}
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", owner), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(err.Error(), plaintext, jsonOut, err)
+					exit(1)
 				}
 				input["ownerId"] = foundUser.ID
 			}
@@ -483,21 +654,24 @@ Examples:
 		initiative, err := client.CreateInitiative(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "initiative", initiative.ID, initiative.Name, &journal.Inverse{Action: "delete"})
 
+		if printCreated(cmd, createdEntity{Type: "initiative", ID: initiative.ID, Name: initiative.Name, URL: initiative.URL}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(initiative)
 		} else if plaintext {
 			fmt.Printf("Created initiative: %s (%s)\n", initiative.Name, initiative.ID)
+			printResultURL(initiative.URL, plaintext)
 		} else {
 			fmt.Printf("%s Created initiative %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgCyan, color.Bold).Sprint(initiative.Name))
 			fmt.Printf("  ID: %s\n", initiative.ID)
-			if initiative.URL != "" {
-				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(initiative.URL))
-			}
+			printResultURL(initiative.URL, plaintext)
 		}
 	},
 }
@@ -511,19 +685,24 @@ var initiativeUpdateCmd = &cobra.Command{
 The description can be provided inline via --description or read from a markdown file via --description-file.
 Use --description-file - to read from stdin.
 
+--append-description (or --append-description-file) and --prepend-description
+add text to the current description, separated by a blank line, instead of
+replacing it.
+
 Examples:
   linear-cli initiative update ID --name "New name"
+  linear-cli initiative update ID --append-description "Kickoff held on 2024-06-01"
   linear-cli initiative update ID --status Active
   linear-cli initiative update ID --description-file updated-brief.md`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -540,7 +719,7 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
@@ -571,27 +750,16 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = viewer.ID
 			case "none", "unassigned", "":
 				input["ownerId"] = nil
 			default:
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				foundUser, err := newUserLookup(client).find(owner)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if strings.EqualFold(user.Email, owner) || strings.EqualFold(user.Name, owner) {
-						foundUser = &user
-						break
-					}
-				}
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", owner), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(err.Error(), plaintext, jsonOut, err)
+					exit(1)
 				}
 				input["ownerId"] = foundUser.ID
 			}
@@ -604,30 +772,70 @@ Examples:
 			tdr, _ := cmd.Flags().GetString("target-date-resolution")
 			input["targetDateResolution"] = tdr
 		}
-		if cmd.Flags().Changed("content") {
-			content, _ := cmd.Flags().GetString("content")
+		content, hasContent, err := proseFromFlags(cmd, "content")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasContent {
 			input["content"] = content
 		}
+		// --append-description/--prepend-description merge with the current text
+		edit, hasEdit, err := descriptionEditFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasEdit {
+			read := func(ctx context.Context) (string, time.Time, error) {
+				i, err := client.GetInitiative(ctx, args[0])
+				if err != nil {
+					return "", time.Time{}, err
+				}
+				return i.Description, i.UpdatedAt, nil
+			}
+			current, updatedAt, err := read(context.Background())
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get initiative: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			merged, err := mergeDescription(context.Background(), current, updatedAt, read, edit)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to update the description: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			input["description"] = merged
+		}
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		initiative, err := client.UpdateInitiative(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "initiative", initiative.ID, initiative.Name, nil)
 
+		if urlOnly(cmd) {
+			printURLOnly(initiative.URL, "initiative")
+			return
+		}
 		if jsonOut {
 			output.JSON(initiative)
 		} else if plaintext {
 			fmt.Printf("Updated initiative: %s\n", initiative.Name)
+			printResultURL(initiative.URL, plaintext)
 		} else {
 			fmt.Printf("%s Updated initiative %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgCyan, color.Bold).Sprint(initiative.Name))
+			printResultURL(initiative.URL, plaintext)
+		}
+		if hasEdit && !jsonOut {
+			printDescriptionPreview(edit, input["description"].(string), plaintext)
 		}
 	},
 }
@@ -645,15 +853,16 @@ var initiativeDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		err = client.DeleteInitiative(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "initiative", args[0], "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{
@@ -683,7 +892,7 @@ var initiativeProjectsCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -692,8 +901,9 @@ var initiativeProjectsCmd = &cobra.Command{
 		projects, err := client.GetInitiativeProjects(context.Background(), initiativeID, limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get initiative projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, projects.PageInfo, len(projects.Nodes))
 
 		if jsonOut {
 			output.JSON(projects.Nodes)
@@ -729,22 +939,11 @@ var initiativeProjectsCmd = &cobra.Command{
 				if p.Lead != nil {
 					lead = p.Lead.Name
 				}
-				stateColor := color.New(color.FgWhite)
-				switch p.State {
-				case "started":
-					stateColor = color.New(color.FgYellow)
-				case "completed":
-					stateColor = color.New(color.FgGreen)
-				case "canceled":
-					stateColor = color.New(color.FgRed)
-				case "planned":
-					stateColor = color.New(color.FgCyan)
-				}
 
 				rows = append(rows, []string{
 					p.ID[:8],
 					color.New(color.FgWhite, color.Bold).Sprint(p.Name),
-					stateColor.Sprint(p.State),
+					projectStateText(p.State),
 					fmt.Sprintf("%.0f%%", p.Progress*100),
 					lead,
 				})
@@ -777,7 +976,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -787,14 +986,14 @@ Examples:
 		initiative, err := client.GetInitiative(ctx, initiativeID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Check which projects are already linked
 		existingProjects, err := client.GetInitiativeProjects(ctx, initiativeID, 100, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get initiative projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		existingIDs := make(map[string]bool)
@@ -820,6 +1019,7 @@ Examples:
 				}
 				continue
 			}
+			recordOperation(cmd, "initiative", initiativeID, "", nil)
 			addedProjects = append(addedProjects, projectID)
 			if link.Project != nil {
 				results = append(results, map[string]interface{}{
@@ -889,7 +1089,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -899,14 +1099,14 @@ Examples:
 		initiative, err := client.GetInitiative(ctx, initiativeID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get initiative: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Check which projects are currently linked
 		existingProjects, err := client.GetInitiativeProjects(ctx, initiativeID, 100, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get initiative projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		existingIDs := make(map[string]string) // map project ID to name
@@ -931,6 +1131,7 @@ Examples:
 				}
 				continue
 			}
+			recordOperation(cmd, "initiative", initiativeID, "", nil)
 			removedProjects = append(removedProjects, map[string]string{
 				"projectId":   projectID,
 				"projectName": existingIDs[projectID],
@@ -989,16 +1190,25 @@ func init() {
 	// Initiative projects flags
 	initiativeProjectsCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return")
 
+	// Get flags
+	initiativeGetCmd.Flags().Bool("full", false, "With --plaintext, print every section, marking empty ones None")
+	initiativeGetCmd.Flags().Int("projects-limit", 0, "Maximum number of linked projects to show (0 = all)")
+	initiativeGetCmd.Flags().Bool("rollup", false, "Total the issues and estimates of every linked project")
+
 	// List flags
 	initiativeListCmd.Flags().StringP("status", "s", "", "Filter by status (Planned, Active, Completed)")
 	initiativeListCmd.Flags().IntP("limit", "l", 50, "Maximum number of initiatives to fetch")
 	initiativeListCmd.Flags().BoolP("include-completed", "c", false, "Include completed initiatives")
 	initiativeListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	initiativeListCmd.Flags().String("owner", "", "Filter by owner: me, email, or name")
+	initiativeListCmd.Flags().String("health", "", "Filter by health: onTrack, atRisk, offTrack")
+	initiativeListCmd.Flags().Bool("tree", false, "Show sub-initiatives indented under their parents")
+	initiativeListCmd.Flags().Int("description-lines", defaultDescriptionLines, "Description lines per item in plaintext output (0 for none, -1 for the full text)")
 
 	// Create flags
 	initiativeCreateCmd.Flags().String("name", "", "Initiative name (required)")
 	initiativeCreateCmd.Flags().StringP("description", "d", "", "Initiative description")
-	initiativeCreateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
+	initiativeCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	initiativeCreateCmd.Flags().StringP("status", "s", "", "Status (Planned, Active, Completed)")
 	initiativeCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
 	initiativeCreateCmd.Flags().String("target-date-resolution", "", "Target date resolution (month, quarter, halfYear, year)")
@@ -1006,13 +1216,17 @@ func init() {
 	initiativeCreateCmd.Flags().String("icon", "", "Initiative icon")
 	initiativeCreateCmd.Flags().Float64("sort-order", 0, "Sort order (float)")
 	initiativeCreateCmd.Flags().String("content", "", "Initiative content (markdown)")
+	initiativeCreateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
 	initiativeCreateCmd.Flags().StringP("owner", "o", "", "Initiative owner (email, name, or 'me')")
+	addURLOnlyFlag(initiativeCreateCmd)
+	addPorcelainFlag(initiativeCreateCmd)
 	_ = initiativeCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
 	initiativeUpdateCmd.Flags().String("name", "", "New name")
 	initiativeUpdateCmd.Flags().StringP("description", "d", "", "New description")
-	initiativeUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
+	initiativeUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	addDescriptionEditFlags(initiativeUpdateCmd)
 	initiativeUpdateCmd.Flags().StringP("status", "s", "", "New status (Planned, Active, Completed)")
 	initiativeUpdateCmd.Flags().String("target-date", "", "New target date (YYYY-MM-DD, or empty to remove)")
 	initiativeUpdateCmd.Flags().String("target-date-resolution", "", "Target date resolution (month, quarter, halfYear, year)")
@@ -1021,4 +1235,6 @@ func init() {
 	initiativeUpdateCmd.Flags().StringP("owner", "o", "", "Initiative owner (email, name, 'me', or 'none' to unset)")
 	initiativeUpdateCmd.Flags().Float64("sort-order", 0, "Sort order (float)")
 	initiativeUpdateCmd.Flags().String("content", "", "Initiative content (markdown)")
+	initiativeUpdateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
+	addURLOnlyFlag(initiativeUpdateCmd)
 }
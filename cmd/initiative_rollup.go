@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+)
+
+// initiativeRollupConcurrency caps the project issue fetches of --rollup
+const initiativeRollupConcurrency = 4
+
+// rollupBarWidth is the width of the rich-mode progress bars
+const rollupBarWidth = 20
+
+// projectRollup is one linked project's issue totals. Error is set instead
+// of Summary when its issues couldn't be fetched.
+type projectRollup struct {
+	ID      string             `json:"id"`
+	Name    string             `json:"name"`
+	Summary *issueGroupSummary `json:"summary,omitempty"`
+	Error   string             `json:"error,omitempty"`
+}
+
+// initiativeRollup is what 'initiative get --rollup' adds: per-project
+// totals and their sum over the projects that could be fetched
+type initiativeRollup struct {
+	Projects []projectRollup   `json:"projects"`
+	Total    issueGroupSummary `json:"total"`
+	Failed   int               `json:"failed"`
+}
+
+// rollupText renders a summary as "42/118 issues complete, 310/720 points"
+func rollupText(s issueGroupSummary) string {
+	return fmt.Sprintf("%d/%d issues complete, %s/%s points", s.Done, s.Total, formatPoints(s.DonePoints), formatPoints(s.TotalPoints))
+}
+
+// progressBar draws done/total as a bar of width cells
+func progressBar(done, total float64, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = int(done / total * float64(width))
+	}
+	filled = min(max(filled, 0), width)
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
+
+// fetchInitiativeProjects completes the initiative's projects connection,
+// which GetInitiative returns only the first page of. limit caps the total;
+// 0 means every project.
+func fetchInitiativeProjects(client *api.Client, initiative *api.Initiative, limit int) error {
+	if initiative.Projects == nil {
+		initiative.Projects = &api.Projects{}
+	}
+	projects := initiative.Projects
+	for projects.PageInfo.HasNextPage && projects.PageInfo.EndCursor != "" && (limit <= 0 || len(projects.Nodes) < limit) {
+		page, err := client.GetInitiativeProjects(context.Background(), initiative.ID, 50, projects.PageInfo.EndCursor)
+		if err != nil {
+			return err
+		}
+		projects.Nodes = append(projects.Nodes, page.Nodes...)
+		projects.PageInfo = page.PageInfo
+	}
+	if limit > 0 && len(projects.Nodes) > limit {
+		projects.Nodes = projects.Nodes[:limit]
+		projects.PageInfo.HasNextPage = true
+	}
+	return nil
+}
+
+// rollupInitiative fetches every project's issues concurrently and totals
+// them. A project that fails is marked with its error and left out of the
+// total rather than failing the rollup.
+func rollupInitiative(client *api.Client, projects []api.Project) *initiativeRollup {
+	rollup := &initiativeRollup{Projects: make([]projectRollup, len(projects))}
+	sem := make(chan struct{}, initiativeRollupConcurrency)
+	var wg sync.WaitGroup
+	for i, project := range projects {
+		rollup.Projects[i] = projectRollup{ID: project.ID, Name: project.Name}
+		wg.Add(1)
+		go func(i int, projectID string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			issues, err := fetchAllIssuePages(func(after string) (*api.Issues, error) {
+				return client.GetProjectIssues(context.Background(), projectID, nil, 100, after)
+			})
+			if err != nil {
+				rollup.Projects[i].Error = err.Error()
+				return
+			}
+			summary := &issueGroupSummary{}
+			for _, issue := range issues.Nodes {
+				summary.add(issue)
+			}
+			rollup.Projects[i].Summary = summary
+		}(i, project.ID)
+	}
+	wg.Wait()
+
+	for _, p := range rollup.Projects {
+		if p.Summary == nil {
+			rollup.Failed++
+			continue
+		}
+		rollup.Total.Done += p.Summary.Done
+		rollup.Total.Total += p.Summary.Total
+		rollup.Total.DonePoints += p.Summary.DonePoints
+		rollup.Total.TotalPoints += p.Summary.TotalPoints
+	}
+	return rollup
+}
+
+// printRollupPlaintext writes the rollup as a markdown section
+func printRollupPlaintext(rollup *initiativeRollup) {
+	fmt.Println("\n## Rollup")
+	for _, p := range rollup.Projects {
+		if p.Summary == nil {
+			fmt.Printf("- %s: error: %s\n", escapeMarkdown(p.Name), p.Error)
+			continue
+		}
+		fmt.Printf("- %s: %s\n", escapeMarkdown(p.Name), rollupText(*p.Summary))
+	}
+	fmt.Printf("\nTotal: %s\n", rollupText(rollup.Total))
+	if rollup.Failed > 0 {
+		fmt.Printf("(%d projects could not be fetched and are not counted)\n", rollup.Failed)
+	}
+}
+
+// printRollupRich draws a progress bar per project and the initiative total
+func printRollupRich(rollup *initiativeRollup) {
+	fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Rollup:"))
+	width := 0
+	for _, p := range rollup.Projects {
+		width = max(width, len(p.Name))
+	}
+	width = min(max(width, len("Total")), 40)
+	for _, p := range rollup.Projects {
+		name := fmt.Sprintf("%-*s", width, truncateString(p.Name, width))
+		if p.Summary == nil {
+			fmt.Printf("  %s  %s\n", color.New(color.FgCyan).Sprint(name),
+				color.New(color.FgRed).Sprintf("✗ %s", p.Error))
+			continue
+		}
+		fmt.Printf("  %s  %s  %s\n", color.New(color.FgCyan).Sprint(name),
+			color.New(color.FgGreen).Sprint(progressBar(float64(p.Summary.Done), float64(p.Summary.Total), rollupBarWidth)),
+			rollupText(*p.Summary))
+	}
+	fmt.Printf("  %s  %s  %s\n", color.New(color.Bold).Sprintf("%-*s", width, "Total"),
+		color.New(color.FgGreen, color.Bold).Sprint(progressBar(float64(rollup.Total.Done), float64(rollup.Total.Total), rollupBarWidth)),
+		color.New(color.Bold).Sprint(rollupText(rollup.Total)))
+	if rollup.Failed > 0 {
+		fmt.Printf("  %s %d projects could not be fetched and are not counted\n",
+			color.New(color.FgYellow).Sprint("⚠"), rollup.Failed)
+	}
+}
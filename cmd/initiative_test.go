@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+func TestBuildInitiativeFilter(t *testing.T) {
+	tests := []struct {
+		name, owner, health string
+		want                string
+	}{
+		{"empty", "", "", `{}`},
+		{"me", "me", "", `{"owner":{"isMe":{"eq":true}}}`},
+		{"email", "ada@example.com", "", `{"owner":{"email":{"eq":"ada@example.com"}}}`},
+		{"name", "Ada Lovelace", "", `{"owner":{"name":{"eqIgnoreCase":"Ada Lovelace"}}}`},
+		{"health normalized", "", "at-risk", `{"health":{"eq":"atRisk"}}`},
+		{"both", "ME", "offTrack", `{"health":{"eq":"offTrack"},"owner":{"isMe":{"eq":true}}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := buildInitiativeFilter(tt.owner, tt.health)
+			if err != nil {
+				t.Fatalf("buildInitiativeFilter returned error: %v", err)
+			}
+			got, _ := json.Marshal(filter)
+			if string(got) != tt.want {
+				t.Errorf("filter mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := buildInitiativeFilter("", "great"); err == nil {
+		t.Error("expected error for invalid health")
+	}
+}
+
+func TestBuildInitiativeTree(t *testing.T) {
+	initiatives := []api.Initiative{
+		{ID: "child-b", Name: "Child B", ParentInitiative: &api.Initiative{ID: "root"}},
+		{ID: "root", Name: "Root"},
+		{ID: "grandchild", Name: "Grandchild", ParentInitiative: &api.Initiative{ID: "child-b"}},
+		{ID: "child-a", Name: "Child A", ParentInitiative: &api.Initiative{ID: "root"}},
+		{ID: "orphan", Name: "Orphan", ParentInitiative: &api.Initiative{ID: "missing", Name: "Hidden"}},
+	}
+
+	roots := buildInitiativeTree(initiatives)
+	if len(roots) != 2 {
+		t.Fatalf("got %d roots, want 2", len(roots))
+	}
+	if roots[0].ID != "root" || roots[0].Orphan {
+		t.Errorf("first root = %s (orphan=%v), want root", roots[0].ID, roots[0].Orphan)
+	}
+	if roots[1].ID != "orphan" || !roots[1].Orphan {
+		t.Errorf("second root = %s (orphan=%v), want orphan", roots[1].ID, roots[1].Orphan)
+	}
+
+	children := roots[0].SubInitiatives
+	if len(children) != 2 || children[0].ID != "child-b" || children[1].ID != "child-a" {
+		t.Fatalf("children of root not in input order: %+v", children)
+	}
+	if len(children[0].SubInitiatives) != 1 || children[0].SubInitiatives[0].ID != "grandchild" {
+		t.Errorf("grandchild not nested under child-b")
+	}
+
+	// JSON nests children under subInitiatives instead of the API's connection
+	data, _ := json.Marshal(roots[0])
+	var decoded struct {
+		SubInitiatives []struct {
+			ID string `json:"id"`
+		} `json:"subInitiatives"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.SubInitiatives) != 2 || decoded.SubInitiatives[0].ID != "child-b" {
+		t.Errorf("JSON subInitiatives = %+v", decoded.SubInitiatives)
+	}
+}
+
+func TestHermeticInitiativeRollup(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Initiative", `{"initiative":{"id":"init-1","name":"Payments","status":"Active",
+		"projects":{"nodes":[{"id":"p1","name":"Checkout","state":"started","progress":0.5}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+	s.Data("InitiativeProjects", `{"initiative":{"projects":{"nodes":[
+		{"id":"p2","name":"Refunds","state":"planned","progress":0},
+		{"id":"p3","name":"Payouts","state":"started","progress":0.1}],"pageInfo":{"hasNextPage":false}}}}`)
+	issues := func(nodes string) string {
+		return `{"project":{"issues":{"nodes":[` + nodes + `],"pageInfo":{"hasNextPage":false}}}}`
+	}
+	s.DataFor("ProjectIssues", map[string]interface{}{"id": "p1"}, issues(`
+		{"id":"i1","estimate":3,"state":{"type":"completed"}},
+		{"id":"i2","estimate":5,"state":{"type":"started"}},
+		{"id":"i3","estimate":8,"state":{"type":"canceled"}}`))
+	s.DataFor("ProjectIssues", map[string]interface{}{"id": "p2"}, issues(`
+		{"id":"i4","estimate":2,"state":{"type":"completed"}},
+		{"id":"i5","state":{"type":"backlog"}}`))
+	s.Add(linearmock.Stub{Operation: "ProjectIssues", Variables: map[string]interface{}{"id": "p3"},
+		Response: json.RawMessage(`{"errors":[{"message":"Entity not found"}]}`)})
+
+	r := runMocked(t, "initiative", "get", "init-1", "--rollup", "--plaintext")
+	if r.Exit != 0 {
+		t.Fatalf("initiative get --rollup exited %d: %s", r.Exit, r.Stderr)
+	}
+	for _, want := range []string{
+		"- Refunds (planned, 0%)",
+		"- Checkout: 1/2 issues complete, 3/8 points",
+		"- Payouts: error: ",
+		"Total: 2/4 issues complete, 5/10 points",
+		"(1 projects could not be fetched and are not counted)",
+	} {
+		if !strings.Contains(r.Stdout, want) {
+			t.Errorf("output lacks %q:\n%s", want, r.Stdout)
+		}
+	}
+
+	r = runMocked(t, "initiative", "get", "init-1", "--rollup", "--projects-limit", "2", "--json")
+	var got struct {
+		Projects api.Projects     `json:"projects"`
+		Rollup   initiativeRollup `json:"rollup"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil {
+		t.Fatalf("--json: %v\n%s", err, r.Stdout)
+	}
+	if len(got.Projects.Nodes) != 2 || len(got.Rollup.Projects) != 2 || got.Rollup.Failed != 0 {
+		t.Errorf("--projects-limit 2: %d projects, rollup %+v", len(got.Projects.Nodes), got.Rollup)
+	}
+	if want := (issueGroupSummary{Done: 2, Total: 4, DonePoints: 5, TotalPoints: 10}); got.Rollup.Total != want {
+		t.Errorf("rollup total = %+v, want %+v", got.Rollup.Total, want)
+	}
+}
@@ -3,11 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
@@ -35,89 +38,384 @@ var issueListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List issues",
-	Long:    `List Linear issues with optional filtering.`,
+	Long: `List Linear issues with optional filtering.
+
+When --team is not given and the working directory is mapped to a team in the
+repo's .linear-cli.yaml (see 'issue create --help'), that team is used.
+Pass --no-auto-team to disable.
+
+--parent lists the direct sub-issues of an issue and --top-level leaves
+sub-issues out; both combine with the other filters. --with-children adds a
+Children column (childCount in JSON) with each issue's sub-issue count.
+
+--team can be repeated or comma-separated to list several teams at once. The
+issues are merged newest first (by update time with --sort updated) with a
+Team column, and the footer counts each team; --group-by team prints one
+section per team instead. JSON output stays a flat array, each issue with its
+team.
+
+Snoozed issues are hidden until their snooze ends; pass --include-snoozed to
+list them too.
+
+--sort priority|estimate|due|title|state sorts after fetching, since the API
+only orders by creation and update time. Priority puts Urgent first and no
+priority last, estimate puts the largest first, and due the soonest; --asc
+and --desc flip the direction. Only the fetched issues (--limit) are sorted;
+--all fetches every match first.
+
+--ids-only prints one identifier per line and --count just the number of
+matching issues; both fetch only the fields they need and work with every
+filter, --view, and --all. --count always counts every match, ignoring
+--limit. Neither can be combined with --json or --plaintext.
+
+--sample N prints N issues picked at random from every match (fetching up to
+5000, whatever --limit says), and a footer says how many matched so the
+sample isn't mistaken for the full list, along with the random seed. --shuffle
+prints the fetched issues in random order. Pass --seed to repeat a pick.
+
+With --all, --json, --plaintext, and --ids-only print each page as it
+arrives instead of waiting for the last one, unless a client-side --sort,
+--sample, or several teams need every issue first. If a page fails partway,
+the JSON array is still closed, the error goes to stderr, and the exit code is
+1. The table waits for every page, noting progress on stderr.
+
+--compact prints one line per issue sized to the terminal, for narrow panes:
+a colored state glyph, the identifier, the title cut to fit, and the
+assignee's initials, with no headers or footers. --watch repaints the list
+in place every --interval seconds (default 30) until Ctrl-C, under a
+one-line header unless --compact is given too, and redraws a resized
+terminal within a second. Both work with the filters and --view, but not
+with --json, --ids-only, --count, --sample, or --group-by.
+
+Examples:
+  linear-cli issue list --parent ROB-123
+  linear-cli issue list --team ENG --sort priority --all
+  linear-cli issue list --team ENG,OPS,DESIGN --assignee me
+  linear-cli issue list --team ENG --team OPS --group-by team
+  linear-cli issue list --team ROB --top-level --with-children
+  linear-cli issue list --assignee me --top-level --json
+  linear-cli issue list --team ENG --breached --count
+  linear-cli issue list --team ENG --state Todo --ids-only --all
+  linear-cli issue list --team ENG --sample 5 --seed 42
+  linear-cli issue list --team ENG --priority 1 --watch --compact`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		descriptionLines, _ := cmd.Flags().GetInt("description-lines")
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
 		// Check if --view flag is set (execute custom view instead of filter)
+		issueSorting, err := issueSortFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		fetchAll, _ := cmd.Flags().GetBool("all")
+		script, err := scriptOutputFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		// --count counts every match, not just a page
+		fetchAll = fetchAll || script.Count
+		sample, err := issueSampleFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		// --sample picks from every match, up to a cap
+		maxFetch := 0
+		if sample.Size > 0 {
+			fetchAll = true
+			maxFetch = sampleCandidateCap
+		}
+		sampleToStderr := jsonOut || script.enabled()
+		dash, err := dashboardFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		dash.Noun = "issues"
+
 		viewID, _ := cmd.Flags().GetString("view")
 		if viewID != "" {
-			limit, _ := cmd.Flags().GetInt("limit")
-			if limit == 0 {
-				limit = 50
+			if dash.enabled() {
+				dash.show(func(ctx context.Context) ([]compactItem, error) {
+					issues, err := fetchViewIssues(cmd, client, viewID, fetchAll)
+					if err != nil {
+						return nil, err
+					}
+					issueSorting.withoutOrderBy().apply(issues.Nodes)
+					return issueCompactItems(issues.Nodes), nil
+				}, plaintext)
+				return
+			}
+			fetchView := func(after string) (*api.Issues, error) {
+				return client.GetCustomViewIssues(context.Background(), viewID, 100, after)
+			}
+			if stream := newIssueStream(plaintext, jsonOut, script, issueSorting.withoutOrderBy(), sample, descriptionLines, "# View Results"); fetchAll && stream != nil {
+				if _, err := fetchIssuePagesEach(fetchView, 0, stream.page); err != nil {
+					stream.fail(fmt.Sprintf("Failed to execute view: %v", err))
+					exit(1)
+				}
+				stream.finish("No issues in this view", "issues")
+				return
+			}
+			var issues *api.Issues
+			if fetchAll && !jsonOut && !plaintext && !script.enabled() {
+				issues, err = fetchIssuePagesUpTo(reportIssuePages(fetchView), maxFetch)
+			} else if maxFetch > 0 {
+				issues, err = fetchIssuePagesUpTo(func(after string) (*api.Issues, error) {
+					return client.GetCustomViewIssues(context.Background(), viewID, 100, after)
+				}, maxFetch)
+			} else {
+				issues, err = fetchViewIssues(cmd, client, viewID, fetchAll)
 			}
-			issues, err := client.GetCustomViewIssues(context.Background(), viewID, limit, "")
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to execute view: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
+			}
+			matched, capped := len(issues.Nodes), issues.PageInfo.HasNextPage
+			if sample.Size == 0 {
+				warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
+			}
+			issues.Nodes = sample.apply(issues.Nodes)
+			viewSorting := issueSorting.withoutOrderBy()
+			viewSorting.apply(issues.Nodes)
+			viewSorting.warnSortedWindow(issues.PageInfo, len(issues.Nodes))
+			if script.enabled() {
+				script.printIssues(issues.Nodes)
+			} else {
+				renderIssueCollection(issues, plaintext, jsonOut, "No issues in this view", "issues", "# View Results", descriptionLines)
 			}
-			renderIssueCollection(issues, plaintext, jsonOut, "No issues in this view", "issues", "# View Results")
+			sample.printFooter(len(issues.Nodes), matched, capped, plaintext, sampleToStderr)
 			return
 		}
 
+		applyAutoTeam(cmd)
+
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		if groupBy != "" && groupBy != "team" {
+			output.Error(fmt.Sprintf("invalid --group-by value %q: use team", groupBy), plaintext, jsonOut)
+			exit(1)
+		}
+		teams, err := resolveTeamFlags(client, cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+
 		// Build filter from flags
-		filter := buildIssueFilter(cmd)
+		filter := buildIssueFilterFromFlags(cmd, teams)
 
-		// Handle --parent filter: resolve identifier to UUID if needed
+		// Handle --parent filter: the filter needs the parent's UUID
 		if parentVal, _ := cmd.Flags().GetString("parent"); parentVal != "" {
-			parentID := parentVal
-			// If it looks like an identifier (contains hyphen, not a UUID), resolve it
-			if strings.Contains(parentVal, "-") && !isUUID(parentVal) {
-				parentIssue, err := client.GetIssue(context.Background(), parentVal)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve parent issue '%s': %v", parentVal, err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				parentID = parentIssue.ID
+			parentIssue, err := resolveIssueRef(context.Background(), client, parentVal)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve parent: %v", err), plaintext, jsonOut)
+				exit(1)
 			}
 			filter["parent"] = map[string]interface{}{
-				"id": map[string]interface{}{"eq": parentID},
+				"id": map[string]interface{}{"eq": parentIssue.ID},
 			}
 		}
+		if topLevel, _ := cmd.Flags().GetBool("top-level"); topLevel {
+			filter["parent"] = map[string]interface{}{"null": true}
+		}
+
+		// Handle --breached: open issues whose due date has passed
+		breached, _ := cmd.Flags().GetBool("breached")
+		if breached {
+			applyBreachedFilter(filter, time.Now())
+		}
+		if includeSnoozed, _ := cmd.Flags().GetBool("include-snoozed"); !includeSnoozed {
+			applySnoozedFilter(filter, time.Now())
+		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
 			limit = 50
 		}
 
-		// Get sort option
-		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+		orderBy := issueSorting.OrderBy
+
+		fields := issueListFields(plaintext, jsonOut)
+		withChildren, _ := cmd.Flags().GetBool("with-children")
+		if withChildren {
+			fields |= api.IssueFieldChildren
+		}
+		if script.enabled() {
+			fields = scriptIssueFields(issueSorting, breached)
+		}
+
+		// prepare finishes a page of fetched issues for output
+		prepare := func(nodes []api.Issue) []api.Issue {
+			if withChildren {
+				countIssueChildren(nodes)
+			}
+			if breached {
+				// The server filter is date-based; re-check against the local end of day
+				kept := nodes[:0]
+				for _, issue := range nodes {
+					if _, overdue := issueOverdue(&issue, time.Now()); overdue {
+						kept = append(kept, issue)
+					}
+				}
+				nodes = kept
 			}
+			return nodes
 		}
 
-		issues, err := client.GetIssues(context.Background(), filter, limit, "", orderBy)
+		fetchPage := func(after string) (*api.Issues, error) {
+			return client.GetIssuesWithFields(context.Background(), filter, 250, after, orderBy, fields)
+		}
+		if dash.enabled() {
+			dash.show(func(ctx context.Context) ([]compactItem, error) {
+				var issues *api.Issues
+				var err error
+				switch {
+				case fetchAll:
+					issues, err = fetchAllIssuePages(fetchPage)
+				case len(teams) > 1:
+					issues, err = fetchTeamsIssues(client, filter, teams, limit, orderBy, fields)
+				default:
+					issues, err = client.GetIssuesWithFields(ctx, filter, limit, "", orderBy, fields)
+				}
+				if err != nil {
+					return nil, err
+				}
+				nodes := prepare(issues.Nodes)
+				issueSorting.apply(nodes)
+				return issueCompactItems(nodes), nil
+			}, plaintext)
+			return
+		}
+
+		stream := newIssueStream(plaintext, jsonOut, script, issueSorting, sample, descriptionLines, "# Issues")
+		if fetchAll && stream != nil && len(teams) <= 1 && groupBy == "" {
+			_, err := fetchIssuePagesEach(fetchPage, 0, func(page []api.Issue) error {
+				return stream.page(prepare(page))
+			})
+			if err != nil {
+				stream.fail(fmt.Sprintf("Failed to fetch issues: %v", err))
+				exit(1)
+			}
+			stream.finish("No issues found", "issues")
+			return
+		}
+
+		var issues *api.Issues
+		if fetchAll {
+			if !jsonOut && !plaintext && !script.enabled() {
+				fetchPage = reportIssuePages(fetchPage)
+			}
+			issues, err = fetchIssuePagesUpTo(fetchPage, maxFetch)
+		} else if len(teams) > 1 {
+			issues, err = fetchTeamsIssues(client, filter, teams, limit, orderBy, fields)
+		} else {
+			issues, err = client.GetIssuesWithFields(context.Background(), filter, limit, "", orderBy, fields)
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		capped := issues.PageInfo.HasNextPage
+		if sample.Size == 0 {
+			warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
+		}
+
+		issues.Nodes = prepare(issues.Nodes)
+		matched := len(issues.Nodes)
+		issues.Nodes = sample.apply(issues.Nodes)
+		issueSorting.apply(issues.Nodes)
+		issueSorting.warnSortedWindow(issues.PageInfo, len(issues.Nodes))
 
-		renderIssueCollection(issues, plaintext, jsonOut, "No issues found", "issues", "# Issues")
+		switch {
+		case script.enabled():
+			script.printIssues(issues.Nodes)
+		case len(teams) > 1 || groupBy == "team":
+			renderTeamIssues(issues.Nodes, teamKeys(teams), groupBy == "team", plaintext, jsonOut, descriptionLines)
+		default:
+			renderIssueCollection(issues, plaintext, jsonOut, "No issues found", "issues", "# Issues", descriptionLines)
+		}
+		sample.printFooter(len(issues.Nodes), matched, capped, plaintext, sampleToStderr)
 	},
 }
 
-func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string) {
+// countIssueChildren replaces each issue's fetched child list with its count
+func countIssueChildren(issues []api.Issue) {
+	for i := range issues {
+		count := 0
+		if issues[i].Children != nil {
+			count = len(issues[i].Children.Nodes)
+		}
+		issues[i].ChildCount = &count
+		issues[i].Children = nil
+	}
+}
+
+// issueListFields picks the issue fields to request for list output: the
+// colored table only needs what it renders, while JSON and plaintext show more.
+func issueListFields(plaintext, jsonOut bool) api.IssueFields {
+	if jsonOut || plaintext {
+		return api.IssueFieldsFull
+	}
+	return api.IssueFieldsTable
+}
+
+// issueOverdue reports whether an open issue is past its due date, and by how many days.
+// Completed and canceled issues are never overdue.
+func issueOverdue(issue *api.Issue, now time.Time) (int, bool) {
+	if issue.DueDate == nil || *issue.DueDate == "" {
+		return 0, false
+	}
+	if issue.State != nil && (issue.State.Type == "completed" || issue.State.Type == "canceled") {
+		return 0, false
+	}
+	days, overdue, err := utils.DueDateOverdue(*issue.DueDate, now)
+	if err != nil {
+		return 0, false
+	}
+	return days, overdue
+}
+
+// overdueLabel formats the overdue suffix shown next to a due date
+func overdueLabel(days int) string {
+	if days == 1 {
+		return "OVERDUE by 1 day"
+	}
+	return fmt.Sprintf("OVERDUE by %d days", days)
+}
+
+// applyBreachedFilter restricts an issue filter to open issues due before today
+// in the local timezone
+func applyBreachedFilter(filter map[string]interface{}, now time.Time) {
+	filter["dueDate"] = map[string]interface{}{"lt": now.Format("2006-01-02")}
+	stateFilter, ok := filter["state"].(map[string]interface{})
+	if !ok {
+		stateFilter = map[string]interface{}{}
+	}
+	stateFilter["type"] = map[string]interface{}{"nin": []string{"completed", "canceled"}}
+	filter["state"] = stateFilter
+}
+
+// applySnoozedFilter leaves out issues that are snoozed at now. A snooze
+// that has already run out counts as not snoozed.
+func applySnoozedFilter(filter map[string]interface{}, now time.Time) {
+	filter["or"] = []interface{}{
+		map[string]interface{}{"snoozedUntilAt": map[string]interface{}{"null": true}},
+		map[string]interface{}{"snoozedUntilAt": map[string]interface{}{"lt": now.UTC().Format(time.RFC3339)}},
+	}
+}
+
+func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string, descriptionLines int) {
 	if len(issues.Nodes) == 0 {
 		output.Info(emptyMessage, plaintext, jsonOut)
 		return
@@ -128,37 +426,69 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		return
 	}
 
+	now := time.Now()
+
 	if plaintext {
 		fmt.Println(plaintextTitle)
 		for _, issue := range issues.Nodes {
-			fmt.Printf("## %s\n", issue.Title)
-			fmt.Printf("- **ID**: %s\n", issue.Identifier)
-			if issue.State != nil {
-				fmt.Printf("- **State**: %s\n", issue.State.Name)
-			}
-			if issue.Assignee != nil {
-				fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
-			} else {
-				fmt.Printf("- **Assignee**: Unassigned\n")
-			}
-			if issue.Team != nil {
-				fmt.Printf("- **Team**: %s\n", issue.Team.Key)
-			}
-			fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("- **URL**: %s\n", issue.URL)
-			if issue.Description != "" {
-				fmt.Printf("- **Description**: %s\n", issue.Description)
-			}
-			fmt.Println()
+			printIssueItem(issue, "##", now, descriptionLines)
 		}
 		fmt.Printf("\nTotal: %d %s\n", len(issues.Nodes), summaryLabel)
 		return
 	}
 
-	headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
-	rows := make([][]string, len(issues.Nodes))
+	output.Table(issueTable(issues.Nodes, now), false, false)
+
+	fmt.Printf("\n%s %d %s\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		len(issues.Nodes),
+		summaryLabel)
+}
 
-	for i, issue := range issues.Nodes {
+// printIssueItem prints one issue of a plaintext list under a heading of
+// the given level
+func printIssueItem(issue api.Issue, heading string, now time.Time, descriptionLines int) {
+	fmt.Printf("%s %s\n", heading, issue.Title)
+	fmt.Printf("- **ID**: %s\n", issue.Identifier)
+	if issue.State != nil {
+		fmt.Printf("- **State**: %s\n", issue.State.Name)
+	}
+	if issue.Assignee != nil {
+		fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
+	} else {
+		fmt.Printf("- **Assignee**: Unassigned\n")
+	}
+	if issue.Team != nil {
+		fmt.Printf("- **Team**: %s\n", issue.Team.Key)
+	}
+	if issue.ChildCount != nil {
+		fmt.Printf("- **Children**: %d\n", *issue.ChildCount)
+	}
+	fmt.Printf("- **Created**: %s\n", output.FormatTime(issue.CreatedAt, output.DateOnly))
+	if issue.DueDate != nil && *issue.DueDate != "" {
+		if days, overdue := issueOverdue(&issue, now); overdue {
+			fmt.Printf("- **Due**: %s (%s)\n", *issue.DueDate, overdueLabel(days))
+		} else {
+			fmt.Printf("- **Due**: %s\n", *issue.DueDate)
+		}
+	}
+	fmt.Printf("- **URL**: %s\n", issue.URL)
+	printDescriptionExcerpt(issue.Description, descriptionLines)
+	fmt.Println()
+}
+
+// issueTable builds the colored issue list table
+func issueTable(issues []api.Issue, now time.Time) output.TableData {
+	// --with-children sets ChildCount on every issue
+	showChildren := len(issues) > 0 && issues[0].ChildCount != nil
+	headers := []string{"Title", "State", "Assignee", "Team"}
+	if showChildren {
+		headers = append(headers, "Children")
+	}
+	headers = append(headers, "Created", "URL")
+	rows := make([][]string, len(issues))
+
+	for i, issue := range issues {
 		assignee := "Unassigned"
 		if issue.Assignee != nil {
 			assignee = issue.Assignee.Name
@@ -172,56 +502,37 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		state := ""
 		if issue.State != nil {
 			state = issue.State.Name
-			var stateColor *color.Color
-			switch issue.State.Type {
-			case "triage":
-				stateColor = color.New(color.FgMagenta)
-			case "backlog":
-				stateColor = color.New(color.FgCyan)
-			case "unstarted":
-				stateColor = color.New(color.FgWhite)
-			case "started":
-				stateColor = color.New(color.FgBlue)
-			case "completed":
-				stateColor = color.New(color.FgGreen)
-			case "canceled":
-				stateColor = color.New(color.FgRed)
-			default:
-				stateColor = color.New(color.FgWhite)
-			}
-			state = stateColor.Sprint(state)
+			state = colorizeState(issue.State)
 		}
 
 		if issue.Assignee == nil {
 			assignee = color.New(color.FgYellow).Sprint(assignee)
 		}
 
-		rows[i] = []string{
-			truncateString(issue.Title, 40),
+		title := truncateString(issue.Title, 40)
+		if _, overdue := issueOverdue(&issue, now); overdue {
+			title = color.New(color.FgRed).Sprint("⚠ ") + title
+		}
+
+		row := []string{
+			title,
 			state,
 			assignee,
 			team,
-			issue.CreatedAt.Format("2006-01-02"),
-			issue.URL,
 		}
+		if showChildren {
+			row = append(row, fmt.Sprintf("%d", *issue.ChildCount))
+		}
+		rows[i] = append(row,
+			output.FormatTime(issue.CreatedAt, output.TableTimeFormat(false, false)),
+			issue.URL,
+		)
 	}
 
-	tableData := output.TableData{
+	return output.TableData{
 		Headers: headers,
 		Rows:    rows,
 	}
-
-	output.Table(tableData, false, false)
-
-	fmt.Printf("\n%s %d %s\n",
-		color.New(color.FgGreen).Sprint("✓"),
-		len(issues.Nodes),
-		summaryLabel)
-
-	if issues.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
-			color.New(color.FgYellow).Sprint("ℹ️"))
-	}
 }
 
 var issueSearchCmd = &cobra.Command{
@@ -242,18 +553,23 @@ Examples:
 		query := strings.TrimSpace(strings.Join(args, " "))
 		if query == "" {
 			output.Error("Search query is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		filter := buildIssueFilter(cmd)
+		teams, err := resolveTeamFlags(client, cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		filter := buildIssueFilterFromFlags(cmd, teams)
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
@@ -261,19 +577,10 @@ Examples:
 		}
 
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		includeArchived, _ := cmd.Flags().GetBool("include-archived")
@@ -281,362 +588,403 @@ Examples:
 		issues, err := client.IssueSearch(context.Background(), query, filter, limit, "", orderBy, includeArchived)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
 
 		emptyMsg := fmt.Sprintf("No matches found for %q", query)
-		renderIssueCollection(issues, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
+		renderIssueCollection(issues, plaintext, jsonOut, emptyMsg, "matches", "# Search Results", defaultDescriptionLines)
 	},
 }
 
-var issueGetCmd = &cobra.Command{
-	Use:     "get [issue-id]",
-	Aliases: []string{"show"},
-	Short:   "Get issue details",
-	Long:    `Get detailed information about a specific issue.`,
-	Args:    cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
-
-		authHeader, err := auth.GetAuthHeader()
-		if err != nil {
-			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
-		}
-
-		client := api.NewClient(authHeader)
-		issue, err := client.GetIssue(context.Background(), args[0])
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
+// relationTypeText describes an issue relation from this issue's side
+func relationTypeText(relationType string) string {
+	switch relationType {
+	case "blocks":
+		return "Blocks"
+	case "blocked":
+		return "Blocked by"
+	case "related":
+		return "Related to"
+	case "duplicate":
+		return "Duplicate of"
+	}
+	return relationType
+}
 
-		if jsonOut {
-			output.JSON(issue)
-			return
-		}
+// historyChanges lists the field changes recorded in one history entry
+func historyChanges(entry api.IssueHistoryEntry) []string {
+	changes := []string{}
+	if entry.FromState != nil && entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
+	}
+	if entry.FromAssignee != nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
+	} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
+		changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
+	} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil {
+		changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
+	}
+	if entry.FromCycle != nil && entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
+	}
+	if entry.FromProject != nil && entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
+	}
+	if len(entry.AddedLabelIds) > 0 {
+		changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
+	}
+	if len(entry.RemovedLabelIds) > 0 {
+		changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
+	}
+	return changes
+}
 
-		if plaintext {
-			fmt.Printf("# %s - %s\n\n", issue.Identifier, issue.Title)
+// renderIssueMarkdown writes an issue as a markdown document. Sections
+// always appear in the same order; with full, empty sections say "None".
+// now is used for the overdue note on the due date.
+func renderIssueMarkdown(w io.Writer, issue *api.Issue, full bool, now time.Time) {
+	d := &markdownDoc{w: w, full: full}
+	d.title(fmt.Sprintf("%s - %s", issue.Identifier, escapeMarkdown(issue.Title)))
 
-			if issue.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", issue.Description)
-			}
+	d.section("Description", issue.Description != "", func() {
+		d.body(issue.Description, 2)
+	})
 
-			fmt.Printf("## Core Details\n")
-			fmt.Printf("- **ID**: %s\n", issue.Identifier)
-			fmt.Printf("- **Number**: %d\n", issue.Number)
-			if issue.State != nil {
-				fmt.Printf("- **State**: %s (%s)\n", issue.State.Name, issue.State.Type)
-				if issue.State.Description != nil && *issue.State.Description != "" {
-					fmt.Printf("  - Description: %s\n", *issue.State.Description)
-				}
-			}
-			if issue.Assignee != nil {
-				fmt.Printf("- **Assignee**: %s (%s)\n", issue.Assignee.Name, issue.Assignee.Email)
-				if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
-					fmt.Printf("  - Display Name: %s\n", issue.Assignee.DisplayName)
-				}
-			} else {
-				fmt.Printf("- **Assignee**: Unassigned\n")
-			}
-			if issue.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", issue.Creator.Name, issue.Creator.Email)
-			}
-			if issue.Team != nil {
-				fmt.Printf("- **Team**: %s (%s)\n", issue.Team.Name, issue.Team.Key)
-				if issue.Team.Description != "" {
-					fmt.Printf("  - Description: %s\n", issue.Team.Description)
-				}
+	d.section("Core Details", true, func() {
+		d.field("ID", issue.Identifier)
+		d.field("Number", fmt.Sprint(issue.Number))
+		if issue.State != nil {
+			d.field("State", fmt.Sprintf("%s (%s)", escapeMarkdown(issue.State.Name), issue.State.Type))
+			if issue.State.Description != nil && *issue.State.Description != "" {
+				d.printf("  - Description: %s\n", escapeMarkdown(*issue.State.Description))
 			}
-			fmt.Printf("- **Priority**: %s (%d)\n", priorityToString(issue.Priority), issue.Priority)
-			if issue.PriorityLabel != "" {
-				fmt.Printf("- **Priority Label**: %s\n", issue.PriorityLabel)
+		}
+		if issue.Assignee != nil {
+			d.field("Assignee", fmt.Sprintf("%s (%s)", escapeMarkdown(issue.Assignee.Name), issue.Assignee.Email))
+			if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
+				d.printf("  - Display Name: %s\n", escapeMarkdown(issue.Assignee.DisplayName))
 			}
-			if issue.Estimate != nil {
-				fmt.Printf("- **Estimate**: %.1f\n", *issue.Estimate)
+		} else {
+			d.field("Assignee", "Unassigned")
+		}
+		if issue.Creator != nil {
+			d.field("Creator", fmt.Sprintf("%s (%s)", escapeMarkdown(issue.Creator.Name), issue.Creator.Email))
+		}
+		if issue.Team != nil {
+			d.field("Team", fmt.Sprintf("%s (%s)", escapeMarkdown(issue.Team.Name), issue.Team.Key))
+			if issue.Team.Description != "" {
+				d.printf("  - Description: %s\n", escapeMarkdown(issue.Team.Description))
 			}
+		}
+		d.field("Priority", fmt.Sprintf("%s (%d)", priorityToString(issue.Priority), issue.Priority))
+		if issue.PriorityLabel != "" {
+			d.field("Priority Label", issue.PriorityLabel)
+		}
+		if issue.Estimate != nil {
+			d.field("Estimate", fmt.Sprintf("%.1f", *issue.Estimate))
+		}
+	})
 
-			fmt.Printf("\n## Status & Dates\n")
-			fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
-			if issue.StartedAt != nil {
-				fmt.Printf("- **Started**: %s\n", issue.StartedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.TriagedAt != nil {
-				fmt.Printf("- **Triaged**: %s\n", issue.TriagedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CompletedAt != nil {
-				fmt.Printf("- **Completed**: %s\n", issue.CompletedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CanceledAt != nil {
-				fmt.Printf("- **Canceled**: %s\n", issue.CanceledAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", issue.ArchivedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.DueDate != nil && *issue.DueDate != "" {
-				fmt.Printf("- **Due Date**: %s\n", *issue.DueDate)
+	d.section("Status & Dates", true, func() {
+		d.field("Created", output.FormatTime(issue.CreatedAt, output.DateTime))
+		d.field("Updated", output.FormatTime(issue.UpdatedAt, output.DateTime))
+		if issue.StartedAt != nil {
+			d.field("Started", output.FormatTime(*issue.StartedAt, output.DateTime))
+		}
+		if issue.TriagedAt != nil {
+			d.field("Triaged", output.FormatTime(*issue.TriagedAt, output.DateTime))
+		}
+		if issue.CompletedAt != nil {
+			d.field("Completed", output.FormatTime(*issue.CompletedAt, output.DateTime))
+		}
+		if issue.CanceledAt != nil {
+			d.field("Canceled", output.FormatTime(*issue.CanceledAt, output.DateTime))
+		}
+		if issue.ArchivedAt != nil {
+			d.field("Archived", output.FormatTime(*issue.ArchivedAt, output.DateTime))
+		}
+		if issue.DueDate != nil && *issue.DueDate != "" {
+			if days, overdue := issueOverdue(issue, now); overdue {
+				d.field("Due Date", fmt.Sprintf("%s (%s)", *issue.DueDate, overdueLabel(days)))
+			} else {
+				d.field("Due Date", *issue.DueDate)
 			}
-			if issue.SnoozedUntilAt != nil {
-				snoozedBy := ""
-				if issue.SnoozedBy != nil {
-					snoozedBy = fmt.Sprintf(" by %s", issue.SnoozedBy.Name)
-				}
-				fmt.Printf("- **Snoozed Until**: %s%s\n", issue.SnoozedUntilAt.Format("2006-01-02 15:04:05"), snoozedBy)
+		}
+		if issue.SnoozedUntilAt != nil {
+			snoozedBy := ""
+			if issue.SnoozedBy != nil {
+				snoozedBy = fmt.Sprintf(" by %s", escapeMarkdown(issue.SnoozedBy.Name))
 			}
+			d.field("Snoozed Until", output.FormatTime(*issue.SnoozedUntilAt, output.DateTime)+snoozedBy)
+		}
+	})
 
-			// SLA Information
-			if issue.SLAStartedAt != nil || issue.SLABreachesAt != nil || issue.SLAType != nil {
-				fmt.Printf("\n## SLA\n")
-				if issue.SLAType != nil && *issue.SLAType != "" {
-					fmt.Printf("- **Type**: %s\n", *issue.SLAType)
-				}
-				if issue.SLAStartedAt != nil {
-					fmt.Printf("- **Started**: %s\n", issue.SLAStartedAt.Format("2006-01-02 15:04:05"))
-				}
-				if issue.SLAMediumRiskAt != nil {
-					fmt.Printf("- **Medium Risk At**: %s\n", issue.SLAMediumRiskAt.Format("2006-01-02 15:04:05"))
-				}
-				if issue.SLAHighRiskAt != nil {
-					fmt.Printf("- **High Risk At**: %s\n", issue.SLAHighRiskAt.Format("2006-01-02 15:04:05"))
-				}
-				if issue.SLABreachesAt != nil {
-					fmt.Printf("- **Breaches At**: %s\n", issue.SLABreachesAt.Format("2006-01-02 15:04:05"))
-				}
-			}
+	d.section("SLA", issue.SLAStartedAt != nil || issue.SLABreachesAt != nil || issue.SLAType != nil, func() {
+		if issue.SLAType != nil && *issue.SLAType != "" {
+			d.field("Type", *issue.SLAType)
+		}
+		if issue.SLAStartedAt != nil {
+			d.field("Started", output.FormatTime(*issue.SLAStartedAt, output.DateTime))
+		}
+		if issue.SLAMediumRiskAt != nil {
+			d.field("Medium Risk At", output.FormatTime(*issue.SLAMediumRiskAt, output.DateTime))
+		}
+		if issue.SLAHighRiskAt != nil {
+			d.field("High Risk At", output.FormatTime(*issue.SLAHighRiskAt, output.DateTime))
+		}
+		if issue.SLABreachesAt != nil {
+			d.field("Breaches At", output.FormatTime(*issue.SLABreachesAt, output.DateTime))
+		}
+	})
 
-			fmt.Printf("\n## Technical Details\n")
-			fmt.Printf("- **Board Order**: %.2f\n", issue.BoardOrder)
-			fmt.Printf("- **Sub-Issue Sort Order**: %.2f\n", issue.SubIssueSortOrder)
-			if issue.BranchName != "" {
-				fmt.Printf("- **Git Branch**: %s\n", issue.BranchName)
-			}
-			if issue.CustomerTicketCount > 0 {
-				fmt.Printf("- **Customer Ticket Count**: %d\n", issue.CustomerTicketCount)
+	d.section("Technical Details", true, func() {
+		d.field("Board Order", fmt.Sprintf("%.2f", issue.BoardOrder))
+		d.field("Sub-Issue Sort Order", fmt.Sprintf("%.2f", issue.SubIssueSortOrder))
+		if issue.BranchName != "" {
+			d.field("Git Branch", "`"+issue.BranchName+"`")
+		}
+		if issue.CustomerTicketCount > 0 {
+			d.field("Customer Ticket Count", fmt.Sprint(issue.CustomerTicketCount))
+		}
+		if len(issue.PreviousIdentifiers) > 0 {
+			d.field("Previous Identifiers", strings.Join(issue.PreviousIdentifiers, ", "))
+		}
+		if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
+			d.field("Integration Source", *issue.IntegrationSourceType)
+		}
+		if issue.ExternalUserCreator != nil {
+			d.field("External Creator", fmt.Sprintf("%s (%s)", escapeMarkdown(issue.ExternalUserCreator.Name), issue.ExternalUserCreator.Email))
+		}
+		d.field("URL", issue.URL)
+	})
+
+	d.section("Project", issue.Project != nil, func() {
+		d.field("Name", escapeMarkdown(issue.Project.Name))
+		d.field("State", issue.Project.State)
+		d.field("Progress", fmt.Sprintf("%.0f%%", issue.Project.Progress*100))
+		if issue.Project.Health != "" {
+			d.field("Health", issue.Project.Health)
+		}
+		if issue.Project.Description != "" {
+			d.field("Description", escapeMarkdown(issue.Project.Description))
+		}
+	})
+
+	d.section("Milestone", issue.ProjectMilestone != nil, func() {
+		d.field("Name", escapeMarkdown(issue.ProjectMilestone.Name))
+		d.field("Status", issue.ProjectMilestone.Status)
+		if issue.ProjectMilestone.TargetDate != nil {
+			d.field("Target Date", *issue.ProjectMilestone.TargetDate)
+		}
+	})
+
+	d.section("Cycle", issue.Cycle != nil, func() {
+		d.field("Name", fmt.Sprintf("%s (#%d)", escapeMarkdown(issue.Cycle.Name), issue.Cycle.Number))
+		if issue.Cycle.Description != nil && *issue.Cycle.Description != "" {
+			d.field("Description", escapeMarkdown(*issue.Cycle.Description))
+		}
+		d.field("Period", fmt.Sprintf("%s to %s", issue.Cycle.StartsAt, issue.Cycle.EndsAt))
+		d.field("Progress", fmt.Sprintf("%.0f%%", issue.Cycle.Progress*100))
+		if issue.Cycle.CompletedAt != nil {
+			d.field("Completed", output.FormatTime(*issue.Cycle.CompletedAt, output.DateOnly))
+		}
+	})
+
+	d.section("Labels", issue.Labels != nil && len(issue.Labels.Nodes) > 0, func() {
+		for _, label := range issue.Labels.Nodes {
+			d.printf("- %s", escapeMarkdown(label.Name))
+			if label.Description != nil && *label.Description != "" {
+				d.printf(" - %s", escapeMarkdown(*label.Description))
 			}
-			if len(issue.PreviousIdentifiers) > 0 {
-				fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.PreviousIdentifiers, ", "))
+			d.printf("\n")
+		}
+	})
+
+	d.section("Subscribers", issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0, func() {
+		for _, subscriber := range issue.Subscribers.Nodes {
+			d.printf("- %s (%s)\n", escapeMarkdown(subscriber.Name), subscriber.Email)
+		}
+	})
+
+	hasRelations := false
+	if issue.Relations != nil {
+		for _, relation := range issue.Relations.Nodes {
+			if relation.RelatedIssue != nil {
+				hasRelations = true
 			}
-			if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
-				fmt.Printf("- **Integration Source**: %s\n", *issue.IntegrationSourceType)
+		}
+	}
+	d.section("Related Issues", hasRelations, func() {
+		for _, relation := range issue.Relations.Nodes {
+			if relation.RelatedIssue == nil {
+				continue
 			}
-			if issue.ExternalUserCreator != nil {
-				fmt.Printf("- **External Creator**: %s (%s)\n", issue.ExternalUserCreator.Name, issue.ExternalUserCreator.Email)
+			d.printf("- %s: %s - %s", relationTypeText(relation.Type), relation.RelatedIssue.Identifier, escapeMarkdown(relation.RelatedIssue.Title))
+			if relation.RelatedIssue.State != nil {
+				d.printf(" (%s)", escapeMarkdown(relation.RelatedIssue.State.Name))
 			}
-			fmt.Printf("- **URL**: %s\n", issue.URL)
+			d.printf("\n")
+		}
+	})
 
-			// Project and Cycle Info
-			if issue.Project != nil {
-				fmt.Printf("\n## Project\n")
-				fmt.Printf("- **Name**: %s\n", issue.Project.Name)
-				fmt.Printf("- **State**: %s\n", issue.Project.State)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.Project.Progress*100)
-				if issue.Project.Health != "" {
-					fmt.Printf("- **Health**: %s\n", issue.Project.Health)
-				}
-				if issue.Project.Description != "" {
-					fmt.Printf("- **Description**: %s\n", issue.Project.Description)
-				}
+	d.section("Reactions", len(issue.Reactions) > 0, func() {
+		// Emojis in first-seen order so output is stable
+		var emojis []string
+		reactionMap := make(map[string][]string)
+		for _, reaction := range issue.Reactions {
+			if _, ok := reactionMap[reaction.Emoji]; !ok {
+				emojis = append(emojis, reaction.Emoji)
 			}
+			reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], escapeMarkdown(safeUserName(reaction.User)))
+		}
+		for _, emoji := range emojis {
+			d.printf("- %s: %s\n", emoji, strings.Join(reactionMap[emoji], ", "))
+		}
+	})
 
-			if issue.ProjectMilestone != nil {
-				fmt.Printf("\n## Milestone\n")
-				fmt.Printf("- **Name**: %s\n", issue.ProjectMilestone.Name)
-				fmt.Printf("- **Status**: %s\n", issue.ProjectMilestone.Status)
-				if issue.ProjectMilestone.TargetDate != nil {
-					fmt.Printf("- **Target Date**: %s\n", *issue.ProjectMilestone.TargetDate)
-				}
-			}
+	d.section("Parent Issue", issue.Parent != nil, func() {
+		d.printf("- %s: %s\n", issue.Parent.Identifier, escapeMarkdown(issue.Parent.Title))
+	})
 
-			if issue.Cycle != nil {
-				fmt.Printf("\n## Cycle\n")
-				fmt.Printf("- **Name**: %s (#%d)\n", issue.Cycle.Name, issue.Cycle.Number)
-				if issue.Cycle.Description != nil && *issue.Cycle.Description != "" {
-					fmt.Printf("- **Description**: %s\n", *issue.Cycle.Description)
-				}
-				fmt.Printf("- **Period**: %s to %s\n", issue.Cycle.StartsAt, issue.Cycle.EndsAt)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.Cycle.Progress*100)
-				if issue.Cycle.CompletedAt != nil {
-					fmt.Printf("- **Completed**: %s\n", issue.Cycle.CompletedAt.Format("2006-01-02"))
-				}
+	d.section("Sub-issues", issue.Children != nil && len(issue.Children.Nodes) > 0, func() {
+		for _, child := range issue.Children.Nodes {
+			assignee := "Unassigned"
+			if child.Assignee != nil {
+				assignee = escapeMarkdown(child.Assignee.Name)
 			}
+			d.printf("%s — %s\n", issueTaskItem(child), assignee)
+		}
+	})
 
-			// Labels
-			if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
-				fmt.Printf("\n## Labels\n")
-				for _, label := range issue.Labels.Nodes {
-					fmt.Printf("- %s", label.Name)
-					if label.Description != nil && *label.Description != "" {
-						fmt.Printf(" - %s", *label.Description)
-					}
-					fmt.Println()
-				}
+	d.section("Attachments", issue.Attachments != nil && len(issue.Attachments.Nodes) > 0, func() {
+		for _, attachment := range issue.Attachments.Nodes {
+			d.printf("- [%s](<%s>)", escapeMarkdown(attachment.Title), attachment.URL)
+			if details := attachmentDetails(attachment); details != "" {
+				d.printf(" — %s", details)
 			}
+			d.printf("\n")
+		}
+	})
 
-			// Subscribers
-			if issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0 {
-				fmt.Printf("\n## Subscribers\n")
-				for _, subscriber := range issue.Subscribers.Nodes {
-					fmt.Printf("- %s (%s)\n", subscriber.Name, subscriber.Email)
-				}
+	d.section("Documents", issue.Documents != nil && len(issue.Documents.Nodes) > 0, func() {
+		for _, doc := range issue.Documents.Nodes {
+			icon := ""
+			if doc.Icon != nil && *doc.Icon != "" {
+				icon = *doc.Icon + " "
 			}
+			d.printf("- %s[%s](<%s>)\n", icon, escapeMarkdown(doc.Title), doc.URL)
+		}
+		d.printf("\n> Use `linear-cli document get <id>` to view full document content\n")
+	})
 
-			// Relations
-			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
-				fmt.Printf("\n## Related Issues\n")
-				for _, relation := range issue.Relations.Nodes {
-					if relation.RelatedIssue != nil {
-						relationType := relation.Type
-						switch relationType {
-						case "blocks":
-							relationType = "Blocks"
-						case "blocked":
-							relationType = "Blocked by"
-						case "related":
-							relationType = "Related to"
-						case "duplicate":
-							relationType = "Duplicate of"
-						}
-						fmt.Printf("- %s: %s - %s", relationType, relation.RelatedIssue.Identifier, relation.RelatedIssue.Title)
-						if relation.RelatedIssue.State != nil {
-							fmt.Printf(" [%s]", relation.RelatedIssue.State.Name)
-						}
-						fmt.Println()
-					}
+	d.section("Recent Comments", issue.Comments != nil && len(issue.Comments.Nodes) > 0, func() {
+		for i, comment := range issue.Comments.Nodes {
+			if i > 0 {
+				d.printf("\n")
+			}
+			d.printf("### %s - %s\n\n", escapeMarkdown(safeUserName(comment.User)), output.FormatTime(comment.CreatedAt, output.DateTimeShort))
+			if comment.EditedAt != nil {
+				d.printf("*(edited %s)*\n\n", output.FormatTime(*comment.EditedAt, output.DateTimeShort))
+			}
+			d.body(comment.Body, 3)
+			if comment.Children != nil {
+				for _, reply := range comment.Children.Nodes {
+					d.printf("\n#### Reply from %s\n\n", escapeMarkdown(safeUserName(reply.User)))
+					d.body(reply.Body, 4)
 				}
 			}
+		}
+		d.printf("\n> Use `linear-cli comment list %s` to see all comments\n", issue.Identifier)
+	})
 
-			// Reactions
-			if len(issue.Reactions) > 0 {
-				fmt.Printf("\n## Reactions\n")
-				reactionMap := make(map[string][]string)
-				for _, reaction := range issue.Reactions {
-					reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], safeUserName(reaction.User))
-				}
-				for emoji, users := range reactionMap {
-					fmt.Printf("- %s: %s\n", emoji, strings.Join(users, ", "))
-				}
+	d.section("Recent History", issue.History != nil && len(issue.History.Nodes) > 0, func() {
+		for _, entry := range issue.History.Nodes {
+			d.printf("- **%s** by %s\n", output.FormatTime(entry.CreatedAt, output.DateTimeShort), escapeMarkdown(safeUserName(entry.Actor)))
+			for _, change := range historyChanges(entry) {
+				d.printf("  - %s\n", escapeMarkdown(change))
 			}
+		}
+	})
+}
 
-			// Show parent issue if this is a sub-issue
-			if issue.Parent != nil {
-				fmt.Printf("\n## Parent Issue\n")
-				fmt.Printf("- %s: %s\n", issue.Parent.Identifier, issue.Parent.Title)
-			}
-
-			// Show sub-issues if any
-			if issue.Children != nil && len(issue.Children.Nodes) > 0 {
-				fmt.Printf("\n## Sub-issues\n")
-				for _, child := range issue.Children.Nodes {
-					stateStr := ""
-					if child.State != nil {
-						switch child.State.Type {
-						case "completed", "done":
-							stateStr = "[x]"
-						case "started", "in_progress":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
-					}
+var issueGetCmd = &cobra.Command{
+	Use:     "get [issue-id]",
+	Aliases: []string{"show"},
+	Short:   "Get issue details",
+	Long: `Get detailed information about a specific issue.
 
-					assignee := "Unassigned"
-					if child.Assignee != nil {
-						assignee = child.Assignee.Name
-					}
+With --plaintext the issue is printed as a markdown document with sections
+in a fixed order. Add --full to include every section, with "None" for
+sections that have no data.
 
-					fmt.Printf("- %s %s: %s (%s)\n", stateStr, child.Identifier, child.Title, assignee)
-				}
-			}
+Attachments are listed with their source; ones linking a GitHub pull request
+or GitLab merge request show its state (open, draft, merged, or closed),
+also given as pullRequestState in --json output. --no-attachments leaves
+them out of the request.
 
-			// Show attachments if any
-			if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-				fmt.Printf("\n## Attachments\n")
-				for _, attachment := range issue.Attachments.Nodes {
-					fmt.Printf("- [%s](%s)\n", attachment.Title, attachment.URL)
-				}
-			}
+--fields trims --json output to the given comma-separated dot-paths, e.g.
+state.name; arrays are filtered element by element. Paths that aren't in
+the output are left out, or fail the command with --strict-fields. When
+every path is one the issue list table uses, only those are fetched.
 
-			// Show documents if any
-			if issue.Documents != nil && len(issue.Documents.Nodes) > 0 {
-				fmt.Printf("\n## Documents\n")
-				for _, doc := range issue.Documents.Nodes {
-					icon := ""
-					if doc.Icon != nil && *doc.Icon != "" {
-						icon = *doc.Icon + " "
-					}
-					fmt.Printf("- %s[%s](%s)\n", icon, doc.Title, doc.URL)
-				}
-				fmt.Printf("\n> Use `linear-cli document get <id>` to view full document content\n")
-			}
+Examples:
+  linear-cli issue get ENG-123
+  linear-cli issue get ENG-123 -p --full
+  linear-cli issue get ENG-123 --json --fields identifier,title,state.name,assignee.email,description
+  linear-cli issue get --from-branch`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		full, _ := cmd.Flags().GetBool("full")
 
-			// Show recent comments if any
-			if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-				fmt.Printf("\n## Recent Comments\n")
-				for _, comment := range issue.Comments.Nodes {
-					fmt.Printf("\n### %s - %s\n", safeUserName(comment.User), comment.CreatedAt.Format("2006-01-02 15:04"))
-					if comment.EditedAt != nil {
-						fmt.Printf("*(edited %s)*\n", comment.EditedAt.Format("2006-01-02 15:04"))
-					}
-					fmt.Printf("%s\n", comment.Body)
-					if comment.Children != nil && len(comment.Children.Nodes) > 0 {
-						for _, reply := range comment.Children.Nodes {
-							fmt.Printf("\n  **Reply from %s**: %s\n", safeUserName(reply.User), reply.Body)
-						}
-					}
-				}
-				fmt.Printf("\n> Use `linear-cli comment list %s` to see all comments\n", issue.Identifier)
-			}
+		ref, err := issueArg(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		fields, err := jsonFieldsFromFlags(cmd, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
-			// Show history
-			if issue.History != nil && len(issue.History.Nodes) > 0 {
-				fmt.Printf("\n## Recent History\n")
-				for _, entry := range issue.History.Nodes {
-					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), entry.Actor.Name)
-					changes := []string{}
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
 
-					if entry.FromState != nil && entry.ToState != nil {
-						changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
-					}
-					if entry.FromAssignee != nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
-					} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-						changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
-					} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
-					}
-					if entry.FromPriority != nil && entry.ToPriority != nil {
-						changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
-					}
-					if entry.FromTitle != nil && entry.ToTitle != nil {
-						changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
-					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
-					}
-					if entry.FromProject != nil && entry.ToProject != nil {
-						changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
-					}
-					if len(entry.AddedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
-					}
-					if len(entry.RemovedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
-					}
+		// Fetch only what --fields keeps when a lighter query covers it
+		level := api.IssueFieldsFull
+		if len(fields.Paths) > 0 {
+			level = api.IssueFieldsFor(fields.Paths)
+		}
+		if noAttachments, _ := cmd.Flags().GetBool("no-attachments"); noAttachments && level == api.IssueFieldsFull {
+			level |= api.IssueFieldNoAttachments
+		}
+		client := api.NewClient(authHeader)
+		issue, err := resolveIssueRefFields(context.Background(), client, ref, level)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		annotatePullRequests(issue)
 
-					if len(changes) > 0 {
-						fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
-					}
-					fmt.Println()
-				}
-			}
+		if jsonOut {
+			fields.print(issue, plaintext, jsonOut)
+			return
+		}
 
+		if plaintext {
+			renderIssueMarkdown(os.Stdout, issue, full, time.Now())
 			return
 		}
 
@@ -654,10 +1002,10 @@ var issueGetCmd = &cobra.Command{
 		if issue.State != nil {
 			stateStr := issue.State.Name
 			if issue.State.Type == "completed" && issue.CompletedAt != nil {
-				stateStr += fmt.Sprintf(" (%s)", issue.CompletedAt.Format("2006-01-02"))
+				stateStr += fmt.Sprintf(" (%s)", output.FormatTime(*issue.CompletedAt, output.DateOnly))
 			}
 			fmt.Printf("State: %s\n",
-				color.New(color.FgGreen).Sprint(stateStr))
+				output.ColorChip(stateStr, issue.State.Color, color.New(color.FgGreen)))
 		}
 
 		if issue.Assignee != nil {
@@ -675,6 +1023,14 @@ var issueGetCmd = &cobra.Command{
 
 		fmt.Printf("Priority: %s\n", priorityToString(issue.Priority))
 
+		if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+			chips := make([]string, len(issue.Labels.Nodes))
+			for i, label := range issue.Labels.Nodes {
+				chips[i] = output.ColorChip(label.Name, label.Color, color.New(color.FgMagenta))
+			}
+			fmt.Printf("Labels: %s\n", strings.Join(chips, " "))
+		}
+
 		// Show project and cycle info
 		if issue.Project != nil {
 			fmt.Printf("Project: %s (%s)\n",
@@ -693,17 +1049,22 @@ var issueGetCmd = &cobra.Command{
 				color.New(color.FgMagenta).Sprint(issue.Cycle.Name))
 		}
 
-		fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
-		fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf("Created: %s\n", output.FormatTime(issue.CreatedAt, output.DateTime))
+		fmt.Printf("Updated: %s\n", output.FormatTime(issue.UpdatedAt, output.DateTime))
 
 		if issue.DueDate != nil && *issue.DueDate != "" {
-			fmt.Printf("Due Date: %s\n",
-				color.New(color.FgYellow).Sprint(*issue.DueDate))
+			if days, overdue := issueOverdue(issue, time.Now()); overdue {
+				fmt.Printf("Due: %s\n",
+					color.New(color.FgRed, color.Bold).Sprintf("%s (%s)", *issue.DueDate, overdueLabel(days)))
+			} else {
+				fmt.Printf("Due Date: %s\n",
+					color.New(color.FgYellow).Sprint(*issue.DueDate))
+			}
 		}
 
 		if issue.SnoozedUntilAt != nil {
 			fmt.Printf("Snoozed Until: %s\n",
-				color.New(color.FgYellow).Sprint(issue.SnoozedUntilAt.Format("2006-01-02 15:04:05")))
+				color.New(color.FgYellow).Sprint(output.FormatTime(*issue.SnoozedUntilAt, output.DateTime)))
 		}
 
 		// Show git branch if available
@@ -730,17 +1091,7 @@ var issueGetCmd = &cobra.Command{
 		if issue.Children != nil && len(issue.Children.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
 			for _, child := range issue.Children.Nodes {
-				stateIcon := "○"
-				if child.State != nil {
-					switch child.State.Type {
-					case "completed", "done":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
-					case "started", "in_progress":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
-					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
-					}
-				}
+				stateIcon := issueStateIcon(child.State)
 
 				assignee := "Unassigned"
 				if child.Assignee != nil {
@@ -755,13 +1106,11 @@ var issueGetCmd = &cobra.Command{
 			}
 		}
 
-		// Show attachments if any
+		// Show attachments if any, with the state of linked pull requests
 		if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Attachments:"))
 			for _, attachment := range issue.Attachments.Nodes {
-				fmt.Printf("  📎 %s - %s\n",
-					attachment.Title,
-					color.New(color.FgBlue, color.Underline).Sprint(attachment.URL))
+				fmt.Println(attachmentLine(attachment))
 			}
 		}
 
@@ -788,7 +1137,7 @@ var issueGetCmd = &cobra.Command{
 			for _, comment := range issue.Comments.Nodes {
 				fmt.Printf("  💬 %s - %s\n",
 					color.New(color.FgCyan).Sprint(safeUserName(comment.User)),
-					color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
+					color.New(color.FgWhite, color.Faint).Sprint(output.FormatTime(comment.CreatedAt, output.DateTimeShort)))
 				// Show first line of comment
 				lines := strings.Split(comment.Body, "\n")
 				if len(lines) > 0 && lines[0] != "" {
@@ -806,54 +1155,100 @@ var issueGetCmd = &cobra.Command{
 	},
 }
 
-func buildIssueFilter(cmd *cobra.Command) map[string]interface{} {
+// issueFilterOptions holds the user-facing criteria that are translated into
+// a Linear IssueFilter. It is shared by issue list/search and the view filter flags.
+type issueFilterOptions struct {
+	Assignee         string
+	State            string
+	Team             string   // a team key, as --filter-team takes it
+	TeamIDs          []string // resolved --team teams; several match with one "in" clause
+	Labels           []string
+	Priority         int // -1 means no priority filter
+	NewerThan        string
+	IncludeCompleted bool
+}
+
+// buildIssueFilter translates filter options into an IssueFilter map.
+// An empty NewerThan applies no creation-time filter; an invalid time
+// expression is returned as an error.
+func buildIssueFilter(opts issueFilterOptions) (map[string]interface{}, error) {
 	filter := make(map[string]interface{})
 
-	if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
-		if assignee == "me" {
+	if opts.Assignee != "" {
+		if opts.Assignee == "me" {
 			filter["assignee"] = map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}
 		} else {
-			filter["assignee"] = map[string]interface{}{"email": map[string]interface{}{"eq": assignee}}
+			filter["assignee"] = map[string]interface{}{"email": map[string]interface{}{"eq": opts.Assignee}}
 		}
 	}
 
-	state, _ := cmd.Flags().GetString("state")
-	if state != "" {
-		filter["state"] = map[string]interface{}{"name": map[string]interface{}{"eq": state}}
-	} else {
-		// Only filter out completed issues if no specific state is requested
-		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
-		if !includeCompleted {
-			// Filter out completed and canceled states
-			filter["state"] = map[string]interface{}{
-				"type": map[string]interface{}{
-					"nin": []string{"completed", "canceled"},
-				},
-			}
+	if opts.State != "" {
+		filter["state"] = map[string]interface{}{"name": map[string]interface{}{"eq": opts.State}}
+	} else if !opts.IncludeCompleted {
+		// Only filter out completed and canceled issues if no specific state is requested
+		filter["state"] = map[string]interface{}{
+			"type": map[string]interface{}{
+				"nin": []string{"completed", "canceled"},
+			},
 		}
 	}
 
-	if team, _ := cmd.Flags().GetString("team"); team != "" {
-		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
+	switch {
+	case len(opts.TeamIDs) == 1:
+		filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": opts.TeamIDs[0]}}
+	case len(opts.TeamIDs) > 1:
+		filter["team"] = map[string]interface{}{"id": map[string]interface{}{"in": opts.TeamIDs}}
+	case opts.Team != "":
+		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": opts.Team}}
+	}
+
+	if len(opts.Labels) > 0 {
+		filter["labels"] = map[string]interface{}{
+			"some": map[string]interface{}{
+				"name": map[string]interface{}{"in": opts.Labels},
+			},
+		}
+	}
+
+	if opts.Priority != -1 {
+		filter["priority"] = map[string]interface{}{"eq": opts.Priority}
+	}
+
+	if opts.NewerThan != "" {
+		createdAt, err := utils.ParseTimeExpression(opts.NewerThan)
+		if err != nil {
+			return nil, err
+		}
+		if createdAt != "" {
+			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
+		}
 	}
 
-	if priority, _ := cmd.Flags().GetInt("priority"); priority != -1 {
-		filter["priority"] = map[string]interface{}{"eq": priority}
+	return filter, nil
+}
+
+// buildIssueFilterFromFlags builds the filter for issue list and search,
+// applying the default 6-month window when --newer-than is not given.
+// teams are the resolved --team teams.
+func buildIssueFilterFromFlags(cmd *cobra.Command, teams []api.Team) map[string]interface{} {
+	opts := issueFilterOptions{}
+	opts.Assignee, _ = cmd.Flags().GetString("assignee")
+	opts.State, _ = cmd.Flags().GetString("state")
+	opts.TeamIDs = teamIDs(teams)
+	opts.Priority, _ = cmd.Flags().GetInt("priority")
+	opts.IncludeCompleted, _ = cmd.Flags().GetBool("include-completed")
+	opts.NewerThan, _ = cmd.Flags().GetString("newer-than")
+	if opts.NewerThan == "" {
+		opts.NewerThan = "6_months_ago"
 	}
 
-	// Handle newer-than filter
-	newerThan, _ := cmd.Flags().GetString("newer-than")
-	createdAt, err := utils.ParseTimeExpression(newerThan)
+	filter, err := buildIssueFilter(opts)
 	if err != nil {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		exit(1)
 	}
-	if createdAt != "" {
-		filter["createdAt"] = map[string]interface{}{"gte": createdAt}
-	}
-
 	return filter
 }
 
@@ -893,7 +1288,7 @@ var issueAssignCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -902,7 +1297,7 @@ var issueAssignCmd = &cobra.Command{
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Update issue with assignee
@@ -910,11 +1305,19 @@ var issueAssignCmd = &cobra.Command{
 			"assigneeId": viewer.ID,
 		}
 
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
+		before, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		inverse := issueUpdateInverse(before, input)
+
+		issue, err := client.UpdateIssue(context.Background(), before.ID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, inverse)
 
 		if jsonOut {
 			output.JSON(issue)
@@ -941,19 +1344,60 @@ Use --description-file - to read from stdin.
 Examples:
   linear-cli issue create --title "Bug fix" --team ENG
   linear-cli issue create --title "Bug fix" --team ENG --description "Details here"
-  linear-cli issue create --title "Bug fix" --team ENG --description-file spec.md`,
+  linear-cli issue create --title "Bug fix" --team ENG --description-file spec.md
+  linear-cli issue create --title "Bug fix" --team ENG --assignee dev@example.com --label Bug
+  linear-cli issue create --title "Login fails on Safari" --team ENG --check-duplicates
+  linear-cli issue create --title "Login fails on Safari" --team ENG --strict-duplicates --json
+  linear-cli issue create --title "Crash on launch" --team ENG --template "Bug report"
+  linear-cli issue create --title "Quick note" --team ENG --no-template
+  linear-cli issue create --title "Deploy {{SERVICE}}" --team ENG --description-file deploy.md.tmpl --var SERVICE=payments --var DATE=$(date +%F)
+
+Team, assignee, labels, project, milestone, parent, cycle, state, and
+subscribers are all resolved before the issue is created. If any fail, every
+failure is reported at once with close matches; in --json mode as
+{"error": ..., "unresolved": [{"flag", "value", "reason", "suggestions"}]}.
+
+With --check-duplicates (or check_duplicates: true in the config file), the
+team's non-canceled issues are searched for similar titles first. Matches are
+listed and you are asked to confirm; without a terminal the issue is created
+anyway. --strict-duplicates aborts instead, exiting with code 3 (in --json mode
+the candidates are printed as {"error": ..., "duplicates": [...]}).
+
+When --team is omitted, the nearest .linear-cli.yaml between the working
+directory and the repository root is checked for a path mapping:
+
+  paths:
+    services/api: {team: API, project: PROJECT-ID}
+    web: {team: WEB}
+
+The most specific matching path supplies --team (and --project if not given).
+The applied mapping is printed to stderr; --no-auto-team disables it.
+
+If the team has a default issue template for members, its values (description,
+priority, labels, estimate, ...) are used for every field not given by a flag;
+explicit flags always win. --template applies another issue template (ID or
+name) instead, and --no-template skips the default. The applied template is
+named on stderr.
+
+--var KEY=VALUE (repeatable) fills {{KEY}} placeholders in the title and the
+description, after --description-file is read. A placeholder left without a
+value is an error unless --allow-missing-vars keeps it as written; with
+--allow-env, {{env.NAME}} is filled from the environment. Write \{{KEY}} for
+literal braces.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		applyAutoTeam(cmd)
+
 		// Get flags
 		title, _ := cmd.Flags().GetString("title")
 		descFlag, _ := cmd.Flags().GetString("description")
@@ -961,7 +1405,11 @@ Examples:
 		description, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		if err := substituteVarFlags(cmd, &title, &description); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 		teamKey, _ := cmd.Flags().GetString("team")
 		priority, _ := cmd.Flags().GetInt("priority")
@@ -969,117 +1417,93 @@ Examples:
 
 		if title == "" {
 			output.Error("Title is required (--title)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if teamKey == "" {
-			output.Error("Team is required (--team)", plaintext, jsonOut)
-			os.Exit(1)
+			output.Error("Team is required (--team, or a path mapping in .linear-cli.yaml)", plaintext, jsonOut)
+			exit(1)
 		}
 
-		// Get team ID from key
-		team, err := client.GetTeam(context.Background(), teamKey)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-			os.Exit(1)
+		if assignToMe && cmd.Flags().Changed("assignee") {
+			output.Error("Use either --assign-me or --assignee, not both", plaintext, jsonOut)
+			exit(1)
 		}
 
-		// Build input
+		// Resolve every reference before creating anything so all typos are
+		// reported together
+		resolver := newRefResolver(client)
+
+		team := resolver.team(teamKey)
+
 		input := map[string]interface{}{
-			"title":  title,
-			"teamId": team.ID,
+			"title": title,
+		}
+		if team != nil {
+			input["teamId"] = team.ID
 		}
 
 		if description != "" {
 			input["description"] = description
 		}
 
-		if priority >= 0 && priority <= 4 {
+		if cmd.Flags().Changed("priority") && priority >= 0 && priority <= 4 {
 			input["priority"] = priority
 		}
 
 		if assignToMe {
-			viewer, err := client.GetViewer(context.Background())
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+			if id := resolver.user("assign-me", "me"); id != "" {
+				input["assigneeId"] = id
 			}
-			input["assigneeId"] = viewer.ID
-		}
-
-		// Handle milestone for create
-		if cmd.Flags().Changed("milestone") {
-			milestoneVal, _ := cmd.Flags().GetString("milestone")
-			projectFlag, _ := cmd.Flags().GetString("project")
-			if projectFlag == "" {
-				output.Error("--project is required when using --milestone (milestones are per-project)", plaintext, jsonOut)
-				os.Exit(1)
+		} else if cmd.Flags().Changed("assignee") {
+			assignee, _ := cmd.Flags().GetString("assignee")
+			switch strings.ToLower(assignee) {
+			case "none", "unassigned", "":
+				// Explicitly unassigned, even if a template assigns someone
+				input["assigneeId"] = nil
+			default:
+				if id := resolver.user("assignee", assignee); id != "" {
+					input["assigneeId"] = id
+				}
 			}
-			input["projectId"] = projectFlag
+		}
 
-			if milestoneVal != "" && !strings.EqualFold(milestoneVal, "none") {
-				milestoneID, err := resolveMilestoneByProject(client, projectFlag, milestoneVal, plaintext, jsonOut)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve milestone: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+		// Handle project and milestone (milestones are per-project)
+		projectFlag, _ := cmd.Flags().GetString("project")
+		milestoneVal, _ := cmd.Flags().GetString("milestone")
+		if cmd.Flags().Changed("milestone") && projectFlag == "" {
+			output.Error("--project is required when using --milestone (milestones are per-project)", plaintext, jsonOut)
+			exit(1)
+		}
+		if projectFlag != "" {
+			if projectID := resolver.project(projectFlag); projectID != "" {
+				input["projectId"] = projectID
+				if milestoneVal != "" && !strings.EqualFold(milestoneVal, "none") {
+					if milestoneID := resolver.milestone(projectID, milestoneVal); milestoneID != "" {
+						input["projectMilestoneId"] = milestoneID
+					}
 				}
-				input["projectMilestoneId"] = milestoneID
 			}
-		} else if cmd.Flags().Changed("project") {
-			projectFlag, _ := cmd.Flags().GetString("project")
-			input["projectId"] = projectFlag
 		}
 
 		// Handle parent flag
-		if cmd.Flags().Changed("parent") {
-			parentVal, _ := cmd.Flags().GetString("parent")
-			if parentVal != "" {
-				parentIssue, err := client.GetIssue(context.Background(), parentVal)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve parent issue '%s': %v", parentVal, err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["parentId"] = parentIssue.ID
+		if parentVal, _ := cmd.Flags().GetString("parent"); parentVal != "" {
+			if parentID := resolver.issue("parent", parentVal); parentID != "" {
+				input["parentId"] = parentID
 			}
 		}
 
 		// Handle label flag
-		labelNames, _ := cmd.Flags().GetStringSlice("label")
-		if len(labelNames) > 0 {
-			// Fetch all labels from the workspace
-			allLabels, err := client.GetLabels(context.Background(), nil, 250, "")
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to fetch labels: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			var labelIDs []string
-			for _, name := range labelNames {
-				var found bool
-				for _, label := range allLabels.Nodes {
-					if strings.EqualFold(label.Name, name) {
-						labelIDs = append(labelIDs, label.ID)
-						found = true
-						break
-					}
-				}
-				if !found {
-					var available []string
-					for _, label := range allLabels.Nodes {
-						available = append(available, label.Name)
-					}
-					output.Error(fmt.Sprintf("Label '%s' not found. Available labels: %s", name, strings.Join(available, ", ")), plaintext, jsonOut)
-					os.Exit(1)
-				}
+		if labelNames, _ := cmd.Flags().GetStringSlice("label"); len(labelNames) > 0 {
+			if labelIDs := resolver.labels(labelNames); len(labelIDs) > 0 {
+				input["labelIds"] = labelIDs
 			}
-			input["labelIds"] = labelIDs
 		}
 
-		// Handle cycle flag
-		if cmd.Flags().Changed("cycle") {
-			cycleVal, _ := cmd.Flags().GetString("cycle")
-			if cycleVal != "" {
-				input["cycleId"] = cycleVal
+		// Handle cycle flag (ID, number, or name)
+		if cycleVal, _ := cmd.Flags().GetString("cycle"); cycleVal != "" {
+			if cycleID := resolver.cycle(cycleVal, team); cycleID != "" {
+				input["cycleId"] = cycleID
 			}
 		}
 
@@ -1099,76 +1523,82 @@ Examples:
 			}
 		}
 
-		// Handle state flag (initial state)
-		if cmd.Flags().Changed("state") {
-			stateName, _ := cmd.Flags().GetString("state")
-			if stateName != "" {
-				// Get available states for the team
-				states, err := client.GetTeamStates(context.Background(), teamKey)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var stateID string
-				for _, state := range states {
-					if strings.EqualFold(state.Name, stateName) {
-						stateID = state.ID
-						break
-					}
-				}
-
-				if stateID == "" {
-					var stateNames []string
-					for _, state := range states {
-						stateNames = append(stateNames, state.Name)
-					}
-					output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
+		// Handle state flag (initial state); states are per-team
+		if stateName, _ := cmd.Flags().GetString("state"); stateName != "" && team != nil {
+			if stateID := resolver.state(team, stateName); stateID != "" {
 				input["stateId"] = stateID
 			}
 		}
 
 		// Handle subscriber flag
-		subscriberEmails, _ := cmd.Flags().GetStringSlice("subscriber")
-		if len(subscriberEmails) > 0 {
-			users, err := client.GetUsers(context.Background(), 100, "", "")
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
+		if subscriberEmails, _ := cmd.Flags().GetStringSlice("subscriber"); len(subscriberEmails) > 0 {
 			var subscriberIDs []string
 			for _, email := range subscriberEmails {
-				var found bool
-				for _, user := range users.Nodes {
-					if user.Email == email || user.Name == email {
-						subscriberIDs = append(subscriberIDs, user.ID)
-						found = true
-						break
-					}
-				}
-				if !found {
-					output.Error(fmt.Sprintf("User '%s' not found", email), plaintext, jsonOut)
-					os.Exit(1)
+				if id := resolver.user("subscriber", email); id != "" {
+					subscriberIDs = append(subscriberIDs, id)
 				}
 			}
 			input["subscriberIds"] = subscriberIDs
 		}
 
+		resolver.report(plaintext, jsonOut)
+
+		// Template values fill whatever the flags left unset
+		templateRef, _ := cmd.Flags().GetString("template")
+		noTemplate, _ := cmd.Flags().GetBool("no-template")
+		tmpl, isDefault, err := resolveIssueTemplate(client, templateRef, noTemplate, team)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if tmpl != nil {
+			values, err := issueTemplateValues(tmpl.TemplateData)
+			if err != nil {
+				output.Error(fmt.Sprintf("Template '%s': %v", tmpl.Name, err), plaintext, jsonOut)
+				exit(1)
+			}
+			applyIssueTemplate(input, values)
+			if isDefault {
+				fmt.Fprintf(os.Stderr, "Applied %s default template '%s' (--no-template to skip)\n", team.Key, tmpl.Name)
+			} else {
+				fmt.Fprintf(os.Stderr, "Applied template '%s'\n", tmpl.Name)
+			}
+		}
+		if _, set := input["priority"]; !set && priority >= 0 && priority <= 4 {
+			input["priority"] = priority
+		}
+
+		// Look for existing issues with a similar title before creating
+		strictDuplicates, _ := cmd.Flags().GetBool("strict-duplicates")
+		checkDuplicates := viper.GetBool("check_duplicates")
+		if cmd.Flags().Changed("check-duplicates") {
+			checkDuplicates, _ = cmd.Flags().GetBool("check-duplicates")
+		}
+		if checkDuplicates || strictDuplicates {
+			checkIssueDuplicates(client, title, team.ID, strictDuplicates, plaintext, jsonOut)
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			printDryRun("create issue in "+team.Key, input, "description", plaintext, jsonOut)
+			return
+		}
+
 		// Create issue
 		issue, err := client.CreateIssue(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, &journal.Inverse{Action: "archive"})
 
+		if printCreated(cmd, createdEntity{Type: "issue", ID: issue.ID, Name: issue.Identifier, URL: issue.URL}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(issue)
 		} else if plaintext {
 			fmt.Printf("Created issue %s: %s\n", issue.Identifier, issue.Title)
+			printResultURL(issue.URL, plaintext)
 		} else {
 			fmt.Printf("%s Created issue %s: %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -1177,6 +1607,7 @@ Examples:
 			if issue.Assignee != nil {
 				fmt.Printf("  Assigned to: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
 			}
+			printResultURL(issue.URL, plaintext)
 		}
 	},
 }
@@ -1187,30 +1618,49 @@ var issueUpdateCmd = &cobra.Command{
 	Long: `Update various fields of an issue.
 
 The description can be provided inline via --description or read from a markdown file via --description-file.
-Use --description-file - to read from stdin.
+Use --description-file - to read from stdin. --append-description (or
+--append-description-file) and --prepend-description add text to the current
+description, separated by a blank line, instead of replacing it.
+
+--project accepts a project UUID, slug, URL, or name, or 'none'. When the project
+changes, the issue's milestone is cleared unless it belongs to the new project, and
+a warning is printed if the issue's team is not one of the new project's teams
+(--add-team-to-project adds it instead).
 
 Examples:
   linear-cli issue update LIN-123 --title "New title"
   linear-cli issue update LIN-123 --description "Updated description"
   linear-cli issue update LIN-123 --description-file description.md
+  linear-cli issue update LIN-123 --append-description "Deployed in v1.2"
   linear-cli issue update LIN-123 --assignee user@example.com
   linear-cli issue update LIN-123 --state "In Progress"
   linear-cli issue update LIN-123 --priority 1
   linear-cli issue update LIN-123 --due-date "2024-12-31"
+  linear-cli issue update LIN-123 --project "Q3 Roadmap"
+  linear-cli issue update LIN-123 --project https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f --add-team-to-project
+  linear-cli issue update LIN-123 --project none
   linear-cli issue update LIN-123 --title "New title" --assignee me --priority 2`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		// The current issue supplies the team, project, subscribers, and the
+		// values needed to undo the update
+		current, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
 		// Build update input
 		input := make(map[string]interface{})
 
@@ -1227,10 +1677,20 @@ Examples:
 			description, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = description
 		}
+		// --append-description/--prepend-description are merged with the
+		// current description right before the update is sent
+		edit, hasEdit, err := descriptionEditFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasEdit {
+			input["description"] = edit.apply(current.Description)
+		}
 
 		// Handle assignee update
 		if cmd.Flags().Changed("assignee") {
@@ -1241,30 +1701,17 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["assigneeId"] = viewer.ID
-			case "unassigned", "":
+			case "unassigned", "none", "":
 				input["assigneeId"] = nil
 			default:
 				// Look up user by email
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				foundUser, err := newUserLookup(client).find(assignee)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.Email == assignee || user.Name == assignee {
-						foundUser = &user
-						break
-					}
-				}
-
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(err.Error(), plaintext, jsonOut, err)
+					exit(1)
 				}
 
 				input["assigneeId"] = foundUser.ID
@@ -1275,40 +1722,20 @@ Examples:
 		if cmd.Flags().Changed("state") {
 			stateName, _ := cmd.Flags().GetString("state")
 
-			// First, get the issue to know which team it belongs to
-			issue, err := client.GetIssue(context.Background(), args[0])
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Get available states for the team
-			states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
+			// Get available states for the issue's team
+			states, err := client.GetTeamStates(context.Background(), current.Team.Key)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.ID
-					break
-				}
+				exit(1)
 			}
 
-			if stateID == "" {
-				// Show available states
-				var stateNames []string
-				for _, state := range states {
-					stateNames = append(stateNames, state.Name)
-				}
-				output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+			state, err := findState(states, stateName)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut, err)
+				exit(1)
 			}
 
-			input["stateId"] = stateID
+			input["stateId"] = state.ID
 		}
 
 		// Handle priority update
@@ -1327,16 +1754,41 @@ Examples:
 			}
 		}
 
+		// Handle project update. The current issue reports the old project
+		// and has its milestone and team checked against the new one.
+		var move *issueProjectMove
+		if cmd.Flags().Changed("project") {
+			projectVal, _ := cmd.Flags().GetString("project")
+			move, err = newIssueProjectMove(client, current, projectVal, plaintext, jsonOut)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+			if move.target != nil {
+				input["projectId"] = move.target.ID
+			} else {
+				input["projectId"] = nil
+			}
+		}
+
 		// Handle milestone update
 		if cmd.Flags().Changed("milestone") {
 			milestoneVal, _ := cmd.Flags().GetString("milestone")
 			if milestoneVal == "" || strings.EqualFold(milestoneVal, "none") {
 				input["projectMilestoneId"] = nil
+			} else if move != nil && move.target == nil {
+				output.Error("Cannot set a milestone while removing the issue from its project", plaintext, jsonOut)
+				exit(1)
 			} else {
-				milestoneID, err := resolveMilestone(client, args[0], milestoneVal, plaintext, jsonOut)
+				var milestoneID string
+				if move != nil {
+					milestoneID, err = resolveMilestoneByProject(client, move.target.ID, milestoneVal, plaintext, jsonOut)
+				} else {
+					milestoneID, err = resolveMilestone(client, current, milestoneVal, plaintext, jsonOut)
+				}
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve milestone: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(fmt.Sprintf("Failed to resolve milestone: %v", err), plaintext, jsonOut, err)
+					exit(1)
 				}
 				input["projectMilestoneId"] = milestoneID
 			}
@@ -1348,21 +1800,15 @@ Examples:
 			if parentVal == "" || strings.EqualFold(parentVal, "none") || strings.EqualFold(parentVal, "null") {
 				input["parentId"] = nil
 			} else {
-				// Prevent self-reference (check raw input)
-				if strings.EqualFold(parentVal, args[0]) {
-					output.Error("An issue cannot be its own parent", plaintext, jsonOut)
-					os.Exit(1)
-				}
-				parentIssue, err := client.GetIssue(context.Background(), parentVal)
+				parentIssue, err := resolveIssueRef(context.Background(), client, parentVal)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve parent issue '%s': %v", parentVal, err), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(fmt.Sprintf("Failed to resolve parent: %v", err), plaintext, jsonOut)
+					exit(1)
 				}
-				// Also prevent self-reference after resolution (catches UUID vs identifier mismatch)
-				currentIssue, getErr := client.GetIssue(context.Background(), args[0])
-				if getErr == nil && (parentIssue.ID == currentIssue.ID) {
+				// Compare resolved IDs so UUID, identifier, and URL forms all match
+				if parentIssue.ID == current.ID {
 					output.Error("An issue cannot be its own parent", plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["parentId"] = parentIssue.ID
 			}
@@ -1378,16 +1824,6 @@ Examples:
 			}
 		}
 
-		// Handle project update
-		if cmd.Flags().Changed("project") {
-			projectVal, _ := cmd.Flags().GetString("project")
-			if projectVal == "" || strings.EqualFold(projectVal, "none") {
-				input["projectId"] = nil
-			} else {
-				input["projectId"] = projectVal
-			}
-		}
-
 		// Handle cycle update
 		if cmd.Flags().Changed("cycle") {
 			cycleVal, _ := cmd.Flags().GetString("cycle")
@@ -1399,14 +1835,16 @@ Examples:
 		}
 
 		// Handle team update (move issue to different team)
+		targetTeam := current.Team
 		if cmd.Flags().Changed("team") {
 			teamKey, _ := cmd.Flags().GetString("team")
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			input["teamId"] = team.ID
+			targetTeam = team
 		}
 
 		// Handle adding labels
@@ -1416,23 +1854,17 @@ Examples:
 				allLabels, err := client.GetLabels(context.Background(), nil, 250, "")
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to fetch labels: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 
 				var labelIDs []string
 				for _, name := range labelNames {
-					var found bool
-					for _, label := range allLabels.Nodes {
-						if strings.EqualFold(label.Name, name) {
-							labelIDs = append(labelIDs, label.ID)
-							found = true
-							break
-						}
-					}
-					if !found {
-						output.Error(fmt.Sprintf("Label '%s' not found", name), plaintext, jsonOut)
-						os.Exit(1)
+					label, err := findLabel(allLabels.Nodes, name)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
 					}
+					labelIDs = append(labelIDs, label.ID)
 				}
 				input["addedLabelIds"] = labelIDs
 			}
@@ -1445,23 +1877,17 @@ Examples:
 				allLabels, err := client.GetLabels(context.Background(), nil, 250, "")
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to fetch labels: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 
 				var labelIDs []string
 				for _, name := range labelNames {
-					var found bool
-					for _, label := range allLabels.Nodes {
-						if strings.EqualFold(label.Name, name) {
-							labelIDs = append(labelIDs, label.ID)
-							found = true
-							break
-						}
-					}
-					if !found {
-						output.Error(fmt.Sprintf("Label '%s' not found", name), plaintext, jsonOut)
-						os.Exit(1)
+					label, err := findLabel(allLabels.Nodes, name)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
 					}
+					labelIDs = append(labelIDs, label.ID)
 				}
 				input["removedLabelIds"] = labelIDs
 			}
@@ -1481,50 +1907,33 @@ Examples:
 		if cmd.Flags().Changed("add-subscriber") {
 			subscriberEmails, _ := cmd.Flags().GetStringSlice("add-subscriber")
 			if len(subscriberEmails) > 0 {
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				// Get current issue to find existing subscribers
-				currentIssue, err := client.GetIssue(context.Background(), args[0])
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
+				users := newUserLookup(client)
 
 				// Start with existing subscriber IDs
 				subscriberIDs := []string{}
-				if currentIssue.Subscribers != nil {
-					for _, sub := range currentIssue.Subscribers.Nodes {
+				if current.Subscribers != nil {
+					for _, sub := range current.Subscribers.Nodes {
 						subscriberIDs = append(subscriberIDs, sub.ID)
 					}
 				}
 
 				// Add new subscribers
 				for _, email := range subscriberEmails {
-					var found bool
-					for _, user := range users.Nodes {
-						if user.Email == email || user.Name == email {
-							// Check if already subscribed
-							alreadySubscribed := false
-							for _, existingID := range subscriberIDs {
-								if existingID == user.ID {
-									alreadySubscribed = true
-									break
-								}
-							}
-							if !alreadySubscribed {
-								subscriberIDs = append(subscriberIDs, user.ID)
-							}
-							found = true
+					user, err := users.find(email)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
+					}
+					// Check if already subscribed
+					alreadySubscribed := false
+					for _, existingID := range subscriberIDs {
+						if existingID == user.ID {
+							alreadySubscribed = true
 							break
 						}
 					}
-					if !found {
-						output.Error(fmt.Sprintf("User '%s' not found", email), plaintext, jsonOut)
-						os.Exit(1)
+					if !alreadySubscribed {
+						subscriberIDs = append(subscriberIDs, user.ID)
 					}
 				}
 				input["subscriberIds"] = subscriberIDs
@@ -1535,40 +1944,23 @@ Examples:
 		if cmd.Flags().Changed("remove-subscriber") {
 			subscriberEmails, _ := cmd.Flags().GetStringSlice("remove-subscriber")
 			if len(subscriberEmails) > 0 {
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				// Get current issue to find existing subscribers
-				currentIssue, err := client.GetIssue(context.Background(), args[0])
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
+				users := newUserLookup(client)
 
 				// Build list of IDs to remove
 				removeIDs := make(map[string]bool)
 				for _, email := range subscriberEmails {
-					var found bool
-					for _, user := range users.Nodes {
-						if user.Email == email || user.Name == email {
-							removeIDs[user.ID] = true
-							found = true
-							break
-						}
-					}
-					if !found {
-						output.Error(fmt.Sprintf("User '%s' not found", email), plaintext, jsonOut)
-						os.Exit(1)
+					user, err := users.find(email)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
 					}
+					removeIDs[user.ID] = true
 				}
 
 				// Filter out removed subscribers
 				subscriberIDs := []string{}
-				if currentIssue.Subscribers != nil {
-					for _, sub := range currentIssue.Subscribers.Nodes {
+				if current.Subscribers != nil {
+					for _, sub := range current.Subscribers.Nodes {
 						if !removeIDs[sub.ID] {
 							subscriberIDs = append(subscriberIDs, sub.ID)
 						}
@@ -1581,18 +1973,71 @@ Examples:
 		// Check if any updates were specified
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		// A project move drops a milestone the new project doesn't have and
+		// checks that the issue's team belongs to the new project
+		if move != nil && move.changed() {
+			if !cmd.Flags().Changed("milestone") {
+				if err := move.clearStaleMilestone(client, current, input); err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					exit(1)
+				}
+			}
+			addTeam, _ := cmd.Flags().GetBool("add-team-to-project")
+			if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+				// Adding the team changes the project, so a dry run only warns
+				addTeam = false
+			}
+			if err := move.checkTeam(cmd, client, targetTeam, addTeam); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		if hasEdit {
+			merged, err := mergeDescription(context.Background(), current.Description, current.UpdatedAt, func(ctx context.Context) (string, time.Time, error) {
+				latest, err := client.GetIssue(ctx, current.ID)
+				if err != nil {
+					return "", time.Time{}, err
+				}
+				return latest.Description, latest.UpdatedAt, nil
+			}, edit)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to update the description: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			input["description"] = merged
+		}
+
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+			printDryRun("update "+current.Identifier, input, "description", plaintext, jsonOut)
+			return
 		}
 
+		// Capture current values so the update can be undone
+		inverse := issueUpdateInverse(current, input)
+
 		// Update the issue
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
+		issue, err := client.UpdateIssue(context.Background(), current.ID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
-
-		if jsonOut {
-			output.JSON(issue)
+			exit(1)
+		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, inverse)
+
+		if urlOnly(cmd) {
+			printURLOnly(issue.URL, "issue")
+		} else if jsonOut {
+			if move != nil {
+				output.JSON(struct {
+					*api.Issue
+					ProjectChange *issueProjectMove `json:"projectChange"`
+				}{issue, move})
+			} else {
+				output.JSON(issue)
+			}
 		} else if plaintext {
 			fmt.Printf("Updated issue %s\n", issue.Identifier)
 			fmt.Printf("Title: %s\n", issue.Title)
@@ -1602,6 +2047,13 @@ Examples:
 			if issue.Assignee != nil {
 				fmt.Printf("Assignee: %s\n", issue.Assignee.Name)
 			}
+			if move != nil {
+				fmt.Printf("Project: %s → %s\n", move.From.projectName(), move.To.projectName())
+			}
+			printResultURL(issue.URL, plaintext)
+			if hasEdit {
+				printDescriptionPreview(edit, input["description"].(string), plaintext)
+			}
 		} else {
 			fmt.Printf("%s Updated issue %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -1615,6 +2067,13 @@ Examples:
 			} else {
 				fmt.Printf("  Assignee: %s\n", color.New(color.FgYellow).Sprint("Unassigned"))
 			}
+			if move != nil {
+				fmt.Printf("  Project: %s → %s\n", move.From.projectName(), color.New(color.FgCyan).Sprint(move.To.projectName()))
+			}
+			printResultURL(issue.URL, plaintext)
+			if hasEdit {
+				printDescriptionPreview(edit, input["description"].(string), plaintext)
+			}
 		}
 	},
 }
@@ -1622,450 +2081,85 @@ Examples:
 var issueActivityCmd = &cobra.Command{
 	Use:   "activity [issue-id]",
 	Short: "Show issue activity timeline",
-	Long: `Show a chronological timeline of all activity on an issue including
-state changes, assignee changes, priority changes, project/cycle changes,
-label additions/removals, linked attachments, and recent comments.
+	Long: `Show a chronological timeline of all activity on an issue: history
+(state, assignee, priority, title, description, estimate, due date, label,
+cycle, project, milestone, team, and parent changes, archiving), comments,
+relations, and attachments, merged into one stream, oldest first.
+
+Every output format renders the same normalized events. --json emits
+{"issue", "title", "events"}, each event being
+{"at", "actor", "type", "from", "to", "detail"}; from/to are null when a
+change has no before or after value. Types: state_change, assignment,
+priority, title, description, estimate, due_date, label, cycle, project,
+milestone, team, parent, relation, attachment, comment, archive.
 
 Examples:
   linear-cli issue activity LIN-123
-  linear-cli issue activity LIN-123 --limit 100`,
+  linear-cli issue activity LIN-123 --limit 100
+  linear-cli issue activity LIN-123 --type state_change,assignment
+  linear-cli issue activity LIN-123 --since 2_weeks_ago --json`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		typeList, _ := cmd.Flags().GetStringSlice("type")
+		types, err := parseActivityTypes(typeList)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		var since time.Time
+		if sinceExpr, _ := cmd.Flags().GetString("since"); sinceExpr != "" {
+			parsed, err := utils.ParseTimeExpression(sinceExpr)
+			if err != nil {
+				output.Error(fmt.Sprintf("Invalid --since: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			if parsed != "" {
+				since, _ = time.Parse(time.RFC3339, parsed)
+			}
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		limit, _ := cmd.Flags().GetInt("limit")
 
-		issue, err := client.GetIssueActivity(context.Background(), args[0], limit)
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		issue, err := client.GetIssueActivity(context.Background(), issueID, limit)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue activity: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
+		events := filterActivityEvents(buildActivityEvents(issue), types, since)
+
 		if jsonOut {
 			output.JSON(map[string]interface{}{
-				"issue":       issue.Identifier,
-				"title":       issue.Title,
-				"history":     issue.History,
-				"attachments": issue.Attachments,
-				"relations":   issue.Relations,
-				"comments":    issue.Comments,
+				"issue":  issue.Identifier,
+				"title":  issue.Title,
+				"events": events,
 			})
-			return
-		}
-
-		if plaintext {
-			fmt.Printf("# Activity: %s - %s\n\n", issue.Identifier, issue.Title)
-
-			if issue.State != nil {
-				fmt.Printf("Current State: %s\n", issue.State.Name)
-			}
-			if issue.Assignee != nil {
-				fmt.Printf("Current Assignee: %s\n", issue.Assignee.Name)
-			}
-			fmt.Printf("URL: %s\n\n", issue.URL)
-
-			// History entries
-			if issue.History != nil && len(issue.History.Nodes) > 0 {
-				fmt.Println("## History")
-				for _, entry := range issue.History.Nodes {
-					actorName := "System"
-					if entry.Actor != nil {
-						actorName = entry.Actor.Name
-					} else if entry.BotActor != nil {
-						if entry.BotActor.UserDisplayName != nil {
-							actorName = *entry.BotActor.UserDisplayName
-						} else if entry.BotActor.Name != nil {
-							actorName = *entry.BotActor.Name + " (bot)"
-						} else {
-							actorName = entry.BotActor.Type + " (bot)"
-						}
-					}
-
-					fmt.Printf("\n### %s by %s\n", entry.CreatedAt.Format("2006-01-02 15:04"), actorName)
-
-					if entry.FromState != nil && entry.ToState != nil {
-						fmt.Printf("- State: %s -> %s\n", entry.FromState.Name, entry.ToState.Name)
-					}
-					if entry.FromAssignee != nil && entry.ToAssignee != nil {
-						fmt.Printf("- Assignee: %s -> %s\n", entry.FromAssignee.Name, entry.ToAssignee.Name)
-					} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-						fmt.Printf("- Unassigned from %s\n", entry.FromAssignee.Name)
-					} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-						fmt.Printf("- Assigned to %s\n", entry.ToAssignee.Name)
-					}
-					if entry.FromPriority != nil && entry.ToPriority != nil {
-						fmt.Printf("- Priority: %s -> %s\n", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority))
-					}
-					if entry.FromTitle != nil && entry.ToTitle != nil {
-						fmt.Printf("- Title: \"%s\" -> \"%s\"\n", *entry.FromTitle, *entry.ToTitle)
-					}
-					if entry.UpdatedDescription != nil && *entry.UpdatedDescription {
-						fmt.Println("- Description updated")
-					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						fmt.Printf("- Cycle: %s -> %s\n", entry.FromCycle.Name, entry.ToCycle.Name)
-					} else if entry.FromCycle == nil && entry.ToCycle != nil {
-						fmt.Printf("- Added to cycle: %s\n", entry.ToCycle.Name)
-					} else if entry.FromCycle != nil && entry.ToCycle == nil {
-						fmt.Printf("- Removed from cycle: %s\n", entry.FromCycle.Name)
-					}
-					if entry.FromProject != nil && entry.ToProject != nil {
-						fmt.Printf("- Project: %s -> %s\n", entry.FromProject.Name, entry.ToProject.Name)
-					} else if entry.FromProject == nil && entry.ToProject != nil {
-						fmt.Printf("- Added to project: %s\n", entry.ToProject.Name)
-					} else if entry.FromProject != nil && entry.ToProject == nil {
-						fmt.Printf("- Removed from project: %s\n", entry.FromProject.Name)
-					}
-					if entry.FromTeam != nil && entry.ToTeam != nil {
-						fmt.Printf("- Team: %s -> %s\n", entry.FromTeam.Name, entry.ToTeam.Name)
-					} else if entry.FromTeam == nil && entry.ToTeam != nil {
-						fmt.Printf("- Moved to team: %s\n", entry.ToTeam.Name)
-					} else if entry.FromTeam != nil && entry.ToTeam == nil {
-						fmt.Printf("- Removed from team: %s\n", entry.FromTeam.Name)
-					}
-					if entry.FromParent != nil && entry.ToParent != nil {
-						fmt.Printf("- Parent: %s -> %s\n", entry.FromParent.Identifier, entry.ToParent.Identifier)
-					} else if entry.FromParent == nil && entry.ToParent != nil {
-						fmt.Printf("- Set parent: %s\n", entry.ToParent.Identifier)
-					} else if entry.FromParent != nil && entry.ToParent == nil {
-						fmt.Printf("- Removed parent: %s\n", entry.FromParent.Identifier)
-					}
-					if entry.FromEstimate != nil && entry.ToEstimate != nil {
-						fmt.Printf("- Estimate: %.1f -> %.1f\n", *entry.FromEstimate, *entry.ToEstimate)
-					} else if entry.FromEstimate == nil && entry.ToEstimate != nil {
-						fmt.Printf("- Set estimate: %.1f\n", *entry.ToEstimate)
-					} else if entry.FromEstimate != nil && entry.ToEstimate == nil {
-						fmt.Println("- Estimate removed")
-					}
-					if entry.FromDueDate != nil && entry.ToDueDate != nil {
-						fmt.Printf("- Due date: %s -> %s\n", *entry.FromDueDate, *entry.ToDueDate)
-					} else if entry.FromDueDate == nil && entry.ToDueDate != nil {
-						fmt.Printf("- Set due date: %s\n", *entry.ToDueDate)
-					} else if entry.FromDueDate != nil && entry.ToDueDate == nil {
-						fmt.Println("- Due date removed")
-					}
-					if entry.FromProjectMilestone != nil && entry.ToProjectMilestone != nil {
-						fmt.Printf("- Milestone: %s -> %s\n", entry.FromProjectMilestone.Name, entry.ToProjectMilestone.Name)
-					} else if entry.FromProjectMilestone == nil && entry.ToProjectMilestone != nil {
-						fmt.Printf("- Set milestone: %s\n", entry.ToProjectMilestone.Name)
-					} else if entry.FromProjectMilestone != nil && entry.ToProjectMilestone == nil {
-						fmt.Printf("- Removed from milestone: %s\n", entry.FromProjectMilestone.Name)
-					}
-					if len(entry.AddedLabels) > 0 {
-						names := make([]string, len(entry.AddedLabels))
-						for i, l := range entry.AddedLabels {
-							names[i] = l.Name
-						}
-						fmt.Printf("- Added label(s): %s\n", strings.Join(names, ", "))
-					} else if len(entry.AddedLabelIds) > 0 {
-						fmt.Printf("- Added %d label(s)\n", len(entry.AddedLabelIds))
-					}
-					if len(entry.RemovedLabels) > 0 {
-						names := make([]string, len(entry.RemovedLabels))
-						for i, l := range entry.RemovedLabels {
-							names[i] = l.Name
-						}
-						fmt.Printf("- Removed label(s): %s\n", strings.Join(names, ", "))
-					} else if len(entry.RemovedLabelIds) > 0 {
-						fmt.Printf("- Removed %d label(s)\n", len(entry.RemovedLabelIds))
-					}
-					if len(entry.RelationChanges) > 0 {
-						for _, rc := range entry.RelationChanges {
-							fmt.Printf("- Relation %s: %s\n", rc.Type, rc.Identifier)
-						}
-					}
-					if entry.Attachment != nil {
-						fmt.Printf("- Attachment: %s\n", entry.Attachment.Title)
-					}
-					if entry.Archived != nil && *entry.Archived {
-						if entry.AutoArchived != nil && *entry.AutoArchived {
-							fmt.Println("- Auto-archived")
-						} else {
-							fmt.Println("- Archived")
-						}
-					}
-					if entry.Trashed != nil && *entry.Trashed {
-						fmt.Println("- Trashed")
-					}
-					if entry.AutoClosed != nil && *entry.AutoClosed {
-						fmt.Println("- Auto-closed")
-					}
-				}
-			}
-
-			// Attachments
-			if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-				fmt.Printf("\n## Attachments\n")
-				for _, att := range issue.Attachments.Nodes {
-					creator := ""
-					if att.Creator != nil {
-						creator = fmt.Sprintf(" by %s", att.Creator.Name)
-					}
-					fmt.Printf("- [%s](%s)%s (%s)\n", att.Title, att.URL, creator, att.CreatedAt.Format("2006-01-02"))
-				}
-			}
-
-			// Relations
-			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
-				fmt.Printf("\n## Relations\n")
-				for _, rel := range issue.Relations.Nodes {
-					if rel.RelatedIssue != nil {
-						fmt.Printf("- %s: %s - %s\n", rel.Type, rel.RelatedIssue.Identifier, rel.RelatedIssue.Title)
-					}
-				}
-			}
-
-			// Comments summary
-			if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-				fmt.Printf("\n## Recent Comments (%d shown)\n", len(issue.Comments.Nodes))
-				for _, comment := range issue.Comments.Nodes {
-					userName := "Unknown"
-					if comment.User != nil {
-						userName = comment.User.Name
-					}
-					body := comment.Body
-					if len(body) > 100 {
-						body = body[:97] + "..."
-					}
-					fmt.Printf("- %s by %s: %s\n", comment.CreatedAt.Format("2006-01-02 15:04"), userName, body)
-				}
-			}
-
-			return
-		}
-
-		// Rich display
-		fmt.Println()
-		fmt.Printf("%s %s %s\n",
-			color.New(color.FgCyan, color.Bold).Sprint("Activity:"),
-			color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-			color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
-		fmt.Println(strings.Repeat("─", 60))
-
-		if issue.State != nil {
-			fmt.Printf("Current State: %s\n", color.New(color.FgGreen).Sprint(issue.State.Name))
-		}
-		if issue.Assignee != nil {
-			fmt.Printf("Assignee: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
-		}
-
-		// History entries
-		if issue.History != nil && len(issue.History.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow, color.Bold).Sprint("Timeline:"))
-			for _, entry := range issue.History.Nodes {
-				actorName := "System"
-				if entry.Actor != nil {
-					actorName = entry.Actor.Name
-				} else if entry.BotActor != nil {
-					if entry.BotActor.UserDisplayName != nil {
-						actorName = *entry.BotActor.UserDisplayName
-					} else if entry.BotActor.Name != nil {
-						actorName = *entry.BotActor.Name + " (bot)"
-					} else {
-						actorName = entry.BotActor.Type + " (bot)"
-					}
-				}
-
-				timestamp := color.New(color.FgWhite, color.Faint).Sprint(entry.CreatedAt.Format("2006-01-02 15:04"))
-				actor := color.New(color.FgCyan).Sprint(actorName)
-
-				changes := []string{}
-
-				if entry.FromState != nil && entry.ToState != nil {
-					changes = append(changes, fmt.Sprintf("State: %s -> %s",
-						color.New(color.FgRed).Sprint(entry.FromState.Name),
-						color.New(color.FgGreen).Sprint(entry.ToState.Name)))
-				}
-				if entry.FromAssignee != nil && entry.ToAssignee != nil {
-					changes = append(changes, fmt.Sprintf("Assignee: %s -> %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
-				} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-					changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
-				} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-					changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
-				}
-				if entry.FromPriority != nil && entry.ToPriority != nil {
-					changes = append(changes, fmt.Sprintf("Priority: %s -> %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
-				}
-				if entry.FromTitle != nil && entry.ToTitle != nil {
-					changes = append(changes, "Title changed")
-				}
-				if entry.UpdatedDescription != nil && *entry.UpdatedDescription {
-					changes = append(changes, "Description updated")
-				}
-				if entry.FromCycle != nil && entry.ToCycle != nil {
-					changes = append(changes, fmt.Sprintf("Cycle: %s -> %s", entry.FromCycle.Name, entry.ToCycle.Name))
-				} else if entry.FromCycle == nil && entry.ToCycle != nil {
-					changes = append(changes, fmt.Sprintf("Added to cycle: %s", entry.ToCycle.Name))
-				} else if entry.FromCycle != nil && entry.ToCycle == nil {
-					changes = append(changes, fmt.Sprintf("Removed from cycle: %s", entry.FromCycle.Name))
-				}
-				if entry.FromProject != nil && entry.ToProject != nil {
-					changes = append(changes, fmt.Sprintf("Project: %s -> %s", entry.FromProject.Name, entry.ToProject.Name))
-				} else if entry.FromProject == nil && entry.ToProject != nil {
-					changes = append(changes, fmt.Sprintf("Added to project: %s", entry.ToProject.Name))
-				} else if entry.FromProject != nil && entry.ToProject == nil {
-					changes = append(changes, fmt.Sprintf("Removed from project: %s", entry.FromProject.Name))
-				}
-				if entry.FromTeam != nil && entry.ToTeam != nil {
-					changes = append(changes, fmt.Sprintf("Team: %s -> %s", entry.FromTeam.Name, entry.ToTeam.Name))
-				} else if entry.FromTeam == nil && entry.ToTeam != nil {
-					changes = append(changes, fmt.Sprintf("Moved to team: %s", entry.ToTeam.Name))
-				} else if entry.FromTeam != nil && entry.ToTeam == nil {
-					changes = append(changes, fmt.Sprintf("Removed from team: %s", entry.FromTeam.Name))
-				}
-				if entry.FromParent != nil && entry.ToParent != nil {
-					changes = append(changes, fmt.Sprintf("Parent: %s -> %s", entry.FromParent.Identifier, entry.ToParent.Identifier))
-				} else if entry.FromParent == nil && entry.ToParent != nil {
-					changes = append(changes, fmt.Sprintf("Set parent: %s", entry.ToParent.Identifier))
-				} else if entry.FromParent != nil && entry.ToParent == nil {
-					changes = append(changes, fmt.Sprintf("Removed parent: %s", entry.FromParent.Identifier))
-				}
-				if entry.FromEstimate != nil && entry.ToEstimate != nil {
-					changes = append(changes, fmt.Sprintf("Estimate: %.1f -> %.1f", *entry.FromEstimate, *entry.ToEstimate))
-				} else if entry.FromEstimate == nil && entry.ToEstimate != nil {
-					changes = append(changes, fmt.Sprintf("Set estimate: %.1f", *entry.ToEstimate))
-				} else if entry.FromEstimate != nil && entry.ToEstimate == nil {
-					changes = append(changes, "Estimate removed")
-				}
-				if entry.FromDueDate != nil && entry.ToDueDate != nil {
-					changes = append(changes, fmt.Sprintf("Due date: %s -> %s", *entry.FromDueDate, *entry.ToDueDate))
-				} else if entry.FromDueDate == nil && entry.ToDueDate != nil {
-					changes = append(changes, fmt.Sprintf("Set due date: %s", *entry.ToDueDate))
-				} else if entry.FromDueDate != nil && entry.ToDueDate == nil {
-					changes = append(changes, "Due date removed")
-				}
-				if entry.FromProjectMilestone != nil && entry.ToProjectMilestone != nil {
-					changes = append(changes, fmt.Sprintf("Milestone: %s -> %s", entry.FromProjectMilestone.Name, entry.ToProjectMilestone.Name))
-				} else if entry.FromProjectMilestone == nil && entry.ToProjectMilestone != nil {
-					changes = append(changes, fmt.Sprintf("Set milestone: %s", entry.ToProjectMilestone.Name))
-				} else if entry.FromProjectMilestone != nil && entry.ToProjectMilestone == nil {
-					changes = append(changes, fmt.Sprintf("Removed from milestone: %s", entry.FromProjectMilestone.Name))
-				}
-				if len(entry.AddedLabels) > 0 {
-					names := make([]string, len(entry.AddedLabels))
-					for i, l := range entry.AddedLabels {
-						names[i] = l.Name
-					}
-					changes = append(changes, fmt.Sprintf("Added label(s): %s", strings.Join(names, ", ")))
-				} else if len(entry.AddedLabelIds) > 0 {
-					changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
-				}
-				if len(entry.RemovedLabels) > 0 {
-					names := make([]string, len(entry.RemovedLabels))
-					for i, l := range entry.RemovedLabels {
-						names[i] = l.Name
-					}
-					changes = append(changes, fmt.Sprintf("Removed label(s): %s", strings.Join(names, ", ")))
-				} else if len(entry.RemovedLabelIds) > 0 {
-					changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
-				}
-				if len(entry.RelationChanges) > 0 {
-					for _, rc := range entry.RelationChanges {
-						changes = append(changes, fmt.Sprintf("Relation %s: %s", rc.Type, rc.Identifier))
-					}
-				}
-				if entry.Attachment != nil {
-					changes = append(changes, fmt.Sprintf("Attachment: %s", entry.Attachment.Title))
-				}
-				if entry.Archived != nil && *entry.Archived {
-					if entry.AutoArchived != nil && *entry.AutoArchived {
-						changes = append(changes, "Auto-archived")
-					} else {
-						changes = append(changes, "Archived")
-					}
-				}
-				if entry.Trashed != nil && *entry.Trashed {
-					changes = append(changes, "Trashed")
-				}
-				if entry.AutoClosed != nil && *entry.AutoClosed {
-					changes = append(changes, "Auto-closed")
-				}
-
-				if len(changes) > 0 {
-					fmt.Printf("  %s %s\n", timestamp, actor)
-					for _, change := range changes {
-						fmt.Printf("    - %s\n", change)
-					}
-				}
-			}
-		}
-
-		// Attachments
-		if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow, color.Bold).Sprint("Attachments:"))
-			for _, att := range issue.Attachments.Nodes {
-				fmt.Printf("  %s %s - %s\n",
-					color.New(color.FgWhite, color.Faint).Sprint(att.CreatedAt.Format("2006-01-02")),
-					color.New(color.FgCyan).Sprint(att.Title),
-					color.New(color.FgBlue, color.Underline).Sprint(att.URL))
-			}
-		}
-
-		// Relations
-		if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow, color.Bold).Sprint("Relations:"))
-			for _, rel := range issue.Relations.Nodes {
-				if rel.RelatedIssue != nil {
-					relationType := rel.Type
-					switch relationType {
-					case "blocks":
-						relationType = "Blocks"
-					case "blocked":
-						relationType = "Blocked by"
-					case "related":
-						relationType = "Related to"
-					case "duplicate":
-						relationType = "Duplicate of"
-					}
-					fmt.Printf("  %s %s - %s\n",
-						color.New(color.FgMagenta).Sprint(relationType+":"),
-						color.New(color.FgCyan).Sprint(rel.RelatedIssue.Identifier),
-						rel.RelatedIssue.Title)
-				}
-			}
-		}
-
-		// Comments summary
-		if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow, color.Bold).Sprint("Recent Comments:"))
-			for _, comment := range issue.Comments.Nodes {
-				userName := "Unknown"
-				if comment.User != nil {
-					userName = comment.User.Name
-				}
-				body := comment.Body
-				lines := strings.Split(body, "\n")
-				if len(lines) > 0 {
-					body = lines[0]
-				}
-				if len(body) > 60 {
-					body = body[:57] + "..."
-				}
-				fmt.Printf("  %s %s: %s\n",
-					color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")),
-					color.New(color.FgCyan).Sprint(userName),
-					body)
-			}
+		} else if plaintext {
+			renderActivityMarkdown(os.Stdout, issue, events)
+		} else {
+			renderActivityRich(os.Stdout, issue, events)
 		}
-
-		fmt.Println()
 	},
 }
 
 // resolveStateByType finds the workflow state matching a type with lowest position for the issue's team
-func resolveStateByType(client *api.Client, issueID string, stateType string) (string, string, error) {
-	issue, err := client.GetIssue(context.Background(), issueID)
-	if err != nil {
-		return "", "", fmt.Errorf("failed to get issue: %v", err)
-	}
+func resolveStateByType(client *api.Client, issue *api.Issue, stateType string) (string, string, error) {
 	states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to get team states: %v", err)
@@ -2086,38 +2180,50 @@ func resolveStateByType(client *api.Client, issueID string, stateType string) (s
 }
 
 var issueStartCmd = &cobra.Command{
-	Use:   "start ISSUE-ID",
+	Use:   "start [ISSUE-ID]",
 	Short: "Start working on an issue (set In Progress + assign to me)",
 	Long: `Shortcut to set an issue to "In Progress" and assign it to yourself.
 
 Examples:
-  linear-cli issue start ROB-25`,
-	Args: cobra.ExactArgs(1),
+  linear-cli issue start ROB-25
+  linear-cli issue start --from-branch`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+
+		ref, err := issueArg(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		before, err := resolveIssueRef(context.Background(), client, ref)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
 		// Get current user
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Find the "started" type state (In Progress)
-		stateID, stateName, err := resolveStateByType(client, issueID, "started")
+		stateID, stateName, err := resolveStateByType(client, before, "started")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -2125,11 +2231,14 @@ Examples:
 			"assigneeId": viewer.ID,
 		}
 
-		issue, err := client.UpdateIssue(context.Background(), issueID, input)
+		inverse := issueUpdateInverse(before, input)
+
+		issue, err := client.UpdateIssue(context.Background(), before.ID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to start issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, inverse)
 
 		if jsonOut {
 			output.JSON(issue)
@@ -2146,42 +2255,57 @@ Examples:
 }
 
 var issueDoneCmd = &cobra.Command{
-	Use:   "done ISSUE-ID",
+	Use:   "done [ISSUE-ID]",
 	Short: "Mark an issue as done",
 	Long: `Shortcut to set an issue to the "Done" (completed) state.
 
 Examples:
-  linear-cli issue done ROB-25`,
-	Args: cobra.ExactArgs(1),
+  linear-cli issue done ROB-25
+  linear-cli issue done --from-branch`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+
+		ref, err := issueArg(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		before, err := resolveIssueRef(context.Background(), client, ref)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
 		// Find the "completed" type state (Done)
-		stateID, stateName, err := resolveStateByType(client, issueID, "completed")
+		stateID, stateName, err := resolveStateByType(client, before, "completed")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
 			"stateId": stateID,
 		}
 
-		issue, err := client.UpdateIssue(context.Background(), issueID, input)
+		inverse := issueUpdateInverse(before, input)
+
+		issue, err := client.UpdateIssue(context.Background(), before.ID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to complete issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, inverse)
 
 		if jsonOut {
 			output.JSON(issue)
@@ -2197,7 +2321,7 @@ Examples:
 }
 
 var issueTriageCmd = &cobra.Command{
-	Use:   "triage TEAM-KEY",
+	Use:   "triage TEAM",
 	Short: "List untriaged issues for a team",
 	Long: `Show issues in the Triage or Backlog state that need attention.
 This maps to the daily triage workflow in Linear.
@@ -2213,16 +2337,23 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		limit, _ := cmd.Flags().GetInt("limit")
 
+		team, err := client.GetTeam(context.Background(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+			exit(1)
+		}
+		teamKey = team.Key
+
 		// Build filter for triage/backlog states with no assignee
 		filter := map[string]interface{}{
 			"team": map[string]interface{}{
-				"key": map[string]interface{}{"eq": teamKey},
+				"id": map[string]interface{}{"eq": team.ID},
 			},
 			"state": map[string]interface{}{
 				"type": map[string]interface{}{"in": []string{"triage", "backlog"}},
@@ -2232,8 +2363,9 @@ Examples:
 		issues, err := client.GetIssues(context.Background(), filter, limit, "", "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get triage issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
 
 		if jsonOut {
 			output.JSON(issues.Nodes)
@@ -2261,7 +2393,7 @@ Examples:
 				}
 				fmt.Printf("%s\t%s\t%s\t%s\t%s\n",
 					i.Identifier, i.Title, state, i.PriorityLabel,
-					i.CreatedAt.Format("2006-01-02"))
+					output.FormatTime(i.CreatedAt, output.DateOnly))
 			}
 		} else {
 			fmt.Printf("\n%s Triage for team %s (%d issues)\n\n",
@@ -2276,19 +2408,14 @@ Examples:
 				stateColor := color.New(color.FgWhite)
 				if i.State != nil {
 					state = i.State.Name
-					switch i.State.Type {
-					case "triage":
-						stateColor = color.New(color.FgMagenta)
-					case "backlog":
-						stateColor = color.New(color.FgCyan)
-					}
+					stateColor = issueStateStyle(i.State).Color()
 				}
 				rows = append(rows, []string{
 					color.New(color.FgCyan).Sprint(i.Identifier),
 					truncateString(i.Title, 60),
 					stateColor.Sprint(state),
 					i.PriorityLabel,
-					i.CreatedAt.Format("2006-01-02"),
+					output.FormatTime(i.CreatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 				})
 			}
 			output.Table(output.TableData{
@@ -2305,36 +2432,136 @@ var issueArchiveCmd = &cobra.Command{
 	Short:   "Archive an issue",
 	Long: `Archive an issue (soft delete). Archived issues can be restored in the Linear UI.
 
+Sub-issues are left alone unless --cascade or --orphan is given. --cascade
+archives the direct sub-issues after the parent; add --recursive to include
+their sub-issues too, down to --max-depth levels (default 5; deeper trees are
+refused). --orphan instead removes the parent link from the direct
+sub-issues, keeping them open. Both list the sub-issues and ask first; pass
+--yes to skip the prompt (required when stdin is not a terminal or with
+--json).
+
+Issues the archived ones were blocking are reported ("ROB-77 was blocked by
+ROB-25") so someone can follow up. The relations are kept unless
+--remove-relations is given.
+
 Examples:
-  linear-cli issue archive ROB-25`,
+  linear-cli issue archive ROB-25
+  linear-cli issue archive ROB-25 --cascade
+  linear-cli issue archive ROB-25 --cascade --recursive --yes --json
+  linear-cli issue archive ROB-25 --orphan --remove-relations`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		cascade, _ := cmd.Flags().GetBool("cascade")
+		orphan, _ := cmd.Flags().GetBool("orphan")
+		recursive, _ := cmd.Flags().GetBool("recursive")
+		maxDepth, _ := cmd.Flags().GetInt("max-depth")
+		removeRelations, _ := cmd.Flags().GetBool("remove-relations")
+
+		if recursive && !cascade {
+			output.Error("--recursive only applies to --cascade", plaintext, jsonOut)
+			exit(1)
+		}
+		if maxDepth < 1 {
+			output.Error("--max-depth must be at least 1", plaintext, jsonOut)
+			exit(1)
+		}
+		if !recursive {
+			maxDepth = 1
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
 		// Resolve the issue first to get its UUID
-		issue, err := client.GetIssue(context.Background(), issueID)
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		// Load the relations to report blocked issues and find sub-issues
+		root, err := client.GetIssueRelations(context.Background(), issue.ID)
+		if err != nil {
+			if cascade || orphan || removeRelations {
+				output.Error(fmt.Sprintf("Failed to load the issue's relations: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			fmt.Fprintf(os.Stderr, "Warning: couldn't check which issues %s blocks: %v\n", issue.Identifier, err)
+			root = &api.Issue{ID: issue.ID, Identifier: issue.Identifier}
+		}
+		var subIssues []api.Issue
+		loaded := map[string]*api.Issue{root.ID: root}
+		if cascade || orphan {
+			subIssues, loaded, err = collectSubIssues(context.Background(), client.GetIssueRelations, root, maxDepth, recursive)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+			if len(subIssues) > 0 {
+				action := "Archive"
+				if orphan {
+					action = "Detach"
+				}
+				if err := confirmSubIssues(cmd, root, subIssues, action, plaintext, jsonOut); err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					exit(1)
+				}
+			}
 		}
 
 		_, err = client.ArchiveIssue(context.Background(), issue.ID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to archive issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		recordOperation(cmd, "issue", issue.ID, issue.Identifier, &journal.Inverse{Action: "unarchive"})
+
+		results := archiveSubIssues(cmd, client, root, subIssues, orphan)
+		archived := []*api.Issue{root}
+		for _, r := range results {
+			if r.Action == "archived" && r.Error == "" {
+				archived = append(archived, loaded[r.ID])
+			}
+		}
+		blockers := blockedByArchived(archived)
+		if removeRelations {
+			removeBlockerRelations(cmd, client, blockers)
 		}
 
-		output.Success(fmt.Sprintf("Archived %s", issueID), plaintext, jsonOut)
+		failed := 0
+		for _, r := range results {
+			if r.Error != "" {
+				failed++
+			}
+		}
+		for _, b := range blockers {
+			if b.Error != "" {
+				failed++
+			}
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"success":   failed == 0,
+				"id":        issue.ID,
+				"action":    "archived",
+				"subIssues": results,
+				"blocked":   blockers,
+			})
+		} else {
+			output.Success(fmt.Sprintf("Archived %s", issue.Identifier), plaintext, jsonOut)
+			printArchiveReport(results, blockers, removeRelations, plaintext)
+		}
+		if failed > 0 {
+			exit(1)
+		}
 	},
 }
 
@@ -2352,22 +2579,38 @@ func init() {
 	issueCmd.AddCommand(issueTriageCmd)
 	issueCmd.AddCommand(issueArchiveCmd)
 
+	// Issue get flags
+	issueGetCmd.Flags().Bool("full", false, "With --plaintext, print every section, marking empty ones None")
+	issueGetCmd.Flags().Bool("no-attachments", false, "Don't fetch attachments or the state of linked pull requests")
+	addFieldsFlags(issueGetCmd)
+
 	// Issue list flags
 	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueListCmd.Flags().StringSliceP("team", "t", nil, "Filter by team key, name, or ID (repeatable or comma-separated)")
+	issueListCmd.Flags().String("group-by", "", "Group issues into sections: team")
+	addAutoTeamFlag(issueListCmd)
 	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
-	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	addIssueSortFlags(issueListCmd)
 	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
 	issueListCmd.Flags().String("view", "", "Execute a custom view by ID (overrides other filters)")
 	issueListCmd.Flags().String("parent", "", "Filter by parent issue (identifier like ROB-27 or UUID)")
+	issueListCmd.Flags().Bool("top-level", false, "Exclude sub-issues (issues that have a parent)")
+	issueListCmd.Flags().Bool("with-children", false, "Show each issue's sub-issue count (Children column)")
+	issueListCmd.MarkFlagsMutuallyExclusive("parent", "top-level")
+	issueListCmd.Flags().Bool("breached", false, "Only open issues whose due date has passed")
+	issueListCmd.Flags().Bool("include-snoozed", false, "Include issues that are currently snoozed")
+	addScriptFlags(issueListCmd, "issue identifiers")
+	addSampleFlags(issueListCmd)
+	addDashboardFlags(issueListCmd)
+	issueListCmd.Flags().Int("description-lines", defaultDescriptionLines, "Description lines per item in plaintext output (0 for none, -1 for the full text)")
 
 	// Issue search flags
 	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
 	issueSearchCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID")
 	issueSearchCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueSearchCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
@@ -2378,33 +2621,55 @@ func init() {
 	// Issue create flags
 	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required unless mapped in .linear-cli.yaml)")
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
-	issueCreateCmd.Flags().String("project", "", "Project ID to associate with")
+	issueCreateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'none')")
+	issueCreateCmd.Flags().String("project", "", "Project ID or name to associate with")
 	issueCreateCmd.Flags().String("milestone", "", "Milestone ID or name (requires --project)")
 	issueCreateCmd.Flags().StringSliceP("label", "L", nil, "Label name (repeatable, case-insensitive)")
-	issueCreateCmd.Flags().String("cycle", "", "Cycle ID to assign to")
+	issueCreateCmd.Flags().String("cycle", "", "Cycle ID, number, or name to assign to")
 	issueCreateCmd.Flags().IntP("estimate", "e", -1, "Estimate points")
 	issueCreateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format)")
 	issueCreateCmd.Flags().StringP("state", "s", "", "Initial state name")
 	issueCreateCmd.Flags().StringSlice("subscriber", nil, "Add subscriber by email (repeatable)")
+	issueCreateCmd.Flags().String("template", "", "Issue template ID or name to start from (overrides the team default)")
+	issueCreateCmd.Flags().Bool("no-template", false, "Don't apply the team's default issue template")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("template", "no-template")
+	addAutoTeamFlag(issueCreateCmd)
+	addVarFlags(issueCreateCmd)
+	addURLOnlyFlag(issueCreateCmd)
+	addPorcelainFlag(issueCreateCmd)
+	issueCreateCmd.Flags().Bool("check-duplicates", false, "Search the team for similar open issues before creating (default from check_duplicates in config)")
+	issueCreateCmd.Flags().Bool("strict-duplicates", false, "Abort with exit code 3 when possible duplicates are found (implies --check-duplicates)")
+	addDryRunFlag(issueCreateCmd)
 	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
+	// --team is checked in Run so it can come from the repo path mapping
 
 	// Issue update flags
+	// Archive flags
+	issueArchiveCmd.Flags().Bool("cascade", false, "Also archive the issue's sub-issues")
+	issueArchiveCmd.Flags().Bool("orphan", false, "Remove the parent link from the sub-issues instead of archiving them")
+	issueArchiveCmd.Flags().Bool("recursive", false, "With --cascade, include sub-issues of sub-issues")
+	issueArchiveCmd.Flags().Int("max-depth", defaultArchiveMaxDepth, "With --recursive, how many levels of sub-issues to include")
+	issueArchiveCmd.Flags().Bool("remove-relations", false, "Delete the blocks relations from archived issues to issues that stay")
+	issueArchiveCmd.Flags().Bool("yes", false, "Don't ask before archiving or detaching sub-issues")
+	issueArchiveCmd.MarkFlagsMutuallyExclusive("cascade", "orphan")
+
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
-	issueUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
-	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
+	issueUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	addDescriptionEditFlags(issueUpdateCmd)
+	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'none'/'unassigned')")
 	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
 	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
 	issueUpdateCmd.Flags().String("milestone", "", "Milestone ID or name (or 'none' to unset)")
 	issueUpdateCmd.Flags().String("parent", "", "Parent issue identifier (or 'none' to unset)")
 	issueUpdateCmd.Flags().IntP("estimate", "e", -1, "Estimate points (or -1 to remove)")
-	issueUpdateCmd.Flags().String("project", "", "Project ID (or 'none' to remove from project)")
+	issueUpdateCmd.Flags().String("project", "", "Project UUID, slug, URL, or name (or 'none' to remove from project)")
+	issueUpdateCmd.Flags().Bool("add-team-to-project", false, "With --project, add the issue's team to the new project if it isn't already one of its teams")
 	issueUpdateCmd.Flags().String("cycle", "", "Cycle ID (or 'none' to remove from cycle)")
 	issueUpdateCmd.Flags().StringP("team", "t", "", "Move issue to different team (team key)")
 	issueUpdateCmd.Flags().StringSlice("add-label", nil, "Add labels by name (repeatable)")
@@ -2412,31 +2677,29 @@ func init() {
 	issueUpdateCmd.Flags().String("snooze-until", "", "Snooze until date/time (YYYY-MM-DD or YYYY-MM-DDTHH:MM:SS, or empty to unsnooze)")
 	issueUpdateCmd.Flags().StringSlice("add-subscriber", nil, "Add subscribers by email (repeatable)")
 	issueUpdateCmd.Flags().StringSlice("remove-subscriber", nil, "Remove subscribers by email (repeatable)")
+	addDryRunFlag(issueUpdateCmd)
+	addURLOnlyFlag(issueUpdateCmd)
 
 	// Issue create parent flag
 	issueCreateCmd.Flags().String("parent", "", "Parent issue identifier")
 
 	// Issue activity flags
 	issueActivityCmd.Flags().IntP("limit", "l", 50, "Number of history entries to fetch")
+	issueActivityCmd.Flags().StringSlice("type", nil, "Only these event types, e.g. state_change,comment")
+	issueActivityCmd.Flags().String("since", "", "Only events since a date or expression (e.g. 2025-01-01, 2_weeks_ago)")
 
 	// Issue triage flags
 	issueTriageCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to show")
 }
 
-// resolveMilestone resolves a milestone value (ID or name) for an existing issue.
-// It fetches the issue to find its project, then resolves the milestone within that project.
-func resolveMilestone(client *api.Client, issueID string, milestoneVal string, plaintext, jsonOut bool) (string, error) {
+// resolveMilestone resolves a milestone value (ID or name) for an existing issue,
+// looking names up within the issue's project.
+func resolveMilestone(client *api.Client, issue *api.Issue, milestoneVal string, plaintext, jsonOut bool) (string, error) {
 	// First try using it directly as an ID — if it looks like a UUID
 	if strings.Contains(milestoneVal, "-") && len(milestoneVal) > 20 {
 		return milestoneVal, nil
 	}
 
-	// It's a name — need to find the issue's project and search milestones
-	issue, err := client.GetIssue(context.Background(), issueID)
-	if err != nil {
-		return "", fmt.Errorf("failed to get issue: %v", err)
-	}
-
 	if issue.Project == nil {
 		return "", fmt.Errorf("issue %s is not associated with a project; milestones are per-project", issue.Identifier)
 	}
@@ -2469,7 +2732,8 @@ func resolveMilestoneByProject(client *api.Client, projectID string, milestoneVa
 		names = append(names, ms.Name)
 	}
 	if len(names) > 0 {
-		return "", fmt.Errorf("milestone '%s' not found. Available milestones: %s", milestoneVal, strings.Join(names, ", "))
+		msg := fmt.Sprintf("milestone '%s' not found. Available milestones: %s", milestoneVal, strings.Join(names, ", "))
+		return "", utils.NewNotFoundError(msg, milestoneVal, names)
 	}
 	return "", fmt.Errorf("milestone '%s' not found and no milestones exist on this project", milestoneVal)
 }
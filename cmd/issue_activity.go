@@ -0,0 +1,441 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// activityTypes are the normalized event types issue activity emits, in the
+// order --type help lists them
+var activityTypes = []string{
+	"state_change", "assignment", "priority", "title", "description",
+	"estimate", "due_date", "label", "cycle", "project", "milestone",
+	"team", "parent", "relation", "attachment", "comment", "archive",
+}
+
+// activityEvent is one normalized entry in an issue's activity stream.
+// From and To are display values (state names, user names, identifiers) and
+// are null when the change has no before or after side.
+type activityEvent struct {
+	At     time.Time `json:"at"`
+	Actor  string    `json:"actor"`
+	Type   string    `json:"type"`
+	From   *string   `json:"from"`
+	To     *string   `json:"to"`
+	Detail string    `json:"detail,omitempty"`
+}
+
+// historyActorName names whoever made a history entry
+func historyActorName(entry *api.IssueHistoryEntry) string {
+	switch {
+	case entry.Actor != nil:
+		return entry.Actor.Name
+	case entry.BotActor == nil:
+		return "System"
+	case entry.BotActor.UserDisplayName != nil:
+		return *entry.BotActor.UserDisplayName
+	case entry.BotActor.Name != nil:
+		return *entry.BotActor.Name + " (bot)"
+	default:
+		return entry.BotActor.Type + " (bot)"
+	}
+}
+
+// historyEvents turns one history entry into normalized events, one per change
+func historyEvents(entry *api.IssueHistoryEntry) []activityEvent {
+	actor := historyActorName(entry)
+	var events []activityEvent
+	add := func(typ string, from, to *string, detail string) {
+		events = append(events, activityEvent{At: entry.CreatedAt, Actor: actor, Type: typ, From: from, To: to, Detail: detail})
+	}
+	str := func(s string) *string { return &s }
+
+	if entry.FromState != nil || entry.ToState != nil {
+		var from, to *string
+		if entry.FromState != nil {
+			from = str(entry.FromState.Name)
+		}
+		if entry.ToState != nil {
+			to = str(entry.ToState.Name)
+		}
+		add("state_change", from, to, "")
+	}
+	if entry.FromAssignee != nil || entry.ToAssignee != nil {
+		var from, to *string
+		if entry.FromAssignee != nil {
+			from = str(entry.FromAssignee.Name)
+		}
+		if entry.ToAssignee != nil {
+			to = str(entry.ToAssignee.Name)
+		}
+		add("assignment", from, to, "")
+	}
+	if entry.FromPriority != nil || entry.ToPriority != nil {
+		var from, to *string
+		if entry.FromPriority != nil {
+			from = str(priorityToString(*entry.FromPriority))
+		}
+		if entry.ToPriority != nil {
+			to = str(priorityToString(*entry.ToPriority))
+		}
+		add("priority", from, to, "")
+	}
+	if entry.FromTitle != nil || entry.ToTitle != nil {
+		add("title", entry.FromTitle, entry.ToTitle, "")
+	}
+	if entry.UpdatedDescription != nil && *entry.UpdatedDescription {
+		add("description", nil, nil, "updated")
+	}
+	if entry.FromEstimate != nil || entry.ToEstimate != nil {
+		var from, to *string
+		if entry.FromEstimate != nil {
+			from = str(fmt.Sprintf("%.1f", *entry.FromEstimate))
+		}
+		if entry.ToEstimate != nil {
+			to = str(fmt.Sprintf("%.1f", *entry.ToEstimate))
+		}
+		add("estimate", from, to, "")
+	}
+	if entry.FromDueDate != nil || entry.ToDueDate != nil {
+		add("due_date", entry.FromDueDate, entry.ToDueDate, "")
+	}
+
+	for _, l := range entry.AddedLabels {
+		add("label", nil, str(l.Name), "")
+	}
+	if len(entry.AddedLabels) == 0 {
+		for _, id := range entry.AddedLabelIds {
+			add("label", nil, str(id), "label id")
+		}
+	}
+	for _, l := range entry.RemovedLabels {
+		add("label", str(l.Name), nil, "")
+	}
+	if len(entry.RemovedLabels) == 0 {
+		for _, id := range entry.RemovedLabelIds {
+			add("label", str(id), nil, "label id")
+		}
+	}
+
+	if entry.FromCycle != nil || entry.ToCycle != nil {
+		var from, to *string
+		if entry.FromCycle != nil {
+			from = str(entry.FromCycle.Name)
+		}
+		if entry.ToCycle != nil {
+			to = str(entry.ToCycle.Name)
+		}
+		add("cycle", from, to, "")
+	}
+	if entry.FromProject != nil || entry.ToProject != nil {
+		var from, to *string
+		if entry.FromProject != nil {
+			from = str(entry.FromProject.Name)
+		}
+		if entry.ToProject != nil {
+			to = str(entry.ToProject.Name)
+		}
+		add("project", from, to, "")
+	}
+	if entry.FromProjectMilestone != nil || entry.ToProjectMilestone != nil {
+		var from, to *string
+		if entry.FromProjectMilestone != nil {
+			from = str(entry.FromProjectMilestone.Name)
+		}
+		if entry.ToProjectMilestone != nil {
+			to = str(entry.ToProjectMilestone.Name)
+		}
+		add("milestone", from, to, "")
+	}
+	if entry.FromTeam != nil || entry.ToTeam != nil {
+		var from, to *string
+		if entry.FromTeam != nil {
+			from = str(entry.FromTeam.Name)
+		}
+		if entry.ToTeam != nil {
+			to = str(entry.ToTeam.Name)
+		}
+		add("team", from, to, "")
+	}
+	if entry.FromParent != nil || entry.ToParent != nil {
+		var from, to *string
+		if entry.FromParent != nil {
+			from = str(entry.FromParent.Identifier)
+		}
+		if entry.ToParent != nil {
+			to = str(entry.ToParent.Identifier)
+		}
+		add("parent", from, to, "")
+	}
+
+	for _, rc := range entry.RelationChanges {
+		add("relation", nil, str(rc.Identifier), rc.Type)
+	}
+	if entry.Attachment != nil {
+		add("attachment", nil, str(entry.Attachment.Title), entry.Attachment.URL)
+	}
+
+	switch {
+	case entry.Archived != nil && *entry.Archived && entry.AutoArchived != nil && *entry.AutoArchived:
+		add("archive", nil, nil, "auto-archived")
+	case entry.Archived != nil && *entry.Archived:
+		add("archive", nil, nil, "archived")
+	}
+	if entry.Trashed != nil && *entry.Trashed {
+		add("archive", nil, nil, "trashed")
+	}
+	if entry.AutoClosed != nil && *entry.AutoClosed {
+		add("archive", nil, nil, "auto-closed")
+	}
+	return events
+}
+
+// buildActivityEvents merges an issue's history, comments, relations, and
+// attachments into one stream sorted oldest first. Relations and attachments
+// already recorded by a history entry aren't repeated; those without one
+// (older than the fetched history) are added at their creation time.
+func buildActivityEvents(issue *api.Issue) []activityEvent {
+	var events []activityEvent
+	seenRelations := map[string]bool{}
+	seenAttachments := map[string]bool{}
+
+	if issue.History != nil {
+		for i := range issue.History.Nodes {
+			entry := &issue.History.Nodes[i]
+			events = append(events, historyEvents(entry)...)
+			for _, rc := range entry.RelationChanges {
+				seenRelations[rc.Identifier] = true
+			}
+			if entry.Attachment != nil {
+				seenAttachments[entry.Attachment.ID] = true
+			}
+		}
+	}
+
+	if issue.Comments != nil {
+		for _, c := range issue.Comments.Nodes {
+			actor := "Unknown"
+			if c.User != nil {
+				actor = c.User.Name
+			}
+			events = append(events, activityEvent{At: c.CreatedAt, Actor: actor, Type: "comment", Detail: c.Body})
+		}
+	}
+
+	if issue.Relations != nil {
+		for _, rel := range issue.Relations.Nodes {
+			if rel.RelatedIssue == nil || rel.CreatedAt == nil || seenRelations[rel.RelatedIssue.Identifier] {
+				continue
+			}
+			events = append(events, activityEvent{
+				At:     *rel.CreatedAt,
+				Actor:  "Unknown",
+				Type:   "relation",
+				To:     &rel.RelatedIssue.Identifier,
+				Detail: rel.Type,
+			})
+		}
+	}
+
+	if issue.Attachments != nil {
+		for _, att := range issue.Attachments.Nodes {
+			if seenAttachments[att.ID] {
+				continue
+			}
+			actor := "Unknown"
+			if att.Creator != nil {
+				actor = att.Creator.Name
+			}
+			events = append(events, activityEvent{
+				At:     att.CreatedAt,
+				Actor:  actor,
+				Type:   "attachment",
+				To:     &att.Title,
+				Detail: att.URL,
+			})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].At.Before(events[j].At) })
+	return events
+}
+
+// parseActivityTypes validates a --type list
+func parseActivityTypes(list []string) (map[string]bool, error) {
+	if len(list) == 0 {
+		return nil, nil
+	}
+	valid := map[string]bool{}
+	for _, t := range activityTypes {
+		valid[t] = true
+	}
+	types := map[string]bool{}
+	for _, t := range list {
+		t = strings.TrimSpace(t)
+		if !valid[t] {
+			return nil, fmt.Errorf("unknown activity type '%s'. Valid types: %s", t, strings.Join(activityTypes, ", "))
+		}
+		types[t] = true
+	}
+	return types, nil
+}
+
+// filterActivityEvents keeps events of the given types (all when types is
+// nil) at or after since (no bound when zero)
+func filterActivityEvents(events []activityEvent, types map[string]bool, since time.Time) []activityEvent {
+	kept := make([]activityEvent, 0, len(events))
+	for _, e := range events {
+		if types != nil && !types[e.Type] {
+			continue
+		}
+		if !since.IsZero() && e.At.Before(since) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+	return kept
+}
+
+// fromTo renders a change as "A -> B", "set to B", or "cleared (was A)"
+func fromTo(from, to *string) string {
+	switch {
+	case from != nil && to != nil:
+		return fmt.Sprintf("%s -> %s", *from, *to)
+	case to != nil:
+		return "set to " + *to
+	case from != nil:
+		return fmt.Sprintf("cleared (was %s)", *from)
+	}
+	return ""
+}
+
+// describeActivityEvent renders an event as one line of text, the same for
+// every output format
+func describeActivityEvent(e activityEvent) string {
+	switch e.Type {
+	case "state_change":
+		return "State: " + fromTo(e.From, e.To)
+	case "assignment":
+		switch {
+		case e.From != nil && e.To != nil:
+			return fmt.Sprintf("Assignee: %s -> %s", *e.From, *e.To)
+		case e.To != nil:
+			return "Assigned to " + *e.To
+		default:
+			return "Unassigned from " + *e.From
+		}
+	case "title":
+		return fmt.Sprintf("Title: %q -> %q", deref(e.From), deref(e.To))
+	case "description":
+		return "Description updated"
+	case "label":
+		if e.To != nil {
+			return "Added label: " + *e.To
+		}
+		return "Removed label: " + *e.From
+	case "relation":
+		return fmt.Sprintf("Relation %s: %s", e.Detail, deref(e.To))
+	case "attachment":
+		return "Attachment: " + deref(e.To)
+	case "comment":
+		return "Comment: " + commentExcerpt(e.Detail, 100)
+	case "archive":
+		return strings.ToUpper(e.Detail[:1]) + e.Detail[1:]
+	}
+	label := strings.ReplaceAll(e.Type, "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:] + ": " + fromTo(e.From, e.To)
+}
+
+// deref returns *s, or "" for nil
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// commentExcerpt returns the first line of a comment body cut to maxLen
+func commentExcerpt(body string, maxLen int) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	return truncateString(strings.TrimSpace(line), maxLen)
+}
+
+// groupActivityEvents splits events into runs sharing a time and actor, so a
+// history entry that changed several fields is shown under one heading
+func groupActivityEvents(events []activityEvent) [][]activityEvent {
+	var groups [][]activityEvent
+	for _, e := range events {
+		if n := len(groups); n > 0 {
+			last := groups[n-1][0]
+			if last.At.Equal(e.At) && last.Actor == e.Actor {
+				groups[n-1] = append(groups[n-1], e)
+				continue
+			}
+		}
+		groups = append(groups, []activityEvent{e})
+	}
+	return groups
+}
+
+// renderActivityMarkdown writes the plaintext timeline for an issue
+func renderActivityMarkdown(w io.Writer, issue *api.Issue, events []activityEvent) {
+	fmt.Fprintf(w, "# Activity: %s - %s\n\n", issue.Identifier, issue.Title)
+	if issue.State != nil {
+		fmt.Fprintf(w, "Current State: %s\n", issue.State.Name)
+	}
+	if issue.Assignee != nil {
+		fmt.Fprintf(w, "Current Assignee: %s\n", issue.Assignee.Name)
+	}
+	fmt.Fprintf(w, "URL: %s\n", issue.URL)
+
+	if len(events) == 0 {
+		fmt.Fprintln(w, "\nNo activity.")
+		return
+	}
+	fmt.Fprintln(w, "\n## Timeline")
+	for _, group := range groupActivityEvents(events) {
+		fmt.Fprintf(w, "\n### %s by %s\n", output.FormatTime(group[0].At, output.DateTimeShort), group[0].Actor)
+		for _, e := range group {
+			fmt.Fprintf(w, "- %s\n", describeActivityEvent(e))
+		}
+	}
+}
+
+// renderActivityRich writes the colored timeline for an issue
+func renderActivityRich(w io.Writer, issue *api.Issue, events []activityEvent) {
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%s %s %s\n",
+		color.New(color.FgCyan, color.Bold).Sprint("Activity:"),
+		color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+		color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
+	fmt.Fprintln(w, strings.Repeat("─", 60))
+
+	if issue.State != nil {
+		fmt.Fprintf(w, "Current State: %s\n", color.New(color.FgGreen).Sprint(issue.State.Name))
+	}
+	if issue.Assignee != nil {
+		fmt.Fprintf(w, "Assignee: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+	}
+
+	if len(events) == 0 {
+		fmt.Fprintf(w, "\n%s\n\n", color.New(color.FgYellow).Sprint("No activity."))
+		return
+	}
+	fmt.Fprintf(w, "\n%s\n", color.New(color.FgYellow, color.Bold).Sprint("Timeline:"))
+	for _, group := range groupActivityEvents(events) {
+		fmt.Fprintf(w, "  %s %s\n",
+			color.New(color.FgWhite, color.Faint).Sprint(output.FormatTime(group[0].At, output.DateTimeShort)),
+			color.New(color.FgCyan).Sprint(group[0].Actor))
+		for _, e := range group {
+			fmt.Fprintf(w, "    - %s\n", describeActivityEvent(e))
+		}
+	}
+	fmt.Fprintln(w)
+}
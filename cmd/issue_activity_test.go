@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+)
+
+// TestActivityGolden pins the normalized event schema (--json) and the
+// plaintext rendering built from the same events
+func TestActivityGolden(t *testing.T) {
+	output.SetUTC(true)
+	defer output.SetUTC(false)
+
+	var issue api.Issue
+	loadFixture(t, "issue_activity", &issue)
+	events := buildActivityEvents(&issue)
+
+	data, err := json.MarshalIndent(events, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkGolden(t, "issue_activity_events", append(data, '\n'))
+
+	var buf bytes.Buffer
+	renderActivityMarkdown(&buf, &issue, events)
+	checkGolden(t, "issue_activity", buf.Bytes())
+}
+
+func TestBuildActivityEventsMerge(t *testing.T) {
+	var issue api.Issue
+	loadFixture(t, "issue_activity", &issue)
+	events := buildActivityEvents(&issue)
+
+	counts := map[string]int{}
+	for i, e := range events {
+		counts[e.Type]++
+		if i > 0 && e.At.Before(events[i-1].At) {
+			t.Errorf("event %d (%s) is out of order", i, e.Type)
+		}
+	}
+	// ENG-40 and PR #812 come from history; the list copies aren't repeated
+	if counts["relation"] != 2 || counts["attachment"] != 2 || counts["comment"] != 1 || counts["label"] != 2 {
+		t.Errorf("event counts = %v", counts)
+	}
+	if events[0].Type != "relation" || *events[0].To != "ENG-12" {
+		t.Errorf("first event = %+v, want the ENG-12 relation predating the history", events[0])
+	}
+}
+
+func TestFilterActivityEvents(t *testing.T) {
+	var issue api.Issue
+	loadFixture(t, "issue_activity", &issue)
+	events := buildActivityEvents(&issue)
+
+	types, err := parseActivityTypes([]string{"state_change", "comment"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := filterActivityEvents(events, types, time.Time{})
+	if len(got) != 2 || got[0].Type != "comment" || got[1].Type != "state_change" {
+		t.Errorf("type filter kept %+v", got)
+	}
+
+	since := time.Date(2026, 1, 12, 0, 0, 0, 0, time.UTC)
+	for _, e := range filterActivityEvents(events, nil, since) {
+		if e.At.Before(since) {
+			t.Errorf("--since kept an event from %s", e.At)
+		}
+	}
+
+	if _, err := parseActivityTypes([]string{"state"}); err == nil {
+		t.Error("parseActivityTypes accepted an unknown type")
+	}
+}
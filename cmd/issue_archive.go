@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// defaultArchiveMaxDepth is how deep 'issue archive --cascade --recursive'
+// goes unless --max-depth says otherwise
+const defaultArchiveMaxDepth = 5
+
+// archiveResult is what happened to one issue during issue archive
+type archiveResult struct {
+	ID         string `json:"id"`
+	Identifier string `json:"identifier"`
+	Action     string `json:"action"` // "archived" or "orphaned"
+	Error      string `json:"error,omitempty"`
+}
+
+// archivedBlocker is a blocks relation from an archived issue to one that
+// is still around
+type archivedBlocker struct {
+	RelationID string `json:"relationId"`
+	Issue      string `json:"issue"`
+	BlockedBy  string `json:"blockedBy"`
+	Removed    bool   `json:"removed"`
+	Error      string `json:"error,omitempty"`
+}
+
+// collectSubIssues walks the issue's children breadth-first, parents before
+// their children, down to maxDepth levels. With refuseDeeper it fails if
+// sub-issues continue below maxDepth, rather than leaving part of the tree
+// behind. The returned map holds every visited issue's relations, the
+// root's included.
+func collectSubIssues(ctx context.Context, fetch relationFetcher, root *api.Issue, maxDepth int, refuseDeeper bool) ([]api.Issue, map[string]*api.Issue, error) {
+	loaded := map[string]*api.Issue{root.ID: root}
+	var subIssues []api.Issue
+	level := []*api.Issue{root}
+	for depth := 1; len(level) > 0 && (refuseDeeper || depth <= maxDepth); depth++ {
+		var next []*api.Issue
+		for _, parent := range level {
+			if parent.Children == nil {
+				continue
+			}
+			for _, child := range parent.Children.Nodes {
+				if _, seen := loaded[child.ID]; seen {
+					continue
+				}
+				if depth > maxDepth {
+					return nil, nil, fmt.Errorf("%s has sub-issues more than %d levels deep (e.g. %s); raise --max-depth to include them", root.Identifier, maxDepth, child.Identifier)
+				}
+				full, err := fetch(ctx, child.ID)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to load sub-issue %s: %w", child.Identifier, err)
+				}
+				loaded[child.ID] = full
+				subIssues = append(subIssues, *full)
+				next = append(next, full)
+			}
+		}
+		level = next
+	}
+	return subIssues, loaded, nil
+}
+
+// blockedByArchived lists the blocks relations from the archived issues to
+// issues that stay
+func blockedByArchived(archived []*api.Issue) []archivedBlocker {
+	gone := map[string]bool{}
+	for _, issue := range archived {
+		gone[issue.ID] = true
+	}
+	blockers := []archivedBlocker{}
+	for _, issue := range archived {
+		if issue.Relations == nil {
+			continue
+		}
+		for _, rel := range issue.Relations.Nodes {
+			if rel.Type != "blocks" || rel.RelatedIssue == nil || gone[rel.RelatedIssue.ID] {
+				continue
+			}
+			blockers = append(blockers, archivedBlocker{RelationID: rel.ID, Issue: rel.RelatedIssue.Identifier, BlockedBy: issue.Identifier})
+		}
+	}
+	return blockers
+}
+
+// confirmSubIssues lists the sub-issues an archive will touch and asks
+// before going ahead, unless --yes was given. Without a terminal to ask on
+// it refuses.
+func confirmSubIssues(cmd *cobra.Command, root *api.Issue, subIssues []api.Issue, action string, plaintext, jsonOut bool) error {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return nil
+	}
+	question := fmt.Sprintf("%s %d sub-issue(s) of %s?", action, len(subIssues), root.Identifier)
+	if jsonOut || !stdinIsTerminal() {
+		return fmt.Errorf("refusing to %s %d sub-issue(s) of %s without confirmation; pass --yes", strings.ToLower(action), len(subIssues), root.Identifier)
+	}
+	for _, issue := range subIssues {
+		state := ""
+		if issue.State != nil {
+			state = " (" + issue.State.Name + ")"
+		}
+		fmt.Fprintf(os.Stderr, "  %s %s%s\n", issue.Identifier, issue.Title, state)
+	}
+	if !confirmPrompt(question) {
+		return fmt.Errorf("aborted")
+	}
+	return nil
+}
+
+// archiveSubIssues archives or orphans each sub-issue after the parent has
+// been archived, recording every change that succeeds
+func archiveSubIssues(cmd *cobra.Command, client *api.Client, parent *api.Issue, subIssues []api.Issue, orphan bool) []archiveResult {
+	results := make([]archiveResult, 0, len(subIssues))
+	for _, issue := range subIssues {
+		result := archiveResult{ID: issue.ID, Identifier: issue.Identifier, Action: "archived"}
+		var err error
+		if orphan {
+			result.Action = "orphaned"
+			_, err = client.UpdateIssue(context.Background(), issue.ID, map[string]interface{}{"parentId": nil})
+			if err == nil {
+				recordOperation(cmd, "issue", issue.ID, issue.Identifier, &journal.Inverse{Action: "update", Input: map[string]interface{}{"parentId": parent.ID}})
+			}
+		} else {
+			_, err = client.ArchiveIssue(context.Background(), issue.ID)
+			if err == nil {
+				recordOperation(cmd, "issue", issue.ID, issue.Identifier, &journal.Inverse{Action: "unarchive"})
+			}
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// removeBlockerRelations deletes the reported blocks relations
+func removeBlockerRelations(cmd *cobra.Command, client *api.Client, blockers []archivedBlocker) {
+	for i := range blockers {
+		if err := client.DeleteIssueRelation(context.Background(), blockers[i].RelationID); err != nil {
+			blockers[i].Error = err.Error()
+			continue
+		}
+		blockers[i].Removed = true
+		recordOperation(cmd, "relation", blockers[i].RelationID, "", nil)
+	}
+}
+
+// printArchiveReport prints the per-issue results and the blockers left behind
+func printArchiveReport(results []archiveResult, blockers []archivedBlocker, removeRelations, plaintext bool) {
+	for _, r := range results {
+		switch {
+		case r.Error != "" && plaintext:
+			verb := "archive"
+			if r.Action == "orphaned" {
+				verb = "orphan"
+			}
+			fmt.Printf("Failed to %s %s: %s\n", verb, r.Identifier, r.Error)
+		case r.Error != "":
+			fmt.Printf("  %s %s: %s\n", color.New(color.FgRed).Sprint("✗"), r.Identifier, r.Error)
+		case plaintext:
+			fmt.Printf("%s %s\n", strings.ToUpper(r.Action[:1])+r.Action[1:], r.Identifier)
+		default:
+			fmt.Printf("  %s %s %s\n", color.New(color.FgGreen).Sprint("✓"), r.Action, r.Identifier)
+		}
+	}
+	for _, b := range blockers {
+		line := fmt.Sprintf("%s was blocked by %s", b.Issue, b.BlockedBy)
+		switch {
+		case b.Removed:
+			line += " (relation removed)"
+		case b.Error != "":
+			line += fmt.Sprintf(" (failed to remove the relation: %s)", b.Error)
+		}
+		if plaintext {
+			fmt.Println(line)
+		} else {
+			fmt.Printf("  %s %s\n", color.New(color.FgYellow).Sprint("⚠"), line)
+		}
+	}
+	if len(blockers) > 0 && !removeRelations {
+		fmt.Fprintln(os.Stderr, "Note: the blocks relations were kept; pass --remove-relations to delete them")
+	}
+}
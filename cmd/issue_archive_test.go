@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// stubArchiveTree stubs ENG-1 with sub-issue ENG-2, which has sub-issue
+// ENG-3. ENG-1 blocks ENG-9 and ENG-2 blocks ENG-3.
+func stubArchiveTree(t *testing.T) *linearmock.Server {
+	s := newMockLinear(t)
+	s.Data("Issue", `{"issue":{"id":"i1","identifier":"ENG-1","title":"Parent"}}`)
+	node := func(id, ident, children, relations string) string {
+		return `{"issue":{"id":"` + id + `","identifier":"` + ident + `","title":"` + ident + `","state":{"name":"Todo","type":"unstarted"},
+			"children":{"nodes":[` + children + `]},"relations":{"nodes":[` + relations + `]},"inverseRelations":{"nodes":[]}}}`
+	}
+	s.DataFor("IssueRelations", map[string]interface{}{"id": "i1"}, node("i1", "ENG-1", `{"id":"i2","identifier":"ENG-2"}`,
+		`{"id":"r1","type":"blocks","relatedIssue":{"id":"i9","identifier":"ENG-9"}},{"id":"r0","type":"related","relatedIssue":{"id":"i8","identifier":"ENG-8"}}`))
+	s.DataFor("IssueRelations", map[string]interface{}{"id": "i2"}, node("i2", "ENG-2", `{"id":"i3","identifier":"ENG-3"}`,
+		`{"id":"r2","type":"blocks","relatedIssue":{"id":"i3","identifier":"ENG-3"}}`))
+	s.DataFor("IssueRelations", map[string]interface{}{"id": "i3"}, node("i3", "ENG-3", "", ""))
+	s.Data("ArchiveIssue", `{"issueArchive":{"success":true}}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"issue":{"id":"i2","identifier":"ENG-2"}}}`)
+	s.Data("IssueRelationDelete", `{"issueRelationDelete":{"success":true}}`)
+	return s
+}
+
+func TestHermeticIssueArchiveCascade(t *testing.T) {
+	s := stubArchiveTree(t)
+
+	// Sub-issues need confirmation, which a script can't give
+	r := runMocked(t, "issue", "archive", "ENG-1", "--cascade")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "pass --yes") {
+		t.Errorf("unconfirmed --cascade exited %d: %s", r.Exit, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "ArchiveIssue" {
+			t.Fatalf("unconfirmed --cascade archived something: %v", s.Operations())
+		}
+	}
+
+	s.Reset()
+	r = runMocked(t, "issue", "archive", "ENG-1", "--cascade", "--recursive", "--yes", "--plaintext")
+	if r.Exit != 0 {
+		t.Fatalf("--cascade --recursive exited %d: %s", r.Exit, r.Stderr)
+	}
+	var archived []string
+	for _, req := range s.Requests() {
+		if req.Operation == "ArchiveIssue" {
+			archived = append(archived, req.Variables["id"].(string))
+		}
+	}
+	if strings.Join(archived, ",") != "i1,i2,i3" {
+		t.Errorf("archived %v, want the parent first, then i2, i3", archived)
+	}
+	// ENG-3 is archived too, so only ENG-9 is left blocked
+	want := "Archived ENG-2\nArchived ENG-3\nENG-9 was blocked by ENG-1\n"
+	if !strings.HasSuffix(r.Stdout, want) || strings.Contains(r.Stdout, "ENG-8") {
+		t.Errorf("output:\n%s\nwant suffix:\n%s", r.Stdout, want)
+	}
+	if !strings.Contains(r.Stderr, "--remove-relations") {
+		t.Errorf("no hint about --remove-relations: %s", r.Stderr)
+	}
+
+	// Without --recursive only direct sub-issues are archived, and a tree
+	// deeper than --max-depth is refused
+	s.Reset()
+	r = runMocked(t, "issue", "archive", "ENG-1", "--cascade", "--yes", "--json")
+	var got struct {
+		SubIssues []archiveResult   `json:"subIssues"`
+		Blocked   []archivedBlocker `json:"blocked"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &got); r.Exit != 0 || err != nil {
+		t.Fatalf("--cascade exited %d (%v): %s%s", r.Exit, err, r.Stdout, r.Stderr)
+	}
+	if len(got.SubIssues) != 1 || got.SubIssues[0].Identifier != "ENG-2" {
+		t.Errorf("--cascade sub-issues = %+v", got.SubIssues)
+	}
+	if len(got.Blocked) != 2 || got.Blocked[1].Issue != "ENG-3" || got.Blocked[1].BlockedBy != "ENG-2" {
+		t.Errorf("--cascade blocked = %+v", got.Blocked)
+	}
+	s.Reset()
+	if r := runMocked(t, "issue", "archive", "ENG-1", "--cascade", "--recursive", "--max-depth", "1", "--yes"); r.Exit != 1 || !strings.Contains(r.Stderr, "raise --max-depth") {
+		t.Errorf("--max-depth 1 exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticIssueArchiveOrphan(t *testing.T) {
+	s := stubArchiveTree(t)
+
+	r := runMocked(t, "issue", "archive", "ENG-1", "--orphan", "--remove-relations", "--yes", "--json")
+	if r.Exit != 0 {
+		t.Fatalf("--orphan exited %d: %s", r.Exit, r.Stderr)
+	}
+	var got struct {
+		SubIssues []archiveResult   `json:"subIssues"`
+		Blocked   []archivedBlocker `json:"blocked"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.SubIssues) != 1 || got.SubIssues[0].Identifier != "ENG-2" || got.SubIssues[0].Action != "orphaned" {
+		t.Errorf("sub-issues = %+v", got.SubIssues)
+	}
+	if len(got.Blocked) != 1 || got.Blocked[0].Issue != "ENG-9" || !got.Blocked[0].Removed {
+		t.Errorf("blocked = %+v", got.Blocked)
+	}
+
+	var ops []string
+	for _, req := range s.Requests() {
+		switch req.Operation {
+		case "UpdateIssue":
+			if input := req.Variables["input"].(map[string]interface{}); len(input) != 1 || input["parentId"] != nil {
+				t.Errorf("orphaning sent %v", input)
+			}
+			ops = append(ops, req.Operation+" "+req.Variables["id"].(string))
+		case "ArchiveIssue", "IssueRelationDelete":
+			ops = append(ops, req.Operation+" "+req.Variables["id"].(string))
+		}
+	}
+	if got, want := strings.Join(ops, ", "), "ArchiveIssue i1, UpdateIssue i2, IssueRelationDelete r1"; got != want {
+		t.Errorf("mutations: %s, want %s", got, want)
+	}
+
+	if r := runMocked(t, "issue", "archive", "ENG-1", "--orphan", "--recursive"); r.Exit != 1 || !strings.Contains(r.Stderr, "--recursive only applies to --cascade") {
+		t.Errorf("--orphan --recursive exited %d: %s", r.Exit, r.Stderr)
+	}
+}
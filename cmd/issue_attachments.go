@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+)
+
+// pullRequestColors is the marker color for each pull request state
+var pullRequestColors = map[string]*color.Color{
+	utils.PullRequestOpen:   color.New(color.FgGreen),
+	utils.PullRequestDraft:  color.New(color.FgWhite, color.Faint),
+	utils.PullRequestMerged: color.New(color.FgMagenta),
+	utils.PullRequestClosed: color.New(color.FgRed),
+}
+
+// annotatePullRequests fills in the pull request state of each of the
+// issue's attachments that links to one
+func annotatePullRequests(issue *api.Issue) {
+	if issue.Attachments == nil {
+		return
+	}
+	for i := range issue.Attachments.Nodes {
+		attachment := &issue.Attachments.Nodes[i]
+		sourceType := ""
+		if attachment.SourceType != nil {
+			sourceType = *attachment.SourceType
+		}
+		attachment.PullRequestState = utils.PullRequestState(sourceType, attachment.URL, attachment.Metadata)
+	}
+}
+
+// attachmentDetails is what the markdown listing says after an attachment's
+// link, e.g. "github, merged", or "" when there is nothing to add
+func attachmentDetails(attachment api.Attachment) string {
+	var details []string
+	if attachment.SourceType != nil && *attachment.SourceType != "" {
+		details = append(details, *attachment.SourceType)
+	}
+	if attachment.PullRequestState != "" {
+		details = append(details, attachment.PullRequestState)
+	}
+	return strings.Join(details, ", ")
+}
+
+// attachmentLine is one rich attachment line: pull requests get a marker
+// and state in the state's color, other attachments a paperclip
+func attachmentLine(attachment api.Attachment) string {
+	link := color.New(color.FgBlue, color.Underline).Sprint(attachment.URL)
+	if state := attachment.PullRequestState; state != "" {
+		c := pullRequestColors[state]
+		return "  " + c.Sprint("●") + " " + attachment.Title + " " + c.Sprint(state) + " - " + link
+	}
+	title := attachment.Title
+	if attachment.SourceType != nil && *attachment.SourceType != "" {
+		title += " " + color.New(color.FgWhite, color.Faint).Sprintf("(%s)", *attachment.SourceType)
+	}
+	return "  📎 " + title + " - " + link
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestIssueGetAttachments(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Issue", `{"issue":`+mockFixture(t, "issue_get")+`}`)
+
+	r := runMocked(t, "issue", "get", "ENG-42", "--json")
+	var issue struct {
+		Attachments struct {
+			Nodes []struct {
+				Title            string `json:"title"`
+				PullRequestState string `json:"pullRequestState"`
+			} `json:"nodes"`
+		} `json:"attachments"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &issue); err != nil || r.Exit != 0 {
+		t.Fatalf("issue get --json exited %d: %s (%v)", r.Exit, r.Stdout, err)
+	}
+	if nodes := issue.Attachments.Nodes; len(nodes) != 2 || nodes[0].PullRequestState != "merged" || nodes[1].PullRequestState != "" {
+		t.Errorf("attachments = %+v", nodes)
+	}
+	if vars := s.Requests()[0].Variables; vars["attachments"] != nil {
+		t.Errorf("attachments requested with %v, want the default", vars)
+	}
+
+	r = runMocked(t, "issue", "get", "ENG-42")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "acme/api#7 merged - https://github.com/acme/api/pull/7") {
+		t.Errorf("issue get doesn't show the pull request state:\n%s", r.Stdout)
+	}
+
+	s.Reset()
+	s.Data("Issue", `{"issue":{"id":"i1","identifier":"ENG-42","title":"Fix login"}}`)
+	r = runMocked(t, "issue", "get", "ENG-42", "--no-attachments", "--plaintext")
+	if r.Exit != 0 || strings.Contains(r.Stdout, "Attachments") {
+		t.Errorf("issue get --no-attachments exited %d:\n%s", r.Exit, r.Stdout)
+	}
+	if vars := s.Requests()[0].Variables; vars["attachments"] != false {
+		t.Errorf("--no-attachments sent %v, want attachments: false", vars)
+	}
+}
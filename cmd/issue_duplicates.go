@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+)
+
+const (
+	// duplicateThreshold is the minimum title similarity for a search hit to
+	// be reported as a possible duplicate
+	duplicateThreshold = 0.5
+	// duplicateSearchLimit is how many search hits are compared
+	duplicateSearchLimit = 20
+	// duplicateMaxCandidates caps how many candidates are shown
+	duplicateMaxCandidates = 5
+	// exitDuplicatesFound is the exit code used when issue create stops
+	// because of possible duplicates, so scripts can tell it from a failure
+	exitDuplicatesFound = 3
+)
+
+// duplicateCandidate is an existing issue that looks like the one being created
+type duplicateCandidate struct {
+	Identifier string  `json:"identifier"`
+	Title      string  `json:"title"`
+	State      string  `json:"state"`
+	URL        string  `json:"url"`
+	Similarity float64 `json:"similarity"`
+}
+
+// findDuplicateCandidates searches the team's non-canceled issues for titles
+// similar to title, best match first
+func findDuplicateCandidates(client *api.Client, title, teamID string) ([]duplicateCandidate, error) {
+	filter := map[string]interface{}{
+		"team":  map[string]interface{}{"id": map[string]interface{}{"eq": teamID}},
+		"state": map[string]interface{}{"type": map[string]interface{}{"neq": "canceled"}},
+	}
+	results, err := client.IssueSearch(context.Background(), title, filter, duplicateSearchLimit, "", "", false)
+	if err != nil {
+		return nil, err
+	}
+	return rankDuplicateCandidates(title, results.Nodes), nil
+}
+
+// rankDuplicateCandidates scores issues against title and keeps those at or
+// above duplicateThreshold
+func rankDuplicateCandidates(title string, issues []api.Issue) []duplicateCandidate {
+	var candidates []duplicateCandidate
+	for _, issue := range issues {
+		score := utils.TokenSimilarity(title, issue.Title)
+		if score < duplicateThreshold {
+			continue
+		}
+		state := ""
+		if issue.State != nil {
+			state = issue.State.Name
+		}
+		candidates = append(candidates, duplicateCandidate{
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			State:      state,
+			URL:        issue.URL,
+			Similarity: score,
+		})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+	if len(candidates) > duplicateMaxCandidates {
+		candidates = candidates[:duplicateMaxCandidates]
+	}
+	return candidates
+}
+
+// printDuplicateCandidates writes the candidate list to stderr so it never
+// mixes with the created issue on stdout
+func printDuplicateCandidates(candidates []duplicateCandidate, plaintext bool) {
+	if plaintext {
+		fmt.Fprintf(os.Stderr, "Possible duplicates (%d):\n", len(candidates))
+		for _, c := range candidates {
+			fmt.Fprintf(os.Stderr, "  %s [%s] %s (%.0f%%)\n", c.Identifier, c.State, c.Title, c.Similarity*100)
+		}
+		return
+	}
+	fmt.Fprintf(os.Stderr, "%s\n", color.New(color.FgYellow, color.Bold).Sprintf("⚠ Possible duplicates (%d):", len(candidates)))
+	for _, c := range candidates {
+		fmt.Fprintf(os.Stderr, "  %s %s %s %s\n",
+			color.New(color.FgCyan, color.Bold).Sprint(c.Identifier),
+			color.New(color.FgWhite, color.Faint).Sprintf("[%s]", c.State),
+			c.Title,
+			color.New(color.FgWhite, color.Faint).Sprintf("(%.0f%% match)", c.Similarity*100))
+	}
+}
+
+// stdinIsTerminal reports whether a user can answer a prompt on stdin
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// confirmPrompt asks a yes/no question on stderr, defaulting to no
+func confirmPrompt(question string) bool {
+	fmt.Fprintf(os.Stderr, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// checkIssueDuplicates runs the pre-create duplicate check. It returns when
+// creation should go ahead and exits with exitDuplicatesFound otherwise.
+func checkIssueDuplicates(client *api.Client, title, teamID string, strict, plaintext, jsonOut bool) {
+	candidates, err := findDuplicateCandidates(client, title, teamID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: duplicate check failed: %v\n", err)
+		return
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	if strict {
+		msg := fmt.Sprintf("Not creating issue: %d possible duplicate(s) found", len(candidates))
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"error":      msg,
+				"duplicates": candidates,
+			})
+		} else {
+			printDuplicateCandidates(candidates, plaintext)
+			output.Error(msg, plaintext, jsonOut)
+		}
+		exit(exitDuplicatesFound)
+	}
+
+	if jsonOut {
+		// Nothing can be prompted in JSON mode; note the candidates and proceed
+		for _, c := range candidates {
+			fmt.Fprintf(os.Stderr, "Warning: possible duplicate %s: %s\n", c.Identifier, c.Title)
+		}
+		return
+	}
+
+	printDuplicateCandidates(candidates, plaintext)
+	if plaintext || !stdinIsTerminal() {
+		return
+	}
+	if !confirmPrompt("Create anyway?") {
+		output.Error("Issue not created", plaintext, jsonOut)
+		exit(exitDuplicatesFound)
+	}
+}
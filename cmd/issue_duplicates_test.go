@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestRankDuplicateCandidates(t *testing.T) {
+	issues := []api.Issue{
+		{Identifier: "ENG-1", Title: "Update billing copy"},
+		{Identifier: "ENG-2", Title: "Login fails on Firefox", State: &api.State{Name: "Todo"}},
+		{Identifier: "ENG-3", Title: "Safari login fails", State: &api.State{Name: "In Progress"}},
+	}
+
+	got := rankDuplicateCandidates("Login fails on Safari", issues)
+	if len(got) != 2 {
+		t.Fatalf("got %d candidates, want 2: %+v", len(got), got)
+	}
+	if got[0].Identifier != "ENG-3" || got[0].State != "In Progress" {
+		t.Errorf("best candidate = %+v, want ENG-3 In Progress", got[0])
+	}
+	if got[1].Identifier != "ENG-2" {
+		t.Errorf("second candidate = %+v, want ENG-2", got[1])
+	}
+}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// teamIssuesConcurrency bounds the per-team queries of a multi-team issue
+// list when the API rejects a single "in" filter
+const teamIssuesConcurrency = 4
+
+// teamFlagValues returns the --team values, trimmed and without duplicates
+// (ignoring case). issue list takes several (repeated or comma-separated);
+// other commands take one.
+func teamFlagValues(cmd *cobra.Command) []string {
+	flag := cmd.Flags().Lookup("team")
+	if flag == nil {
+		return nil
+	}
+	var values []string
+	if flag.Value.Type() == "stringSlice" {
+		values, _ = cmd.Flags().GetStringSlice("team")
+	} else if v, _ := cmd.Flags().GetString("team"); v != "" {
+		values = []string{v}
+	}
+
+	var refs []string
+	seen := map[string]bool{}
+	for _, v := range values {
+		ref := strings.TrimSpace(v)
+		if ref != "" && !seen[strings.ToLower(ref)] {
+			seen[strings.ToLower(ref)] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// resolveTeamFlags resolves the --team values (UUID, key, or name) to
+// teams, in the order given and without duplicates
+func resolveTeamFlags(client *api.Client, cmd *cobra.Command) ([]api.Team, error) {
+	var teams []api.Team
+	seen := map[string]bool{}
+	for _, ref := range teamFlagValues(cmd) {
+		team, err := client.GetTeam(context.Background(), ref)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to find team '%s': %w", ref, err)
+		}
+		if !seen[team.ID] {
+			seen[team.ID] = true
+			teams = append(teams, *team)
+		}
+	}
+	return teams, nil
+}
+
+// teamKeys returns the keys of teams, uppercased, for grouping and messages
+func teamKeys(teams []api.Team) []string {
+	keys := make([]string, len(teams))
+	for i, t := range teams {
+		keys[i] = strings.ToUpper(t.Key)
+	}
+	return keys
+}
+
+// teamIDs returns the IDs of teams, for issue filters
+func teamIDs(teams []api.Team) []string {
+	ids := make([]string, len(teams))
+	for i, t := range teams {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+// fetchTeamsIssues lists issues across several teams. The filter already
+// matches them all with one team "in" clause; if the API rejects that, each
+// team is queried concurrently instead. Either way the issues come back
+// deduplicated, sorted by orderBy, and cut to limit.
+func fetchTeamsIssues(client *api.Client, filter map[string]interface{}, teams []api.Team, limit int, orderBy string, fields api.IssueFields) (*api.Issues, error) {
+	issues, err := client.GetIssuesWithFields(context.Background(), filter, limit, "", orderBy, fields)
+	if err == nil {
+		issues.Nodes = mergeTeamIssues([][]api.Issue{issues.Nodes}, orderBy)
+		return issues, nil
+	}
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || !(apiErr.StatusCode == 400 || apiErr.HasCode("INVALID_INPUT") || apiErr.HasCode("GRAPHQL_VALIDATION_FAILED")) {
+		return nil, err
+	}
+
+	lists := make([][]api.Issue, len(teams))
+	truncated := make([]bool, len(teams))
+	errs := make([]error, len(teams))
+	sem := make(chan struct{}, teamIssuesConcurrency)
+	var wg sync.WaitGroup
+	for i, team := range teams {
+		wg.Add(1)
+		go func(i int, team api.Team) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			teamFilter := make(map[string]interface{}, len(filter))
+			for k, v := range filter {
+				teamFilter[k] = v
+			}
+			teamFilter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}
+			page, err := client.GetIssuesWithFields(context.Background(), teamFilter, limit, "", orderBy, fields)
+			if err != nil {
+				errs[i] = fmt.Errorf("team %s: %w", team.Key, err)
+				return
+			}
+			lists[i] = page.Nodes
+			truncated[i] = page.PageInfo.HasNextPage
+		}(i, team)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	merged := &api.Issues{Nodes: mergeTeamIssues(lists, orderBy)}
+	for _, t := range truncated {
+		merged.PageInfo.HasNextPage = merged.PageInfo.HasNextPage || t
+	}
+	if len(merged.Nodes) > limit {
+		merged.Nodes = merged.Nodes[:limit]
+		merged.PageInfo.HasNextPage = true
+	}
+	return merged, nil
+}
+
+// mergeTeamIssues joins issue lists, dropping repeats, newest first by
+// updatedAt when orderBy says so and by createdAt otherwise
+func mergeTeamIssues(lists [][]api.Issue, orderBy string) []api.Issue {
+	merged := []api.Issue{}
+	seen := map[string]bool{}
+	for _, list := range lists {
+		for _, issue := range list {
+			if !seen[issue.ID] {
+				seen[issue.ID] = true
+				merged = append(merged, issue)
+			}
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := merged[i].CreatedAt, merged[j].CreatedAt
+		if orderBy == "updatedAt" {
+			a, b = merged[i].UpdatedAt, merged[j].UpdatedAt
+		}
+		if !a.Equal(b) {
+			return a.After(b)
+		}
+		return merged[i].Identifier < merged[j].Identifier
+	})
+	return merged
+}
+
+// teamIssueGroup is one team's section of 'issue list --group-by team'
+type teamIssueGroup struct {
+	Key    string
+	Issues []api.Issue
+}
+
+// groupIssuesByTeam splits issues by team, keeping the order the teams were
+// given in; teams with no issues still get an (empty) section
+func groupIssuesByTeam(issues []api.Issue, teams []string) []teamIssueGroup {
+	groups := make([]teamIssueGroup, 0, len(teams))
+	index := map[string]int{}
+	for _, key := range teams {
+		index[key] = len(groups)
+		groups = append(groups, teamIssueGroup{Key: key})
+	}
+	for _, issue := range issues {
+		key := ""
+		if issue.Team != nil {
+			key = strings.ToUpper(issue.Team.Key)
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, teamIssueGroup{Key: key})
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+	return groups
+}
+
+// teamCountsSummary renders per-team counts for the footer, e.g.
+// "ENG 12, OPS 3, DESIGN 0"
+func teamCountsSummary(groups []teamIssueGroup) string {
+	parts := make([]string, len(groups))
+	for i, g := range groups {
+		parts[i] = fmt.Sprintf("%s %d", g.Key, len(g.Issues))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// renderTeamIssues prints a multi-team issue list: one merged table with a
+// Team column, or a section per team with groupByTeam. JSON output stays a
+// flat array; each issue carries its team.
+func renderTeamIssues(issues []api.Issue, teams []string, groupByTeam, plaintext, jsonOut bool, descriptionLines int) {
+	if jsonOut {
+		output.JSON(issues)
+		return
+	}
+	if len(issues) == 0 {
+		output.Info("No issues found", plaintext, jsonOut)
+		return
+	}
+
+	now := time.Now()
+	groups := groupIssuesByTeam(issues, teams)
+	summary := teamCountsSummary(groups)
+
+	if plaintext {
+		fmt.Println("# Issues")
+		if groupByTeam {
+			for _, g := range groups {
+				fmt.Printf("## %s\n\n", g.Key)
+				if len(g.Issues) == 0 {
+					fmt.Printf("None\n\n")
+				}
+				for _, issue := range g.Issues {
+					printIssueItem(issue, "###", now, descriptionLines)
+				}
+			}
+		} else {
+			for _, issue := range issues {
+				printIssueItem(issue, "##", now, descriptionLines)
+			}
+		}
+		fmt.Printf("\nTotal: %d issues (%s)\n", len(issues), summary)
+		return
+	}
+
+	if groupByTeam {
+		for _, g := range groups {
+			fmt.Printf("\n%s  %s\n",
+				color.New(color.FgCyan, color.Bold).Sprint(g.Key),
+				color.New(color.Faint).Sprintf("%d issues", len(g.Issues)))
+			if len(g.Issues) == 0 {
+				fmt.Println(color.New(color.Faint).Sprint("  No issues"))
+				continue
+			}
+			output.Table(issueTable(g.Issues, now), false, false)
+		}
+	} else {
+		output.Table(issueTable(issues, now), false, false)
+	}
+
+	fmt.Printf("\n%s %d issues (%s)\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		len(issues),
+		summary)
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func TestTeamFlagValues(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.Flags().StringSliceP("team", "t", nil, "")
+	if err := cmd.ParseFlags([]string{"--team", "eng, ops", "-t", "DESIGN", "--team", "Eng"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(teamFlagValues(cmd), ","); got != "eng,ops,DESIGN" {
+		t.Errorf("teamFlagValues = %s, want eng,ops,DESIGN", got)
+	}
+
+	single := &cobra.Command{}
+	single.Flags().String("team", "", "")
+	single.ParseFlags([]string{"--team", " Engineering "})
+	if got := strings.Join(teamFlagValues(single), ","); got != "Engineering" {
+		t.Errorf("teamFlagValues(string flag) = %s, want Engineering", got)
+	}
+
+	filter, err := buildIssueFilter(issueFilterOptions{TeamIDs: []string{"t-eng", "t-ops"}, Priority: -1, IncludeCompleted: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, _ := json.Marshal(filter)
+	if want := `{"team":{"id":{"in":["t-eng","t-ops"]}}}`; string(got) != want {
+		t.Errorf("filter = %s, want %s", got, want)
+	}
+}
+
+// TestFetchTeamsIssuesFallback checks that a rejected "in" filter falls back
+// to one query per team, with the results merged and deduplicated
+func TestFetchTeamsIssuesFallback(t *testing.T) {
+	issue := func(id, team, created string) string {
+		return fmt.Sprintf(`{"id":%q,"identifier":%q,"title":"t","createdAt":%q,"updatedAt":%q,"team":{"id":"t-%s","key":%q}}`,
+			id, id, created, created, team, team)
+	}
+	var mu sync.Mutex
+	var teamsQueried []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				Filter map[string]map[string]map[string]interface{} `json:"filter"`
+			} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		id := body.Variables.Filter["team"]["id"]
+		if _, ok := id["in"]; ok {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"errors":[{"message":"Invalid filter","extensions":{"code":"GRAPHQL_VALIDATION_FAILED"}}]}`))
+			return
+		}
+		teamID, _ := id["eq"].(string)
+		team := strings.TrimPrefix(teamID, "t-")
+		mu.Lock()
+		teamsQueried = append(teamsQueried, team)
+		mu.Unlock()
+		nodes := map[string]string{
+			// ENG-1 moved teams between the two queries and shows up twice
+			"ENG": issue("ENG-1", "ENG", "2026-01-03T00:00:00Z") + "," + issue("ENG-2", "ENG", "2026-01-01T00:00:00Z"),
+			"OPS": issue("OPS-1", "OPS", "2026-01-02T00:00:00Z") + "," + issue("ENG-1", "ENG", "2026-01-03T00:00:00Z"),
+		}[team]
+		fmt.Fprintf(w, `{"data":{"issues":{"nodes":[%s],"pageInfo":{"hasNextPage":false}}}}`, nodes)
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	teams := []api.Team{{ID: "t-ENG", Key: "ENG"}, {ID: "t-OPS", Key: "OPS"}}
+	filter, _ := buildIssueFilter(issueFilterOptions{TeamIDs: teamIDs(teams), Priority: -1, IncludeCompleted: true})
+	issues, err := fetchTeamsIssues(client, filter, teams, 50, "", api.IssueFieldsTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(teamsQueried) != 2 {
+		t.Errorf("queried teams %v, want one query each for ENG and OPS", teamsQueried)
+	}
+	var ids []string
+	for _, i := range issues.Nodes {
+		ids = append(ids, i.Identifier)
+	}
+	if got := strings.Join(ids, ","); got != "ENG-1,OPS-1,ENG-2" {
+		t.Errorf("merged issues = %s, want ENG-1,OPS-1,ENG-2", got)
+	}
+
+	issues, err = fetchTeamsIssues(client, filter, teams, 2, "", api.IssueFieldsTable)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues.Nodes) != 2 || !issues.PageInfo.HasNextPage {
+		t.Errorf("limit 2 kept %d issues (hasNextPage %v), want 2 and more available", len(issues.Nodes), issues.PageInfo.HasNextPage)
+	}
+}
+
+func TestGroupIssuesByTeam(t *testing.T) {
+	issues := []api.Issue{
+		{Identifier: "OPS-1", Team: &api.Team{Key: "OPS"}},
+		{Identifier: "ENG-1", Team: &api.Team{Key: "ENG"}},
+		{Identifier: "OPS-2", Team: &api.Team{Key: "OPS"}},
+	}
+	groups := groupIssuesByTeam(issues, []string{"ENG", "OPS", "DESIGN"})
+	if got := teamCountsSummary(groups); got != "ENG 1, OPS 2, DESIGN 0" {
+		t.Errorf("teamCountsSummary = %q", got)
+	}
+	if groups[1].Issues[1].Identifier != "OPS-2" {
+		t.Errorf("OPS section = %+v, want the list order kept", groups[1].Issues)
+	}
+}
+
+// TestHermeticIssueListTeamByName checks that --team takes team names as
+// well as keys, and filters on the resolved team IDs
+func TestHermeticIssueListTeamByName(t *testing.T) {
+	s := newMockLinear(t)
+	s.LoadFixtures("testdata/linearmock/teams")
+	s.DataFor("Team", map[string]interface{}{"id": "team-eng"}, `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.DataFor("Team", map[string]interface{}{"id": "eng"}, `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.DataFor("Team", map[string]interface{}{"id": "team-ops"}, `{"team":{"id":"team-ops","key":"OPS","name":"Operations"}}`)
+	// Names aren't keys: team(id:) finds nothing and the teams are listed
+	s.DataFor("Team", map[string]interface{}{"id": "Engineering"}, `{"team":null}`)
+	s.DataFor("Team", map[string]interface{}{"id": "operations"}, `{"team":null}`)
+	s.DataFor("Team", map[string]interface{}{"id": "Enginering"}, `{"team":null}`)
+	s.Data("Issues", `{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1","team":{"id":"team-eng","key":"ENG"}}],"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "issue", "list", "--team", "Engineering", "--ids-only")
+	if r.Exit != 0 || r.Stdout != "ENG-1\n" {
+		t.Fatalf("--team Engineering exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if filter := mustJSON(t, requestsFor(s, "Issues")[0].Variables["filter"]); !strings.Contains(filter, `"team":{"id":{"eq":"team-eng"}}`) {
+		t.Errorf("--team Engineering sent filter %s", filter)
+	}
+
+	s.Reset()
+	r = runMocked(t, "issue", "list", "--team", "eng,operations", "--ids-only")
+	if r.Exit != 0 {
+		t.Fatalf("--team eng,operations exited %d: %s", r.Exit, r.Stderr)
+	}
+	if filter := mustJSON(t, requestsFor(s, "Issues")[0].Variables["filter"]); !strings.Contains(filter, `"team":{"id":{"in":["team-eng","team-ops"]}}`) {
+		t.Errorf("--team eng,operations sent filter %s", filter)
+	}
+
+	r = runMocked(t, "issue", "list", "--team", "Enginering")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "ENG") {
+		t.Errorf("unknown team exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// issueProjectRef names one side of an issue's project change
+type issueProjectRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// issueProjectMove is the project side of an issue update: where the issue
+// was, where it is going, and what had to be fixed up along the way
+type issueProjectMove struct {
+	From             *issueProjectRef `json:"from"`
+	To               *issueProjectRef `json:"to"`
+	ClearedMilestone string           `json:"clearedMilestone,omitempty"`
+	AddedTeam        string           `json:"addedTeam,omitempty"`
+
+	target *api.Project // nil when moving to no project
+}
+
+// newIssueProjectMove resolves --project for an existing issue. value is a
+// project UUID, slug, URL, or name, or "none" to remove the issue from its project.
+func newIssueProjectMove(client *api.Client, issue *api.Issue, value string, plaintext, jsonOut bool) (*issueProjectMove, error) {
+	move := &issueProjectMove{}
+	if issue.Project != nil {
+		move.From = &issueProjectRef{ID: issue.Project.ID, Name: issue.Project.Name}
+	}
+	if value == "" || strings.EqualFold(value, "none") {
+		return move, nil
+	}
+
+	resolver := newRefResolver(client)
+	projectID := resolver.project(value)
+	resolver.report(plaintext, jsonOut)
+
+	project, err := client.GetProject(context.Background(), projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	move.target = project
+	move.To = &issueProjectRef{ID: project.ID, Name: project.Name}
+	return move, nil
+}
+
+// changed reports whether the issue actually moves to a different project
+func (m *issueProjectMove) changed() bool {
+	if m.From == nil || m.To == nil {
+		return m.From != m.To
+	}
+	return m.From.ID != m.To.ID
+}
+
+// projectName returns a display name for one side of the move
+func (r *issueProjectRef) projectName() string {
+	if r == nil {
+		return "none"
+	}
+	return r.Name
+}
+
+// clearStaleMilestone drops the issue's milestone from the update unless it
+// belongs to the target project. The milestone is fetched so the check uses
+// its own project relationship.
+func (m *issueProjectMove) clearStaleMilestone(client *api.Client, issue *api.Issue, input map[string]interface{}) error {
+	if issue.ProjectMilestone == nil {
+		return nil
+	}
+	ms, err := client.GetProjectMilestone(context.Background(), issue.ProjectMilestone.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check milestone %q: %w", issue.ProjectMilestone.Name, err)
+	}
+	if m.target != nil && ms.Project != nil && ms.Project.ID == m.target.ID {
+		return nil
+	}
+
+	input["projectMilestoneId"] = nil
+	m.ClearedMilestone = ms.Name
+	owner := "another project"
+	if ms.Project != nil {
+		owner = fmt.Sprintf("project %q", ms.Project.Name)
+	}
+	fmt.Fprintf(os.Stderr, "Note: cleared milestone %q; it belongs to %s, not %s\n", ms.Name, owner, m.To.projectName())
+	return nil
+}
+
+// checkTeam warns when team is not one of the target project's teams, or with
+// addTeam adds it to the project so the issue fits there
+func (m *issueProjectMove) checkTeam(cmd *cobra.Command, client *api.Client, team *api.Team, addTeam bool) error {
+	if m.target == nil || team == nil {
+		return nil
+	}
+	teamIDs := []string{}
+	if m.target.Teams != nil {
+		for _, t := range m.target.Teams.Nodes {
+			if t.ID == team.ID {
+				return nil
+			}
+			teamIDs = append(teamIDs, t.ID)
+		}
+	}
+
+	if !addTeam {
+		fmt.Fprintf(os.Stderr, "Warning: team %s is not one of project %q's teams; pass --add-team-to-project to add it\n", team.Key, m.target.Name)
+		return nil
+	}
+
+	input := map[string]interface{}{"teamIds": append(teamIDs, team.ID)}
+	updated, err := client.UpdateProject(context.Background(), m.target.ID, input)
+	if err != nil {
+		return fmt.Errorf("failed to add team %s to project %q: %w", team.Key, m.target.Name, err)
+	}
+	recordOperation(cmd, "project", updated.ID, updated.Name, restoreInverse(m.target, input))
+	m.AddedTeam = team.Key
+	fmt.Fprintf(os.Stderr, "Note: added team %s to project %q\n", team.Key, m.target.Name)
+	return nil
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestIssueProjectMoveChanged(t *testing.T) {
+	a := &issueProjectRef{ID: "a", Name: "Alpha"}
+	b := &issueProjectRef{ID: "b", Name: "Beta"}
+	tests := []struct {
+		from, to *issueProjectRef
+		want     bool
+	}{
+		{nil, nil, false},
+		{nil, a, true},
+		{a, nil, true},
+		{a, &issueProjectRef{ID: "a", Name: "Alpha"}, false},
+		{a, b, true},
+	}
+	for _, tt := range tests {
+		m := &issueProjectMove{From: tt.from, To: tt.to}
+		if got := m.changed(); got != tt.want {
+			t.Errorf("changed(%s → %s) = %v, want %v", tt.from.projectName(), tt.to.projectName(), got, tt.want)
+		}
+	}
+}
+
+func TestClearStaleMilestone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":{"projectMilestone":{"id":"m1","name":"Beta launch","project":{"id":"p1","name":"Alpha"}}}}`))
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+	issue := &api.Issue{ProjectMilestone: &api.ProjectMilestone{ID: "m1", Name: "Beta launch"}}
+
+	tests := []struct {
+		name      string
+		target    *api.Project
+		wantClear bool
+	}{
+		{"same project keeps milestone", &api.Project{ID: "p1", Name: "Alpha"}, false},
+		{"other project clears milestone", &api.Project{ID: "p2", Name: "Beta"}, true},
+		{"no project clears milestone", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &issueProjectMove{target: tt.target}
+			if tt.target != nil {
+				m.To = &issueProjectRef{ID: tt.target.ID, Name: tt.target.Name}
+			}
+			input := map[string]interface{}{}
+			if err := m.clearStaleMilestone(client, issue, input); err != nil {
+				t.Fatal(err)
+			}
+			_, cleared := input["projectMilestoneId"]
+			if cleared != tt.wantClear {
+				t.Errorf("projectMilestoneId cleared = %v, want %v", cleared, tt.wantClear)
+			}
+			if tt.wantClear && m.ClearedMilestone != "Beta launch" {
+				t.Errorf("ClearedMilestone = %q, want %q", m.ClearedMilestone, "Beta launch")
+			}
+		})
+	}
+}
+
+func TestCheckTeamWarnsWithoutRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	m := &issueProjectMove{target: &api.Project{ID: "p1", Name: "Alpha", Teams: &api.Teams{Nodes: []api.Team{{ID: "t1", Key: "ENG"}}}}}
+	if err := m.checkTeam(nil, client, &api.Team{ID: "t1", Key: "ENG"}, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.checkTeam(nil, client, &api.Team{ID: "t2", Key: "OPS"}, false); err != nil {
+		t.Fatal(err)
+	}
+	if m.AddedTeam != "" {
+		t.Errorf("AddedTeam = %q, want none", m.AddedTeam)
+	}
+}
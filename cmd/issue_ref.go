@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// issueIdentifierPattern matches a team key and issue number, e.g. ENG-123
+var issueIdentifierPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*-[0-9]+$`)
+
+// issueNotFound is the error every issue-taking command reports for a
+// reference that doesn't name an issue
+func issueNotFound(ref string) error {
+	return fmt.Errorf("issue not found: %s", ref)
+}
+
+// parseIssueRef normalizes an issue reference without calling the API. It
+// accepts an identifier in any case (eng-123 becomes ENG-123), a UUID, or an
+// issue URL such as https://linear.app/acme/issue/ENG-123/fix-login.
+func parseIssueRef(ref string) (string, error) {
+	value := strings.TrimSpace(ref)
+	if i := strings.Index(value, "/issue/"); i >= 0 && strings.Contains(value, "linear.app/") {
+		value = value[i+len("/issue/"):]
+		if j := strings.IndexAny(value, "/?#"); j >= 0 {
+			value = value[:j]
+		}
+	}
+
+	switch {
+	case isUUID(value):
+		return strings.ToLower(value), nil
+	case issueIdentifierPattern.MatchString(value):
+		return strings.ToUpper(value), nil
+	}
+	return "", issueNotFound(ref)
+}
+
+// resolveIssueRef fetches the issue an identifier, UUID, or issue URL refers
+// to, with a single API call. Missing issues and malformed references both
+// give issueNotFound; other failures keep the API error.
+func resolveIssueRef(ctx context.Context, client *api.Client, ref string) (*api.Issue, error) {
+	return resolveIssueRefFields(ctx, client, ref, api.IssueFieldsFull)
+}
+
+// resolveIssueRefFields is resolveIssueRef fetching only the given fields
+func resolveIssueRefFields(ctx context.Context, client *api.Client, ref string, fields api.IssueFields) (*api.Issue, error) {
+	id, err := parseIssueRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := client.GetIssueWithFields(ctx, id, fields)
+	if err != nil {
+		var apiErr *api.APIError
+		if errors.As(err, &apiErr) && (apiErr.HasCode("ENTITY_NOT_FOUND") || apiErr.HasCode("INVALID_INPUT")) {
+			return nil, issueNotFound(ref)
+		}
+		return nil, fmt.Errorf("failed to fetch issue %s: %w", ref, err)
+	}
+	return issue, nil
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"ENG-123", "ENG-123"},
+		{"rob-123", "ROB-123"},
+		{" Eng-7 ", "ENG-7"},
+		{"A1_B-42", "A1_B-42"},
+		{"5B3C7B2E-1F0A-4D6C-9E8B-0A1B2C3D4E5F", "5b3c7b2e-1f0a-4d6c-9e8b-0a1b2c3d4e5f"},
+		{"5b3c7b2e-1f0a-4d6c-9e8b-0a1b2c3d4e5f", "5b3c7b2e-1f0a-4d6c-9e8b-0a1b2c3d4e5f"},
+		{"https://linear.app/acme/issue/ENG-123/fix-login", "ENG-123"},
+		{"https://linear.app/acme/issue/eng-123", "ENG-123"},
+		{"linear.app/acme/issue/ENG-123/fix-login?foo=bar", "ENG-123"},
+		{"https://linear.app/acme/issue/ENG-123#comment-1", "ENG-123"},
+	}
+	for _, tt := range tests {
+		got, err := parseIssueRef(tt.ref)
+		if err != nil {
+			t.Errorf("parseIssueRef(%q) error: %v", tt.ref, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseIssueRef(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestParseIssueRefInvalid(t *testing.T) {
+	for _, ref := range []string{
+		"",
+		"123",
+		"ENG",
+		"ENG-",
+		"-123",
+		"ENG-12a",
+		"fix the login bug",
+		"https://linear.app/acme/project/alpha-1234",
+		"https://example.com/issue/ENG-123",
+	} {
+		_, err := parseIssueRef(ref)
+		if err == nil {
+			t.Errorf("parseIssueRef(%q): expected error", ref)
+			continue
+		}
+		if want := "issue not found: " + ref; err.Error() != want {
+			t.Errorf("parseIssueRef(%q) error = %q, want %q", ref, err, want)
+		}
+	}
+}
+
+func TestResolveIssueRef(t *testing.T) {
+	var requests int
+	var gotID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		gotID, _ = body.Variables["id"].(string)
+		if gotID == "ENG-404" {
+			w.Write([]byte(`{"data":null,"errors":[{"message":"Entity not found: Issue","extensions":{"code":"INPUT_ERROR","userError":true}}]}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"issue":{"id":"i1","identifier":"ENG-123","title":"Fix login"}}}`))
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	issue, err := resolveIssueRef(context.Background(), client, "https://linear.app/acme/issue/eng-123/fix-login")
+	if err != nil {
+		t.Fatalf("resolveIssueRef: %v", err)
+	}
+	if issue.ID != "i1" || gotID != "ENG-123" {
+		t.Errorf("got issue %q fetched as %q, want i1 fetched as ENG-123", issue.ID, gotID)
+	}
+	if requests != 1 {
+		t.Errorf("made %d requests, want 1", requests)
+	}
+
+	requests = 0
+	_, err = resolveIssueRef(context.Background(), client, "eng-404")
+	if err == nil || err.Error() != "issue not found: eng-404" {
+		t.Errorf("missing issue: got %v, want issue not found: eng-404", err)
+	}
+	if requests != 1 {
+		t.Errorf("missing issue made %d requests, want 1", requests)
+	}
+
+	requests = 0
+	_, err = resolveIssueRef(context.Background(), client, "not an issue")
+	if err == nil || !strings.HasPrefix(err.Error(), "issue not found:") {
+		t.Errorf("malformed ref: got %v, want issue not found", err)
+	}
+	if requests != 0 {
+		t.Errorf("malformed ref made %d requests, want 0", requests)
+	}
+}
@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+// unresolvedRef is a flag value that could not be resolved to a Linear entity
+type unresolvedRef struct {
+	Flag        string   `json:"flag"`
+	Value       string   `json:"value"`
+	Reason      string   `json:"reason"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// refResolver resolves flag values (team, users, labels, ...) to IDs and
+// collects every failure, so all typos can be reported in one go before any
+// mutation is attempted.
+type refResolver struct {
+	client     *api.Client
+	unresolved []unresolvedRef
+	users      *userLookup
+}
+
+func newRefResolver(client *api.Client) *refResolver {
+	return &refResolver{client: client, users: newUserLookup(client)}
+}
+
+func (r *refResolver) fail(flag, value, reason string, candidates []string) {
+	r.unresolved = append(r.unresolved, unresolvedRef{
+		Flag:        flag,
+		Value:       value,
+		Reason:      reason,
+		Suggestions: utils.Suggest(value, candidates, utils.MaxSuggestions),
+	})
+}
+
+// team resolves a team key, ID, or name
+func (r *refResolver) team(value string) *api.Team {
+	team, err := r.client.GetTeam(context.Background(), value)
+	if err == nil {
+		return team
+	}
+
+	// GetTeam already tried UUID, key, and name, and suggests the closest teams
+	var lookupErr *api.TeamLookupError
+	if errors.As(err, &lookupErr) && !lookupErr.Ambiguous {
+		r.unresolved = append(r.unresolved, unresolvedRef{
+			Flag:        "team",
+			Value:       value,
+			Reason:      "team not found",
+			Suggestions: lookupErr.Suggestions,
+		})
+		return nil
+	}
+	r.fail("team", value, err.Error(), nil)
+	return nil
+}
+
+// user resolves me, an email, or a display name to a user ID
+func (r *refResolver) user(flag, value string) string {
+	if strings.EqualFold(value, "me") {
+		viewer, err := r.client.GetViewer(context.Background())
+		if err != nil {
+			r.fail(flag, value, fmt.Sprintf("failed to get current user: %v", err), nil)
+			return ""
+		}
+		return viewer.ID
+	}
+
+	user, err := r.users.find(value)
+	var notFound *utils.NotFoundError
+	if errors.As(err, &notFound) {
+		r.unresolved = append(r.unresolved, unresolvedRef{
+			Flag:        flag,
+			Value:       value,
+			Reason:      "user not found",
+			Suggestions: notFound.Suggestions,
+		})
+		return ""
+	}
+	if err != nil {
+		r.fail(flag, value, err.Error(), nil)
+		return ""
+	}
+	return user.ID
+}
+
+// labels resolves label names (case-insensitive) to IDs
+func (r *refResolver) labels(names []string) []string {
+	all, err := r.client.GetLabels(context.Background(), nil, 250, "")
+	if err != nil {
+		for _, name := range names {
+			r.fail("label", name, fmt.Sprintf("failed to fetch labels: %v", err), nil)
+		}
+		return nil
+	}
+
+	var candidates []string
+	for _, label := range all.Nodes {
+		candidates = append(candidates, label.Name)
+	}
+
+	var ids []string
+	for _, name := range names {
+		found := false
+		for _, label := range all.Nodes {
+			if strings.EqualFold(label.Name, name) {
+				ids = append(ids, label.ID)
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.fail("label", name, "label not found", candidates)
+		}
+	}
+	return ids
+}
+
+// project resolves a project ID, slug, URL, or name
+func (r *refResolver) project(value string) string {
+	for _, key := range projectLookupKeys(value) {
+		if project, err := r.client.GetProject(context.Background(), key); err == nil {
+			return project.ID
+		}
+	}
+
+	projects, err := r.client.GetProjects(context.Background(), nil, 250, "", "")
+	if err != nil {
+		r.fail("project", value, fmt.Sprintf("failed to list projects: %v", err), nil)
+		return ""
+	}
+	var candidates []string
+	for _, p := range projects.Nodes {
+		if strings.EqualFold(p.Name, value) {
+			return p.ID
+		}
+		candidates = append(candidates, p.Name)
+	}
+	r.fail("project", value, "project not found", candidates)
+	return ""
+}
+
+// issue resolves an issue identifier or ID (used for --parent)
+func (r *refResolver) issue(flag, value string) string {
+	issue, err := resolveIssueRef(context.Background(), r.client, value)
+	if err != nil {
+		r.fail(flag, value, err.Error(), nil)
+		return ""
+	}
+	return issue.ID
+}
+
+// cycle resolves a cycle ID, number, or name within a team
+func (r *refResolver) cycle(value string, team *api.Team) string {
+	if cycle, err := r.client.GetCycle(context.Background(), value); err == nil {
+		return cycle.ID
+	}
+	if team == nil {
+		r.fail("cycle", value, "cycle not found (fix --team to look it up by number or name)", nil)
+		return ""
+	}
+
+	filter := map[string]interface{}{"team": map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}}
+	cycles, err := r.client.GetCycles(context.Background(), filter, 100, "")
+	if err != nil {
+		r.fail("cycle", value, fmt.Sprintf("failed to list cycles: %v", err), nil)
+		return ""
+	}
+	number, numErr := strconv.Atoi(strings.TrimPrefix(value, "#"))
+	var candidates []string
+	for _, c := range cycles.Nodes {
+		if (numErr == nil && c.Number == number) || (c.Name != "" && strings.EqualFold(c.Name, value)) {
+			return c.ID
+		}
+		if c.Name != "" {
+			candidates = append(candidates, c.Name)
+		}
+		candidates = append(candidates, strconv.Itoa(c.Number))
+	}
+	r.fail("cycle", value, fmt.Sprintf("cycle not found in team %s", team.Key), candidates)
+	return ""
+}
+
+// milestone resolves a milestone ID or name within a project
+func (r *refResolver) milestone(projectID, value string) string {
+	if strings.Contains(value, "-") && len(value) > 20 {
+		return value
+	}
+	milestones, err := r.client.GetProjectMilestones(context.Background(), projectID, 50, "")
+	if err != nil {
+		r.fail("milestone", value, fmt.Sprintf("failed to list project milestones: %v", err), nil)
+		return ""
+	}
+	var candidates []string
+	for _, ms := range milestones.Nodes {
+		if strings.EqualFold(ms.Name, value) {
+			return ms.ID
+		}
+		candidates = append(candidates, ms.Name)
+	}
+	r.fail("milestone", value, "milestone not found in project", candidates)
+	return ""
+}
+
+// state resolves a workflow state name within a team
+func (r *refResolver) state(team *api.Team, name string) string {
+	states, err := r.client.GetTeamStates(context.Background(), team.Key)
+	if err != nil {
+		r.fail("state", name, fmt.Sprintf("failed to get team states: %v", err), nil)
+		return ""
+	}
+	var candidates []string
+	for _, s := range states {
+		if strings.EqualFold(s.Name, name) {
+			return s.ID
+		}
+		candidates = append(candidates, s.Name)
+	}
+	r.fail("state", name, fmt.Sprintf("state not found in team %s", team.Key), candidates)
+	return ""
+}
+
+// report prints every unresolved reference and exits when there are any
+func (r *refResolver) report(plaintext, jsonOut bool) {
+	if len(r.unresolved) == 0 {
+		return
+	}
+
+	summary := fmt.Sprintf("%d reference(s) could not be resolved", len(r.unresolved))
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"error":      summary,
+			"unresolved": r.unresolved,
+		})
+		exit(1)
+	}
+
+	lines := []string{summary + ":"}
+	for _, u := range r.unresolved {
+		line := fmt.Sprintf("  --%s %q: %s", u.Flag, u.Value, u.Reason)
+		if len(u.Suggestions) > 0 {
+			line += fmt.Sprintf(" (did you mean: %s?)", strings.Join(u.Suggestions, ", "))
+		}
+		lines = append(lines, line)
+	}
+	output.Error(strings.Join(lines, "\n"), plaintext, jsonOut)
+	exit(1)
+}
+
+// projectLookupKeys returns the IDs to try for a project reference. A project
+// URL (https://linear.app/ws/project/name-slugid/...) yields its path segment
+// and the slug ID at its end; anything else is tried as given.
+func projectLookupKeys(value string) []string {
+	i := strings.Index(value, "/project/")
+	if i < 0 {
+		return []string{value}
+	}
+	segment := value[i+len("/project/"):]
+	if j := strings.IndexAny(segment, "/?#"); j >= 0 {
+		segment = segment[:j]
+	}
+	keys := []string{segment}
+	if j := strings.LastIndex(segment, "-"); j >= 0 && j < len(segment)-1 {
+		keys = append(keys, segment[j+1:])
+	}
+	return keys
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRefResolverCollectsFailures(t *testing.T) {
+	r := newRefResolver(nil)
+	r.fail("label", "Bgu", "label not found", []string{"Bug", "Feature", "Docs"})
+	r.fail("assignee", "alice@exmaple.com", "user not found", []string{"alice@example.com", "Alice", "bob@example.com"})
+	r.fail("parent", "ENG-999", "issue not found", nil)
+
+	got, _ := json.Marshal(r.unresolved)
+	want := `[{"flag":"label","value":"Bgu","reason":"label not found","suggestions":["Bug"]},` +
+		`{"flag":"assignee","value":"alice@exmaple.com","reason":"user not found","suggestions":["alice@example.com","Alice"]},` +
+		`{"flag":"parent","value":"ENG-999","reason":"issue not found"}]`
+	if string(got) != want {
+		t.Errorf("unresolved mismatch\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestProjectLookupKeys(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Q3 Roadmap", []string{"Q3 Roadmap"}},
+		{"0a1b2c3d4e5f", []string{"0a1b2c3d4e5f"}},
+		{"https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f", []string{"q3-roadmap-0a1b2c3d4e5f", "0a1b2c3d4e5f"}},
+		{"https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f/overview?tab=issues", []string{"q3-roadmap-0a1b2c3d4e5f", "0a1b2c3d4e5f"}},
+	}
+	for _, tt := range tests {
+		got, _ := json.Marshal(projectLookupKeys(tt.in))
+		want, _ := json.Marshal(tt.want)
+		if string(got) != string(want) {
+			t.Errorf("projectLookupKeys(%q) = %s, want %s", tt.in, got, want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// sampleCandidateCap is the most matching issues --sample fetches to pick
+// from, so a broad filter can't page through a whole workspace
+const sampleCandidateCap = 5000
+
+// issueSample is what --sample, --shuffle and --seed asked issue list for
+type issueSample struct {
+	Size    int
+	Shuffle bool
+	Seed    int64
+}
+
+// addSampleFlags registers --sample, --shuffle and --seed on a list command
+func addSampleFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("sample", 0, fmt.Sprintf("Print N issues picked at random from every match (up to %d), ignoring --limit", sampleCandidateCap))
+	cmd.Flags().Bool("shuffle", false, "Print the fetched issues in random order")
+	cmd.Flags().Int64("seed", 0, "Random seed for --sample and --shuffle, for a repeatable pick (default: random)")
+	cmd.MarkFlagsMutuallyExclusive("sample", "count")
+	cmd.MarkFlagsMutuallyExclusive("shuffle", "count")
+	cmd.MarkFlagsMutuallyExclusive("shuffle", "sort")
+}
+
+// issueSampleFromFlags reads --sample, --shuffle and --seed. Without --seed
+// the seed comes from the clock, and is reported so a pick can be repeated.
+func issueSampleFromFlags(cmd *cobra.Command) (issueSample, error) {
+	size, _ := cmd.Flags().GetInt("sample")
+	shuffle, _ := cmd.Flags().GetBool("shuffle")
+	seed, _ := cmd.Flags().GetInt64("seed")
+	s := issueSample{Size: size, Shuffle: shuffle, Seed: seed}
+	if cmd.Flags().Changed("sample") && size < 1 {
+		return s, fmt.Errorf("invalid --sample value %d: must be at least 1", size)
+	}
+	if cmd.Flags().Changed("seed") && !s.enabled() {
+		return s, errors.New("--seed needs --sample or --shuffle")
+	}
+	if !cmd.Flags().Changed("seed") {
+		s.Seed = time.Now().UnixNano()
+	}
+	return s, nil
+}
+
+func (s issueSample) enabled() bool {
+	return s.Size > 0 || s.Shuffle
+}
+
+// apply picks the sample from issues, or shuffles them. Every issue is
+// equally likely to be picked, and the picked issues come out in random
+// order.
+func (s issueSample) apply(issues []api.Issue) []api.Issue {
+	if !s.enabled() {
+		return issues
+	}
+	rng := rand.New(rand.NewSource(s.Seed))
+	picked := append([]api.Issue(nil), issues...)
+	n := len(picked)
+	if s.Size > 0 && s.Size < n {
+		n = s.Size
+	}
+	// A partial Fisher-Yates shuffle: the first n slots end up a uniform pick
+	for i := 0; i < n; i++ {
+		j := i + rng.Intn(len(picked)-i)
+		picked[i], picked[j] = picked[j], picked[i]
+	}
+	return picked[:n]
+}
+
+// printFooter says how many issues the sample was picked from, so it isn't
+// mistaken for the complete list. It goes to stderr when stdout is JSON or
+// bare identifiers.
+func (s issueSample) printFooter(picked, matched int, capped, plaintext, toStderr bool) {
+	if s.Size == 0 {
+		return
+	}
+	of := fmt.Sprintf("%d matching issues", matched)
+	if capped {
+		of = fmt.Sprintf("the first %d matching issues", matched)
+	}
+	line := fmt.Sprintf("sampled %d of %s (seed %d)", picked, of, s.Seed)
+	switch {
+	case toStderr:
+		fmt.Fprintln(os.Stderr, line)
+	case plaintext:
+		fmt.Println(line)
+	default:
+		fmt.Printf("%s %s\n", color.New(color.FgYellow).Sprint("⚄"), line)
+	}
+}
+
+// fetchIssuePagesUpTo follows a paginated issue connection until it ends or
+// at least max issues were fetched; max 0 means no limit. When it stops
+// early, the result's PageInfo still says there's a next page.
+func fetchIssuePagesUpTo(fetch func(after string) (*api.Issues, error), max int) (*api.Issues, error) {
+	all := &api.Issues{}
+	pageInfo, err := fetchIssuePagesEach(fetch, max, func(page []api.Issue) error {
+		all.Nodes = append(all.Nodes, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	all.PageInfo = pageInfo
+	return all, nil
+}
+
+// fetchIssuePagesEach is fetchIssuePagesUpTo handing each page to onPage as
+// it arrives instead of collecting them, so output can start before the last
+// page. An error from onPage stops the fetch. It returns the last PageInfo.
+func fetchIssuePagesEach(fetch func(after string) (*api.Issues, error), max int, onPage func(page []api.Issue) error) (api.PageInfo, error) {
+	fetched := 0
+	after := ""
+	for {
+		page, err := fetch(after)
+		if err != nil {
+			return api.PageInfo{}, err
+		}
+		pageInfo := page.PageInfo
+		last := !pageInfo.HasNextPage || pageInfo.EndCursor == ""
+		if last {
+			pageInfo.HasNextPage = false
+		}
+		nodes := page.Nodes
+		if max > 0 && !last && fetched+len(nodes) >= max {
+			// More match, so this is the last page wanted
+			nodes = nodes[:max-fetched]
+			last = true
+		}
+		fetched += len(nodes)
+		if err := onPage(nodes); err != nil {
+			return api.PageInfo{}, err
+		}
+		if last {
+			return pageInfo, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
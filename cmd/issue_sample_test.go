@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestIssueSampleApply(t *testing.T) {
+	issues := make([]api.Issue, 10)
+	for i := range issues {
+		issues[i].Identifier = fmt.Sprintf("ENG-%d", i)
+	}
+	ids := func(issues []api.Issue) string {
+		var out []string
+		for _, issue := range issues {
+			out = append(out, issue.Identifier)
+		}
+		return strings.Join(out, ",")
+	}
+
+	picked := issueSample{Size: 3, Seed: 7}.apply(issues)
+	if len(picked) != 3 || ids(picked) != ids(issueSample{Size: 3, Seed: 7}.apply(issues)) {
+		t.Errorf("same seed picked %s, then something else", ids(picked))
+	}
+	if ids(issues) != "ENG-0,ENG-1,ENG-2,ENG-3,ENG-4,ENG-5,ENG-6,ENG-7,ENG-8,ENG-9" {
+		t.Errorf("apply reordered its input: %s", ids(issues))
+	}
+	if got := (issueSample{Size: 20, Seed: 1}).apply(issues); len(got) != 10 {
+		t.Errorf("oversized sample = %d issues", len(got))
+	}
+
+	shuffled := issueSample{Shuffle: true, Seed: 3}.apply(issues)
+	sorted := strings.Split(ids(shuffled), ",")
+	sort.Strings(sorted)
+	if len(shuffled) != 10 || strings.Join(sorted, ",") != ids(issues) {
+		t.Errorf("shuffle = %s", ids(shuffled))
+	}
+
+	// Every issue is about as likely to be picked
+	seen := map[string]int{}
+	for seed := int64(0); seed < 2000; seed++ {
+		for _, issue := range (issueSample{Size: 2, Seed: seed}).apply(issues) {
+			seen[issue.Identifier]++
+		}
+	}
+	for _, issue := range issues {
+		if n := seen[issue.Identifier]; n < 300 || n > 500 {
+			t.Errorf("%s picked %d times in 2000 samples of 2, want about 400", issue.Identifier, n)
+		}
+	}
+}
+
+func TestHermeticIssueListSample(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Issues", `{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1"},{"id":"i2","identifier":"ENG-2"}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	s.DataFor("Issues", map[string]interface{}{"after": "c1"}, `{"issues":{"nodes":[{"id":"i3","identifier":"ENG-3"}],
+		"pageInfo":{"hasNextPage":false}}}`)
+
+	// The sample is drawn from every page, whatever --limit says
+	r := runMocked(t, "issue", "list", "--team", "ENG", "--sample", "2", "--seed", "42", "--limit", "1", "--ids-only")
+	if r.Exit != 0 || len(strings.Fields(r.Stdout)) != 2 {
+		t.Fatalf("--sample exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if !strings.Contains(r.Stderr, "sampled 2 of 3 matching issues (seed 42)") {
+		t.Errorf("footer missing from stderr: %q", r.Stderr)
+	}
+	issueReqs := requestsFor(s, "Issues")
+	if got := len(issueReqs); got != 2 {
+		t.Errorf("--sample made %d issue requests, want 2", got)
+	}
+	if filter := mustJSON(t, issueReqs[0].Variables["filter"]); !strings.Contains(filter, `"team-eng"`) {
+		t.Errorf("--sample dropped the team filter: %s", filter)
+	}
+	again := runMocked(t, "issue", "list", "--team", "ENG", "--sample", "2", "--seed", "42", "--ids-only")
+	if again.Stdout != r.Stdout {
+		t.Errorf("same seed printed %q, then %q", r.Stdout, again.Stdout)
+	}
+
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--sample", "5", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "Total: 3 issues\nsampled 3 of 3 matching issues (seed ") {
+		t.Errorf("plaintext exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	// --shuffle keeps to the fetched page
+	s.Reset()
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--shuffle", "--seed", "1", "--ids-only", "--limit", "2")
+	if r.Exit != 0 || len(strings.Fields(r.Stdout)) != 2 || len(requestsFor(s, "Issues")) != 1 || strings.Contains(r.Stderr, "sampled") {
+		t.Errorf("--shuffle exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	for _, args := range [][]string{
+		{"issue", "list", "--sample", "0"},
+		{"issue", "list", "--sample", "2", "--count"},
+		{"issue", "list", "--shuffle", "--sort", "priority"},
+		{"issue", "list", "--seed", "3"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+}
+
+func TestFetchIssuePagesUpTo(t *testing.T) {
+	calls := 0
+	fetch := func(after string) (*api.Issues, error) {
+		calls++
+		page := &api.Issues{Nodes: make([]api.Issue, 3)}
+		page.PageInfo.HasNextPage = true
+		page.PageInfo.EndCursor = fmt.Sprintf("c%d", calls)
+		return page, nil
+	}
+	issues, err := fetchIssuePagesUpTo(fetch, 5)
+	if err != nil || len(issues.Nodes) != 5 || calls != 2 || !issues.PageInfo.HasNextPage {
+		t.Errorf("got %d issues in %d calls (more: %v), %v", len(issues.Nodes), calls, issues.PageInfo.HasNextPage, err)
+	}
+}
@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var issueSnoozeCmd = &cobra.Command{
+	Use:   "snooze ISSUE-ID",
+	Short: "Snooze an issue until a later time",
+	Long: `Snooze an issue, hiding it from triage and from 'issue list' until the
+given time. Give either --until (a date, date and time, or 'tomorrow') or --for
+(a duration such as 3d, 2w, or 4h). Dates without a time mean 9am local time.
+
+Examples:
+  linear-cli issue snooze ROB-123 --until 2024-07-01
+  linear-cli issue snooze ROB-123 --until 2024-07-01T14:00
+  linear-cli issue snooze ROB-123 --for 3d`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		now := time.Now()
+		var until time.Time
+		var err error
+		if cmd.Flags().Changed("for") {
+			forVal, _ := cmd.Flags().GetString("for")
+			until, err = utils.AddRelative(now, forVal)
+		} else {
+			untilVal, _ := cmd.Flags().GetString("until")
+			until, err = utils.ParseFutureTime(untilVal, now)
+		}
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if !until.After(now) {
+			output.Error(fmt.Sprintf("Snooze time %s is in the past", output.FormatTime(until, output.DateTime)), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		input := map[string]interface{}{
+			"snoozedUntilAt": until.UTC().Format(time.RFC3339),
+		}
+		issue := updateIssueSnooze(cmd, client, args[0], input, "snooze", plaintext, jsonOut)
+
+		if jsonOut {
+			output.JSON(issue)
+		} else if plaintext {
+			fmt.Printf("Snoozed %s until %s\n", issue.Identifier, output.FormatTime(until, output.DateTime))
+		} else {
+			fmt.Printf("%s Snoozed %s until %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				color.New(color.FgYellow).Sprint(output.FormatTime(until, output.DateTime)))
+		}
+	},
+}
+
+var issueUnsnoozeCmd = &cobra.Command{
+	Use:   "unsnooze ISSUE-ID",
+	Short: "Clear an issue's snooze",
+	Long: `Clear an issue's snooze so it shows up in triage and 'issue list' again.
+
+Examples:
+  linear-cli issue unsnooze ROB-123`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		input := map[string]interface{}{"snoozedUntilAt": nil}
+		issue := updateIssueSnooze(cmd, client, args[0], input, "unsnooze", plaintext, jsonOut)
+
+		if jsonOut {
+			output.JSON(issue)
+		} else if plaintext {
+			fmt.Printf("Unsnoozed %s\n", issue.Identifier)
+		} else {
+			fmt.Printf("%s Unsnoozed %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier))
+		}
+	},
+}
+
+// updateIssueSnooze applies a snooze change, recording it so it can be undone
+func updateIssueSnooze(cmd *cobra.Command, client *api.Client, ref string, input map[string]interface{}, action string, plaintext, jsonOut bool) *api.Issue {
+	before, err := resolveIssueRef(context.Background(), client, ref)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		exit(1)
+	}
+
+	issue, err := client.UpdateIssue(context.Background(), before.ID, input)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to %s issue: %v", action, err), plaintext, jsonOut)
+		exit(1)
+	}
+	recordOperation(cmd, "issue", issue.ID, issue.Identifier, issueUpdateInverse(before, input))
+	return issue
+}
+
+var issueRemindCmd = &cobra.Command{
+	Use:   "remind ISSUE-ID",
+	Short: "Set a reminder notification for an issue",
+	Long: `Schedule a reminder: at the given time Linear sends an inbox notification
+about the issue. --at takes a date (9am local), a local date and time
+(YYYY-MM-DDTHH:MM), 'tomorrow', or a duration from now such as 2h or 3d.
+
+Linear delivers reminders to the authenticated user only, so --user accepts
+'me' (the default) or your own email or name.
+
+Examples:
+  linear-cli issue remind ROB-123 --at 2024-07-01T09:00
+  linear-cli issue remind ROB-123 --at 3d --user me`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		now := time.Now()
+		atVal, _ := cmd.Flags().GetString("at")
+		at, err := utils.ParseFutureTime(atVal, now)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if !at.After(now) {
+			output.Error(fmt.Sprintf("Reminder time %s is in the past", output.FormatTime(at, output.DateTime)), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		if user, _ := cmd.Flags().GetString("user"); user != "me" {
+			viewer, err := client.GetViewer(context.Background())
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			if !strings.EqualFold(user, viewer.Email) && !strings.EqualFold(user, viewer.Name) && user != viewer.ID {
+				output.Error(fmt.Sprintf("Linear only sends reminders to the authenticated user (%s); cannot remind '%s'", viewer.Email, user), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		issueID, err := parseIssueRef(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		issue, err := client.CreateIssueReminder(context.Background(), issueID, at)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to set reminder: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"issue":      issue,
+				"reminderAt": at.UTC().Format(time.RFC3339),
+			})
+		} else if plaintext {
+			fmt.Printf("Reminder set for %s at %s\n", issue.Identifier, output.FormatTime(at, output.DateTime))
+		} else {
+			fmt.Printf("%s Reminder set for %s at %s\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
+				color.New(color.FgYellow).Sprint(output.FormatTime(at, output.DateTime)))
+		}
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueSnoozeCmd)
+	issueCmd.AddCommand(issueUnsnoozeCmd)
+	issueCmd.AddCommand(issueRemindCmd)
+
+	issueSnoozeCmd.Flags().String("until", "", "Snooze until a date (YYYY-MM-DD), date and time (YYYY-MM-DDTHH:MM), or 'tomorrow'")
+	issueSnoozeCmd.Flags().String("for", "", "Snooze for a duration, e.g. 3d, 2w, 4h")
+	issueSnoozeCmd.MarkFlagsOneRequired("until", "for")
+	issueSnoozeCmd.MarkFlagsMutuallyExclusive("until", "for")
+
+	issueRemindCmd.Flags().String("at", "", "When to send the reminder: YYYY-MM-DD, YYYY-MM-DDTHH:MM, 'tomorrow', or a duration like 2h (required)")
+	issueRemindCmd.Flags().String("user", "me", "Who to remind; Linear only supports the authenticated user")
+	_ = issueRemindCmd.MarkFlagRequired("at")
+}
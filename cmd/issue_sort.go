@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// issueSortKey is a --sort value of the issue lists that is applied after
+// fetching, because the API only orders by creation or update time
+type issueSortKey struct {
+	Name    string
+	Desc    bool // default direction
+	Meaning string
+	// compare orders two issues ascending; missing reports issues without
+	// a value, which sort last in either direction
+	compare func(a, b *api.Issue) int
+	missing func(i *api.Issue) bool
+}
+
+// issueSortKeys lists the client-side sort keys, in the order help shows them
+var issueSortKeys = []issueSortKey{
+	{
+		Name: "priority", Desc: true, Meaning: "Urgent first; no priority counts as lowest",
+		compare: func(a, b *api.Issue) int { return comparePriority(a.Priority, b.Priority) },
+	},
+	{
+		Name: "estimate", Desc: true, Meaning: "largest estimate first; unestimated last",
+		compare: func(a, b *api.Issue) int { return cmp.Compare(*a.Estimate, *b.Estimate) },
+		missing: func(i *api.Issue) bool { return i.Estimate == nil },
+	},
+	{
+		Name: "due", Meaning: "soonest due date first; no due date last",
+		compare: func(a, b *api.Issue) int { return compareDates(a.DueDate, b.DueDate) },
+		missing: func(i *api.Issue) bool { return dateMissing(i.DueDate) },
+	},
+	{
+		Name: "title", Meaning: "A to Z, ignoring case",
+		compare: func(a, b *api.Issue) int { return compareFold(a.Title, b.Title) },
+	},
+	{
+		Name: "state", Meaning: "workflow order: triage, backlog, unstarted, started, completed, canceled",
+		compare: func(a, b *api.Issue) int {
+			if c := cmp.Compare(stateTypeOrder[a.State.Type], stateTypeOrder[b.State.Type]); c != 0 {
+				return c
+			}
+			return strings.Compare(a.State.Name, b.State.Name)
+		},
+		missing: func(i *api.Issue) bool { return i.State == nil },
+	},
+}
+
+// issueTimeSortKeys order by the API's own orderBy values, newest first, so
+// --asc can reverse them after fetching
+var issueTimeSortKeys = map[string]issueSortKey{
+	"createdAt": {
+		Name: "created", Desc: true,
+		compare: func(a, b *api.Issue) int { return a.CreatedAt.Compare(b.CreatedAt) },
+	},
+	"updatedAt": {
+		Name: "updated", Desc: true,
+		compare: func(a, b *api.Issue) int { return a.UpdatedAt.Compare(b.UpdatedAt) },
+	},
+}
+
+// priorityRank orders priorities from lowest to highest: None, Low, Normal,
+// High, Urgent
+func priorityRank(p int) int {
+	if p <= 0 {
+		return 0
+	}
+	return 5 - p
+}
+
+// issueSort is a parsed --sort with its direction
+type issueSort struct {
+	OrderBy string        // API orderBy to fetch with; empty for Linear's default
+	key     *issueSortKey // nil when the fetched order stands
+	desc    bool
+}
+
+// parseIssueSort resolves --sort with --asc/--desc. created and updated are
+// fetched in the API's order; the other keys sort the fetched issues.
+func parseIssueSort(sortBy string, asc, desc bool) (issueSort, error) {
+	for i := range issueSortKeys {
+		if sortBy == issueSortKeys[i].Name {
+			key := issueSortKeys[i]
+			return issueSort{key: &key, desc: (key.Desc || desc) && !asc}, nil
+		}
+	}
+
+	orderBy, err := sortOrderBy(sortBy)
+	if err != nil {
+		return issueSort{}, fmt.Errorf("Invalid sort option: %s. Valid options are: %s", sortBy, strings.Join(issueSortNames(), ", "))
+	}
+	if orderBy == "" {
+		if asc || desc {
+			return issueSort{}, fmt.Errorf("--asc and --desc need a --sort key other than linear")
+		}
+		return issueSort{}, nil
+	}
+	if !asc {
+		return issueSort{OrderBy: orderBy}, nil
+	}
+	key := issueTimeSortKeys[orderBy]
+	return issueSort{OrderBy: orderBy, key: &key}, nil
+}
+
+// issueSortFromFlags reads --sort, --asc, and --desc
+func issueSortFromFlags(cmd *cobra.Command) (issueSort, error) {
+	sortBy, _ := cmd.Flags().GetString("sort")
+	asc, _ := cmd.Flags().GetBool("asc")
+	desc, _ := cmd.Flags().GetBool("desc")
+	return parseIssueSort(sortBy, asc, desc)
+}
+
+// issueSortNames lists every --sort value the issue lists accept
+func issueSortNames() []string {
+	var names []string
+	for _, opt := range sortOptions {
+		names = append(names, opt.Name)
+	}
+	for _, key := range issueSortKeys {
+		names = append(names, key.Name)
+	}
+	return names
+}
+
+// withoutOrderBy is the sort for a connection that takes no orderBy, such
+// as a project's or a view's issues: created and updated sort after fetching
+// like the other keys
+func (s issueSort) withoutOrderBy() issueSort {
+	if s.key == nil && s.OrderBy != "" {
+		key := issueTimeSortKeys[s.OrderBy]
+		s.key = &key
+		s.desc = true
+	}
+	s.OrderBy = ""
+	return s
+}
+
+// apply sorts issues in place. Ties keep their fetched order, and issues
+// without a value for the key go last.
+func (s issueSort) apply(issues []api.Issue) {
+	if s.key == nil {
+		return
+	}
+	key := s.key
+	var missing func(i int) bool
+	if key.missing != nil {
+		missing = func(i int) bool { return key.missing(&issues[i]) }
+	}
+	sortStableBy(issues, s.desc, func(i, j int) int { return key.compare(&issues[i], &issues[j]) }, missing, nil)
+}
+
+// warnSortedWindow notes on stderr that a client-side sort only ordered the
+// fetched page when more issues matched
+func (s issueSort) warnSortedWindow(pageInfo api.PageInfo, shown int) {
+	if s.key == nil || !pageInfo.HasNextPage {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Note: sorted the %d fetched issues by %s; more match, pass --all to sort every one\n", shown, s.key.Name)
+}
+
+// addIssueSortFlags registers --sort, --asc, --desc, and --all on an issue
+// list command
+func addIssueSortFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("sort", "o", "linear", "Sort order: "+strings.Join(issueSortNames(), ", ")+" (all but linear, created, and updated sort the fetched issues)")
+	cmd.Flags().Bool("asc", false, "Sort ascending (e.g. --sort priority --asc puts no priority first)")
+	cmd.Flags().Bool("desc", false, "Sort descending")
+	cmd.MarkFlagsMutuallyExclusive("asc", "desc")
+	cmd.Flags().Bool("all", false, "Fetch every matching issue, ignoring --limit, so client-side sorts see them all")
+}
+
+// fetchAllIssuePages follows a paginated issue connection to its end
+func fetchAllIssuePages(fetch func(after string) (*api.Issues, error)) (*api.Issues, error) {
+	return fetchIssuePagesUpTo(fetch, 0)
+}
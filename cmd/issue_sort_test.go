@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestIssueSort(t *testing.T) {
+	est := func(f float64) *float64 { return &f }
+	due := func(s string) *string { return &s }
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 0, 0, 0, 0, time.UTC) }
+	issues := []api.Issue{
+		{Identifier: "A", Title: "banana", Priority: 0, Estimate: est(3), CreatedAt: day(1), State: &api.State{Name: "Done", Type: "completed"}},
+		{Identifier: "B", Title: "Apple", Priority: 4, DueDate: due("2026-03-01"), CreatedAt: day(4), State: &api.State{Name: "Todo", Type: "unstarted"}},
+		{Identifier: "C", Title: "cherry", Priority: 1, Estimate: est(1), DueDate: due("2026-02-01"), CreatedAt: day(2), State: &api.State{Name: "In Progress", Type: "started"}},
+		{Identifier: "D", Title: "apricot", Priority: 2, Estimate: est(3), CreatedAt: day(3)},
+		{Identifier: "E", Title: "Date", Priority: 1, DueDate: due(""), CreatedAt: day(5), State: &api.State{Name: "Backlog", Type: "backlog"}},
+	}
+
+	tests := []struct {
+		sort      string
+		asc, desc bool
+		want      string
+	}{
+		// No priority is the lowest, not the highest; ties keep fetched order
+		{sort: "priority", want: "C,E,D,B,A"},
+		{sort: "priority", asc: true, want: "A,B,D,C,E"},
+		// Issues without a value go last in either direction
+		{sort: "estimate", want: "A,D,C,B,E"},
+		{sort: "estimate", asc: true, want: "C,A,D,B,E"},
+		{sort: "due", want: "C,B,A,D,E"},
+		{sort: "due", desc: true, want: "B,C,A,D,E"},
+		{sort: "title", want: "B,D,A,C,E"},
+		{sort: "state", want: "E,B,C,A,D"},
+		{sort: "created", asc: true, want: "A,C,D,B,E"},
+		{sort: "createdAt", asc: true, want: "A,C,D,B,E"},
+		// The API already orders these; nothing is reordered client-side
+		{sort: "created", want: "A,B,C,D,E"},
+		{sort: "linear", want: "A,B,C,D,E"},
+	}
+	for _, tt := range tests {
+		s, err := parseIssueSort(tt.sort, tt.asc, tt.desc)
+		if err != nil {
+			t.Errorf("parseIssueSort(%s): %v", tt.sort, err)
+			continue
+		}
+		sorted := append([]api.Issue(nil), issues...)
+		s.apply(sorted)
+		var ids []string
+		for _, i := range sorted {
+			ids = append(ids, i.Identifier)
+		}
+		if got := strings.Join(ids, ","); got != tt.want {
+			t.Errorf("--sort %s (asc %v, desc %v) = %s, want %s", tt.sort, tt.asc, tt.desc, got, tt.want)
+		}
+	}
+}
+
+func TestParseIssueSort(t *testing.T) {
+	if s, err := parseIssueSort("updated", false, false); err != nil || s.OrderBy != "updatedAt" || s.key != nil {
+		t.Errorf("updated = %+v, %v; want the API's orderBy only", s, err)
+	}
+	if s, _ := parseIssueSort("priority", false, false); s.OrderBy != "" || s.key == nil {
+		t.Errorf("priority = %+v, want a client-side sort", s)
+	}
+	if _, err := parseIssueSort("linear", true, false); err == nil {
+		t.Error("--asc without a sort key should fail")
+	}
+	_, err := parseIssueSort("size", false, false)
+	if err == nil || !strings.Contains(err.Error(), "linear, created, updated, priority, estimate, due, title, state") {
+		t.Errorf("invalid sort error = %v", err)
+	}
+
+	// Project and view issues take no orderBy, so created sorts after fetching
+	s, _ := parseIssueSort("created", false, false)
+	s = s.withoutOrderBy()
+	if s.OrderBy != "" || s.key == nil || !s.desc {
+		t.Errorf("created without orderBy = %+v, want a newest-first client-side sort", s)
+	}
+}
+
+func TestHermeticProjectIssuesSort(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectIssues", `{"project":{"issues":{"nodes":[
+		{"id":"1","identifier":"ENG-1","title":"Low","priority":4,"createdAt":"2026-01-01T00:00:00Z","updatedAt":"2026-01-01T00:00:00Z"},
+		{"id":"2","identifier":"ENG-2","title":"None","priority":0,"createdAt":"2026-01-01T00:00:00Z","updatedAt":"2026-01-01T00:00:00Z"},
+		{"id":"3","identifier":"ENG-3","title":"Urgent","priority":1,"createdAt":"2026-01-01T00:00:00Z","updatedAt":"2026-01-01T00:00:00Z"}
+	],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+
+	r := runMocked(t, "project", "issues", "proj-1", "--sort", "priority", "--json")
+	var issues []api.Issue
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &issues) != nil || len(issues) != 3 {
+		t.Fatalf("project issues --sort priority exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := issues[0].Identifier + "," + issues[1].Identifier + "," + issues[2].Identifier; got != "ENG-3,ENG-1,ENG-2" {
+		t.Errorf("order = %s, want ENG-3,ENG-1,ENG-2", got)
+	}
+	if !strings.Contains(r.Stderr, "pass --all to sort every one") {
+		t.Errorf("stderr = %q, want a note that only the fetched page was sorted", r.Stderr)
+	}
+}
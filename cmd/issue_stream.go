@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/viper"
+)
+
+// issueStream prints an --all issue list page by page as the pages arrive,
+// for the outputs that don't need every issue before the first line: JSON,
+// plaintext, and --ids-only. The result reads the same as when buffered.
+type issueStream struct {
+	plaintext        bool
+	jsonOut          bool
+	idsOnly          bool
+	descriptionLines int
+	plaintextTitle   string
+	json             *output.JSONArrayWriter
+	printed          int
+	now              time.Time
+}
+
+// newIssueStream returns a stream for the output the flags ask for, or nil
+// when it can't be streamed: the colored table lines its columns up over
+// every issue, and --count, --sample, and client-side sorts need them all.
+func newIssueStream(plaintext, jsonOut bool, script scriptOutput, sorting issueSort, sample issueSample, descriptionLines int, plaintextTitle string) *issueStream {
+	if script.Count || sample.enabled() || sorting.key != nil {
+		return nil
+	}
+	if !jsonOut && !plaintext && !script.IDsOnly {
+		return nil
+	}
+	s := &issueStream{
+		plaintext:        plaintext,
+		jsonOut:          jsonOut,
+		idsOnly:          script.IDsOnly,
+		descriptionLines: descriptionLines,
+		plaintextTitle:   plaintextTitle,
+		now:              time.Now(),
+	}
+	if jsonOut {
+		s.json = output.NewJSONArrayWriter(os.Stdout)
+	}
+	return s
+}
+
+// page prints one page of issues
+func (s *issueStream) page(issues []api.Issue) error {
+	for _, issue := range issues {
+		switch {
+		case s.idsOnly:
+			fmt.Println(issue.Identifier)
+		case s.json != nil:
+			if err := s.json.Write(issue); err != nil {
+				return err
+			}
+		default:
+			if s.printed == 0 {
+				fmt.Println(s.plaintextTitle)
+			}
+			printIssueItem(issue, "##", s.now, s.descriptionLines)
+		}
+		s.printed++
+	}
+	return nil
+}
+
+// finish ends the output once every page is printed
+func (s *issueStream) finish(emptyMessage, summaryLabel string) {
+	switch {
+	case s.idsOnly:
+	case s.printed == 0:
+		output.Info(emptyMessage, s.plaintext, s.jsonOut)
+	case s.json != nil:
+		_ = s.json.Close()
+	default:
+		fmt.Printf("\nTotal: %d %s\n", s.printed, summaryLabel)
+	}
+}
+
+// fail reports an error that cut the stream short. Once output has started
+// the error goes to stderr, after closing the JSON array so stdout stays
+// valid JSON; before that it's reported as usual.
+func (s *issueStream) fail(message string) {
+	started := s.printed > 0
+	if s.json != nil {
+		started = s.json.Started()
+	}
+	if !started {
+		output.Error(message, s.plaintext, s.jsonOut)
+		return
+	}
+	if s.json != nil {
+		_ = s.json.Close()
+	}
+	output.Error(fmt.Sprintf("%s (printed %d issues before the error)", message, s.printed), true, false)
+}
+
+// reportIssuePages wraps an --all fetch to note on stderr how many issues
+// have arrived each time another page is on the way, so a long fetch for
+// the table doesn't look stuck. --progress none turns it off.
+func reportIssuePages(fetch func(after string) (*api.Issues, error)) func(after string) (*api.Issues, error) {
+	if viper.GetString("progress") == string(output.ProgressNone) {
+		return fetch
+	}
+	fetched := 0
+	return func(after string) (*api.Issues, error) {
+		page, err := fetch(after)
+		if err != nil {
+			return nil, err
+		}
+		fetched += len(page.Nodes)
+		if page.PageInfo.HasNextPage {
+			fmt.Fprintf(os.Stderr, "Fetched %d issues, fetching more...\n", fetched)
+		}
+		return page, nil
+	}
+}
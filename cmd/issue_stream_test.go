@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+func TestHermeticIssueListStream(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Issues", `{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1","title":"One"},{"id":"i2","identifier":"ENG-2","title":"Two"}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	s.DataFor("Issues", map[string]interface{}{"after": "c1"}, `{"issues":{"nodes":[{"id":"i3","identifier":"ENG-3","title":"Three"}],
+		"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "issue", "list", "--team", "ENG", "--all", "--json")
+	var issues []map[string]interface{}
+	if err := json.Unmarshal([]byte(r.Stdout), &issues); err != nil || r.Exit != 0 || len(issues) != 3 || issues[2]["identifier"] != "ENG-3" {
+		t.Fatalf("--all --json exited %d: %s (%v)", r.Exit, r.Stdout, err)
+	}
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--all", "--plaintext")
+	if r.Exit != 0 || !strings.HasPrefix(r.Stdout, "# Issues\n## One\n") || !strings.HasSuffix(r.Stdout, "\nTotal: 3 issues\n") {
+		t.Errorf("--all --plaintext exited %d:\n%s", r.Exit, r.Stdout)
+	}
+	if r := runMocked(t, "issue", "list", "--team", "ENG", "--all", "--ids-only"); r.Stdout != "ENG-1\nENG-2\nENG-3\n" {
+		t.Errorf("--all --ids-only = %q", r.Stdout)
+	}
+
+	// The table still waits for every page, noting each one on stderr
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--all")
+	if r.Exit != 0 || !strings.Contains(r.Stderr, "Fetched 2 issues, fetching more...") || !strings.Contains(r.Stdout, "3 issues") {
+		t.Errorf("--all table exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if r := runMocked(t, "issue", "list", "--team", "ENG", "--all", "--progress", "none"); strings.Contains(r.Stderr, "Fetched") {
+		t.Errorf("--progress none still reported pages: %s", r.Stderr)
+	}
+
+	// A failed page leaves what was printed intact: the JSON array is closed
+	// and the error goes to stderr
+	s.Add(linearmock.Stub{Operation: "Issues", Variables: map[string]interface{}{"after": "c1"},
+		Response: json.RawMessage(`{"errors":[{"message":"rate limited"}]}`)})
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--all", "--json")
+	issues = nil
+	if err := json.Unmarshal([]byte(r.Stdout), &issues); err != nil || len(issues) != 2 {
+		t.Errorf("interrupted --json printed invalid output: %s (%v)", r.Stdout, err)
+	}
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "rate limited") || !strings.Contains(r.Stderr, "printed 2 issues before the error") {
+		t.Errorf("interrupted --json exited %d: %s", r.Exit, r.Stderr)
+	}
+	r = runMocked(t, "issue", "list", "--team", "ENG", "--all", "--ids-only")
+	if r.Exit != 1 || r.Stdout != "ENG-1\nENG-2\n" || !strings.Contains(r.Stderr, "rate limited") {
+		t.Errorf("interrupted --ids-only exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
+
+func TestHermeticIssueListStreamFailsFirstPage(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Add(linearmock.Stub{Operation: "Issues", Response: json.RawMessage(`{"errors":[{"message":"boom"}]}`)})
+
+	// Nothing was streamed, so the error is the usual JSON error
+	r := runMocked(t, "issue", "list", "--team", "ENG", "--all", "--json")
+	var got map[string]interface{}
+	_ = json.Unmarshal([]byte(r.Stdout), &got)
+	if msg, _ := got["error"].(string); r.Exit != 1 || !strings.Contains(msg, "boom") {
+		t.Errorf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
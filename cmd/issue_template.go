@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// issueTemplateFields are the issue template data keys issue create copies
+// into its input. Other keys (teamId, subIssues, ...) are ignored: the team
+// always comes from --team.
+var issueTemplateFields = []string{
+	"title",
+	"description",
+	"descriptionData",
+	"priority",
+	"estimate",
+	"labelIds",
+	"assigneeId",
+	"stateId",
+	"projectId",
+	"projectMilestoneId",
+	"cycleId",
+	"dueDate",
+	"subscriberIds",
+	"parentId",
+}
+
+// resolveIssueTemplate returns the template issue create applies: the one
+// named by --template (ID or name) when given, otherwise the team's default
+// template for members unless --no-template. isDefault reports which. A nil
+// template means none applies.
+func resolveIssueTemplate(client *api.Client, ref string, noTemplate bool, team *api.Team) (tmpl *api.Template, isDefault bool, err error) {
+	ctx := context.Background()
+	if ref != "" {
+		teamKey := ""
+		if team != nil {
+			teamKey = team.Key
+		}
+		id, err := resolveTemplateID(client, ref, "issue", teamKey)
+		if err != nil {
+			return nil, false, fmt.Errorf("Invalid --template: %v", err)
+		}
+		tmpl, err := client.GetTemplate(ctx, id)
+		if err != nil {
+			return nil, false, fmt.Errorf("Failed to get template '%s': %v", ref, err)
+		}
+		if tmpl.Type != "" && tmpl.Type != "issue" {
+			return nil, false, fmt.Errorf("Invalid --template: '%s' is a %s template, not an issue template", tmpl.Name, tmpl.Type)
+		}
+		return tmpl, false, nil
+	}
+
+	if noTemplate || team == nil || team.DefaultTemplateForMembers == nil || team.DefaultTemplateForMembers.ID == "" {
+		return nil, false, nil
+	}
+	tmpl, err = client.GetTemplate(ctx, team.DefaultTemplateForMembers.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("Failed to get %s's default template (--no-template to skip): %v", team.Key, err)
+	}
+	return tmpl, true, nil
+}
+
+// issueTemplateValues parses issue template data into issue create input
+// fields, keeping only the keys in issueTemplateFields
+func issueTemplateValues(raw json.RawMessage) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	if len(raw) == 0 || string(raw) == "null" {
+		return values, nil
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("Invalid template data: %v", err)
+	}
+	for _, key := range issueTemplateFields {
+		if v, ok := data[key]; ok && v != nil {
+			values[key] = v
+		}
+	}
+	return values, nil
+}
+
+// applyIssueTemplate fills the input fields the flags left unset with the
+// template's values, so explicit flags always win. A flag's description
+// replaces the template's rich-text descriptionData too, and a flag's
+// project drops the template's milestone, which belongs to another project.
+func applyIssueTemplate(input, values map[string]interface{}) {
+	for key, v := range values {
+		if _, set := input[key]; set {
+			continue
+		}
+		switch key {
+		case "descriptionData":
+			if _, set := input["description"]; set {
+				continue
+			}
+		case "projectMilestoneId":
+			if _, set := input["projectId"]; set {
+				continue
+			}
+		}
+		input[key] = v
+	}
+}
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// TestIssueTemplatePrecedence covers flag > --template > team default > nothing
+func TestIssueTemplatePrecedence(t *testing.T) {
+	templates := map[string]string{
+		"tpl-default": `{"id":"tpl-default","name":"Triage","type":"issue","templateData":{"priority":4,"description":"Default body","labelIds":["l-triage"],"teamId":"t1"}}`,
+		"tpl-bug":     `{"id":"tpl-bug","name":"Bug report","type":"issue","templateData":{"priority":1,"descriptionData":{"type":"doc"},"estimate":2}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if strings.Contains(body.Query, "templates {") {
+			w.Write([]byte(`{"data":{"templates":[` + templates["tpl-default"] + `,` + templates["tpl-bug"] + `]}}`))
+			return
+		}
+		id, _ := body.Variables["id"].(string)
+		w.Write([]byte(`{"data":{"template":` + templates[id] + `}}`))
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	team := &api.Team{ID: "t1", Key: "ENG", DefaultTemplateForMembers: &api.Template{ID: "tpl-default", Name: "Triage"}}
+
+	tests := []struct {
+		name        string
+		ref         string
+		noTemplate  bool
+		team        *api.Team
+		flags       map[string]interface{}
+		wantName    string
+		wantDefault bool
+		want        map[string]interface{}
+	}{
+		{
+			name:        "team default fills unset fields",
+			team:        team,
+			flags:       map[string]interface{}{"title": "T", "teamId": "t1"},
+			wantName:    "Triage",
+			wantDefault: true,
+			want:        map[string]interface{}{"title": "T", "teamId": "t1", "priority": 4.0, "description": "Default body", "labelIds": []interface{}{"l-triage"}},
+		},
+		{
+			name:        "flags beat the team default",
+			team:        team,
+			flags:       map[string]interface{}{"title": "T", "teamId": "t1", "priority": 2, "labelIds": []string{"l-bug"}},
+			wantName:    "Triage",
+			wantDefault: true,
+			want:        map[string]interface{}{"title": "T", "teamId": "t1", "priority": 2.0, "description": "Default body", "labelIds": []interface{}{"l-bug"}},
+		},
+		{
+			name:     "explicit template replaces the default",
+			ref:      "bug report",
+			team:     team,
+			flags:    map[string]interface{}{"title": "T", "teamId": "t1", "estimate": 5},
+			wantName: "Bug report",
+			want:     map[string]interface{}{"title": "T", "teamId": "t1", "priority": 1.0, "descriptionData": map[string]interface{}{"type": "doc"}, "estimate": 5.0},
+		},
+		{
+			name:       "--no-template skips the default",
+			noTemplate: true,
+			team:       team,
+			flags:      map[string]interface{}{"title": "T", "teamId": "t1"},
+			want:       map[string]interface{}{"title": "T", "teamId": "t1"},
+		},
+		{
+			name:  "no default, nothing applied",
+			team:  &api.Team{ID: "t2", Key: "OPS"},
+			flags: map[string]interface{}{"title": "T", "teamId": "t2"},
+			want:  map[string]interface{}{"title": "T", "teamId": "t2"},
+		},
+	}
+	for _, tt := range tests {
+		tmpl, isDefault, err := resolveIssueTemplate(client, tt.ref, tt.noTemplate, tt.team)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		input := tt.flags
+		if tmpl != nil {
+			if tmpl.Name != tt.wantName || isDefault != tt.wantDefault {
+				t.Errorf("%s: applied %q (default %v), want %q (default %v)", tt.name, tmpl.Name, isDefault, tt.wantName, tt.wantDefault)
+			}
+			values, err := issueTemplateValues(tmpl.TemplateData)
+			if err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			applyIssueTemplate(input, values)
+		} else if tt.wantName != "" {
+			t.Errorf("%s: no template applied, want %q", tt.name, tt.wantName)
+		}
+		got, _ := json.Marshal(input)
+		want, _ := json.Marshal(tt.want)
+		if string(got) != string(want) {
+			t.Errorf("%s:\n got %s\nwant %s", tt.name, got, want)
+		}
+	}
+
+	if _, _, err := resolveIssueTemplate(client, "Feature request", false, team); err == nil || !strings.Contains(err.Error(), "Bug report") {
+		t.Errorf("unknown --template error = %v, want one listing the issue templates", err)
+	}
+}
+
+func TestApplyIssueTemplateLinkedFields(t *testing.T) {
+	values := map[string]interface{}{
+		"descriptionData":    map[string]interface{}{"type": "doc"},
+		"projectMilestoneId": "m1",
+		"projectId":          "p1",
+		"assigneeId":         "u1",
+	}
+	input := map[string]interface{}{"description": "Mine", "projectId": "p2", "assigneeId": nil}
+	applyIssueTemplate(input, values)
+	got, _ := json.Marshal(input)
+	if want := `{"assigneeId":null,"description":"Mine","projectId":"p2"}`; string(got) != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
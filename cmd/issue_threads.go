@@ -0,0 +1,386 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// exitUnresolvedThreads is the issue threads exit code under
+// --fail-if-unresolved when any listed thread is still open
+const exitUnresolvedThreads = 2
+
+// threadExcerptLen is how much of a root comment's first line a thread shows
+const threadExcerptLen = 60
+
+// reactionEmoji maps the shortcodes Linear stores reactions under to the
+// emoji they draw; others are shown as :name:
+var reactionEmoji = map[string]string{
+	"+1":               "👍",
+	"thumbsup":         "👍",
+	"-1":               "👎",
+	"thumbsdown":       "👎",
+	"heart":            "❤️",
+	"tada":             "🎉",
+	"eyes":             "👀",
+	"rocket":           "🚀",
+	"smile":            "😄",
+	"laughing":         "😆",
+	"joy":              "😂",
+	"confused":         "😕",
+	"fire":             "🔥",
+	"white_check_mark": "✅",
+	"100":              "💯",
+	"pray":             "🙏",
+	"clap":             "👏",
+	"thinking_face":    "🤔",
+}
+
+// reactionCount is how many times one emoji was used across a thread
+type reactionCount struct {
+	Emoji string `json:"emoji"`
+	Count int    `json:"count"`
+}
+
+// commentThread is a root comment with its replies, oldest first, and what
+// issue threads reports about them
+type commentThread struct {
+	api.Comment
+	Replies        []api.Comment   `json:"replies"`
+	Resolved       bool            `json:"resolved"`
+	ResolvedBy     string          `json:"resolvedBy,omitempty"`
+	Participants   []string        `json:"participants"`
+	LastActivityAt time.Time       `json:"lastActivityAt"`
+	Excerpt        string          `json:"excerpt"`
+	Reactions      []reactionCount `json:"reactions"`
+}
+
+// fetchAllIssueComments follows an issue's comments to the end
+func fetchAllIssueComments(client *api.Client, issueID string) ([]api.Comment, error) {
+	var all []api.Comment
+	after := ""
+	for {
+		page, err := client.GetIssueComments(context.Background(), issueID, 100, after, "")
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// buildCommentThreads groups comments into threads under their root comment,
+// following parentId up through nested replies. A reply whose parent isn't
+// among the comments starts its own thread. Threads and replies are oldest
+// first.
+func buildCommentThreads(comments []api.Comment) []commentThread {
+	byID := make(map[string]*api.Comment, len(comments))
+	for i := range comments {
+		byID[comments[i].ID] = &comments[i]
+	}
+	rootOf := func(c *api.Comment) string {
+		seen := map[string]bool{}
+		for c.ParentID != nil && *c.ParentID != "" && !seen[c.ID] {
+			seen[c.ID] = true
+			parent, ok := byID[*c.ParentID]
+			if !ok {
+				break
+			}
+			c = parent
+		}
+		return c.ID
+	}
+
+	index := map[string]int{}
+	var threads []commentThread
+	var replies []*api.Comment
+	for i := range comments {
+		c := &comments[i]
+		if rootOf(c) != c.ID {
+			replies = append(replies, c)
+			continue
+		}
+		index[c.ID] = len(threads)
+		threads = append(threads, commentThread{Comment: *c, Replies: []api.Comment{}})
+	}
+	for _, c := range replies {
+		t := &threads[index[rootOf(c)]]
+		t.Replies = append(t.Replies, *c)
+	}
+
+	sort.SliceStable(threads, func(i, j int) bool { return threads[i].CreatedAt.Before(threads[j].CreatedAt) })
+	for i := range threads {
+		summarizeThread(&threads[i])
+	}
+	return threads
+}
+
+// summarizeThread fills in a thread's status, participants, last activity,
+// excerpt, and reaction counts
+func summarizeThread(t *commentThread) {
+	sort.SliceStable(t.Replies, func(i, j int) bool { return t.Replies[i].CreatedAt.Before(t.Replies[j].CreatedAt) })
+	t.Resolved = t.ResolvedAt != nil
+	if t.ResolvingUser != nil {
+		t.ResolvedBy = safeUserName(t.ResolvingUser)
+	}
+	t.Excerpt = updateExcerpt(t.Body, threadExcerptLen)
+
+	t.Participants = []string{}
+	seen := map[string]bool{}
+	counts := map[string]int{}
+	var emojis []string
+	for _, c := range append([]api.Comment{t.Comment}, t.Replies...) {
+		if author := getCommentAuthor(&c); !seen[author] {
+			seen[author] = true
+			t.Participants = append(t.Participants, author)
+		}
+		for _, at := range []*time.Time{&c.CreatedAt, c.EditedAt, c.ResolvedAt} {
+			if at != nil && at.After(t.LastActivityAt) {
+				t.LastActivityAt = *at
+			}
+		}
+		for _, r := range commentReactions(c.ReactionData) {
+			if _, ok := counts[r.Emoji]; !ok {
+				emojis = append(emojis, r.Emoji)
+			}
+			counts[r.Emoji] += r.Count
+		}
+	}
+	t.Reactions = make([]reactionCount, len(emojis))
+	for i, e := range emojis {
+		t.Reactions[i] = reactionCount{Emoji: e, Count: counts[e]}
+	}
+}
+
+// commentReactions reads a comment's reactionData, a list of
+// {"emoji", "reactions"} entries. Entries carrying userIds or a count
+// instead of the reactions themselves are counted from those.
+func commentReactions(data interface{}) []reactionCount {
+	entries, _ := data.([]interface{})
+	var counts []reactionCount
+	for _, entry := range entries {
+		m, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name, _ := m["emoji"].(string)
+		if name == "" {
+			continue
+		}
+		n := 1
+		if list, ok := m["reactions"].([]interface{}); ok {
+			n = len(list)
+		} else if list, ok := m["userIds"].([]interface{}); ok {
+			n = len(list)
+		} else if count, ok := m["count"].(float64); ok {
+			n = int(count)
+		}
+		if n > 0 {
+			counts = append(counts, reactionCount{Emoji: emojiForShortcode(name), Count: n})
+		}
+	}
+	return counts
+}
+
+// emojiForShortcode turns a reaction's shortcode into its emoji. Names that
+// are already emoji are kept; unknown shortcodes become :name:.
+func emojiForShortcode(name string) string {
+	name = strings.Trim(name, ":")
+	if e, ok := reactionEmoji[name]; ok {
+		return e
+	}
+	for _, r := range name {
+		if r > 0x2000 {
+			return name
+		}
+	}
+	return ":" + name + ":"
+}
+
+// formatReactions renders a thread's reactions as "👍 3 🎉 1"
+func formatReactions(reactions []reactionCount) string {
+	parts := make([]string, len(reactions))
+	for i, r := range reactions {
+		parts[i] = fmt.Sprintf("%s %d", r.Emoji, r.Count)
+	}
+	return strings.Join(parts, " ")
+}
+
+// countUnresolved returns how many threads are still open
+func countUnresolved(threads []commentThread) int {
+	n := 0
+	for _, t := range threads {
+		if !t.Resolved {
+			n++
+		}
+	}
+	return n
+}
+
+// threadStatus is a thread's status as the rendered output shows it
+func threadStatus(t commentThread) string {
+	if !t.Resolved {
+		return "unresolved"
+	}
+	if t.ResolvedBy != "" {
+		return "resolved by " + t.ResolvedBy
+	}
+	return "resolved"
+}
+
+var issueThreadsCmd = &cobra.Command{
+	Use:   "threads ISSUE-ID",
+	Short: "List an issue's comment threads and whether they're resolved",
+	Long: `List the comment threads on an issue, oldest first: whether each is
+resolved (and by whom), who took part, when it last saw activity (a
+comment, edit, or resolution), the first line of the root comment, and the
+emoji reactions across the thread.
+
+--json emits {"issue", "threads", "unresolved"}; each thread is its root
+comment with "replies" nested under it plus "resolved", "resolvedBy",
+"participants", "lastActivityAt", "excerpt", and "reactions"
+([{"emoji", "count"}]).
+
+--fail-if-unresolved exits 2 after printing when any listed thread is still
+unresolved, so a release checklist or CI step can block on open review
+discussions.
+
+Examples:
+  linear-cli issue threads LIN-123
+  linear-cli issue threads LIN-123 --unresolved
+  linear-cli issue threads LIN-123 --fail-if-unresolved --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		comments, err := fetchAllIssueComments(client, issue.ID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		threads := buildCommentThreads(comments)
+		if unresolvedOnly, _ := cmd.Flags().GetBool("unresolved"); unresolvedOnly {
+			open := []commentThread{}
+			for _, t := range threads {
+				if !t.Resolved {
+					open = append(open, t)
+				}
+			}
+			threads = open
+		}
+		if threads == nil {
+			threads = []commentThread{}
+		}
+		unresolved := countUnresolved(threads)
+
+		if jsonOut {
+			output.JSON(map[string]interface{}{
+				"issue":      issue.Identifier,
+				"threads":    threads,
+				"unresolved": unresolved,
+			})
+		} else if plaintext {
+			renderThreadsMarkdown(os.Stdout, issue.Identifier, threads)
+		} else {
+			renderThreadsRich(os.Stdout, issue.Identifier, threads)
+		}
+
+		if failIfUnresolved, _ := cmd.Flags().GetBool("fail-if-unresolved"); failIfUnresolved && unresolved > 0 {
+			exit(exitUnresolvedThreads)
+		}
+	},
+}
+
+// renderThreadsMarkdown writes one section per thread
+func renderThreadsMarkdown(w io.Writer, identifier string, threads []commentThread) {
+	fmt.Fprintf(w, "# Threads on %s\n\n", identifier)
+	if len(threads) == 0 {
+		fmt.Fprintln(w, "No comment threads.")
+		return
+	}
+	fmt.Fprintf(w, "%d threads, %d unresolved\n", len(threads), countUnresolved(threads))
+	for _, t := range threads {
+		fmt.Fprintf(w, "\n## %s\n\n", escapeMarkdown(t.Excerpt))
+		fmt.Fprintf(w, "- **Status**: %s\n", threadStatus(t))
+		fmt.Fprintf(w, "- **Author**: %s\n", getCommentAuthor(&t.Comment))
+		fmt.Fprintf(w, "- **Replies**: %d\n", len(t.Replies))
+		fmt.Fprintf(w, "- **Participants**: %s\n", strings.Join(t.Participants, ", "))
+		fmt.Fprintf(w, "- **Last activity**: %s\n", output.FormatTime(t.LastActivityAt, output.DateTime))
+		if len(t.Reactions) > 0 {
+			fmt.Fprintf(w, "- **Reactions**: %s\n", formatReactions(t.Reactions))
+		}
+		if t.URL != "" {
+			fmt.Fprintf(w, "- **URL**: %s\n", t.URL)
+		}
+	}
+}
+
+// renderThreadsRich prints the threads as a table with a summary line
+func renderThreadsRich(w io.Writer, identifier string, threads []commentThread) {
+	if len(threads) == 0 {
+		fmt.Fprintf(w, "\n%s No comment threads on %s\n",
+			color.New(color.FgYellow).Sprint("ℹ"),
+			color.New(color.FgCyan).Sprint(identifier))
+		return
+	}
+
+	fmt.Fprintf(w, "\n%s Threads on %s\n\n",
+		color.New(color.FgCyan, color.Bold).Sprint("💬"),
+		color.New(color.FgCyan).Sprint(identifier))
+	rows := make([][]string, len(threads))
+	for i, t := range threads {
+		status := color.New(color.FgGreen).Sprint(threadStatus(t))
+		if !t.Resolved {
+			status = color.New(color.FgYellow).Sprint(threadStatus(t))
+		}
+		rows[i] = []string{
+			status,
+			getCommentAuthor(&t.Comment),
+			fmt.Sprintf("%d", len(t.Replies)),
+			strings.Join(t.Participants, ", "),
+			formatTimeAgo(t.LastActivityAt),
+			formatReactions(t.Reactions),
+			t.Excerpt,
+		}
+	}
+	output.Table(output.TableData{
+		Headers: []string{"Status", "Author", "Replies", "Participants", "Last activity", "Reactions", "Excerpt"},
+		Rows:    rows,
+	}, false, false)
+	fmt.Fprintf(w, "\n%d threads, %d unresolved\n", len(threads), countUnresolved(threads))
+}
+
+func init() {
+	issueCmd.AddCommand(issueThreadsCmd)
+
+	issueThreadsCmd.Flags().Bool("unresolved", false, "Only list threads that aren't resolved")
+	issueThreadsCmd.Flags().Bool("fail-if-unresolved", false, "Exit 2 when any listed thread is unresolved")
+}
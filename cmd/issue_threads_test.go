@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestBuildCommentThreads(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 3, 1, h, 0, 0, 0, time.UTC) }
+	parent := func(id string) *string { return &id }
+	resolved := at(9)
+	comments := []api.Comment{
+		{ID: "r2", Body: "## Second thread\nmore", CreatedAt: at(2), User: &api.User{Name: "Bea"}},
+		{ID: "c2", Body: "nested", CreatedAt: at(5), ParentID: parent("c1"), User: &api.User{Name: "Cal"},
+			ReactionData: []interface{}{map[string]interface{}{"emoji": "+1", "reactions": []interface{}{"a", "b"}}}},
+		{ID: "c1", Body: "reply", CreatedAt: at(3), ParentID: parent("r1"), User: &api.User{Name: "Bea"}},
+		{ID: "r1", Body: "First thread", CreatedAt: at(1), User: &api.User{Name: "Ann"},
+			ResolvedAt: &resolved, ResolvingUser: &api.User{Name: "Ann"},
+			ReactionData: []interface{}{map[string]interface{}{"emoji": "+1", "userIds": []interface{}{"u"}}, map[string]interface{}{"emoji": "party_parrot", "count": 2.0}}},
+		{ID: "o1", Body: "orphan", CreatedAt: at(4), ParentID: parent("gone"), User: &api.User{Name: "Dee"}},
+	}
+
+	threads := buildCommentThreads(comments)
+	if len(threads) != 3 || threads[0].ID != "r1" || threads[1].ID != "r2" || threads[2].ID != "o1" {
+		t.Fatalf("threads = %+v", threads)
+	}
+	first := threads[0]
+	if len(first.Replies) != 2 || first.Replies[0].ID != "c1" || first.Replies[1].ID != "c2" {
+		t.Errorf("replies = %+v", first.Replies)
+	}
+	if !first.Resolved || first.ResolvedBy != "Ann" || threadStatus(first) != "resolved by Ann" {
+		t.Errorf("status = %v %q", first.Resolved, first.ResolvedBy)
+	}
+	if got := strings.Join(first.Participants, ","); got != "Ann,Bea,Cal" {
+		t.Errorf("participants = %s", got)
+	}
+	if !first.LastActivityAt.Equal(resolved) {
+		t.Errorf("last activity = %s", first.LastActivityAt)
+	}
+	if got := formatReactions(first.Reactions); got != "👍 3 :party_parrot: 2" {
+		t.Errorf("reactions = %q", got)
+	}
+	if threads[1].Excerpt != "Second thread" || threads[1].Resolved || len(threads[1].Reactions) != 0 {
+		t.Errorf("second thread = %+v", threads[1])
+	}
+	if countUnresolved(threads) != 2 {
+		t.Errorf("unresolved = %d", countUnresolved(threads))
+	}
+}
+
+func TestHermeticIssueThreads(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Issue", `{"issue":{"id":"i1","identifier":"ENG-1","title":"Review"}}`)
+	s.Data("IssueComments", `{"issue":{"comments":{"nodes":[
+		{"id":"r1","body":"Ship it?","createdAt":"2025-03-01T01:00:00Z","user":{"name":"Ann"},
+			"resolvedAt":"2025-03-01T04:00:00Z","resolvingUser":{"name":"Bea"}},
+		{"id":"r2","body":"Still failing on CI","createdAt":"2025-03-01T02:00:00Z","user":{"name":"Bea"},
+			"reactionData":[{"emoji":"eyes","reactions":[{"userId":"u1"}]}]}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+	s.DataFor("IssueComments", map[string]interface{}{"after": "c1"}, `{"issue":{"comments":{"nodes":[
+		{"id":"c1","body":"Looking","createdAt":"2025-03-01T03:00:00Z","parentId":"r2","user":{"name":"Cal"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+
+	r := runMocked(t, "issue", "threads", "ENG-1", "--json")
+	var got struct {
+		Issue   string
+		Threads []struct {
+			ID           string
+			Resolved     bool
+			Replies      []api.Comment
+			Reactions    []reactionCount
+			Participants []string
+		}
+		Unresolved int
+	}
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &got) != nil {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got.Issue != "ENG-1" || got.Unresolved != 1 || len(got.Threads) != 2 ||
+		len(got.Threads[1].Replies) != 1 || got.Threads[1].Replies[0].ID != "c1" ||
+		len(got.Threads[1].Reactions) != 1 || got.Threads[1].Reactions[0].Emoji != "👀" {
+		t.Errorf("JSON = %s", r.Stdout)
+	}
+
+	r = runMocked(t, "issue", "threads", "ENG-1", "--unresolved", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "1 threads, 1 unresolved\n\n## Still failing on CI\n\n- **Status**: unresolved\n") ||
+		!strings.Contains(r.Stdout, "- **Participants**: Bea, Cal\n") || strings.Contains(r.Stdout, "Ship it") {
+		t.Errorf("plaintext exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	if r := runMocked(t, "issue", "threads", "ENG-1", "--fail-if-unresolved", "--json"); r.Exit != exitUnresolvedThreads || !strings.Contains(r.Stdout, `"unresolved": 1`) {
+		t.Errorf("--fail-if-unresolved exited %d: %s", r.Exit, r.Stdout)
+	}
+}
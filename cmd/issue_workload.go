@@ -0,0 +1,282 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// workloadRow is one assignee's share of a team's open work
+type workloadRow struct {
+	Assignee     string     `json:"assignee"`
+	AssigneeID   string     `json:"assigneeId,omitempty"`
+	Issues       int        `json:"issues"`
+	Points       float64    `json:"points"`
+	Unestimated  int        `json:"unestimated"`
+	OldestAt     *time.Time `json:"oldestCreatedAt"`
+	OverCapacity bool       `json:"overCapacity"`
+	Identifiers  []string   `json:"identifiers"`
+}
+
+// load is what --capacity and the sort compare: points, or issue count when
+// the team doesn't use estimates
+func (r *workloadRow) load(estimated bool) float64 {
+	if estimated {
+		return r.Points
+	}
+	return float64(r.Issues)
+}
+
+// buildWorkload totals issues per assignee, heaviest load first, with the
+// Unassigned row last. capacity, when positive, flags rows above it.
+func buildWorkload(issues []api.Issue, estimated bool, capacity float64) []*workloadRow {
+	byAssignee := map[string]*workloadRow{}
+	var rows []*workloadRow
+	for _, issue := range issues {
+		key, name := "", "Unassigned"
+		if issue.Assignee != nil {
+			key, name = issue.Assignee.ID, issue.Assignee.Name
+		}
+		row, ok := byAssignee[key]
+		if !ok {
+			row = &workloadRow{Assignee: name, AssigneeID: key, Identifiers: []string{}}
+			byAssignee[key] = row
+			rows = append(rows, row)
+		}
+
+		row.Issues++
+		if issue.Estimate != nil {
+			row.Points += *issue.Estimate
+		} else {
+			row.Unestimated++
+		}
+		if row.OldestAt == nil || issue.CreatedAt.Before(*row.OldestAt) {
+			created := issue.CreatedAt
+			row.OldestAt = &created
+		}
+		row.Identifiers = append(row.Identifiers, issue.Identifier)
+	}
+
+	for _, row := range rows {
+		row.OverCapacity = capacity > 0 && row.load(estimated) > capacity
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if (rows[i].AssigneeID == "") != (rows[j].AssigneeID == "") {
+			return rows[j].AssigneeID == ""
+		}
+		if li, lj := rows[i].load(estimated), rows[j].load(estimated); li != lj {
+			return li > lj
+		}
+		if rows[i].Issues != rows[j].Issues {
+			return rows[i].Issues > rows[j].Issues
+		}
+		return strings.ToLower(rows[i].Assignee) < strings.ToLower(rows[j].Assignee)
+	})
+	return rows
+}
+
+// workloadCycleFilter turns --cycle into an IssueFilter clause: current,
+// next, and previous use the team's cycle flags; anything else is resolved
+// as a cycle ID, number, or name
+func workloadCycleFilter(resolver *refResolver, value string, team *api.Team) map[string]interface{} {
+	switch strings.ToLower(value) {
+	case "current", "active":
+		return map[string]interface{}{"isActive": map[string]interface{}{"eq": true}}
+	case "next":
+		return map[string]interface{}{"isNext": map[string]interface{}{"eq": true}}
+	case "previous":
+		return map[string]interface{}{"isPrevious": map[string]interface{}{"eq": true}}
+	}
+	return map[string]interface{}{"id": map[string]interface{}{"eq": resolver.cycle(value, team)}}
+}
+
+// parseStateTypes splits --state-type and checks each workflow state type
+func parseStateTypes(value string) ([]string, error) {
+	var types []string
+	for _, t := range strings.Split(value, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		if _, ok := stateTypeOrder[t]; !ok {
+			return nil, fmt.Errorf("invalid state type %q: use triage, backlog, unstarted, started, completed, or canceled", t)
+		}
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+// formatAge renders how long ago t was in whole days, e.g. "12d"
+func formatAge(t *time.Time, now time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return fmt.Sprintf("%dd", int(now.Sub(*t).Hours()/24))
+}
+
+var issueWorkloadCmd = &cobra.Command{
+	Use:   "workload",
+	Short: "Show open issues and points per assignee",
+	Long: `Total a team's open issues by assignee for capacity planning: issue count,
+estimate points, and the age of each person's oldest issue, heaviest first,
+with unassigned issues last. Every matching issue is fetched, not just the
+first page.
+
+By default all issues that are not completed or canceled count; --state-type
+narrows that to a comma-separated list of workflow state types. --cycle takes
+current, next, previous, or a cycle number, name, or ID. When the team does
+not use estimates, issue counts stand in for points. --capacity marks anyone
+above it in red.
+
+Examples:
+  linear-cli issue workload --team ENG
+  linear-cli issue workload --team ENG --cycle current --capacity 10
+  linear-cli issue workload --team ENG --state-type unstarted,started --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamRef, _ := cmd.Flags().GetString("team")
+		cycleVal, _ := cmd.Flags().GetString("cycle")
+		capacity, _ := cmd.Flags().GetFloat64("capacity")
+		stateTypesVal, _ := cmd.Flags().GetString("state-type")
+		stateTypes, err := parseStateTypes(stateTypesVal)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		resolver := newRefResolver(client)
+		team := resolver.team(teamRef)
+		resolver.report(plaintext, jsonOut)
+
+		filter := map[string]interface{}{
+			"team": map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}},
+		}
+		if len(stateTypes) > 0 {
+			filter["state"] = map[string]interface{}{"type": map[string]interface{}{"in": stateTypes}}
+		} else {
+			filter["state"] = map[string]interface{}{"type": map[string]interface{}{"nin": []string{"completed", "canceled"}}}
+		}
+		if cycleVal != "" {
+			filter["cycle"] = workloadCycleFilter(resolver, cycleVal, team)
+			resolver.report(plaintext, jsonOut)
+		}
+
+		all, err := fetchAllIssuePages(func(after string) (*api.Issues, error) {
+			return client.GetIssuesWithFields(context.Background(), filter, 250, after, "", api.IssueFieldsTable)
+		})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		issues := all.Nodes
+
+		estimated := team.IssueEstimationType != "" && team.IssueEstimationType != "notUsed"
+		rows := buildWorkload(issues, estimated, capacity)
+
+		if jsonOut {
+			result := map[string]interface{}{
+				"team":      team.Key,
+				"estimated": estimated,
+				"issues":    len(issues),
+				"assignees": rows,
+			}
+			if capacity > 0 {
+				result["capacity"] = capacity
+			}
+			output.JSON(result)
+			return
+		}
+		if len(rows) == 0 {
+			output.Info(fmt.Sprintf("No open issues in team %s", team.Key), plaintext, jsonOut)
+			return
+		}
+		if plaintext {
+			writeWorkloadPlaintext(os.Stdout, rows, estimated, time.Now())
+			return
+		}
+
+		now := time.Now()
+		headers := []string{"Assignee", "Issues", "Points", "Oldest"}
+		if !estimated {
+			headers = []string{"Assignee", "Issues", "Oldest"}
+		}
+		tableRows := [][]string{}
+		for _, row := range rows {
+			name := row.Assignee
+			if row.OverCapacity {
+				name = color.New(color.FgRed, color.Bold).Sprint(name + " (over capacity)")
+			} else if row.AssigneeID == "" {
+				name = color.New(color.Faint).Sprint(name)
+			}
+			cells := []string{name, fmt.Sprintf("%d", row.Issues)}
+			if estimated {
+				points := formatPoints(row.Points)
+				if row.Unestimated > 0 {
+					points += color.New(color.Faint).Sprintf(" (+%d unestimated)", row.Unestimated)
+				}
+				cells = append(cells, points)
+			}
+			cells = append(cells, formatAge(row.OldestAt, now))
+			tableRows = append(tableRows, cells)
+		}
+		output.Table(output.TableData{Headers: headers, Rows: tableRows}, false, false)
+
+		unit := fmt.Sprintf("%d issues", len(issues))
+		if !estimated {
+			unit += " (team does not use estimates; counts shown)"
+		}
+		fmt.Printf("\n%s %s open in %s\n", color.New(color.FgGreen).Sprint("✓"), unit, team.Key)
+	},
+}
+
+// writeWorkloadPlaintext writes the workload as a tab-separated table
+func writeWorkloadPlaintext(w io.Writer, rows []*workloadRow, estimated bool, now time.Time) {
+	fmt.Fprintln(w, "# Workload")
+	if estimated {
+		fmt.Fprintln(w, "Assignee\tIssues\tPoints\tUnestimated\tOldest\tOver capacity")
+	} else {
+		fmt.Fprintln(w, "Assignee\tIssues\tOldest\tOver capacity")
+	}
+	for _, row := range rows {
+		over := ""
+		if row.OverCapacity {
+			over = "yes"
+		}
+		if estimated {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%s\t%s\n", row.Assignee, row.Issues, formatPoints(row.Points), row.Unestimated, formatAge(row.OldestAt, now), over)
+		} else {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", row.Assignee, row.Issues, formatAge(row.OldestAt, now), over)
+		}
+	}
+}
+
+func init() {
+	issueCmd.AddCommand(issueWorkloadCmd)
+
+	issueWorkloadCmd.Flags().StringP("team", "t", "", "Team key, name, or ID (required)")
+	issueWorkloadCmd.Flags().String("cycle", "", "Only issues in this cycle: current, next, previous, or a number, name, or ID")
+	issueWorkloadCmd.Flags().String("state-type", "", "Comma-separated state types to count (default: everything not completed or canceled)")
+	issueWorkloadCmd.Flags().Float64("capacity", 0, "Flag assignees above this many points (issues when the team doesn't estimate)")
+	_ = issueWorkloadCmd.MarkFlagRequired("team")
+}
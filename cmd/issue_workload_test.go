@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func workloadIssue(id, assignee string, estimate *float64, created time.Time) api.Issue {
+	issue := api.Issue{Identifier: id, Estimate: estimate, CreatedAt: created}
+	if assignee != "" {
+		issue.Assignee = &api.User{ID: "u-" + assignee, Name: assignee}
+	}
+	return issue
+}
+
+func TestBuildWorkload(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	pts := func(f float64) *float64 { return &f }
+	issues := []api.Issue{
+		workloadIssue("ENG-1", "Ada", pts(3), now.AddDate(0, 0, -2)),
+		workloadIssue("ENG-2", "Ada", pts(5), now.AddDate(0, 0, -20)),
+		workloadIssue("ENG-3", "", pts(13), now.AddDate(0, 0, -1)),
+		workloadIssue("ENG-4", "Grace", pts(2), now.AddDate(0, 0, -5)),
+		workloadIssue("ENG-5", "Grace", nil, now.AddDate(0, 0, -3)),
+		workloadIssue("ENG-6", "Grace", pts(1), now.AddDate(0, 0, -4)),
+		workloadIssue("ENG-7", "Linus", pts(1), now.AddDate(0, 0, -1)),
+	}
+
+	rows := buildWorkload(issues, true, 5)
+	var names []string
+	for _, r := range rows {
+		names = append(names, r.Assignee)
+	}
+	if got, want := strings.Join(names, ","), "Ada,Grace,Linus,Unassigned"; got != want {
+		t.Fatalf("order = %s, want %s (points descending, Unassigned last)", got, want)
+	}
+
+	ada := rows[0]
+	if ada.Points != 8 || ada.Issues != 2 || !ada.OverCapacity {
+		t.Errorf("Ada = %+v, want 8 points over 2 issues, over capacity", ada)
+	}
+	if got := formatAge(ada.OldestAt, now); got != "20d" {
+		t.Errorf("Ada oldest = %s, want 20d", got)
+	}
+	if len(ada.Identifiers) != 2 || ada.Identifiers[0] != "ENG-1" {
+		t.Errorf("Ada identifiers = %v", ada.Identifiers)
+	}
+	if grace := rows[1]; grace.Points != 3 || grace.Unestimated != 1 || grace.OverCapacity {
+		t.Errorf("Grace = %+v, want 3 points, 1 unestimated, within capacity", grace)
+	}
+	if !rows[3].OverCapacity {
+		t.Errorf("Unassigned with 13 points should be over capacity 5")
+	}
+
+	// Without estimates, issue counts drive the order and the capacity check
+	rows = buildWorkload(issues, false, 2)
+	if rows[0].Assignee != "Grace" || !rows[0].OverCapacity || rows[1].OverCapacity {
+		t.Errorf("count mode: got %s first (over=%v), then %s (over=%v); want Grace over capacity first", rows[0].Assignee, rows[0].OverCapacity, rows[1].Assignee, rows[1].OverCapacity)
+	}
+}
+
+func TestParseStateTypes(t *testing.T) {
+	got, err := parseStateTypes("unstarted, started")
+	if err != nil || len(got) != 2 || got[0] != "unstarted" || got[1] != "started" {
+		t.Errorf("parseStateTypes = %v, %v", got, err)
+	}
+	if got, err := parseStateTypes(""); err != nil || len(got) != 0 {
+		t.Errorf("empty: %v, %v", got, err)
+	}
+	if _, err := parseStateTypes("started,doing"); err == nil {
+		t.Error("expected an error for an unknown state type")
+	}
+}
+
+func TestWriteWorkloadPlaintext(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	five := 5.0
+	rows := buildWorkload([]api.Issue{
+		workloadIssue("ENG-1", "Ada", &five, now.AddDate(0, 0, -3)),
+		workloadIssue("ENG-2", "", nil, now.AddDate(0, 0, -1)),
+	}, true, 4)
+
+	var buf bytes.Buffer
+	writeWorkloadPlaintext(&buf, rows, true, now)
+	want := "# Workload\n" +
+		"Assignee\tIssues\tPoints\tUnestimated\tOldest\tOver capacity\n" +
+		"Ada\t1\t5\t0\t3d\tyes\n" +
+		"Unassigned\t1\t0\t1\t1d\t\n"
+	if buf.String() != want {
+		t.Errorf("plaintext =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
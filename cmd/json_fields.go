@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+// jsonFields is what --fields and --strict-fields asked a get command for:
+// its JSON trimmed to the given dot-paths
+type jsonFields struct {
+	Paths  []string
+	Strict bool
+}
+
+// addFieldsFlags registers --fields and --strict-fields on a get command
+func addFieldsFlags(cmd *cobra.Command) {
+	cmd.Flags().String("fields", "", "With --json, keep only these comma-separated dot-paths, e.g. identifier,state.name")
+	cmd.Flags().Bool("strict-fields", false, "Fail when a --fields path isn't in the output instead of leaving it out")
+}
+
+// jsonFieldsFromFlags reads --fields and --strict-fields. They trim JSON
+// output, so they need --json.
+func jsonFieldsFromFlags(cmd *cobra.Command, jsonOut bool) (jsonFields, error) {
+	value, _ := cmd.Flags().GetString("fields")
+	strict, _ := cmd.Flags().GetBool("strict-fields")
+	f := jsonFields{Paths: output.ParseFields(value), Strict: strict}
+	switch {
+	case strict && len(f.Paths) == 0:
+		return f, errors.New("--strict-fields needs --fields")
+	case cmd.Flags().Changed("fields") && len(f.Paths) == 0:
+		return f, errors.New("--fields needs at least one field, e.g. --fields identifier,title")
+	case len(f.Paths) > 0 && !jsonOut:
+		return f, errors.New("--fields trims JSON output; add --json")
+	}
+	return f, nil
+}
+
+// print outputs data as JSON, trimmed to the requested paths if any
+func (f jsonFields) print(data interface{}, plaintext, jsonOut bool) {
+	if len(f.Paths) == 0 {
+		output.JSON(data)
+		return
+	}
+	selected, err := output.SelectFields(data, f.Paths, f.Strict)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		exit(1)
+	}
+	output.JSON(selected)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHermeticIssueGetFields(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("IssueWithFields", `{"issue":{"id":"i1","identifier":"ENG-1","title":"Fix login","url":"https://linear.app/x/issue/ENG-1",
+		"state":{"name":"Todo","type":"unstarted"}}}`)
+	s.Data("Issue", `{"issue":{"id":"i1","identifier":"ENG-1","title":"Fix login","description":"Steps",
+		"labels":{"nodes":[{"id":"l1","name":"bug"},{"id":"l2","name":"auth"}]}}}`)
+
+	// Paths the minimal selection covers are fetched with it
+	r := runMocked(t, "issue", "get", "ENG-1", "--json", "--fields", "identifier,title,state.name")
+	want := "{\n  \"identifier\": \"ENG-1\",\n  \"state\": {\n    \"name\": \"Todo\"\n  },\n  \"title\": \"Fix login\"\n}\n"
+	if r.Exit != 0 || r.Stdout != want {
+		t.Errorf("exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if query := s.Requests()[0].Query; strings.Contains(query, "description") || strings.Contains(query, "IssueCore") {
+		t.Errorf("--fields should narrow the query:\n%s", query)
+	}
+
+	// Anything else falls back to the full issue, arrays filtered per element
+	s.Reset()
+	r = runMocked(t, "issue", "get", "ENG-1", "--json", "--fields", "description,labels.nodes.name,nope")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, `"description": "Steps"`) || strings.Contains(r.Stdout, `"l1"`) ||
+		!strings.Contains(r.Stdout, `"name": "auth"`) || strings.Contains(r.Stdout, "nope") {
+		t.Errorf("exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Issue" {
+		t.Errorf("operations = %s", got)
+	}
+
+	r = runMocked(t, "issue", "get", "ENG-1", "--json", "--fields", "description,nope", "--strict-fields")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "unknown field(s): nope") {
+		t.Errorf("--strict-fields exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, args := range [][]string{
+		{"issue", "get", "ENG-1", "--fields", "title"},
+		{"issue", "get", "ENG-1", "--json", "--strict-fields"},
+		{"issue", "get", "ENG-1", "--json", "--fields", ","},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+}
+
+func TestHermeticProjectGetFields(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Project", `{"project":`+mockFixture(t, "project_get")+`}`)
+
+	r := runMocked(t, "project", "get", "p1", "--json", "--fields", "name")
+	if r.Exit != 0 || !strings.HasPrefix(r.Stdout, "{\n  \"name\": ") || strings.Count(r.Stdout, "\n") != 3 {
+		t.Errorf("exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
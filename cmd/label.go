@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -28,7 +31,17 @@ var labelListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List labels",
-	Long:    `List issue labels, optionally filtered by team.`,
+	Long: `List issue labels, optionally filtered by team.
+
+--counts adds the number of (non-archived) issues carrying each label. The API
+has no count field, so this makes at least one request per label; narrow the
+list with --team or --limit on large workspaces. --unused shows only labels with
+no issues (group labels are skipped, since issues carry their children), and
+--older-than restricts the list to labels created before a time.
+
+Examples:
+  linear-cli label list --team ENG --counts
+  linear-cli label list --unused --older-than 6_months_ago`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -36,7 +49,7 @@ var labelListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -45,15 +58,45 @@ var labelListCmd = &cobra.Command{
 
 		filter := map[string]interface{}{}
 		if teamKey != "" {
-			filter["team"] = map[string]interface{}{
-				"key": map[string]interface{}{"eq": teamKey},
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}
+		}
+		if olderThan, _ := cmd.Flags().GetString("older-than"); olderThan != "" {
+			createdBefore, err := utils.ParseTimeExpression(olderThan)
+			if err != nil {
+				output.Error(fmt.Sprintf("Invalid older-than value: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			if createdBefore != "" {
+				filter["createdAt"] = map[string]interface{}{"lt": createdBefore}
 			}
 		}
 
 		labels, err := client.GetLabels(context.Background(), filter, limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list labels: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		warnIfTruncated(cmd, labels.PageInfo, len(labels.Nodes))
+
+		counts, _ := cmd.Flags().GetBool("counts")
+		unused, _ := cmd.Flags().GetBool("unused")
+		if counts || unused {
+			if len(labels.Nodes) > labelCountWarnThreshold {
+				fmt.Fprintf(os.Stderr, "%s counting issues for %d labels takes at least %d API requests; narrow with --team or --limit\n",
+					color.New(color.FgYellow).Sprint("⚠"), len(labels.Nodes), len(labels.Nodes))
+			}
+			if err := countLabelIssues(client, labels.Nodes); err != nil {
+				output.Error(fmt.Sprintf("Failed to count label issues: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+		if unused {
+			labels.Nodes = unusedLabels(labels.Nodes)
 		}
 
 		if jsonOut {
@@ -72,7 +115,11 @@ var labelListCmd = &cobra.Command{
 
 		if plaintext {
 			fmt.Println("# Labels")
-			fmt.Println("Name\tColor\tGroup\tDescription\tParent\tTeam")
+			header := "Name\tColor\tGroup\tDescription\tParent\tTeam"
+			if counts || unused {
+				header += "\tIssues"
+			}
+			fmt.Println(header)
 			for _, l := range labels.Nodes {
 				desc := ""
 				if l.Description != nil {
@@ -90,10 +137,17 @@ var labelListCmd = &cobra.Command{
 				if l.IsGroup {
 					isGroup = "yes"
 				}
-				fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\n", l.Name, l.Color, isGroup, desc, parent, team)
+				line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", l.Name, l.Color, isGroup, desc, parent, team)
+				if l.IssueCount != nil {
+					line += fmt.Sprintf("\t%d", *l.IssueCount)
+				}
+				fmt.Println(line)
 			}
 		} else {
 			headers := []string{"Name", "Color", "Group", "Description", "Parent", "Team"}
+			if counts || unused {
+				headers = append(headers, "Issues")
+			}
 			rows := [][]string{}
 
 			for _, l := range labels.Nodes {
@@ -114,14 +168,18 @@ var labelListCmd = &cobra.Command{
 					isGroup = "✓"
 				}
 
-				rows = append(rows, []string{
-					color.New(color.FgWhite, color.Bold).Sprint(l.Name),
+				row := []string{
+					output.ColorChip(l.Name, l.Color, color.New(color.FgWhite, color.Bold)),
 					l.Color,
 					isGroup,
 					desc,
 					parent,
 					team,
-				})
+				}
+				if l.IssueCount != nil {
+					row = append(row, fmt.Sprintf("%d", *l.IssueCount))
+				}
+				rows = append(rows, row)
 			}
 
 			output.Table(output.TableData{
@@ -136,6 +194,54 @@ var labelListCmd = &cobra.Command{
 	},
 }
 
+// labelCountConcurrency bounds the per-label issue counts in label list --counts
+const labelCountConcurrency = 8
+
+// labelCountWarnThreshold is the label count above which --counts warns about API cost
+const labelCountWarnThreshold = 50
+
+// countLabelIssues sets IssueCount on every label, counting concurrently
+func countLabelIssues(client *api.Client, labels []api.Label) error {
+	errs := make([]error, len(labels))
+	sem := make(chan struct{}, labelCountConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range labels {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			count, err := client.CountLabelIssues(context.Background(), labels[i].ID)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", labels[i].Name, err)
+				return
+			}
+			labels[i].IssueCount = &count
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unusedLabels keeps labels with a zero IssueCount. Group labels are dropped:
+// issues carry their child labels, never the group itself.
+func unusedLabels(labels []api.Label) []api.Label {
+	unused := []api.Label{}
+	for _, l := range labels {
+		if !l.IsGroup && l.IssueCount != nil && *l.IssueCount == 0 {
+			unused = append(unused, l)
+		}
+	}
+	return unused
+}
+
 var labelCreateCmd = &cobra.Command{
 	Use:     "create",
 	Aliases: []string{"new"},
@@ -143,12 +249,12 @@ var labelCreateCmd = &cobra.Command{
 	Long:    `Create a new issue label.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -156,7 +262,11 @@ var labelCreateCmd = &cobra.Command{
 		name, _ := cmd.Flags().GetString("name")
 		labelColor, _ := cmd.Flags().GetString("color")
 		teamID, _ := cmd.Flags().GetString("team-id")
-		description, _ := cmd.Flags().GetString("description")
+		description, _, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 		parentID, _ := cmd.Flags().GetString("parent-id")
 		isGroup, _ := cmd.Flags().GetBool("is-group")
 
@@ -182,9 +292,13 @@ var labelCreateCmd = &cobra.Command{
 		label, err := client.CreateLabel(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create label: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "label", label.ID, label.Name, &journal.Inverse{Action: "delete"})
 
+		if printCreated(cmd, createdEntity{Type: "label", ID: label.ID, Name: label.Name}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(label)
 		} else if plaintext {
@@ -210,7 +324,7 @@ var labelUpdateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -223,8 +337,12 @@ var labelUpdateCmd = &cobra.Command{
 			c, _ := cmd.Flags().GetString("color")
 			input["color"] = c
 		}
-		if cmd.Flags().Changed("description") {
-			d, _ := cmd.Flags().GetString("description")
+		d, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input["description"] = d
 		}
 		if cmd.Flags().Changed("parent-id") {
@@ -237,14 +355,22 @@ var labelUpdateCmd = &cobra.Command{
 		}
 		if len(input) == 0 {
 			output.Error("No fields to update. Use --name, --color, --description, --parent-id, or --is-group.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		// Capture current values so the update can be undone
+		var inverse *journal.Inverse
+		idFilter := map[string]interface{}{"id": map[string]interface{}{"eq": labelID}}
+		if before, err := client.GetLabels(context.Background(), idFilter, 1, ""); err == nil && len(before.Nodes) == 1 {
+			inverse = restoreInverse(before.Nodes[0], input)
 		}
 
 		label, err := client.UpdateLabel(context.Background(), labelID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update label: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "label", label.ID, label.Name, inverse)
 
 		if jsonOut {
 			output.JSON(label)
@@ -269,15 +395,16 @@ var labelDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		err = client.DeleteLabel(context.Background(), labelID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete label: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "label", labelID, "", nil)
 
 		output.Success("Deleted label", plaintext, jsonOut)
 	},
@@ -292,21 +419,27 @@ func init() {
 
 	// List flags
 	labelListCmd.Flags().IntP("limit", "l", 50, "Maximum number of labels to return")
-	labelListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	labelListCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID")
+	labelListCmd.Flags().Bool("counts", false, "Show the number of issues carrying each label (one or more API requests per label)")
+	labelListCmd.Flags().Bool("unused", false, "Only show labels with no issues (implies --counts)")
+	labelListCmd.Flags().String("older-than", "", "Only show labels created before this time (e.g. 6_months_ago, 2025-01-01)")
 
 	// Create flags
 	labelCreateCmd.Flags().StringP("name", "n", "", "Label name (required)")
 	labelCreateCmd.Flags().StringP("color", "c", "", "Label color (hex, e.g., #e11d48)")
 	labelCreateCmd.Flags().StringP("description", "d", "", "Label description")
+	labelCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	labelCreateCmd.Flags().String("team-id", "", "Team ID to scope the label to")
 	labelCreateCmd.Flags().String("parent-id", "", "Parent label ID (for nested labels)")
 	labelCreateCmd.Flags().Bool("is-group", false, "Whether this is a group label (container for child labels)")
+	addPorcelainFlag(labelCreateCmd)
 	_ = labelCreateCmd.MarkFlagRequired("name")
 
 	// Update flags
 	labelUpdateCmd.Flags().StringP("name", "n", "", "New label name")
 	labelUpdateCmd.Flags().StringP("color", "c", "", "New label color (hex)")
 	labelUpdateCmd.Flags().StringP("description", "d", "", "New label description")
+	labelUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	labelUpdateCmd.Flags().String("parent-id", "", "New parent label ID (for nested labels)")
 	labelUpdateCmd.Flags().Bool("is-group", false, "Whether this is a group label")
 }
@@ -0,0 +1,496 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// labelViewRef is a custom view whose filters mention a label
+type labelViewRef struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Team   string   `json:"team,omitempty"`
+	Fields []string `json:"fields"` // filter paths, e.g. filterData.labels.name.eq
+}
+
+// labelRenameResult is the JSON output of label rename and label merge
+type labelRenameResult struct {
+	Label      *api.Label     `json:"label"`
+	MergedFrom *api.Label     `json:"mergedFrom,omitempty"`
+	Relabeled  *int           `json:"relabeledIssues,omitempty"`
+	Views      []labelViewRef `json:"views"`
+}
+
+var labelRenameCmd = &cobra.Command{
+	Use:   "rename OLD NEW",
+	Short: "Rename a label and report views that reference it",
+	Long: `Rename a label, then scan every custom view's filters for references to
+the old label ID or name and list the views that may need attention. Views
+are never modified.
+
+OLD is a label name or ID. --team picks the team's label when several teams
+have one by that name; without it, a workspace label is preferred.
+
+If a label named NEW already exists in the same scope the rename fails, unless
+--merge-into-existing is given: then OLD is merged into NEW as by 'label merge'.
+
+Examples:
+  linear-cli label rename bug Bug --team ENG
+  linear-cli label rename "needs-triage" "Triage" --merge-into-existing
+  linear-cli label rename bug defect --json`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		newName := strings.TrimSpace(args[1])
+		teamKey, _ := cmd.Flags().GetString("team")
+		merge, _ := cmd.Flags().GetBool("merge-into-existing")
+
+		if newName == "" {
+			output.Error("NEW must not be empty", plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		if teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			teamKey = team.Key
+		}
+
+		old, err := resolveLabel(client, args[0], teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		sameName, err := labelsNamed(client, newName)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to check for an existing '%s' label: %v", newName, err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if existing := labelNameConflict(sameName, old); existing != nil {
+			if !merge {
+				output.Error(fmt.Sprintf("A label named '%s' already exists in %s (%s); use --merge-into-existing to merge '%s' into it",
+					existing.Name, labelScope(existing), existing.ID, old.Name), plaintext, jsonOut)
+				exit(1)
+			}
+			runLabelMerge(cmd, client, old, existing, plaintext, jsonOut)
+			return
+		}
+
+		input := map[string]interface{}{"name": newName}
+		inverse := restoreInverse(*old, input)
+		label, err := client.UpdateLabel(context.Background(), old.ID, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to rename label: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "label", label.ID, label.Name, inverse)
+
+		views, err := findLabelViewRefs(client, old.ID, old.Name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: renamed the label but could not scan custom views: %v\n", err)
+		}
+
+		if jsonOut {
+			output.JSON(labelRenameResult{Label: label, Views: views})
+			return
+		}
+		output.Success(fmt.Sprintf("Renamed label %s to %s", old.Name,
+			color.New(color.FgWhite, color.Bold).Sprint(label.Name)), plaintext, jsonOut)
+		printLabelViewRefs(views, old.Name, plaintext)
+	},
+}
+
+var labelMergeCmd = &cobra.Command{
+	Use:   "merge FROM INTO",
+	Short: "Move every issue from one label to another and delete the first",
+	Long: `Merge label FROM into label INTO: every issue carrying FROM gets INTO
+instead, then FROM is deleted. If any issue fails to update, FROM is kept so
+the merge can be re-run. Custom views that reference FROM are listed, since
+their filters no longer match anything; views are never modified.
+
+FROM and INTO are label names or IDs; --team picks the team's label when
+several teams have one by that name. Group labels can't be merged.
+
+Examples:
+  linear-cli label merge defect Bug --team ENG
+  linear-cli label merge LABEL-ID OTHER-LABEL-ID --json`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		teamKey, _ := cmd.Flags().GetString("team")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		if teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			teamKey = team.Key
+		}
+
+		from, err := resolveLabel(client, args[0], teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		into, err := resolveLabel(client, args[1], teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		runLabelMerge(cmd, client, from, into, plaintext, jsonOut)
+	},
+}
+
+// runLabelMerge relabels every issue carrying from with into, deletes from,
+// and reports the custom views that referenced it
+func runLabelMerge(cmd *cobra.Command, client *api.Client, from, into *api.Label, plaintext, jsonOut bool) {
+	if from.ID == into.ID {
+		output.Error(fmt.Sprintf("'%s' and '%s' are the same label", from.Name, into.Name), plaintext, jsonOut)
+		exit(1)
+	}
+	if from.IsGroup || into.IsGroup {
+		output.Error("Group labels can't be merged; merge their child labels instead", plaintext, jsonOut)
+		exit(1)
+	}
+
+	issueIDs, err := client.GetLabelIssueIDs(context.Background(), from.ID)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list issues labeled '%s': %v", from.Name, err), plaintext, jsonOut)
+		exit(1)
+	}
+	if failed := relabelIssues(client, issueIDs, from.ID, into.ID); len(failed) > 0 {
+		output.Error(fmt.Sprintf("Failed to relabel %d of %d issues, so '%s' was kept (re-run to retry): %v",
+			len(failed), len(issueIDs), from.Name, failed[0]), plaintext, jsonOut)
+		exit(1)
+	}
+
+	if err := client.DeleteLabel(context.Background(), from.ID); err != nil {
+		output.Error(fmt.Sprintf("Relabeled %d issues but failed to delete '%s': %v", len(issueIDs), from.Name, err), plaintext, jsonOut)
+		exit(1)
+	}
+	recordOperation(cmd, "label", from.ID, from.Name, nil)
+
+	views, err := findLabelViewRefs(client, from.ID, from.Name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: merged the label but could not scan custom views: %v\n", err)
+	}
+
+	if jsonOut {
+		relabeled := len(issueIDs)
+		output.JSON(labelRenameResult{Label: into, MergedFrom: from, Relabeled: &relabeled, Views: views})
+		return
+	}
+	output.Success(fmt.Sprintf("Merged label %s into %s (%d issues relabeled)", from.Name,
+		color.New(color.FgWhite, color.Bold).Sprint(into.Name), len(issueIDs)), plaintext, jsonOut)
+	printLabelViewRefs(views, from.Name, plaintext)
+}
+
+// relabelIssues swaps label from for into on each issue, concurrently on
+// the shared pool under --progress, and returns the errors of the updates
+// that failed
+func relabelIssues(client *api.Client, issueIDs []string, from, into string) []error {
+	input := map[string]interface{}{
+		"addedLabelIds":   []string{into},
+		"removedLabelIds": []string{from},
+	}
+	entity := func(i int) string { return issueIDs[i] }
+	errs := runWithProgress("Relabeling issues", len(issueIDs), entity, func(ctx context.Context, i int) error {
+		if _, err := client.UpdateIssue(ctx, issueIDs[i], input); err != nil {
+			return fmt.Errorf("issue %s: %w", issueIDs[i], err)
+		}
+		return nil
+	})
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+	return failed
+}
+
+// labelsNamed returns every label whose name matches, ignoring case
+func labelsNamed(client *api.Client, name string) ([]api.Label, error) {
+	filter := map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": name}}
+	labels, err := client.GetLabels(context.Background(), filter, 250, "")
+	if err != nil {
+		return nil, err
+	}
+	return labels.Nodes, nil
+}
+
+// fetchAllLabels pages through every label in the workspace, team labels
+// included
+func fetchAllLabels(client *api.Client) ([]api.Label, error) {
+	var all []api.Label
+	after := ""
+	for {
+		page, err := client.GetLabels(context.Background(), nil, 250, after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// labelsNamedIn returns the labels whose name matches, ignoring case
+func labelsNamedIn(labels []api.Label, name string) []api.Label {
+	var named []api.Label
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			named = append(named, l)
+		}
+	}
+	return named
+}
+
+// resolveLabel finds a label by ID or name. Names can repeat across teams, so
+// teamKey picks one; without it a workspace label wins, then a label that is
+// the only one by that name.
+func resolveLabel(client *api.Client, ref, teamKey string) (*api.Label, error) {
+	if isUUID(ref) {
+		idFilter := map[string]interface{}{"id": map[string]interface{}{"eq": ref}}
+		labels, err := client.GetLabels(context.Background(), idFilter, 1, "")
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get label: %v", err)
+		}
+		if len(labels.Nodes) == 0 {
+			return nil, fmt.Errorf("Label '%s' not found", ref)
+		}
+		return &labels.Nodes[0], nil
+	}
+
+	labels, err := labelsNamed(client, ref)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find label '%s': %v", ref, err)
+	}
+	if len(labels) == 0 {
+		// No label has the name; list them all only to suggest the closest
+		var candidates []string
+		if all, err := client.GetLabels(context.Background(), nil, 250, ""); err == nil {
+			for _, l := range all.Nodes {
+				candidates = append(candidates, l.Name)
+			}
+		}
+		return nil, utils.NewNotFoundError(fmt.Sprintf("Label '%s' not found", ref), ref, candidates)
+	}
+	return pickLabel(labels, ref, teamKey)
+}
+
+// pickLabel chooses among labels sharing a name (see resolveLabel)
+func pickLabel(labels []api.Label, name, teamKey string) (*api.Label, error) {
+	var workspace *api.Label
+	var teamLabels []*api.Label
+	for i := range labels {
+		l := &labels[i]
+		if l.Team == nil {
+			workspace = l
+			continue
+		}
+		if teamKey == "" || strings.EqualFold(l.Team.Key, teamKey) {
+			teamLabels = append(teamLabels, l)
+		}
+	}
+
+	switch {
+	case teamKey != "" && len(teamLabels) > 0:
+		return teamLabels[0], nil
+	case workspace != nil:
+		return workspace, nil
+	case len(teamLabels) == 1:
+		return teamLabels[0], nil
+	case len(teamLabels) > 1:
+		var teams []string
+		for _, l := range teamLabels {
+			teams = append(teams, l.Team.Key)
+		}
+		sort.Strings(teams)
+		return nil, fmt.Errorf("Label '%s' exists in several teams (%s); pick one with --team", name, strings.Join(teams, ", "))
+	case teamKey != "":
+		return nil, fmt.Errorf("Label '%s' not found in team %s or the workspace", name, teamKey)
+	}
+	return nil, fmt.Errorf("Label '%s' not found", name)
+}
+
+// labelNameConflict returns a label other than old that the new name would
+// clash with: one in old's team, or a workspace label. Renaming a workspace
+// label clashes with any team's label of that name.
+func labelNameConflict(sameName []api.Label, old *api.Label) *api.Label {
+	for i := range sameName {
+		l := &sameName[i]
+		if l.ID == old.ID {
+			continue
+		}
+		if l.Team == nil || old.Team == nil || strings.EqualFold(l.Team.Key, old.Team.Key) {
+			return l
+		}
+	}
+	return nil
+}
+
+// labelScope names where a label lives, e.g. "team ENG" or "the workspace"
+func labelScope(l *api.Label) string {
+	if l.Team != nil {
+		return "team " + l.Team.Key
+	}
+	return "the workspace"
+}
+
+// findLabelViewRefs scans every custom view's issue, project, and initiative
+// filters for the label
+func findLabelViewRefs(client *api.Client, labelID, labelName string) ([]labelViewRef, error) {
+	refs := []labelViewRef{}
+	after := ""
+	for {
+		page, err := client.GetCustomViews(context.Background(), nil, 100, after)
+		if err != nil {
+			return refs, err
+		}
+		for _, v := range page.Nodes {
+			var fields []string
+			for _, f := range []struct {
+				name string
+				data map[string]interface{}
+			}{
+				{"filterData", v.FilterData},
+				{"projectFilterData", v.ProjectFilterData},
+				{"initiativeFilterData", v.InitiativeFilterData},
+			} {
+				if f.data != nil {
+					fields = append(fields, labelFilterRefs(f.data, labelID, labelName, f.name, false)...)
+				}
+			}
+			if len(fields) == 0 {
+				continue
+			}
+			ref := labelViewRef{ID: v.ID, Name: v.Name, Fields: fields}
+			if v.Team != nil {
+				ref.Team = v.Team.Key
+			}
+			refs = append(refs, ref)
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return refs, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// labelFilterRefs walks filter JSON of any shape and returns the path of
+// every value that references the label: its ID anywhere, or its name
+// (ignoring case) below a key mentioning labels, so a title filter that
+// happens to contain the word isn't reported
+func labelFilterRefs(node interface{}, labelID, labelName, path string, underLabel bool) []string {
+	var refs []string
+	switch v := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			child := path + "." + k
+			if path == "" {
+				child = k
+			}
+			refs = append(refs, labelFilterRefs(v[k], labelID, labelName, child, underLabel || strings.Contains(strings.ToLower(k), "label"))...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			refs = append(refs, labelFilterRefs(item, labelID, labelName, fmt.Sprintf("%s[%d]", path, i), underLabel)...)
+		}
+	case string:
+		if v == labelID || (underLabel && strings.EqualFold(v, labelName)) {
+			refs = append(refs, path)
+		}
+	}
+	return refs
+}
+
+// printLabelViewRefs lists the views whose filters mention a renamed or
+// merged label
+func printLabelViewRefs(views []labelViewRef, labelName string, plaintext bool) {
+	if len(views) == 0 {
+		if plaintext {
+			fmt.Printf("No custom views reference '%s'\n", labelName)
+		} else {
+			fmt.Printf("%s No custom views reference '%s'\n", color.New(color.Faint).Sprint("ℹ"), labelName)
+		}
+		return
+	}
+
+	if plaintext {
+		fmt.Printf("\n# Views referencing '%s'\n", labelName)
+		fmt.Println("Name\tTeam\tID\tFields")
+		for _, v := range views {
+			fmt.Printf("%s\t%s\t%s\t%s\n", v.Name, v.Team, v.ID, strings.Join(v.Fields, ", "))
+		}
+		return
+	}
+
+	fmt.Printf("\n%s %d custom views reference '%s' and may need attention:\n",
+		color.New(color.FgYellow).Sprint("⚠"), len(views), labelName)
+	rows := make([][]string, len(views))
+	for i, v := range views {
+		rows[i] = []string{
+			color.New(color.FgWhite, color.Bold).Sprint(v.Name),
+			v.Team,
+			strings.Join(v.Fields, ", "),
+			v.ID,
+		}
+	}
+	output.Table(output.TableData{
+		Headers: []string{"View", "Team", "Filter", "ID"},
+		Rows:    rows,
+	}, false, false)
+}
+
+func init() {
+	labelCmd.AddCommand(labelRenameCmd)
+	labelCmd.AddCommand(labelMergeCmd)
+
+	labelRenameCmd.Flags().StringP("team", "t", "", "Team whose label to rename, when several teams have one by that name")
+	labelRenameCmd.Flags().Bool("merge-into-existing", false, "If a label named NEW already exists, merge OLD into it instead of failing")
+
+	labelMergeCmd.Flags().StringP("team", "t", "", "Team whose labels to use, when several teams have one by that name")
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestUnusedLabels(t *testing.T) {
+	zero, three := 0, 3
+	labels := []api.Label{
+		{Name: "stale", IssueCount: &zero},
+		{Name: "bug", IssueCount: &three},
+		{Name: "Area", IsGroup: true, IssueCount: &zero},
+		{Name: "uncounted"},
+	}
+
+	got := unusedLabels(labels)
+	if len(got) != 1 || got[0].Name != "stale" {
+		t.Errorf("unusedLabels = %+v, want only 'stale'", got)
+	}
+	if got := unusedLabels(nil); got == nil {
+		t.Error("unusedLabels(nil) should be an empty slice so --json prints []")
+	}
+}
+
+func TestLabelFilterRefs(t *testing.T) {
+	var filter map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"and": [
+			{"labels": {"some": {"name": {"eqIgnoreCase": "Bug"}}}},
+			{"or": [
+				{"labels": {"id": {"in": ["l-other", "l-bug"]}}},
+				{"title": {"contains": "bug"}}
+			]},
+			{"assignee": {"id": {"eq": "l-bug"}}}
+		],
+		"priority": {"eq": 1}
+	}`), &filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := labelFilterRefs(filter, "l-bug", "bug", "filterData", false)
+	want := []string{
+		"filterData.and[0].labels.some.name.eqIgnoreCase",
+		"filterData.and[1].or[0].labels.id.in[1]",
+		"filterData.and[2].assignee.id.eq",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("labelFilterRefs =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if got := labelFilterRefs(filter, "l-none", "Feature", "filterData", false); len(got) != 0 {
+		t.Errorf("unrelated label matched %v", got)
+	}
+}
+
+func TestPickLabel(t *testing.T) {
+	eng, ops := &api.Team{Key: "ENG"}, &api.Team{Key: "OPS"}
+	teamOnly := []api.Label{{ID: "e", Name: "bug", Team: eng}, {ID: "o", Name: "Bug", Team: ops}}
+	withWorkspace := append([]api.Label{{ID: "w", Name: "Bug"}}, teamOnly...)
+
+	tests := []struct {
+		labels  []api.Label
+		team    string
+		want    string
+		wantErr string
+	}{
+		{teamOnly, "ops", "o", ""},
+		{withWorkspace, "", "w", ""},
+		{withWorkspace, "ENG", "e", ""},
+		{withWorkspace, "DESIGN", "w", ""},
+		{teamOnly[:1], "", "e", ""},
+		{teamOnly, "", "", "several teams (ENG, OPS)"},
+		{teamOnly, "DESIGN", "", "not found in team DESIGN"},
+		{nil, "", "", "not found"},
+	}
+	for _, tt := range tests {
+		got, err := pickLabel(tt.labels, "bug", tt.team)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("pickLabel(team %q) error = %v, want %q", tt.team, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got.ID != tt.want {
+			t.Errorf("pickLabel(team %q) = %+v, %v; want %s", tt.team, got, err, tt.want)
+		}
+	}
+}
+
+func TestLabelNameConflict(t *testing.T) {
+	eng, ops := &api.Team{Key: "ENG"}, &api.Team{Key: "OPS"}
+	old := &api.Label{ID: "old", Name: "defect", Team: eng}
+
+	if got := labelNameConflict([]api.Label{{ID: "x", Name: "Bug", Team: ops}}, old); got != nil {
+		t.Errorf("another team's label conflicted: %+v", got)
+	}
+	if got := labelNameConflict([]api.Label{{ID: "x", Name: "Bug", Team: eng}}, old); got == nil || got.ID != "x" {
+		t.Errorf("same-team label didn't conflict: %+v", got)
+	}
+	if got := labelNameConflict([]api.Label{{ID: "w", Name: "Bug"}}, old); got == nil {
+		t.Error("workspace label didn't conflict")
+	}
+	// Changing only the case renames the label onto itself
+	if got := labelNameConflict([]api.Label{*old}, old); got != nil {
+		t.Errorf("label conflicted with itself: %+v", got)
+	}
+	workspaceOld := &api.Label{ID: "wold", Name: "defect"}
+	if got := labelNameConflict([]api.Label{{ID: "x", Name: "Bug", Team: ops}}, workspaceOld); got == nil {
+		t.Error("renaming a workspace label didn't conflict with a team label")
+	}
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// scriptOutput is what --ids-only or --count asked a list command for:
+// bare values for shell scripts instead of a rendered list
+type scriptOutput struct {
+	IDsOnly bool
+	Count   bool
+}
+
+// addScriptFlags registers --ids-only and --count on a list command; ids
+// says what --ids-only prints, e.g. "issue identifiers"
+func addScriptFlags(cmd *cobra.Command, ids string) {
+	cmd.Flags().Bool("ids-only", false, "Print only the "+ids+", one per line")
+	cmd.Flags().Bool("count", false, "Print only the number of matches (counts every match, ignoring --limit)")
+	cmd.MarkFlagsMutuallyExclusive("ids-only", "count")
+}
+
+// scriptOutputFromFlags reads --ids-only and --count. They print bare values,
+// so they can't be combined with --json or --plaintext.
+func scriptOutputFromFlags(cmd *cobra.Command) (scriptOutput, error) {
+	idsOnly, _ := cmd.Flags().GetBool("ids-only")
+	count, _ := cmd.Flags().GetBool("count")
+	s := scriptOutput{IDsOnly: idsOnly, Count: count}
+	if s.enabled() && (cmd.Flags().Changed("json") || cmd.Flags().Changed("json-envelope") || cmd.Flags().Changed("plaintext")) {
+		return s, errors.New("--ids-only and --count print bare values and can't be combined with --json or --plaintext")
+	}
+	return s, nil
+}
+
+func (s scriptOutput) enabled() bool {
+	return s.IDsOnly || s.Count
+}
+
+// scriptIssueFields is the issue field level --ids-only and --count fetch.
+// Sorting by anything but time, or re-checking due dates, needs the table
+// fields; otherwise identifiers are enough.
+func scriptIssueFields(sorting issueSort, breached bool) api.IssueFields {
+	if breached || (sorting.key != nil && sorting.OrderBy == "") {
+		return api.IssueFieldsTable
+	}
+	return api.IssueFieldsIDs
+}
+
+// printIssues prints the issues' identifiers or how many there are
+func (s scriptOutput) printIssues(issues []api.Issue) {
+	if s.Count {
+		fmt.Println(len(issues))
+		return
+	}
+	for _, issue := range issues {
+		fmt.Println(issue.Identifier)
+	}
+}
+
+// printProjects prints the projects' IDs or how many there are
+func (s scriptOutput) printProjects(projects []api.Project) {
+	if s.Count {
+		fmt.Println(len(projects))
+		return
+	}
+	for _, project := range projects {
+		fmt.Println(project.ID)
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHermeticIssueListIDsOnlyAndCount(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("Issues", `{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1"},{"id":"i2","identifier":"ENG-2"}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	s.DataFor("Issues", map[string]interface{}{"after": "c1"}, `{"issues":{"nodes":[{"id":"i3","identifier":"ENG-3"}],
+		"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "issue", "list", "--team", "ENG", "--ids-only", "--limit", "2")
+	if r.Exit != 0 || r.Stdout != "ENG-1\nENG-2\n" {
+		t.Fatalf("--ids-only exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	query := requestsFor(s, "Issues")[0].Query
+	if strings.Contains(query, "title") || strings.Contains(query, "IssueCore") {
+		t.Errorf("--ids-only should only select identifiers:\n%s", query)
+	}
+
+	// --count follows every page, whatever --limit says
+	s.Reset()
+	if r := runMocked(t, "issue", "list", "--team", "ENG", "--count", "--limit", "2"); r.Exit != 0 || r.Stdout != "3\n" {
+		t.Errorf("--count exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	issueReqs := requestsFor(s, "Issues")
+	if got := len(issueReqs); got != 2 {
+		t.Errorf("--count made %d issue requests, want 2", got)
+	}
+	if filter := mustJSON(t, issueReqs[0].Variables["filter"]); !strings.Contains(filter, `"team-eng"`) {
+		t.Errorf("--count dropped the team filter: %s", filter)
+	}
+
+	for _, args := range [][]string{
+		{"issue", "list", "--ids-only", "--json"},
+		{"issue", "list", "--count", "--plaintext"},
+		{"issue", "list", "--ids-only", "--count"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+}
+
+func TestHermeticProjectListIDsOnlyAndCount(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectIDs", `{"projects":{"nodes":[{"id":"p1"},{"id":"p2"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("ProjectCount", `{"projects":{"nodes":[{"id":"p1"},{"id":"p2"},{"id":"p3"}],"pageInfo":{"hasNextPage":false}}}`)
+
+	if r := runMocked(t, "project", "list", "--all-time", "--ids-only"); r.Exit != 0 || r.Stdout != "p1\np2\n" {
+		t.Errorf("--ids-only exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if r := runMocked(t, "project", "list", "--all-time", "--count"); r.Exit != 0 || r.Stdout != "3\n" {
+		t.Errorf("--count exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "ProjectIDs,ProjectCount" {
+		t.Errorf("operations = %s", got)
+	}
+}
+
+func TestHermeticViewRunCount(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"Mine","modelName":"Issue"}}`)
+	s.Data("CustomViewIssues", `{"customView":{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+	s.DataFor("CustomViewIssues", map[string]interface{}{"after": "c1"}, `{"customView":{"issues":{"nodes":[{"id":"i2","identifier":"ENG-2"}],"pageInfo":{"hasNextPage":false}}}}`)
+
+	if r := runMocked(t, "view", "run", "v1", "--count"); r.Exit != 0 || r.Stdout != "2\n" {
+		t.Errorf("--count exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if r := runMocked(t, "view", "run", "v1", "--ids-only", "--limit", "1"); r.Exit != 0 || r.Stdout != "ENG-1\n" {
+		t.Errorf("--ids-only exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// markdownDoc writes the plaintext (markdown) output of the get commands.
+// The layout is fixed: "#" for the entity, "##" for sections in a set order,
+// "###" for items within a section. With full set, sections without data are
+// still written, with a "None" body, so the output has the same shape for
+// every entity.
+type markdownDoc struct {
+	w    io.Writer
+	full bool
+}
+
+func (d *markdownDoc) printf(format string, a ...interface{}) {
+	fmt.Fprintf(d.w, format, a...)
+}
+
+// title writes the document's top-level heading
+func (d *markdownDoc) title(text string) {
+	d.printf("# %s\n", text)
+}
+
+// section writes a "##" section when has is set (or always with --full)
+func (d *markdownDoc) section(name string, has bool, body func()) {
+	if !has && !d.full {
+		return
+	}
+	d.printf("\n## %s\n\n", name)
+	if !has {
+		d.printf("None\n")
+		return
+	}
+	body()
+}
+
+// field writes a "- **Label**: value" line
+func (d *markdownDoc) field(label, value string) {
+	d.printf("- **%s**: %s\n", label, value)
+}
+
+// body writes user-authored markdown nested under a heading of the given level
+func (d *markdownDoc) body(text string, level int) {
+	d.printf("%s\n", nestMarkdown(strings.TrimRight(text, "\n"), level))
+}
+
+// markdownEscaper escapes characters that would otherwise start emphasis,
+// code, links, or HTML in inline text such as titles
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	`*`, `\*`,
+	`_`, `\_`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`<`, `\<`,
+	`>`, `\>`,
+	"\r\n", " ",
+	"\n", " ",
+)
+
+// escapeMarkdown makes a title or name safe to embed in a heading or list item
+func escapeMarkdown(s string) string {
+	s = markdownEscaper.Replace(s)
+	if strings.HasPrefix(s, "#") {
+		s = `\` + s
+	}
+	return s
+}
+
+// nestMarkdown pushes ATX headings in user-authored markdown below level so
+// a description's "# Heading" can't break out of the section it's printed in.
+// Fenced code blocks are left alone.
+func nestMarkdown(text string, level int) string {
+	lines := strings.Split(text, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		hashes := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		if hashes > 6 || (len(trimmed) > hashes && trimmed[hashes] != ' ') {
+			continue
+		}
+		depth := hashes + level
+		if depth > 6 {
+			depth = 6
+		}
+		lines[i] = strings.Repeat("#", depth) + trimmed[hashes:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// issueTaskItem renders an issue as a markdown task-list item. Checkboxes
+// only express done/not done, so started and canceled get a textual suffix.
+func issueTaskItem(issue api.Issue) string {
+	item := fmt.Sprintf("- %s %s: %s", issueStateCheckbox(issue.State), issue.Identifier, escapeMarkdown(issue.Title))
+	if issue.State != nil && (issue.State.Type == "started" || issue.State.Type == "canceled") {
+		item += fmt.Sprintf(" (%s)", issue.State.Type)
+	}
+	return item
+}
+
+// descriptionPreview returns the first line of a description for list items,
+// with an ellipsis when more follows
+func descriptionPreview(description string) string {
+	text := strings.TrimSpace(description)
+	first, rest, _ := strings.Cut(text, "\n")
+	preview := escapeMarkdown(strings.TrimSpace(first))
+	if strings.TrimSpace(rest) != "" {
+		preview += " …"
+	}
+	return preview
+}
+
+const (
+	// defaultDescriptionLines is how many description lines plaintext list
+	// output shows unless --description-lines says otherwise
+	defaultDescriptionLines = 3
+	// excerptLineMaxRunes cuts very long description lines in list excerpts
+	excerptLineMaxRunes = 200
+)
+
+// descriptionExcerpt renders the first maxLines lines of a description as an
+// indented blockquote for plaintext list items, so headings inside the
+// description can't be mistaken for the listing's own. maxLines 0 shows
+// nothing and a negative maxLines shows every line. Lines that are cut off
+// are counted in a final "… (N more lines)" line.
+func descriptionExcerpt(description string, maxLines int) string {
+	text := strings.ReplaceAll(description, "\r\n", "\n")
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r", "\n"))
+	if text == "" || maxLines == 0 {
+		return ""
+	}
+
+	lines := strings.Split(text, "\n")
+	shown := lines
+	if maxLines > 0 && len(lines) > maxLines {
+		shown = lines[:maxLines]
+	}
+
+	var b strings.Builder
+	for _, line := range shown {
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			b.WriteString("  >\n")
+			continue
+		}
+		if runes := []rune(line); len(runes) > excerptLineMaxRunes {
+			line = string(runes[:excerptLineMaxRunes]) + "…"
+		}
+		fmt.Fprintf(&b, "  > %s\n", line)
+	}
+	if hidden := len(lines) - len(shown); hidden == 1 {
+		b.WriteString("  > … (1 more line)\n")
+	} else if hidden > 1 {
+		fmt.Fprintf(&b, "  > … (%d more lines)\n", hidden)
+	}
+	return b.String()
+}
+
+// printDescriptionExcerpt prints a "Description" list item with its
+// excerpt, or nothing when the excerpt is empty
+func printDescriptionExcerpt(description string, maxLines int) {
+	if excerpt := descriptionExcerpt(description, maxLines); excerpt != "" {
+		fmt.Printf("- **Description**:\n%s", excerpt)
+	}
+}
@@ -0,0 +1,158 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite testdata/*.golden files")
+
+// loadFixture decodes testdata/name.json into v
+func loadFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatalf("decode %s: %v", name, err)
+	}
+}
+
+// checkGolden compares got with testdata/name.golden, rewriting it with -update
+func checkGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test ./cmd -run Golden -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s mismatch\n--- got ---\n%s\n--- want ---\n%s", path, got, want)
+	}
+}
+
+func TestGetMarkdownGolden(t *testing.T) {
+	output.SetUTC(true)
+	defer output.SetUTC(false)
+	now := time.Date(2026, 2, 12, 12, 0, 0, 0, time.UTC)
+
+	var project api.Project
+	loadFixture(t, "project_get", &project)
+	var issue api.Issue
+	loadFixture(t, "issue_get", &issue)
+	annotatePullRequests(&issue)
+	var initiative api.Initiative
+	loadFixture(t, "initiative_get", &initiative)
+
+	renders := map[string]func(w io.Writer, full bool){
+		"project_get":    func(w io.Writer, full bool) { renderProjectMarkdown(w, &project, full, now) },
+		"issue_get":      func(w io.Writer, full bool) { renderIssueMarkdown(w, &issue, full, now) },
+		"initiative_get": func(w io.Writer, full bool) { renderInitiativeMarkdown(w, &initiative, full) },
+	}
+	for name, render := range renders {
+		for _, full := range []bool{false, true} {
+			golden := name
+			if full {
+				golden += "_full"
+			}
+			t.Run(golden, func(t *testing.T) {
+				var buf bytes.Buffer
+				render(&buf, full)
+				checkGolden(t, golden, buf.Bytes())
+
+				var again bytes.Buffer
+				render(&again, full)
+				if !bytes.Equal(buf.Bytes(), again.Bytes()) {
+					t.Error("output is not stable across renders")
+				}
+			})
+		}
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"plain title", "plain title"},
+		{"Fix *all* the_things", `Fix \*all\* the\_things`},
+		{"[beta] <b>", `\[beta\] \<b\>`},
+		{"`code`", "\\`code\\`"},
+		{"# not a heading", `\# not a heading`},
+		{"two\nlines", "two lines"},
+	}
+	for _, tt := range tests {
+		if got := escapeMarkdown(tt.in); got != tt.want {
+			t.Errorf("escapeMarkdown(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNestMarkdown(t *testing.T) {
+	in := "# Title\ntext\n### Deep\n#hashtag\n```\n# comment\n```\n###### Six"
+	want := "### Title\ntext\n##### Deep\n#hashtag\n```\n# comment\n```\n###### Six"
+	if got := nestMarkdown(in, 2); got != want {
+		t.Errorf("nestMarkdown =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestIssueTaskItem(t *testing.T) {
+	tests := []struct {
+		stateType string
+		want      string
+	}{
+		{"unstarted", "- [ ] ENG-1: Title"},
+		{"started", "- [ ] ENG-1: Title (started)"},
+		{"completed", "- [x] ENG-1: Title"},
+		{"canceled", "- [x] ENG-1: Title (canceled)"},
+	}
+	for _, tt := range tests {
+		issue := api.Issue{Identifier: "ENG-1", Title: "Title", State: &api.State{Type: tt.stateType}}
+		if got := issueTaskItem(issue); got != tt.want {
+			t.Errorf("issueTaskItem(%s) = %q, want %q", tt.stateType, got, tt.want)
+		}
+	}
+}
+
+func TestDescriptionExcerpt(t *testing.T) {
+	long := strings.Repeat("x", excerptLineMaxRunes+50)
+	tests := []struct {
+		name     string
+		in       string
+		maxLines int
+		want     string
+	}{
+		{"empty", "  \n", 3, ""},
+		{"none", "one\ntwo", 0, ""},
+		{"fits", "one\ntwo", 3, "  > one\n  > two\n"},
+		{"cut", "# Heading\none\n\ntwo\nthree", 3, "  > # Heading\n  > one\n  >\n  > … (2 more lines)\n"},
+		{"one more", "a\nb\nc\nd", 3, "  > a\n  > b\n  > c\n  > … (1 more line)\n"},
+		{"full", "a\nb\nc\nd", -1, "  > a\n  > b\n  > c\n  > d\n"},
+		{"crlf", "one\r\ntwo  \r\nthree\r\nfour\r\n", 2, "  > one\n  > two\n  > … (2 more lines)\n"},
+		{"bare cr", "one\rtwo", 3, "  > one\n  > two\n"},
+		{"long line", long, 3, "  > " + strings.Repeat("x", excerptLineMaxRunes) + "…\n"},
+		{"long multibyte line", strings.Repeat("é", excerptLineMaxRunes+1), 1, "  > " + strings.Repeat("é", excerptLineMaxRunes) + "…\n"},
+	}
+	for _, tt := range tests {
+		if got := descriptionExcerpt(tt.in, tt.maxLines); got != tt.want {
+			t.Errorf("%s: descriptionExcerpt =\n%q\nwant\n%q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -5,13 +5,17 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 )
 
 // milestoneCmd is the parent command: project milestone
@@ -25,55 +29,112 @@ Examples:
   linear-cli project milestone get MILESTONE-ID
   linear-cli project milestone create PROJECT-ID --name "Beta Release"
   linear-cli project milestone update MILESTONE-ID --name "GA Release"
-  linear-cli project milestone delete MILESTONE-ID`,
+  linear-cli project milestone delete MILESTONE-ID
+  linear-cli project milestone assign MILESTONE-ID --label backend --state-type unstarted,started`,
 }
 
 var milestoneListCmd = &cobra.Command{
-	Use:     "list PROJECT-ID",
+	Use:     "list [PROJECT-ID]",
 	Aliases: []string{"ls"},
-	Short:   "List milestones for a project",
-	Long:    `List all milestones within a specific project.`,
-	Args:    cobra.ExactArgs(1),
+	Short:   "List milestones for a project or team",
+	Long: `List all milestones within a specific project, or with --team across every
+active project of a team, with the owning project shown alongside each
+milestone. Milestones whose target date has passed while they are not done
+are marked overdue; --overdue lists only those.
+
+Examples:
+  linear-cli project milestone list PROJECT-ID
+  linear-cli project milestone list PROJECT-ID --overdue
+  linear-cli project milestone list --team ENG --overdue`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		projectID := args[0]
+		teamKey, _ := cmd.Flags().GetString("team")
+		overdueOnly, _ := cmd.Flags().GetBool("overdue")
+
+		if (len(args) == 0) == (teamKey == "") {
+			output.Error("Give either a project ID or --team", plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		limit, _ := cmd.Flags().GetInt("limit")
+		var milestones []api.ProjectMilestone
+		if teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			projects, err := fetchTeamProjects(client, team.ID)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			milestones, err = collectTeamMilestones(client, projects)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+		} else {
+			limit, _ := cmd.Flags().GetInt("limit")
+			page, err := client.GetProjectMilestones(context.Background(), args[0], limit, "")
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			warnIfTruncated(cmd, page.PageInfo, len(page.Nodes))
+			milestones = page.Nodes
+		}
 
-		milestones, err := client.GetProjectMilestones(context.Background(), projectID, limit, "")
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to list milestones: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+		now := time.Now()
+		if overdueOnly {
+			milestones = filterOverdueMilestones(milestones, now)
 		}
 
-		if len(milestones.Nodes) == 0 {
-			output.Info("No milestones found for this project.", plaintext, jsonOut)
+		if len(milestones) == 0 {
+			switch {
+			case overdueOnly:
+				output.Info("No overdue milestones found.", plaintext, jsonOut)
+			case teamKey != "":
+				output.Info(fmt.Sprintf("No milestones found for team %s.", teamKey), plaintext, jsonOut)
+			default:
+				output.Info("No milestones found for this project.", plaintext, jsonOut)
+			}
 			return
 		}
 
 		if jsonOut {
-			output.JSON(milestones.Nodes)
+			output.JSON(milestones)
 			return
 		}
 
+		showProject := teamKey != ""
+
 		if plaintext {
 			fmt.Println("# Milestones")
-			for _, ms := range milestones.Nodes {
+			for i := range milestones {
+				ms := &milestones[i]
 				fmt.Printf("## %s\n", ms.Name)
 				fmt.Printf("- **ID**: %s\n", ms.ID)
+				if showProject && ms.Project != nil {
+					fmt.Printf("- **Project**: %s\n", ms.Project.Name)
+				}
 				fmt.Printf("- **Status**: %s\n", ms.Status)
 				fmt.Printf("- **Progress**: %.0f%%\n", ms.Progress*100)
 				if ms.TargetDate != nil {
-					fmt.Printf("- **Target Date**: %s\n", *ms.TargetDate)
+					if milestoneOverdue(ms, now) {
+						fmt.Printf("- **Target Date**: %s (overdue)\n", *ms.TargetDate)
+					} else {
+						fmt.Printf("- **Target Date**: %s\n", *ms.TargetDate)
+					}
 				}
 				fmt.Printf("- **Sort Order**: %.2f\n", ms.SortOrder)
 				if ms.Description != nil && *ms.Description != "" {
@@ -81,30 +142,38 @@ var milestoneListCmd = &cobra.Command{
 				}
 				fmt.Println()
 			}
-			fmt.Printf("Total: %d milestones\n", len(milestones.Nodes))
+			fmt.Printf("Total: %d milestones\n", len(milestones))
 			return
 		}
 
 		// Table output
 		headers := []string{"Name", "Status", "Progress", "Target Date", "Sort", "ID"}
+		if showProject {
+			headers = append([]string{"Project"}, headers...)
+		}
 		rows := [][]string{}
 
-		for _, ms := range milestones.Nodes {
-			targetDate := "-"
-			if ms.TargetDate != nil {
-				targetDate = *ms.TargetDate
+		for i := range milestones {
+			ms := &milestones[i]
+			targetDate := milestoneTargetLabel(ms, now)
+			if targetDate == "" {
+				targetDate = "-"
 			}
 
 			statusColor := milestoneStatusColor(ms.Status)
 
-			rows = append(rows, []string{
+			row := []string{
 				truncateString(ms.Name, 30),
 				statusColor.Sprint(ms.Status),
 				fmt.Sprintf("%.0f%%", ms.Progress*100),
 				targetDate,
 				fmt.Sprintf("%.2f", ms.SortOrder),
 				ms.ID,
-			})
+			}
+			if showProject {
+				row = append([]string{truncateString(milestoneProjectName(ms), 25)}, row...)
+			}
+			rows = append(rows, row)
 		}
 
 		output.Table(output.TableData{
@@ -114,7 +183,7 @@ var milestoneListCmd = &cobra.Command{
 
 		fmt.Printf("\n%s %d milestones\n",
 			color.New(color.FgGreen).Sprint("✓"),
-			len(milestones.Nodes))
+			len(milestones))
 	},
 }
 
@@ -131,7 +200,7 @@ var milestoneGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -139,7 +208,7 @@ var milestoneGetCmd = &cobra.Command{
 		ms, err := client.GetProjectMilestone(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get milestone: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -155,16 +224,20 @@ var milestoneGetCmd = &cobra.Command{
 			fmt.Printf("- **Status**: %s\n", ms.Status)
 			fmt.Printf("- **Progress**: %.0f%%\n", ms.Progress*100)
 			if ms.TargetDate != nil {
-				fmt.Printf("- **Target Date**: %s\n", *ms.TargetDate)
+				if milestoneOverdue(ms, time.Now()) {
+					fmt.Printf("- **Target Date**: %s (overdue)\n", *ms.TargetDate)
+				} else {
+					fmt.Printf("- **Target Date**: %s\n", *ms.TargetDate)
+				}
 			}
 			if ms.Description != nil && *ms.Description != "" {
 				fmt.Printf("- **Description**: %s\n", *ms.Description)
 			}
 			fmt.Printf("- **Sort Order**: %.2f\n", ms.SortOrder)
-			fmt.Printf("- **Created**: %s\n", ms.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", ms.UpdatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(ms.CreatedAt, output.DateTime))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(ms.UpdatedAt, output.DateTime))
 			if ms.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", ms.ArchivedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("- **Archived**: %s\n", output.FormatTime(*ms.ArchivedAt, output.DateTime))
 			}
 
 			if ms.Project != nil {
@@ -176,22 +249,11 @@ var milestoneGetCmd = &cobra.Command{
 			if ms.Issues != nil && len(ms.Issues.Nodes) > 0 {
 				fmt.Printf("\n## Issues (%d)\n", len(ms.Issues.Nodes))
 				for _, issue := range ms.Issues.Nodes {
-					stateStr := "[ ]"
-					if issue.State != nil {
-						switch issue.State.Type {
-						case "completed":
-							stateStr = "[x]"
-						case "started":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						}
-					}
 					assignee := "Unassigned"
 					if issue.Assignee != nil {
-						assignee = issue.Assignee.Name
+						assignee = escapeMarkdown(issue.Assignee.Name)
 					}
-					fmt.Printf("- %s %s: %s (%s)\n", stateStr, issue.Identifier, issue.Title, assignee)
+					fmt.Printf("%s — %s\n", issueTaskItem(issue), assignee)
 				}
 			}
 			return
@@ -216,7 +278,7 @@ var milestoneGetCmd = &cobra.Command{
 		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Progress:"), progressColor.Sprintf("%.0f%%", ms.Progress*100))
 
 		if ms.TargetDate != nil {
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Target Date:"), *ms.TargetDate)
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Target Date:"), milestoneTargetLabel(ms, time.Now()))
 		}
 
 		if ms.Description != nil && *ms.Description != "" {
@@ -233,17 +295,7 @@ var milestoneGetCmd = &cobra.Command{
 		if ms.Issues != nil && len(ms.Issues.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Issues:"))
 			for _, issue := range ms.Issues.Nodes {
-				stateIcon := "○"
-				if issue.State != nil {
-					switch issue.State.Type {
-					case "completed":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
-					case "started":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
-					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
-					}
-				}
+				stateIcon := issueStateIcon(issue.State)
 				assignee := "Unassigned"
 				if issue.Assignee != nil {
 					assignee = issue.Assignee.Name
@@ -257,10 +309,10 @@ var milestoneGetCmd = &cobra.Command{
 		}
 
 		fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Timeline:"))
-		fmt.Printf("  Created: %s\n", ms.CreatedAt.Format("2006-01-02"))
-		fmt.Printf("  Updated: %s\n", ms.UpdatedAt.Format("2006-01-02"))
+		fmt.Printf("  Created: %s\n", output.FormatTime(ms.CreatedAt, output.DateOnly))
+		fmt.Printf("  Updated: %s\n", output.FormatTime(ms.UpdatedAt, output.DateOnly))
 		if ms.ArchivedAt != nil {
-			fmt.Printf("  Archived: %s\n", ms.ArchivedAt.Format("2006-01-02"))
+			fmt.Printf("  Archived: %s\n", output.FormatTime(*ms.ArchivedAt, output.DateOnly))
 		}
 
 		fmt.Println()
@@ -276,27 +328,79 @@ var milestoneCreateCmd = &cobra.Command{
 The description can be provided inline via --description or read from a markdown file via --description-file.
 Use --description-file - to read from stdin.
 
+Several milestones can be created at once with --from-file (a YAML or JSON list of
+{name, description, targetDate} entries, use - for stdin) or with the compact
+--bulk "Name:YYYY-MM-DD,..." form. Entries are created in order with spaced sort
+orders so they appear in the given sequence. All dates are validated before anything
+is created; a failed entry does not stop the remaining ones unless --fail-fast is set.
+
+Target dates are YYYY-MM-DD or an expression such as today, +2_weeks, or
+end_of_quarter (also end_of_week, end_of_month, end_of_year), sent as a date.
+A target date before the project's start date or after its target date prints
+a warning with the project's dates; --strict-dates makes that an error before
+anything is created, and --no-validate skips the check.
+
 Examples:
   linear-cli project milestone create PROJECT-ID --name "Beta Release"
-  linear-cli project milestone create PROJECT-ID --name "Beta Release" --description-file milestone-desc.md`,
+  linear-cli project milestone create PROJECT-ID --name "Beta Release" --description-file milestone-desc.md
+  linear-cli project milestone create PROJECT-ID --from-file milestones.yaml
+  linear-cli project milestone create PROJECT-ID --bulk "Alpha:2025-03-01,Beta:2025-06-01,GA:2025-09-01"
+  linear-cli project milestone create PROJECT-ID --name "Beta" --target-date end_of_quarter --strict-dates`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 		projectID := args[0]
 
+		fromFile, _ := cmd.Flags().GetString("from-file")
+		bulk, _ := cmd.Flags().GetString("bulk")
+		bulkMode := fromFile != "" || bulk != ""
+		var entries []milestoneEntry
+		if bulkMode {
+			for _, f := range []string{"name", "description", "description-file", "target-date"} {
+				if cmd.Flags().Changed(f) {
+					output.Error(fmt.Sprintf("--%s cannot be combined with --from-file or --bulk", f), plaintext, jsonOut)
+					exit(1)
+				}
+			}
+
+			var err error
+			if fromFile != "" {
+				var content string
+				content, err = readContentFromFile(fromFile)
+				if err == nil {
+					entries, err = parseMilestoneFile(content)
+				}
+			} else {
+				entries, err = parseMilestoneBulk(bulk)
+			}
+			if err == nil {
+				err = validateMilestoneEntries(entries)
+			}
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		if bulkMode {
+			checkMilestoneWindow(cmd, client, projectID, nil, entries, plaintext, jsonOut)
+			runMilestoneBulkCreate(cmd, client, projectID, entries, plaintext, jsonOut)
+			return
+		}
+
 		name, _ := cmd.Flags().GetString("name")
 		if name == "" {
-			output.Error("Name is required (--name)", plaintext, jsonOut)
-			os.Exit(1)
+			output.Error("Name is required (--name, or --from-file/--bulk for several milestones)", plaintext, jsonOut)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -311,14 +415,22 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
 
 		if cmd.Flags().Changed("target-date") {
 			targetDate, _ := cmd.Flags().GetString("target-date")
-			input["targetDate"] = targetDate
+			value, err := parseMilestoneTargetDate(targetDate)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+			if value != nil {
+				input["targetDate"] = value
+				checkMilestoneWindow(cmd, client, projectID, nil, []milestoneEntry{{Name: name, TargetDate: value.(string)}}, plaintext, jsonOut)
+			}
 		}
 
 		if cmd.Flags().Changed("sort-order") {
@@ -329,18 +441,24 @@ Examples:
 		ms, err := client.CreateProjectMilestone(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create milestone: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "milestone", ms.ID, ms.Name, &journal.Inverse{Action: "delete"})
 
+		if printCreated(cmd, createdEntity{Type: "milestone", ID: ms.ID, Name: ms.Name, URL: milestoneURL(ms)}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(ms)
 		} else if plaintext {
 			fmt.Printf("Created milestone: %s (ID: %s)\n", ms.Name, ms.ID)
+			printResultURL(milestoneURL(ms), plaintext)
 		} else {
 			fmt.Printf("%s Created milestone %s (ID: %s)\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgCyan, color.Bold).Sprint(ms.Name),
 				ms.ID)
+			printResultURL(milestoneURL(ms), plaintext)
 		}
 	},
 }
@@ -354,19 +472,24 @@ var milestoneUpdateCmd = &cobra.Command{
 The description can be provided inline via --description or read from a markdown file via --description-file.
 Use --description-file - to read from stdin.
 
+--target-date takes the same dates and expressions as 'milestone create' and is
+checked against the project's dates the same way (--strict-dates, --no-validate).
+
 Examples:
   linear-cli project milestone update MILESTONE-ID --name "New Name"
   linear-cli project milestone update MILESTONE-ID --description-file updated-desc.md
-  linear-cli project milestone update MILESTONE-ID --target-date "2025-12-31"`,
+  linear-cli project milestone update MILESTONE-ID --target-date "2025-12-31"
+  linear-cli project milestone update MILESTONE-ID --target-date +2_weeks
+  linear-cli project milestone update MILESTONE-ID --target-date none`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -385,18 +508,19 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
 
 		if cmd.Flags().Changed("target-date") {
 			targetDate, _ := cmd.Flags().GetString("target-date")
-			if targetDate == "" {
-				input["targetDate"] = nil
-			} else {
-				input["targetDate"] = targetDate
+			value, err := parseMilestoneTargetDate(targetDate)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
 			}
+			input["targetDate"] = value
 		}
 
 		if cmd.Flags().Changed("sort-order") {
@@ -406,22 +530,36 @@ Examples:
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+
+		// Capture current values so the update can be undone, and check a
+		// new target date against the project's dates
+		var inverse *journal.Inverse
+		if before, err := client.GetProjectMilestone(context.Background(), args[0]); err == nil {
+			inverse = restoreInverse(before, input)
+			if date, ok := input["targetDate"].(string); ok && before.Project != nil {
+				checkMilestoneWindow(cmd, client, before.Project.ID, before.Project, []milestoneEntry{{Name: before.Name, TargetDate: date}}, plaintext, jsonOut)
+			}
 		}
 
 		ms, err := client.UpdateProjectMilestone(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update milestone: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "milestone", ms.ID, ms.Name, inverse)
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(milestoneURL(ms), "milestone")
+		} else if jsonOut {
 			output.JSON(ms)
 		} else if plaintext {
 			fmt.Printf("Updated milestone: %s\n", ms.Name)
 			fmt.Printf("ID: %s\n", ms.ID)
 			fmt.Printf("Status: %s\n", ms.Status)
 			fmt.Printf("Progress: %.0f%%\n", ms.Progress*100)
+			printResultURL(milestoneURL(ms), plaintext)
 		} else {
 			fmt.Printf("%s Updated milestone %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -432,6 +570,7 @@ Examples:
 			if ms.TargetDate != nil {
 				fmt.Printf("  Target: %s\n", *ms.TargetDate)
 			}
+			printResultURL(milestoneURL(ms), plaintext)
 		}
 	},
 }
@@ -448,7 +587,7 @@ var milestoneDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -456,8 +595,9 @@ var milestoneDeleteCmd = &cobra.Command{
 		err = client.DeleteProjectMilestone(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete milestone: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "milestone", args[0], "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{"success": true, "id": args[0], "action": "deleted"})
@@ -471,17 +611,456 @@ var milestoneDeleteCmd = &cobra.Command{
 	},
 }
 
-func milestoneStatusColor(status string) *color.Color {
-	switch strings.ToLower(status) {
-	case "done":
-		return color.New(color.FgGreen)
-	case "next":
-		return color.New(color.FgBlue)
-	case "overdue":
-		return color.New(color.FgRed)
-	default: // unstarted
-		return color.New(color.FgWhite)
+var milestoneAssignCmd = &cobra.Command{
+	Use:   "assign MILESTONE-ID",
+	Short: "Attach matching project issues to a milestone",
+	Long: `Set the milestone on every issue in the milestone's project that matches
+the given filters. Issues already on the milestone are left unchanged.
+
+With --clear, issues currently on the milestone (and matching the filters)
+are detached from it instead. Use --dry-run to preview the affected issues.
+
+Examples:
+  linear-cli project milestone assign MILESTONE-ID --label backend --state-type unstarted,started
+  linear-cli project milestone assign MILESTONE-ID --project PROJECT-ID --label backend --dry-run
+  linear-cli project milestone assign MILESTONE-ID --clear --state-type canceled`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		milestoneID := args[0]
+
+		projectID, _ := cmd.Flags().GetString("project")
+		labels, _ := cmd.Flags().GetStringSlice("label")
+		stateTypes, _ := cmd.Flags().GetStringSlice("state-type")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		clearMilestone, _ := cmd.Flags().GetBool("clear")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		milestone, err := client.GetProjectMilestone(context.Background(), milestoneID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get milestone: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if milestone.Project == nil {
+			output.Error("Milestone has no project", plaintext, jsonOut)
+			exit(1)
+		}
+		if projectID != "" {
+			// --project may be a slug ID or URL; the milestone names its project by ID
+			project, err := client.GetProject(context.Background(), projectRefKey(projectID))
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get project %s: %v", projectID, err), plaintext, jsonOut)
+				exit(1)
+			}
+			if project.ID != milestone.Project.ID {
+				output.Error(fmt.Sprintf("Milestone '%s' belongs to project '%s', not %s", milestone.Name, milestone.Project.Name, project.Name), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		filter := milestoneAssignFilter(labels, stateTypes)
+		if clearMilestone {
+			filter["projectMilestone"] = map[string]interface{}{"id": map[string]interface{}{"eq": milestone.ID}}
+		}
+
+		var issues []api.Issue
+		after := ""
+		for {
+			page, err := client.GetProjectIssues(context.Background(), milestone.Project.ID, filter, 100, after)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get project issues: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			issues = append(issues, page.Nodes...)
+			if !page.PageInfo.HasNextPage {
+				break
+			}
+			after = page.PageInfo.EndCursor
+		}
+
+		results := make([]milestoneAssignResult, 0, len(issues))
+		failed := 0
+		progress := newProgress("Assigning issues", len(issues))
+		report := func(r milestoneAssignResult) {
+			results = append(results, r)
+			progress.Clear()
+			if !jsonOut {
+				printMilestoneAssignResult(r, plaintext)
+			}
+			progress.Step(r.Identifier)
+		}
+		for i := range issues {
+			issue := &issues[i]
+			result := milestoneAssignResult{Identifier: issue.Identifier}
+			if issue.ProjectMilestone != nil {
+				result.PreviousMilestone = &issue.ProjectMilestone.Name
+			}
+
+			input := map[string]interface{}{"projectMilestoneId": milestone.ID}
+			action := "assigned"
+			if clearMilestone {
+				input["projectMilestoneId"] = nil
+				action = "cleared"
+			} else if issue.ProjectMilestone != nil && issue.ProjectMilestone.ID == milestone.ID {
+				result.Result = "unchanged"
+				report(result)
+				continue
+			}
+
+			if dryRun {
+				result.Result = "would be " + action
+				report(result)
+				continue
+			}
+
+			inverse := restoreInverse(issue, input)
+			if _, err := client.UpdateIssue(context.Background(), issue.ID, input); err != nil {
+				result.Result = "failed"
+				result.Error = err.Error()
+				failed++
+			} else {
+				result.Result = action
+				recordOperation(cmd, "issue", issue.ID, issue.Identifier, inverse)
+			}
+			report(result)
+		}
+
+		progress.Finish()
+
+		if jsonOut {
+			output.JSON(results)
+		} else {
+			summary := summarizeMilestoneAssign(results, milestone.Name, dryRun)
+			if plaintext {
+				fmt.Printf("\nSummary: %s\n", summary)
+			} else {
+				fmt.Printf("\n%s\n", summary)
+			}
+		}
+
+		if failed > 0 {
+			exit(1)
+		}
+	},
+}
+
+// milestoneAssignResult reports the outcome for one issue of milestone assign
+type milestoneAssignResult struct {
+	Identifier        string  `json:"identifier"`
+	PreviousMilestone *string `json:"previousMilestone"`
+	Result            string  `json:"result"`
+	Error             string  `json:"error,omitempty"`
+}
+
+// milestoneAssignFilter builds the IssueFilter for milestone assign
+func milestoneAssignFilter(labels, stateTypes []string) map[string]interface{} {
+	filter := map[string]interface{}{}
+	if len(labels) > 0 {
+		filter["labels"] = map[string]interface{}{
+			"some": map[string]interface{}{
+				"name": map[string]interface{}{"in": labels},
+			},
+		}
+	}
+	if len(stateTypes) > 0 {
+		filter["state"] = map[string]interface{}{
+			"type": map[string]interface{}{"in": stateTypes},
+		}
+	}
+	return filter
+}
+
+func printMilestoneAssignResult(r milestoneAssignResult, plaintext bool) {
+	previous := "none"
+	if r.PreviousMilestone != nil {
+		previous = *r.PreviousMilestone
+	}
+	if plaintext {
+		if r.Error != "" {
+			fmt.Printf("%s\t%s\t%s: %s\n", r.Identifier, previous, r.Result, r.Error)
+		} else {
+			fmt.Printf("%s\t%s\t%s\n", r.Identifier, previous, r.Result)
+		}
+		return
+	}
+	switch r.Result {
+	case "failed":
+		fmt.Printf("%s %s: %s\n", color.New(color.FgRed).Sprint("✗"), r.Identifier, r.Error)
+	case "unchanged":
+		fmt.Printf("%s %s already on milestone\n", color.New(color.FgWhite, color.Faint).Sprint("-"), r.Identifier)
+	default:
+		fmt.Printf("%s %s %s (previous: %s)\n", color.New(color.FgGreen).Sprint("✓"),
+			color.New(color.FgCyan).Sprint(r.Identifier), r.Result, previous)
+	}
+}
+
+func summarizeMilestoneAssign(results []milestoneAssignResult, milestoneName string, dryRun bool) string {
+	if len(results) == 0 {
+		return "No matching issues"
+	}
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Result]++
+	}
+	var parts []string
+	for _, key := range []string{"assigned", "cleared", "would be assigned", "would be cleared", "unchanged", "failed"} {
+		if counts[key] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[key], key))
+		}
+	}
+	summary := fmt.Sprintf("%s for milestone '%s'", strings.Join(parts, ", "), milestoneName)
+	if dryRun {
+		summary += " (dry run, nothing changed)"
+	}
+	return summary
+}
+
+// milestoneEntry is a single milestone in a bulk create request
+type milestoneEntry struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description,omitempty"`
+	TargetDate  string `yaml:"targetDate" json:"targetDate,omitempty"`
+}
+
+// milestoneBulkResult reports the outcome of creating one bulk entry
+type milestoneBulkResult struct {
+	Index      int    `json:"index"`
+	Name       string `json:"name"`
+	TargetDate string `json:"targetDate,omitempty"`
+	Status     string `json:"status"`
+	ID         string `json:"id,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// milestoneURL links to a milestone through the project it came back with
+func milestoneURL(ms *api.ProjectMilestone) string {
+	if ms.Project == nil {
+		return ""
+	}
+	return constructMilestoneURL(ms.Project.URL, ms.ID)
+}
+
+// milestoneSortSpacing is the gap between sort orders of bulk-created milestones
+const milestoneSortSpacing = 100.0
+
+// parseMilestoneFile parses a YAML (or JSON) list of milestone entries.
+// A top-level "milestones:" key wrapping the list is also accepted.
+func parseMilestoneFile(content string) ([]milestoneEntry, error) {
+	var entries []milestoneEntry
+	if err := yaml.Unmarshal([]byte(content), &entries); err != nil {
+		var wrapped struct {
+			Milestones []milestoneEntry `yaml:"milestones"`
+		}
+		if wrappedErr := yaml.Unmarshal([]byte(content), &wrapped); wrappedErr != nil {
+			return nil, fmt.Errorf("failed to parse milestones file: %v", err)
+		}
+		entries = wrapped.Milestones
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no milestones found in file")
+	}
+	return entries, nil
+}
+
+// parseMilestoneBulk parses the compact "Name:YYYY-MM-DD,Name2:YYYY-MM-DD" form
+func parseMilestoneBulk(value string) ([]milestoneEntry, error) {
+	var entries []milestoneEntry
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		entries = append(entries, parseMilestoneSpec(part))
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no milestones found in --bulk value")
+	}
+	return entries, nil
+}
+
+// parseMilestoneSpec parses a single "Name:YYYY-MM-DD" entry. The date is
+// optional: the spec splits at its last colon when what follows looks like a
+// date, so a name like "Phase 2: Launch" stays whole while a mistyped date
+// like "Beta:2025-3-1" is still split off and rejected by
+// validateMilestoneEntries.
+func parseMilestoneSpec(spec string) milestoneEntry {
+	spec = strings.TrimSpace(spec)
+	entry := milestoneEntry{Name: spec}
+	if idx := strings.LastIndex(spec, ":"); idx >= 0 {
+		date := strings.TrimSpace(spec[idx+1:])
+		if looksLikeDate(date) {
+			entry.Name = strings.TrimSpace(spec[:idx])
+			entry.TargetDate = date
+		}
+	}
+	return entry
+}
+
+// dateKeywords start the named date expressions ParseDateExpression accepts
+var dateKeywords = []string{"today", "tomorrow", "end_of_", "end-of-", "end of "}
+
+// looksLikeDate reports whether s was meant as a date: it starts with a
+// digit, a "+", or a date keyword. Whether it parses is left to validation.
+func looksLikeDate(s string) bool {
+	if s == "" {
+		return false
+	}
+	if c := s[0]; c == '+' || (c >= '0' && c <= '9') {
+		return true
+	}
+	lower := strings.ToLower(s)
+	for _, kw := range dateKeywords {
+		if strings.HasPrefix(lower, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMilestoneEntries checks every entry before anything is created,
+// normalizing target dates to YYYY-MM-DD
+func validateMilestoneEntries(entries []milestoneEntry) error {
+	var problems []string
+	for i, e := range entries {
+		if strings.TrimSpace(e.Name) == "" {
+			problems = append(problems, fmt.Sprintf("entry %d: name is required", i+1))
+		}
+		if e.TargetDate != "" {
+			date, err := utils.ParseDateExpression(e.TargetDate, time.Now())
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("entry %d (%s): invalid target date '%s' (expected YYYY-MM-DD or an expression like +2_weeks)", i+1, e.Name, e.TargetDate))
+			}
+			entries[i].TargetDate = date
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid milestones, nothing was created:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+// runMilestoneBulkCreate creates the entries in order and reports per-entry results
+func runMilestoneBulkCreate(cmd *cobra.Command, client *api.Client, projectID string, entries []milestoneEntry, plaintext, jsonOut bool) {
+	failFast, _ := cmd.Flags().GetBool("fail-fast")
+
+	// Place new milestones after the existing ones unless --sort-order gives a base
+	baseOrder, _ := cmd.Flags().GetFloat64("sort-order")
+	if !cmd.Flags().Changed("sort-order") {
+		existing, err := client.GetProjectMilestones(context.Background(), projectID, 250, "")
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get existing milestones: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		for _, ms := range existing.Nodes {
+			if ms.SortOrder > baseOrder {
+				baseOrder = ms.SortOrder
+			}
+		}
+	}
+
+	results, created, failed := createMilestoneEntries(cmd, client, projectID, entries, baseOrder, failFast, plaintext, jsonOut)
+	skipped := len(entries) - created - failed
+
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"projectId": projectID,
+			"created":   created,
+			"failed":    failed,
+			"skipped":   skipped,
+			"results":   results,
+		})
+	} else {
+		summary := fmt.Sprintf("%d created, %d failed", created, failed)
+		if skipped > 0 {
+			summary += fmt.Sprintf(", %d skipped (--fail-fast)", skipped)
+		}
+		if scriptedOutput(cmd) {
+			fmt.Fprintf(os.Stderr, "%s\n", summary)
+		} else if plaintext {
+			fmt.Printf("\nSummary: %s\n", summary)
+		} else {
+			fmt.Printf("\n%s\n", summary)
+		}
+	}
+
+	if failed > 0 {
+		exit(1)
+	}
+}
+
+// createMilestoneEntries creates the entries in order with sort orders
+// spaced after baseOrder, printing one line per entry unless jsonOut is set
+func createMilestoneEntries(cmd *cobra.Command, client *api.Client, projectID string, entries []milestoneEntry, baseOrder float64, failFast, plaintext, jsonOut bool) (results []milestoneBulkResult, created, failed int) {
+	results = make([]milestoneBulkResult, len(entries))
+	progress := newProgress("Creating milestones", len(entries))
+	for i, e := range entries {
+		results[i] = milestoneBulkResult{Index: i, Name: e.Name, TargetDate: e.TargetDate, Status: "skipped"}
+		if failFast && failed > 0 {
+			progress.Step(e.Name)
+			continue
+		}
+
+		input := map[string]interface{}{
+			"name":      e.Name,
+			"projectId": projectID,
+			"sortOrder": baseOrder + float64(i+1)*milestoneSortSpacing,
+		}
+		if e.Description != "" {
+			input["description"] = e.Description
+		}
+		if e.TargetDate != "" {
+			input["targetDate"] = e.TargetDate
+		}
+
+		ms, err := client.CreateProjectMilestone(context.Background(), input)
+		if err != nil {
+			results[i].Status = "failed"
+			results[i].Error = err.Error()
+			failed++
+		} else {
+			results[i].Status = "created"
+			results[i].ID = ms.ID
+			results[i].URL = milestoneURL(ms)
+			created++
+			recordOperation(cmd, "milestone", ms.ID, ms.Name, &journal.Inverse{Action: "delete"})
+		}
+
+		progress.Clear()
+		if !jsonOut {
+			switch {
+			case scriptedOutput(cmd) && err == nil:
+				printCreated(cmd, createdEntity{Type: "milestone", ID: ms.ID, Name: e.Name, URL: results[i].URL})
+			case scriptedOutput(cmd):
+				fmt.Fprintf(os.Stderr, "Failed milestone: %s: %v\n", e.Name, err)
+			case plaintext && err == nil:
+				fmt.Printf("Created milestone: %s (ID: %s)\n", e.Name, ms.ID)
+			case plaintext:
+				fmt.Printf("Failed milestone: %s: %v\n", e.Name, err)
+			case err == nil:
+				fmt.Printf("%s Created milestone %s (ID: %s)\n",
+					color.New(color.FgGreen).Sprint("✓"),
+					color.New(color.FgCyan, color.Bold).Sprint(e.Name),
+					ms.ID)
+			default:
+				fmt.Printf("%s Failed to create milestone %s: %v\n",
+					color.New(color.FgRed).Sprint("✗"),
+					color.New(color.FgCyan, color.Bold).Sprint(e.Name),
+					err)
+			}
+		}
+		progress.Step(e.Name)
 	}
+	progress.Finish()
+	return results, created, failed
 }
 
 func init() {
@@ -491,22 +1070,41 @@ func init() {
 	milestoneCmd.AddCommand(milestoneCreateCmd)
 	milestoneCmd.AddCommand(milestoneUpdateCmd)
 	milestoneCmd.AddCommand(milestoneDeleteCmd)
+	milestoneCmd.AddCommand(milestoneAssignCmd)
 
 	// List flags
-	milestoneListCmd.Flags().IntP("limit", "l", 50, "Maximum number of milestones to return")
+	milestoneListCmd.Flags().IntP("limit", "l", 50, "Maximum number of milestones to return (ignored with --team, which lists all)")
+	milestoneListCmd.Flags().String("team", "", "List milestones across all active projects of this team (key)")
+	milestoneListCmd.Flags().Bool("overdue", false, "Only milestones past their target date that are not done")
 
 	// Create flags
 	milestoneCreateCmd.Flags().String("name", "", "Milestone name (required)")
 	milestoneCreateCmd.Flags().StringP("description", "d", "", "Milestone description")
-	milestoneCreateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
-	milestoneCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD)")
+	milestoneCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	milestoneCreateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD, or e.g. +2_weeks, end_of_quarter)")
 	milestoneCreateCmd.Flags().Float64("sort-order", 0, "Sort order (float, controls position in list)")
-	_ = milestoneCreateCmd.MarkFlagRequired("name")
+	milestoneCreateCmd.Flags().String("from-file", "", "Create milestones from a YAML/JSON list of {name, description, targetDate} (use - for stdin)")
+	milestoneCreateCmd.Flags().String("bulk", "", "Create milestones from a compact list, e.g. \"Alpha:2025-03-01,Beta:2025-06-01\"")
+	milestoneCreateCmd.Flags().Bool("fail-fast", false, "Stop bulk creation at the first failure")
+	addURLOnlyFlag(milestoneCreateCmd)
+	addPorcelainFlag(milestoneCreateCmd)
+	milestoneCreateCmd.MarkFlagsOneRequired("name", "from-file", "bulk")
+	addMilestoneDateFlags(milestoneCreateCmd)
+	milestoneCreateCmd.MarkFlagsMutuallyExclusive("from-file", "bulk")
 
 	// Update flags
 	milestoneUpdateCmd.Flags().String("name", "", "New name")
 	milestoneUpdateCmd.Flags().StringP("description", "d", "", "New description")
-	milestoneUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
-	milestoneUpdateCmd.Flags().String("target-date", "", "New target date (YYYY-MM-DD, or empty to remove)")
+	milestoneUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	milestoneUpdateCmd.Flags().String("target-date", "", "New target date (YYYY-MM-DD, e.g. +2_weeks or end_of_quarter, or none to remove)")
 	milestoneUpdateCmd.Flags().Float64("sort-order", 0, "New sort order (float, controls position in list)")
+	addURLOnlyFlag(milestoneUpdateCmd)
+	addMilestoneDateFlags(milestoneUpdateCmd)
+
+	// Assign flags
+	milestoneAssignCmd.Flags().String("project", "", "Project ID, slug ID, or URL (defaults to the milestone's project)")
+	milestoneAssignCmd.Flags().StringSlice("label", nil, "Only issues with any of these labels (repeatable)")
+	milestoneAssignCmd.Flags().StringSlice("state-type", nil, "Only issues in these state types: triage, backlog, unstarted, started, completed, canceled")
+	milestoneAssignCmd.Flags().Bool("dry-run", false, "Show affected issues without changing anything")
+	milestoneAssignCmd.Flags().Bool("clear", false, "Detach matching issues from the milestone instead")
 }
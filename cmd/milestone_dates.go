@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addMilestoneDateFlags registers the flags that control how a milestone's
+// target date is checked against its project's dates
+func addMilestoneDateFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("strict-dates", false, "Fail instead of warning when the target date is outside the project's start and target dates")
+	cmd.Flags().Bool("no-validate", false, "Don't check the target date against the project's dates")
+	cmd.MarkFlagsMutuallyExclusive("strict-dates", "no-validate")
+}
+
+// milestoneWindowProblem says how a milestone target date (YYYY-MM-DD) falls
+// outside the project's start and target dates, or returns "" when it
+// doesn't. Either end of the project may be unset, and both are inclusive.
+func milestoneWindowProblem(targetDate string, project *api.Project) string {
+	if targetDate == "" || project == nil {
+		return ""
+	}
+	start, end := "", ""
+	if project.StartDate != nil {
+		start = *project.StartDate
+	}
+	if project.TargetDate != nil {
+		end = *project.TargetDate
+	}
+	window := fmt.Sprintf("project %s runs %s to %s", project.Name, orUnset(start), orUnset(end))
+	switch {
+	case start != "" && targetDate < start:
+		return fmt.Sprintf("target date %s is before the project's start date (%s)", targetDate, window)
+	case end != "" && targetDate > end:
+		return fmt.Sprintf("target date %s is after the project's target date (%s)", targetDate, window)
+	}
+	return ""
+}
+
+func orUnset(date string) string {
+	if date == "" {
+		return "(unset)"
+	}
+	return date
+}
+
+// checkMilestoneWindow compares milestone target dates against the project
+// window, fetching the project unless it's given. Problems are warnings on
+// stderr, or with --strict-dates an error that stops before anything is
+// changed. --no-validate skips the check.
+func checkMilestoneWindow(cmd *cobra.Command, client *api.Client, projectID string, project *api.Project, entries []milestoneEntry, plaintext, jsonOut bool) {
+	if noValidate, _ := cmd.Flags().GetBool("no-validate"); noValidate {
+		return
+	}
+	dated := false
+	for _, e := range entries {
+		dated = dated || e.TargetDate != ""
+	}
+	if !dated {
+		return
+	}
+	if project == nil {
+		var err error
+		project, err = client.GetProject(context.Background(), projectID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: couldn't check the target date against the project's dates: %v\n", err)
+			return
+		}
+	}
+
+	var problems []string
+	for _, e := range entries {
+		if problem := milestoneWindowProblem(e.TargetDate, project); problem != "" {
+			if len(entries) > 1 {
+				problem = e.Name + ": " + problem
+			}
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) == 0 {
+		return
+	}
+	if strict, _ := cmd.Flags().GetBool("strict-dates"); strict {
+		output.Error(fmt.Sprintf("%s; nothing was changed (pass --no-validate to allow it)", strings.Join(problems, "; ")), plaintext, jsonOut)
+		exit(1)
+	}
+	for _, problem := range problems {
+		fmt.Fprintf(os.Stderr, "%s %s\n", color.New(color.FgYellow).Sprint("⚠"), problem)
+	}
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestMilestoneWindowProblem(t *testing.T) {
+	date := func(s string) *string { return &s }
+	project := &api.Project{Name: "Auth", StartDate: date("2025-03-01"), TargetDate: date("2025-06-30")}
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"2025-06-30", ""},
+		{"2025-03-01", ""},
+		{"2025-04-15", ""},
+		{"2025-07-01", "target date 2025-07-01 is after the project's target date (project Auth runs 2025-03-01 to 2025-06-30)"},
+		{"2025-02-28", "target date 2025-02-28 is before the project's start date (project Auth runs 2025-03-01 to 2025-06-30)"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := milestoneWindowProblem(tt.target, project); got != tt.want {
+			t.Errorf("milestoneWindowProblem(%q) = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+
+	// An open-ended project only bounds one side
+	open := &api.Project{Name: "Auth", TargetDate: date("2025-06-30")}
+	if got := milestoneWindowProblem("2020-01-01", open); got != "" {
+		t.Errorf("no start date: %q", got)
+	}
+	if got := milestoneWindowProblem("2025-07-01", open); !strings.Contains(got, "runs (unset) to 2025-06-30") {
+		t.Errorf("no start date, late: %q", got)
+	}
+	if got := milestoneWindowProblem("2030-01-01", &api.Project{Name: "Auth"}); got != "" {
+		t.Errorf("undated project: %q", got)
+	}
+}
+
+func TestHermeticMilestoneCreateDateWindow(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Project", `{"project":{"id":"p1","name":"Auth","startDate":"2025-03-01","targetDate":"2025-06-30"}}`)
+	s.Data("ProjectMilestoneCreate", `{"projectMilestoneCreate":{"success":true,"projectMilestone":{"id":"m1","name":"GA"}}}`)
+
+	// The project's target date itself is inside the window
+	r := runMocked(t, "project", "milestone", "create", "p1", "--name", "GA", "--target-date", "2025-06-30", "--strict-dates", "--json")
+	if r.Exit != 0 || strings.Contains(r.Stderr, "⚠") {
+		t.Errorf("boundary date exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	r = runMocked(t, "project", "milestone", "create", "p1", "--name", "GA", "--target-date", "2025-07-01")
+	if r.Exit != 0 || !strings.Contains(r.Stderr, "after the project's target date (project Auth runs 2025-03-01 to 2025-06-30)") {
+		t.Errorf("late date exited %d: %s", r.Exit, r.Stderr)
+	}
+
+	s.Reset()
+	r = runMocked(t, "project", "milestone", "create", "p1", "--bulk", "Alpha:2025-04-01,GA:2025-07-01", "--strict-dates")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "GA: target date 2025-07-01") {
+		t.Errorf("--strict-dates exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "ProjectMilestoneCreate" {
+			t.Error("--strict-dates created a milestone")
+		}
+	}
+
+	// --no-validate doesn't look at the project, and expressions become dates
+	s.Reset()
+	r = runMocked(t, "project", "milestone", "create", "p1", "--name", "GA", "--target-date", "+2_weeks", "--no-validate", "--json")
+	if r.Exit != 0 || strings.Join(s.Operations(), ",") != "ProjectMilestoneCreate" {
+		t.Fatalf("--no-validate exited %d (operations %v): %s", r.Exit, s.Operations(), r.Stderr)
+	}
+	if date, _ := s.Requests()[0].Variables["input"].(map[string]interface{})["targetDate"].(string); len(date) != len("2025-01-01") {
+		t.Errorf("targetDate = %q", date)
+	}
+
+	if r := runMocked(t, "project", "milestone", "create", "p1", "--name", "GA", "--target-date", "next_tuesday"); r.Exit != 1 {
+		t.Errorf("bad expression exited %d", r.Exit)
+	}
+}
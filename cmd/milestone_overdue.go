@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+)
+
+// teamMilestoneConcurrency bounds the per-project milestone lookups in
+// project milestone list --team
+const teamMilestoneConcurrency = 8
+
+// milestoneOverdue reports whether a milestone's target date has passed
+// while it is still not done. Like issue due dates, a milestone due today is
+// not overdue yet.
+func milestoneOverdue(ms *api.ProjectMilestone, now time.Time) bool {
+	if ms.TargetDate == nil || ms.Status == "done" {
+		return false
+	}
+	_, overdue, err := utils.DueDateOverdue(*ms.TargetDate, now)
+	return err == nil && overdue
+}
+
+// milestoneTargetLabel renders a milestone's target date, in red with an
+// "(overdue)" suffix when it has passed. It returns "" without a target date.
+func milestoneTargetLabel(ms *api.ProjectMilestone, now time.Time) string {
+	if ms.TargetDate == nil {
+		return ""
+	}
+	if milestoneOverdue(ms, now) {
+		return color.New(color.FgRed).Sprintf("%s (overdue)", *ms.TargetDate)
+	}
+	return *ms.TargetDate
+}
+
+// parseMilestoneTargetDate turns a --target-date value into the mutation
+// input: "none" (or empty) clears the date, anything else is a date or a
+// date expression such as +2_weeks or end_of_quarter, sent as YYYY-MM-DD
+func parseMilestoneTargetDate(value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.EqualFold(value, "none") {
+		return nil, nil
+	}
+	date, err := utils.ParseDateExpression(value, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("invalid target date '%s' (expected YYYY-MM-DD, an expression like +2_weeks or end_of_quarter, or none to clear)", value)
+	}
+	return date, nil
+}
+
+// fetchTeamProjects pages through the team's projects that are not completed
+// or canceled
+func fetchTeamProjects(client *api.Client, teamID string) ([]api.Project, error) {
+	filter := map[string]interface{}{
+		"accessibleTeams": map[string]interface{}{
+			"some": map[string]interface{}{
+				"id": map[string]interface{}{"eq": teamID},
+			},
+		},
+		"state": map[string]interface{}{
+			"nin": []string{"completed", "canceled"},
+		},
+	}
+	var all []api.Project
+	after := ""
+	for {
+		page, err := client.GetProjects(context.Background(), filter, 100, after, "")
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// fetchProjectMilestones pages through all of a project's milestones
+func fetchProjectMilestones(client *api.Client, projectID string) ([]api.ProjectMilestone, error) {
+	var all []api.ProjectMilestone
+	after := ""
+	for {
+		page, err := client.GetProjectMilestones(context.Background(), projectID, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// collectTeamMilestones fetches every project's milestones concurrently and
+// returns them with their Project set, ordered by target date (undated
+// last), then project name, then sort order. The first failure is returned
+// naming its project.
+func collectTeamMilestones(client *api.Client, projects []api.Project) ([]api.ProjectMilestone, error) {
+	perProject := make([][]api.ProjectMilestone, len(projects))
+	errs := make([]error, len(projects))
+	sem := make(chan struct{}, teamMilestoneConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range projects {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			perProject[i], errs[i] = fetchProjectMilestones(client, projects[i].ID)
+		}(i)
+	}
+	wg.Wait()
+
+	var all []api.ProjectMilestone
+	for i, p := range projects {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("project '%s': %v", p.Name, errs[i])
+		}
+		owner := &api.Project{ID: p.ID, Name: p.Name, State: p.State}
+		for _, ms := range perProject[i] {
+			ms.Project = owner
+			all = append(all, ms)
+		}
+	}
+	sortTeamMilestones(all)
+	return all, nil
+}
+
+// sortTeamMilestones orders milestones by target date with undated ones
+// last, breaking ties by project name and then sort order
+func sortTeamMilestones(milestones []api.ProjectMilestone) {
+	sort.SliceStable(milestones, func(i, j int) bool {
+		a, b := milestones[i], milestones[j]
+		if (a.TargetDate == nil) != (b.TargetDate == nil) {
+			return a.TargetDate != nil
+		}
+		if a.TargetDate != nil && *a.TargetDate != *b.TargetDate {
+			return *a.TargetDate < *b.TargetDate
+		}
+		if an, bn := milestoneProjectName(&a), milestoneProjectName(&b); an != bn {
+			return an < bn
+		}
+		return a.SortOrder < b.SortOrder
+	})
+}
+
+// milestoneProjectName returns the owning project's name, or ""
+func milestoneProjectName(ms *api.ProjectMilestone) string {
+	if ms.Project == nil {
+		return ""
+	}
+	return ms.Project.Name
+}
+
+// filterOverdueMilestones keeps only milestones that are overdue at now
+func filterOverdueMilestones(milestones []api.ProjectMilestone, now time.Time) []api.ProjectMilestone {
+	kept := make([]api.ProjectMilestone, 0, len(milestones))
+	for i := range milestones {
+		if milestoneOverdue(&milestones[i], now) {
+			kept = append(kept, milestones[i])
+		}
+	}
+	return kept
+}
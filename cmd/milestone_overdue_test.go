@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func strPtr(s string) *string { return &s }
+
+func TestMilestoneOverdue(t *testing.T) {
+	now := time.Date(2025, 6, 15, 18, 0, 0, 0, time.Local)
+	tests := []struct {
+		name string
+		ms   api.ProjectMilestone
+		want bool
+	}{
+		{"past, in progress", api.ProjectMilestone{TargetDate: strPtr("2025-06-14"), Status: "next"}, true},
+		{"past, done", api.ProjectMilestone{TargetDate: strPtr("2025-06-01"), Status: "done"}, false},
+		{"due today", api.ProjectMilestone{TargetDate: strPtr("2025-06-15"), Status: "next"}, false},
+		{"future", api.ProjectMilestone{TargetDate: strPtr("2025-07-01"), Status: "unstarted"}, false},
+		{"no date", api.ProjectMilestone{Status: "next"}, false},
+		{"bad date", api.ProjectMilestone{TargetDate: strPtr("soon"), Status: "next"}, false},
+	}
+	for _, tt := range tests {
+		if got := milestoneOverdue(&tt.ms, now); got != tt.want {
+			t.Errorf("%s: milestoneOverdue = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestParseMilestoneTargetDate(t *testing.T) {
+	for _, in := range []string{"none", "NONE", "", "  "} {
+		if v, err := parseMilestoneTargetDate(in); err != nil || v != nil {
+			t.Errorf("parseMilestoneTargetDate(%q) = %v, %v; want nil", in, v, err)
+		}
+	}
+	if v, err := parseMilestoneTargetDate("2025-12-31"); err != nil || v != "2025-12-31" {
+		t.Errorf("parseMilestoneTargetDate(date) = %v, %v", v, err)
+	}
+	if _, err := parseMilestoneTargetDate("31/12/2025"); err == nil {
+		t.Error("parseMilestoneTargetDate accepted a non-ISO date")
+	}
+}
+
+func TestCollectTeamMilestones(t *testing.T) {
+	// Project p1 has two pages of milestones; p2 has one
+	pages := map[string][]string{
+		"p1": {`[{"id":"m1","name":"Beta","targetDate":"2025-03-01","sortOrder":2}]`, `[{"id":"m2","name":"Alpha","targetDate":"2025-03-01","sortOrder":1}]`},
+		"p2": {`[{"id":"m3","name":"GA","targetDate":"2025-01-01"},{"id":"m4","name":"Someday"}]`},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				After  string `json:"after"`
+				Filter struct {
+					Project struct {
+						ID struct {
+							Eq string `json:"eq"`
+						} `json:"id"`
+					} `json:"project"`
+				} `json:"filter"`
+			} `json:"variables"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		project := req.Variables.Filter.Project.ID.Eq
+		page := 0
+		if req.Variables.After != "" {
+			page = 1
+		}
+		nodes := pages[project][page]
+		hasNext := page+1 < len(pages[project])
+		fmt.Fprintf(w, `{"data":{"projectMilestones":{"nodes":%s,"pageInfo":{"hasNextPage":%v,"endCursor":"c%d"}}}}`, nodes, hasNext, page)
+	}))
+	defer server.Close()
+
+	projects := []api.Project{{ID: "p1", Name: "Zeta"}, {ID: "p2", Name: "Apex"}}
+	got, err := collectTeamMilestones(api.NewClientWithURL(server.URL, "key"), projects)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct{ id, project string }{{"m3", "Apex"}, {"m2", "Zeta"}, {"m1", "Zeta"}, {"m4", "Apex"}}
+	if len(got) != len(want) {
+		t.Fatalf("got %d milestones, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].ID != w.id || milestoneProjectName(&got[i]) != w.project {
+			t.Errorf("milestone %d = %s (%s), want %s (%s)", i, got[i].ID, milestoneProjectName(&got[i]), w.id, w.project)
+		}
+	}
+}
+
+func TestCollectTeamMilestonesReportsProject(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errors":[{"message":"boom"}]}`))
+	}))
+	defer server.Close()
+
+	_, err := collectTeamMilestones(api.NewClientWithURL(server.URL, "key"), []api.Project{{ID: "p1", Name: "Zeta"}})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.HasPrefix(err.Error(), "project 'Zeta'") {
+		t.Errorf("error = %q, want it to name the project", err)
+	}
+}
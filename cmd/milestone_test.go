@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMilestoneSpec(t *testing.T) {
+	tests := []struct {
+		spec string
+		want milestoneEntry
+	}{
+		{"Beta:2025-03-01", milestoneEntry{Name: "Beta", TargetDate: "2025-03-01"}},
+		{" GA ", milestoneEntry{Name: "GA"}},
+		{"Phase 1: Design:2025-01-15", milestoneEntry{Name: "Phase 1: Design", TargetDate: "2025-01-15"}},
+		// A colon only starts a date when what follows looks like one
+		{"Phase 2: Launch", milestoneEntry{Name: "Phase 2: Launch"}},
+		{"Freeze:+2_weeks", milestoneEntry{Name: "Freeze", TargetDate: "+2_weeks"}},
+		{"Ship:End of month", milestoneEntry{Name: "Ship", TargetDate: "End of month"}},
+		// Mistyped dates are still split off, so validation rejects them
+		{"Beta:2025-3-1", milestoneEntry{Name: "Beta", TargetDate: "2025-3-1"}},
+		{"GA:2025-02-30", milestoneEntry{Name: "GA", TargetDate: "2025-02-30"}},
+	}
+	for _, tt := range tests {
+		if got := parseMilestoneSpec(tt.spec); got != tt.want {
+			t.Errorf("parseMilestoneSpec(%q) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+
+	entries, err := parseMilestoneBulk("Alpha:2025-03-01, Beta, Phase 3: GA:2025-06-01")
+	if err != nil || len(entries) != 3 || entries[1] != (milestoneEntry{Name: "Beta"}) ||
+		entries[2] != (milestoneEntry{Name: "Phase 3: GA", TargetDate: "2025-06-01"}) {
+		t.Errorf("parseMilestoneBulk = %+v, %v", entries, err)
+	}
+}
+
+func TestValidateMilestoneEntriesRejectsDateTypos(t *testing.T) {
+	for _, spec := range []string{"Beta:2025-3-1", "GA:2025-02-30", "Freeze:+2_fortnights"} {
+		entries := []milestoneEntry{parseMilestoneSpec(spec)}
+		err := validateMilestoneEntries(entries)
+		if err == nil || !strings.Contains(err.Error(), "invalid target date") {
+			t.Errorf("validateMilestoneEntries(%q) = %v, want an invalid target date error", spec, err)
+		}
+	}
+}
+
+func TestHermeticMilestoneAssignProjectBySlug(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectMilestone", `{"projectMilestone":{"id":"m1","name":"Alpha","project":{"id":"p1","name":"Launch"}}}`)
+	s.DataFor("Project", map[string]interface{}{"id": "abc123"}, `{"project":{"id":"p1","slugId":"abc123","name":"Launch"}}`)
+	s.DataFor("Project", map[string]interface{}{"id": "def456"}, `{"project":{"id":"p2","slugId":"def456","name":"Elsewhere"}}`)
+	s.Data("ProjectIssues", `{"project":{"issues":{"nodes":[{"id":"i1","identifier":"ENG-1"}],"pageInfo":{"hasNextPage":false}}}}`)
+
+	// A slug ID or project URL names the same project the milestone does by UUID
+	for _, ref := range []string{"abc123", "https://linear.app/acme/project/launch-abc123/overview"} {
+		r := runMocked(t, "project", "milestone", "assign", "m1", "--project", ref, "--dry-run", "--plaintext")
+		if r.Exit != 0 {
+			t.Fatalf("--project %s: exit %d, stderr %q", ref, r.Exit, r.Stderr)
+		}
+		if !strings.Contains(r.Stdout, "ENG-1") {
+			t.Errorf("--project %s: stdout = %q, want ENG-1 previewed", ref, r.Stdout)
+		}
+	}
+
+	r := runMocked(t, "project", "milestone", "assign", "m1", "--project", "def456", "--dry-run", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "belongs to project 'Launch', not Elsewhere") {
+		t.Errorf("other project: exit %d, stderr %q", r.Exit, r.Stderr)
+	}
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// noOnboardEnv turns first-run onboarding off when set to a true value,
+// like --no-onboard, for machines that must never prompt
+const noOnboardEnv = "LINEAR_NO_ONBOARD"
+
+// onboardingTerminal reports whether someone is at a terminal to be walked
+// through signing in: stdin and stdout both attached to one
+var onboardingTerminal = func() bool {
+	return stdinIsTerminal() && stdoutIsTerminal()
+}
+
+// onboardingPrompts is how onboarding asks; tests replace it
+var onboardingPrompts = auth.TerminalPrompts
+
+// applyOnboarding sets up what a command does if it finds no credentials.
+// At a terminal it offers the guided sign-in and then carries on; anywhere
+// else, or with --no-onboard, JSON output, or under 'serve', it fails as
+// before with the commands that fix it on stderr. The auth commands handle
+// signing in themselves.
+func applyOnboarding(cmd *cobra.Command) {
+	off, _ := strconv.ParseBool(os.Getenv(noOnboardEnv))
+	interactive := !off && !viper.GetBool("no_onboard") && !viper.GetBool("json") && !serving &&
+		!isAuthCommand(cmd) && onboardingTerminal()
+	if interactive {
+		auth.EnableOnboarding(onboardingPrompts())
+		return
+	}
+	auth.ShowSetupHelp(os.Stderr)
+}
+
+// isAuthCommand reports whether cmd is 'auth' or one of its subcommands
+func isAuthCommand(cmd *cobra.Command) bool {
+	root := cmd.Root()
+	path := strings.TrimPrefix(cmd.CommandPath(), root.Name()+" ")
+	return path == authCmd.Name() || strings.HasPrefix(path, authCmd.Name()+" ")
+}
+
+// stdoutIsTerminal reports whether stdout is attached to a terminal
+func stdoutIsTerminal() bool {
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+)
+
+func TestFirstRunOnboarding(t *testing.T) {
+	s := newMockLinear(t)
+	t.Setenv("LINEAR_API_KEY", "")
+	s.Fixtures("testdata/linearmock/teams")
+	s.Data("Me", `{"viewer":{"id":"u1","name":"Ada","email":"ada@example.com"}}`)
+
+	// Not at a terminal: the usual error, plus how to fix it
+	r := runMocked(t, "team", "list", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "linear-cli auth login") || !strings.Contains(r.Stderr, "export LINEAR_API_KEY=") {
+		t.Fatalf("team list without credentials exited %d: stderr %q", r.Exit, r.Stderr)
+	}
+
+	defer func(terminal func() bool, prompts func() auth.Prompts) {
+		onboardingTerminal, onboardingPrompts = terminal, prompts
+	}(onboardingTerminal, onboardingPrompts)
+	onboardingTerminal = func() bool { return true }
+	var asked []string
+	onboardingPrompts = func() auth.Prompts {
+		answer := func(q string) (string, error) {
+			asked = append(asked, q)
+			if strings.Contains(q, "API Key") {
+				return "lin_api_pasted", nil
+			}
+			return "n", nil
+		}
+		return auth.Prompts{Out: &strings.Builder{}, Ask: answer, AskSecret: answer, OpenURL: func(string) error { return nil }}
+	}
+
+	// --no-onboard keeps the plain failure
+	r = runMocked(t, "team", "list", "--plaintext", "--no-onboard")
+	if r.Exit != 1 || len(asked) != 0 {
+		t.Fatalf("--no-onboard exited %d after asking %v", r.Exit, asked)
+	}
+
+	// At a terminal the sign-in runs, then the command carries on
+	r = runMocked(t, "team", "list", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "ENG\tEngineering") || len(asked) != 2 {
+		t.Fatalf("team list with onboarding exited %d after asking %v: %s%s", r.Exit, asked, r.Stdout, r.Stderr)
+	}
+	if s.Requests()[len(s.Requests())-1].Operation != "Teams" {
+		t.Errorf("operations = %v", s.Operations())
+	}
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// linearURL is what a Linear web URL points at. Key is what the entity's get
+// command takes: an issue identifier, a slug ID, or for cycles the cycle number
+// (with Team holding the team key).
+type linearURL struct {
+	Type string
+	Key  string
+	Team string
+}
+
+// parseLinearURL identifies the entity a Linear URL refers to, e.g.
+//
+//	https://linear.app/acme/issue/ENG-123/fix-login
+//	https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f/overview
+//	https://linear.app/acme/team/ENG/cycle/12
+func parseLinearURL(raw string) (*linearURL, error) {
+	value := strings.TrimSpace(raw)
+	if !strings.Contains(value, "://") {
+		value = "https://" + value
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Host != "linear.app" && u.Host != "www.linear.app") {
+		return nil, fmt.Errorf("not a Linear URL: %s", raw)
+	}
+
+	// /{workspace}/{kind}/{key}/...
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) >= 3 && segments[2] != "" {
+		switch kind := segments[1]; kind {
+		case "issue":
+			if issueIdentifierPattern.MatchString(segments[2]) {
+				return &linearURL{Type: "issue", Key: strings.ToUpper(segments[2])}, nil
+			}
+		case "project", "document", "initiative", "view":
+			return &linearURL{Type: kind, Key: urlSlugID(segments[2])}, nil
+		case "team":
+			if len(segments) >= 5 && segments[3] == "cycle" {
+				if _, err := strconv.Atoi(segments[4]); err == nil {
+					return &linearURL{Type: "cycle", Key: segments[4], Team: strings.ToUpper(segments[2])}, nil
+				}
+			}
+		}
+	}
+	return nil, fmt.Errorf("unsupported Linear URL: %s (expected an issue, project, document, initiative, cycle, or view link)", raw)
+}
+
+// projectRefKey returns what GetProject takes for a project reference: the
+// slug ID of a project URL, or the reference itself
+func projectRefKey(ref string) string {
+	if target, err := parseLinearURL(ref); err == nil && target.Type == "project" {
+		return target.Key
+	}
+	return strings.TrimSpace(ref)
+}
+
+// urlSlugID returns the slug ID at the end of a "name-slugid" URL segment
+func urlSlugID(segment string) string {
+	if i := strings.LastIndex(segment, "-"); i >= 0 && i < len(segment)-1 {
+		return segment[i+1:]
+	}
+	return segment
+}
+
+// openTarget routes one entity type to its get command and fetcher
+type openTarget struct {
+	get   *cobra.Command
+	fetch func(ctx context.Context, client *api.Client, id string) (interface{}, error)
+}
+
+var openTargets = map[string]openTarget{
+	"issue": {issueGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return resolveIssueRef(ctx, client, id)
+	}},
+	"project": {projectGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return client.GetProject(ctx, id)
+	}},
+	"document": {documentGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return client.GetDocument(ctx, id)
+	}},
+	"initiative": {initiativeGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return client.GetInitiative(ctx, id)
+	}},
+	"cycle": {cycleGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return client.GetCycle(ctx, id)
+	}},
+	"view": {viewGetCmd, func(ctx context.Context, client *api.Client, id string) (interface{}, error) {
+		return client.GetCustomView(ctx, id)
+	}},
+}
+
+// openActions are the --action shortcuts for issue URLs
+var openActions = map[string]*cobra.Command{
+	"archive": issueArchiveCmd,
+	"done":    issueDoneCmd,
+	"start":   issueStartCmd,
+}
+
+// findCycleByNumber returns the ID of a team's cycle with the given number
+func findCycleByNumber(ctx context.Context, client *api.Client, teamKey, number string) (string, error) {
+	team, err := client.GetTeam(ctx, teamKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to find team %s: %w", teamKey, err)
+	}
+	n, _ := strconv.Atoi(number)
+	filter := map[string]interface{}{
+		"team":   map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}},
+		"number": map[string]interface{}{"eq": n},
+	}
+	cycles, err := client.GetCycles(ctx, filter, 1, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to find cycle: %w", err)
+	}
+	if len(cycles.Nodes) == 0 {
+		return "", fmt.Errorf("cycle %s not found in team %s", number, team.Key)
+	}
+	return cycles.Nodes[0].ID, nil
+}
+
+var openCmd = &cobra.Command{
+	Use:   "open URL",
+	Short: "Show whatever a Linear URL points at",
+	Long: `Show the issue, project, document, initiative, cycle, or view a Linear URL
+points at, with the same output as the matching get command. Nothing is opened
+in a browser.
+
+With --json the output is {"type": "issue", "entity": {...}}.
+
+--action applies a shortcut to an issue URL instead of showing it: archive,
+done, or start, with the same output as 'issue archive', 'issue done', or
+'issue start'.
+
+Examples:
+  linear-cli open https://linear.app/acme/issue/ENG-123/fix-login
+  linear-cli open https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f --json
+  linear-cli open https://linear.app/acme/team/ENG/cycle/12 -p
+  linear-cli open https://linear.app/acme/issue/ENG-123 --action done`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		target, err := parseLinearURL(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		action, _ := cmd.Flags().GetString("action")
+		if action != "" {
+			actionCmd, ok := openActions[action]
+			if !ok {
+				output.Error(fmt.Sprintf("Invalid --action %q: use archive, done, or start", action), plaintext, jsonOut)
+				exit(1)
+			}
+			if target.Type != "issue" {
+				output.Error(fmt.Sprintf("--action only applies to issue URLs, not a %s", target.Type), plaintext, jsonOut)
+				exit(1)
+			}
+			actionCmd.Run(actionCmd, []string{target.Key})
+			return
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		id := target.Key
+		if target.Type == "cycle" {
+			id, err = findCycleByNumber(context.Background(), client, target.Team, target.Key)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		route := openTargets[target.Type]
+		if !jsonOut {
+			route.get.Run(route.get, []string{id})
+			return
+		}
+
+		entity, err := route.fetch(context.Background(), client, id)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch %s: %v", target.Type, err), plaintext, jsonOut)
+			exit(1)
+		}
+		output.JSON(map[string]interface{}{
+			"type":   target.Type,
+			"entity": entity,
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(openCmd)
+	openCmd.Flags().String("action", "", "Apply a shortcut to an issue URL instead of showing it: archive, done, or start")
+}
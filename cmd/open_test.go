@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseLinearURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want linearURL
+	}{
+		{"https://linear.app/acme/issue/ENG-123/fix-login", linearURL{Type: "issue", Key: "ENG-123"}},
+		{"https://linear.app/acme/issue/eng-123", linearURL{Type: "issue", Key: "ENG-123"}},
+		{"linear.app/acme/issue/ENG-123?comment=abc", linearURL{Type: "issue", Key: "ENG-123"}},
+		{"https://linear.app/acme/project/q3-roadmap-0a1b2c3d4e5f/overview", linearURL{Type: "project", Key: "0a1b2c3d4e5f"}},
+		{"https://linear.app/acme/document/release-notes-9f8e7d6c5b4a", linearURL{Type: "document", Key: "9f8e7d6c5b4a"}},
+		{"https://www.linear.app/acme/initiative/mobile-launch-1a2b3c4d5e6f", linearURL{Type: "initiative", Key: "1a2b3c4d5e6f"}},
+		{"https://linear.app/acme/view/my-bugs-abcdef012345", linearURL{Type: "view", Key: "abcdef012345"}},
+		{"https://linear.app/acme/team/eng/cycle/12", linearURL{Type: "cycle", Key: "12", Team: "ENG"}},
+	}
+	for _, tt := range tests {
+		got, err := parseLinearURL(tt.url)
+		if err != nil {
+			t.Errorf("parseLinearURL(%q) error: %v", tt.url, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("parseLinearURL(%q) = %+v, want %+v", tt.url, *got, tt.want)
+		}
+	}
+}
+
+func TestParseLinearURLErrors(t *testing.T) {
+	tests := []struct {
+		url        string
+		wantPrefix string
+	}{
+		{"https://github.com/acme/repo/issues/1", "not a Linear URL"},
+		{"https://linear.app.evil.com/acme/issue/ENG-1", "not a Linear URL"},
+		{"ENG-123", "not a Linear URL"},
+		{"https://linear.app/acme", "unsupported Linear URL"},
+		{"https://linear.app/acme/settings/api", "unsupported Linear URL"},
+		{"https://linear.app/acme/issue/not-an-id", "unsupported Linear URL"},
+		{"https://linear.app/acme/team/ENG/cycle/current", "unsupported Linear URL"},
+		{"https://linear.app/acme/team/ENG/active", "unsupported Linear URL"},
+	}
+	for _, tt := range tests {
+		_, err := parseLinearURL(tt.url)
+		if err == nil || !strings.HasPrefix(err.Error(), tt.wantPrefix) {
+			t.Errorf("parseLinearURL(%q) error = %v, want %q...", tt.url, err, tt.wantPrefix)
+		}
+	}
+}
+
+func TestOpenTargetsCoverParsedTypes(t *testing.T) {
+	for _, typ := range []string{"issue", "project", "document", "initiative", "cycle", "view"} {
+		if route, ok := openTargets[typ]; !ok || route.get == nil || route.fetch == nil {
+			t.Errorf("no open route for %s", typ)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// createdEntity is what a create command's success output says about the
+// entity it made
+type createdEntity struct {
+	Type string // "issue", "project", "document", ...
+	ID   string
+	Name string // identifier (ENG-42) where there is one, else the name
+	URL  string // "" when the entity has no page or the API didn't say
+}
+
+// porcelainLine is the --porcelain output for e:
+//
+//	<type>\t<id>\t<identifier-or-name>\t<url>\n
+//
+// This shape is a stability contract for scripts; it won't change without a
+// major version. Tabs and line breaks in names become spaces, and a missing
+// URL leaves its field empty.
+func (e createdEntity) porcelainLine() string {
+	name := strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ").Replace(e.Name)
+	return fmt.Sprintf("%s\t%s\t%s\t%s\n", e.Type, e.ID, name, e.URL)
+}
+
+// addPorcelainFlag registers --porcelain on a create command. Register it
+// after --url-only, which it excludes.
+func addPorcelainFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("porcelain", false, "Print only one stable tab-separated line per created entity: type, ID, identifier or name, URL")
+	if cmd.Flags().Lookup("url-only") != nil {
+		cmd.MarkFlagsMutuallyExclusive("porcelain", "url-only")
+	}
+}
+
+// porcelain reports whether the command was asked for --porcelain output
+func porcelain(cmd *cobra.Command) bool {
+	if cmd.Flags().Lookup("porcelain") == nil {
+		return false
+	}
+	on, _ := cmd.Flags().GetBool("porcelain")
+	return on
+}
+
+// scriptedOutput reports whether the command prints only a machine-readable
+// line (--porcelain or --url-only), so --json is ignored and errors stay
+// plain text on stderr
+func scriptedOutput(cmd *cobra.Command) bool {
+	return porcelain(cmd) || urlOnly(cmd)
+}
+
+// printCreated is the shared success path of create commands for scripted
+// output: it prints e's porcelain line for --porcelain or its URL for
+// --url-only and returns true. Otherwise it prints nothing and returns
+// false, and the command prints its usual output.
+func printCreated(cmd *cobra.Command, e createdEntity) bool {
+	switch {
+	case porcelain(cmd):
+		fmt.Print(e.porcelainLine())
+		return true
+	case urlOnly(cmd):
+		printURLOnly(e.URL, e.Type)
+		return true
+	}
+	return false
+}
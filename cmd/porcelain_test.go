@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPorcelainLine(t *testing.T) {
+	e := createdEntity{Type: "label", ID: "l1", Name: "needs\ttriage\r\nnow"}
+	if got, want := e.porcelainLine(), "label\tl1\tneeds triage now\t\n"; got != want {
+		t.Errorf("porcelainLine() = %q, want %q", got, want)
+	}
+}
+
+func TestPorcelain(t *testing.T) {
+	s := newMockLinear(t)
+	issue := mockFixture(t, "issue_get")
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("CreateIssue", `{"issueCreate":{"issue":`+issue+`}}`)
+	s.Data("Issue", `{"issue":`+issue+`}`)
+	s.Data("CreateProject", `{"projectCreate":{"project":{"id":"p1","name":"Checkout","url":"https://linear.app/acme/project/checkout-abc"}}}`)
+	s.Data("Project", `{"project":{"id":"p1","name":"Checkout","url":"https://linear.app/acme/project/checkout-abc"}}`)
+	s.Data("CreateDocument", `{"documentCreate":{"document":{"id":"d1","title":"Runbook","url":"https://linear.app/acme/document/runbook-1a2b"}}}`)
+	s.Data("InitiativeCreate", `{"initiativeCreate":{"success":true,"initiative":{"id":"in1","name":"Q4 reliability","url":"https://linear.app/acme/initiative/q4-reliability-3c4d"}}}`)
+	s.Data("CreateLabel", `{"issueLabelCreate":{"issueLabel":{"id":"l1","name":"needs-triage"}}}`)
+	s.Data("CreateCycle", `{"cycleCreate":{"cycle":{"id":"c1","number":12,"name":""}}}`)
+	s.Data("CreateComment", `{"commentCreate":{"comment":{"id":"3f2a1b9c-0000-4000-8000-000000000001","body":"Shipped"}}}`)
+	s.Data("ProjectMilestones", `{"projectMilestones":{"nodes":[],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("ProjectMilestoneCreate", `{"projectMilestoneCreate":{"projectMilestone":{"id":"ms-1","name":"Alpha",
+		"project":{"id":"p1","name":"Checkout","url":"https://linear.app/acme/project/checkout-abc"}}}}`)
+	s.Data("CustomViewCreate", `{"customViewCreate":{"success":true,"customView":{"id":"v1","name":"Bugs","slugId":"9f8e7d6c5b4a",
+		"organization":{"id":"o1","name":"Acme","urlKey":"acme"}}}}`)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--porcelain"},
+			"issue\ta1b2c3d4-0000-4000-8000-000000000001\tENG-42\thttps://linear.app/acme/issue/ENG-42\n"},
+		{[]string{"project", "create", "--name", "Checkout", "--porcelain"},
+			"project\tp1\tCheckout\thttps://linear.app/acme/project/p1\n"},
+		{[]string{"document", "create", "--title", "Runbook", "--team", "ENG", "--porcelain"},
+			"document\td1\tRunbook\thttps://linear.app/acme/document/runbook-1a2b\n"},
+		{[]string{"initiative", "create", "--name", "Q4 reliability", "--porcelain"},
+			"initiative\tin1\tQ4 reliability\thttps://linear.app/acme/initiative/q4-reliability-3c4d\n"},
+		// Labels and cycles have no page, so the URL field is empty
+		{[]string{"label", "create", "--name", "needs-triage", "--porcelain"},
+			"label\tl1\tneeds-triage\t\n"},
+		{[]string{"cycle", "create", "--team-id", "team-eng", "--starts", "2026-11-02", "--ends", "2026-11-16", "--porcelain"},
+			"cycle\tc1\tCycle 12\t\n"},
+		{[]string{"project", "milestone", "create", "p1", "--bulk", "Alpha:2026-11-01,Beta:2026-12-01", "--porcelain"},
+			"milestone\tms-1\tAlpha\thttps://linear.app/acme/project/checkout-abc/overview#milestone-ms-1\n" +
+				"milestone\tms-1\tBeta\thttps://linear.app/acme/project/checkout-abc/overview#milestone-ms-1\n"},
+		{[]string{"view", "create", "--name", "Bugs", "--porcelain", "--json"},
+			"view\tv1\tBugs\thttps://linear.app/acme/view/9f8e7d6c5b4a\n"},
+		{[]string{"issue", "comment", "create", "ENG-42", "--body", "Shipped", "--porcelain"},
+			"comment\t3f2a1b9c-0000-4000-8000-000000000001\tENG-42\thttps://linear.app/acme/issue/ENG-42#comment-3f2a1b9c\n"},
+	}
+	for _, tt := range tests {
+		r := runMocked(t, tt.args...)
+		if r.Exit != 0 || r.Stdout != tt.want {
+			t.Errorf("%s exited %d: stdout %q, want %q (stderr %q)", strings.Join(tt.args, " "), r.Exit, r.Stdout, tt.want, r.Stderr)
+		}
+	}
+
+	s.Reset()
+	s.Error("CreateLabel", 200, "Label name already exists", "")
+	r := runMocked(t, "label", "create", "--name", "needs-triage", "--porcelain", "--json")
+	if r.Exit != 1 || r.Stdout != "" || !strings.Contains(r.Stderr, "Label name already exists") {
+		t.Errorf("failed label create --porcelain exited %d: stdout %q, stderr %q", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/viper"
+)
+
+// newProgress starts a stderr progress reporter for a bulk command, honoring
+// --progress. The result may be nil, which reports nothing.
+func newProgress(label string, total int) *output.Progress {
+	mode, err := output.ParseProgressMode(viper.GetString("progress"))
+	if err != nil {
+		output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+		exit(1)
+	}
+	return output.NewProgress(os.Stderr, mode, stderrIsTerminal(), label, total)
+}
+
+// runWithProgress runs task for each of n items on the shared pool, reporting
+// each finished item, named by entity, under --progress
+func runWithProgress(label string, n int, entity func(i int) string, task func(ctx context.Context, i int) error) []error {
+	progress := newProgress(label, n)
+	errs := api.DefaultPool().RunEach(context.Background(), n, task, func(i int, _ error) {
+		progress.Step(entity(i))
+	})
+	progress.Finish()
+	return errs
+}
+
+// stderrIsTerminal reports whether stderr is attached to a terminal
+func stderrIsTerminal() bool {
+	info, err := os.Stderr.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
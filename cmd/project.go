@@ -3,11 +3,14 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
@@ -33,6 +36,64 @@ func constructProjectURL(projectID string, originalURL string) string {
 	return originalURL
 }
 
+// constructMilestoneURL links to a milestone on its project's overview.
+// The API has no milestone URL, so it is the project URL plus an anchor:
+// https://linear.app/{workspace}/project/{slug}/overview#milestone-{id}
+func constructMilestoneURL(projectURL string, milestoneID string) string {
+	if projectURL == "" {
+		return ""
+	}
+	base := strings.TrimSuffix(strings.TrimSuffix(projectURL, "/"), "/overview")
+	return fmt.Sprintf("%s/overview#milestone-%s", base, milestoneID)
+}
+
+// constructCommentURL links to a comment on its issue, for when the API
+// didn't return the comment's own URL. Linear anchors comments by the first
+// eight characters of their ID: {issueURL}#comment-{short id}
+func constructCommentURL(issueURL string, commentID string) string {
+	if issueURL == "" {
+		return ""
+	}
+	if i := strings.Index(issueURL, "#"); i >= 0 {
+		issueURL = issueURL[:i]
+	}
+	short := strings.ReplaceAll(commentID, "-", "")
+	if len(short) > 8 {
+		short = short[:8]
+	}
+	return fmt.Sprintf("%s#comment-%s", issueURL, short)
+}
+
+// constructViewURL links to a custom view by its slug ID, which Linear
+// resolves without the name part: https://linear.app/{workspace}/view/{slugId}
+func constructViewURL(workspace string, slugID string) string {
+	if workspace == "" || slugID == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://linear.app/%s/view/%s", workspace, slugID)
+}
+
+// projectInitiatives lists the initiatives a project rolls up to, each
+// formatted by format, joined with commas; "" when there are none
+func projectInitiatives(project *api.Project, format func(api.Initiative) string) string {
+	if project.Initiatives == nil {
+		return ""
+	}
+	var parts []string
+	for _, initiative := range project.Initiatives.Nodes {
+		parts = append(parts, format(initiative))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// initiativeWithStatus formats an initiative as "Name (Status)"
+func initiativeWithStatus(initiative api.Initiative) string {
+	if initiative.Status == "" {
+		return initiative.Name
+	}
+	return fmt.Sprintf("%s (%s)", initiative.Name, initiative.Status)
+}
+
 // resolveInitiativeID resolves an initiative name or UUID to an initiative ID.
 // If the value looks like a UUID, it is returned as-is. Otherwise, initiatives are
 // listed and the first one whose name matches (case-insensitive) is returned.
@@ -45,12 +106,14 @@ func resolveInitiativeID(client *api.Client, ctx context.Context, value string)
 	if err != nil {
 		return "", fmt.Errorf("failed to list initiatives: %w", err)
 	}
+	var candidates []string
 	for _, init := range initiatives.Nodes {
 		if strings.EqualFold(init.Name, value) {
 			return init.ID, nil
 		}
+		candidates = append(candidates, init.Name)
 	}
-	return "", fmt.Errorf("initiative not found: %s", value)
+	return "", utils.NewNotFoundError(fmt.Sprintf("initiative not found: %s", value), value, candidates)
 }
 
 // projectCmd represents the project command
@@ -72,16 +135,60 @@ var projectListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List projects",
-	Long:    `List all projects in your Linear workspace.`,
+	Long: `List all projects in your Linear workspace.
+
+Health is marked stale when it has not been updated in over 14 days.
+--risk-report lists only atRisk and offTrack projects with each one's latest
+status update; with --plaintext it prints a markdown digest grouped by health.
+
+By default only projects created in the last 6 months are listed; set
+project_list.newer_than in the config file to change that. When the default
+window hides projects, a note on stderr says how many. --newer-than sets the
+window explicitly, --all-time (or --newer-than all_time) removes it, and
+--active-since also keeps older projects that were updated within the window.
+
+Examples:
+  linear-cli project list --all-time
+  linear-cli project list --active-since 2_weeks_ago
+  linear-cli project list --health atRisk
+  linear-cli project list --risk-report
+  linear-cli project list --risk-report --plaintext > digest.md
+  linear-cli project list --health offTrack --ids-only
+  linear-cli project list --all-time --count
+  linear-cli project list --show-initiatives
+
+--ids-only prints one project ID per line and --count just the number of
+matching projects (every match, ignoring --limit); both fetch only IDs and
+can't be combined with --json or --plaintext.
+
+The table leaves out the initiatives each project rolls up to unless
+--show-initiatives adds an Initiative column; plaintext and JSON always
+include them.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		descriptionLines, _ := cmd.Flags().GetInt("description-lines")
+
+		// Resolve the time window before any request, so typos fail fast
+		newerThan, _ := cmd.Flags().GetString("newer-than")
+		activeSince, _ := cmd.Flags().GetString("active-since")
+		allTime, _ := cmd.Flags().GetBool("all-time")
+		window, err := resolveProjectListWindow(newerThan, activeSince, allTime, viper.GetString("project_list.newer_than"))
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		script, err := scriptOutputFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -92,15 +199,32 @@ var projectListCmd = &cobra.Command{
 		state, _ := cmd.Flags().GetString("state")
 		limit, _ := cmd.Flags().GetInt("limit")
 		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
+		health, _ := cmd.Flags().GetString("health")
+		riskReport, _ := cmd.Flags().GetBool("risk-report")
+		showInitiatives, _ := cmd.Flags().GetBool("show-initiatives")
+
+		if health != "" && !isValidHealth(health) {
+			output.Error(fmt.Sprintf("Invalid health value '%s'. Valid values: onTrack, atRisk, offTrack", health), plaintext, jsonOut)
+			exit(1)
+		}
+		if riskReport && health == "onTrack" {
+			output.Error("--risk-report only covers atRisk and offTrack projects", plaintext, jsonOut)
+			exit(1)
+		}
 
 		// Build filter
 		filter := make(map[string]interface{})
+		if health != "" {
+			filter["health"] = map[string]interface{}{"eq": health}
+		} else if riskReport {
+			filter["health"] = map[string]interface{}{"in": riskHealthOrder}
+		}
 		if teamKey != "" {
 			// Get team ID from key
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			// ProjectFilter uses accessibleTeams (TeamCollectionFilter) since
 			// projects can belong to multiple teams. We filter for projects
@@ -120,40 +244,70 @@ var projectListCmd = &cobra.Command{
 			}
 		}
 
-		// Handle newer-than filter
-		newerThan, _ := cmd.Flags().GetString("newer-than")
-		createdAt, err := utils.ParseTimeExpression(newerThan)
-		if err != nil {
-			output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-			os.Exit(1)
-		}
-		if createdAt != "" {
-			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
+		windowed := make(map[string]interface{}, len(filter)+1)
+		for k, v := range filter {
+			windowed[k] = v
 		}
+		window.apply(windowed)
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
+		projectSorting, err := parseProjectSort(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if script.Count {
+			count, err := client.CountProjects(context.Background(), windowed)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to count projects: %v", err), plaintext, jsonOut)
+				exit(1)
 			}
+			fmt.Println(count)
+			window.reportHiddenProjects(client, filter, os.Stderr)
+			return
 		}
 
 		// Get projects
-		projects, err := client.GetProjects(context.Background(), filter, limit, "", orderBy)
+		// --ids-only fetches just IDs unless a client-side sort needs the
+		// fields it sorts by
+		fetchProjects := client.GetProjects
+		if script.IDsOnly && projectSorting.key == nil {
+			fetchProjects = client.GetProjectIDs
+		}
+		var projects *api.Projects
+		if fetchAll, _ := cmd.Flags().GetBool("all"); fetchAll {
+			projects, err = fetchAllProjectPages(func(after string) (*api.Projects, error) {
+				return fetchProjects(context.Background(), windowed, 100, after, projectSorting.OrderBy)
+			})
+		} else {
+			projects, err = fetchProjects(context.Background(), windowed, limit, "", projectSorting.OrderBy)
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		warnIfTruncated(cmd, projects.PageInfo, len(projects.Nodes))
+		window.reportHiddenProjects(client, filter, os.Stderr)
+		projectSorting.apply(projects.Nodes)
+		projectSorting.warnSortedWindow(projects.PageInfo, len(projects.Nodes))
+		if script.IDsOnly {
+			script.printProjects(projects.Nodes)
+			return
+		}
+
+		now := time.Now()
+		if riskReport {
+			rows := buildRiskReport(client, projects.Nodes, now)
+			if jsonOut {
+				output.JSON(rows)
+			} else if plaintext {
+				fmt.Print(renderRiskDigest(rows, now))
+			} else {
+				printRiskTable(rows, plaintext, jsonOut)
+			}
+			return
 		}
 
 		// Handle output
@@ -168,7 +322,11 @@ var projectListCmd = &cobra.Command{
 				fmt.Printf("- **State**: %s\n", project.State)
 				fmt.Printf("- **Progress**: %.0f%%\n", project.Progress*100)
 				if project.Health != "" {
-					fmt.Printf("- **Health**: %s\n", project.Health)
+					if isHealthStale(project.HealthUpdatedAt, now) {
+						fmt.Printf("- **Health**: %s (stale)\n", project.Health)
+					} else {
+						fmt.Printf("- **Health**: %s\n", project.Health)
+					}
 				}
 				if project.Priority > 0 {
 					fmt.Printf("- **Priority**: %s\n", project.PriorityLabel)
@@ -191,24 +349,25 @@ var projectListCmd = &cobra.Command{
 					}
 					fmt.Printf("- **Teams**: %s\n", teams)
 				}
+				if initiatives := projectInitiatives(&project, initiativeWithStatus); initiatives != "" {
+					fmt.Printf("- **Initiatives**: %s\n", initiatives)
+				}
 				if project.StartDate != nil {
 					fmt.Printf("- **Start Date**: %s\n", *project.StartDate)
 				}
 				if project.TargetDate != nil {
 					fmt.Printf("- **Target Date**: %s\n", *project.TargetDate)
 				}
-				fmt.Printf("- **Created**: %s\n", project.CreatedAt.Format("2006-01-02"))
-				fmt.Printf("- **Updated**: %s\n", project.UpdatedAt.Format("2006-01-02"))
+				fmt.Printf("- **Created**: %s\n", output.FormatTime(project.CreatedAt, output.DateOnly))
+				fmt.Printf("- **Updated**: %s\n", output.FormatTime(project.UpdatedAt, output.DateOnly))
 				if project.CompletedAt != nil {
-					fmt.Printf("- **Completed**: %s\n", project.CompletedAt.Format("2006-01-02"))
+					fmt.Printf("- **Completed**: %s\n", output.FormatTime(*project.CompletedAt, output.DateOnly))
 				}
 				if project.CanceledAt != nil {
-					fmt.Printf("- **Canceled**: %s\n", project.CanceledAt.Format("2006-01-02"))
+					fmt.Printf("- **Canceled**: %s\n", output.FormatTime(*project.CanceledAt, output.DateOnly))
 				}
 				fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL))
-				if project.Description != "" {
-					fmt.Printf("- **Description**: %s\n", project.Description)
-				}
+				printDescriptionExcerpt(project.Description, descriptionLines)
 				fmt.Println()
 			}
 			fmt.Printf("\nTotal: %d projects\n", len(projects.Nodes))
@@ -216,6 +375,9 @@ var projectListCmd = &cobra.Command{
 		} else {
 			// Table output
 			headers := []string{"Name", "State", "Health", "Progress", "Lead", "Teams", "URL"}
+			if showInitiatives {
+				headers = []string{"Name", "State", "Health", "Progress", "Lead", "Teams", "Initiative", "URL"}
+			}
 			rows := [][]string{}
 
 			for _, project := range projects.Nodes {
@@ -234,47 +396,26 @@ var projectListCmd = &cobra.Command{
 					}
 				}
 
-				stateColor := color.New(color.FgGreen)
-				switch project.State {
-				case "planned":
-					stateColor = color.New(color.FgCyan)
-				case "started":
-					stateColor = color.New(color.FgBlue)
-				case "paused":
-					stateColor = color.New(color.FgYellow)
-				case "completed":
-					stateColor = color.New(color.FgGreen)
-				case "canceled":
-					stateColor = color.New(color.FgRed)
-				}
-
-				// Format health with color
-				health := project.Health
-				healthColor := color.New(color.FgWhite)
-				switch project.Health {
-				case "onTrack":
-					healthColor = color.New(color.FgGreen)
-					health = "On Track"
-				case "atRisk":
-					healthColor = color.New(color.FgYellow)
-					health = "At Risk"
-				case "offTrack":
-					healthColor = color.New(color.FgRed)
-					health = "Off Track"
-				}
-
 				// Format progress
 				progressStr := fmt.Sprintf("%.0f%%", project.Progress*100)
 
-				rows = append(rows, []string{
+				health := formatHealth(project.Health)
+				if project.Health != "" && isHealthStale(project.HealthUpdatedAt, now) {
+					health += color.New(color.FgYellow).Sprint(" (stale)")
+				}
+
+				row := []string{
 					truncateString(project.Name, 25),
-					stateColor.Sprint(project.State),
-					healthColor.Sprint(health),
+					projectStateText(project.State),
+					health,
 					progressStr,
 					lead,
 					teams,
-					constructProjectURL(project.ID, project.URL),
-				})
+				}
+				if showInitiatives {
+					row = append(row, projectInitiatives(&project, func(i api.Initiative) string { return i.Name }))
+				}
+				rows = append(rows, append(row, constructProjectURL(project.ID, project.URL)))
 			}
 
 			output.Table(output.TableData{
@@ -291,268 +432,308 @@ var projectListCmd = &cobra.Command{
 	},
 }
 
-var projectGetCmd = &cobra.Command{
-	Use:     "get PROJECT-ID",
-	Aliases: []string{"show"},
-	Short:   "Get project details",
-	Long:    `Get detailed information about a specific project.`,
-	Args:    cobra.ExactArgs(1),
-	Run: func(cmd *cobra.Command, args []string) {
-		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
-		projectID := args[0]
+// renderProjectMarkdown writes a project as a markdown document. Sections
+// always appear in the same order; with full, empty sections say "None".
+// now is used for the overdue note on milestone target dates.
+func renderProjectMarkdown(w io.Writer, project *api.Project, full bool, now time.Time) {
+	d := &markdownDoc{w: w, full: full}
+	d.title(escapeMarkdown(project.Name))
 
-		// Get auth header
-		authHeader, err := auth.GetAuthHeader()
-		if err != nil {
-			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+	d.section("Description", project.Description != "", func() {
+		d.body(project.Description, 2)
+	})
+	d.section("Content", project.Content != "", func() {
+		d.body(project.Content, 2)
+	})
+
+	d.section("Core Details", true, func() {
+		d.field("ID", project.ID)
+		d.field("Slug ID", project.SlugId)
+		d.field("State", project.State)
+		d.field("Progress", fmt.Sprintf("%.0f%%", project.Progress*100))
+		d.field("Health", project.Health)
+		if project.HealthUpdatedAt != nil {
+			d.field("Health Updated", output.FormatTime(*project.HealthUpdatedAt, output.DateTime))
+		}
+		d.field("Scope", fmt.Sprintf("%.0f", project.Scope))
+		if project.Priority > 0 {
+			d.field("Priority", fmt.Sprintf("%s (%d)", project.PriorityLabel, project.Priority))
 		}
+		if project.Icon != nil && *project.Icon != "" {
+			d.field("Icon", *project.Icon)
+		}
+		d.field("Color", project.Color)
+		if project.Trashed {
+			d.field("Trashed", "yes")
+		}
+		if initiatives := projectInitiatives(project, func(i api.Initiative) string {
+			return escapeMarkdown(initiativeWithStatus(i))
+		}); initiatives != "" {
+			d.field("Initiatives", initiatives)
+		}
+	})
 
-		// Create API client
-		client := api.NewClient(authHeader)
+	d.section("Timeline", true, func() {
+		if project.StartDate != nil {
+			dateStr := *project.StartDate
+			if project.StartDateResolution != "" {
+				dateStr += fmt.Sprintf(" (%s)", project.StartDateResolution)
+			}
+			d.field("Start Date", dateStr)
+		}
+		if project.TargetDate != nil {
+			dateStr := *project.TargetDate
+			if project.TargetDateResolution != "" {
+				dateStr += fmt.Sprintf(" (%s)", project.TargetDateResolution)
+			}
+			d.field("Target Date", dateStr)
+		}
+		d.field("Created", output.FormatTime(project.CreatedAt, output.DateTime))
+		d.field("Updated", output.FormatTime(project.UpdatedAt, output.DateTime))
+		if project.StartedAt != nil {
+			d.field("Started", output.FormatTime(*project.StartedAt, output.DateTime))
+		}
+		if project.CompletedAt != nil {
+			d.field("Completed", output.FormatTime(*project.CompletedAt, output.DateTime))
+		}
+		if project.CanceledAt != nil {
+			d.field("Canceled", output.FormatTime(*project.CanceledAt, output.DateTime))
+		}
+		if project.ArchivedAt != nil {
+			d.field("Archived", output.FormatTime(*project.ArchivedAt, output.DateTime))
+		}
+		if project.AutoArchivedAt != nil {
+			d.field("Auto-Archived", output.FormatTime(*project.AutoArchivedAt, output.DateTime))
+		}
+	})
 
-		// Get project details
-		project, err := client.GetProject(context.Background(), projectID)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+	d.section("People", true, func() {
+		if project.Lead != nil {
+			d.field("Lead", fmt.Sprintf("%s (%s)", escapeMarkdown(project.Lead.Name), project.Lead.Email))
+			if project.Lead.DisplayName != "" && project.Lead.DisplayName != project.Lead.Name {
+				d.printf("  - Display Name: %s\n", escapeMarkdown(project.Lead.DisplayName))
+			}
+		} else {
+			d.field("Lead", "Unassigned")
+		}
+		if project.Creator != nil {
+			d.field("Creator", fmt.Sprintf("%s (%s)", escapeMarkdown(project.Creator.Name), project.Creator.Email))
 		}
+	})
 
-		// Handle output
-		if jsonOut {
-			output.JSON(project)
-		} else if plaintext {
-			fmt.Printf("# %s\n\n", project.Name)
+	d.section("Slack Integration", true, func() {
+		d.field("Slack New Issue", fmt.Sprint(project.SlackNewIssue))
+		d.field("Slack Issue Comments", fmt.Sprint(project.SlackIssueComments))
+		d.field("Slack Issue Statuses", fmt.Sprint(project.SlackIssueStatuses))
+	})
 
-			if project.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", project.Description)
-			}
+	d.section("Origin", project.ConvertedFromIssue != nil, func() {
+		d.field("Converted from Issue", fmt.Sprintf("%s - %s", project.ConvertedFromIssue.Identifier, escapeMarkdown(project.ConvertedFromIssue.Title)))
+	})
 
-			if project.Content != "" {
-				fmt.Printf("## Content\n%s\n\n", project.Content)
-			}
+	d.section("Template", project.LastAppliedTemplate != nil, func() {
+		d.field("Last Applied", escapeMarkdown(project.LastAppliedTemplate.Name))
+		if project.LastAppliedTemplate.Description != "" {
+			d.printf("  - Description: %s\n", escapeMarkdown(project.LastAppliedTemplate.Description))
+		}
+	})
 
-			fmt.Printf("## Core Details\n")
-			fmt.Printf("- **ID**: %s\n", project.ID)
-			fmt.Printf("- **Slug ID**: %s\n", project.SlugId)
-			fmt.Printf("- **State**: %s\n", project.State)
-			fmt.Printf("- **Progress**: %.0f%%\n", project.Progress*100)
-			fmt.Printf("- **Health**: %s\n", project.Health)
-			if project.HealthUpdatedAt != nil {
-				fmt.Printf("- **Health Updated**: %s\n", project.HealthUpdatedAt.Format("2006-01-02 15:04:05"))
+	d.section("Teams", project.Teams != nil && len(project.Teams.Nodes) > 0, func() {
+		for _, team := range project.Teams.Nodes {
+			d.printf("- **%s** (%s)\n", escapeMarkdown(team.Name), team.Key)
+			if team.Description != "" {
+				d.printf("  - Description: %s\n", escapeMarkdown(team.Description))
 			}
-			fmt.Printf("- **Scope**: %.0f\n", project.Scope)
-			if project.Priority > 0 {
-				fmt.Printf("- **Priority**: %s (%d)\n", project.PriorityLabel, project.Priority)
+			d.printf("  - Cycles Enabled: %v\n", team.CyclesEnabled)
+		}
+	})
+
+	d.section("URL", true, func() {
+		d.printf("- %s\n", constructProjectURL(project.ID, project.URL))
+	})
+
+	d.section("Members", project.Members != nil && len(project.Members.Nodes) > 0, func() {
+		for _, member := range project.Members.Nodes {
+			d.printf("- %s (%s)", escapeMarkdown(member.Name), member.Email)
+			if member.DisplayName != "" && member.DisplayName != member.Name {
+				d.printf(" - %s", escapeMarkdown(member.DisplayName))
 			}
-			if project.Icon != nil && *project.Icon != "" {
-				fmt.Printf("- **Icon**: %s\n", *project.Icon)
+			if member.Admin {
+				d.printf(" (admin)")
 			}
-			fmt.Printf("- **Color**: %s\n", project.Color)
-			if project.Trashed {
-				fmt.Printf("- **Trashed**: yes\n")
+			if !member.Active {
+				d.printf(" (inactive)")
 			}
+			d.printf("\n")
+		}
+	})
 
-			fmt.Printf("\n## Timeline\n")
-			if project.StartDate != nil {
-				dateStr := *project.StartDate
-				if project.StartDateResolution != "" {
-					dateStr += fmt.Sprintf(" (%s)", project.StartDateResolution)
+	d.section("Milestones", project.ProjectMilestones != nil && len(project.ProjectMilestones.Nodes) > 0, func() {
+		for i := range project.ProjectMilestones.Nodes {
+			ms := &project.ProjectMilestones.Nodes[i]
+			d.printf("- **%s** — %s, %.0f%%", escapeMarkdown(ms.Name), ms.Status, ms.Progress*100)
+			if ms.TargetDate != nil {
+				d.printf(", target: %s", *ms.TargetDate)
+				if milestoneOverdue(ms, now) {
+					d.printf(" (overdue)")
 				}
-				fmt.Printf("- **Start Date**: %s\n", dateStr)
 			}
-			if project.TargetDate != nil {
-				dateStr := *project.TargetDate
-				if project.TargetDateResolution != "" {
-					dateStr += fmt.Sprintf(" (%s)", project.TargetDateResolution)
-				}
-				fmt.Printf("- **Target Date**: %s\n", dateStr)
+			d.printf("\n")
+		}
+	})
+
+	d.section("Recent Project Updates", project.ProjectUpdates != nil && len(project.ProjectUpdates.Nodes) > 0, func() {
+		for i, update := range project.ProjectUpdates.Nodes {
+			if i > 0 {
+				d.printf("\n")
 			}
-			fmt.Printf("- **Created**: %s\n", project.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", project.UpdatedAt.Format("2006-01-02 15:04:05"))
-			if project.StartedAt != nil {
-				fmt.Printf("- **Started**: %s\n", project.StartedAt.Format("2006-01-02 15:04:05"))
+			d.printf("### %s by %s\n\n", output.FormatTime(update.CreatedAt, output.DateTimeShort), escapeMarkdown(safeUserName(update.User)))
+			if update.EditedAt != nil {
+				d.field("Edited", output.FormatTime(*update.EditedAt, output.DateTimeShort))
 			}
-			if project.CompletedAt != nil {
-				fmt.Printf("- **Completed**: %s\n", project.CompletedAt.Format("2006-01-02 15:04:05"))
+			d.field("Health", update.Health)
+			if update.Body != "" {
+				d.printf("\n")
+				d.body(update.Body, 3)
 			}
-			if project.CanceledAt != nil {
-				fmt.Printf("- **Canceled**: %s\n", project.CanceledAt.Format("2006-01-02 15:04:05"))
+		}
+	})
+
+	d.section("Documents", project.Documents != nil && len(project.Documents.Nodes) > 0, func() {
+		for i, doc := range project.Documents.Nodes {
+			if i > 0 {
+				d.printf("\n")
+			}
+			d.printf("### %s\n\n", escapeMarkdown(doc.Title))
+			if doc.Icon != nil && *doc.Icon != "" {
+				d.field("Icon", *doc.Icon)
 			}
-			if project.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", project.ArchivedAt.Format("2006-01-02 15:04:05"))
+			d.field("Color", doc.Color)
+			d.field("Created", fmt.Sprintf("%s by %s", output.FormatTime(doc.CreatedAt, output.DateOnly), escapeMarkdown(doc.Creator.Name)))
+			if doc.UpdatedBy != nil {
+				d.field("Updated", fmt.Sprintf("%s by %s", output.FormatTime(doc.UpdatedAt, output.DateOnly), escapeMarkdown(doc.UpdatedBy.Name)))
 			}
-			if project.AutoArchivedAt != nil {
-				fmt.Printf("- **Auto-Archived**: %s\n", project.AutoArchivedAt.Format("2006-01-02 15:04:05"))
+			if doc.Content != "" {
+				d.printf("\n")
+				d.body(doc.Content, 3)
 			}
+		}
+	})
 
-			fmt.Printf("\n## People\n")
-			if project.Lead != nil {
-				fmt.Printf("- **Lead**: %s (%s)\n", project.Lead.Name, project.Lead.Email)
-				if project.Lead.DisplayName != "" && project.Lead.DisplayName != project.Lead.Name {
-					fmt.Printf("  - Display Name: %s\n", project.Lead.DisplayName)
+	hasIssues := project.Issues != nil && len(project.Issues.Nodes) > 0
+	issuesHeading := "Issues"
+	if hasIssues {
+		issuesHeading = fmt.Sprintf("Issues (%d)", len(project.Issues.Nodes))
+	}
+	d.section(issuesHeading, hasIssues, func() {
+		for _, issue := range project.Issues.Nodes {
+			d.printf("%s\n", issueTaskItem(issue))
+
+			assignee := "Unassigned"
+			if issue.Assignee != nil {
+				assignee = escapeMarkdown(issue.Assignee.Name)
+			}
+			d.printf("  - Assignee: %s\n", assignee)
+			d.printf("  - Priority: %s\n", priorityToString(issue.Priority))
+			if issue.Estimate != nil {
+				d.printf("  - Estimate: %.1f\n", *issue.Estimate)
+			}
+			if issue.State != nil {
+				d.printf("  - State: %s\n", escapeMarkdown(issue.State.Name))
+			}
+			if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+				labels := []string{}
+				for _, label := range issue.Labels.Nodes {
+					labels = append(labels, escapeMarkdown(label.Name))
 				}
-			} else {
-				fmt.Printf("- **Lead**: Unassigned\n")
+				d.printf("  - Labels: %s\n", strings.Join(labels, ", "))
 			}
-			if project.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", project.Creator.Name, project.Creator.Email)
+			d.printf("  - Updated: %s\n", output.FormatTime(issue.UpdatedAt, output.DateTimeShort))
+			if issue.Description != "" {
+				d.printf("  - Description: %s\n", descriptionPreview(issue.Description))
 			}
+		}
+	})
+}
 
-			fmt.Printf("\n## Slack Integration\n")
-			fmt.Printf("- **Slack New Issue**: %v\n", project.SlackNewIssue)
-			fmt.Printf("- **Slack Issue Comments**: %v\n", project.SlackIssueComments)
-			fmt.Printf("- **Slack Issue Statuses**: %v\n", project.SlackIssueStatuses)
+var projectGetCmd = &cobra.Command{
+	Use:     "get PROJECT-ID",
+	Aliases: []string{"show"},
+	Short:   "Get project details",
+	Long: `Get detailed information about a specific project.
 
-			if project.ConvertedFromIssue != nil {
-				fmt.Printf("\n## Origin\n")
-				fmt.Printf("- **Converted from Issue**: %s - %s\n", project.ConvertedFromIssue.Identifier, project.ConvertedFromIssue.Title)
-			}
+With --plaintext the project is printed as a markdown document with sections
+in a fixed order. Add --full to include every section, with "None" for
+sections that have no data.
 
-			if project.LastAppliedTemplate != nil {
-				fmt.Printf("\n## Template\n")
-				fmt.Printf("- **Last Applied**: %s\n", project.LastAppliedTemplate.Name)
-				if project.LastAppliedTemplate.Description != "" {
-					fmt.Printf("  - Description: %s\n", project.LastAppliedTemplate.Description)
-				}
-			}
+--scope-history adds the project's scope and completed scope week by week,
+with a trend: how much scope changed since the first week with any, the
+completion rate over the last 4 weeks, and the finish date that rate projects
+for the remaining scope, next to the target date.
 
-			// Teams
-			if project.Teams != nil && len(project.Teams.Nodes) > 0 {
-				fmt.Printf("\n## Teams\n")
-				for _, team := range project.Teams.Nodes {
-					fmt.Printf("- **%s** (%s)\n", team.Name, team.Key)
-					if team.Description != "" {
-						fmt.Printf("  - Description: %s\n", team.Description)
-					}
-					fmt.Printf("  - Cycles Enabled: %v\n", team.CyclesEnabled)
-				}
-			}
+--fields trims --json output to the given comma-separated dot-paths, e.g.
+lead.name; arrays are filtered element by element. Paths that aren't in the
+output are left out, or fail the command with --strict-fields.
 
-			fmt.Printf("\n## URL\n")
-			fmt.Printf("- %s\n", constructProjectURL(project.ID, project.URL))
+Examples:
+  linear-cli project get PROJECT-ID
+  linear-cli project get PROJECT-ID -p --full
+  linear-cli project get PROJECT-ID --scope-history
+  linear-cli project get PROJECT-ID --json --fields name,state,progress,lead.name`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		projectID := args[0]
+		full, _ := cmd.Flags().GetBool("full")
+		withScope, _ := cmd.Flags().GetBool("scope-history")
+		fields, err := jsonFieldsFromFlags(cmd, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
-			// Show members if available
-			if project.Members != nil && len(project.Members.Nodes) > 0 {
-				fmt.Printf("\n## Members\n")
-				for _, member := range project.Members.Nodes {
-					fmt.Printf("- %s (%s)", member.Name, member.Email)
-					if member.DisplayName != "" && member.DisplayName != member.Name {
-						fmt.Printf(" - %s", member.DisplayName)
-					}
-					if member.Admin {
-						fmt.Printf(" [Admin]")
-					}
-					if !member.Active {
-						fmt.Printf(" [Inactive]")
-					}
-					fmt.Println()
-				}
-			}
+		// Get auth header
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
 
-			// Milestones
-			if project.ProjectMilestones != nil && len(project.ProjectMilestones.Nodes) > 0 {
-				fmt.Printf("\n## Milestones\n")
-				for _, ms := range project.ProjectMilestones.Nodes {
-					fmt.Printf("- **%s** — %s, %.0f%%", ms.Name, ms.Status, ms.Progress*100)
-					if ms.TargetDate != nil {
-						fmt.Printf(", target: %s", *ms.TargetDate)
-					}
-					fmt.Println()
-				}
-			}
+		// Create API client
+		client := api.NewClient(authHeader)
 
-			// Project Updates
-			if project.ProjectUpdates != nil && len(project.ProjectUpdates.Nodes) > 0 {
-				fmt.Printf("\n## Recent Project Updates\n")
-				for _, update := range project.ProjectUpdates.Nodes {
-					fmt.Printf("\n### %s by %s\n", update.CreatedAt.Format("2006-01-02 15:04"), safeUserName(update.User))
-					if update.EditedAt != nil {
-						fmt.Printf("*(edited %s)*\n", update.EditedAt.Format("2006-01-02 15:04"))
-					}
-					fmt.Printf("- **Health**: %s\n", update.Health)
-					fmt.Printf("\n%s\n", update.Body)
-				}
-			}
+		// Get project details
+		project, err := client.GetProject(context.Background(), projectID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
 
-			// Documents
-			if project.Documents != nil && len(project.Documents.Nodes) > 0 {
-				fmt.Printf("\n## Documents\n")
-				for _, doc := range project.Documents.Nodes {
-					fmt.Printf("\n### %s\n", doc.Title)
-					if doc.Icon != nil && *doc.Icon != "" {
-						fmt.Printf("- **Icon**: %s\n", *doc.Icon)
-					}
-					fmt.Printf("- **Color**: %s\n", doc.Color)
-					fmt.Printf("- **Created**: %s by %s\n", doc.CreatedAt.Format("2006-01-02"), doc.Creator.Name)
-					if doc.UpdatedBy != nil {
-						fmt.Printf("- **Updated**: %s by %s\n", doc.UpdatedAt.Format("2006-01-02"), doc.UpdatedBy.Name)
-					}
-					fmt.Printf("\n%s\n", doc.Content)
-				}
+		var scope *projectScopeReport
+		if withScope {
+			history, err := client.GetProjectScopeHistory(context.Background(), project.ID)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get scope history: %v", err), plaintext, jsonOut)
+				exit(1)
 			}
+			scope = analyzeScopeHistory(history)
+		}
 
-			// Show recent issues
-			if project.Issues != nil && len(project.Issues.Nodes) > 0 {
-				fmt.Printf("\n## Issues (%d total)\n", len(project.Issues.Nodes))
-				for _, issue := range project.Issues.Nodes {
-					stateStr := ""
-					if issue.State != nil {
-						switch issue.State.Type {
-						case "completed":
-							stateStr = "[x]"
-						case "started":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
-					}
-
-					assignee := "Unassigned"
-					if issue.Assignee != nil {
-						assignee = issue.Assignee.Name
-					}
-
-					fmt.Printf("\n### %s %s (#%d)\n", stateStr, issue.Identifier, issue.Number)
-					fmt.Printf("**%s**\n", issue.Title)
-					fmt.Printf("- Assignee: %s\n", assignee)
-					fmt.Printf("- Priority: %s\n", priorityToString(issue.Priority))
-					if issue.Estimate != nil {
-						fmt.Printf("- Estimate: %.1f\n", *issue.Estimate)
-					}
-					if issue.State != nil {
-						fmt.Printf("- State: %s\n", issue.State.Name)
-					}
-					if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
-						labels := []string{}
-						for _, label := range issue.Labels.Nodes {
-							labels = append(labels, label.Name)
-						}
-						fmt.Printf("- Labels: %s\n", strings.Join(labels, ", "))
-					}
-					fmt.Printf("- Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04"))
-					if issue.Description != "" {
-						// Show first 3 lines of description
-						lines := strings.Split(issue.Description, "\n")
-						preview := ""
-						for i, line := range lines {
-							if i >= 3 {
-								preview += "\n  ..."
-								break
-							}
-							if i > 0 {
-								preview += "\n  "
-							}
-							preview += line
-						}
-						fmt.Printf("- Description: %s\n", preview)
-					}
-				}
+		// Handle output
+		if jsonOut {
+			if scope == nil {
+				fields.print(project, plaintext, jsonOut)
+				return
+			}
+			fields.print(struct {
+				*api.Project
+				ScopeHistory *projectScopeReport `json:"scopeHistory"`
+			}{project, scope}, plaintext, jsonOut)
+		} else if plaintext {
+			renderProjectMarkdown(os.Stdout, project, full, time.Now())
+			if scope != nil {
+				printScopeHistoryMarkdown(scope)
 			}
 		} else {
 			// Formatted output
@@ -566,20 +747,7 @@ var projectGetCmd = &cobra.Command{
 				fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Description:"), project.Description)
 			}
 
-			stateColor := color.New(color.FgGreen)
-			switch project.State {
-			case "planned":
-				stateColor = color.New(color.FgCyan)
-			case "started":
-				stateColor = color.New(color.FgBlue)
-			case "paused":
-				stateColor = color.New(color.FgYellow)
-			case "completed":
-				stateColor = color.New(color.FgGreen)
-			case "canceled":
-				stateColor = color.New(color.FgRed)
-			}
-			fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("State:"), stateColor.Sprint(project.State))
+			fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("State:"), projectStateText(project.State))
 
 			progressColor := color.New(color.FgRed)
 			if project.Progress >= 0.75 {
@@ -615,6 +783,15 @@ var projectGetCmd = &cobra.Command{
 				}
 			}
 
+			if initiatives := projectInitiatives(project, func(i api.Initiative) string {
+				if i.Status == "" {
+					return i.Name
+				}
+				return fmt.Sprintf("%s (%s)", i.Name, initiativeStatusText(i.Status))
+			}); initiatives != "" {
+				fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("Initiatives:"), initiatives)
+			}
+
 			// Show members if available
 			if project.Members != nil && len(project.Members.Nodes) > 0 {
 				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Members:"))
@@ -628,19 +805,13 @@ var projectGetCmd = &cobra.Command{
 			// Show milestones if available
 			if project.ProjectMilestones != nil && len(project.ProjectMilestones.Nodes) > 0 {
 				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Milestones:"))
-				for _, ms := range project.ProjectMilestones.Nodes {
-					statusColor := color.New(color.FgWhite)
-					switch strings.ToLower(ms.Status) {
-					case "done":
-						statusColor = color.New(color.FgGreen)
-					case "next":
-						statusColor = color.New(color.FgBlue)
-					case "overdue":
-						statusColor = color.New(color.FgRed)
-					}
+				now := time.Now()
+				for i := range project.ProjectMilestones.Nodes {
+					ms := &project.ProjectMilestones.Nodes[i]
+					statusColor := milestoneStatusColor(ms.Status)
 					targetStr := ""
 					if ms.TargetDate != nil {
-						targetStr = fmt.Sprintf(" → %s", *ms.TargetDate)
+						targetStr = " → " + milestoneTargetLabel(ms, now)
 					}
 					fmt.Printf("  • %s %s %.0f%%%s\n",
 						color.New(color.FgCyan).Sprint(ms.Name),
@@ -657,17 +828,7 @@ var projectGetCmd = &cobra.Command{
 					if i >= 5 {
 						break // Show only first 5
 					}
-					stateIcon := "○"
-					if issue.State != nil {
-						switch issue.State.Type {
-						case "completed":
-							stateIcon = color.New(color.FgGreen).Sprint("✓")
-						case "started":
-							stateIcon = color.New(color.FgBlue).Sprint("◐")
-						case "canceled":
-							stateIcon = color.New(color.FgRed).Sprint("✗")
-						}
-					}
+					stateIcon := issueStateIcon(issue.State)
 					assignee := "Unassigned"
 					if issue.Assignee != nil {
 						assignee = issue.Assignee.Name
@@ -682,13 +843,13 @@ var projectGetCmd = &cobra.Command{
 
 			// Show timestamps
 			fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Timeline:"))
-			fmt.Printf("  Created: %s\n", project.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("  Updated: %s\n", project.UpdatedAt.Format("2006-01-02"))
+			fmt.Printf("  Created: %s\n", output.FormatTime(project.CreatedAt, output.DateOnly))
+			fmt.Printf("  Updated: %s\n", output.FormatTime(project.UpdatedAt, output.DateOnly))
 			if project.CompletedAt != nil {
-				fmt.Printf("  Completed: %s\n", project.CompletedAt.Format("2006-01-02"))
+				fmt.Printf("  Completed: %s\n", output.FormatTime(*project.CompletedAt, output.DateOnly))
 			}
 			if project.CanceledAt != nil {
-				fmt.Printf("  Canceled: %s\n", project.CanceledAt.Format("2006-01-02"))
+				fmt.Printf("  Canceled: %s\n", output.FormatTime(*project.CanceledAt, output.DateOnly))
 			}
 
 			// Show URL
@@ -698,6 +859,10 @@ var projectGetCmd = &cobra.Command{
 					color.New(color.FgBlue, color.Underline).Sprint(constructProjectURL(project.ID, project.URL)))
 			}
 
+			if scope != nil {
+				printScopeHistoryRich(scope)
+			}
+
 			fmt.Println()
 		}
 	},
@@ -721,7 +886,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -731,7 +896,7 @@ Examples:
 		project, err := client.GetProject(ctx, projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Build set of existing team IDs
@@ -747,8 +912,8 @@ Examples:
 		for _, key := range teamKeys {
 			team, err := client.GetTeam(ctx, key)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", key, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", key, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			if existingIDs[team.ID] {
 				if !jsonOut {
@@ -785,8 +950,9 @@ Examples:
 		updated, err := client.UpdateProject(ctx, projectID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project", updated.ID, updated.Name, restoreInverse(project, input))
 
 		if jsonOut {
 			output.JSON(updated)
@@ -833,7 +999,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -843,7 +1009,7 @@ Examples:
 		project, err := client.GetProject(ctx, projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Resolve team keys to IDs for removal
@@ -851,8 +1017,8 @@ Examples:
 		for _, key := range teamKeys {
 			team, err := client.GetTeam(ctx, key)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", key, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", key, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			// Check if team is actually on the project
 			found := false
@@ -899,8 +1065,9 @@ Examples:
 		updated, err := client.UpdateProject(ctx, projectID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project", updated.ID, updated.Name, restoreInverse(project, input))
 
 		if jsonOut {
 			output.JSON(updated)
@@ -940,19 +1107,36 @@ var projectCreateCmd = &cobra.Command{
 The description can be provided inline via --description or read from a markdown file via --description-file.
 Use --description-file - to read from stdin.
 
+Milestones can be created along with the project, either with repeated
+--milestone "Name:YYYY-MM-DD" flags (the date is optional; a colon stays
+in the name unless what follows starts with a digit, "+", or a date keyword) or with
+--milestones-file, a YAML/JSON list of {name, description, targetDate}.
+They are created in the given order after the project. If a milestone fails,
+the project is kept and the command exits non-zero after reporting which
+milestones were created.
+
 Examples:
   linear-cli project create --name "My Project" --team-ids TEAM-UUID
   linear-cli project create --name "My Project" --description "Details" --state started
   linear-cli project create --name "My Project" --description-file project-brief.md
-  linear-cli project create --name "My Project" --team-ids TEAM-UUID --initiative "Q1 Goals"`,
+  linear-cli project create --name "My Project" --team-ids TEAM-UUID --initiative "Q1 Goals"
+  linear-cli project create --name "Launch" --team-ids TEAM-UUID --milestone "Beta:2025-03-01" --milestone "GA:2025-06-01"
+  linear-cli project create --name "Launch" --team-ids TEAM-UUID --milestones-file milestones.yaml`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
+
+		// Validate milestones up front so a bad entry doesn't leave a bare project
+		milestones, err := projectCreateMilestones(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -967,7 +1151,7 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
@@ -1007,8 +1191,12 @@ Examples:
 		}
 
 		// Handle content (rich markdown)
-		if cmd.Flags().Changed("content") {
-			content, _ := cmd.Flags().GetString("content")
+		content, hasContent, err := proseFromFlags(cmd, "content")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasContent {
 			input["content"] = content
 		}
 
@@ -1020,27 +1208,16 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["leadId"] = viewer.ID
 			case "":
 				// Don't set leadId
 			default:
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				foundUser, err := newUserLookup(client).find(lead)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.ID == lead || user.Email == lead || user.Name == lead {
-						foundUser = &user
-						break
-					}
-				}
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", lead), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(err.Error(), plaintext, jsonOut, err)
+					exit(1)
 				}
 				input["leadId"] = foundUser.ID
 			}
@@ -1050,11 +1227,7 @@ Examples:
 		if cmd.Flags().Changed("members") {
 			membersArg, _ := cmd.Flags().GetStringSlice("members")
 			if len(membersArg) > 0 {
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
+				users := newUserLookup(client)
 				var memberIDs []string
 				for _, member := range membersArg {
 					memberLower := strings.ToLower(member)
@@ -1062,21 +1235,15 @@ Examples:
 						viewer, err := client.GetViewer(context.Background())
 						if err != nil {
 							output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-							os.Exit(1)
+							exit(1)
 						}
 						memberIDs = append(memberIDs, viewer.ID)
 						continue
 					}
-					var foundUser *api.User
-					for _, user := range users.Nodes {
-						if strings.EqualFold(user.Email, member) || strings.EqualFold(user.Name, member) {
-							foundUser = &user
-							break
-						}
-					}
-					if foundUser == nil {
-						output.Error(fmt.Sprintf("User not found: %s", member), plaintext, jsonOut)
-						os.Exit(1)
+					foundUser, err := users.find(member)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
 					}
 					memberIDs = append(memberIDs, foundUser.ID)
 				}
@@ -1086,7 +1253,12 @@ Examples:
 
 		// Handle template
 		if cmd.Flags().Changed("template-id") {
-			templateID, _ := cmd.Flags().GetString("template-id")
+			templateRef, _ := cmd.Flags().GetString("template-id")
+			templateID, err := resolveTemplateID(client, templateRef, "project", "")
+			if err != nil {
+				output.Error(fmt.Sprintf("Invalid --template-id: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
 			input["templateId"] = templateID
 		}
 
@@ -1114,38 +1286,91 @@ Examples:
 
 		project, err := client.CreateProject(context.Background(), input)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to create project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			msg := fmt.Sprintf("Failed to create project: %v", err)
+			if _, ok := input["templateId"]; ok {
+				if templates, terr := client.GetTemplates(context.Background()); terr == nil {
+					msg += "\n" + templateSuggestion(templates, "project", "")
+				}
+			}
+			output.Error(msg, plaintext, jsonOut)
+			exit(1)
 		}
+		recordOperation(cmd, "project", project.ID, project.Name, &journal.Inverse{Action: "archive"})
 
 		// Handle --initiative: link the newly created project to an initiative
 		if cmd.Flags().Changed("initiative") {
 			initiativeVal, _ := cmd.Flags().GetString("initiative")
 			initiativeID, err := resolveInitiativeID(client, context.Background(), initiativeVal)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve initiative '%s': %v", initiativeVal, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to resolve initiative '%s': %v", initiativeVal, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			_, err = client.AddProjectToInitiative(context.Background(), initiativeID, project.ID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Project created but failed to add to initiative: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			if !jsonOut {
 				fmt.Fprintf(os.Stderr, "Linked project to initiative %s\n", initiativeVal)
 			}
 		}
 
-		if jsonOut {
-			output.JSON(project)
-		} else {
+		projectURL := constructProjectURL(project.ID, project.URL)
+		scripted := printCreated(cmd, createdEntity{Type: "project", ID: project.ID, Name: project.Name, URL: projectURL})
+		if !scripted && !jsonOut {
 			output.Success(fmt.Sprintf("Created project %s (%s)",
 				color.New(color.FgWhite, color.Bold).Sprint(project.Name),
 				project.State), plaintext, jsonOut)
+			printResultURL(projectURL, plaintext)
+		}
+		if len(milestones) == 0 {
+			if jsonOut {
+				output.JSON(project)
+			}
+			return
+		}
+
+		// A new project has no milestones, so sort orders start from zero
+		results, created, failed := createMilestoneEntries(cmd, client, project.ID, milestones, 0, false, plaintext, jsonOut)
+		if jsonOut {
+			output.JSON(struct {
+				*api.Project
+				Milestones []milestoneBulkResult `json:"milestones"`
+			}{project, results})
+		}
+		if failed > 0 {
+			if !jsonOut {
+				fmt.Fprintf(os.Stderr, "%d of %d milestones created; project %s was kept (ID: %s)\n",
+					created, len(milestones), project.Name, project.ID)
+			}
+			exit(1)
 		}
 	},
 }
 
+// projectCreateMilestones parses and validates the --milestone and
+// --milestones-file entries of project create
+func projectCreateMilestones(cmd *cobra.Command) ([]milestoneEntry, error) {
+	var entries []milestoneEntry
+	if filePath, _ := cmd.Flags().GetString("milestones-file"); filePath != "" {
+		content, err := readContentFromFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		if entries, err = parseMilestoneFile(content); err != nil {
+			return nil, err
+		}
+	}
+	specs, _ := cmd.Flags().GetStringArray("milestone")
+	for _, spec := range specs {
+		entries = append(entries, parseMilestoneSpec(spec))
+	}
+	if err := validateMilestoneEntries(entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
 var projectUpdateCmd = &cobra.Command{
 	Use:     "update PROJECT-ID",
 	Aliases: []string{"edit"},
@@ -1155,8 +1380,14 @@ var projectUpdateCmd = &cobra.Command{
 The description can be provided inline via --description or read from a markdown file via --description-file.
 Use --description-file - to read from stdin.
 
+--append-description (or --append-description-file) and --prepend-description
+add text to the current description, separated by a blank line, instead of
+replacing it. If the project changes between reading and writing, the text is
+added to the newer description.
+
 Examples:
   linear-cli project update PROJECT-ID --name "New Name"
+  linear-cli project update PROJECT-ID --append-description "Deployed v1.2 on 2024-06-01"
   linear-cli project update PROJECT-ID --state started
   linear-cli project update PROJECT-ID --lead user@example.com
   linear-cli project update PROJECT-ID --lead me
@@ -1166,13 +1397,13 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 		projectID := args[0]
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -1188,7 +1419,7 @@ Examples:
 			desc, err := resolveBodyFromFlags(descFlag, cmd.Flags().Changed("description"), filePath, "description", "description-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["description"] = desc
 		}
@@ -1214,30 +1445,17 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["leadId"] = viewer.ID
 			case "none", "unassigned", "":
 				input["leadId"] = nil
 			default:
 				// Look up user by email or name
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				foundUser, err := newUserLookup(client).find(lead)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.ID == lead || user.Email == lead || user.Name == lead {
-						foundUser = &user
-						break
-					}
-				}
-
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", lead), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(err.Error(), plaintext, jsonOut, err)
+					exit(1)
 				}
 
 				input["leadId"] = foundUser.ID
@@ -1267,8 +1485,12 @@ Examples:
 		}
 
 		// Handle content update (rich markdown content)
-		if cmd.Flags().Changed("content") {
-			content, _ := cmd.Flags().GetString("content")
+		content, hasContent, err := proseFromFlags(cmd, "content")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasContent {
 			input["content"] = content
 		}
 
@@ -1279,34 +1501,24 @@ Examples:
 				input["memberIds"] = []string{}
 			} else {
 				// Resolve member emails/names to IDs
-				users, err := client.GetUsers(context.Background(), 100, "", "")
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
+				users := newUserLookup(client)
 
 				var memberIDs []string
 				for _, member := range membersArg {
-					var foundUser *api.User
 					memberLower := strings.ToLower(member)
 					if memberLower == "me" {
 						viewer, err := client.GetViewer(context.Background())
 						if err != nil {
 							output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-							os.Exit(1)
+							exit(1)
 						}
 						memberIDs = append(memberIDs, viewer.ID)
 						continue
 					}
-					for _, user := range users.Nodes {
-						if strings.EqualFold(user.Email, member) || strings.EqualFold(user.Name, member) {
-							foundUser = &user
-							break
-						}
-					}
-					if foundUser == nil {
-						output.Error(fmt.Sprintf("User not found: %s", member), plaintext, jsonOut)
-						os.Exit(1)
+					foundUser, err := users.find(member)
+					if err != nil {
+						output.Error(err.Error(), plaintext, jsonOut, err)
+						exit(1)
 					}
 					memberIDs = append(memberIDs, foundUser.ID)
 				}
@@ -1314,25 +1526,8 @@ Examples:
 			}
 		}
 
-		// Handle Slack integration flags
-		if cmd.Flags().Changed("slack-new-issue") {
-			v, _ := cmd.Flags().GetBool("slack-new-issue")
-			input["slackNewIssue"] = v
-		}
-		if cmd.Flags().Changed("slack-issue-comments") {
-			v, _ := cmd.Flags().GetBool("slack-issue-comments")
-			input["slackIssueComments"] = v
-		}
-		if cmd.Flags().Changed("slack-issue-statuses") {
-			v, _ := cmd.Flags().GetBool("slack-issue-statuses")
-			input["slackIssueStatuses"] = v
-		}
-
-		// Handle trashed
-		if cmd.Flags().Changed("trashed") {
-			v, _ := cmd.Flags().GetBool("trashed")
-			input["trashed"] = v
-		}
+		// Handle Slack integration flags and trashed; --flag=false is sent
+		applyToggleFlags(cmd, input, projectToggles)
 
 		// Handle completed-at and canceled-at
 		if cmd.Flags().Changed("completed-at") {
@@ -1408,20 +1603,57 @@ Examples:
 			}
 		}
 
+		// --append-description/--prepend-description merge with the current text
+		var before *api.Project
+		edit, hasEdit, err := descriptionEditFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasEdit {
+			before, err = client.GetProject(context.Background(), projectID)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			merged, err := mergeDescription(context.Background(), before.Description, before.UpdatedAt, func(ctx context.Context) (string, time.Time, error) {
+				p, err := client.GetProject(ctx, projectID)
+				if err != nil {
+					return "", time.Time{}, err
+				}
+				return p.Description, p.UpdatedAt, nil
+			}, edit)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to update the description: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			input["description"] = merged
+		}
+
 		initiativeChanged := cmd.Flags().Changed("initiative")
 		if len(input) == 0 && !initiativeChanged {
 			output.Error("No fields to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Update project fields if any were specified
 		var project *api.Project
 		if len(input) > 0 {
+			// Capture current values so the update can be undone
+			var inverse *journal.Inverse
+			if before == nil {
+				before, _ = client.GetProject(context.Background(), projectID)
+			}
+			if before != nil {
+				inverse = restoreInverse(before, input)
+			}
+
 			project, err = client.UpdateProject(context.Background(), projectID, input)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "project", project.ID, project.Name, inverse)
 		}
 
 		// Handle --initiative flag
@@ -1434,7 +1666,7 @@ Examples:
 				initiativeIDs, err := client.GetInitiativeLinksForProject(ctx, projectID)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get initiative links: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				for _, initID := range initiativeIDs {
 					err := client.RemoveProjectFromInitiative(ctx, initID, projectID)
@@ -1449,13 +1681,13 @@ Examples:
 				// Add project to the specified initiative
 				initiativeID, err := resolveInitiativeID(client, ctx, initiativeVal)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to resolve initiative '%s': %v", initiativeVal, err), plaintext, jsonOut)
-					os.Exit(1)
+					output.Error(fmt.Sprintf("Failed to resolve initiative '%s': %v", initiativeVal, err), plaintext, jsonOut, err)
+					exit(1)
 				}
 				_, err = client.AddProjectToInitiative(ctx, initiativeID, projectID)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to add project to initiative: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				if !jsonOut {
 					fmt.Fprintf(os.Stderr, "Linked project to initiative %s\n", initiativeVal)
@@ -1467,12 +1699,14 @@ Examples:
 				project, err = client.GetProject(ctx, projectID)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 			}
 		}
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(constructProjectURL(project.ID, project.URL), "project")
+		} else if jsonOut {
 			output.JSON(project)
 		} else {
 			leadInfo := ""
@@ -1481,6 +1715,10 @@ Examples:
 			}
 			output.Success(fmt.Sprintf("Updated project %s%s",
 				color.New(color.FgWhite, color.Bold).Sprint(project.Name), leadInfo), plaintext, jsonOut)
+			printResultURL(constructProjectURL(project.ID, project.URL), plaintext)
+			if hasEdit {
+				printDescriptionPreview(edit, input["description"].(string), plaintext)
+			}
 		}
 	},
 }
@@ -1497,15 +1735,16 @@ var projectArchiveCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		err = client.ArchiveProject(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to archive project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project", args[0], "", &journal.Inverse{Action: "unarchive"})
 
 		output.Success("Archived project", plaintext, jsonOut)
 	},
@@ -1523,15 +1762,16 @@ var projectDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		err = client.DeleteProject(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project", args[0], "", nil)
 
 		output.Success("Deleted project", plaintext, jsonOut)
 	},
@@ -1543,9 +1783,31 @@ var projectIssuesCmd = &cobra.Command{
 	Short:   "List issues in a project",
 	Long: `List all issues that belong to a specific project.
 
+--group-by milestone|state|assignee splits the list into sections, each with a
+completion summary ("4/9 done, 12/30 points"; canceled issues are not counted).
+Milestones are ordered by target date, with issues that have no milestone last.
+--unfinished hides completed and canceled issues; summaries still cover the
+whole group, so --group-by fetches every issue in the project, ignoring --limit.
+Groups are per milestone, state, or user; two with the same name get the ID or
+email added. In JSON mode --group-by returns an object keyed by group name.
+
+--rollup-parents treats parent issues as epics: every listed issue with
+sub-issues becomes a section headed by its completion ("2/5 done, 3/13
+points"), with its sub-issues beneath, and the remaining issues follow under
+"No parent". Sub-issues in other projects are counted too and marked
+external. In JSON mode it returns {"parents": [...], "unparented": [...]}.
+
+--sort priority|estimate|due|title|state|created|updated orders the issues
+(within each group with --group-by); --asc/--desc flip the direction. The
+sort applies to the fetched issues only; add --all to fetch every issue.
+
 Examples:
-  linear-cli project issues PROJECT-ID            # List project issues
-  linear-cli project issues PROJECT-ID --json     # JSON output`,
+  linear-cli project issues PROJECT-ID                          # List project issues
+  linear-cli project issues PROJECT-ID --json                   # JSON output
+  linear-cli project issues PROJECT-ID --group-by milestone     # One section per milestone
+  linear-cli project issues PROJECT-ID --group-by assignee --unfinished
+  linear-cli project issues PROJECT-ID --rollup-parents --all
+  linear-cli project issues PROJECT-ID --sort priority --all`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -1555,16 +1817,67 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		limit, _ := cmd.Flags().GetInt("limit")
-
-		issues, err := client.GetProjectIssues(context.Background(), projectID, limit, "")
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		unfinished, _ := cmd.Flags().GetBool("unfinished")
+		if groupBy != "" {
+			if err := validateGroupBy(groupBy); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+		issueSorting, err := issueSortFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueSorting = issueSorting.withoutOrderBy()
+
+		var issues *api.Issues
+		fetchAll, _ := cmd.Flags().GetBool("all")
+		// Group summaries count every issue, not just a page
+		fetchAll = fetchAll || groupBy != ""
+		if fetchAll {
+			issues, err = fetchAllIssuePages(func(after string) (*api.Issues, error) {
+				return client.GetProjectIssues(context.Background(), projectID, nil, 100, after)
+			})
+		} else {
+			issues, err = client.GetProjectIssues(context.Background(), projectID, nil, limit, "")
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project issues: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
+		issueSorting.apply(issues.Nodes)
+		issueSorting.warnSortedWindow(issues.PageInfo, len(issues.Nodes))
+
+		if groupBy != "" {
+			renderIssueGroups(groupIssues(issues.Nodes, groupBy, unfinished), plaintext, jsonOut)
+			return
+		}
+		if rollupParents, _ := cmd.Flags().GetBool("rollup-parents"); rollupParents {
+			parentIDs, err := findParentIssues(client, issues.Nodes)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find parent issues: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			children := fetchParentChildren(client, parentIDs)
+			renderParentRollups(buildParentRollups(issues.Nodes, parentIDs, children, issueSorting, unfinished), plaintext, jsonOut)
+			return
+		}
+		if unfinished {
+			kept := []api.Issue{}
+			for _, issue := range issues.Nodes {
+				if !isFinishedIssue(issue) {
+					kept = append(kept, issue)
+				}
+			}
+			issues.Nodes = kept
 		}
 
 		if jsonOut {
@@ -1605,18 +1918,7 @@ Examples:
 				stateColor := color.New(color.FgWhite)
 				if i.State != nil {
 					state = i.State.Name
-					switch i.State.Type {
-					case "triage":
-						stateColor = color.New(color.FgMagenta)
-					case "backlog":
-						stateColor = color.New(color.FgCyan)
-					case "started":
-						stateColor = color.New(color.FgYellow)
-					case "completed":
-						stateColor = color.New(color.FgGreen)
-					case "canceled":
-						stateColor = color.New(color.FgRed)
-					}
+					stateColor = issueStateStyle(i.State).Color()
 				}
 				assignee := "Unassigned"
 				if i.Assignee != nil {
@@ -1644,6 +1946,94 @@ Examples:
 	},
 }
 
+var projectDocumentsCmd = &cobra.Command{
+	Use:     "documents PROJECT-REF",
+	Aliases: []string{"docs"},
+	Short:   "List documents in a project",
+	Long: `List the documents that belong to a project, most recently updated first.
+PROJECT-REF is a project ID, slug, URL, or name.
+
+Examples:
+  linear-cli project documents PROJECT-ID
+  linear-cli project documents "Mobile App" --json
+  linear-cli project docs https://linear.app/acme/project/mobile-app-3f2a1b`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		resolver := newRefResolver(client)
+		projectID := resolver.project(args[0])
+		resolver.report(plaintext, jsonOut)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		filter := map[string]interface{}{
+			"project": map[string]interface{}{"id": map[string]interface{}{"eq": projectID}},
+		}
+		docs, err := client.GetDocuments(context.Background(), filter, limit, "", "updatedAt")
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch project documents: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		warnIfTruncated(cmd, docs.PageInfo, len(docs.Nodes))
+
+		if jsonOut {
+			output.JSON(docs.Nodes)
+			return
+		}
+		if len(docs.Nodes) == 0 {
+			output.Info("No documents in this project", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("# Documents")
+			fmt.Println("ID\tTitle\tCreator\tUpdated")
+			for _, doc := range docs.Nodes {
+				creator := ""
+				if doc.Creator != nil {
+					creator = doc.Creator.Name
+				}
+				fmt.Printf("%s\t%s\t%s\t%s\n", doc.ID, doc.Title, creator, output.FormatTime(doc.UpdatedAt, output.DateOnly))
+			}
+			return
+		}
+
+		rows := [][]string{}
+		for _, doc := range docs.Nodes {
+			creator := ""
+			if doc.Creator != nil {
+				creator = doc.Creator.Name
+			}
+			icon := ""
+			if doc.Icon != nil && *doc.Icon != "" {
+				icon = *doc.Icon + " "
+			}
+			rows = append(rows, []string{
+				icon + truncateString(doc.Title, 45),
+				creator,
+				output.FormatTime(doc.UpdatedAt, output.TableTimeFormat(plaintext, jsonOut)),
+				doc.URL,
+			})
+		}
+		output.Table(output.TableData{
+			Headers: []string{"Title", "Creator", "Updated", "URL"},
+			Rows:    rows,
+		}, false, false)
+
+		fmt.Printf("\n%s %d documents in project\n",
+			color.New(color.FgGreen).Sprint("✓"),
+			len(docs.Nodes))
+	},
+}
+
 func init() {
 	rootCmd.AddCommand(projectCmd)
 	projectCmd.AddCommand(projectListCmd)
@@ -1651,18 +2041,31 @@ func init() {
 	projectCmd.AddCommand(projectAddTeamCmd)
 	projectCmd.AddCommand(projectRemoveTeamCmd)
 	projectCmd.AddCommand(projectIssuesCmd)
+	projectCmd.AddCommand(projectDocumentsCmd)
 	projectCmd.AddCommand(projectCreateCmd)
 	projectCmd.AddCommand(projectUpdateCmd)
 	projectCmd.AddCommand(projectArchiveCmd)
 	projectCmd.AddCommand(projectDeleteCmd)
 
+	// Project get flags
+	projectGetCmd.Flags().Bool("full", false, "With --plaintext, print every section, marking empty ones None")
+	projectGetCmd.Flags().Bool("scope-history", false, "Show scope week by week with the completion trend and projected finish")
+	addFieldsFlags(projectGetCmd)
+
 	// Project issues flags
 	projectIssuesCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to return")
+	projectIssuesCmd.Flags().String("group-by", "", "Group issues into sections: milestone, state, or assignee")
+	projectIssuesCmd.Flags().Bool("unfinished", false, "Hide completed and canceled issues")
+	projectIssuesCmd.Flags().Bool("rollup-parents", false, "Show parent issues as sections with their sub-issues' completion")
+	projectIssuesCmd.MarkFlagsMutuallyExclusive("group-by", "rollup-parents")
+	addIssueSortFlags(projectIssuesCmd)
+
+	projectDocumentsCmd.Flags().IntP("limit", "l", 50, "Maximum number of documents to return")
 
 	// Project create flags
 	projectCreateCmd.Flags().String("name", "", "Project name (required)")
 	projectCreateCmd.Flags().StringP("description", "d", "", "Project description")
-	projectCreateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
+	projectCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	projectCreateCmd.Flags().StringSlice("team-ids", nil, "Team IDs to associate with")
 	projectCreateCmd.Flags().String("state", "planned", "State: planned, started, paused, completed, canceled")
 	projectCreateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
@@ -1671,20 +2074,27 @@ func init() {
 	projectCreateCmd.Flags().StringP("color", "c", "", "Project color (hex, e.g., #4285F4)")
 	projectCreateCmd.Flags().Int("priority", -1, "Project priority (0=None, 1=Urgent, 2=High, 3=Medium, 4=Low)")
 	projectCreateCmd.Flags().String("content", "", "Project content (rich markdown)")
+	projectCreateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
 	projectCreateCmd.Flags().StringP("lead", "L", "", "Project lead (email, name, UUID, or 'me')")
 	projectCreateCmd.Flags().StringSlice("members", nil, "Project members (emails/names, repeatable)")
-	projectCreateCmd.Flags().String("template-id", "", "Template ID to apply")
+	projectCreateCmd.Flags().String("template-id", "", "Project template ID or name to apply")
 	projectCreateCmd.Flags().Bool("use-default-template", false, "Apply default project template")
 	projectCreateCmd.Flags().String("converted-from-issue", "", "Issue ID this project was converted from")
 	projectCreateCmd.Flags().String("start-date-resolution", "", "Start date resolution (day, month, quarter, year)")
 	projectCreateCmd.Flags().String("target-date-resolution", "", "Target date resolution (day, month, quarter, year)")
 	projectCreateCmd.Flags().StringP("initiative", "I", "", "Initiative to link project to (name or UUID)")
+	projectCreateCmd.Flags().StringArray("milestone", nil, "Milestone to create with the project, as \"Name:YYYY-MM-DD\" (repeatable, in order)")
+	projectCreateCmd.Flags().String("milestones-file", "", "Create milestones from a YAML/JSON list of {name, description, targetDate} (use - for stdin)")
+	projectCreateCmd.MarkFlagsMutuallyExclusive("milestone", "milestones-file")
+	addURLOnlyFlag(projectCreateCmd)
+	addPorcelainFlag(projectCreateCmd)
 	_ = projectCreateCmd.MarkFlagRequired("name")
 
 	// Project update flags
 	projectUpdateCmd.Flags().String("name", "", "New project name")
 	projectUpdateCmd.Flags().StringP("description", "d", "", "New description")
-	projectUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file (use - for stdin)")
+	projectUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	addDescriptionEditFlags(projectUpdateCmd)
 	projectUpdateCmd.Flags().String("state", "", "New state: planned, started, paused, completed, canceled")
 	projectUpdateCmd.Flags().String("start-date", "", "New start date (YYYY-MM-DD)")
 	projectUpdateCmd.Flags().String("target-date", "", "New target date (YYYY-MM-DD)")
@@ -1693,6 +2103,7 @@ func init() {
 	projectUpdateCmd.Flags().StringP("color", "c", "", "Project color (hex, e.g., #4285F4)")
 	projectUpdateCmd.Flags().Int("priority", -1, "Project priority (0=None, 1=Urgent, 2=High, 3=Medium, 4=Low)")
 	projectUpdateCmd.Flags().String("content", "", "Project content (rich markdown)")
+	projectUpdateCmd.Flags().String("content-file", "", "Read content from a markdown file or https:// URL (use - for stdin)")
 	projectUpdateCmd.Flags().StringSlice("members", nil, "Project members (emails/names, repeatable, or 'none' to clear)")
 	// Slack integration flags
 	projectUpdateCmd.Flags().Bool("slack-new-issue", false, "Notify Slack on new issues")
@@ -1714,12 +2125,22 @@ func init() {
 	projectUpdateCmd.Flags().Int("update-reminders-hour", -1, "Hour for update reminders (0-23)")
 	projectUpdateCmd.Flags().String("update-reminders-paused-until", "", "Pause reminders until (ISO 8601 timestamp or 'none' to resume)")
 	projectUpdateCmd.Flags().StringP("initiative", "I", "", "Initiative to link project to (name, UUID, or 'none' to unset)")
+	addURLOnlyFlag(projectUpdateCmd)
 
 	// List command flags
 	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key")
 	projectListCmd.Flags().StringP("state", "s", "", "Filter by state (planned, started, paused, completed, canceled)")
 	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return")
 	projectListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled projects")
-	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
-	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: "+strings.Join(projectSortNames(), ", ")+" (priority, target-date, and progress sort the fetched projects)")
+	projectListCmd.Flags().Bool("all", false, "Fetch every matching project, ignoring --limit, so client-side sorts see them all")
+	projectListCmd.Flags().String("health", "", "Filter by health: onTrack, atRisk, offTrack")
+	projectListCmd.Flags().Bool("risk-report", false, "Only atRisk/offTrack projects, with each one's latest status update")
+	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: project_list.newer_than from config, else 6_months_ago; 'all_time' for no filter)")
+	projectListCmd.Flags().Bool("all-time", false, "Show projects regardless of age (same as --newer-than all_time)")
+	projectListCmd.Flags().String("active-since", "", "Show projects created or updated after this time, e.g. 2_weeks_ago")
+	projectListCmd.Flags().Bool("show-initiatives", false, "Add an Initiative column to the table with the initiatives each project rolls up to")
+	projectListCmd.Flags().Int("description-lines", defaultDescriptionLines, "Description lines per item in plaintext output (0 for none, -1 for the full text)")
+	addScriptFlags(projectListCmd, "project IDs")
+	projectListCmd.MarkFlagsMutuallyExclusive("newer-than", "all-time", "active-since")
 }
@@ -0,0 +1,246 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// convertStep is one step of project convert. Status is "done", "failed",
+// "pending" when an earlier step failed, or "planned" under --dry-run.
+type convertStep struct {
+	Step   string `json:"step"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	run    func() error
+}
+
+// projectConvertResult is the JSON output of project convert
+type projectConvertResult struct {
+	Issue   string        `json:"issue"`
+	Project *api.Project  `json:"project"`
+	DryRun  bool          `json:"dryRun"`
+	Steps   []convertStep `json:"steps"`
+}
+
+var projectConvertCmd = &cobra.Command{
+	Use:   "convert ISSUE-ID",
+	Short: "Turn an issue into a project",
+	Long: `Convert an issue into a project, one step at a time:
+
+  1. create the project in the issue's team, named after the issue (or
+     --name), with the issue's description as its content and the issue
+     recorded as what it was converted from
+  2. add the original issue to the project
+  3. with --move-children, add each sub-issue to the project too; they stay
+     sub-issues of the original
+  4. with --close-original, comment on the original with a link to the
+     project and move it to the team's first canceled state
+
+Every step is reported as it runs. If one fails, the rest are not attempted
+and are listed as pending, so what's left can be finished by hand. --dry-run
+prints the steps without changing anything.
+
+Examples:
+  linear-cli project convert ENG-123
+  linear-cli project convert ENG-123 --name "Auth revamp" --move-children
+  linear-cli project convert ENG-123 --move-children --close-original --dry-run`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		name, _ := cmd.Flags().GetString("name")
+		moveChildren, _ := cmd.Flags().GetBool("move-children")
+		closeOriginal, _ := cmd.Flags().GetBool("close-original")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+		ctx := context.Background()
+
+		issue, err := resolveIssueRef(ctx, client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if issue.Team == nil {
+			output.Error(fmt.Sprintf("Issue %s has no team", issue.Identifier), plaintext, jsonOut)
+			exit(1)
+		}
+		if name = strings.TrimSpace(name); name == "" {
+			name = issue.Title
+		}
+
+		// Look the canceled state up front so a team without one fails
+		// before anything is created
+		var canceledID, canceledName string
+		if closeOriginal {
+			canceledID, canceledName, err = resolveStateByType(client, issue, "canceled")
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		result := projectConvertResult{Issue: issue.Identifier, DryRun: dryRun}
+		add := func(step string, run func() error) {
+			result.Steps = append(result.Steps, convertStep{Step: step, run: run})
+		}
+		// updateIssue moves an issue and journals the change
+		updateIssue := func(target *api.Issue, input map[string]interface{}) error {
+			inverse := restoreInverse(target, input)
+			if _, err := client.UpdateIssue(ctx, target.ID, input); err != nil {
+				return err
+			}
+			recordOperation(cmd, "issue", target.ID, target.Identifier, inverse)
+			return nil
+		}
+
+		add(fmt.Sprintf("create project %q in team %s from %s", name, issue.Team.Key, issue.Identifier), func() error {
+			input := map[string]interface{}{
+				"name":                 name,
+				"teamIds":              []string{issue.Team.ID},
+				"convertedFromIssueId": issue.ID,
+			}
+			if issue.Description != "" {
+				input["content"] = issue.Description
+			}
+			project, err := client.CreateProject(ctx, input)
+			if err != nil {
+				return err
+			}
+			recordOperation(cmd, "project", project.ID, project.Name, &journal.Inverse{Action: "archive"})
+			result.Project = project
+			return nil
+		})
+		add(fmt.Sprintf("add %s to the project", issue.Identifier), func() error {
+			return updateIssue(issue, map[string]interface{}{"projectId": result.Project.ID})
+		})
+		if moveChildren && issue.Children != nil {
+			for i := range issue.Children.Nodes {
+				child := &issue.Children.Nodes[i]
+				add(fmt.Sprintf("add sub-issue %s to the project", child.Identifier), func() error {
+					return updateIssue(child, map[string]interface{}{"projectId": result.Project.ID})
+				})
+			}
+		}
+		if closeOriginal {
+			add(fmt.Sprintf("comment on %s with a link to the project", issue.Identifier), func() error {
+				body := fmt.Sprintf("Converted to project [%s](%s)", result.Project.Name, result.Project.URL)
+				comment, err := client.CreateComment(ctx, issue.ID, body, nil)
+				if err != nil {
+					return err
+				}
+				recordOperation(cmd, "comment", comment.ID, "", &journal.Inverse{Action: "delete"})
+				return nil
+			})
+			add(fmt.Sprintf("move %s to %s", issue.Identifier, canceledName), func() error {
+				return updateIssue(issue, map[string]interface{}{"stateId": canceledID})
+			})
+		}
+
+		failed := runConvertSteps(result.Steps, dryRun, plaintext, jsonOut)
+		if jsonOut {
+			output.JSON(result)
+		} else {
+			printConvertSummary(result, failed, plaintext)
+		}
+		if failed {
+			exit(1)
+		}
+	},
+}
+
+// runConvertSteps runs the steps in order, printing each outcome unless the
+// output is JSON, and stops at the first failure. It reports whether one
+// failed.
+func runConvertSteps(steps []convertStep, dryRun, plaintext, jsonOut bool) bool {
+	failed := false
+	for i := range steps {
+		step := &steps[i]
+		switch {
+		case dryRun:
+			step.Status = "planned"
+		case failed:
+			step.Status = "pending"
+		default:
+			step.Status = "done"
+			if err := step.run(); err != nil {
+				step.Status = "failed"
+				step.Error = err.Error()
+				failed = true
+			}
+		}
+		if !jsonOut && step.Status != "pending" {
+			printConvertStep(i+1, *step, plaintext)
+		}
+	}
+	return failed
+}
+
+// printConvertStep prints one step's outcome
+func printConvertStep(n int, step convertStep, plaintext bool) {
+	if plaintext {
+		line := fmt.Sprintf("%d. %s: %s", n, step.Step, step.Status)
+		if step.Error != "" {
+			line += " (" + step.Error + ")"
+		}
+		fmt.Println(line)
+		return
+	}
+	switch step.Status {
+	case "planned":
+		fmt.Printf("  %s %d. %s\n", color.New(color.FgCyan).Sprint("→"), n, step.Step)
+	case "failed":
+		fmt.Printf("  %s %d. %s: %s\n", color.New(color.FgRed).Sprint("✗"), n, step.Step, step.Error)
+	default:
+		fmt.Printf("  %s %d. %s\n", color.New(color.FgGreen).Sprint("✓"), n, step.Step)
+	}
+}
+
+// printConvertSummary lists the steps a failure left undone, or names the
+// new project
+func printConvertSummary(result projectConvertResult, failed, plaintext bool) {
+	if result.DryRun {
+		fmt.Println("Dry run: nothing was changed")
+		return
+	}
+	if failed {
+		var pending []string
+		for i, step := range result.Steps {
+			if step.Status == "pending" {
+				pending = append(pending, fmt.Sprintf("  %d. %s", i+1, step.Step))
+			}
+		}
+		if len(pending) > 0 {
+			fmt.Printf("Stopped; not done:\n%s\n", strings.Join(pending, "\n"))
+		}
+		if result.Project != nil {
+			fmt.Printf("Project %s was kept (ID: %s)\n", result.Project.Name, result.Project.ID)
+		}
+		return
+	}
+	output.Success(fmt.Sprintf("Converted %s into project %s (%s)", result.Issue,
+		color.New(color.FgWhite, color.Bold).Sprint(result.Project.Name), result.Project.URL), plaintext, false)
+}
+
+func init() {
+	projectCmd.AddCommand(projectConvertCmd)
+
+	projectConvertCmd.Flags().String("name", "", "Project name (default: the issue's title)")
+	projectConvertCmd.Flags().Bool("move-children", false, "Also add the issue's sub-issues to the project")
+	projectConvertCmd.Flags().Bool("close-original", false, "Comment on the issue with a link to the project and cancel it")
+	projectConvertCmd.Flags().Bool("dry-run", false, "Print the steps without changing anything")
+}
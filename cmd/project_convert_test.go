@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// stubConvertIssue stubs an issue with two sub-issues in a team with a
+// canceled state, and the mutations project convert makes
+func stubConvertIssue(s *linearmock.Server) {
+	s.Data("Issue", `{"issue":{"id":"i1","identifier":"ENG-1","title":"Auth revamp","description":"Plan",
+		"team":{"id":"t1","key":"ENG"},"state":{"id":"s1","name":"Todo","type":"unstarted"},
+		"children":{"nodes":[{"id":"c1","identifier":"ENG-2"},{"id":"c2","identifier":"ENG-3"}]}}}`)
+	s.Data("TeamStates", `{"team":{"states":{"nodes":[{"id":"s9","name":"Canceled","type":"canceled","position":5},
+		{"id":"s8","name":"Duplicate","type":"canceled","position":6}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("CreateProject", `{"projectCreate":{"success":true,"project":{"id":"p1","name":"Auth revamp","url":"https://linear.app/x/project/p1"}}}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"i1","identifier":"ENG-1"}}}`)
+	s.Data("CreateComment", `{"commentCreate":{"success":true,"comment":{"id":"cm1","body":"x"}}}`)
+}
+
+func TestHermeticProjectConvert(t *testing.T) {
+	s := newMockLinear(t)
+	stubConvertIssue(s)
+
+	r := runMocked(t, "project", "convert", "ENG-1", "--move-children", "--close-original", "--json")
+	var got projectConvertResult
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &got) != nil {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got.Project == nil || got.Project.ID != "p1" || len(got.Steps) != 6 {
+		t.Fatalf("result = %s", r.Stdout)
+	}
+	for _, step := range got.Steps {
+		if step.Status != "done" {
+			t.Errorf("step %q is %s", step.Step, step.Status)
+		}
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Issue,TeamStates,CreateProject,UpdateIssue,UpdateIssue,UpdateIssue,CreateComment,UpdateIssue" {
+		t.Errorf("operations = %s", got)
+	}
+	reqs := s.Requests()
+	create := mustJSON(t, reqs[2].Variables["input"])
+	for _, want := range []string{`"convertedFromIssueId":"i1"`, `"teamIds":["t1"]`, `"content":"Plan"`, `"name":"Auth revamp"`} {
+		if !strings.Contains(create, want) {
+			t.Errorf("project input %s lacks %s", create, want)
+		}
+	}
+	if reqs[5].Variables["id"] != "c2" || !strings.Contains(mustJSON(t, reqs[5].Variables["input"]), `"projectId":"p1"`) {
+		t.Errorf("sub-issue update = %v", reqs[5].Variables)
+	}
+	if !strings.Contains(mustJSON(t, reqs[6].Variables["input"]), "[Auth revamp](https://linear.app/x/project/p1)") {
+		t.Errorf("comment = %v", reqs[6].Variables)
+	}
+	if !strings.Contains(mustJSON(t, reqs[7].Variables["input"]), `"stateId":"s9"`) {
+		t.Errorf("close = %v", reqs[7].Variables)
+	}
+}
+
+func TestHermeticProjectConvertDryRun(t *testing.T) {
+	s := newMockLinear(t)
+	stubConvertIssue(s)
+
+	r := runMocked(t, "project", "convert", "ENG-1", "--name", "Auth", "--close-original", "--dry-run", "--plaintext")
+	want := `1. create project "Auth" in team ENG from ENG-1: planned
+2. add ENG-1 to the project: planned
+3. comment on ENG-1 with a link to the project: planned
+4. move ENG-1 to Canceled: planned
+Dry run: nothing was changed
+`
+	if r.Exit != 0 || r.Stdout != want {
+		t.Errorf("exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Issue,TeamStates" {
+		t.Errorf("dry run made %s", got)
+	}
+}
+
+func TestHermeticProjectConvertStopsOnFailure(t *testing.T) {
+	s := newMockLinear(t)
+	stubConvertIssue(s)
+	s.Add(linearmock.Stub{Operation: "UpdateIssue", Variables: map[string]interface{}{"id": "c1"},
+		Response: json.RawMessage(`{"errors":[{"message":"Issue is archived"}]}`)})
+
+	r := runMocked(t, "project", "convert", "ENG-1", "--move-children", "--close-original", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stdout, "3. add sub-issue ENG-2 to the project: failed (") ||
+		!strings.Contains(r.Stdout, "Stopped; not done:\n  4. add sub-issue ENG-3 to the project\n  5. comment on ENG-1") ||
+		!strings.Contains(r.Stdout, "Project Auth revamp was kept (ID: p1)") {
+		t.Errorf("exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if strings.Contains(strings.Join(s.Operations(), ","), "CreateComment") {
+		t.Errorf("steps after the failure ran: %v", s.Operations())
+	}
+}
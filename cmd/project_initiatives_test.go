@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+const projectWithInitiatives = `{"id":"proj-1","name":"Checkout v2","state":"started","progress":0.5,
+	"url":"https://linear.app/acme/project/checkout-v2-abc","createdAt":"2026-01-02T10:00:00Z","updatedAt":"2026-01-03T10:00:00Z",
+	"teams":{"nodes":[{"id":"team-eng","key":"ENG","name":"Engineering"}]},
+	"initiatives":{"nodes":[{"id":"init-1","name":"Q3 Platform","status":"Active"},{"id":"init-2","name":"Growth","status":"Planned"}]}}`
+
+func TestProjectInitiativeLinks(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Project", `{"project":`+projectWithInitiatives+`}`)
+	s.Data("Projects", `{"projects":{"nodes":[`+projectWithInitiatives+`],"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "project", "get", "proj-1", "--plaintext")
+	if !strings.Contains(r.Stdout, "- **Initiatives**: Q3 Platform (Active), Growth (Planned)\n") {
+		t.Errorf("project get --plaintext is missing the initiatives:\n%s", r.Stdout)
+	}
+	r = runMocked(t, "project", "get", "proj-1")
+	if !strings.Contains(r.Stdout, "Initiatives: Q3 Platform (Active), Growth (Planned)") {
+		t.Errorf("project get is missing the initiatives:\n%s", r.Stdout)
+	}
+	r = runMocked(t, "project", "get", "proj-1", "--json")
+	if !strings.Contains(r.Stdout, `"name": "Growth"`) {
+		t.Errorf("project get --json is missing the initiatives:\n%s", r.Stdout)
+	}
+
+	r = runMocked(t, "project", "list", "--all-time")
+	if r.Exit != 0 || strings.Contains(r.Stdout, "Q3 Platform") {
+		t.Errorf("project list shows initiatives without --show-initiatives (exit %d):\n%s", r.Exit, r.Stdout)
+	}
+	r = runMocked(t, "project", "list", "--all-time", "--show-initiatives")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "INITIATIVE") || !strings.Contains(r.Stdout, "Q3 Platform, Growth") {
+		t.Errorf("project list --show-initiatives exited %d:\n%s", r.Exit, r.Stdout)
+	}
+	r = runMocked(t, "project", "list", "--all-time", "--plaintext")
+	if !strings.Contains(r.Stdout, "- **Initiatives**: Q3 Platform (Active), Growth (Planned)\n") {
+		t.Errorf("project list --plaintext is missing the initiatives:\n%s", r.Stdout)
+	}
+}
@@ -0,0 +1,298 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// stateTypeOrder is the workflow order used when grouping by state
+var stateTypeOrder = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  5,
+}
+
+// issueGroupSummary counts completion within a group. Canceled issues are
+// left out of the totals, as in Linear's own progress figures.
+type issueGroupSummary struct {
+	Done        int     `json:"done"`
+	Total       int     `json:"total"`
+	DonePoints  float64 `json:"donePoints"`
+	TotalPoints float64 `json:"totalPoints"`
+}
+
+// String renders the summary line, e.g. "4/9 done, 12/30 points"
+func (s issueGroupSummary) String() string {
+	return fmt.Sprintf("%d/%d done, %s/%s points", s.Done, s.Total, formatPoints(s.DonePoints), formatPoints(s.TotalPoints))
+}
+
+// add counts an issue toward the summary, skipping canceled ones
+func (s *issueGroupSummary) add(issue api.Issue) {
+	if issue.State != nil && issue.State.Type == "canceled" {
+		return
+	}
+	points := 0.0
+	if issue.Estimate != nil {
+		points = *issue.Estimate
+	}
+	s.Total++
+	s.TotalPoints += points
+	if issue.State != nil && issue.State.Type == "completed" {
+		s.Done++
+		s.DonePoints += points
+	}
+}
+
+// formatPoints drops the decimals from whole estimates
+func formatPoints(p float64) string {
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", p), ".0")
+}
+
+// issueGroup is one section of 'project issues --group-by'
+type issueGroup struct {
+	Name       string            `json:"-"`
+	TargetDate *string           `json:"targetDate,omitempty"`
+	Summary    issueGroupSummary `json:"summary"`
+	Issues     []api.Issue       `json:"issues"`
+
+	key     string // the milestone, state, or assignee ID
+	detail  string // tells apart groups whose names are the same
+	sortKey string
+	last    bool // the "no milestone"/"Unassigned" bucket sorts after the rest
+}
+
+// issueGroups keeps groups in display order; it marshals to a JSON object
+// keyed by group name without losing that order
+type issueGroups []*issueGroup
+
+func (g issueGroups) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, group := range g {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(group.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(group)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// validateGroupBy checks the project issues --group-by value
+func validateGroupBy(by string) error {
+	switch by {
+	case "milestone", "state", "assignee":
+		return nil
+	}
+	return fmt.Errorf("invalid --group-by value %q: use milestone, state, or assignee", by)
+}
+
+// isFinishedIssue reports whether an issue is completed or canceled
+func isFinishedIssue(issue api.Issue) bool {
+	return issue.State != nil && (issue.State.Type == "completed" || issue.State.Type == "canceled")
+}
+
+// groupIssues splits issues into groups by milestone, state, or assignee.
+// Groups are keyed by ID, so two assignees or states sharing a name stay
+// apart; their names then get the email or ID added. Summaries always cover
+// the whole group; with unfinished, completed and canceled issues are then
+// dropped from the listed issues.
+func groupIssues(issues []api.Issue, by string, unfinished bool) issueGroups {
+	byKey := map[string]*issueGroup{}
+	var groups issueGroups
+	for _, issue := range issues {
+		key := issueGroupOf(issue, by)
+		group, ok := byKey[key.key]
+		if !ok {
+			group = key
+			group.Issues = []api.Issue{}
+			byKey[key.key] = group
+			groups = append(groups, group)
+		}
+
+		group.Summary.add(issue)
+		if !unfinished || !isFinishedIssue(issue) {
+			group.Issues = append(group.Issues, issue)
+		}
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if groups[i].last != groups[j].last {
+			return groups[j].last
+		}
+		if groups[i].sortKey != groups[j].sortKey {
+			return groups[i].sortKey < groups[j].sortKey
+		}
+		if groups[i].Name != groups[j].Name {
+			return groups[i].Name < groups[j].Name
+		}
+		return groups[i].detail < groups[j].detail
+	})
+
+	named := map[string]int{}
+	for _, group := range groups {
+		named[group.Name]++
+	}
+	for _, group := range groups {
+		if named[group.Name] > 1 && group.detail != "" {
+			group.Name += " (" + group.detail + ")"
+		}
+	}
+	return groups
+}
+
+// issueGroupOf returns the empty group an issue belongs to, with how that
+// group sorts
+func issueGroupOf(issue api.Issue, by string) *issueGroup {
+	switch by {
+	case "milestone":
+		ms := issue.ProjectMilestone
+		if ms == nil {
+			return &issueGroup{Name: "No milestone", last: true}
+		}
+		group := &issueGroup{Name: ms.Name, key: groupKey(ms.ID, ms.Name), detail: ms.ID, sortKey: "1"}
+		// Dated milestones come first, by date; undated ones follow by name
+		if ms.TargetDate != nil && *ms.TargetDate != "" {
+			group.TargetDate = ms.TargetDate
+			group.sortKey = "0 " + *ms.TargetDate
+		}
+		return group
+	case "state":
+		if issue.State == nil {
+			return &issueGroup{Name: "No state", last: true}
+		}
+		order, ok := stateTypeOrder[issue.State.Type]
+		if !ok {
+			order = len(stateTypeOrder)
+		}
+		return &issueGroup{Name: issue.State.Name, key: groupKey(issue.State.ID, issue.State.Name), detail: issue.State.ID, sortKey: fmt.Sprintf("%d", order)}
+	default:
+		a := issue.Assignee
+		if a == nil {
+			return &issueGroup{Name: "Unassigned", last: true}
+		}
+		detail := a.Email
+		if detail == "" {
+			detail = a.ID
+		}
+		return &issueGroup{Name: a.Name, key: groupKey(a.ID, a.Name), detail: detail, sortKey: strings.ToLower(a.Name)}
+	}
+}
+
+// groupKey keys a group by its entity's ID, falling back to the name when
+// the ID wasn't fetched
+func groupKey(id, name string) string {
+	if id != "" {
+		return id
+	}
+	return "name:" + name
+}
+
+// renderIssueGroups prints grouped project issues in the requested format
+func renderIssueGroups(groups issueGroups, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(groups)
+		return
+	}
+	if len(groups) == 0 {
+		if plaintext {
+			fmt.Println("No issues found")
+		} else {
+			fmt.Printf("\n%s No issues in this project\n", color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+		return
+	}
+	if plaintext {
+		writeIssueGroupsPlaintext(os.Stdout, groups)
+		return
+	}
+
+	for _, group := range groups {
+		heading := group.Name
+		if group.TargetDate != nil {
+			heading += " (" + *group.TargetDate + ")"
+		}
+		fmt.Printf("\n%s  %s\n",
+			color.New(color.FgCyan, color.Bold).Sprint(heading),
+			color.New(color.Faint).Sprint(group.Summary.String()))
+		if len(group.Issues) == 0 {
+			fmt.Println(color.New(color.Faint).Sprint("  No unfinished issues"))
+			continue
+		}
+
+		rows := [][]string{}
+		for _, i := range group.Issues {
+			state := ""
+			stateColor := color.New(color.FgWhite)
+			if i.State != nil {
+				state = i.State.Name
+				stateColor = issueStateStyle(i.State).Color()
+			}
+			assignee := "Unassigned"
+			if i.Assignee != nil {
+				assignee = i.Assignee.Name
+			}
+			rows = append(rows, []string{
+				color.New(color.FgCyan).Sprint(i.Identifier),
+				i.Title,
+				stateColor.Sprint(state),
+				i.PriorityLabel,
+				assignee,
+			})
+		}
+		output.Table(output.TableData{
+			Headers: []string{"ID", "Title", "State", "Priority", "Assignee"},
+			Rows:    rows,
+		}, false, false)
+	}
+}
+
+// writeIssueGroupsPlaintext writes one "## group" section per group under a
+// "# Issues" heading, each with its summary line and issue rows
+func writeIssueGroupsPlaintext(w io.Writer, groups issueGroups) {
+	fmt.Fprintln(w, "# Issues")
+	for _, group := range groups {
+		heading := group.Name
+		if group.TargetDate != nil {
+			heading += " (" + *group.TargetDate + ")"
+		}
+		fmt.Fprintf(w, "\n## %s\n\n%s\n", heading, group.Summary)
+		if len(group.Issues) == 0 {
+			continue
+		}
+		fmt.Fprintln(w, "\nID\tTitle\tState\tPriority\tAssignee")
+		for _, i := range group.Issues {
+			state := ""
+			if i.State != nil {
+				state = i.State.Name
+			}
+			assignee := "Unassigned"
+			if i.Assignee != nil {
+				assignee = i.Assignee.Name
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", i.Identifier, i.Title, state, i.PriorityLabel, assignee)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func groupTestIssues() []api.Issue {
+	est := func(f float64) *float64 { return &f }
+	date := func(s string) *string { return &s }
+	alpha := &api.ProjectMilestone{ID: "m1", Name: "Alpha", TargetDate: date("2026-03-01")}
+	beta := &api.ProjectMilestone{ID: "m2", Name: "Beta", TargetDate: date("2026-01-15")}
+	later := &api.ProjectMilestone{ID: "m3", Name: "Someday"}
+	done := &api.State{Name: "Done", Type: "completed"}
+	todo := &api.State{Name: "Todo", Type: "unstarted"}
+	canceled := &api.State{Name: "Canceled", Type: "canceled"}
+	ann := &api.User{Name: "Ann"}
+	return []api.Issue{
+		{Identifier: "P-1", Title: "One", State: done, Estimate: est(3), ProjectMilestone: alpha, Assignee: ann},
+		{Identifier: "P-2", Title: "Two", State: todo, Estimate: est(5), ProjectMilestone: alpha},
+		{Identifier: "P-3", Title: "Three", State: canceled, Estimate: est(8), ProjectMilestone: alpha},
+		{Identifier: "P-4", Title: "Four", State: todo, Estimate: est(2)},
+		{Identifier: "P-5", Title: "Five", State: done, ProjectMilestone: beta, Assignee: ann},
+		{Identifier: "P-6", Title: "Six", State: todo, ProjectMilestone: later},
+	}
+}
+
+func TestGroupIssuesByMilestone(t *testing.T) {
+	groups := groupIssues(groupTestIssues(), "milestone", false)
+
+	var names []string
+	for _, g := range groups {
+		names = append(names, g.Name)
+	}
+	if got := strings.Join(names, ","); got != "Beta,Alpha,Someday,No milestone" {
+		t.Fatalf("group order = %s", got)
+	}
+	alpha := groups[1]
+	if got := alpha.Summary.String(); got != "1/2 done, 3/8 points" {
+		t.Errorf("Alpha summary = %q", got)
+	}
+	if len(alpha.Issues) != 3 {
+		t.Errorf("Alpha lists %d issues, want 3", len(alpha.Issues))
+	}
+}
+
+func TestGroupIssuesUnfinishedKeepsSummary(t *testing.T) {
+	groups := groupIssues(groupTestIssues(), "milestone", true)
+	alpha := groups[1]
+	if len(alpha.Issues) != 1 || alpha.Issues[0].Identifier != "P-2" {
+		t.Errorf("Alpha issues = %v, want only P-2", alpha.Issues)
+	}
+	if alpha.Summary.Done != 1 || alpha.Summary.Total != 2 {
+		t.Errorf("Alpha summary = %+v, want whole-group counts", alpha.Summary)
+	}
+	if beta := groups[0]; len(beta.Issues) != 0 {
+		t.Errorf("Beta should list no issues, got %d", len(beta.Issues))
+	}
+}
+
+func TestGroupIssuesByAssigneeAndState(t *testing.T) {
+	byAssignee := groupIssues(groupTestIssues(), "assignee", false)
+	if len(byAssignee) != 2 || byAssignee[0].Name != "Ann" || byAssignee[1].Name != "Unassigned" {
+		t.Errorf("assignee groups = %v", byAssignee)
+	}
+	byState := groupIssues(groupTestIssues(), "state", false)
+	var names []string
+	for _, g := range byState {
+		names = append(names, g.Name)
+	}
+	if got := strings.Join(names, ","); got != "Todo,Done,Canceled" {
+		t.Errorf("state group order = %s", got)
+	}
+}
+
+func TestGroupIssuesKeysByID(t *testing.T) {
+	issues := []api.Issue{
+		{Identifier: "P-1", Assignee: &api.User{ID: "u1", Name: "Sam", Email: "sam@a.example"}, State: &api.State{ID: "s1", Name: "Todo", Type: "unstarted"}},
+		{Identifier: "P-2", Assignee: &api.User{ID: "u2", Name: "Sam", Email: "sam@b.example"}, State: &api.State{ID: "s2", Name: "Todo", Type: "unstarted"}},
+		{Identifier: "P-3", Assignee: &api.User{ID: "u1", Name: "Sam", Email: "sam@a.example"}, State: &api.State{ID: "s1", Name: "Todo", Type: "unstarted"}},
+		{Identifier: "P-4", Assignee: &api.User{ID: "u3", Name: "Kim"}},
+	}
+	var names []string
+	for _, g := range groupIssues(issues, "assignee", false) {
+		names = append(names, g.Name+"="+g.Summary.String())
+	}
+	if got := strings.Join(names, ","); got != "Kim=0/1 done, 0/0 points,Sam (sam@a.example)=0/2 done, 0/0 points,Sam (sam@b.example)=0/1 done, 0/0 points" {
+		t.Errorf("assignee groups = %s", got)
+	}
+	byState := groupIssues(issues, "state", false)
+	if len(byState) != 3 || byState[0].Name != "Todo (s1)" || byState[1].Name != "Todo (s2)" || byState[2].Name != "No state" {
+		t.Errorf("state groups = %v", byState)
+	}
+}
+
+func TestProjectIssuesGroupByFetchesEveryPage(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectIssues", `{"project":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","estimate":2,"state":{"id":"s1","name":"Done","type":"completed"}}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+	s.DataFor("ProjectIssues", map[string]interface{}{"after": "c1"}, `{"project":{"issues":{"nodes":[
+		{"id":"i2","identifier":"ENG-2","estimate":3,"state":{"id":"s2","name":"Todo","type":"unstarted"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+
+	r := runMocked(t, "project", "issues", "p1", "--group-by", "milestone", "--limit", "1", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "1/2 done, 2/5 points") {
+		t.Errorf("--group-by --limit 1 exited %d, want a summary of both pages:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
+
+func TestIssueGroupsJSONKeepsOrder(t *testing.T) {
+	data, err := json.Marshal(groupIssues(groupTestIssues(), "milestone", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(data)
+	if !strings.HasPrefix(s, `{"Beta":{"targetDate":"2026-01-15","summary":{"done":1,"total":1,"donePoints":0,"totalPoints":0},"issues":[]}`) {
+		t.Errorf("unexpected JSON start: %s", s)
+	}
+	if strings.Index(s, `"Alpha"`) > strings.Index(s, `"No milestone"`) {
+		t.Errorf("groups out of order: %s", s)
+	}
+	var decoded map[string]struct {
+		Summary issueGroupSummary `json:"summary"`
+		Issues  []api.Issue       `json:"issues"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil || len(decoded) != 4 {
+		t.Errorf("JSON does not decode as an object of 4 groups: %v", err)
+	}
+}
+
+func TestWriteIssueGroupsPlaintext(t *testing.T) {
+	var buf bytes.Buffer
+	writeIssueGroupsPlaintext(&buf, groupIssues(groupTestIssues()[:2], "milestone", false))
+	want := "# Issues\n\n## Alpha (2026-03-01)\n\n1/2 done, 3/8 points\n\nID\tTitle\tState\tPriority\tAssignee\n" +
+		"P-1\tOne\tDone\t\tAnn\nP-2\tTwo\tTodo\t\tUnassigned\n"
+	if buf.String() != want {
+		t.Errorf("plaintext =\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestBuildParentRollups(t *testing.T) {
+	issues := groupTestIssues()
+	for i := range issues {
+		issues[i].ID = strings.ToLower(issues[i].Identifier)
+	}
+	in := &api.Project{ID: "proj-1"}
+	other := &api.Project{ID: "proj-2"}
+	est := func(f float64) *float64 { return &f }
+	children := map[string]issueChildren{
+		"p-4": {projectID: "proj-1", nodes: []api.Issue{
+			{ID: "p-1", Identifier: "P-1", State: &api.State{Name: "Done", Type: "completed"}, Estimate: est(3), Project: in},
+			{ID: "p-2", Identifier: "P-2", State: &api.State{Name: "Todo", Type: "unstarted"}, Estimate: est(5), Project: in},
+			{ID: "x-9", Identifier: "X-9", State: &api.State{Name: "Done", Type: "completed"}, Estimate: est(2), Project: other},
+		}},
+		"p-6": {err: errors.New("timeout")},
+	}
+	parents := map[string]bool{"p-4": true, "p-6": true}
+
+	rollups := buildParentRollups(issues, parents, children, issueSort{}, true)
+	if len(rollups.Parents) != 2 || rollups.Parents[0].Issue.Identifier != "P-4" {
+		t.Fatalf("parents = %+v", rollups.Parents)
+	}
+	epic := rollups.Parents[0]
+	if got := epic.summaryText(); got != "2/3 done, 5/10 points, 1 external" {
+		t.Errorf("P-4 summary = %q", got)
+	}
+	if len(epic.Children) != 1 || epic.Children[0].Identifier != "P-2" {
+		t.Errorf("--unfinished children = %v, want only P-2", epic.Children)
+	}
+	if failed := rollups.Parents[1]; failed.Error != "timeout" || !strings.Contains(failed.summaryText(), "could not be fetched") {
+		t.Errorf("P-6 = %+v", failed)
+	}
+	// P-1 and P-2 are listed under P-4; P-3 and P-5 are finished
+	if len(rollups.Unparented) != 0 {
+		t.Errorf("unparented = %v", rollups.Unparented)
+	}
+
+	// A sub-issue with sub-issues of its own is listed once, as a parent,
+	// and still counts toward its parent's summary
+	nestedChildren := map[string]issueChildren{
+		"p-4": {projectID: "proj-1", nodes: []api.Issue{
+			{ID: "p-1", Identifier: "P-1", State: &api.State{Name: "Done", Type: "completed"}, Project: in},
+			{ID: "p-6", Identifier: "P-6", State: &api.State{Name: "Todo", Type: "unstarted"}, Project: in},
+		}},
+		"p-6": {projectID: "proj-1", nodes: []api.Issue{
+			{ID: "p-2", Identifier: "P-2", State: &api.State{Name: "Todo", Type: "unstarted"}, Project: in},
+		}},
+	}
+	rollups = buildParentRollups(issues, parents, nestedChildren, issueSort{}, false)
+	seen := map[string]int{}
+	for _, rollup := range rollups.Parents {
+		seen[rollup.Issue.Identifier]++
+		for _, child := range rollup.Children {
+			seen[child.Identifier]++
+		}
+	}
+	if seen["P-6"] != 1 || seen["P-2"] != 1 || len(rollups.Parents) != 2 {
+		t.Errorf("listed %v across %d parents; want P-6 and P-2 once each", seen, len(rollups.Parents))
+	}
+	if got := rollups.Parents[0].summaryText(); got != "1/2 done, 0/0 points" {
+		t.Errorf("P-4 summary = %q, want P-6 counted", got)
+	}
+
+	rollups = buildParentRollups(issues, parents, children, issueSort{}, false)
+	var buf bytes.Buffer
+	writeParentRollupsPlaintext(&buf, rollups)
+	want := "# Issues\n\n## P-4 Four\n\n2/3 done, 5/10 points, 1 external\n\nID\tTitle\tState\tPriority\tAssignee\tExternal\n" +
+		"P-1\t\tDone\t\tUnassigned\t\nP-2\t\tTodo\t\tUnassigned\t\nX-9\t\tDone\t\tUnassigned\texternal\n" +
+		"\n## P-6 Six\n\nsub-issues could not be fetched: timeout\n" +
+		"\n## No parent\n\nID\tTitle\tState\tPriority\tAssignee\nP-3\tThree\tCanceled\t\tUnassigned\nP-5\tFive\tDone\t\tAnn\n"
+	if buf.String() != want {
+		t.Errorf("plaintext =\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestProjectIssuesRollupParents(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectIssues", `{"project":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","title":"Checkout epic","state":{"name":"In Progress","type":"started"}},
+		{"id":"i2","identifier":"ENG-2","title":"Cart API","state":{"name":"Done","type":"completed"}},
+		{"id":"i3","identifier":"ENG-3","title":"Docs","state":{"name":"Todo","type":"unstarted"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("Issues", `{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","children":{"nodes":[{"id":"i2"},{"id":"w7"}]}},
+		{"id":"i2","identifier":"ENG-2","children":{"nodes":[]}},
+		{"id":"i3","identifier":"ENG-3","children":{"nodes":[]}}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("IssueChildren", `{"issue":{"id":"i1","project":{"id":"p1"},"children":{"nodes":[
+		{"id":"i2","identifier":"ENG-2","title":"Cart API","estimate":3,"state":{"name":"Done","type":"completed"},"project":{"id":"p1","name":"Checkout"}},
+		{"id":"w7","identifier":"WEB-7","title":"Cart UI","estimate":2,"state":{"name":"Todo","type":"unstarted"},"project":{"id":"p2","name":"Web"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+
+	r := runMocked(t, "project", "issues", "p1", "--rollup-parents", "--json")
+	var got parentRollups
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil || r.Exit != 0 {
+		t.Fatalf("project issues --rollup-parents exited %d: %s (%v)", r.Exit, r.Stdout+r.Stderr, err)
+	}
+	if len(got.Parents) != 1 || got.Parents[0].Summary != (issueGroupSummary{Done: 1, Total: 2, DonePoints: 3, TotalPoints: 5}) ||
+		strings.Join(got.Parents[0].External, ",") != "WEB-7" || len(got.Parents[0].Children) != 2 {
+		t.Errorf("parents = %+v", got.Parents)
+	}
+	if len(got.Unparented) != 1 || got.Unparented[0].Identifier != "ENG-3" {
+		t.Errorf("unparented = %+v", got.Unparented)
+	}
+	if ops := strings.Join(s.Operations(), ","); ops != "ProjectIssues,Issues,IssueChildren" {
+		t.Errorf("operations = %s", ops)
+	}
+}
@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// parentLookupChunk is how many issue IDs one parent lookup filters on
+const parentLookupChunk = 100
+
+// parentRollup is one parent issue in 'project issues --rollup-parents'
+// with its sub-issues' completion. External names the sub-issues from other
+// projects, which are counted too. Error is set instead of the summary when
+// the sub-issues couldn't be fetched.
+type parentRollup struct {
+	Issue    api.Issue         `json:"issue"`
+	Summary  issueGroupSummary `json:"summary"`
+	External []string          `json:"external"`
+	Children []api.Issue       `json:"children"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// summaryText is the summary line, e.g. "2/5 done, 3/13 points, 1 external"
+func (r *parentRollup) summaryText() string {
+	if r.Error != "" {
+		return "sub-issues could not be fetched: " + r.Error
+	}
+	text := r.Summary.String()
+	if len(r.External) > 0 {
+		text += fmt.Sprintf(", %d external", len(r.External))
+	}
+	return text
+}
+
+// parentRollups is the --rollup-parents listing: the parents in listing
+// order, then the issues that are neither a parent nor a parent's sub-issue
+type parentRollups struct {
+	Parents    []*parentRollup `json:"parents"`
+	Unparented []api.Issue     `json:"unparented"`
+}
+
+// issueChildren is a parent's fetched sub-issues and the parent's project,
+// which tells which sub-issues are external
+type issueChildren struct {
+	projectID string
+	nodes     []api.Issue
+	err       error
+}
+
+// findParentIssues returns the IDs of the issues that have sub-issues
+func findParentIssues(client *api.Client, issues []api.Issue) (map[string]bool, error) {
+	parents := map[string]bool{}
+	for start := 0; start < len(issues); start += parentLookupChunk {
+		ids := []string{}
+		for _, issue := range issues[start:min(start+parentLookupChunk, len(issues))] {
+			ids = append(ids, issue.ID)
+		}
+		filter := map[string]interface{}{"id": map[string]interface{}{"in": ids}}
+		found, err := fetchAllIssuePages(func(after string) (*api.Issues, error) {
+			return client.GetIssuesWithFields(context.Background(), filter, 250, after, "", api.IssueFieldsIDs|api.IssueFieldChildren)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range found.Nodes {
+			if issue.Children != nil && len(issue.Children.Nodes) > 0 {
+				parents[issue.ID] = true
+			}
+		}
+	}
+	return parents, nil
+}
+
+// fetchParentChildren fetches every parent's sub-issues concurrently on the
+// shared pool. A parent that fails carries its error rather than failing the
+// listing.
+func fetchParentChildren(client *api.Client, parentIDs map[string]bool) map[string]issueChildren {
+	ids := make([]string, 0, len(parentIDs))
+	for id := range parentIDs {
+		ids = append(ids, id)
+	}
+	results := make([]issueChildren, len(ids))
+	entity := func(i int) string { return ids[i] }
+	errs := runWithProgress("Fetching sub-issues", len(ids), entity, func(ctx context.Context, i int) error {
+		// The pool retries rate-limited tasks, so each try starts over
+		result := issueChildren{}
+		after := ""
+		for {
+			issue, err := client.GetIssueChildren(ctx, ids[i], 100, after)
+			if err != nil {
+				return err
+			}
+			if issue.Project != nil {
+				result.projectID = issue.Project.ID
+			}
+			if issue.Children == nil {
+				break
+			}
+			result.nodes = append(result.nodes, issue.Children.Nodes...)
+			after = issue.Children.PageInfo.EndCursor
+			if !issue.Children.PageInfo.HasNextPage || after == "" {
+				break
+			}
+		}
+		results[i] = result
+		return nil
+	})
+
+	children := make(map[string]issueChildren, len(ids))
+	for i, id := range ids {
+		results[i].err = errs[i]
+		children[id] = results[i]
+	}
+	return children
+}
+
+// buildParentRollups arranges the listed issues under their parents.
+// Summaries cover every sub-issue; with unfinished, completed and canceled
+// sub-issues and unparented issues are then left out of the lists. A
+// sub-issue that is a listed parent itself appears once, under its own
+// heading, though its parent's summary still counts it.
+func buildParentRollups(issues []api.Issue, parentIDs map[string]bool, children map[string]issueChildren, sorting issueSort, unfinished bool) parentRollups {
+	rollups := parentRollups{Parents: []*parentRollup{}, Unparented: []api.Issue{}}
+	nested := map[string]bool{}
+	for _, issue := range issues {
+		if !parentIDs[issue.ID] {
+			continue
+		}
+		rollup := &parentRollup{Issue: issue, External: []string{}, Children: []api.Issue{}}
+		rollups.Parents = append(rollups.Parents, rollup)
+		kids := children[issue.ID]
+		if kids.err != nil {
+			rollup.Error = kids.err.Error()
+			continue
+		}
+		sorting.apply(kids.nodes)
+		for _, child := range kids.nodes {
+			nested[child.ID] = true
+			rollup.Summary.add(child)
+			if child.Project == nil || child.Project.ID != kids.projectID {
+				rollup.External = append(rollup.External, child.Identifier)
+			}
+			if parentIDs[child.ID] {
+				continue
+			}
+			if !unfinished || !isFinishedIssue(child) {
+				rollup.Children = append(rollup.Children, child)
+			}
+		}
+	}
+
+	for _, issue := range issues {
+		if parentIDs[issue.ID] || nested[issue.ID] || (unfinished && isFinishedIssue(issue)) {
+			continue
+		}
+		rollups.Unparented = append(rollups.Unparented, issue)
+	}
+	return rollups
+}
+
+// isExternal reports whether the rollup counted child from another project
+func (r *parentRollup) isExternal(child api.Issue) bool {
+	for _, identifier := range r.External {
+		if identifier == child.Identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// renderParentRollups prints the --rollup-parents listing in the requested
+// format
+func renderParentRollups(rollups parentRollups, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(rollups)
+		return
+	}
+	if len(rollups.Parents) == 0 && len(rollups.Unparented) == 0 {
+		if plaintext {
+			fmt.Println("No issues found")
+		} else {
+			fmt.Printf("\n%s No issues in this project\n", color.New(color.FgYellow).Sprint("ℹ️"))
+		}
+		return
+	}
+	if plaintext {
+		writeParentRollupsPlaintext(os.Stdout, rollups)
+		return
+	}
+
+	faint := color.New(color.Faint)
+	for _, rollup := range rollups.Parents {
+		fmt.Printf("\n%s %s  %s\n",
+			color.New(color.FgCyan, color.Bold).Sprint(rollup.Issue.Identifier),
+			color.New(color.Bold).Sprint(rollup.Issue.Title),
+			faint.Sprint(rollup.summaryText()))
+		if rollup.Error == "" && len(rollup.Children) == 0 {
+			fmt.Println(faint.Sprint("  No unfinished sub-issues"))
+		}
+		for _, child := range rollup.Children {
+			printRollupChild(child, rollup.isExternal(child))
+		}
+	}
+	if len(rollups.Unparented) > 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgCyan, color.Bold).Sprint("No parent"))
+		for _, issue := range rollups.Unparented {
+			printRollupChild(issue, false)
+		}
+	}
+}
+
+// printRollupChild prints one indented issue line of the rich listing
+func printRollupChild(issue api.Issue, external bool) {
+	state := ""
+	stateColor := color.New(color.FgWhite)
+	if issue.State != nil {
+		state = issue.State.Name
+		stateColor = issueStateStyle(issue.State).Color()
+	}
+	assignee := "Unassigned"
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.Name
+	}
+	line := fmt.Sprintf("  %s  %s  %s  %s",
+		color.New(color.FgCyan).Sprintf("%-9s", issue.Identifier),
+		issue.Title,
+		stateColor.Sprint(state),
+		color.New(color.Faint).Sprint(assignee))
+	if external {
+		line += "  " + color.New(color.FgYellow).Sprint("external")
+	}
+	fmt.Println(line)
+}
+
+// writeParentRollupsPlaintext writes one "## parent" section per parent
+// under a "# Issues" heading, each with its summary line and sub-issue rows,
+// then a "## No parent" section
+func writeParentRollupsPlaintext(w io.Writer, rollups parentRollups) {
+	fmt.Fprintln(w, "# Issues")
+	for _, rollup := range rollups.Parents {
+		fmt.Fprintf(w, "\n## %s %s\n\n%s\n", rollup.Issue.Identifier, rollup.Issue.Title, rollup.summaryText())
+		if len(rollup.Children) == 0 {
+			continue
+		}
+		fmt.Fprintln(w, "\nID\tTitle\tState\tPriority\tAssignee\tExternal")
+		for _, child := range rollup.Children {
+			external := ""
+			if rollup.isExternal(child) {
+				external = "external"
+			}
+			fmt.Fprintf(w, "%s\t%s\n", plaintextIssueRow(child), external)
+		}
+	}
+	if len(rollups.Unparented) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "\n## No parent\n\nID\tTitle\tState\tPriority\tAssignee")
+	for _, issue := range rollups.Unparented {
+		fmt.Fprintln(w, plaintextIssueRow(issue))
+	}
+}
+
+// plaintextIssueRow is an issue's ID, title, state, priority, and assignee,
+// tab-separated
+func plaintextIssueRow(issue api.Issue) string {
+	state := ""
+	if issue.State != nil {
+		state = issue.State.Name
+	}
+	assignee := "Unassigned"
+	if issue.Assignee != nil {
+		assignee = issue.Assignee.Name
+	}
+	return fmt.Sprintf("%s\t%s\t%s\t%s\t%s", issue.Identifier, issue.Title, state, issue.PriorityLabel, assignee)
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// healthStaleAfter is how long a project's health can go unchanged before
+// it is flagged as stale
+const healthStaleAfter = 14 * 24 * time.Hour
+
+// riskReportConcurrency bounds the latest-update lookups in --risk-report
+const riskReportConcurrency = 8
+
+// riskHealthOrder is the digest section order, worst first
+var riskHealthOrder = []string{"offTrack", "atRisk"}
+
+// riskReportRow is one project in a --risk-report
+type riskReportRow struct {
+	ID              string     `json:"id"`
+	Name            string     `json:"name"`
+	Health          string     `json:"health"`
+	HealthUpdatedAt *time.Time `json:"healthUpdatedAt"`
+	Stale           bool       `json:"stale"`
+	Lead            string     `json:"lead,omitempty"`
+	LastUpdateAt    *time.Time `json:"lastUpdateAt"`
+	LastUpdate      string     `json:"lastUpdate,omitempty"`
+	UpdateError     string     `json:"updateError,omitempty"` // why LastUpdate couldn't be fetched
+	URL             string     `json:"url"`
+}
+
+// isHealthStale reports whether a project's health was last set more than
+// healthStaleAfter before now. Never-set health counts as stale.
+func isHealthStale(healthUpdatedAt *time.Time, now time.Time) bool {
+	return healthUpdatedAt == nil || now.Sub(*healthUpdatedAt) > healthStaleAfter
+}
+
+// updateExcerpt returns the first non-empty line of a status update body
+// with leading markdown markers removed, cut to maxLen
+func updateExcerpt(body string, maxLen int) string {
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(line), "#>*-+ "))
+		if line != "" {
+			return truncateString(line, maxLen)
+		}
+	}
+	return ""
+}
+
+// buildRiskReport fetches each project's latest status update concurrently
+// and returns the report rows in project order. A failed lookup is recorded
+// on its row rather than read as "no status updates".
+func buildRiskReport(client *api.Client, projects []api.Project, now time.Time) []riskReportRow {
+	rows := make([]riskReportRow, len(projects))
+	sem := make(chan struct{}, riskReportConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range projects {
+		p := &projects[i]
+		rows[i] = riskReportRow{
+			ID:              p.ID,
+			Name:            p.Name,
+			Health:          p.Health,
+			HealthUpdatedAt: p.HealthUpdatedAt,
+			Stale:           isHealthStale(p.HealthUpdatedAt, now),
+			URL:             constructProjectURL(p.ID, p.URL),
+		}
+		if p.Lead != nil {
+			rows[i].Lead = p.Lead.Name
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(row *riskReportRow) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			updates, err := client.GetProjectUpdates(context.Background(), row.ID, 1, "")
+			if err != nil {
+				row.UpdateError = err.Error()
+				return
+			}
+			if len(updates.Nodes) == 0 {
+				return
+			}
+			latest := updates.Nodes[0]
+			row.LastUpdateAt = &latest.CreatedAt
+			row.LastUpdate = updateExcerpt(latest.Body, 100)
+		}(&rows[i])
+	}
+	wg.Wait()
+
+	return rows
+}
+
+// renderRiskDigest renders a paste-ready markdown digest grouped by health
+func renderRiskDigest(rows []riskReportRow, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Project Risk Report (%s)\n", output.FormatTime(now, output.DateOnly))
+
+	if len(rows) == 0 {
+		b.WriteString("\nNo at-risk or off-track projects.\n")
+		return b.String()
+	}
+
+	for _, health := range riskHealthOrder {
+		var group []riskReportRow
+		for _, row := range rows {
+			if row.Health == health {
+				group = append(group, row)
+			}
+		}
+		if len(group) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n## %s (%d)\n\n", healthStyles[health].Label, len(group))
+		for _, row := range group {
+			lead := "unassigned"
+			if row.Lead != "" {
+				lead = "@" + row.Lead
+			}
+			fmt.Fprintf(&b, "- **[%s](%s)** (%s)", row.Name, row.URL, lead)
+			if row.Stale {
+				b.WriteString(" [stale]")
+			}
+			if row.UpdateError != "" {
+				fmt.Fprintf(&b, " — status updates unavailable: %s", row.UpdateError)
+			} else if row.LastUpdateAt != nil {
+				fmt.Fprintf(&b, " — %s", output.FormatTime(*row.LastUpdateAt, output.DateOnly))
+				if row.LastUpdate != "" {
+					fmt.Fprintf(&b, ": %s", row.LastUpdate)
+				}
+			} else {
+				b.WriteString(" — no status updates")
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// printRiskTable renders the risk report as a colored table
+func printRiskTable(rows []riskReportRow, plaintext, jsonOut bool) {
+	headers := []string{"Name", "Health", "Lead", "Last Update", "Excerpt"}
+	tableRows := [][]string{}
+	for _, row := range rows {
+		health := formatHealth(row.Health)
+		if row.Stale {
+			health += color.New(color.FgYellow).Sprint(" (stale)")
+		}
+		lead := color.New(color.FgYellow).Sprint("Unassigned")
+		if row.Lead != "" {
+			lead = row.Lead
+		}
+		lastUpdate := color.New(color.FgWhite, color.Faint).Sprint("never")
+		excerpt := truncateString(row.LastUpdate, 50)
+		if row.UpdateError != "" {
+			lastUpdate = color.New(color.FgRed).Sprint("unavailable")
+			excerpt = truncateString(row.UpdateError, 50)
+		} else if row.LastUpdateAt != nil {
+			lastUpdate = output.FormatTime(*row.LastUpdateAt, output.TableTimeFormat(plaintext, jsonOut))
+		}
+		tableRows = append(tableRows, []string{
+			truncateString(row.Name, 25),
+			health,
+			lead,
+			lastUpdate,
+			excerpt,
+		})
+	}
+
+	output.Table(output.TableData{
+		Headers: headers,
+		Rows:    tableRows,
+	}, plaintext, jsonOut)
+
+	fmt.Printf("\n%s %d at-risk projects\n",
+		color.New(color.FgYellow).Sprint("⚠"),
+		len(rows))
+}
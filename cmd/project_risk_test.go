@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+)
+
+func TestIsHealthStale(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	recent := now.Add(-13 * 24 * time.Hour)
+	old := now.Add(-15 * 24 * time.Hour)
+
+	if isHealthStale(&recent, now) {
+		t.Error("13-day-old health should not be stale")
+	}
+	if !isHealthStale(&old, now) {
+		t.Error("15-day-old health should be stale")
+	}
+	if !isHealthStale(nil, now) {
+		t.Error("never-updated health should be stale")
+	}
+}
+
+func TestUpdateExcerpt(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{"", ""},
+		{"\n\n## Blocked on vendor\nMore detail", "Blocked on vendor"},
+		{"- first bullet\n- second", "first bullet"},
+		{"> quoted", "quoted"},
+		{strings.Repeat("a", 30), strings.Repeat("a", 17) + "..."},
+	}
+	for _, tt := range tests {
+		if got := updateExcerpt(tt.body, 20); got != tt.want {
+			t.Errorf("updateExcerpt(%q) = %q, want %q", tt.body, got, tt.want)
+		}
+	}
+}
+
+func TestRenderRiskDigest(t *testing.T) {
+	output.SetUTC(true)
+	defer output.SetUTC(false)
+
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+	updated := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	rows := []riskReportRow{
+		{Name: "Billing", Health: "atRisk", Lead: "Ada", URL: "https://linear.app/x/project/billing", LastUpdateAt: &updated, LastUpdate: "Vendor slipped"},
+		{Name: "Search", Health: "offTrack", Stale: true, URL: "https://linear.app/x/project/search"},
+		{Name: "Ledger", Health: "atRisk", URL: "https://linear.app/x/project/ledger", UpdateError: "request failed"},
+	}
+
+	want := `# Project Risk Report (2025-06-15)
+
+## Off Track (1)
+
+- **[Search](https://linear.app/x/project/search)** (unassigned) [stale] — no status updates
+
+## At Risk (2)
+
+- **[Billing](https://linear.app/x/project/billing)** (@Ada) — 2025-06-10: Vendor slipped
+- **[Ledger](https://linear.app/x/project/ledger)** (unassigned) — status updates unavailable: request failed
+`
+	if got := renderRiskDigest(rows, now); got != want {
+		t.Errorf("digest mismatch\n got:\n%s\nwant:\n%s", got, want)
+	}
+
+	if got := renderRiskDigest(nil, now); !strings.Contains(got, "No at-risk or off-track projects.") {
+		t.Errorf("empty digest = %q", got)
+	}
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// scopeTrendWeeks is how many recent weeks the completion rate, and so the
+// projected finish, is taken from
+const scopeTrendWeeks = 4
+
+// scopeWeek is one row of a project's scope history
+type scopeWeek struct {
+	WeekOf    string  `json:"weekOf"`
+	Scope     float64 `json:"scope"`
+	Completed float64 `json:"completed"`
+}
+
+// scopeTrend is what --scope-history works out from the weekly history.
+// Fields that can't be computed yet are left out.
+type scopeTrend struct {
+	ScopeChangePercent *float64 `json:"scopeChangePercent,omitempty"`
+	WeeklyRate         *float64 `json:"completionRatePerWeek,omitempty"`
+	ProjectedFinish    string   `json:"projectedFinish,omitempty"`
+	TargetDate         string   `json:"targetDate,omitempty"`
+	Summary            string   `json:"summary"`
+}
+
+// projectScopeReport is the raw history with the weekly table and trend
+type projectScopeReport struct {
+	ScopeHistory          []float64   `json:"scopeHistory"`
+	CompletedScopeHistory []float64   `json:"completedScopeHistory"`
+	Weeks                 []scopeWeek `json:"weeks"`
+	Trend                 scopeTrend  `json:"trend"`
+}
+
+// weekStart returns the Monday starting t's week, in UTC
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// analyzeScopeHistory lays the history out week by week from the week the
+// project was created and works out the trend: scope change since the first
+// week with any scope, the completion rate over the last scopeTrendWeeks
+// weeks, and the finish date that rate projects for the remaining scope.
+func analyzeScopeHistory(h *api.ProjectScopeHistory) *projectScopeReport {
+	report := &projectScopeReport{
+		ScopeHistory:          h.ScopeHistory,
+		CompletedScopeHistory: h.CompletedScopeHistory,
+		Weeks:                 []scopeWeek{},
+	}
+	if h.TargetDate != nil {
+		report.Trend.TargetDate = *h.TargetDate
+	}
+	first := weekStart(h.CreatedAt)
+	for i, scope := range h.ScopeHistory {
+		week := scopeWeek{WeekOf: first.AddDate(0, 0, 7*i).Format("2006-01-02"), Scope: scope}
+		if i < len(h.CompletedScopeHistory) {
+			week.Completed = h.CompletedScopeHistory[i]
+		}
+		report.Weeks = append(report.Weeks, week)
+	}
+
+	n := len(report.Weeks)
+	if n < 2 {
+		report.Trend.Summary = fmt.Sprintf("not enough history for a trend yet (%d weeks recorded)", n)
+		return report
+	}
+	last := report.Weeks[n-1]
+
+	var parts []string
+	for _, w := range report.Weeks {
+		if w.Scope <= 0 {
+			continue
+		}
+		change := (last.Scope - w.Scope) / w.Scope * 100
+		report.Trend.ScopeChangePercent = &change
+		switch rounded := math.Round(change); {
+		case rounded > 0:
+			parts = append(parts, fmt.Sprintf("scope grew %.0f%% since start", rounded))
+		case rounded < 0:
+			parts = append(parts, fmt.Sprintf("scope shrank %.0f%% since start", -rounded))
+		default:
+			parts = append(parts, "scope unchanged since start")
+		}
+		break
+	}
+
+	intervals := min(scopeTrendWeeks, n-1)
+	rate := (last.Completed - report.Weeks[n-1-intervals].Completed) / float64(intervals)
+	report.Trend.WeeklyRate = &rate
+	parts = append(parts, fmt.Sprintf("completion rate ~%s pts/week", formatPoints(math.Round(rate*10)/10)))
+
+	remaining := last.Scope - last.Completed
+	lastWeek, _ := time.Parse("2006-01-02", last.WeekOf)
+	projection := ""
+	switch {
+	case remaining <= 0:
+		projection = "all scope completed"
+	case rate <= 0:
+		projection = fmt.Sprintf("no projected finish: nothing completed in the last %d weeks", intervals)
+	default:
+		finish := lastWeek.AddDate(0, 0, int(math.Ceil(remaining/rate*7)))
+		report.Trend.ProjectedFinish = finish.Format("2006-01-02")
+		projection = "projected finish " + report.Trend.ProjectedFinish
+	}
+	if report.Trend.TargetDate != "" && report.Trend.ProjectedFinish != "" {
+		projection += " vs target " + report.Trend.TargetDate
+	}
+	parts = append(parts, projection)
+	report.Trend.Summary = strings.Join(parts, "; ")
+	return report
+}
+
+// scopePercent is the completed share of a week's scope
+func scopePercent(w scopeWeek) string {
+	if w.Scope <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", w.Completed/w.Scope*100)
+}
+
+// printScopeHistoryMarkdown writes the history as a markdown table and the
+// trend below it
+func printScopeHistoryMarkdown(report *projectScopeReport) {
+	fmt.Println("\n## Scope History")
+	if len(report.Weeks) > 0 {
+		fmt.Println("\n| Week of | Scope | Completed | Done |")
+		fmt.Println("|---|---|---|---|")
+		for _, w := range report.Weeks {
+			fmt.Printf("| %s | %s | %s | %s |\n", w.WeekOf, formatPoints(w.Scope), formatPoints(w.Completed), scopePercent(w))
+		}
+	}
+	fmt.Printf("\n%s\n", report.Trend.Summary)
+}
+
+// printScopeHistoryRich prints the weekly table with a bar per week and the
+// trend below it
+func printScopeHistoryRich(report *projectScopeReport) {
+	fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Scope History:"))
+	if len(report.Weeks) > 0 {
+		rows := make([][]string, len(report.Weeks))
+		for i, w := range report.Weeks {
+			rows[i] = []string{
+				w.WeekOf,
+				formatPoints(w.Scope),
+				formatPoints(w.Completed),
+				color.New(color.FgGreen).Sprint(progressBar(w.Completed, w.Scope, rollupBarWidth)) + " " + scopePercent(w),
+			}
+		}
+		output.Table(output.TableData{Headers: []string{"Week of", "Scope", "Completed", "Done"}, Rows: rows}, false, false)
+	}
+	fmt.Printf("%s %s\n", color.New(color.FgCyan).Sprint("→"), report.Trend.Summary)
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestAnalyzeScopeHistory(t *testing.T) {
+	target := "2024-08-30"
+	// Created on a Wednesday, so the weeks start on the Monday before
+	created := time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		scope     []float64
+		completed []float64
+		target    *string
+		want      string
+		finish    string
+	}{
+		{
+			name:      "growing scope with a projection",
+			scope:     []float64{0, 50, 55, 58, 60, 62},
+			completed: []float64{0, 0, 6, 14, 22, 30},
+			target:    &target,
+			want:      "scope grew 24% since start; completion rate ~7.5 pts/week; projected finish 2024-07-03 vs target 2024-08-30",
+			finish:    "2024-07-03",
+		},
+		{
+			name:      "stalled",
+			scope:     []float64{20, 18},
+			completed: []float64{5, 5},
+			want:      "scope shrank 10% since start; completion rate ~0 pts/week; no projected finish: nothing completed in the last 1 weeks",
+		},
+		{
+			name:      "done",
+			scope:     []float64{10, 10, 10},
+			completed: []float64{2, 6, 10},
+			want:      "scope unchanged since start; completion rate ~4 pts/week; all scope completed",
+		},
+		{
+			name:  "one week",
+			scope: []float64{10},
+			want:  "not enough history for a trend yet (1 weeks recorded)",
+		},
+		{
+			name: "no history",
+			want: "not enough history for a trend yet (0 weeks recorded)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := analyzeScopeHistory(&api.ProjectScopeHistory{CreatedAt: created, TargetDate: tt.target, ScopeHistory: tt.scope, CompletedScopeHistory: tt.completed})
+			if report.Trend.Summary != tt.want {
+				t.Errorf("summary = %q\nwant      %q", report.Trend.Summary, tt.want)
+			}
+			if report.Trend.ProjectedFinish != tt.finish {
+				t.Errorf("projected finish = %q, want %q", report.Trend.ProjectedFinish, tt.finish)
+			}
+			if len(report.Weeks) != len(tt.scope) {
+				t.Fatalf("%d weeks, want %d", len(report.Weeks), len(tt.scope))
+			}
+			if len(report.Weeks) > 1 && (report.Weeks[0].WeekOf != "2024-04-29" || report.Weeks[1].WeekOf != "2024-05-06") {
+				t.Errorf("weeks start %s, %s", report.Weeks[0].WeekOf, report.Weeks[1].WeekOf)
+			}
+		})
+	}
+}
+
+func TestHermeticProjectGetScopeHistory(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Project", `{"project":`+mockFixture(t, "project_get")+`}`)
+	s.Data("ProjectScopeHistory", `{"project":{"id":"p1","createdAt":"2024-05-01T15:00:00Z","targetDate":"2024-08-30",
+		"scopeHistory":[0,50,55,58,60,62],"completedScopeHistory":[0,0,6,14,22,30]}}`)
+
+	r := runMocked(t, "project", "get", "p1", "--scope-history", "--json")
+	var got struct {
+		Name         string
+		ScopeHistory projectScopeReport
+	}
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &got) != nil {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got.Name == "" || len(got.ScopeHistory.ScopeHistory) != 6 || len(got.ScopeHistory.Weeks) != 6 ||
+		got.ScopeHistory.Trend.WeeklyRate == nil || *got.ScopeHistory.Trend.WeeklyRate != 7.5 {
+		t.Errorf("JSON = %s", r.Stdout)
+	}
+
+	r = runMocked(t, "project", "get", "p1", "--scope-history", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "## Scope History\n\n| Week of | Scope | Completed | Done |\n|---|---|---|---|\n| 2024-04-29 | 0 | 0 | - |\n") ||
+		!strings.Contains(r.Stdout, "| 2024-06-03 | 62 | 30 | 48% |\n\nscope grew 24% since start;") {
+		t.Errorf("plaintext exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	// Without the flag the history isn't fetched
+	s.Reset()
+	s.Data("Project", `{"project":`+mockFixture(t, "project_get")+`}`)
+	if r := runMocked(t, "project", "get", "p1", "--json"); r.Exit != 0 || strings.Contains(r.Stdout, "scopeHistory") {
+		t.Errorf("plain get exited %d: %s", r.Exit, r.Stdout)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "Project" {
+		t.Errorf("operations = %s", got)
+	}
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// projectSortKey is a --sort value of project list that is applied after
+// fetching, because the API only orders by creation or update time
+type projectSortKey struct {
+	Name    string
+	Desc    bool
+	Meaning string
+	// compare orders two projects ascending; missing reports projects
+	// without a value, which sort last
+	compare func(a, b *api.Project) int
+	missing func(p *api.Project) bool
+}
+
+// projectSortKeys lists the client-side sort keys, in the order help shows
+// them
+var projectSortKeys = []projectSortKey{
+	{
+		Name: "priority", Desc: true, Meaning: "Urgent first; no priority counts as lowest",
+		compare: func(a, b *api.Project) int { return comparePriority(a.Priority, b.Priority) },
+	},
+	{
+		Name: "target-date", Meaning: "soonest target date first; no target date last",
+		compare: func(a, b *api.Project) int { return compareDates(a.TargetDate, b.TargetDate) },
+		missing: func(p *api.Project) bool { return dateMissing(p.TargetDate) },
+	},
+	{
+		Name: "progress", Desc: true, Meaning: "furthest along first",
+		compare: func(a, b *api.Project) int { return cmp.Compare(a.Progress, b.Progress) },
+	},
+}
+
+// projectSort is a parsed project list --sort
+type projectSort struct {
+	OrderBy string          // API orderBy to fetch with; empty for Linear's default
+	key     *projectSortKey // nil when the fetched order stands
+}
+
+// parseProjectSort resolves a project list --sort. created and updated are
+// fetched in the API's order; the other keys sort the fetched projects.
+func parseProjectSort(sortBy string) (projectSort, error) {
+	for i := range projectSortKeys {
+		if sortBy == projectSortKeys[i].Name {
+			return projectSort{key: &projectSortKeys[i]}, nil
+		}
+	}
+	orderBy, err := sortOrderBy(sortBy)
+	if err != nil {
+		return projectSort{}, fmt.Errorf("Invalid sort option: %s. Valid options are: %s", sortBy, strings.Join(projectSortNames(), ", "))
+	}
+	return projectSort{OrderBy: orderBy}, nil
+}
+
+// projectSortNames lists every --sort value project list accepts
+func projectSortNames() []string {
+	var names []string
+	for _, opt := range sortOptions {
+		names = append(names, opt.Name)
+	}
+	for _, key := range projectSortKeys {
+		names = append(names, key.Name)
+	}
+	return names
+}
+
+// apply sorts projects in place. Projects without a value for the key go
+// last, and ties break by name, ignoring case, then by ID, so repeated runs
+// list them the same way.
+func (s projectSort) apply(projects []api.Project) {
+	if s.key == nil {
+		return
+	}
+	key := s.key
+	var missing func(i int) bool
+	if key.missing != nil {
+		missing = func(i int) bool { return key.missing(&projects[i]) }
+	}
+	sortStableBy(projects, key.Desc,
+		func(i, j int) int { return key.compare(&projects[i], &projects[j]) },
+		missing,
+		func(i, j int) int {
+			if c := compareFold(projects[i].Name, projects[j].Name); c != 0 {
+				return c
+			}
+			return strings.Compare(projects[i].ID, projects[j].ID)
+		})
+}
+
+// warnSortedWindow notes on stderr that a client-side sort only ordered the
+// fetched page when more projects matched
+func (s projectSort) warnSortedWindow(pageInfo api.PageInfo, shown int) {
+	if s.key == nil || !pageInfo.HasNextPage {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Note: sorted the %d fetched projects by %s; more match, pass --all to sort every one\n", shown, s.key.Name)
+}
+
+// fetchAllProjectPages follows a paginated project connection to its end
+func fetchAllProjectPages(fetch func(after string) (*api.Projects, error)) (*api.Projects, error) {
+	all := &api.Projects{}
+	after := ""
+	for {
+		page, err := fetch(after)
+		if err != nil {
+			return nil, err
+		}
+		all.Nodes = append(all.Nodes, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestProjectSort(t *testing.T) {
+	target := func(s string) *string { return &s }
+	projects := []api.Project{
+		{ID: "p5", Name: "beta", Priority: 0, Progress: 0.5},
+		{ID: "p4", Name: "Alpha", Priority: 2, Progress: 0.1, TargetDate: target("2026-06-01")},
+		{ID: "p3", Name: "gamma", Priority: 1, Progress: 0.5, TargetDate: target("2026-03-01")},
+		{ID: "p2", Name: "alpha", Priority: 2, Progress: 0.9, TargetDate: target("")},
+		{ID: "p1", Name: "delta", Priority: 4, Progress: 0.1, TargetDate: target("2026-03-01")},
+	}
+
+	tests := []struct {
+		sort string
+		want string
+	}{
+		// No priority is the lowest; ties go by name, ignoring case, then ID
+		{sort: "priority", want: "p3,p2,p4,p1,p5"},
+		// Undated projects go last, in name order too
+		{sort: "target-date", want: "p1,p3,p4,p2,p5"},
+		{sort: "progress", want: "p2,p5,p3,p4,p1"},
+		// The API already orders these; nothing is reordered client-side
+		{sort: "created", want: "p5,p4,p3,p2,p1"},
+		{sort: "linear", want: "p5,p4,p3,p2,p1"},
+	}
+	for _, tt := range tests {
+		s, err := parseProjectSort(tt.sort)
+		if err != nil {
+			t.Errorf("parseProjectSort(%s): %v", tt.sort, err)
+			continue
+		}
+		// Any fetched order sorts the same way
+		for _, reversed := range []bool{false, true} {
+			sorted := append([]api.Project(nil), projects...)
+			if reversed && s.key != nil {
+				for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+					sorted[i], sorted[j] = sorted[j], sorted[i]
+				}
+			}
+			s.apply(sorted)
+			var ids []string
+			for _, p := range sorted {
+				ids = append(ids, p.ID)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("--sort %s (fetched reversed %v) = %s, want %s", tt.sort, reversed, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestParseProjectSort(t *testing.T) {
+	if s, err := parseProjectSort("updated"); err != nil || s.OrderBy != "updatedAt" || s.key != nil {
+		t.Errorf("updated = %+v, %v; want the API's orderBy only", s, err)
+	}
+	if s, _ := parseProjectSort("target-date"); s.OrderBy != "" || s.key == nil {
+		t.Errorf("target-date = %+v, want a client-side sort", s)
+	}
+	_, err := parseProjectSort("due")
+	if err == nil || !strings.Contains(err.Error(), "Valid options are: linear, created, updated, priority, target-date, progress") {
+		t.Errorf("invalid sort error = %v", err)
+	}
+}
+
+func TestHermeticProjectListSort(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Projects", `{"projects":{"nodes":[
+		{"id":"p1","name":"Later","targetDate":"2026-09-01"},
+		{"id":"p2","name":"Undated"}
+	],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	s.DataFor("Projects", map[string]interface{}{"after": "c1"}, `{"projects":{"nodes":[
+		{"id":"p3","name":"Sooner","targetDate":"2026-04-01"}
+	],"pageInfo":{"hasNextPage":false}}}`)
+
+	r := runMocked(t, "project", "list", "--all-time", "--sort", "target-date", "--json")
+	var projects []api.Project
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &projects) != nil || len(projects) != 2 {
+		t.Fatalf("project list --sort target-date exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if projects[0].ID != "p1" || !strings.Contains(r.Stderr, "pass --all to sort every one") {
+		t.Errorf("first page sorted to %s, stderr %q; want p1 and a note that only the page was sorted", projects[0].ID, r.Stderr)
+	}
+
+	// --all sorts every page, and --ids-only keeps the sorted order
+	r = runMocked(t, "project", "list", "--all-time", "--sort", "target-date", "--all", "--ids-only")
+	if r.Exit != 0 || r.Stdout != "p3\np1\np2\n" || strings.Contains(r.Stderr, "--all") {
+		t.Errorf("--all --ids-only exited %d: %q %s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, req := range s.Requests() {
+		if req.Operation == "ProjectIDs" {
+			t.Error("--ids-only with a client-side sort fetched IDs only")
+		}
+	}
+
+	r = runMocked(t, "project", "list", "--sort", "due")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "linear, created, updated, priority, target-date, progress") {
+		t.Errorf("--sort due exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,253 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// noInitiative is the group of projects not linked to any initiative
+const noInitiative = "(no initiative)"
+
+// healthRank orders health worst first; no health at all sorts last
+var healthRank = map[string]int{"offTrack": 0, "atRisk": 1, "onTrack": 2}
+
+// worseHealth reports whether health a is worse than b
+func worseHealth(a, b string) bool {
+	rankA, okA := healthRank[a]
+	rankB, okB := healthRank[b]
+	if !okA {
+		return false
+	}
+	return !okB || rankA < rankB
+}
+
+// statusDigestProject is one project's status updates in a digest, newest
+// first. Health is that of its latest update.
+type statusDigestProject struct {
+	ID      string              `json:"id"`
+	Name    string              `json:"name"`
+	URL     string              `json:"url"`
+	Health  string              `json:"health"`
+	Updates []api.ProjectUpdate `json:"updates"`
+}
+
+// statusDigestInitiative groups the projects of one initiative. Its health
+// is the worst of its projects'.
+type statusDigestInitiative struct {
+	ID       string                `json:"id,omitempty"`
+	Name     string                `json:"name"`
+	URL      string                `json:"url,omitempty"`
+	Health   string                `json:"health"`
+	Projects []statusDigestProject `json:"projects"`
+}
+
+// fetchRecentProjectUpdates pages through every status update created since
+func fetchRecentProjectUpdates(client *api.Client, since time.Time) ([]api.ProjectUpdate, error) {
+	var updates []api.ProjectUpdate
+	after := ""
+	for {
+		page, err := client.GetRecentProjectUpdates(context.Background(), since, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return updates, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// groupUpdatesByProject collects updates under their projects, worst
+// health first, then by name
+func groupUpdatesByProject(updates []api.ProjectUpdate) []statusDigestProject {
+	byID := map[string]*statusDigestProject{}
+	var order []string
+	for _, u := range updates {
+		if u.Project == nil {
+			continue
+		}
+		p, ok := byID[u.Project.ID]
+		if !ok {
+			p = &statusDigestProject{ID: u.Project.ID, Name: u.Project.Name, URL: constructProjectURL(u.Project.ID, u.Project.URL)}
+			byID[u.Project.ID] = p
+			order = append(order, u.Project.ID)
+		}
+		p.Updates = append(p.Updates, u)
+	}
+
+	projects := make([]statusDigestProject, 0, len(order))
+	for _, id := range order {
+		p := byID[id]
+		sort.SliceStable(p.Updates, func(i, j int) bool { return p.Updates[i].CreatedAt.After(p.Updates[j].CreatedAt) })
+		p.Health = p.Updates[0].Health
+		projects = append(projects, *p)
+	}
+	sortDigestProjects(projects)
+	return projects
+}
+
+func sortDigestProjects(projects []statusDigestProject) {
+	sort.SliceStable(projects, func(i, j int) bool {
+		if projects[i].Health != projects[j].Health {
+			return worseHealth(projects[i].Health, projects[j].Health)
+		}
+		return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
+	})
+}
+
+// groupUpdatesByInitiative nests the project groups under the initiatives
+// their projects belong to; a project in several initiatives appears in
+// each. Initiatives are ordered worst health first, then by name, with
+// the projects in no initiative last.
+func groupUpdatesByInitiative(updates []api.ProjectUpdate) []statusDigestInitiative {
+	initiativesOf := map[string][]api.Initiative{}
+	for _, u := range updates {
+		if u.Project != nil && u.Project.Initiatives != nil {
+			initiativesOf[u.Project.ID] = u.Project.Initiatives.Nodes
+		}
+	}
+
+	byID := map[string]*statusDigestInitiative{}
+	var order []string
+	add := func(key string, group statusDigestInitiative, project statusDigestProject) {
+		g, ok := byID[key]
+		if !ok {
+			g = &group
+			byID[key] = g
+			order = append(order, key)
+		}
+		g.Projects = append(g.Projects, project)
+		if len(g.Projects) == 1 || worseHealth(project.Health, g.Health) {
+			g.Health = project.Health
+		}
+	}
+	for _, project := range groupUpdatesByProject(updates) {
+		initiatives := initiativesOf[project.ID]
+		if len(initiatives) == 0 {
+			add("", statusDigestInitiative{Name: noInitiative}, project)
+			continue
+		}
+		for _, initiative := range initiatives {
+			add(initiative.ID, statusDigestInitiative{ID: initiative.ID, Name: initiative.Name, URL: initiative.URL}, project)
+		}
+	}
+
+	groups := make([]statusDigestInitiative, 0, len(order))
+	for _, key := range order {
+		groups = append(groups, *byID[key])
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].ID == "") != (groups[j].ID == "") {
+			return groups[j].ID == ""
+		}
+		if groups[i].Health != groups[j].Health {
+			return worseHealth(groups[i].Health, groups[j].Health)
+		}
+		return strings.ToLower(groups[i].Name) < strings.ToLower(groups[j].Name)
+	})
+	return groups
+}
+
+// healthLabel is the markdown name of a health value
+func healthLabel(health string) string {
+	if style, ok := healthStyles[health]; ok {
+		return style.Label
+	}
+	return "No health"
+}
+
+// markdownLink links text to url, or leaves it plain without a url
+func markdownLink(text, url string) string {
+	if url == "" {
+		return escapeMarkdown(text)
+	}
+	return fmt.Sprintf("[%s](%s)", escapeMarkdown(text), url)
+}
+
+// renderStatusDigest renders the updates as paste-ready markdown: a section
+// per project, or per initiative with nested project sections when
+// initiatives is set
+func renderStatusDigest(projects []statusDigestProject, initiatives []statusDigestInitiative, since time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Project Status Updates since %s\n", output.FormatTime(since, output.DateOnly))
+
+	writeProject := func(p statusDigestProject, level string) {
+		fmt.Fprintf(&b, "\n%s %s — %s\n", level, markdownLink(p.Name, p.URL), healthLabel(p.Health))
+		for _, u := range p.Updates {
+			fmt.Fprintf(&b, "\n**%s, %s** (%s)\n\n%s\n", output.FormatTime(u.CreatedAt, output.DateOnly), safeUserName(u.User), healthLabel(u.Health), strings.TrimSpace(u.Body))
+		}
+	}
+
+	if initiatives == nil {
+		if len(projects) == 0 {
+			b.WriteString("\nNo status updates.\n")
+		}
+		for _, p := range projects {
+			writeProject(p, "##")
+		}
+		return b.String()
+	}
+
+	if len(initiatives) == 0 {
+		b.WriteString("\nNo status updates.\n")
+	}
+	for _, g := range initiatives {
+		name := escapeMarkdown(g.Name)
+		if g.ID != "" {
+			name = markdownLink(g.Name, g.URL)
+		}
+		fmt.Fprintf(&b, "\n## %s — %s\n", name, healthLabel(g.Health))
+		for _, p := range g.Projects {
+			writeProject(p, "###")
+		}
+	}
+	return b.String()
+}
+
+// printStatusDigestRich prints each project's updates as one line each,
+// under initiative headings when initiatives is set
+func printStatusDigestRich(projects []statusDigestProject, initiatives []statusDigestInitiative, since time.Time) {
+	printProject := func(p statusDigestProject, indent string) {
+		fmt.Printf("%s%s  %s\n", indent, color.New(color.FgCyan, color.Bold).Sprint(p.Name), formatHealth(p.Health))
+		for _, u := range p.Updates {
+			fmt.Printf("%s  %s  %-16s %s\n", indent,
+				color.New(color.FgWhite, color.Faint).Sprint(output.FormatTime(u.CreatedAt, output.DateOnly)),
+				truncateString(safeUserName(u.User), 16),
+				updateExcerpt(u.Body, 80))
+		}
+	}
+
+	count := 0
+	if initiatives == nil {
+		for _, p := range projects {
+			fmt.Println()
+			printProject(p, "")
+			count += len(p.Updates)
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, g := range initiatives {
+			fmt.Printf("\n%s  %s\n", color.New(color.Bold).Sprint(g.Name), formatHealth(g.Health))
+			for _, p := range g.Projects {
+				printProject(p, "  ")
+				if !seen[p.ID] {
+					seen[p.ID] = true
+					count += len(p.Updates)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("\n%s %d status updates since %s\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		count,
+		output.FormatTime(since, output.DateOnly))
+}
@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+)
+
+// digestUpdatesJSON is a RecentProjectUpdates page: Billing and Search in
+// the Payments initiative, Search also in Platform, and Docs in none
+const digestUpdatesJSON = `{"projectUpdates":{"nodes":[
+	{"id":"u4","body":"All green","health":"onTrack","createdAt":"2025-06-14T10:00:00Z","user":{"id":"a","name":"Ada"},
+		"project":{"id":"p-docs","name":"Docs","url":"https://linear.app/x/project/docs","initiatives":{"nodes":[]}}},
+	{"id":"u3","body":"Vendor slipped\nMore detail","health":"atRisk","createdAt":"2025-06-13T10:00:00Z","user":{"id":"b","name":"Bo"},
+		"project":{"id":"p-billing","name":"Billing","url":"https://linear.app/x/project/billing",
+			"initiatives":{"nodes":[{"id":"i-pay","name":"Payments","url":"https://linear.app/x/initiative/pay"}]}}},
+	{"id":"u2","body":"Index rebuild failed","health":"offTrack","createdAt":"2025-06-12T10:00:00Z","user":{"id":"a","name":"Ada"},
+		"project":{"id":"p-search","name":"Search","url":"https://linear.app/x/project/search",
+			"initiatives":{"nodes":[{"id":"i-pay","name":"Payments","url":"https://linear.app/x/initiative/pay"},{"id":"i-plat","name":"Platform","url":"https://linear.app/x/initiative/plat"}]}}},
+	{"id":"u1","body":"Kicked off","health":"onTrack","createdAt":"2025-06-10T10:00:00Z","user":{"id":"b","name":"Bo"},
+		"project":{"id":"p-billing","name":"Billing","url":"https://linear.app/x/project/billing",
+			"initiatives":{"nodes":[{"id":"i-pay","name":"Payments","url":"https://linear.app/x/initiative/pay"}]}}}
+],"pageInfo":{"hasNextPage":false}}}`
+
+func digestUpdates(t *testing.T) []api.ProjectUpdate {
+	t.Helper()
+	var page struct {
+		ProjectUpdates api.ProjectUpdates `json:"projectUpdates"`
+	}
+	if err := json.Unmarshal([]byte(digestUpdatesJSON), &page); err != nil {
+		t.Fatal(err)
+	}
+	return page.ProjectUpdates.Nodes
+}
+
+func TestGroupUpdatesByInitiative(t *testing.T) {
+	groups := groupUpdatesByInitiative(digestUpdates(t))
+	var got []string
+	for _, g := range groups {
+		var projects []string
+		for _, p := range g.Projects {
+			projects = append(projects, p.Name+"="+p.Health)
+		}
+		got = append(got, g.Name+"("+g.Health+"): "+strings.Join(projects, ","))
+	}
+	want := []string{
+		"Payments(offTrack): Search=offTrack,Billing=atRisk",
+		"Platform(offTrack): Search=offTrack",
+		"(no initiative)(onTrack): Docs=onTrack",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("groups:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if billing := groups[0].Projects[1]; len(billing.Updates) != 2 || billing.Updates[0].ID != "u3" {
+		t.Errorf("Billing's updates should be newest first: %+v", billing.Updates)
+	}
+}
+
+func TestRenderStatusDigestByInitiative(t *testing.T) {
+	output.SetUTC(true)
+	defer output.SetUTC(false)
+
+	updates := digestUpdates(t)
+	since := time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)
+	got := renderStatusDigest(groupUpdatesByProject(updates), groupUpdatesByInitiative(updates), since)
+	want := `# Project Status Updates since 2025-06-08
+
+## [Payments](https://linear.app/x/initiative/pay) — Off Track
+
+### [Search](https://linear.app/x/project/p-search) — Off Track
+
+**2025-06-12, Ada** (Off Track)
+
+Index rebuild failed
+
+### [Billing](https://linear.app/x/project/p-billing) — At Risk
+
+**2025-06-13, Bo** (At Risk)
+
+Vendor slipped
+More detail
+
+**2025-06-10, Bo** (On Track)
+
+Kicked off
+
+## [Platform](https://linear.app/x/initiative/plat) — Off Track
+
+### [Search](https://linear.app/x/project/p-search) — Off Track
+
+**2025-06-12, Ada** (Off Track)
+
+Index rebuild failed
+
+## (no initiative) — On Track
+
+### [Docs](https://linear.app/x/project/p-docs) — On Track
+
+**2025-06-14, Ada** (On Track)
+
+All green
+`
+	if got != want {
+		t.Errorf("digest:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestHermeticStatusListAllProjects(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("RecentProjectUpdates", digestUpdatesJSON)
+
+	r := runMocked(t, "project", "status", "list", "--all-projects", "--group-by", "initiative", "--since", "2025-06-08", "--json")
+	if r.Exit != 0 {
+		t.Fatalf("exited %d: %s", r.Exit, r.Stderr)
+	}
+	var got struct {
+		Initiatives []struct {
+			Name     string
+			Health   string
+			Projects []struct {
+				Name    string
+				Updates []struct{ ID string }
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &got); err != nil {
+		t.Fatalf("bad JSON: %v\n%s", err, r.Stdout)
+	}
+	if len(got.Initiatives) != 3 || got.Initiatives[0].Name != "Payments" || got.Initiatives[0].Health != "offTrack" ||
+		len(got.Initiatives[0].Projects) != 2 || len(got.Initiatives[0].Projects[1].Updates) != 2 {
+		t.Errorf("JSON = %s", r.Stdout)
+	}
+	if vars := s.Requests()[0].Variables; !strings.Contains(mustJSON(t, vars["filter"]), `"gte":"2025-06-08T00:00:00Z"`) {
+		t.Errorf("filter = %v", vars["filter"])
+	}
+
+	r = runMocked(t, "project", "status", "list", "--all-projects", "--plaintext", "--since", "2025-06-08")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "## [Search](https://linear.app/x/project/p-search) — Off Track\n") {
+		t.Errorf("project digest exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	for _, args := range [][]string{
+		{"project", "status", "list"},
+		{"project", "status", "list", "PROJ", "--all-projects"},
+		{"project", "status", "list", "PROJ", "--group-by", "initiative"},
+		{"project", "status", "list", "--all-projects", "--group-by", "team"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%v exited %d", args, r.Exit)
+		}
+	}
+}
+
+func mustJSON(t *testing.T, v interface{}) string {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -36,20 +39,88 @@ var statusListCmd = &cobra.Command{
 	Use:     "list [project-id]",
 	Aliases: []string{"ls"},
 	Short:   "List status updates for a project",
-	Long:    `List all status updates for a project, ordered by most recent.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `List all status updates for a project, ordered by most recent.
+
+--all-projects lists the updates posted to every project since --since
+(default 1_week_ago) instead, grouped by project, worst health first. With
+--group-by initiative the projects are nested under their initiatives, each
+with the worst health of its projects; a project in several initiatives is
+listed under each, and projects in none are grouped under "(no initiative)".
+--plaintext renders the digest as markdown ready to paste into a review doc;
+--json nests the updates under initiative, then project.
+
+Examples:
+  linear-cli project status list PROJECT-ID
+  linear-cli project status list --all-projects --since 2_weeks_ago
+  linear-cli project status list --all-projects --group-by initiative --plaintext > review.md`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		allProjects, _ := cmd.Flags().GetBool("all-projects")
+		groupBy, _ := cmd.Flags().GetString("group-by")
+		switch {
+		case allProjects && len(args) > 0:
+			output.Error("Pass either a project ID or --all-projects, not both", plaintext, jsonOut)
+			exit(1)
+		case !allProjects && len(args) == 0:
+			output.Error("A project ID is required, or --all-projects for every project", plaintext, jsonOut)
+			exit(1)
+		case !allProjects && (cmd.Flags().Changed("group-by") || cmd.Flags().Changed("since")):
+			output.Error("--group-by and --since only apply with --all-projects", plaintext, jsonOut)
+			exit(1)
+		case groupBy != "project" && groupBy != "initiative":
+			output.Error(fmt.Sprintf("Invalid --group-by '%s': use project or initiative", groupBy), plaintext, jsonOut)
+			exit(1)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		if allProjects {
+			sinceExpr, _ := cmd.Flags().GetString("since")
+			parsed, err := utils.ParseTimeExpression(sinceExpr)
+			if err == nil && parsed == "" {
+				err = fmt.Errorf("the digest needs a start date")
+			}
+			if err != nil {
+				output.Error(fmt.Sprintf("Invalid --since: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			since, _ := time.Parse(time.RFC3339, parsed)
+
+			updates, err := fetchRecentProjectUpdates(client, since)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to fetch project updates: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			projects := groupUpdatesByProject(updates)
+			var initiatives []statusDigestInitiative
+			if groupBy == "initiative" {
+				initiatives = groupUpdatesByInitiative(updates)
+			}
+
+			switch {
+			case jsonOut && initiatives != nil:
+				output.JSON(map[string]interface{}{"since": since, "initiatives": initiatives})
+			case jsonOut:
+				output.JSON(map[string]interface{}{"since": since, "projects": projects})
+			case plaintext:
+				fmt.Print(renderStatusDigest(projects, initiatives, since))
+			case len(updates) == 0:
+				output.Info(fmt.Sprintf("No status updates since %s", output.FormatTime(since, output.DateOnly)), plaintext, jsonOut)
+			default:
+				printStatusDigestRich(projects, initiatives, since)
+			}
+			return
+		}
+
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
 			limit = 20
@@ -58,8 +129,9 @@ var statusListCmd = &cobra.Command{
 		updates, err := client.GetProjectUpdates(context.Background(), args[0], limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch project updates: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, updates.PageInfo, len(updates.Nodes))
 
 		if len(updates.Nodes) == 0 {
 			output.Info("No status updates found for this project", plaintext, jsonOut)
@@ -74,11 +146,11 @@ var statusListCmd = &cobra.Command{
 		if plaintext {
 			fmt.Println("# Project Status Updates")
 			for _, u := range updates.Nodes {
-				fmt.Printf("\n## %s by %s\n", u.CreatedAt.Format("2006-01-02 15:04"), safeUserName(u.User))
+				fmt.Printf("\n## %s by %s\n", output.FormatTime(u.CreatedAt, output.DateTimeShort), safeUserName(u.User))
 				fmt.Printf("- **ID**: %s\n", u.ID)
 				fmt.Printf("- **Health**: %s\n", u.Health)
 				if u.EditedAt != nil {
-					fmt.Printf("- **Edited**: %s\n", u.EditedAt.Format("2006-01-02 15:04"))
+					fmt.Printf("- **Edited**: %s\n", output.FormatTime(*u.EditedAt, output.DateTimeShort))
 				}
 				if u.URL != "" {
 					fmt.Printf("- **URL**: %s\n", u.URL)
@@ -107,7 +179,7 @@ var statusListCmd = &cobra.Command{
 			preview = truncateString(preview, 50)
 
 			rows[i] = []string{
-				u.CreatedAt.Format("2006-01-02"),
+				output.FormatTime(u.CreatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 				healthStr,
 				author,
 				preview,
@@ -131,8 +203,14 @@ var statusGetCmd = &cobra.Command{
 	Use:     "get [update-id]",
 	Aliases: []string{"show"},
 	Short:   "Get a project status update",
-	Long:    `Get full details of a project status update.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get full details of a project status update.
+
+Pass "latest" with --project for the project's most recent update.
+
+Examples:
+  linear-cli project status get UPDATE-ID
+  linear-cli project status get latest --project PROJECT-ID --json`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -140,15 +218,21 @@ var statusGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		update, err := client.GetProjectUpdate(context.Background(), args[0])
+		updateID, _, err := resolveStatusUpdateID(cmd, client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		update, err := client.GetProjectUpdate(context.Background(), updateID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -167,13 +251,13 @@ var statusGetCmd = &cobra.Command{
 			if update.Project != nil {
 				fmt.Printf("- **Project**: %s\n", update.Project.Name)
 			}
-			fmt.Printf("- **Created**: %s\n", update.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", update.UpdatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(update.CreatedAt, output.DateTime))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(update.UpdatedAt, output.DateTime))
 			if update.EditedAt != nil {
-				fmt.Printf("- **Edited**: %s\n", update.EditedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("- **Edited**: %s\n", output.FormatTime(*update.EditedAt, output.DateTime))
 			}
 			if update.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", update.ArchivedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("- **Archived**: %s\n", output.FormatTime(*update.ArchivedAt, output.DateTime))
 			}
 			if update.URL != "" {
 				fmt.Printf("- **URL**: %s\n", update.URL)
@@ -211,11 +295,11 @@ var statusGetCmd = &cobra.Command{
 		}
 		fmt.Printf("%s %s\n",
 			color.New(color.Bold).Sprint("Created:"),
-			update.CreatedAt.Format("2006-01-02 15:04:05"))
+			output.FormatTime(update.CreatedAt, output.DateTime))
 		if update.EditedAt != nil {
 			fmt.Printf("%s %s\n",
 				color.New(color.Bold).Sprint("Edited:"),
-				update.EditedAt.Format("2006-01-02 15:04:05"))
+				output.FormatTime(*update.EditedAt, output.DateTime))
 		}
 		if update.URL != "" {
 			fmt.Printf("%s %s\n",
@@ -261,15 +345,22 @@ Use --body-file - to read from stdin.
 
 Health values: onTrack, atRisk, offTrack
 
+--milestone appends a progress line per milestone ("Alpha: 7/10 issues
+complete", canceled issues not counted) and --diff-since-last appends the
+issues completed since the project's previous status update. The body may
+be omitted when either section is requested.
+
 Examples:
   linear-cli project status create PROJECT-ID --body "Sprint going well" --health onTrack
   linear-cli project status create PROJECT-ID --body-file status-update.md --health atRisk
   linear-cli project status create PROJECT-ID --body "Private update" --hide-diff
+  linear-cli project status create PROJECT-ID --body "Weekly" --milestone MS-1 --milestone MS-2
+  linear-cli project status create PROJECT-ID --health onTrack --diff-since-last
   cat report.md | linear-cli project status create PROJECT-ID --body-file - --health onTrack`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		// Resolve body from --body or --body-file
 		bodyFlag, _ := cmd.Flags().GetString("body")
@@ -277,30 +368,65 @@ Examples:
 		body, err := resolveBodyFromFlags(bodyFlag, cmd.Flags().Changed("body"), filePath, "body", "body-file")
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 		health, _ := cmd.Flags().GetString("health")
+		milestoneIDs, _ := cmd.Flags().GetStringSlice("milestone")
+		diffSinceLast, _ := cmd.Flags().GetBool("diff-since-last")
 
-		if body == "" {
+		if body == "" && len(milestoneIDs) == 0 && !diffSinceLast {
 			output.Error("Body is required (--body or --body-file)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if health != "" {
 			if !isValidHealth(health) {
 				output.Error(fmt.Sprintf("Invalid health value '%s'. Valid values: onTrack, atRisk, offTrack", health), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
+		var progress []milestoneProgress
+		var project *api.Project
+		if len(milestoneIDs) > 0 {
+			// The argument may be a slug ID; milestones name the project by ID
+			if project, err = client.GetProject(context.Background(), args[0]); err != nil {
+				output.Error(fmt.Sprintf("Failed to get project %s: %v", args[0], err), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+		for _, id := range milestoneIDs {
+			mp, err := fetchMilestoneProgress(client, project, id)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to compute milestone progress: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			progress = append(progress, *mp)
+		}
+
+		var since *time.Time
+		var completed []api.Issue
+		if diffSinceLast {
+			since, completed, err = fetchCompletedSinceLastUpdate(client, args[0])
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get issues completed since the last update: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			if since == nil {
+				fmt.Fprintln(os.Stderr, "Warning: project has no previous status update; skipping --diff-since-last")
+			}
+		}
+
+		body = appendStatusSections(body, progress, since, completed)
+
 		input := map[string]interface{}{
 			"projectId": args[0],
 			"body":      body,
@@ -316,10 +442,13 @@ Examples:
 		update, err := client.CreateProjectUpdate(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project-update", update.ID, "", &journal.Inverse{Action: "archive"})
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(update.URL, "status update")
+		} else if jsonOut {
 			output.JSON(update)
 		} else if plaintext {
 			projectName := args[0]
@@ -328,6 +457,7 @@ Examples:
 			}
 			fmt.Printf("Created status update on %s (health: %s)\n", projectName, update.Health)
 			fmt.Printf("ID: %s\n", update.ID)
+			printResultURL(update.URL, plaintext)
 		} else {
 			projectName := args[0]
 			if update.Project != nil {
@@ -338,9 +468,7 @@ Examples:
 				color.New(color.FgCyan, color.Bold).Sprint(projectName))
 			fmt.Printf("  Health: %s\n", formatHealth(update.Health))
 			fmt.Printf("  ID: %s\n", color.New(color.FgWhite, color.Faint).Sprint(update.ID))
-			if update.URL != "" {
-				fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(update.URL))
-			}
+			printResultURL(update.URL, plaintext)
 		}
 	},
 }
@@ -352,10 +480,12 @@ var statusUpdateCmd = &cobra.Command{
 	Long: `Update the body or health of an existing status update.
 
 The body can be provided inline via --body or read from a markdown file via --body-file.
-Use --body-file - to read from stdin.
+Use --body-file - to read from stdin. Pass "latest" with --project to edit
+the project's most recent update.
 
 Examples:
   linear-cli project status update UPDATE-ID --body "Updated status text"
+  linear-cli project status update latest --project PROJECT-ID --health atRisk
   linear-cli project status update UPDATE-ID --body-file updated-status.md
   linear-cli project status update UPDATE-ID --health offTrack
   linear-cli project status update UPDATE-ID --body "New text" --health onTrack
@@ -363,12 +493,12 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -382,7 +512,7 @@ Examples:
 			body, err := resolveBodyFromFlags(bodyFlag, cmd.Flags().Changed("body"), filePath, "body", "body-file")
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["body"] = body
 		}
@@ -391,7 +521,7 @@ Examples:
 			health, _ := cmd.Flags().GetString("health")
 			if !isValidHealth(health) {
 				output.Error(fmt.Sprintf("Invalid health value '%s'. Valid values: onTrack, atRisk, offTrack", health), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			input["health"] = health
 		}
@@ -403,25 +533,36 @@ Examples:
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use --body and/or --health.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
-		update, err := client.UpdateProjectUpdate(context.Background(), args[0], input)
+		updateID, _, err := resolveStatusUpdateID(cmd, client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		update, err := client.UpdateProjectUpdate(context.Background(), updateID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project-update", update.ID, "", nil)
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(update.URL, "status update")
+		} else if jsonOut {
 			output.JSON(update)
 		} else if plaintext {
 			fmt.Printf("Updated project status update %s\n", update.ID)
 			fmt.Printf("Health: %s\n", update.Health)
+			printResultURL(update.URL, plaintext)
 		} else {
 			fmt.Printf("%s Updated project status update\n",
 				color.New(color.FgGreen).Sprint("✓"))
 			fmt.Printf("  Health: %s\n", formatHealth(update.Health))
 			fmt.Printf("  ID: %s\n", color.New(color.FgWhite, color.Faint).Sprint(update.ID))
+			printResultURL(update.URL, plaintext)
 		}
 	},
 }
@@ -435,8 +576,14 @@ var statusDeleteCmd = &cobra.Command{
 Note: Linear does not support permanent deletion of project updates.
 This command archives the update instead.
 
+"latest" with --project archives the project's most recent update, after
+asking for confirmation; pass --yes to skip the prompt (required when stdin
+is not a terminal or with --json).
+
 Examples:
-  linear-cli project status delete UPDATE-ID`,
+  linear-cli project status delete UPDATE-ID
+  linear-cli project status delete latest --project PROJECT-ID
+  linear-cli project status delete latest --project PROJECT-ID --yes --json`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -445,49 +592,214 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
-		err = client.ArchiveProjectUpdate(context.Background(), args[0])
+		updateID, latest, err := resolveStatusUpdateID(cmd, client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if latest != nil {
+			yes, _ := cmd.Flags().GetBool("yes")
+			switch {
+			case yes:
+			case !jsonOut && stdinIsTerminal():
+				if !confirmPrompt(fmt.Sprintf("Archive the latest status update (%s)?", describeStatusUpdate(latest))) {
+					fmt.Fprintln(os.Stderr, "Aborted.")
+					exit(1)
+				}
+			default:
+				output.Error(fmt.Sprintf("Refusing to archive the latest status update (%s) without confirmation; pass --yes", describeStatusUpdate(latest)), plaintext, jsonOut)
+				exit(1)
+			}
+		}
+
+		err = client.ArchiveProjectUpdate(context.Background(), updateID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to archive project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "project-update", updateID, "", nil)
 
 		if jsonOut {
-			output.JSON(map[string]interface{}{"success": true, "id": args[0], "action": "archived"})
+			output.JSON(map[string]interface{}{"success": true, "id": updateID, "action": "archived"})
 		} else if plaintext {
-			fmt.Printf("Archived project status update %s\n", args[0])
+			fmt.Printf("Archived project status update %s\n", updateID)
 		} else {
 			fmt.Printf("%s Archived project status update %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgWhite, color.Faint).Sprint(args[0]))
+				color.New(color.FgWhite, color.Faint).Sprint(updateID))
 		}
 	},
 }
 
-func formatHealth(health string) string {
-	switch health {
-	case "onTrack":
-		return color.New(color.FgGreen).Sprint("On Track")
-	case "atRisk":
-		return color.New(color.FgYellow).Sprint("At Risk")
-	case "offTrack":
-		return color.New(color.FgRed).Sprint("Off Track")
-	default:
-		return health
+// latestStatusUpdate stands for a project's newest status update in get,
+// update, and delete
+const latestStatusUpdate = "latest"
+
+// resolveStatusUpdateID returns the update ID to act on. "latest" needs
+// --project and costs one query; the update it found is returned too, so
+// callers can describe it. Any other ID is used as given.
+func resolveStatusUpdateID(cmd *cobra.Command, client *api.Client, id string) (string, *api.ProjectUpdate, error) {
+	projectID, _ := cmd.Flags().GetString("project")
+	if id != latestStatusUpdate {
+		if projectID != "" {
+			return "", nil, fmt.Errorf("--project only applies to '%s'; pass either an update ID or '%s --project PROJECT-ID'", latestStatusUpdate, latestStatusUpdate)
+		}
+		return id, nil, nil
+	}
+	if projectID == "" {
+		return "", nil, fmt.Errorf("'%s' needs --project PROJECT-ID", latestStatusUpdate)
+	}
+
+	update, err := client.GetLatestProjectUpdate(context.Background(), projectID)
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed to find the latest status update: %v", err)
+	}
+	if update == nil {
+		return "", nil, fmt.Errorf("Project %s has no status updates", projectID)
+	}
+	return update.ID, update, nil
+}
+
+// describeStatusUpdate summarizes an update for a confirmation prompt, e.g.
+// "atRisk, by Jane Doe, 2026-03-02"
+func describeStatusUpdate(update *api.ProjectUpdate) string {
+	parts := []string{}
+	if update.Health != "" {
+		parts = append(parts, update.Health)
+	}
+	if update.User != nil {
+		parts = append(parts, "by "+update.User.Name)
+	}
+	parts = append(parts, output.FormatTime(update.CreatedAt, output.DateOnly))
+	return strings.Join(parts, ", ")
+}
+
+// milestoneProgress is a milestone's completed/total issue count for a status update
+type milestoneProgress struct {
+	Name      string
+	Completed int
+	Total     int
+}
+
+// fetchMilestoneProgress counts a milestone's completed issues, ignoring
+// canceled ones. The milestone must belong to project.
+func fetchMilestoneProgress(client *api.Client, project *api.Project, milestoneID string) (*milestoneProgress, error) {
+	milestone, err := client.GetProjectMilestone(context.Background(), milestoneID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get milestone %s: %v", milestoneID, err)
+	}
+	if milestone.Project == nil || milestone.Project.ID != project.ID {
+		return nil, fmt.Errorf("milestone '%s' does not belong to project %s", milestone.Name, project.Name)
+	}
+	projectID := project.ID
+
+	filter := map[string]interface{}{
+		"projectMilestone": map[string]interface{}{"id": map[string]interface{}{"eq": milestone.ID}},
+	}
+	progress := &milestoneProgress{Name: milestone.Name}
+	after := ""
+	for {
+		page, err := client.GetProjectIssues(context.Background(), projectID, filter, 100, after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issues for milestone '%s': %v", milestone.Name, err)
+		}
+		for _, issue := range page.Nodes {
+			if issue.State == nil {
+				progress.Total++
+				continue
+			}
+			switch issue.State.Type {
+			case "canceled":
+			case "completed":
+				progress.Completed++
+				progress.Total++
+			default:
+				progress.Total++
+			}
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return progress, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// fetchCompletedSinceLastUpdate returns the time of the project's latest
+// status update and the issues completed after it. since is nil when the
+// project has no previous update.
+func fetchCompletedSinceLastUpdate(client *api.Client, projectID string) (*time.Time, []api.Issue, error) {
+	updates, err := client.GetProjectUpdates(context.Background(), projectID, 1, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(updates.Nodes) == 0 {
+		return nil, nil, nil
+	}
+	since := updates.Nodes[0].CreatedAt
+
+	filter := map[string]interface{}{
+		"completedAt": map[string]interface{}{"gt": since.Format(time.RFC3339)},
+		"state":       map[string]interface{}{"type": map[string]interface{}{"eq": "completed"}},
+	}
+	var issues []api.Issue
+	after := ""
+	for {
+		page, err := client.GetProjectIssues(context.Background(), projectID, filter, 100, after)
+		if err != nil {
+			return nil, nil, err
+		}
+		issues = append(issues, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return &since, issues, nil
+		}
+		after = page.PageInfo.EndCursor
 	}
 }
 
-func isValidHealth(health string) bool {
-	switch health {
-	case "onTrack", "atRisk", "offTrack":
-		return true
-	default:
-		return false
+// appendStatusSections adds the milestone progress and completed-issues
+// sections to a status update body. Sections with nothing to report are
+// left out, except that an empty completed list is stated explicitly.
+func appendStatusSections(body string, progress []milestoneProgress, since *time.Time, completed []api.Issue) string {
+	var sections []string
+
+	if len(progress) > 0 {
+		var b strings.Builder
+		b.WriteString("**Milestone progress**\n")
+		for _, mp := range progress {
+			if mp.Total == 0 {
+				fmt.Fprintf(&b, "- %s: no issues\n", mp.Name)
+				continue
+			}
+			fmt.Fprintf(&b, "- %s: %d/%d issues complete\n", mp.Name, mp.Completed, mp.Total)
+		}
+		sections = append(sections, strings.TrimRight(b.String(), "\n"))
 	}
+
+	if since != nil {
+		var b strings.Builder
+		fmt.Fprintf(&b, "**Completed since last update (%s)**\n", output.FormatTime(*since, output.DateOnly))
+		if len(completed) == 0 {
+			b.WriteString("- None\n")
+		}
+		for _, issue := range completed {
+			fmt.Fprintf(&b, "- %s %s\n", issue.Identifier, issue.Title)
+		}
+		sections = append(sections, strings.TrimRight(b.String(), "\n"))
+	}
+
+	if len(sections) == 0 {
+		return body
+	}
+	appendix := strings.Join(sections, "\n\n")
+	if strings.TrimSpace(body) == "" {
+		return appendix
+	}
+	return strings.TrimRight(body, "\n") + "\n\n" + appendix
 }
 
 func init() {
@@ -500,18 +812,31 @@ func init() {
 
 	// list flags
 	statusListCmd.Flags().IntP("limit", "l", 20, "Maximum number of updates to fetch")
+	statusListCmd.Flags().Bool("all-projects", false, "List recent updates across every project as a digest")
+	statusListCmd.Flags().String("since", "1_week_ago", "With --all-projects: updates since a date or expression (e.g. 2025-01-01, 2_weeks_ago)")
+	statusListCmd.Flags().String("group-by", "project", "With --all-projects: group by project or initiative")
 
 	// create flags
 	statusCreateCmd.Flags().StringP("body", "b", "", "Status update body text (required unless --body-file is used)")
-	statusCreateCmd.Flags().String("body-file", "", "Read body from a markdown file (use - for stdin)")
+	statusCreateCmd.Flags().String("body-file", "", "Read body from a markdown file or https:// URL (use - for stdin)")
 	statusCreateCmd.Flags().String("health", "", "Project health: onTrack, atRisk, offTrack")
 	statusCreateCmd.Flags().Bool("hide-diff", false, "Hide the project diff in this update")
+	statusCreateCmd.Flags().StringSlice("milestone", nil, "Append progress for this milestone ID (repeatable)")
+	statusCreateCmd.Flags().Bool("diff-since-last", false, "Append issues completed since the previous status update")
+	addURLOnlyFlag(statusCreateCmd)
 
 	// update flags
 	statusUpdateCmd.Flags().StringP("body", "b", "", "New body text")
-	statusUpdateCmd.Flags().String("body-file", "", "Read body from a markdown file (use - for stdin)")
+	statusUpdateCmd.Flags().String("body-file", "", "Read body from a markdown file or https:// URL (use - for stdin)")
 	statusUpdateCmd.Flags().String("health", "", "New health: onTrack, atRisk, offTrack")
 	statusUpdateCmd.Flags().Bool("hide-diff", false, "Hide the project diff in this update")
+	addURLOnlyFlag(statusUpdateCmd)
+
+	// "latest" resolution
+	for _, c := range []*cobra.Command{statusGetCmd, statusUpdateCmd, statusDeleteCmd} {
+		c.Flags().String("project", "", "Project ID whose newest update 'latest' refers to")
+	}
+	statusDeleteCmd.Flags().Bool("yes", false, "Archive 'latest' without asking")
 
 	// Aliases so "linear-cli project update-status" also works — handled via Aliases on projectStatusCmd
 }
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+func TestAppendStatusSections(t *testing.T) {
+	since := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	progress := []milestoneProgress{
+		{Name: "Alpha", Completed: 7, Total: 10},
+		{Name: "Beta"},
+	}
+	completed := []api.Issue{
+		{Identifier: "ROB-1", Title: "Ship login"},
+		{Identifier: "ROB-2", Title: "Fix crash"},
+	}
+
+	tests := []struct {
+		name      string
+		body      string
+		progress  []milestoneProgress
+		since     *time.Time
+		completed []api.Issue
+		want      string
+	}{
+		{
+			name: "no sections",
+			body: "All good",
+			want: "All good",
+		},
+		{
+			name:     "milestones after body",
+			body:     "Weekly update\n",
+			progress: progress,
+			want:     "Weekly update\n\n**Milestone progress**\n- Alpha: 7/10 issues complete\n- Beta: no issues",
+		},
+		{
+			name:      "completed without body",
+			since:     &since,
+			completed: completed,
+			want:      "**Completed since last update (2025-03-01)**\n- ROB-1 Ship login\n- ROB-2 Fix crash",
+		},
+		{
+			name:  "nothing completed",
+			body:  "Quiet week",
+			since: &since,
+			want:  "Quiet week\n\n**Completed since last update (2025-03-01)**\n- None",
+		},
+		{
+			name:      "both sections",
+			body:      "Update",
+			progress:  progress[:1],
+			since:     &since,
+			completed: completed[:1],
+			want:      "Update\n\n**Milestone progress**\n- Alpha: 7/10 issues complete\n\n**Completed since last update (2025-03-01)**\n- ROB-1 Ship login",
+		},
+	}
+
+	output.SetUTC(true)
+	defer output.SetUTC(false)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := appendStatusSections(tt.body, tt.progress, tt.since, tt.completed); got != tt.want {
+				t.Errorf("appendStatusSections() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveStatusUpdateID(t *testing.T) {
+	queries := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries++
+		nodes := `{"id":"upd-2","health":"atRisk","createdAt":"2026-03-02T12:00:00Z","user":{"id":"u1","name":"Jane Doe"}}`
+		if queries > 1 {
+			nodes = ""
+		}
+		fmt.Fprintf(w, `{"data":{"project":{"projectUpdates":{"nodes":[%s]}}}}`, nodes)
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+
+	newCmd := func(args ...string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("project", "", "")
+		if err := cmd.ParseFlags(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+
+	id, latest, err := resolveStatusUpdateID(newCmd(), client, "upd-1")
+	if err != nil || id != "upd-1" || latest != nil || queries != 0 {
+		t.Errorf("concrete ID = %q, %v, %v after %d queries; want upd-1 unchanged, no query", id, latest, err, queries)
+	}
+	if _, _, err := resolveStatusUpdateID(newCmd("--project", "p1"), client, "upd-1"); err == nil {
+		t.Error("--project with a concrete ID should fail")
+	}
+	if _, _, err := resolveStatusUpdateID(newCmd(), client, "latest"); err == nil || !strings.Contains(err.Error(), "--project") {
+		t.Errorf("latest without --project: err = %v, want one naming --project", err)
+	}
+
+	id, latest, err = resolveStatusUpdateID(newCmd("--project", "p1"), client, "latest")
+	if err != nil || id != "upd-2" || queries != 1 {
+		t.Fatalf("latest = %q, %v after %d queries; want upd-2 in one query", id, err, queries)
+	}
+	if got := describeStatusUpdate(latest); got != "atRisk, by Jane Doe, 2026-03-02" {
+		t.Errorf("describeStatusUpdate = %q", got)
+	}
+
+	if _, _, err := resolveStatusUpdateID(newCmd("--project", "p1"), client, "latest"); err == nil || !strings.Contains(err.Error(), "no status updates") {
+		t.Errorf("project without updates: err = %v, want 'no status updates'", err)
+	}
+}
+
+func TestHermeticStatusCreateMilestoneBySlug(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Project", `{"project":{"id":"p1","slugId":"abc123","name":"Launch"}}`)
+	s.DataFor("ProjectMilestone", map[string]interface{}{"id": "m1"}, `{"projectMilestone":{"id":"m1","name":"Alpha","project":{"id":"p1","name":"Launch"}}}`)
+	s.DataFor("ProjectMilestone", map[string]interface{}{"id": "m2"}, `{"projectMilestone":{"id":"m2","name":"Other","project":{"id":"p2","name":"Elsewhere"}}}`)
+	s.Data("ProjectIssues", `{"project":{"issues":{"nodes":[
+		{"id":"i1","state":{"type":"completed"}},{"id":"i2","state":{"type":"started"}},{"id":"i3","state":{"type":"canceled"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("ProjectUpdateCreate", `{"projectUpdateCreate":{"success":true,"projectUpdate":{"id":"u1","project":{"id":"p1","name":"Launch"}}}}`)
+
+	// A slug ID names the same project the milestone does by UUID
+	r := runMocked(t, "project", "status", "create", "abc123", "--milestone", "m1", "--plaintext")
+	if r.Exit != 0 {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, req := range s.Requests() {
+		if req.Operation == "ProjectIssues" && req.Variables["id"] != "p1" {
+			t.Errorf("milestone issues fetched for %v, want the resolved ID p1", req.Variables["id"])
+		}
+		if req.Operation == "ProjectUpdateCreate" && !strings.Contains(mustJSON(t, req.Variables["input"]), "Alpha: 1/2 issues complete") {
+			t.Errorf("body lacks the milestone progress: %s", mustJSON(t, req.Variables["input"]))
+		}
+	}
+
+	r = runMocked(t, "project", "status", "create", "abc123", "--milestone", "m2")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "does not belong to project Launch") {
+		t.Errorf("other project's milestone exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
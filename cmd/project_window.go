@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+// defaultProjectWindow is the project list window when neither a flag nor
+// project_list.newer_than in the config file sets one
+const defaultProjectWindow = "6_months_ago"
+
+// projectListWindow is the time window 'project list' filters by
+type projectListWindow struct {
+	Since   string // RFC3339 cutoff, or "" for all time
+	Active  bool   // updated since the cutoff also counts, not only created
+	Default bool   // no flag chose the window, so hidden projects are reported
+}
+
+// resolveProjectListWindow picks the window from --newer-than, --active-since,
+// and --all-time, falling back to configured (project_list.newer_than) and
+// then defaultProjectWindow. Invalid expressions are reported before any
+// request is made.
+func resolveProjectListWindow(newerThan, activeSince string, allTime bool, configured string) (projectListWindow, error) {
+	switch {
+	case allTime:
+		return projectListWindow{}, nil
+	case activeSince != "":
+		since, err := utils.ParseTimeExpression(activeSince)
+		if err != nil {
+			return projectListWindow{}, fmt.Errorf("invalid --active-since value: %w", err)
+		}
+		return projectListWindow{Since: since, Active: true}, nil
+	case newerThan != "":
+		since, err := utils.ParseTimeExpression(newerThan)
+		if err != nil {
+			return projectListWindow{}, fmt.Errorf("invalid --newer-than value: %w", err)
+		}
+		return projectListWindow{Since: since}, nil
+	}
+
+	source := "default"
+	if configured == "" {
+		configured = defaultProjectWindow
+	} else {
+		source = "project_list.newer_than in the config file"
+	}
+	since, err := utils.ParseTimeExpression(configured)
+	if err != nil {
+		return projectListWindow{}, fmt.Errorf("invalid %s: %w", source, err)
+	}
+	return projectListWindow{Since: since, Default: true}, nil
+}
+
+// apply adds the window to a ProjectFilter. --active-since matches projects
+// created or updated since the cutoff, so long-running projects still show.
+func (w projectListWindow) apply(filter map[string]interface{}) {
+	if w.Since == "" {
+		return
+	}
+	if w.Active {
+		filter["or"] = []map[string]interface{}{
+			{"createdAt": map[string]interface{}{"gte": w.Since}},
+			{"updatedAt": map[string]interface{}{"gte": w.Since}},
+		}
+		return
+	}
+	filter["createdAt"] = map[string]interface{}{"gte": w.Since}
+}
+
+// hiddenProjectsCap is how many hidden projects reportHiddenProjects counts
+// before settling for "N+": one page of IDs, so listing never pages through
+// a workspace's whole history
+const hiddenProjectsCap = 250
+
+// reportHiddenProjects writes to out how many projects matching filter were
+// left out only because they were created before the default window
+func (w projectListWindow) reportHiddenProjects(client *api.Client, filter map[string]interface{}, out io.Writer) {
+	if !w.Default || w.Since == "" {
+		return
+	}
+	older := make(map[string]interface{}, len(filter))
+	for k, v := range filter {
+		older[k] = v
+	}
+	older["createdAt"] = map[string]interface{}{"lt": w.Since}
+
+	hidden, err := client.GetProjectIDs(context.Background(), older, hiddenProjectsCap, "", "")
+	if err != nil || len(hidden.Nodes) == 0 {
+		return
+	}
+	count := fmt.Sprint(len(hidden.Nodes))
+	if hidden.PageInfo.HasNextPage {
+		count += "+"
+	}
+	noun := "projects"
+	if count == "1" {
+		noun = "project"
+	}
+	fmt.Fprintf(out, "Note: %s older %s hidden (use --newer-than all_time)\n", count, noun)
+}
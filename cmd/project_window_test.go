@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestResolveProjectListWindow(t *testing.T) {
+	tests := []struct {
+		name        string
+		newerThan   string
+		activeSince string
+		allTime     bool
+		configured  string
+		wantAll     bool
+		wantActive  bool
+		wantDefault bool
+		wantErr     string
+	}{
+		{name: "default", wantDefault: true},
+		{name: "configured default", configured: "1_year_ago", wantDefault: true},
+		{name: "configured all_time", configured: "all_time", wantAll: true, wantDefault: true},
+		{name: "explicit", newerThan: "2_weeks_ago"},
+		{name: "explicit all_time", newerThan: "all_time", wantAll: true},
+		{name: "all-time flag", allTime: true, wantAll: true},
+		{name: "active since", activeSince: "1_month_ago", wantActive: true},
+		{name: "bad newer-than", newerThan: "yesterday-ish", wantErr: "--newer-than"},
+		{name: "bad active-since", activeSince: "-2_days_ago", wantErr: "--active-since"},
+		{name: "bad config", configured: "forever", wantErr: "project_list.newer_than"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := resolveProjectListWindow(tt.newerThan, tt.activeSince, tt.allTime, tt.configured)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want one mentioning %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (w.Since == "") != tt.wantAll || w.Active != tt.wantActive || w.Default != tt.wantDefault {
+				t.Errorf("window = %+v, want all=%v active=%v default=%v", w, tt.wantAll, tt.wantActive, tt.wantDefault)
+			}
+		})
+	}
+}
+
+func TestProjectListWindowApply(t *testing.T) {
+	const since = "2024-01-01T00:00:00Z"
+
+	filter := map[string]interface{}{}
+	projectListWindow{Since: since}.apply(filter)
+	if got, _ := json.Marshal(filter); string(got) != `{"createdAt":{"gte":"2024-01-01T00:00:00Z"}}` {
+		t.Errorf("newer-than filter = %s", got)
+	}
+
+	filter = map[string]interface{}{}
+	projectListWindow{Since: since, Active: true}.apply(filter)
+	want := `{"or":[{"createdAt":{"gte":"2024-01-01T00:00:00Z"}},{"updatedAt":{"gte":"2024-01-01T00:00:00Z"}}]}`
+	if got, _ := json.Marshal(filter); string(got) != want {
+		t.Errorf("active-since filter = %s, want %s", got, want)
+	}
+
+	filter = map[string]interface{}{}
+	projectListWindow{}.apply(filter)
+	if len(filter) != 0 {
+		t.Errorf("all-time filter = %v, want empty", filter)
+	}
+}
+
+func TestReportHiddenProjects(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, string(body))
+		w.Write([]byte(`{"data":{"projects":{"nodes":[{"id":"a"},{"id":"b"},{"id":"c"}],"pageInfo":{"hasNextPage":false}}}}`))
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "key")
+	filter := map[string]interface{}{"state": map[string]interface{}{"nin": []string{"completed"}}}
+
+	var out bytes.Buffer
+	projectListWindow{Since: "2024-01-01T00:00:00Z", Default: true}.reportHiddenProjects(client, filter, &out)
+	if got := out.String(); got != "Note: 3 older projects hidden (use --newer-than all_time)\n" {
+		t.Errorf("note = %q", got)
+	}
+	if len(requests) != 1 || !strings.Contains(requests[0], `"createdAt":{"lt":"2024-01-01T00:00:00Z"}`) || !strings.Contains(requests[0], `"state"`) {
+		t.Errorf("count request = %v, want the list filter with createdAt lt the cutoff", requests)
+	}
+	if _, ok := filter["createdAt"]; ok {
+		t.Error("reportHiddenProjects modified the caller's filter")
+	}
+
+	out.Reset()
+	projectListWindow{Since: "2024-01-01T00:00:00Z"}.reportHiddenProjects(client, filter, &out)
+	if out.Len() != 0 || len(requests) != 1 {
+		t.Errorf("explicit window should not count hidden projects; got %q after %d requests", out.String(), len(requests))
+	}
+
+	// Past one page the count is capped instead of paging through them all
+	more := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, string(body))
+		w.Write([]byte(`{"data":{"projects":{"nodes":[{"id":"a"},{"id":"b"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`))
+	}))
+	defer more.Close()
+	requests = nil
+	out.Reset()
+	projectListWindow{Since: "2024-01-01T00:00:00Z", Default: true}.reportHiddenProjects(api.NewClientWithURL(more.URL, "key"), filter, &out)
+	if got := out.String(); got != "Note: 2+ older projects hidden (use --newer-than all_time)\n" || len(requests) != 1 {
+		t.Errorf("capped note = %q after %d requests", got, len(requests))
+	}
+}
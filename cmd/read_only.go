@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// readOnlyEnv locks read-only mode on when set to a true value such as 1;
+// neither --read-only=false nor the config file can turn it back off
+const readOnlyEnv = "LINEAR_READ_ONLY"
+
+// exitReadOnly is the exit code when a command stops because read-only mode
+// refused a mutation, so scripts can tell it from a failure
+const exitReadOnly = 5
+
+// applyReadOnly turns read-only mode on or off for the command about to run:
+// on when LINEAR_READ_ONLY is set, else as --read-only or read_only in the
+// config file says. Under 'serve --stdio' a session started read-only stays
+// so, whatever later requests pass.
+func applyReadOnly() {
+	if serving && api.ReadOnly() != "" {
+		api.SetReadOnly(api.ReadOnly())
+		return
+	}
+	if locked, _ := strconv.ParseBool(os.Getenv(readOnlyEnv)); locked {
+		api.SetReadOnly(readOnlyEnv)
+		return
+	}
+	switch {
+	case !viper.GetBool("read_only"):
+		api.SetReadOnly("")
+	case rootCmd.PersistentFlags().Changed("read-only"):
+		api.SetReadOnly("--read-only")
+	default:
+		api.SetReadOnly("read_only in the config file")
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func TestHermeticReadOnly(t *testing.T) {
+	s := newMockLinear(t)
+	t.Setenv(readOnlyEnv, "")
+	t.Cleanup(func() { api.SetReadOnly("") })
+	s.Fixtures("testdata/linearmock/teams")
+	s.Data("ArchiveProject", `{"projectArchive":{"success":true}}`)
+	stubConvertIssue(s)
+
+	r := runMocked(t, "project", "archive", "proj-1", "--read-only")
+	if r.Exit != exitReadOnly || !strings.Contains(r.Stdout+r.Stderr, "read-only mode (--read-only): refusing to run mutation ArchiveProject") {
+		t.Errorf("archive exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	// Queries still run, and so does a dry run, which only reads
+	if r := runMocked(t, "team", "list", "--json", "--read-only"); r.Exit != 0 {
+		t.Errorf("team list exited %d: %s", r.Exit, r.Stderr)
+	}
+	if r := runMocked(t, "project", "convert", "ENG-1", "--dry-run", "--plaintext", "--read-only"); r.Exit != 0 {
+		t.Errorf("convert --dry-run exited %d: %s", r.Exit, r.Stderr)
+	}
+	r = runMocked(t, "project", "convert", "ENG-1", "--plaintext", "--read-only")
+	if r.Exit != exitReadOnly || !strings.Contains(r.Stdout, "1. create project \"Auth revamp\" in team ENG from ENG-1: failed (read-only mode") {
+		t.Errorf("convert exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "ArchiveProject" || op == "CreateProject" {
+			t.Errorf("%s reached the API", op)
+		}
+	}
+
+	// Without the flag the mutation goes through
+	if r := runMocked(t, "project", "archive", "proj-1"); r.Exit != 0 {
+		t.Errorf("archive without --read-only exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticReadOnlyConfigAndEnv(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ArchiveProject", `{"projectArchive":{"success":true}}`)
+	t.Cleanup(func() { api.SetReadOnly("") })
+
+	viper.Set("read_only", true)
+	r := runMocked(t, "project", "archive", "proj-1")
+	viper.Set("read_only", false)
+	if r.Exit != exitReadOnly || !strings.Contains(r.Stdout+r.Stderr, "read-only mode (read_only in the config file)") {
+		t.Errorf("config read_only exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	// The environment variable can't be overridden by a flag
+	t.Setenv(readOnlyEnv, "1")
+	r = runMocked(t, "project", "archive", "proj-1", "--read-only=false")
+	if r.Exit != exitReadOnly || !strings.Contains(r.Stdout+r.Stderr, "read-only mode (LINEAR_READ_ONLY)") {
+		t.Errorf("env lock exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if len(s.Requests()) != 0 {
+		t.Errorf("requests reached the API: %v", s.Operations())
+	}
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+// findUser returns the user whose ID, email, name, or display name is ref,
+// ignoring case. When none is, the error suggests the closest emails and
+// names.
+func findUser(users []api.User, ref string) (*api.User, error) {
+	var candidates []string
+	for i, u := range users {
+		if u.ID == ref || strings.EqualFold(u.Email, ref) || strings.EqualFold(u.Name, ref) || strings.EqualFold(u.DisplayName, ref) {
+			return &users[i], nil
+		}
+		candidates = append(candidates, u.Email, u.Name)
+	}
+	return nil, utils.NewNotFoundError(fmt.Sprintf("User not found: %s", ref), ref, candidates)
+}
+
+// userLookup resolves user references with one targeted users query each.
+// Every user is listed, once, only for a reference the query matches
+// nobody for, to find it by display name or suggest the closest users.
+type userLookup struct {
+	client  *api.Client
+	all     []api.User
+	fetched bool
+}
+
+func newUserLookup(client *api.Client) *userLookup {
+	return &userLookup{client: client}
+}
+
+// find returns the user whose ID, email, name, or display name is ref,
+// ignoring case, like findUser
+func (l *userLookup) find(ref string) (*api.User, error) {
+	matches, err := l.client.FindUsers(context.Background(), userRefFilter(ref), 10, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up user %s: %w", ref, err)
+	}
+	if user, err := findUser(matches.Nodes, ref); err == nil {
+		return user, nil
+	}
+
+	if !l.fetched {
+		all, err := fetchAllUsers(l.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get users: %w", err)
+		}
+		l.all, l.fetched = all, true
+	}
+	return findUser(l.all, ref)
+}
+
+// userRefFilter is the UserFilter that matches ref as a user ID, an email,
+// or else a name or display name
+func userRefFilter(ref string) map[string]interface{} {
+	switch {
+	case isUUID(ref):
+		return map[string]interface{}{"id": map[string]interface{}{"eq": ref}}
+	case strings.Contains(ref, "@"):
+		return map[string]interface{}{"email": map[string]interface{}{"eqIgnoreCase": ref}}
+	}
+	return map[string]interface{}{"or": []interface{}{
+		map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": ref}},
+		map[string]interface{}{"displayName": map[string]interface{}{"eqIgnoreCase": ref}},
+	}}
+}
+
+// findLabel returns the label named name, ignoring case, or an error
+// suggesting the closest label names
+func findLabel(labels []api.Label, name string) (*api.Label, error) {
+	var candidates []string
+	for i, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			return &labels[i], nil
+		}
+		candidates = append(candidates, l.Name)
+	}
+	return nil, utils.NewNotFoundError(fmt.Sprintf("Label '%s' not found", name), name, candidates)
+}
+
+// findState returns the workflow state named name, ignoring case, or an
+// error listing the team's states and suggesting the closest ones
+func findState(states []api.WorkflowState, name string) (*api.WorkflowState, error) {
+	var candidates []string
+	for i, s := range states {
+		if strings.EqualFold(s.Name, name) {
+			return &states[i], nil
+		}
+		candidates = append(candidates, s.Name)
+	}
+	msg := fmt.Sprintf("State '%s' not found. Available states: %s", name, strings.Join(candidates, ", "))
+	return nil, utils.NewNotFoundError(msg, name, candidates)
+}
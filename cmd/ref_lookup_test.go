@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+func TestFindUser(t *testing.T) {
+	users := []api.User{
+		{ID: "u1", Name: "Alice Smith", DisplayName: "alice", Email: "alice@example.com"},
+		{ID: "u2", Name: "Bob Jones", DisplayName: "bob", Email: "bob@example.com"},
+	}
+	for _, ref := range []string{"u2", "BOB@example.com", "bob jones", "Bob"} {
+		if u, err := findUser(users, ref); err != nil || u.ID != "u2" {
+			t.Errorf("findUser(%q) = %+v, %v", ref, u, err)
+		}
+	}
+
+	_, err := findUser(users, "alice@exmaple.com")
+	var notFound *utils.NotFoundError
+	if !errors.As(err, &notFound) || err.Error() != "User not found: alice@exmaple.com; did you mean: alice@example.com?" {
+		t.Errorf("typo error = %v", err)
+	}
+}
+
+func TestFindState(t *testing.T) {
+	states := []api.WorkflowState{{ID: "s1", Name: "Todo"}, {ID: "s2", Name: "In Progress"}, {ID: "s3", Name: "Done"}}
+	if s, err := findState(states, "in progress"); err != nil || s.ID != "s2" {
+		t.Errorf("findState = %+v, %v", s, err)
+	}
+	_, err := findState(states, "In Progres")
+	if want := "State 'In Progres' not found. Available states: Todo, In Progress, Done; did you mean: In Progress?"; err == nil || err.Error() != want {
+		t.Errorf("error = %v\nwant %s", err, want)
+	}
+	if _, err := findLabel([]api.Label{{ID: "l1", Name: "Bug"}}, "bgu"); err == nil || err.Error() != "Label 'bgu' not found; did you mean: Bug?" {
+		t.Errorf("findLabel error = %v", err)
+	}
+}
+
+// TestErrorSuggestions checks that output.Error finds the suggestions of
+// the resolvers' typed errors, however they are wrapped
+func TestErrorSuggestions(t *testing.T) {
+	_, err := findLabel([]api.Label{{ID: "l1", Name: "Bugs, urgent"}, {ID: "l2", Name: "Docs"}}, "bugs, urgnt")
+	wrapped := fmt.Errorf("issue create: %w", err)
+	if got := output.Suggestions(wrapped); len(got) != 1 || got[0] != "Bugs, urgent" {
+		t.Errorf("Suggestions = %q, want the label name whole", got)
+	}
+	if got := output.Suggestions(errors.New("Label 'x' not found; did you mean: a, b?")); got != nil {
+		t.Errorf("Suggestions of a plain error = %q, want none", got)
+	}
+	teamErr := fmt.Errorf("Failed to find team 'ENB': %w", &api.TeamLookupError{Ref: "ENB", Suggestions: []string{"ENG"}})
+	if got := output.Suggestions(nil, teamErr); len(got) != 1 || got[0] != "ENG" {
+		t.Errorf("Suggestions of a team lookup = %q, want ENG", got)
+	}
+	ambiguous := &api.TeamLookupError{Ref: "platform", Ambiguous: true, Suggestions: []string{"PLA"}}
+	if got := output.Suggestions(ambiguous); got != nil {
+		t.Errorf("Suggestions of an ambiguous team = %q, want none", got)
+	}
+}
+
+func TestUserLookupBeyondFirstPage(t *testing.T) {
+	s := newMockLinear(t)
+	users := make([]api.User, 250)
+	for i := range users {
+		n := i + 1
+		users[i] = api.User{ID: fmt.Sprintf("user-%d", n), Name: fmt.Sprintf("User %d", n), DisplayName: fmt.Sprintf("u%d", n), Email: fmt.Sprintf("user%d@example.com", n)}
+	}
+	page := func(nodes []api.User, next string) map[string]interface{} {
+		return map[string]interface{}{"users": map[string]interface{}{
+			"nodes":    nodes,
+			"pageInfo": map[string]interface{}{"hasNextPage": next != "", "endCursor": next},
+		}}
+	}
+	s.Data("Issue", `{"issue":`+mockFixture(t, "issue_get")+`}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"issue":`+mockFixture(t, "issue_get")+`}}`)
+	s.Data("Users", page(users[:100], "page-2"))
+	s.DataFor("Users", map[string]interface{}{"after": "page-2"}, page(users[100:], ""))
+	s.Data("FindUsers", page(nil, ""))
+	s.DataFor("FindUsers", map[string]interface{}{"filter": userRefFilter("user201@example.com")}, page(users[200:201], ""))
+
+	assignee := func(args ...string) interface{} {
+		t.Helper()
+		s.Reset()
+		r := runMocked(t, append([]string{"issue", "update", "ENG-42", "--json"}, args...)...)
+		if r.Exit != 0 {
+			t.Fatalf("issue update %v exited %d: %s", args, r.Exit, r.Stdout+r.Stderr)
+		}
+		for _, req := range s.Requests() {
+			if req.Operation == "UpdateIssue" {
+				return req.Variables["input"].(map[string]interface{})["assigneeId"]
+			}
+		}
+		return nil
+	}
+
+	// An email is found with one targeted query
+	if got := assignee("--assignee", "user201@example.com"); got != "user-201" {
+		t.Errorf("--assignee user201@example.com set %v, want user-201", got)
+	}
+	if ops := strings.Join(s.Operations(), ","); ops != "Issue,FindUsers,UpdateIssue" {
+		t.Errorf("email lookup operations = %s", ops)
+	}
+
+	// When the targeted query matches nobody, every user is paged through
+	if got := assignee("--assignee", "u201"); got != "user-201" {
+		t.Errorf("--assignee u201 set %v, want user-201", got)
+	}
+	if ops := strings.Join(s.Operations(), ","); ops != "Issue,FindUsers,Users,Users,UpdateIssue" {
+		t.Errorf("fallback operations = %s", ops)
+	}
+}
@@ -3,11 +3,11 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -48,14 +48,14 @@ var relationListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
-		issue, err := client.GetIssue(context.Background(), args[0])
+		issue, err := resolveIssueRef(context.Background(), client, args[0])
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		type relationEntry struct {
@@ -213,11 +213,11 @@ Examples:
 
 		if relType == "" {
 			output.Error("--type is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 		if target == "" {
 			output.Error("--target is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		validTypes := []string{"blocks", "blocked-by", "related", "duplicate", "parent", "sub-issue"}
@@ -230,35 +230,40 @@ Examples:
 		}
 		if !found {
 			output.Error(fmt.Sprintf("Invalid type '%s'. Valid types: %s", relType, strings.Join(validTypes, ", ")), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
-		issueID := args[0]
+		srcIssue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		targetIssue, err := resolveIssueRef(context.Background(), client, target)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueID := srcIssue.Identifier
+		target = targetIssue.Identifier
 
 		switch relType {
 		case "parent":
-			// Resolve the target to get its ID
-			targetIssue, err := client.GetIssue(context.Background(), target)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve target issue '%s': %v", target, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
 			input := map[string]interface{}{
 				"parentId": targetIssue.ID,
 			}
-			issue, err := client.UpdateIssue(context.Background(), issueID, input)
+			issue, err := client.UpdateIssue(context.Background(), srcIssue.ID, input)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to set parent: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "issue", issue.ID, issue.Identifier, nil)
 
 			if jsonOut {
 				output.JSON(issue)
@@ -272,21 +277,15 @@ Examples:
 			}
 
 		case "sub-issue":
-			// Resolve issueID to get its real ID
-			parentIssue, err := client.GetIssue(context.Background(), issueID)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve issue '%s': %v", issueID, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
 			input := map[string]interface{}{
-				"parentId": parentIssue.ID,
+				"parentId": srcIssue.ID,
 			}
-			childIssue, err := client.UpdateIssue(context.Background(), target, input)
+			childIssue, err := client.UpdateIssue(context.Background(), targetIssue.ID, input)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to set sub-issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "issue", childIssue.ID, childIssue.Identifier, nil)
 
 			if jsonOut {
 				output.JSON(childIssue)
@@ -301,22 +300,12 @@ Examples:
 
 		case "blocked-by":
 			// Swap direction: target blocks issueID
-			targetIssue, err := client.GetIssue(context.Background(), target)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve target issue '%s': %v", target, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-			srcIssue, err := client.GetIssue(context.Background(), issueID)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve issue '%s': %v", issueID, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
 			relation, err := client.CreateIssueRelation(context.Background(), targetIssue.ID, srcIssue.ID, "blocks")
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to create relation: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "relation", relation.ID, "", &journal.Inverse{Action: "delete"})
 
 			if jsonOut {
 				output.JSON(relation)
@@ -331,22 +320,12 @@ Examples:
 
 		default:
 			// blocks, related, duplicate — direct API call
-			srcIssue, err := client.GetIssue(context.Background(), issueID)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve issue '%s': %v", issueID, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-			targetIssue, err := client.GetIssue(context.Background(), target)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve target issue '%s': %v", target, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
 			relation, err := client.CreateIssueRelation(context.Background(), srcIssue.ID, targetIssue.ID, relType)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to create relation: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "relation", relation.ID, "", &journal.Inverse{Action: "delete"})
 
 			if jsonOut {
 				output.JSON(relation)
@@ -387,21 +366,32 @@ Examples:
 
 		if relType == "" {
 			output.Error("--type is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 		if target == "" {
 			output.Error("--target is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
-		issueID := args[0]
+		srcIssue, err := resolveIssueRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		targetIssue, err := resolveIssueRef(context.Background(), client, target)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueID := srcIssue.Identifier
+		target = targetIssue.Identifier
 
 		switch relType {
 		case "parent":
@@ -409,11 +399,12 @@ Examples:
 			input := map[string]interface{}{
 				"parentId": nil,
 			}
-			issue, err := client.UpdateIssue(context.Background(), issueID, input)
+			issue, err := client.UpdateIssue(context.Background(), srcIssue.ID, input)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to remove parent: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "issue", issue.ID, issue.Identifier, nil)
 
 			if jsonOut {
 				output.JSON(issue)
@@ -430,11 +421,12 @@ Examples:
 			input := map[string]interface{}{
 				"parentId": nil,
 			}
-			childIssue, err := client.UpdateIssue(context.Background(), target, input)
+			childIssue, err := client.UpdateIssue(context.Background(), targetIssue.ID, input)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to remove sub-issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "issue", childIssue.ID, childIssue.Identifier, nil)
 
 			if jsonOut {
 				output.JSON(childIssue)
@@ -449,23 +441,11 @@ Examples:
 
 		default:
 			// For blocks, blocked-by, related, duplicate: find and delete the relation
-			issue, err := client.GetIssue(context.Background(), issueID)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Resolve target identifier
-			targetIssue, err := client.GetIssue(context.Background(), target)
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to resolve target issue '%s': %v", target, err), plaintext, jsonOut)
-				os.Exit(1)
-			}
 
 			// Find matching relation
 			var relationID string
-			if issue.Relations != nil {
-				for _, rel := range issue.Relations.Nodes {
+			if srcIssue.Relations != nil {
+				for _, rel := range srcIssue.Relations.Nodes {
 					if rel.RelatedIssue != nil && rel.RelatedIssue.ID == targetIssue.ID {
 						// Match type (blocked-by maps to blocks in the API)
 						apiType := relType
@@ -482,14 +462,15 @@ Examples:
 
 			if relationID == "" {
 				output.Error(fmt.Sprintf("No %s relation found between %s and %s", relType, issueID, target), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 
 			err = client.DeleteIssueRelation(context.Background(), relationID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to delete relation: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
+			recordOperation(cmd, "relation", relationID, "", nil)
 
 			if jsonOut {
 				output.JSON(map[string]interface{}{"success": true, "relationId": relationID})
@@ -527,7 +508,7 @@ Examples:
 		newType, _ := cmd.Flags().GetString("type")
 		if newType == "" {
 			output.Error("--type is required", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		validTypes := []string{"blocks", "related", "duplicate"}
@@ -540,13 +521,13 @@ Examples:
 		}
 		if !found {
 			output.Error(fmt.Sprintf("Invalid type '%s'. Valid types for update: %s", newType, strings.Join(validTypes, ", ")), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -558,8 +539,9 @@ Examples:
 		relation, err := client.UpdateIssueRelation(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update relation: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "relation", relation.ID, "", nil)
 
 		if jsonOut {
 			output.JSON(relation)
@@ -3,8 +3,12 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/fatih/color"
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -15,6 +19,13 @@ var (
 	jsonOut   bool
 )
 
+// exit ends the current command with a status code. Commands call it instead
+// of os.Exit so 'serve --stdio' can unwind back to its request loop.
+var exit = func(code int) {
+	finishCommand()
+	os.Exit(exitStatus(code))
+}
+
 // version is set at build time via -ldflags
 // default value is for local dev builds
 var version = "dev"
@@ -34,13 +45,30 @@ var rootCmd = &cobra.Command{
 	Short:   "A comprehensive Linear CLI tool",
 	Long:    color.New(color.FgCyan).Sprintf("%s\nA CLI for Linear's API featuring:\n• Issues, projects, cycles, labels, documents, initiatives, views\n• Comments, attachments, relations, milestones, status updates\n• Team and user management\n• Raw GraphQL queries\n• Table/plaintext/JSON output formats\n", generateHeader()),
 	Version: version,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		applyJSONEnvelope(cmd)
+		applyOnboarding(cmd)
+		if err := routeStdin(cmd); err != nil {
+			output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+			exit(1)
+		}
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		finishCommand()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
-	err := rootCmd.Execute()
+	args, err := expandAliases(os.Args[1:])
 	if err != nil {
-		os.Exit(1)
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(exitStatus(1))
+	}
+	rootCmd.SetArgs(args)
+	err = rootCmd.Execute()
+	if err != nil {
+		os.Exit(exitStatus(1))
 	}
 }
 
@@ -56,10 +84,51 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.linear-cli.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().Bool("utc", false, "show timestamps in UTC instead of local time")
+	rootCmd.PersistentFlags().Bool("strict-schema", false, "fail on enum values this version doesn't know (e.g. a new project health)")
+	rootCmd.PersistentFlags().String("progress", "auto", "progress reporting on stderr for bulk commands: auto, json (NDJSON events), none")
+	rootCmd.PersistentFlags().Int("max-requests", 0, "abort with exit code 4 once this many API requests have been made (0 = no limit)")
+	rootCmd.PersistentFlags().Int("parallel", api.DefaultParallel, "how many API requests bulk commands send at once; lowered automatically while Linear is rate limiting")
+	rootCmd.PersistentFlags().Bool("verbose", false, "report API request count and remaining rate limit on stderr")
+	rootCmd.PersistentFlags().String("stdin-as", "", "read piped stdin as this prose flag (description, body, or content)")
+	rootCmd.PersistentFlags().Bool("no-normalize", false, "send prose flags (description, body, content) exactly as given, without tidying line endings, whitespace, and list indentation")
+	rootCmd.PersistentFlags().Bool("debug", false, "print debugging details on stderr, such as the command an alias expands to")
+	rootCmd.PersistentFlags().Bool("json-envelope", false, "JSON output wrapped as {schemaVersion, cliVersion, command, generatedAt, data} (implies --json)")
+	rootCmd.PersistentFlags().Bool("no-color", false, "turn off colored output (so does a NO_COLOR environment variable)")
+	rootCmd.PersistentFlags().Bool("no-onboard", false, "never offer the guided first-run sign-in; fail with setup instructions instead (so does LINEAR_NO_ONBOARD=1)")
+	rootCmd.PersistentFlags().Bool("read-only", false, "refuse anything that would change data, with exit code 5 (LINEAR_READ_ONLY=1 locks this on)")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
 	_ = viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
+	_ = viper.BindPFlag("utc", rootCmd.PersistentFlags().Lookup("utc"))
+	_ = viper.BindPFlag("strict_schema", rootCmd.PersistentFlags().Lookup("strict-schema"))
+	_ = viper.BindPFlag("progress", rootCmd.PersistentFlags().Lookup("progress"))
+	_ = viper.BindPFlag("max_requests", rootCmd.PersistentFlags().Lookup("max-requests"))
+	_ = viper.BindPFlag("parallel", rootCmd.PersistentFlags().Lookup("parallel"))
+	_ = viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
+	_ = viper.BindPFlag("json_envelope", rootCmd.PersistentFlags().Lookup("json-envelope"))
+	_ = viper.BindPFlag("read_only", rootCmd.PersistentFlags().Lookup("read-only"))
+	_ = viper.BindPFlag("no_color", rootCmd.PersistentFlags().Lookup("no-color"))
+	_ = viper.BindPFlag("no_onboard", rootCmd.PersistentFlags().Lookup("no-onboard"))
+	_ = viper.BindPFlag("no_normalize", rootCmd.PersistentFlags().Lookup("no-normalize"))
+
+	// Fail fast on mutations when the token is read-only
+	api.SetDefaultPreflight(auth.CheckWriteAccess)
+	// Check enum values under --strict-schema whatever the output mode
+	api.SetDefaultResultCheck(checkSchema)
+}
+
+// applyJSONEnvelope turns the --json-envelope wrapper on or off for cmd.
+// The envelope implies --json, so the flag itself is set for commands to see.
+func applyJSONEnvelope(cmd *cobra.Command) {
+	if !viper.GetBool("json_envelope") {
+		output.ClearEnvelope()
+		return
+	}
+	root := cmd.Root()
+	_ = root.PersistentFlags().Set("json", "true")
+	output.SetEnvelope(strings.TrimPrefix(cmd.CommandPath(), root.Name()+" "), version)
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -81,10 +150,20 @@ func initConfig() {
 
 	viper.AutomaticEnv() // read in environment variables that match
 
+	output.SetUTC(viper.GetBool("utc"))
+	// fatih/color already honors NO_COLOR and a stdout that isn't a terminal
+	if viper.GetBool("no_color") {
+		color.NoColor = true
+	}
+	applyRequestBudget()
+	api.ResetLastAPIError()
+	api.SetParallel(viper.GetInt("parallel"))
+
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
 		if !plaintext && !jsonOut {
 			fmt.Fprintln(os.Stderr, color.New(color.FgGreen).Sprintf("✅ Using config file: %s", viper.ConfigFileUsed()))
 		}
 	}
+	applyReadOnly()
 }
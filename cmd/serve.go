@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// maxServeRequestSize bounds one request line, including any stdin payload
+const maxServeRequestSize = 10 << 20
+
+// serveRequest is one line of 'serve --stdio' input
+type serveRequest struct {
+	ID      json.RawMessage `json:"id"`
+	Command []string        `json:"command"`
+	Stdin   string          `json:"stdin,omitempty"`
+}
+
+// serveResponse is one line of 'serve --stdio' output. Error is set when the
+// request itself was unusable and no command ran.
+type serveResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Exit   int             `json:"exit"`
+	Stdout string          `json:"stdout"`
+	Stderr string          `json:"stderr"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// serveExit is the panic value exit raises while a served command runs
+type serveExit int
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run commands from a JSON request loop on stdin/stdout",
+	Long: `Run many commands in one process, for agents and scripts that call the CLI
+repeatedly. Credentials are read once and HTTP connections are reused.
+
+With --stdio, each stdin line is a JSON request:
+
+  {"id":1,"command":["issue","list","--team","ENG","--json"]}
+
+and each response is one JSON line on stdout:
+
+  {"id":1,"exit":0,"stdout":"...","stderr":"..."}
+
+An optional "stdin" string is fed to the command (e.g. for --description-file -).
+Requests run one at a time, in order. A malformed request gets a response with
+"error" set and the loop continues; EOF on stdin exits cleanly.
+
+--max-requests on serve caps API requests for the whole session. The request
+that runs out of budget fails with exit 4, then the loop stops and serve
+exits 4.
+
+Examples:
+  echo '{"id":1,"command":["team","list","--json"]}' | linear-cli serve --stdio`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		stdio, _ := cmd.Flags().GetBool("stdio")
+		if !stdio {
+			output.Error("serve requires --stdio (the only transport so far)", plaintext, jsonOut)
+			exit(1)
+		}
+
+		auth.CacheCredentials()
+		// --max-requests caps the whole session, not each request
+		serving = true
+		defer func() { serving = false }()
+		// Output is captured, never shown on a terminal
+		color.NoColor = true
+
+		if err := serveStdio(os.Stdin, os.Stdout); err != nil {
+			output.Error(fmt.Sprintf("serve: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+	},
+}
+
+// serveStdio answers newline-delimited JSON requests from in until EOF
+func serveStdio(in io.Reader, out io.Writer) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 64*1024), maxServeRequestSize)
+	enc := json.NewEncoder(out)
+
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		if err := enc.Encode(handleServeLine(line)); err != nil {
+			return err
+		}
+		// The budget spans the session; stop rather than fail every request
+		if api.RequestBudgetExceeded() {
+			return &api.BudgetExceededError{Max: api.RequestBudget()}
+		}
+	}
+	return scanner.Err()
+}
+
+// handleServeLine validates one request line and runs it
+func handleServeLine(line []byte) serveResponse {
+	var req serveRequest
+	if err := json.Unmarshal(line, &req); err != nil {
+		return serveResponse{ID: json.RawMessage("null"), Exit: 2, Error: fmt.Sprintf("malformed request: %v", err)}
+	}
+	if len(req.ID) == 0 {
+		req.ID = json.RawMessage("null")
+	}
+	if len(req.Command) == 0 {
+		return serveResponse{ID: req.ID, Exit: 2, Error: "request has no command"}
+	}
+	if req.Command[0] == "serve" {
+		return serveResponse{ID: req.ID, Exit: 2, Error: "serve cannot be run from within serve"}
+	}
+	return runServeCommand(req)
+}
+
+// runServeCommand executes a request through the command tree with stdio
+// redirected, turning exit calls back into an exit code
+func runServeCommand(req serveRequest) serveResponse {
+	resp := serveResponse{ID: req.ID}
+	var stdout, stderr bytes.Buffer
+
+	restore, err := redirectStdio(req.Stdin, &stdout, &stderr)
+	if err != nil {
+		resp.Exit = 1
+		resp.Error = fmt.Sprintf("failed to capture output: %v", err)
+		return resp
+	}
+	resetCommandFlags(rootCmd)
+	resp.Exit = executeCaptured(req.Command)
+	restore()
+
+	resp.Stdout = stdout.String()
+	resp.Stderr = stderr.String()
+	return resp
+}
+
+// executeCaptured runs args through rootCmd and returns the exit code
+func executeCaptured(args []string) (code int) {
+	processExit := exit
+	exit = func(code int) {
+		finishCommand()
+		panic(serveExit(exitStatus(code)))
+	}
+	defer func() {
+		exit = processExit
+		if r := recover(); r != nil {
+			if c, ok := r.(serveExit); ok {
+				code = int(c)
+				return
+			}
+			fmt.Fprintf(os.Stderr, "panic: %v\n", r)
+			code = 1
+		}
+	}()
+
+	args, err := expandAliases(args)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return exitStatus(1)
+	}
+	rootCmd.SetArgs(args)
+	if err := rootCmd.Execute(); err != nil {
+		return exitStatus(1)
+	}
+	return 0
+}
+
+// redirectStdio points os.Stdin at input and copies os.Stdout and os.Stderr
+// into the given writers until restore is called
+func redirectStdio(input string, stdout, stderr io.Writer) (restore func(), err error) {
+	var files []*os.File
+	closeAll := func() {
+		for _, f := range files {
+			f.Close()
+		}
+	}
+	pipe := func() (*os.File, *os.File, error) {
+		r, w, err := os.Pipe()
+		if err == nil {
+			files = append(files, r, w)
+		}
+		return r, w, err
+	}
+
+	inR, inW, err := pipe()
+	if err != nil {
+		return nil, err
+	}
+	outR, outW, err := pipe()
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+	errR, errW, err := pipe()
+	if err != nil {
+		closeAll()
+		return nil, err
+	}
+
+	// Commands that never read stdin unblock this writer when inR closes
+	go func() {
+		_, _ = io.WriteString(inW, input)
+		inW.Close()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(stdout, outR)
+	}()
+	go func() {
+		defer wg.Done()
+		_, _ = io.Copy(stderr, errR)
+	}()
+
+	origIn, origOut, origErr := os.Stdin, os.Stdout, os.Stderr
+	os.Stdin, os.Stdout, os.Stderr = inR, outW, errW
+
+	return func() {
+		os.Stdin, os.Stdout, os.Stderr = origIn, origOut, origErr
+		outW.Close()
+		errW.Close()
+		wg.Wait()
+		closeAll()
+	}, nil
+}
+
+// resetCommandFlags restores every flag in the tree to its default so one
+// request's flags don't leak into the next
+func resetCommandFlags(c *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			_ = sv.Replace(sliceFlagDefault(f.DefValue))
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	c.Flags().VisitAll(reset)
+	c.PersistentFlags().VisitAll(reset)
+	for _, sub := range c.Commands() {
+		resetCommandFlags(sub)
+	}
+}
+
+// sliceFlagDefault parses a slice flag's DefValue, e.g. "[a,b]"
+func sliceFlagDefault(def string) []string {
+	def = strings.TrimSuffix(strings.TrimPrefix(def, "["), "]")
+	if def == "" {
+		return []string{}
+	}
+	return strings.Split(def, ",")
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().Bool("stdio", false, "Read JSON requests from stdin and write JSON responses to stdout")
+}
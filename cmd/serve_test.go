@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestServeStdio(t *testing.T) {
+	in := strings.Join([]string{
+		`{"id":1,"command":["project","create","--name","X","--milestone","Bad:2025-13-01","--json"]}`,
+		`not json`,
+		``,
+		`{"id":"b","command":["project","create","--name","X","--milestone",":2025-01-01"]}`,
+		`{"id":3,"command":[]}`,
+		`{"id":4,"command":["serve","--stdio"]}`,
+		`{"id":5,"command":["--version"]}`,
+	}, "\n")
+
+	var out bytes.Buffer
+	if err := serveStdio(strings.NewReader(in), &out); err != nil {
+		t.Fatalf("serveStdio: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 6 {
+		t.Fatalf("got %d responses, want 6:\n%s", len(lines), out.String())
+	}
+	var resps []serveResponse
+	for _, line := range lines {
+		var r serveResponse
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			t.Fatalf("response is not JSON: %q", line)
+		}
+		resps = append(resps, r)
+	}
+
+	// exit(1) inside the command becomes an exit code, and --json output is captured
+	if string(resps[0].ID) != "1" || resps[0].Exit != 1 || !strings.Contains(resps[0].Stdout, `"error"`) {
+		t.Errorf("response 1 = %+v", resps[0])
+	}
+	if string(resps[1].ID) != "null" || resps[1].Exit != 2 || !strings.Contains(resps[1].Error, "malformed request") {
+		t.Errorf("malformed response = %+v", resps[1])
+	}
+
+	// Flags from request 1 must not leak: no --json, and only this request's milestone
+	r := resps[2]
+	if string(r.ID) != `"b"` || r.Exit != 1 || r.Stdout != "" {
+		t.Errorf("response b = %+v", r)
+	}
+	if !strings.Contains(r.Stderr, "entry 1: name is required") || strings.Contains(r.Stderr, "entry 2") {
+		t.Errorf("response b stderr = %q", r.Stderr)
+	}
+
+	if resps[3].Error != "request has no command" || resps[4].Error == "" {
+		t.Errorf("invalid requests = %+v, %+v", resps[3], resps[4])
+	}
+	if resps[5].Exit != 0 || !strings.Contains(resps[5].Stdout, "version") {
+		t.Errorf("version response = %+v", resps[5])
+	}
+}
+
+func TestSliceFlagDefault(t *testing.T) {
+	if got := sliceFlagDefault("[]"); len(got) != 0 {
+		t.Errorf("sliceFlagDefault([]) = %v", got)
+	}
+	if got := sliceFlagDefault("[a,b]"); strings.Join(got, "|") != "a|b" {
+		t.Errorf("sliceFlagDefault([a,b]) = %v", got)
+	}
+}
@@ -0,0 +1,458 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// sortedItem is one of a set of siblings Linear orders by sortOrder, such as
+// a project's milestones or the favorites in a folder
+type sortedItem struct {
+	ID        string
+	Name      string
+	SortOrder float64
+}
+
+// sortPosition is where move puts an item: before or after a sibling, or
+// first or last
+type sortPosition struct {
+	Before string
+	After  string
+	First  bool
+	Last   bool
+}
+
+// sortOrderSpacing is the gap move leaves between sortOrders, matching
+// milestone create
+const sortOrderSpacing = milestoneSortSpacing
+
+// sortUpdate is one sortOrder change of a move, with its outcome once
+// applied: "updated", "failed", or "not applied" after a failure
+type sortUpdate struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	From   float64 `json:"from"`
+	To     float64 `json:"to"`
+	Status string  `json:"status,omitempty"`
+	Error  string  `json:"error,omitempty"`
+}
+
+// sortMovePlan is what a move changes: nothing when the item is already in
+// place, its own sortOrder when a value fits between its new neighbors, or
+// every sibling's when none does (Rebalanced)
+type sortMovePlan struct {
+	ID         string       `json:"id"`
+	Name       string       `json:"name"`
+	Rebalanced bool         `json:"rebalanced"`
+	Updates    []sortUpdate `json:"updates"`
+}
+
+// planSortMove works out the sortOrder updates that put id at pos among
+// items. A moved item normally gets the midpoint of its new neighbors, or
+// sortOrderSpacing beyond the end. When the neighbors are so close that no
+// float fits between them, the whole set is renumbered in its new order.
+func planSortMove(items []sortedItem, id string, pos sortPosition) (sortMovePlan, error) {
+	ordered := append([]sortedItem(nil), items...)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].SortOrder < ordered[j].SortOrder })
+
+	var moving *sortedItem
+	var rest []sortedItem
+	for i := range ordered {
+		if ordered[i].ID == id {
+			moving = &ordered[i]
+		} else {
+			rest = append(rest, ordered[i])
+		}
+	}
+	if moving == nil {
+		return sortMovePlan{}, fmt.Errorf("%s is not in the list", id)
+	}
+	plan := sortMovePlan{ID: moving.ID, Name: moving.Name, Updates: []sortUpdate{}}
+
+	idx := len(rest)
+	switch {
+	case pos.First:
+		idx = 0
+	case pos.Last:
+	default:
+		anchor := pos.Before
+		if anchor == "" {
+			anchor = pos.After
+		}
+		if anchor == id {
+			return plan, fmt.Errorf("can't move %s relative to itself", id)
+		}
+		idx = -1
+		for i, item := range rest {
+			if item.ID == anchor {
+				idx = i
+				if pos.After != "" {
+					idx++
+				}
+				break
+			}
+		}
+		if idx < 0 {
+			return plan, fmt.Errorf("%s is not among the siblings of %s", anchor, id)
+		}
+	}
+
+	var lo, hi *sortedItem
+	if idx > 0 {
+		lo = &rest[idx-1]
+	}
+	if idx < len(rest) {
+		hi = &rest[idx]
+	}
+	between := func(v float64) bool {
+		return (lo == nil || lo.SortOrder < v) && (hi == nil || v < hi.SortOrder)
+	}
+	// Order follows sortOrder, so an item already between its new
+	// neighbors is already in place
+	if between(moving.SortOrder) {
+		return plan, nil
+	}
+
+	var target float64
+	switch {
+	case lo == nil:
+		target = hi.SortOrder - sortOrderSpacing
+	case hi == nil:
+		target = lo.SortOrder + sortOrderSpacing
+	default:
+		target = lo.SortOrder + (hi.SortOrder-lo.SortOrder)/2
+	}
+	if between(target) {
+		plan.Updates = append(plan.Updates, sortUpdate{ID: moving.ID, Name: moving.Name, From: moving.SortOrder, To: target})
+		return plan, nil
+	}
+
+	final := append(append(append([]sortedItem(nil), rest[:idx]...), *moving), rest[idx:]...)
+	plan.Rebalanced = true
+	plan.Updates = rebalanceSortOrders(final)
+	return plan, nil
+}
+
+// rebalanceSortOrders spaces items' sortOrders evenly in the given order.
+// The new values are picked so none equals a current one, so applying the
+// updates one at a time, in any order, never gives two items the same
+// sortOrder that didn't already share one, even if it stops part way.
+func rebalanceSortOrders(items []sortedItem) []sortUpdate {
+	taken := make(map[float64]bool, len(items))
+	for _, item := range items {
+		taken[item.SortOrder] = true
+	}
+	// A current value can clash with at most one of these n+1 offsets,
+	// since they are less than sortOrderSpacing apart, so one is free
+	var offset float64
+	for k := 0; k <= len(items); k++ {
+		offset = float64(k) * sortOrderSpacing / float64(len(items)+1)
+		clash := false
+		for i := range items {
+			if taken[float64(i+1)*sortOrderSpacing+offset] {
+				clash = true
+				break
+			}
+		}
+		if !clash {
+			break
+		}
+	}
+	updates := make([]sortUpdate, len(items))
+	for i, item := range items {
+		updates[i] = sortUpdate{ID: item.ID, Name: item.Name, From: item.SortOrder, To: float64(i+1)*sortOrderSpacing + offset}
+	}
+	return updates
+}
+
+// applySortMove applies the plan's updates in order, stopping at the first
+// failure; the rest are marked "not applied". It returns that failure.
+func applySortMove(plan *sortMovePlan, update func(id string, sortOrder float64) error, applied func(u sortUpdate)) error {
+	var failure error
+	for i := range plan.Updates {
+		u := &plan.Updates[i]
+		if failure != nil {
+			u.Status = "not applied"
+			continue
+		}
+		if err := update(u.ID, u.To); err != nil {
+			u.Status = "failed"
+			u.Error = err.Error()
+			failure = err
+			continue
+		}
+		u.Status = "updated"
+		applied(*u)
+	}
+	return failure
+}
+
+// readSortPosition reads --before, --after, --first, and --last, exactly one
+// of which must be given
+func readSortPosition(cmd *cobra.Command) (sortPosition, error) {
+	var pos sortPosition
+	pos.Before, _ = cmd.Flags().GetString("before")
+	pos.After, _ = cmd.Flags().GetString("after")
+	pos.First, _ = cmd.Flags().GetBool("first")
+	pos.Last, _ = cmd.Flags().GetBool("last")
+	given := 0
+	for _, set := range []bool{pos.Before != "", pos.After != "", pos.First, pos.Last} {
+		if set {
+			given++
+		}
+	}
+	if given != 1 {
+		return pos, errors.New("give exactly one of --before, --after, --first, or --last")
+	}
+	return pos, nil
+}
+
+// addSortPositionFlags adds the move position flags; noun names the siblings
+func addSortPositionFlags(cmd *cobra.Command, noun string) {
+	cmd.Flags().String("before", "", "Place it just before this "+noun)
+	cmd.Flags().String("after", "", "Place it just after this "+noun)
+	cmd.Flags().Bool("first", false, "Place it first")
+	cmd.Flags().Bool("last", false, "Place it last")
+}
+
+// runSortMove plans and applies a move to pos among items, records each
+// update, and prints the result. entityType is the history entity type and noun
+// how the output refers to an item.
+func runSortMove(cmd *cobra.Command, entityType, noun string, items []sortedItem, id string, pos sortPosition, update func(id string, sortOrder float64) error, plaintext, jsonOut bool) {
+	plan, err := planSortMove(items, id, pos)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		exit(1)
+	}
+
+	failure := applySortMove(&plan, update, func(u sortUpdate) {
+		recordOperation(cmd, entityType, u.ID, u.Name, &journal.Inverse{
+			Action: "update",
+			Input:  map[string]interface{}{"sortOrder": u.From},
+		})
+	})
+
+	if jsonOut {
+		output.JSON(plan)
+	} else {
+		printSortMove(plan, noun, plaintext)
+	}
+	if failure != nil {
+		applied := 0
+		for _, u := range plan.Updates {
+			if u.Status == "updated" {
+				applied++
+			}
+		}
+		msg := fmt.Sprintf("Failed to move %s %s: %v", noun, plan.Name, failure)
+		if plan.Rebalanced {
+			msg = fmt.Sprintf("Renumbering stopped after %d of %d updates: %v. No two %ss were given the same sort order; run the move again to finish",
+				applied, len(plan.Updates), failure, noun)
+		}
+		output.Error(msg, true, false)
+		exit(1)
+	}
+}
+
+// printSortMove prints the outcome of a move
+func printSortMove(plan sortMovePlan, noun string, plaintext bool) {
+	if len(plan.Updates) == 0 {
+		output.Info(fmt.Sprintf("%s %s is already in that position", noun, plan.Name), plaintext, false)
+		return
+	}
+	if plan.Rebalanced {
+		note := fmt.Sprintf("Sort orders were too close to fit %s between, so every %s was renumbered:", plan.Name, noun)
+		if plaintext {
+			fmt.Println(note)
+		} else {
+			fmt.Println(color.New(color.FgYellow).Sprint(note))
+		}
+		for _, u := range plan.Updates {
+			line := fmt.Sprintf("%s: %g -> %g", u.Name, u.From, u.To)
+			switch {
+			case plaintext:
+				fmt.Printf("- %s (%s)\n", line, u.Status)
+			case u.Status == "updated":
+				fmt.Printf("  %s %s\n", color.New(color.FgGreen).Sprint("✓"), line)
+			case u.Status == "failed":
+				fmt.Printf("  %s %s: %s\n", color.New(color.FgRed).Sprint("✗"), line, u.Error)
+			default:
+				fmt.Printf("  %s %s (not applied)\n", color.New(color.FgYellow).Sprint("-"), line)
+			}
+		}
+		return
+	}
+	u := plan.Updates[0]
+	if u.Status != "updated" {
+		return
+	}
+	if plaintext {
+		fmt.Printf("Moved %s %s (sort order %g -> %g)\n", noun, u.Name, u.From, u.To)
+		return
+	}
+	fmt.Printf("%s Moved %s %s %s\n",
+		color.New(color.FgGreen).Sprint("✓"),
+		noun,
+		color.New(color.FgCyan, color.Bold).Sprint(u.Name),
+		color.New(color.FgWhite, color.Faint).Sprintf("(sort order %g → %g)", u.From, u.To))
+}
+
+var milestoneMoveCmd = &cobra.Command{
+	Use:   "move MILESTONE-ID",
+	Short: "Reorder a milestone among its project's milestones",
+	Long: `Move a milestone before or after another milestone of the same project,
+or to the start or end, without picking sort order numbers.
+
+The milestone gets a sort order halfway between its new neighbors. When they
+are too close for one to fit, every milestone of the project is renumbered
+evenly in the new order, one update at a time, with a line per milestone.
+
+Examples:
+  linear-cli project milestone move MILESTONE-ID --after OTHER-MILESTONE-ID
+  linear-cli project milestone move MILESTONE-ID --before OTHER-MILESTONE-ID
+  linear-cli project milestone move MILESTONE-ID --first`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		pos, err := readSortPosition(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+		ctx := context.Background()
+
+		ms, err := client.GetProjectMilestone(ctx, args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get milestone: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if ms.Project == nil {
+			output.Error(fmt.Sprintf("Milestone %s has no project", args[0]), plaintext, jsonOut)
+			exit(1)
+		}
+		var items []sortedItem
+		after := ""
+		for {
+			page, err := client.GetProjectMilestones(ctx, ms.Project.ID, 250, after)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get the project's milestones: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			for _, sibling := range page.Nodes {
+				items = append(items, sortedItem{ID: sibling.ID, Name: sibling.Name, SortOrder: sibling.SortOrder})
+			}
+			if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+				break
+			}
+			after = page.PageInfo.EndCursor
+		}
+
+		runSortMove(cmd, "milestone", "milestone", items, ms.ID, pos, func(id string, sortOrder float64) error {
+			_, err := client.UpdateProjectMilestone(ctx, id, map[string]interface{}{"sortOrder": sortOrder})
+			return err
+		}, plaintext, jsonOut)
+	},
+}
+
+var favoriteMoveCmd = &cobra.Command{
+	Use:   "move FAVORITE-ID",
+	Short: "Reorder a favorite within its folder",
+	Long: `Move a favorite before or after another favorite in the same folder (or
+at the top level), or to the start or end, without picking sort order numbers.
+
+The favorite gets a sort order halfway between its new neighbors. When they
+are too close for one to fit, every favorite in the folder is renumbered
+evenly in the new order, one update at a time, with a line per favorite.
+
+Examples:
+  linear-cli favorite move FAV-ID --after OTHER-FAV-ID
+  linear-cli favorite move FAV-ID --first`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		pos, err := readSortPosition(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+		ctx := context.Background()
+
+		var favorites []api.Favorite
+		after := ""
+		for {
+			page, err := client.GetFavorites(ctx, 250, after)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
+				exit(1)
+			}
+			favorites = append(favorites, page.Nodes...)
+			if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+				break
+			}
+			after = page.PageInfo.EndCursor
+		}
+
+		var moving *api.Favorite
+		for i := range favorites {
+			if favorites[i].ID == args[0] {
+				moving = &favorites[i]
+			}
+		}
+		if moving == nil {
+			output.Error(fmt.Sprintf("Favorite not found: %s", args[0]), plaintext, jsonOut)
+			exit(1)
+		}
+		// Siblings share the moved favorite's folder
+		folder := func(f *api.Favorite) string {
+			if f.Parent == nil {
+				return ""
+			}
+			return f.Parent.ID
+		}
+		var items []sortedItem
+		for i := range favorites {
+			if folder(&favorites[i]) == folder(moving) {
+				items = append(items, sortedItem{ID: favorites[i].ID, Name: favorites[i].Title, SortOrder: favorites[i].SortOrder})
+			}
+		}
+
+		runSortMove(cmd, "favorite", "favorite", items, moving.ID, pos, func(id string, sortOrder float64) error {
+			_, err := client.UpdateFavorite(ctx, id, map[string]interface{}{"sortOrder": sortOrder})
+			return err
+		}, plaintext, jsonOut)
+	},
+}
+
+func init() {
+	milestoneCmd.AddCommand(milestoneMoveCmd)
+	addSortPositionFlags(milestoneMoveCmd, "milestone")
+
+	favoriteCmd.AddCommand(favoriteMoveCmd)
+	addSortPositionFlags(favoriteMoveCmd, "favorite")
+}
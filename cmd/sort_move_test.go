@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// movedOrder lists the item IDs by their sort order once plan is applied
+func movedOrder(items []sortedItem, plan sortMovePlan) string {
+	orders := map[string]float64{}
+	for _, item := range items {
+		orders[item.ID] = item.SortOrder
+	}
+	for _, u := range plan.Updates {
+		orders[u.ID] = u.To
+	}
+	var moved []sortedItem
+	for id, order := range orders {
+		moved = append(moved, sortedItem{ID: id, SortOrder: order})
+	}
+	sort.Slice(moved, func(i, j int) bool { return moved[i].SortOrder < moved[j].SortOrder })
+	var ids []string
+	for _, item := range moved {
+		ids = append(ids, item.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestPlanSortMove(t *testing.T) {
+	items := []sortedItem{{"a", "A", 100}, {"b", "B", 200}, {"c", "C", 300}, {"d", "D", 400}}
+	tests := []struct {
+		name    string
+		id      string
+		pos     sortPosition
+		order   string
+		updates int
+	}{
+		{"after", "d", sortPosition{After: "a"}, "a,d,b,c", 1},
+		{"before", "a", sortPosition{Before: "d"}, "b,c,a,d", 1},
+		{"first", "c", sortPosition{First: true}, "c,a,b,d", 1},
+		{"last", "a", sortPosition{Last: true}, "b,c,d,a", 1},
+		{"already there", "b", sortPosition{After: "a"}, "a,b,c,d", 0},
+	}
+	for _, tt := range tests {
+		plan, err := planSortMove(items, tt.id, tt.pos)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := movedOrder(items, plan); got != tt.order || len(plan.Updates) != tt.updates || plan.Rebalanced {
+			t.Errorf("%s: order %s with %d updates (rebalanced %v), want %s with %d", tt.name, got, len(plan.Updates), plan.Rebalanced, tt.order, tt.updates)
+		}
+	}
+	if plan, _ := planSortMove(items, "d", sortPosition{After: "a"}); plan.Updates[0].To != 150 {
+		t.Errorf("midpoint = %v, want 150", plan.Updates[0].To)
+	}
+
+	for _, pos := range []sortPosition{{After: "zzz"}, {Before: "a"}} {
+		if _, err := planSortMove(items, "a", pos); err == nil {
+			t.Errorf("move to %+v should fail", pos)
+		}
+	}
+}
+
+func TestPlanSortMoveRebalances(t *testing.T) {
+	// No float lies between 1 and the next one up
+	items := []sortedItem{{"a", "A", 1}, {"b", "B", math.Nextafter(1, 2)}, {"c", "C", 5}}
+	plan, err := planSortMove(items, "c", sortPosition{After: "a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Rebalanced || len(plan.Updates) != 3 || movedOrder(items, plan) != "a,c,b" {
+		t.Fatalf("plan = %+v", plan)
+	}
+
+	// Two siblings already sharing a value leave no midpoint either, and the
+	// new values must avoid every current one
+	items = []sortedItem{{"a", "A", 100}, {"b", "B", 100}, {"c", "C", 200}}
+	plan, _ = planSortMove(items, "c", sortPosition{After: "a"})
+	if !plan.Rebalanced || movedOrder(items, plan) != "a,c,b" {
+		t.Fatalf("plan = %+v", plan)
+	}
+	seen := map[float64]bool{100: true, 200: true}
+	for _, u := range plan.Updates {
+		if seen[u.To] {
+			t.Errorf("rebalance reuses sort order %v: %+v", u.To, plan.Updates)
+		}
+		seen[u.To] = true
+	}
+}
+
+func TestApplySortMoveStopsAtFailure(t *testing.T) {
+	plan := sortMovePlan{Rebalanced: true, Updates: []sortUpdate{{ID: "a", To: 100}, {ID: "b", To: 200}, {ID: "c", To: 300}}}
+	var applied []string
+	err := applySortMove(&plan, func(id string, _ float64) error {
+		if id == "b" {
+			return errors.New("boom")
+		}
+		return nil
+	}, func(u sortUpdate) { applied = append(applied, u.ID) })
+	if err == nil || strings.Join(applied, ",") != "a" {
+		t.Fatalf("err %v, applied %v", err, applied)
+	}
+	var statuses []string
+	for _, u := range plan.Updates {
+		statuses = append(statuses, u.Status)
+	}
+	if got := strings.Join(statuses, ","); got != "updated,failed,not applied" {
+		t.Errorf("statuses = %s", got)
+	}
+}
+
+func TestMilestoneMove(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("ProjectMilestone", `{"projectMilestone":{"id":"m3","name":"GA","sortOrder":300,"project":{"id":"p1","name":"Checkout"}}}`)
+	s.Data("ProjectMilestones", `{"projectMilestones":{"nodes":[
+		{"id":"m1","name":"Alpha","sortOrder":100},
+		{"id":"m2","name":"Beta","sortOrder":200},
+		{"id":"m3","name":"GA","sortOrder":300}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("ProjectMilestoneUpdate", `{"projectMilestoneUpdate":{"success":true,"projectMilestone":{"id":"m3","name":"GA","sortOrder":150}}}`)
+
+	r := runMocked(t, "project", "milestone", "move", "m3", "--after", "m1", "--json")
+	var plan sortMovePlan
+	if r.Exit != 0 || json.Unmarshal([]byte(r.Stdout), &plan) != nil || len(plan.Updates) != 1 || plan.Updates[0].To != 150 {
+		t.Fatalf("milestone move exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	last := s.Requests()[len(s.Requests())-1]
+	if last.Operation != "ProjectMilestoneUpdate" || last.Variables["id"] != "m3" {
+		t.Errorf("last request = %s %v", last.Operation, last.Variables)
+	}
+
+	r = runMocked(t, "project", "milestone", "move", "m3", "--first", "--last")
+	if r.Exit != 1 {
+		t.Errorf("two positions exited %d", r.Exit)
+	}
+
+	// A failed update in a rebalance is reported with what was left undone
+	s.Reset()
+	s.Data("ProjectMilestone", `{"projectMilestone":{"id":"m3","name":"GA","sortOrder":300,"project":{"id":"p1"}}}`)
+	s.Data("ProjectMilestones", `{"projectMilestones":{"nodes":[
+		{"id":"m1","name":"Alpha","sortOrder":100},
+		{"id":"m2","name":"Beta","sortOrder":100},
+		{"id":"m3","name":"GA","sortOrder":300}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("ProjectMilestoneUpdate", `{"projectMilestoneUpdate":{"success":true,"projectMilestone":{"id":"x"}}}`)
+	s.Add(linearmock.Stub{Operation: "ProjectMilestoneUpdate", Variables: map[string]interface{}{"id": "m3"},
+		Response: json.RawMessage(`{"errors":[{"message":"Forbidden"}]}`)})
+	r = runMocked(t, "project", "milestone", "move", "m3", "--after", "m1", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "stopped after 1 of 3 updates") || !strings.Contains(r.Stdout, "(not applied)") {
+		t.Errorf("failed rebalance exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// sortOption is one accepted --sort value of the list commands
+type sortOption struct {
+	Name    string
+	Aliases []string
+	OrderBy string // PaginationOrderBy value; empty for Linear's default
+	Meaning string
+}
+
+// sortOptions lists the --sort values, in the order help shows them
+var sortOptions = []sortOption{
+	{Name: "linear", OrderBy: "", Meaning: "Linear's default order"},
+	{Name: "created", Aliases: []string{"createdAt"}, OrderBy: "createdAt", Meaning: "newest created first"},
+	{Name: "updated", Aliases: []string{"updatedAt"}, OrderBy: "updatedAt", Meaning: "most recently updated first"},
+}
+
+// sortOrderBy maps a --sort value to the API's orderBy; empty means Linear's
+// default order
+func sortOrderBy(sortBy string) (string, error) {
+	if sortBy == "" {
+		return "", nil
+	}
+	var names []string
+	for _, opt := range sortOptions {
+		if sortBy == opt.Name {
+			return opt.OrderBy, nil
+		}
+		for _, alias := range opt.Aliases {
+			if sortBy == alias {
+				return opt.OrderBy, nil
+			}
+		}
+		names = append(names, opt.Name)
+	}
+	return "", fmt.Errorf("Invalid sort option: %s. Valid options are: %s", sortBy, strings.Join(names, ", "))
+}
+
+// The comparators below order values ascending and are shared by the
+// client-side sorts of the issue and project lists.
+
+// comparePriority orders Linear priorities from lowest to highest, with no
+// priority lowest of all (see priorityRank)
+func comparePriority(a, b int) int {
+	return cmp.Compare(priorityRank(a), priorityRank(b))
+}
+
+// compareDates orders two YYYY-MM-DD dates; check dateMissing first
+func compareDates(a, b *string) int {
+	return strings.Compare(*a, *b)
+}
+
+// dateMissing reports whether an optional date is unset
+func dateMissing(d *string) bool {
+	return d == nil || *d == ""
+}
+
+// compareFold orders two names alphabetically, ignoring case
+func compareFold(a, b string) int {
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+// sortStableBy sorts slice in place by the element comparator compare,
+// reversed when desc. Elements missing reports as having no value go last
+// in either direction. Ties are ordered by tieBreak, ascending, when it is
+// set and otherwise keep their current order. missing and tieBreak may be
+// nil.
+func sortStableBy(slice interface{}, desc bool, compare func(i, j int) int, missing func(i int) bool, tieBreak func(i, j int) int) {
+	sort.SliceStable(slice, func(i, j int) bool {
+		c := 0
+		mi, mj := missing != nil && missing(i), missing != nil && missing(j)
+		switch {
+		case mi || mj:
+			if mi != mj {
+				return mj
+			}
+		case desc:
+			c = -compare(i, j)
+		default:
+			c = compare(i, j)
+		}
+		if c == 0 && tieBreak != nil {
+			c = tieBreak(i, j)
+		}
+		return c < 0
+	})
+}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+// valueStyle is how one API enum value is rendered. Values missing from a
+// style map fall back to neutralStyle with the raw value as label, so a new
+// value added by Linear shows up as-is instead of blank.
+type valueStyle struct {
+	Label    string
+	Attrs    []color.Attribute
+	Icon     string
+	Checkbox string
+	// Fill is an RGB hex color for renderers outside the terminal (DOT, Mermaid)
+	Fill string
+}
+
+var neutralStyle = valueStyle{
+	Attrs:    []color.Attribute{color.FgWhite},
+	Icon:     "○",
+	Checkbox: "[ ]",
+	Fill:     "#ffffff",
+}
+
+// Color returns the style's color
+func (s valueStyle) Color() *color.Color {
+	return color.New(s.Attrs...)
+}
+
+// Sprint renders the style's label in its color
+func (s valueStyle) Sprint() string {
+	return s.Color().Sprint(s.Label)
+}
+
+// projectStateStyles covers Project.state
+var projectStateStyles = map[string]valueStyle{
+	"backlog":   {Attrs: []color.Attribute{color.FgWhite, color.Faint}, Icon: "○"},
+	"planned":   {Attrs: []color.Attribute{color.FgCyan}, Icon: "○"},
+	"started":   {Attrs: []color.Attribute{color.FgBlue}, Icon: "◐"},
+	"paused":    {Attrs: []color.Attribute{color.FgYellow}, Icon: "‖"},
+	"completed": {Attrs: []color.Attribute{color.FgGreen}, Icon: "✓"},
+	"canceled":  {Attrs: []color.Attribute{color.FgRed}, Icon: "✗"},
+}
+
+// healthStyles covers project, project update, and initiative health
+var healthStyles = map[string]valueStyle{
+	"onTrack":  {Label: "On Track", Attrs: []color.Attribute{color.FgGreen}},
+	"atRisk":   {Label: "At Risk", Attrs: []color.Attribute{color.FgYellow}},
+	"offTrack": {Label: "Off Track", Attrs: []color.Attribute{color.FgRed}},
+}
+
+// issueStateTypeStyles covers WorkflowState.type
+var issueStateTypeStyles = map[string]valueStyle{
+	"triage":    {Attrs: []color.Attribute{color.FgMagenta}, Icon: "○", Checkbox: "[ ]", Fill: "#f3e8ff"},
+	"backlog":   {Attrs: []color.Attribute{color.FgCyan}, Icon: "○", Checkbox: "[ ]", Fill: "#e0f2fe"},
+	"unstarted": {Attrs: []color.Attribute{color.FgWhite}, Icon: "○", Checkbox: "[ ]", Fill: "#f3f4f6"},
+	"started":   {Attrs: []color.Attribute{color.FgBlue}, Icon: "◐", Checkbox: "[ ]", Fill: "#dbeafe"},
+	"completed": {Attrs: []color.Attribute{color.FgGreen}, Icon: "✓", Checkbox: "[x]", Fill: "#dcfce7"},
+	"canceled":  {Attrs: []color.Attribute{color.FgRed}, Icon: "✗", Checkbox: "[x]", Fill: "#fee2e2"},
+}
+
+// initiativeStatusStyles covers Initiative.status
+var initiativeStatusStyles = map[string]valueStyle{
+	"Planned":   {Attrs: []color.Attribute{color.FgCyan}},
+	"Active":    {Attrs: []color.Attribute{color.FgBlue}},
+	"Completed": {Attrs: []color.Attribute{color.FgGreen}},
+}
+
+// milestoneStatusStyles covers ProjectMilestone.status (lowercased)
+var milestoneStatusStyles = map[string]valueStyle{
+	"unstarted": {Attrs: []color.Attribute{color.FgWhite}},
+	"next":      {Attrs: []color.Attribute{color.FgBlue}},
+	"overdue":   {Attrs: []color.Attribute{color.FgRed}},
+	"done":      {Attrs: []color.Attribute{color.FgGreen}},
+}
+
+// favoriteTypeStyles covers Favorite.type
+var favoriteTypeStyles = map[string]valueStyle{
+	"issue":                  {Attrs: []color.Attribute{color.FgCyan}, Icon: "📋"},
+	"project":                {Attrs: []color.Attribute{color.FgMagenta}, Icon: "📊"},
+	"cycle":                  {Attrs: []color.Attribute{color.FgBlue}, Icon: "🔄"},
+	"customView":             {Attrs: []color.Attribute{color.FgGreen}, Icon: "👁"},
+	"predefinedViewIssues":   {Attrs: []color.Attribute{color.FgGreen}, Icon: "👁"},
+	"predefinedViewProjects": {Attrs: []color.Attribute{color.FgGreen}, Icon: "👁"},
+	"document":               {Attrs: []color.Attribute{color.FgWhite}, Icon: "📄"},
+	"initiative":             {Attrs: []color.Attribute{color.FgRed}, Icon: "🎯"},
+	"label":                  {Attrs: []color.Attribute{color.FgYellow}, Icon: "🏷"},
+	"projectLabel":           {Attrs: []color.Attribute{color.FgYellow}, Icon: "🏷"},
+	"user":                   {Attrs: []color.Attribute{color.FgCyan}, Icon: "👤"},
+	"folder":                 {Attrs: []color.Attribute{color.FgYellow}, Icon: "📁"},
+	"pullRequest":            {Attrs: []color.Attribute{color.FgMagenta}, Icon: "🔀"},
+}
+
+// checkSchemaValue returns an error for a value missing from styles when
+// --strict-schema is set. Empty values are never an error.
+func checkSchemaValue(entity string, styles map[string]valueStyle, value string) error {
+	if value == "" || !viper.GetBool("strict_schema") {
+		return nil
+	}
+	if _, ok := styles[value]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown %s value %q returned by the API", entity, value)
+}
+
+// checkSchema is the API client's result check: with --strict-schema it
+// fails a response holding an enum value missing from the style maps, before
+// any output mode renders it. Without the flag it returns nil straight away.
+func checkSchema(result interface{}) error {
+	if !viper.GetBool("strict_schema") {
+		return nil
+	}
+	if err := checkSchemaIn(reflect.ValueOf(result)); err != nil {
+		return fmt.Errorf("%w (--strict-schema)", err)
+	}
+	return nil
+}
+
+// checkSchemaIn walks a decoded response, checking the enum fields of every
+// API type that has them
+func checkSchemaIn(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkSchemaIn(v.Elem())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := checkSchemaIn(v.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+	default:
+		return nil
+	}
+
+	if v.CanInterface() {
+		if err := checkSchemaValues(v.Interface()); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if err := checkSchemaIn(v.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSchemaValues checks the enum fields of one API value
+func checkSchemaValues(value interface{}) error {
+	type check struct {
+		entity string
+		styles map[string]valueStyle
+		value  string
+	}
+	var checks []check
+	switch x := value.(type) {
+	case api.Project:
+		checks = []check{{"project state", projectStateStyles, x.State}, {"health", healthStyles, x.Health}}
+	case api.ProjectUpdate:
+		checks = []check{{"health", healthStyles, x.Health}}
+	case api.Initiative:
+		checks = []check{{"initiative status", initiativeStatusStyles, x.Status}, {"health", healthStyles, x.Health}}
+	case api.State:
+		checks = []check{{"issue state type", issueStateTypeStyles, x.Type}}
+	case api.WorkflowState:
+		checks = []check{{"issue state type", issueStateTypeStyles, x.Type}}
+	case api.ProjectMilestone:
+		checks = []check{{"milestone status", milestoneStatusStyles, strings.ToLower(x.Status)}}
+	case api.Favorite:
+		checks = []check{{"favorite type", favoriteTypeStyles, x.Type}}
+	}
+	for _, c := range checks {
+		if err := checkSchemaValue(c.entity, c.styles, c.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// styleFor returns the style for value, falling back to a neutral style that
+// shows the raw value. With --strict-schema an unknown value is fatal.
+func styleFor(entity string, styles map[string]valueStyle, value string) valueStyle {
+	if err := checkSchemaValue(entity, styles, value); err != nil {
+		output.Error(fmt.Sprintf("%v (--strict-schema)", err), viper.GetBool("plaintext"), viper.GetBool("json"))
+		exit(1)
+	}
+
+	style, ok := styles[value]
+	if !ok {
+		style = neutralStyle
+	}
+	if style.Label == "" {
+		style.Label = value
+	}
+	if style.Icon == "" {
+		style.Icon = neutralStyle.Icon
+	}
+	if style.Checkbox == "" {
+		style.Checkbox = neutralStyle.Checkbox
+	}
+	if style.Fill == "" {
+		style.Fill = neutralStyle.Fill
+	}
+	return style
+}
+
+// projectStateText renders a colored project state
+func projectStateText(state string) string {
+	return styleFor("project state", projectStateStyles, state).Sprint()
+}
+
+// formatHealth renders a colored health label ("On Track", ...)
+func formatHealth(health string) string {
+	return styleFor("health", healthStyles, health).Sprint()
+}
+
+// isValidHealth reports whether health is an accepted input value
+func isValidHealth(health string) bool {
+	_, ok := healthStyles[health]
+	return ok
+}
+
+// issueStateStyle returns the style for a workflow state's type
+func issueStateStyle(state *api.State) valueStyle {
+	if state == nil {
+		return styleFor("issue state type", issueStateTypeStyles, "")
+	}
+	return styleFor("issue state type", issueStateTypeStyles, state.Type)
+}
+
+// colorizeState returns a colorized state name
+func colorizeState(state *api.State) string {
+	if state == nil {
+		return ""
+	}
+	return issueStateStyle(state).Color().Sprint(state.Name)
+}
+
+// issueStateIcon returns a colored ✓/◐/✗/○ for a workflow state
+func issueStateIcon(state *api.State) string {
+	style := issueStateStyle(state)
+	if state == nil || style.Icon == neutralStyle.Icon {
+		return neutralStyle.Icon
+	}
+	return style.Color().Sprint(style.Icon)
+}
+
+// issueStateCheckbox returns a markdown task checkbox for a workflow state:
+// [x] once the issue is closed (completed or canceled), [ ] otherwise
+func issueStateCheckbox(state *api.State) string {
+	return issueStateStyle(state).Checkbox
+}
+
+// initiativeStatusText renders a colored initiative status
+func initiativeStatusText(status string) string {
+	return styleFor("initiative status", initiativeStatusStyles, status).Sprint()
+}
+
+// milestoneStatusColor returns the color for a milestone status
+func milestoneStatusColor(status string) *color.Color {
+	return styleFor("milestone status", milestoneStatusStyles, strings.ToLower(status)).Color()
+}
+
+// getTypeColor returns the color for a favorite type
+func getTypeColor(favType string) *color.Color {
+	return styleFor("favorite type", favoriteTypeStyles, favType).Color()
+}
+
+// getTypeIcon returns the icon for a favorite type
+func getTypeIcon(favType string) string {
+	style := styleFor("favorite type", favoriteTypeStyles, favType)
+	if _, ok := favoriteTypeStyles[favType]; !ok {
+		return "⭐"
+	}
+	return style.Icon
+}
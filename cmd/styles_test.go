@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+func TestRenderersDegradeOnUnknownValues(t *testing.T) {
+	prev := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = prev }()
+
+	tests := []struct {
+		name   string
+		render func(string) string
+		value  string
+		want   string
+	}{
+		{"project state known", projectStateText, "started", "started"},
+		{"project state unknown", projectStateText, "archivedForever", "archivedForever"},
+		{"health known", formatHealth, "atRisk", "At Risk"},
+		{"health unknown", formatHealth, "wobbly", "wobbly"},
+		{"health empty", formatHealth, "", ""},
+		{"initiative status unknown", initiativeStatusText, "Paused", "Paused"},
+		{"issue state unknown", func(v string) string { return colorizeState(&api.State{Name: "Review", Type: v}) }, "inReview", "Review"},
+		{"issue state checkbox unknown", func(v string) string { return issueStateCheckbox(&api.State{Type: v}) }, "inReview", "[ ]"},
+		{"issue state checkbox nil", func(string) string { return issueStateCheckbox(nil) }, "", "[ ]"},
+		{"issue state icon unknown", func(v string) string { return issueStateIcon(&api.State{Type: v}) }, "inReview", "○"},
+		{"issue state icon known", func(v string) string { return issueStateIcon(&api.State{Type: v}) }, "completed", "✓"},
+		{"milestone status unknown", func(v string) string { return milestoneStatusColor(v).Sprint(v) }, "blocked", "blocked"},
+		{"favorite type icon unknown", getTypeIcon, "dashboard", "⭐"},
+		{"favorite type color unknown", func(v string) string { return getTypeColor(v).Sprint(v) }, "dashboard", "dashboard"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.render(tt.value); got != tt.want {
+				t.Errorf("render(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSchemaValueStrict(t *testing.T) {
+	viper.Set("strict_schema", true)
+	// nil drops the override, so --strict-schema works again in later tests
+	defer viper.Set("strict_schema", nil)
+
+	styleMaps := map[string]map[string]valueStyle{
+		"project state":     projectStateStyles,
+		"health":            healthStyles,
+		"issue state type":  issueStateTypeStyles,
+		"initiative status": initiativeStatusStyles,
+		"milestone status":  milestoneStatusStyles,
+		"favorite type":     favoriteTypeStyles,
+	}
+	for entity, styles := range styleMaps {
+		if err := checkSchemaValue(entity, styles, "somethingNew"); err == nil {
+			t.Errorf("%s: expected error for unknown value in strict mode", entity)
+		}
+		if err := checkSchemaValue(entity, styles, ""); err != nil {
+			t.Errorf("%s: empty value should not be an error: %v", entity, err)
+		}
+		for known := range styles {
+			if err := checkSchemaValue(entity, styles, known); err != nil {
+				t.Errorf("%s: known value %q rejected: %v", entity, known, err)
+			}
+		}
+	}
+
+	viper.Set("strict_schema", false)
+	if err := checkSchemaValue("health", healthStyles, "somethingNew"); err != nil {
+		t.Errorf("non-strict mode should accept unknown values: %v", err)
+	}
+}
+
+func TestHermeticStrictSchemaEveryMode(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Projects", `{"projects":{"nodes":[
+		{"id":"p1","name":"Launch","state":"started","health":"wobbly",
+		 "projectMilestones":{"nodes":[{"id":"m1","name":"Beta","status":"next"}]}}
+	],"pageInfo":{"hasNextPage":false}}}`)
+
+	for _, mode := range []string{"--json", "--plaintext", "--no-color"} {
+		r := runMocked(t, "project", "list", "--all-time", "--strict-schema", mode)
+		if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "unknown health value") {
+			t.Errorf("%s exited %d: %s%s", mode, r.Exit, r.Stdout, r.Stderr)
+		}
+		if strings.Contains(r.Stdout, "Launch") {
+			t.Errorf("%s rendered the project: %s", mode, r.Stdout)
+		}
+	}
+
+	// Without the flag the raw value is shown
+	r := runMocked(t, "project", "list", "--all-time", "--json")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "wobbly") {
+		t.Errorf("non-strict exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
+
+func TestCheckSchemaNested(t *testing.T) {
+	viper.Set("strict_schema", true)
+	// nil drops the override, so --strict-schema works again in later tests
+	defer viper.Set("strict_schema", nil)
+
+	ok := &api.Issues{Nodes: []api.Issue{{State: &api.State{Type: "started"}}}}
+	if err := checkSchema(ok); err != nil {
+		t.Errorf("known values rejected: %v", err)
+	}
+	bad := &api.Issues{Nodes: []api.Issue{{State: &api.State{Type: "started"}}, {State: &api.State{Type: "inReview"}}}}
+	if err := checkSchema(bad); err == nil || !strings.Contains(err.Error(), `"inReview"`) {
+		t.Errorf("nested unknown state type = %v", err)
+	}
+	milestones := &api.ProjectMilestones{Nodes: []api.ProjectMilestone{{Status: "Overdue"}}}
+	if err := checkSchema(milestones); err != nil {
+		t.Errorf("milestone status is matched ignoring case: %v", err)
+	}
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
@@ -33,7 +32,21 @@ var teamListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List teams",
-	Long:    `List all teams in your Linear workspace.`,
+	Long: `List all teams in your Linear workspace.
+
+--tree nests sub-teams under their parent teams: indented keys in the table,
+an indented markdown list with --plaintext, and a "subTeams" array on each
+team with --json. --aggregate adds sub-teams' issues into each parent's Issues
+count (totalIssueCount in JSON). A team whose parent is past --limit is shown
+at the top level, with a note on stderr.
+
+--parent lists only a team's sub-teams; with --tree, everything below it.
+
+Examples:
+  linear-cli team list
+  linear-cli team list --tree --aggregate
+  linear-cli team list --parent ENG
+  linear-cli team list --parent ENG --tree --json`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -42,65 +55,78 @@ var teamListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
+		tree, _ := cmd.Flags().GetBool("tree")
+		aggregate, _ := cmd.Flags().GetBool("aggregate")
+		if aggregate && !tree {
+			output.Error("--aggregate needs --tree", plaintext, jsonOut)
+			exit(1)
+		}
+
 		// Get limit
 		limit, _ := cmd.Flags().GetInt("limit")
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		// Get teams
 		teams, err := client.GetTeams(context.Background(), limit, "", orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
+		}
+		warnIfTruncated(cmd, teams.PageInfo, len(teams.Nodes))
+
+		nodes := teams.Nodes
+		rootID := ""
+		if parentRef, _ := cmd.Flags().GetString("parent"); parentRef != "" {
+			parent, err := findListedTeam(client, teams.Nodes, parentRef)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut, err)
+				exit(1)
+			}
+			rootID = parent.ID
+			nodes = teamDescendants(teams.Nodes, parent.ID, tree)
+		}
+		if tree {
+			roots, unplaced := buildTeamTree(nodes, rootID)
+			if aggregate {
+				for _, root := range roots {
+					root.aggregate()
+				}
+			}
+			renderTeamTree(roots, len(nodes), plaintext, jsonOut)
+			noteUnplacedTeams(unplaced)
+			return
 		}
 
 		// Handle output
 		if jsonOut {
-			output.JSON(teams.Nodes)
+			output.JSON(nodes)
 		} else if plaintext {
 			fmt.Println("Key\tName\tDescription\tPrivate\tCycles\tTriage\tTimezone\tIssues")
-			for _, team := range teams.Nodes {
+			for _, team := range nodes {
 				description := team.Description
 				if len(description) > 50 {
 					description = description[:47] + "..."
 				}
-				cyclesStr := "No"
-				if team.CyclesEnabled {
-					cyclesStr = "Yes"
-				}
-				triageStr := "No"
-				if team.TriageEnabled {
-					triageStr = "Yes"
-				}
 				fmt.Printf("%s\t%s\t%s\t%v\t%s\t%s\t%s\t%d\n",
 					team.Key,
 					team.Name,
 					description,
 					team.Private,
-					cyclesStr,
-					triageStr,
+					yesNo(team.CyclesEnabled),
+					yesNo(team.TriageEnabled),
 					team.Timezone,
 					team.IssueCount,
 				)
@@ -110,38 +136,8 @@ var teamListCmd = &cobra.Command{
 			headers := []string{"Key", "Name", "Description", "Private", "Cycles", "Triage", "Issues"}
 			rows := [][]string{}
 
-			for _, team := range teams.Nodes {
-				description := team.Description
-				if len(description) > 30 {
-					description = description[:27] + "..."
-				}
-
-				privateStr := ""
-				if team.Private {
-					privateStr = color.New(color.FgYellow).Sprint("🔒")
-				} else {
-					privateStr = color.New(color.FgGreen).Sprint("○")
-				}
-
-				cyclesStr := color.New(color.FgRed).Sprint("○")
-				if team.CyclesEnabled {
-					cyclesStr = color.New(color.FgGreen).Sprint("●")
-				}
-
-				triageStr := color.New(color.FgRed).Sprint("○")
-				if team.TriageEnabled {
-					triageStr = color.New(color.FgGreen).Sprint("●")
-				}
-
-				rows = append(rows, []string{
-					color.New(color.FgCyan, color.Bold).Sprint(team.Key),
-					team.Name,
-					description,
-					privateStr,
-					cyclesStr,
-					triageStr,
-					fmt.Sprintf("%d", team.IssueCount),
-				})
+			for _, team := range nodes {
+				rows = append(rows, teamTableRow(team, "", team.IssueCount))
 			}
 
 			output.Table(output.TableData{
@@ -152,28 +148,80 @@ var teamListCmd = &cobra.Command{
 			if !plaintext && !jsonOut {
 				fmt.Printf("\n%s %d teams\n",
 					color.New(color.FgGreen).Sprint("✓"),
-					len(teams.Nodes))
+					len(nodes))
 			}
 		}
 	},
 }
 
+// teamTableRow is one team's row in the team list table, its key after
+// indent and issues as the Issues column
+func teamTableRow(team api.Team, indent string, issues int) []string {
+	description := team.Description
+	if len(description) > 30 {
+		description = description[:27] + "..."
+	}
+
+	privateStr := ""
+	if team.Private {
+		privateStr = color.New(color.FgYellow).Sprint("🔒")
+	} else {
+		privateStr = color.New(color.FgGreen).Sprint("○")
+	}
+
+	cyclesStr := color.New(color.FgRed).Sprint("○")
+	if team.CyclesEnabled {
+		cyclesStr = color.New(color.FgGreen).Sprint("●")
+	}
+
+	triageStr := color.New(color.FgRed).Sprint("○")
+	if team.TriageEnabled {
+		triageStr = color.New(color.FgGreen).Sprint("●")
+	}
+
+	return []string{
+		indent + color.New(color.FgCyan, color.Bold).Sprint(team.Key),
+		team.Name,
+		description,
+		privateStr,
+		cyclesStr,
+		triageStr,
+		fmt.Sprintf("%d", issues),
+	}
+}
+
 var teamGetCmd = &cobra.Command{
-	Use:     "get TEAM-KEY",
+	Use:     "get TEAM",
 	Aliases: []string{"show"},
 	Short:   "Get team details",
-	Long:    `Get detailed information about a specific team.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a specific team.
+
+The team can be given by UUID, key, or name. A name must match exactly one
+team (ignoring case); when several teams share it, use the key instead.
+
+--fields trims --json output to the given comma-separated dot-paths, e.g.
+states.nodes.name; arrays are filtered element by element. Paths that
+aren't in the output are left out, or fail the command with --strict-fields.
+
+Examples:
+  linear-cli team get ENG
+  linear-cli team get ENG --json --fields key,name,timezone`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 		teamKey := args[0]
+		fields, err := jsonFieldsFromFlags(cmd, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -182,13 +230,13 @@ var teamGetCmd = &cobra.Command{
 		// Get team details
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut, err)
+			exit(1)
 		}
 
 		// Handle output
 		if jsonOut {
-			output.JSON(team)
+			fields.print(team, plaintext, jsonOut)
 		} else if plaintext {
 			fmt.Printf("Key: %s\n", team.Key)
 			fmt.Printf("Name: %s\n", team.Name)
@@ -345,6 +393,7 @@ var teamGetCmd = &cobra.Command{
 			if team.DefaultProjectTemplate != nil {
 				fmt.Printf("  %s %s\n", color.New(color.Bold).Sprint("Project Template:"), team.DefaultProjectTemplate.Name)
 			}
+			fmt.Printf("  %s\n", color.New(color.FgWhite, color.Faint).Sprintf("Templates: linear-cli template list --team %s", team.Key))
 
 			// Automation section
 			fmt.Printf("\n%s\n", color.New(color.Bold, color.FgYellow).Sprint("Automation"))
@@ -383,7 +432,7 @@ var teamMembersCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -393,7 +442,7 @@ var teamMembersCmd = &cobra.Command{
 		members, err := client.GetTeamMembers(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Handle output
@@ -458,6 +507,36 @@ var teamMembersCmd = &cobra.Command{
 	},
 }
 
+// teamTemplateFlags maps the default template flags to their input field and template type
+var teamTemplateFlags = []struct {
+	flag, field, templateType string
+}{
+	{"default-template-for-members", "defaultTemplateForMembersId", "issue"},
+	{"default-template-for-non-members", "defaultTemplateForNonMembersId", "issue"},
+	{"default-project-template", "defaultProjectTemplateId", "project"},
+}
+
+// applyTeamTemplateFlags resolves the default template flags (ID or name) into input.
+// Unknown template names produce an error listing the valid templates.
+func applyTeamTemplateFlags(cmd *cobra.Command, client *api.Client, input map[string]interface{}, teamKey string) error {
+	for _, tf := range teamTemplateFlags {
+		if !cmd.Flags().Changed(tf.flag) {
+			continue
+		}
+		v, _ := cmd.Flags().GetString(tf.flag)
+		if v == "" {
+			input[tf.field] = v
+			continue
+		}
+		id, err := resolveTemplateID(client, v, tf.templateType, teamKey)
+		if err != nil {
+			return fmt.Errorf("Invalid --%s: %v", tf.flag, err)
+		}
+		input[tf.field] = id
+	}
+	return nil
+}
+
 var teamCreateCmd = &cobra.Command{
 	Use:     "create",
 	Aliases: []string{"new"},
@@ -476,7 +555,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -490,8 +569,12 @@ Examples:
 			key, _ := cmd.Flags().GetString("key")
 			input["key"] = key
 		}
-		if cmd.Flags().Changed("description") {
-			desc, _ := cmd.Flags().GetString("description")
+		desc, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input["description"] = desc
 		}
 		if cmd.Flags().Changed("color") {
@@ -502,22 +585,12 @@ Examples:
 			icon, _ := cmd.Flags().GetString("icon")
 			input["icon"] = icon
 		}
-		if cmd.Flags().Changed("private") {
-			private, _ := cmd.Flags().GetBool("private")
-			input["private"] = private
-		}
-		if cmd.Flags().Changed("cycles-enabled") {
-			cycles, _ := cmd.Flags().GetBool("cycles-enabled")
-			input["cyclesEnabled"] = cycles
-		}
-		if cmd.Flags().Changed("triage-enabled") {
-			triage, _ := cmd.Flags().GetBool("triage-enabled")
-			input["triageEnabled"] = triage
-		}
 		if cmd.Flags().Changed("timezone") {
 			tz, _ := cmd.Flags().GetString("timezone")
 			input["timezone"] = tz
 		}
+		// Boolean settings, including --flag=false and --no-flag
+		applyToggleFlags(cmd, input, teamToggles)
 		// Cycle settings
 		if cmd.Flags().Changed("cycle-start-day") {
 			v, _ := cmd.Flags().GetFloat64("cycle-start-day")
@@ -531,44 +604,15 @@ Examples:
 			v, _ := cmd.Flags().GetInt("cycle-cooldown")
 			input["cycleCooldownTime"] = v
 		}
-		if cmd.Flags().Changed("cycle-auto-assign-started") {
-			v, _ := cmd.Flags().GetBool("cycle-auto-assign-started")
-			input["cycleIssueAutoAssignStarted"] = v
-		}
-		if cmd.Flags().Changed("cycle-auto-assign-completed") {
-			v, _ := cmd.Flags().GetBool("cycle-auto-assign-completed")
-			input["cycleIssueAutoAssignCompleted"] = v
-		}
-		if cmd.Flags().Changed("cycle-lock-to-active") {
-			v, _ := cmd.Flags().GetBool("cycle-lock-to-active")
-			input["cycleLockToActive"] = v
-		}
 		if cmd.Flags().Changed("upcoming-cycle-count") {
 			v, _ := cmd.Flags().GetFloat64("upcoming-cycle-count")
 			input["upcomingCycleCount"] = v
 		}
-		// Triage settings
-		if cmd.Flags().Changed("require-priority-to-leave-triage") {
-			v, _ := cmd.Flags().GetBool("require-priority-to-leave-triage")
-			input["requirePriorityToLeaveTriage"] = v
-		}
 		// Issue estimation settings
-		if cmd.Flags().Changed("inherit-issue-estimation") {
-			v, _ := cmd.Flags().GetBool("inherit-issue-estimation")
-			input["inheritIssueEstimation"] = v
-		}
 		if cmd.Flags().Changed("issue-estimation-type") {
 			v, _ := cmd.Flags().GetString("issue-estimation-type")
 			input["issueEstimationType"] = v
 		}
-		if cmd.Flags().Changed("issue-estimation-allow-zero") {
-			v, _ := cmd.Flags().GetBool("issue-estimation-allow-zero")
-			input["issueEstimationAllowZero"] = v
-		}
-		if cmd.Flags().Changed("issue-estimation-extended") {
-			v, _ := cmd.Flags().GetBool("issue-estimation-extended")
-			input["issueEstimationExtended"] = v
-		}
 		if cmd.Flags().Changed("default-issue-estimate") {
 			v, _ := cmd.Flags().GetFloat64("default-issue-estimate")
 			input["defaultIssueEstimate"] = v
@@ -577,27 +621,10 @@ Examples:
 			v, _ := cmd.Flags().GetString("set-issue-sort-order-on-state-change")
 			input["setIssueSortOrderOnStateChange"] = v
 		}
-		// Workflow settings
-		if cmd.Flags().Changed("group-issue-history") {
-			v, _ := cmd.Flags().GetBool("group-issue-history")
-			input["groupIssueHistory"] = v
-		}
-		if cmd.Flags().Changed("inherit-workflow-statuses") {
-			v, _ := cmd.Flags().GetBool("inherit-workflow-statuses")
-			input["inheritWorkflowStatuses"] = v
-		}
 		// Template settings
-		if cmd.Flags().Changed("default-template-for-members") {
-			v, _ := cmd.Flags().GetString("default-template-for-members")
-			input["defaultTemplateForMembersId"] = v
-		}
-		if cmd.Flags().Changed("default-template-for-non-members") {
-			v, _ := cmd.Flags().GetString("default-template-for-non-members")
-			input["defaultTemplateForNonMembersId"] = v
-		}
-		if cmd.Flags().Changed("default-project-template") {
-			v, _ := cmd.Flags().GetString("default-project-template")
-			input["defaultProjectTemplateId"] = v
+		if err := applyTeamTemplateFlags(cmd, client, input, ""); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 		// Auto-close/archive settings
 		if cmd.Flags().Changed("auto-close-period") {
@@ -627,8 +654,9 @@ Examples:
 		team, err := client.CreateTeam(context.Background(), input, copyFrom)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "team", team.ID, team.Key, nil)
 
 		if jsonOut {
 			output.JSON(team)
@@ -648,10 +676,15 @@ var teamUpdateCmd = &cobra.Command{
 	Short:   "Update a team",
 	Long: `Update a team's settings.
 
+Boolean settings are only changed when their flag is given: --private turns
+one on, and --private=false turns it off. --no-private, --no-cycles-enabled,
+and --no-triage-enabled are shorthands for the false form.
+
 Examples:
   linear-cli team update ENG --name "Engineering Team"
   linear-cli team update ENG --description "Updated description"
   linear-cli team update ENG --cycles-enabled --triage-enabled
+  linear-cli team update ENG --no-private --cycle-lock-to-active=false
   linear-cli team update ENG --color "#FF5733"`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -662,7 +695,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -670,8 +703,8 @@ Examples:
 		// First, get the team ID from the key
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to find team: %v", err), plaintext, jsonOut, err)
+			exit(1)
 		}
 
 		input := map[string]interface{}{}
@@ -683,8 +716,12 @@ Examples:
 			key, _ := cmd.Flags().GetString("key")
 			input["key"] = key
 		}
-		if cmd.Flags().Changed("description") {
-			desc, _ := cmd.Flags().GetString("description")
+		desc, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input["description"] = desc
 		}
 		if cmd.Flags().Changed("color") {
@@ -695,22 +732,12 @@ Examples:
 			icon, _ := cmd.Flags().GetString("icon")
 			input["icon"] = icon
 		}
-		if cmd.Flags().Changed("private") {
-			private, _ := cmd.Flags().GetBool("private")
-			input["private"] = private
-		}
-		if cmd.Flags().Changed("cycles-enabled") {
-			cycles, _ := cmd.Flags().GetBool("cycles-enabled")
-			input["cyclesEnabled"] = cycles
-		}
-		if cmd.Flags().Changed("triage-enabled") {
-			triage, _ := cmd.Flags().GetBool("triage-enabled")
-			input["triageEnabled"] = triage
-		}
 		if cmd.Flags().Changed("timezone") {
 			tz, _ := cmd.Flags().GetString("timezone")
 			input["timezone"] = tz
 		}
+		// Boolean settings, including --flag=false and --no-flag
+		applyToggleFlags(cmd, input, teamToggles)
 		// Cycle settings
 		if cmd.Flags().Changed("cycle-start-day") {
 			v, _ := cmd.Flags().GetFloat64("cycle-start-day")
@@ -724,44 +751,15 @@ Examples:
 			v, _ := cmd.Flags().GetInt("cycle-cooldown")
 			input["cycleCooldownTime"] = v
 		}
-		if cmd.Flags().Changed("cycle-auto-assign-started") {
-			v, _ := cmd.Flags().GetBool("cycle-auto-assign-started")
-			input["cycleIssueAutoAssignStarted"] = v
-		}
-		if cmd.Flags().Changed("cycle-auto-assign-completed") {
-			v, _ := cmd.Flags().GetBool("cycle-auto-assign-completed")
-			input["cycleIssueAutoAssignCompleted"] = v
-		}
-		if cmd.Flags().Changed("cycle-lock-to-active") {
-			v, _ := cmd.Flags().GetBool("cycle-lock-to-active")
-			input["cycleLockToActive"] = v
-		}
 		if cmd.Flags().Changed("upcoming-cycle-count") {
 			v, _ := cmd.Flags().GetFloat64("upcoming-cycle-count")
 			input["upcomingCycleCount"] = v
 		}
-		// Triage settings
-		if cmd.Flags().Changed("require-priority-to-leave-triage") {
-			v, _ := cmd.Flags().GetBool("require-priority-to-leave-triage")
-			input["requirePriorityToLeaveTriage"] = v
-		}
 		// Issue estimation settings
-		if cmd.Flags().Changed("inherit-issue-estimation") {
-			v, _ := cmd.Flags().GetBool("inherit-issue-estimation")
-			input["inheritIssueEstimation"] = v
-		}
 		if cmd.Flags().Changed("issue-estimation-type") {
 			v, _ := cmd.Flags().GetString("issue-estimation-type")
 			input["issueEstimationType"] = v
 		}
-		if cmd.Flags().Changed("issue-estimation-allow-zero") {
-			v, _ := cmd.Flags().GetBool("issue-estimation-allow-zero")
-			input["issueEstimationAllowZero"] = v
-		}
-		if cmd.Flags().Changed("issue-estimation-extended") {
-			v, _ := cmd.Flags().GetBool("issue-estimation-extended")
-			input["issueEstimationExtended"] = v
-		}
 		if cmd.Flags().Changed("default-issue-estimate") {
 			v, _ := cmd.Flags().GetFloat64("default-issue-estimate")
 			input["defaultIssueEstimate"] = v
@@ -770,27 +768,10 @@ Examples:
 			v, _ := cmd.Flags().GetString("set-issue-sort-order-on-state-change")
 			input["setIssueSortOrderOnStateChange"] = v
 		}
-		// Workflow settings
-		if cmd.Flags().Changed("group-issue-history") {
-			v, _ := cmd.Flags().GetBool("group-issue-history")
-			input["groupIssueHistory"] = v
-		}
-		if cmd.Flags().Changed("inherit-workflow-statuses") {
-			v, _ := cmd.Flags().GetBool("inherit-workflow-statuses")
-			input["inheritWorkflowStatuses"] = v
-		}
 		// Template settings
-		if cmd.Flags().Changed("default-template-for-members") {
-			v, _ := cmd.Flags().GetString("default-template-for-members")
-			input["defaultTemplateForMembersId"] = v
-		}
-		if cmd.Flags().Changed("default-template-for-non-members") {
-			v, _ := cmd.Flags().GetString("default-template-for-non-members")
-			input["defaultTemplateForNonMembersId"] = v
-		}
-		if cmd.Flags().Changed("default-project-template") {
-			v, _ := cmd.Flags().GetString("default-project-template")
-			input["defaultProjectTemplateId"] = v
+		if err := applyTeamTemplateFlags(cmd, client, input, team.Key); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 		// Auto-close/archive settings
 		if cmd.Flags().Changed("auto-close-period") {
@@ -805,14 +786,6 @@ Examples:
 			v, _ := cmd.Flags().GetFloat64("auto-archive-period")
 			input["autoArchivePeriod"] = v
 		}
-		if cmd.Flags().Changed("auto-close-parent-issues") {
-			v, _ := cmd.Flags().GetBool("auto-close-parent-issues")
-			input["autoCloseParentIssues"] = v
-		}
-		if cmd.Flags().Changed("auto-close-child-issues") {
-			v, _ := cmd.Flags().GetBool("auto-close-child-issues")
-			input["autoCloseChildIssues"] = v
-		}
 		if cmd.Flags().Changed("marked-as-duplicate-state") {
 			v, _ := cmd.Flags().GetString("marked-as-duplicate-state")
 			input["markedAsDuplicateWorkflowStateId"] = v
@@ -827,52 +800,18 @@ Examples:
 			v, _ := cmd.Flags().GetString("parent")
 			input["parentId"] = v
 		}
-		// Access/membership settings
-		if cmd.Flags().Changed("join-by-default") {
-			v, _ := cmd.Flags().GetBool("join-by-default")
-			input["joinByDefault"] = v
-		}
-		if cmd.Flags().Changed("all-members-can-join") {
-			v, _ := cmd.Flags().GetBool("all-members-can-join")
-			input["allMembersCanJoin"] = v
-		}
-		if cmd.Flags().Changed("scim-managed") {
-			v, _ := cmd.Flags().GetBool("scim-managed")
-			input["scimManaged"] = v
-		}
-		// AI settings
-		if cmd.Flags().Changed("ai-thread-summaries") {
-			v, _ := cmd.Flags().GetBool("ai-thread-summaries")
-			input["aiThreadSummariesEnabled"] = v
-		}
-		if cmd.Flags().Changed("ai-discussion-summaries") {
-			v, _ := cmd.Flags().GetBool("ai-discussion-summaries")
-			input["aiDiscussionSummariesEnabled"] = v
-		}
-		// Slack settings
-		if cmd.Flags().Changed("slack-new-issue") {
-			v, _ := cmd.Flags().GetBool("slack-new-issue")
-			input["slackNewIssue"] = v
-		}
-		if cmd.Flags().Changed("slack-issue-comments") {
-			v, _ := cmd.Flags().GetBool("slack-issue-comments")
-			input["slackIssueComments"] = v
-		}
-		if cmd.Flags().Changed("slack-issue-statuses") {
-			v, _ := cmd.Flags().GetBool("slack-issue-statuses")
-			input["slackIssueStatuses"] = v
-		}
 
 		if len(input) == 0 {
 			output.Error("No fields to update. Use --help to see available options.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		updatedTeam, err := client.UpdateTeam(context.Background(), team.ID, input)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to update team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to update team: %v", explainToggleRejection(err, input)), plaintext, jsonOut)
+			exit(1)
 		}
+		recordOperation(cmd, "team", updatedTeam.ID, updatedTeam.Key, nil)
 
 		if jsonOut {
 			output.JSON(updatedTeam)
@@ -903,7 +842,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -911,15 +850,16 @@ Examples:
 		// First, get the team ID from the key
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to find team: %v", err), plaintext, jsonOut, err)
+			exit(1)
 		}
 
 		err = client.DeleteTeam(context.Background(), team.ID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "team", team.ID, team.Key, nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{
@@ -948,7 +888,7 @@ Useful for discovering valid values for the --state flag on issue commands.`,
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -956,7 +896,7 @@ Useful for discovering valid values for the --state flag on issue commands.`,
 		states, err := client.GetTeamStates(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -971,24 +911,10 @@ Useful for discovering valid values for the --state flag on issue commands.`,
 			rows := [][]string{}
 
 			for _, s := range states {
-				typeColor := color.New(color.FgWhite)
-				switch s.Type {
-				case "triage":
-					typeColor = color.New(color.FgMagenta)
-				case "backlog":
-					typeColor = color.New(color.FgCyan)
-				case "unstarted":
-					typeColor = color.New(color.FgWhite)
-				case "started":
-					typeColor = color.New(color.FgYellow)
-				case "completed":
-					typeColor = color.New(color.FgGreen)
-				case "canceled":
-					typeColor = color.New(color.FgRed)
-				}
+				typeColor := styleFor("issue state type", issueStateTypeStyles, s.Type).Color()
 
 				rows = append(rows, []string{
-					typeColor.Sprint(s.Name),
+					output.ColorChip(s.Name, s.Color, typeColor),
 					typeColor.Sprint(s.Type),
 					s.Color,
 				})
@@ -1022,11 +948,17 @@ func init() {
 	// List command flags
 	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+	teamListCmd.Flags().Bool("tree", false, "Nest sub-teams under their parent teams")
+	teamListCmd.Flags().Bool("aggregate", false, "With --tree, count sub-teams' issues in their parents' Issues column")
+	teamListCmd.Flags().String("parent", "", "List only the sub-teams of this team (key, name, or ID)")
+
+	addFieldsFlags(teamGetCmd)
 
 	// Create command flags - basic settings
 	teamCreateCmd.Flags().StringP("name", "n", "", "Team name (required)")
 	teamCreateCmd.Flags().StringP("key", "k", "", "Team identifier key (auto-generated from name if omitted)")
 	teamCreateCmd.Flags().StringP("description", "d", "", "Team description")
+	teamCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	teamCreateCmd.Flags().StringP("color", "c", "", "Team color (hex, e.g., #4285F4)")
 	teamCreateCmd.Flags().String("icon", "", "Team icon")
 	teamCreateCmd.Flags().Bool("private", false, "Make the team private")
@@ -1062,9 +994,9 @@ func init() {
 	teamCreateCmd.Flags().Bool("inherit-workflow-statuses", false, "Inherit workflow statuses from parent team")
 
 	// Create command flags - template settings
-	teamCreateCmd.Flags().String("default-template-for-members", "", "Default issue template ID for team members")
-	teamCreateCmd.Flags().String("default-template-for-non-members", "", "Default issue template ID for non-members")
-	teamCreateCmd.Flags().String("default-project-template", "", "Default project template ID")
+	teamCreateCmd.Flags().String("default-template-for-members", "", "Default issue template ID or name for team members")
+	teamCreateCmd.Flags().String("default-template-for-non-members", "", "Default issue template ID or name for non-members")
+	teamCreateCmd.Flags().String("default-project-template", "", "Default project template ID or name")
 
 	// Create command flags - auto-close/archive settings
 	teamCreateCmd.Flags().Float64("auto-close-period", 0, "Auto-close period in months (0 to disable)")
@@ -1076,14 +1008,17 @@ func init() {
 	teamUpdateCmd.Flags().StringP("name", "n", "", "New team name")
 	teamUpdateCmd.Flags().StringP("key", "k", "", "New team identifier key")
 	teamUpdateCmd.Flags().StringP("description", "d", "", "New team description")
+	teamUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	teamUpdateCmd.Flags().StringP("color", "c", "", "New team color (hex)")
 	teamUpdateCmd.Flags().String("icon", "", "New team icon")
 	teamUpdateCmd.Flags().Bool("private", false, "Set team visibility to private")
+	addNegatedFlag(teamUpdateCmd, "private", "Make the team visible to the whole workspace (same as --private=false)")
 	teamUpdateCmd.Flags().String("timezone", "", "New team timezone")
 	teamUpdateCmd.Flags().String("parent", "", "Parent team ID for team hierarchy")
 
 	// Update command flags - cycle settings
 	teamUpdateCmd.Flags().Bool("cycles-enabled", false, "Enable/disable cycles")
+	addNegatedFlag(teamUpdateCmd, "cycles-enabled", "Disable cycles (same as --cycles-enabled=false)")
 	teamUpdateCmd.Flags().Float64("cycle-start-day", 0, "Day of week cycles start (0=Sunday, 1=Monday, etc.)")
 	teamUpdateCmd.Flags().Int("cycle-duration", 1, "Cycle duration in weeks")
 	teamUpdateCmd.Flags().Int("cycle-cooldown", 0, "Cooldown time between cycles in weeks")
@@ -1094,6 +1029,7 @@ func init() {
 
 	// Update command flags - triage settings
 	teamUpdateCmd.Flags().Bool("triage-enabled", false, "Enable/disable triage mode")
+	addNegatedFlag(teamUpdateCmd, "triage-enabled", "Disable triage mode (same as --triage-enabled=false)")
 	teamUpdateCmd.Flags().Bool("require-priority-to-leave-triage", false, "Require priority to be set before leaving triage")
 
 	// Update command flags - issue estimation settings
@@ -1110,9 +1046,9 @@ func init() {
 	teamUpdateCmd.Flags().String("default-issue-state", "", "Default workflow state ID for new issues")
 
 	// Update command flags - template settings
-	teamUpdateCmd.Flags().String("default-template-for-members", "", "Default issue template ID for team members")
-	teamUpdateCmd.Flags().String("default-template-for-non-members", "", "Default issue template ID for non-members")
-	teamUpdateCmd.Flags().String("default-project-template", "", "Default project template ID")
+	teamUpdateCmd.Flags().String("default-template-for-members", "", "Default issue template ID or name for team members")
+	teamUpdateCmd.Flags().String("default-template-for-non-members", "", "Default issue template ID or name for non-members")
+	teamUpdateCmd.Flags().String("default-project-template", "", "Default project template ID or name")
 
 	// Update command flags - auto-close/archive settings
 	teamUpdateCmd.Flags().Float64("auto-close-period", 0, "Auto-close period in months (0 to disable)")
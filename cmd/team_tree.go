@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+)
+
+// teamTreeNode is a team in team list --tree, with its sub-teams nested
+type teamTreeNode struct {
+	api.Team
+	// TotalIssueCount adds up the team's and every sub-team's issues;
+	// only set with --aggregate
+	TotalIssueCount *int            `json:"totalIssueCount,omitempty"`
+	SubTeams        []*teamTreeNode `json:"subTeams,omitempty"`
+}
+
+// buildTeamTree nests teams under their parents, keeping the given order
+// among siblings. Sub-teams of rootID (the --parent team, if any) are the
+// roots. Any other team whose parent isn't among teams, such as one past
+// --limit, also goes at the root and is returned in unplaced.
+func buildTeamTree(teams []api.Team, rootID string) (roots []*teamTreeNode, unplaced []*teamTreeNode) {
+	nodes := make(map[string]*teamTreeNode, len(teams))
+	for _, team := range teams {
+		nodes[team.ID] = &teamTreeNode{Team: team}
+	}
+	for _, team := range teams {
+		node := nodes[team.ID]
+		parent := (*teamTreeNode)(nil)
+		if team.Parent != nil {
+			parent = nodes[team.Parent.ID]
+		}
+		// A parent chain that loops back would never reach the root
+		if parent == nil || teamTreeLoops(nodes, team) {
+			roots = append(roots, node)
+			if team.Parent != nil && team.Parent.ID != rootID {
+				unplaced = append(unplaced, node)
+			}
+			continue
+		}
+		parent.SubTeams = append(parent.SubTeams, node)
+	}
+	return roots, unplaced
+}
+
+// teamTreeLoops reports whether following team's parents leads back to it
+func teamTreeLoops(nodes map[string]*teamTreeNode, team api.Team) bool {
+	seen := map[string]bool{team.ID: true}
+	for parent := team.Parent; parent != nil; {
+		if seen[parent.ID] {
+			return true
+		}
+		seen[parent.ID] = true
+		node, ok := nodes[parent.ID]
+		if !ok {
+			return false
+		}
+		parent = node.Parent
+	}
+	return false
+}
+
+// aggregate sets TotalIssueCount on n and every sub-team, and returns n's
+func (n *teamTreeNode) aggregate() int {
+	total := n.IssueCount
+	for _, sub := range n.SubTeams {
+		total += sub.aggregate()
+	}
+	n.TotalIssueCount = &total
+	return total
+}
+
+// issues is the Issues column for n: the total under --aggregate
+func (n *teamTreeNode) issues() int {
+	if n.TotalIssueCount != nil {
+		return *n.TotalIssueCount
+	}
+	return n.IssueCount
+}
+
+// findListedTeam finds ref among the listed teams by ID or key, or else
+// looks it up, so --parent works with a team past --limit
+func findListedTeam(client *api.Client, teams []api.Team, ref string) (*api.Team, error) {
+	for i := range teams {
+		if teams[i].ID == ref || strings.EqualFold(teams[i].Key, ref) {
+			return &teams[i], nil
+		}
+	}
+	return client.GetTeam(context.Background(), ref)
+}
+
+// teamDescendants returns the teams under parentID in their listed order:
+// its direct sub-teams, or with all every team below it
+func teamDescendants(teams []api.Team, parentID string, all bool) []api.Team {
+	under := map[string]bool{parentID: true}
+	for grew := true; grew; {
+		grew = false
+		for _, team := range teams {
+			if team.Parent == nil || under[team.ID] || (all && !under[team.Parent.ID]) || (!all && team.Parent.ID != parentID) {
+				continue
+			}
+			under[team.ID] = true
+			grew = all
+		}
+	}
+	var kept []api.Team
+	for _, team := range teams {
+		if team.ID != parentID && under[team.ID] {
+			kept = append(kept, team)
+		}
+	}
+	return kept
+}
+
+// renderTeamTree prints the team tree as nested JSON, an indented markdown
+// list, or a table with sub-team keys indented
+func renderTeamTree(roots []*teamTreeNode, count int, plaintext, jsonOut bool) {
+	if jsonOut {
+		if roots == nil {
+			roots = []*teamTreeNode{}
+		}
+		output.JSON(roots)
+		return
+	}
+	if plaintext {
+		var walk func(nodes []*teamTreeNode, depth int)
+		walk = func(nodes []*teamTreeNode, depth int) {
+			for _, n := range nodes {
+				fmt.Printf("%s- **%s** %s (issues: %d, private: %v, cycles: %s, triage: %s)\n",
+					strings.Repeat("  ", depth), n.Key, n.Name, n.issues(), n.Private,
+					yesNo(n.CyclesEnabled), yesNo(n.TriageEnabled))
+				walk(n.SubTeams, depth+1)
+			}
+		}
+		walk(roots, 0)
+		return
+	}
+
+	var rows [][]string
+	var walk func(nodes []*teamTreeNode, depth int)
+	walk = func(nodes []*teamTreeNode, depth int) {
+		for _, n := range nodes {
+			indent := ""
+			if depth > 0 {
+				indent = strings.Repeat("  ", depth-1) + "└ "
+			}
+			rows = append(rows, teamTableRow(n.Team, indent, n.issues()))
+			walk(n.SubTeams, depth+1)
+		}
+	}
+	walk(roots, 0)
+	output.Table(output.TableData{
+		Headers: []string{"Key", "Name", "Description", "Private", "Cycles", "Triage", "Issues"},
+		Rows:    rows,
+	}, false, false)
+	fmt.Printf("\n%s %d teams\n", color.New(color.FgGreen).Sprint("✓"), count)
+}
+
+// noteUnplacedTeams says on stderr which teams are shown at the root only
+// because their parent wasn't listed
+func noteUnplacedTeams(unplaced []*teamTreeNode) {
+	if len(unplaced) == 0 {
+		return
+	}
+	var names []string
+	for _, n := range unplaced {
+		parent := n.Parent.Key
+		if parent == "" {
+			parent = n.Parent.ID
+		}
+		names = append(names, fmt.Sprintf("%s (parent %s)", n.Key, parent))
+	}
+	fmt.Fprintf(os.Stderr, "Note: shown at the top level because their parent team isn't listed (raise --limit to place them): %s\n",
+		strings.Join(names, ", "))
+}
+
+// yesNo formats a setting for plaintext output
+func yesNo(b bool) string {
+	if b {
+		return "Yes"
+	}
+	return "No"
+}
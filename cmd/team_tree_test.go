@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const teamTreeFixture = `{"teams":{"nodes":[
+	{"id":"t1","key":"ENG","name":"Engineering","issueCount":10,"cyclesEnabled":true},
+	{"id":"t2","key":"WEB","name":"Web","issueCount":5,"parent":{"id":"t1","key":"ENG"}},
+	{"id":"t3","key":"CSS","name":"Styles","issueCount":2,"parent":{"id":"t2","key":"WEB"}},
+	{"id":"t4","key":"OPS","name":"Operations","issueCount":7},
+	{"id":"t5","key":"SEC","name":"Security","issueCount":1,"parent":{"id":"t9","key":"IT"}}],
+	"pageInfo":{"hasNextPage":false}}}`
+
+func TestHermeticTeamListTree(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Teams", teamTreeFixture)
+
+	r := runMocked(t, "team", "list", "--tree", "--aggregate", "--plaintext")
+	want := "- **ENG** Engineering (issues: 17, private: false, cycles: Yes, triage: No)\n" +
+		"  - **WEB** Web (issues: 7, private: false, cycles: No, triage: No)\n" +
+		"    - **CSS** Styles (issues: 2, private: false, cycles: No, triage: No)\n" +
+		"- **OPS** Operations (issues: 7, private: false, cycles: No, triage: No)\n" +
+		"- **SEC** Security (issues: 1, private: false, cycles: No, triage: No)\n"
+	if r.Exit != 0 || r.Stdout != want {
+		t.Errorf("--tree --plaintext exited %d:\n%s\nwant:\n%s", r.Exit, r.Stdout, want)
+	}
+	if !strings.Contains(r.Stderr, "SEC (parent IT)") {
+		t.Errorf("no note about the unplaced team: %q", r.Stderr)
+	}
+
+	r = runMocked(t, "team", "list", "--tree", "--json")
+	var roots []struct {
+		Key             string `json:"key"`
+		TotalIssueCount *int   `json:"totalIssueCount"`
+		SubTeams        []struct {
+			Key      string `json:"key"`
+			SubTeams []struct {
+				Key string `json:"key"`
+			} `json:"subTeams"`
+		} `json:"subTeams"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &roots); err != nil || len(roots) != 3 {
+		t.Fatalf("--tree --json = %s (%v)", r.Stdout, err)
+	}
+	if roots[0].SubTeams[0].SubTeams[0].Key != "CSS" || roots[0].TotalIssueCount != nil {
+		t.Errorf("--tree --json = %s", r.Stdout)
+	}
+
+	r = runMocked(t, "team", "list", "--tree")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "└ ") || !strings.Contains(r.Stdout, "5 teams") {
+		t.Errorf("--tree table exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	// --parent keeps to the team's sub-teams, and its children aren't noted
+	r = runMocked(t, "team", "list", "--parent", "eng", "--plaintext")
+	if lines := strings.Split(strings.TrimSpace(r.Stdout), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "WEB\t") {
+		t.Errorf("--parent = %q", r.Stdout)
+	}
+	r = runMocked(t, "team", "list", "--parent", "ENG", "--tree", "--plaintext")
+	if r.Stdout != "- **WEB** Web (issues: 5, private: false, cycles: No, triage: No)\n  - **CSS** Styles (issues: 2, private: false, cycles: No, triage: No)\n" || r.Stderr != "" {
+		t.Errorf("--parent --tree = %q %q", r.Stdout, r.Stderr)
+	}
+
+	if r := runMocked(t, "team", "list", "--aggregate"); r.Exit != 1 {
+		t.Errorf("--aggregate without --tree exited %d", r.Exit)
+	}
+}
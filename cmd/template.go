@@ -0,0 +1,563 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// validTemplateTypes lists the template types accepted by --type
+var validTemplateTypes = []string{"issue", "project", "document"}
+
+var templateCmd = &cobra.Command{
+	Use:     "template",
+	Aliases: []string{"templates", "tpl"},
+	Short:   "Manage issue, project and document templates",
+	Long: `List, inspect, create, update and delete Linear templates.
+
+Template IDs are used by flags such as 'project create --template-id' and
+'team update --default-template-for-members'.
+
+Examples:
+  linear-cli template list
+  linear-cli template list --team ENG --type issue
+  linear-cli template get TEMPLATE-ID
+  linear-cli template create --team ENG --type issue --name "Bug report" --data-file template.json`,
+}
+
+var templateListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List templates",
+	Long: `List templates, optionally filtered by team and type.
+
+Examples:
+  linear-cli template list
+  linear-cli template list --team ENG
+  linear-cli template list --type project --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		templateType, _ := cmd.Flags().GetString("type")
+		if err := validateTemplateType(templateType); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		if teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			teamKey = team.Key
+		}
+
+		templates, err := client.GetTemplates(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list templates: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		templates = filterTemplates(templates, templateType, teamKey)
+
+		if jsonOut {
+			output.JSON(templates)
+			return
+		}
+
+		if len(templates) == 0 {
+			output.Info("No templates found", plaintext, jsonOut)
+			return
+		}
+
+		headers := []string{"Name", "Type", "Team", "Description", "ID"}
+		rows := [][]string{}
+		for _, t := range templates {
+			team := "workspace"
+			if t.Team != nil {
+				team = t.Team.Key
+			}
+			name := t.Name
+			if !plaintext {
+				name = color.New(color.FgWhite, color.Bold).Sprint(t.Name)
+			}
+			rows = append(rows, []string{
+				name,
+				t.Type,
+				team,
+				truncateString(t.Description, 40),
+				t.ID,
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			fmt.Printf("\n%s %d templates\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				len(templates))
+		}
+	},
+}
+
+var templateGetCmd = &cobra.Command{
+	Use:     "get TEMPLATE-ID",
+	Aliases: []string{"show"},
+	Short:   "Get template details",
+	Long: `Show a template's details, including its template data pretty-printed.
+
+The --json output contains the raw templateData, which can be saved and
+passed back to 'template create --data-file'.
+
+Examples:
+  linear-cli template get TEMPLATE-ID
+  linear-cli template get TEMPLATE-ID --json | jq .templateData > template.json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		template, err := client.GetTemplate(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch template: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		if jsonOut {
+			output.JSON(template)
+			return
+		}
+
+		data := prettyTemplateData(template.TemplateData)
+
+		if plaintext {
+			fmt.Printf("# %s\n\n", template.Name)
+			fmt.Printf("## Metadata\n")
+			fmt.Printf("- **ID**: %s\n", template.ID)
+			fmt.Printf("- **Type**: %s\n", template.Type)
+			if template.Team != nil {
+				fmt.Printf("- **Team**: %s (%s)\n", template.Team.Name, template.Team.Key)
+			} else {
+				fmt.Printf("- **Team**: workspace\n")
+			}
+			if template.Creator != nil {
+				fmt.Printf("- **Creator**: %s (%s)\n", template.Creator.Name, template.Creator.Email)
+			}
+			if template.CreatedAt != nil {
+				fmt.Printf("- **Created**: %s\n", output.FormatTime(*template.CreatedAt, output.DateTime))
+			}
+			if template.UpdatedAt != nil {
+				fmt.Printf("- **Updated**: %s\n", output.FormatTime(*template.UpdatedAt, output.DateTime))
+			}
+			if template.Description != "" {
+				fmt.Printf("\n## Description\n%s\n", template.Description)
+			}
+			if data != "" {
+				fmt.Printf("\n## Template Data\n```json\n%s\n```\n", data)
+			}
+			return
+		}
+
+		fmt.Printf("%s %s\n",
+			color.New(color.FgWhite, color.Bold).Sprint(template.Name),
+			color.New(color.FgWhite, color.Faint).Sprintf("(%s)", template.ID))
+		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Details:"))
+		fmt.Printf("Type: %s\n", color.New(color.FgCyan).Sprint(template.Type))
+		if template.Team != nil {
+			fmt.Printf("Team: %s (%s)\n", template.Team.Name, color.New(color.FgCyan).Sprint(template.Team.Key))
+		} else {
+			fmt.Printf("Team: workspace\n")
+		}
+		if template.Creator != nil {
+			fmt.Printf("Creator: %s\n", template.Creator.Name)
+		}
+		if template.UpdatedAt != nil {
+			fmt.Printf("Updated: %s\n", output.FormatTime(*template.UpdatedAt, output.DateTime))
+		}
+		if template.Description != "" {
+			fmt.Printf("\n%s\n%s\n", color.New(color.FgYellow).Sprint("Description:"), template.Description)
+		}
+		if data != "" {
+			fmt.Printf("\n%s\n%s\n", color.New(color.FgYellow).Sprint("Template Data:"), data)
+		}
+	},
+}
+
+var templateCreateCmd = &cobra.Command{
+	Use:     "create",
+	Aliases: []string{"new"},
+	Short:   "Create a template",
+	Long: `Create a template from a JSON file containing its template data.
+
+The data file holds the templateData object (for issue templates, fields like
+title, description, priority and labelIds). Use "-" to read from stdin.
+Omit --team to create a workspace-level template.
+
+Examples:
+  linear-cli template create --team ENG --type issue --name "Bug report" --data-file template.json
+  linear-cli template get OTHER-ID --json | jq .templateData | linear-cli template create --type issue --name "Copy" --data-file -`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		name, _ := cmd.Flags().GetString("name")
+		templateType, _ := cmd.Flags().GetString("type")
+		teamKey, _ := cmd.Flags().GetString("team")
+		description, _, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		dataFile, _ := cmd.Flags().GetString("data-file")
+
+		if err := validateTemplateType(templateType); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		data, err := readTemplateData(dataFile)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		input := map[string]interface{}{
+			"name":         name,
+			"type":         templateType,
+			"templateData": data,
+		}
+		if description != "" {
+			input["description"] = description
+		}
+		if teamKey != "" {
+			team, err := client.GetTeam(context.Background(), teamKey)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
+			}
+			input["teamId"] = team.ID
+		}
+
+		template, err := client.CreateTemplate(context.Background(), input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create template: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "template", template.ID, template.Name, &journal.Inverse{Action: "delete"})
+
+		if jsonOut {
+			output.JSON(template)
+		} else if plaintext {
+			fmt.Printf("Created template: %s (ID: %s)\n", template.Name, template.ID)
+		} else {
+			fmt.Printf("%s Created template %s (ID: %s)\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(template.Name),
+				color.New(color.FgWhite, color.Faint).Sprint(template.ID))
+		}
+	},
+}
+
+var templateUpdateCmd = &cobra.Command{
+	Use:     "update TEMPLATE-ID",
+	Aliases: []string{"edit"},
+	Short:   "Update a template",
+	Long: `Update a template's name, description or template data.
+
+Examples:
+  linear-cli template update TEMPLATE-ID --name "Bug report (v2)"
+  linear-cli template update TEMPLATE-ID --data-file template.json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		templateID := args[0]
+
+		input := map[string]interface{}{}
+		if cmd.Flags().Changed("name") {
+			name, _ := cmd.Flags().GetString("name")
+			input["name"] = name
+		}
+		description, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
+			input["description"] = description
+		}
+		if cmd.Flags().Changed("data-file") {
+			dataFile, _ := cmd.Flags().GetString("data-file")
+			data, err := readTemplateData(dataFile)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				exit(1)
+			}
+			input["templateData"] = data
+		}
+		if len(input) == 0 {
+			output.Error("No fields to update. Use --name, --description, or --data-file.", plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		// Capture current values so the update can be undone
+		var inverse *journal.Inverse
+		if before, err := client.GetTemplate(context.Background(), templateID); err == nil {
+			inverse = restoreInverse(before, input)
+		}
+
+		template, err := client.UpdateTemplate(context.Background(), templateID, input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to update template: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "template", template.ID, template.Name, inverse)
+
+		if jsonOut {
+			output.JSON(template)
+		} else {
+			output.Success(fmt.Sprintf("Updated template %s",
+				color.New(color.FgWhite, color.Bold).Sprint(template.Name)), plaintext, jsonOut)
+		}
+	},
+}
+
+var templateDeleteCmd = &cobra.Command{
+	Use:     "delete TEMPLATE-ID",
+	Aliases: []string{"rm"},
+	Short:   "Delete a template",
+	Long:    `Permanently delete a template.`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		templateID := args[0]
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+		if err := client.DeleteTemplate(context.Background(), templateID); err != nil {
+			output.Error(fmt.Sprintf("Failed to delete template: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "template", templateID, "", nil)
+
+		output.Success("Deleted template", plaintext, jsonOut)
+	},
+}
+
+// validateTemplateType checks a --type value; an empty type is allowed
+func validateTemplateType(templateType string) error {
+	if templateType == "" {
+		return nil
+	}
+	for _, t := range validTemplateTypes {
+		if templateType == t {
+			return nil
+		}
+	}
+	return fmt.Errorf("Invalid template type '%s'. Valid types: %s", templateType, strings.Join(validTemplateTypes, ", "))
+}
+
+// filterTemplates keeps templates matching the given type and team key (empty matches all)
+func filterTemplates(templates []api.Template, templateType, teamKey string) []api.Template {
+	filtered := []api.Template{}
+	for _, t := range templates {
+		if templateType != "" && t.Type != templateType {
+			continue
+		}
+		if teamKey != "" && (t.Team == nil || !strings.EqualFold(t.Team.Key, teamKey)) {
+			continue
+		}
+		filtered = append(filtered, t)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return strings.ToLower(filtered[i].Name) < strings.ToLower(filtered[j].Name)
+	})
+	return filtered
+}
+
+// readTemplateData reads and parses the JSON template data from a file or stdin ("-")
+func readTemplateData(path string) (interface{}, error) {
+	content, err := readContentFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var data interface{}
+	if err := json.Unmarshal([]byte(content), &data); err != nil {
+		return nil, fmt.Errorf("Invalid template data in '%s': %v", path, err)
+	}
+	return data, nil
+}
+
+// prettyTemplateData indents raw template data for display
+func prettyTemplateData(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, raw, "", "  "); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// templateSuggestion lists the templates of a type (and team, when given)
+// for use in error messages
+func templateSuggestion(templates []api.Template, templateType, teamKey string) string {
+	var names []string
+	for _, t := range templates {
+		if t.Type != templateType {
+			continue
+		}
+		if teamKey != "" && t.Team != nil && !strings.EqualFold(t.Team.Key, teamKey) {
+			continue
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", t.Name, t.ID))
+	}
+	if len(names) == 0 {
+		return fmt.Sprintf("no %s templates exist; create one with 'linear-cli template create --type %s'", templateType, templateType)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("available %s templates: %s", templateType, strings.Join(names, ", "))
+}
+
+// resolveTemplateID accepts a template ID or name and returns the template ID.
+// A name resolves to the team's own template first, then a workspace
+// template; with a team given, other teams' templates never match. Unknown
+// names produce an error listing the valid templates of that type, and names
+// shared by several templates an error listing their IDs.
+func resolveTemplateID(client *api.Client, ref, templateType, teamKey string) (string, error) {
+	if isUUID(ref) {
+		return ref, nil
+	}
+	templates, err := client.GetTemplates(context.Background())
+	if err != nil {
+		return "", fmt.Errorf("failed to list templates: %v", err)
+	}
+	var own, workspace, other []api.Template
+	for _, t := range templates {
+		if t.Type != templateType || !strings.EqualFold(t.Name, ref) {
+			continue
+		}
+		switch {
+		case t.Team == nil:
+			workspace = append(workspace, t)
+		case teamKey != "" && strings.EqualFold(t.Team.Key, teamKey):
+			own = append(own, t)
+		default:
+			other = append(other, t)
+		}
+	}
+	groups := [][]api.Template{own, workspace}
+	if teamKey == "" {
+		groups = append(groups, other)
+	}
+	for _, group := range groups {
+		switch len(group) {
+		case 0:
+			continue
+		case 1:
+			return group[0].ID, nil
+		}
+		var ids []string
+		for _, t := range group {
+			scope := "workspace"
+			if t.Team != nil {
+				scope = t.Team.Key
+			}
+			ids = append(ids, fmt.Sprintf("%s (%s)", t.ID, scope))
+		}
+		sort.Strings(ids)
+		return "", fmt.Errorf("several %s templates are named '%s': %s; pass a template ID instead", templateType, ref, strings.Join(ids, ", "))
+	}
+	if len(other) > 0 {
+		return "", fmt.Errorf("template '%s' belongs to another team, not %s; %s", ref, teamKey, templateSuggestion(templates, templateType, teamKey))
+	}
+	return "", fmt.Errorf("template '%s' not found; %s", ref, templateSuggestion(templates, templateType, teamKey))
+}
+
+func init() {
+	rootCmd.AddCommand(templateCmd)
+	templateCmd.AddCommand(templateListCmd)
+	templateCmd.AddCommand(templateGetCmd)
+	templateCmd.AddCommand(templateCreateCmd)
+	templateCmd.AddCommand(templateUpdateCmd)
+	templateCmd.AddCommand(templateDeleteCmd)
+
+	// List flags
+	templateListCmd.Flags().StringP("team", "t", "", "Filter by team key, name, or ID")
+	templateListCmd.Flags().String("type", "", "Filter by type: issue, project, document")
+
+	// Create flags
+	templateCreateCmd.Flags().String("name", "", "Template name (required)")
+	templateCreateCmd.Flags().String("type", "", "Template type: issue, project, document (required)")
+	templateCreateCmd.Flags().StringP("team", "t", "", "Team key (omit for a workspace template)")
+	templateCreateCmd.Flags().StringP("description", "d", "", "Template description")
+	templateCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	templateCreateCmd.Flags().String("data-file", "", "JSON file with the template data, or - for stdin (required)")
+	_ = templateCreateCmd.MarkFlagRequired("name")
+	_ = templateCreateCmd.MarkFlagRequired("type")
+	_ = templateCreateCmd.MarkFlagRequired("data-file")
+
+	// Update flags
+	templateUpdateCmd.Flags().String("name", "", "New template name")
+	templateUpdateCmd.Flags().StringP("description", "d", "", "New template description")
+	templateUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
+	templateUpdateCmd.Flags().String("data-file", "", "JSON file with new template data, or - for stdin")
+}
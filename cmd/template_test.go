@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+func TestResolveTemplateIDScopesNamesToTeam(t *testing.T) {
+	s := linearmock.New(t)
+	s.Data("Templates", `{"templates":[
+		{"id":"tpl-ops","name":"Bug report","type":"issue","team":{"id":"t2","key":"OPS"}},
+		{"id":"tpl-eng","name":"Bug report","type":"issue","team":{"id":"t1","key":"ENG"}},
+		{"id":"tpl-ws","name":"Bug report","type":"issue"},
+		{"id":"tpl-ws-feat","name":"Feature","type":"issue"},
+		{"id":"tpl-ops-only","name":"Incident","type":"issue","team":{"id":"t2","key":"OPS"}},
+		{"id":"tpl-dup-1","name":"Spike","type":"issue","team":{"id":"t1","key":"ENG"}},
+		{"id":"tpl-dup-2","name":"Spike","type":"issue","team":{"id":"t1","key":"ENG"}}
+	]}`)
+	client := api.NewClientWithURL(s.URL, "key")
+
+	tests := []struct {
+		ref, teamKey string
+		want         string // template ID, or a substring of the error
+		wantErr      bool
+	}{
+		// The team's own template wins, then the workspace's
+		{ref: "bug report", teamKey: "ENG", want: "tpl-eng"},
+		{ref: "Bug report", teamKey: "DES", want: "tpl-ws"},
+		{ref: "Feature", teamKey: "ENG", want: "tpl-ws-feat"},
+		// Another team's template never applies
+		{ref: "Incident", teamKey: "ENG", want: "belongs to another team", wantErr: true},
+		// Without a team, a name shared by several team templates is ambiguous
+		{ref: "Incident", want: "tpl-ops-only"},
+		{ref: "Spike", teamKey: "ENG", want: "tpl-dup-1 (ENG), tpl-dup-2 (ENG)", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := resolveTemplateID(client, tt.ref, "issue", tt.teamKey)
+		switch {
+		case tt.wantErr && (err == nil || !strings.Contains(err.Error(), tt.want)):
+			t.Errorf("resolveTemplateID(%q, %q) = %q, %v; want an error mentioning %q", tt.ref, tt.teamKey, got, err, tt.want)
+		case !tt.wantErr && (err != nil || got != tt.want):
+			t.Errorf("resolveTemplateID(%q, %q) = %q, %v; want %s", tt.ref, tt.teamKey, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// toggleFlag is a boolean setting a create or update command sends only
+// when the flag is given, so --flag=false turns the setting off rather than
+// being dropped
+type toggleFlag struct {
+	Flag  string // CLI flag name
+	Field string // GraphQL input field
+}
+
+// teamToggles are the boolean settings of team create and team update.
+// Create defines a subset; flags a command doesn't define are skipped.
+var teamToggles = []toggleFlag{
+	{"private", "private"},
+	{"cycles-enabled", "cyclesEnabled"},
+	{"triage-enabled", "triageEnabled"},
+	{"cycle-auto-assign-started", "cycleIssueAutoAssignStarted"},
+	{"cycle-auto-assign-completed", "cycleIssueAutoAssignCompleted"},
+	{"cycle-lock-to-active", "cycleLockToActive"},
+	{"require-priority-to-leave-triage", "requirePriorityToLeaveTriage"},
+	{"inherit-issue-estimation", "inheritIssueEstimation"},
+	{"issue-estimation-allow-zero", "issueEstimationAllowZero"},
+	{"issue-estimation-extended", "issueEstimationExtended"},
+	{"group-issue-history", "groupIssueHistory"},
+	{"inherit-workflow-statuses", "inheritWorkflowStatuses"},
+	{"auto-close-parent-issues", "autoCloseParentIssues"},
+	{"auto-close-child-issues", "autoCloseChildIssues"},
+	{"join-by-default", "joinByDefault"},
+	{"all-members-can-join", "allMembersCanJoin"},
+	{"scim-managed", "scimManaged"},
+	{"ai-thread-summaries", "aiThreadSummariesEnabled"},
+	{"ai-discussion-summaries", "aiDiscussionSummariesEnabled"},
+	{"slack-new-issue", "slackNewIssue"},
+	{"slack-issue-comments", "slackIssueComments"},
+	{"slack-issue-statuses", "slackIssueStatuses"},
+}
+
+// projectToggles are the boolean settings of project update
+var projectToggles = []toggleFlag{
+	{"slack-new-issue", "slackNewIssue"},
+	{"slack-issue-comments", "slackIssueComments"},
+	{"slack-issue-statuses", "slackIssueStatuses"},
+	{"trashed", "trashed"},
+}
+
+// toggleOffHints explain why Linear may refuse to turn a setting off
+var toggleOffHints = map[string]string{
+	"cyclesEnabled": "Linear won't disable cycles while the team has an active or upcoming cycle; complete or delete them first (linear-cli cycle list --team KEY)",
+	"triageEnabled": "Linear won't disable triage while issues are still in the team's triage state; move them out first",
+}
+
+// addNegatedFlag registers --no-NAME, which sends NAME as false, beside an
+// existing boolean flag; the two can't be combined
+func addNegatedFlag(cmd *cobra.Command, name, usage string) {
+	cmd.Flags().Bool("no-"+name, false, usage)
+	cmd.MarkFlagsMutuallyExclusive(name, "no-"+name)
+}
+
+// applyToggleFlags copies every toggle given as --flag, --flag=true,
+// --flag=false, or --no-flag into input
+func applyToggleFlags(cmd *cobra.Command, input map[string]interface{}, toggles []toggleFlag) {
+	for _, tf := range toggles {
+		if f := cmd.Flags().Lookup("no-" + tf.Flag); f != nil && f.Changed {
+			input[tf.Field] = false
+			continue
+		}
+		if f := cmd.Flags().Lookup(tf.Flag); f != nil && f.Changed {
+			v, _ := cmd.Flags().GetBool(tf.Flag)
+			input[tf.Field] = v
+		}
+	}
+}
+
+// explainToggleRejection adds a hint to an API error from an update that
+// turned off a setting Linear guards, such as cycles
+func explainToggleRejection(err error, input map[string]interface{}) error {
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+	for _, tf := range teamToggles {
+		hint := toggleOffHints[tf.Field]
+		if v, ok := input[tf.Field].(bool); ok && !v && hint != "" {
+			return fmt.Errorf("%w — %s", err, hint)
+		}
+	}
+	return err
+}
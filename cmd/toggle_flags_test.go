@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestHermeticTeamToggles sends every team toggle both ways and checks the
+// mutation carries exactly that value, false included
+func TestHermeticTeamToggles(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+
+	for _, tf := range teamToggles {
+		for _, v := range []bool{true, false} {
+			s.Reset()
+			s.Data("UpdateTeam", fmt.Sprintf(`{"teamUpdate":{"team":{"id":"team-eng","key":"ENG","name":"Engineering","%s":%v}}}`, tf.Field, v))
+
+			r := runMocked(t, "team", "update", "ENG", fmt.Sprintf("--%s=%v", tf.Flag, v), "--json")
+			reqs := s.Requests()
+			if r.Exit != 0 || len(reqs) != 2 {
+				t.Errorf("team update --%s=%v exited %d after %v: %s", tf.Flag, v, r.Exit, s.Operations(), r.Stderr)
+				continue
+			}
+			input := reqs[1].Variables["input"].(map[string]interface{})
+			if len(input) != 1 || input[tf.Field] != v {
+				t.Errorf("team update --%s=%v sent %v, want only %s: %v", tf.Flag, v, input, tf.Field, v)
+			}
+
+			var team map[string]interface{}
+			if err := json.Unmarshal([]byte(r.Stdout), &team); err != nil {
+				t.Errorf("team update --%s=%v output: %v", tf.Flag, v, err)
+			} else if got, ok := team[tf.Field]; ok && got != v {
+				t.Errorf("team update --%s=%v printed %s = %v", tf.Flag, v, tf.Field, got)
+			}
+		}
+	}
+}
+
+func TestHermeticNegatedToggles(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("UpdateTeam", `{"teamUpdate":{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}}`)
+
+	for flag, field := range map[string]string{"no-private": "private", "no-cycles-enabled": "cyclesEnabled", "no-triage-enabled": "triageEnabled"} {
+		s.Reset()
+		r := runMocked(t, "team", "update", "ENG", "--"+flag, "--json")
+		if reqs := s.Requests(); r.Exit != 0 || len(reqs) != 2 {
+			t.Errorf("team update --%s exited %d: %s", flag, r.Exit, r.Stderr)
+		} else if input := reqs[1].Variables["input"].(map[string]interface{}); len(input) != 1 || input[field] != false {
+			t.Errorf("team update --%s sent %v, want %s: false", flag, input, field)
+		}
+	}
+
+	r := runMocked(t, "team", "update", "ENG", "--private", "--no-private")
+	if r.Exit == 0 || !strings.Contains(r.Stderr, "none of the others can be") {
+		t.Errorf("--private with --no-private exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticToggleRejection(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Error("UpdateTeam", 200, "Cannot disable cycles for team with active cycle", "INVALID_INPUT")
+
+	r := runMocked(t, "team", "update", "ENG", "--no-cycles-enabled", "--plaintext")
+	if r.Exit != 1 || !strings.Contains(r.Stderr, "Cannot disable cycles") || !strings.Contains(r.Stderr, "complete or delete them first") {
+		t.Errorf("rejected --no-cycles-enabled exited %d: %s", r.Exit, r.Stderr)
+	}
+
+	// The hint only applies when the setting was being turned off
+	r = runMocked(t, "team", "update", "ENG", "--cycles-enabled", "--plaintext")
+	if r.Exit != 1 || strings.Contains(r.Stderr, "complete or delete them first") {
+		t.Errorf("rejected --cycles-enabled exited %d: %s", r.Exit, r.Stderr)
+	}
+}
+
+func TestHermeticProjectToggles(t *testing.T) {
+	s := newMockLinear(t)
+	project := mockFixture(t, "project_get")
+	s.Data("Project", `{"project":`+project+`}`)
+	s.Data("ProjectUpdate", `{"projectUpdate":{"project":`+project+`}}`)
+
+	for _, tf := range projectToggles {
+		s.Reset()
+		r := runMocked(t, "project", "update", "proj-1", "--"+tf.Flag+"=false", "--json")
+		reqs := s.Requests()
+		if r.Exit != 0 || len(reqs) != 2 {
+			t.Errorf("project update --%s=false exited %d: %s", tf.Flag, r.Exit, r.Stderr)
+			continue
+		}
+		if input := reqs[1].Variables["input"].(map[string]interface{}); len(input) != 1 || input[tf.Field] != false {
+			t.Errorf("project update --%s=false sent %v", tf.Flag, input)
+		}
+	}
+}
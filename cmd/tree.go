@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/fatih/color"
@@ -59,7 +58,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -68,7 +67,7 @@ Examples:
 		issue, err := client.GetIssue(ctx, args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		walker := &treeWalker{
@@ -114,31 +113,6 @@ func formatIssueOneLiner(issue *api.Issue, plaintext bool) string {
 	return fmt.Sprintf("%s%s - %s", ident, stateStr, issue.Title)
 }
 
-// colorizeState returns a colorized state name
-func colorizeState(state *api.State) string {
-	if state == nil {
-		return ""
-	}
-	var stateColor *color.Color
-	switch state.Type {
-	case "triage":
-		stateColor = color.New(color.FgMagenta)
-	case "backlog":
-		stateColor = color.New(color.FgWhite, color.Faint)
-	case "unstarted":
-		stateColor = color.New(color.FgWhite)
-	case "started":
-		stateColor = color.New(color.FgYellow)
-	case "completed":
-		stateColor = color.New(color.FgGreen)
-	case "canceled":
-		stateColor = color.New(color.FgRed)
-	default:
-		stateColor = color.New(color.FgWhite)
-	}
-	return stateColor.Sprint(state.Name)
-}
-
 // printTree prints the text representation of the issue tree
 func (w *treeWalker) printTree(issue *api.Issue, plaintext bool) {
 	// Mark root as visited
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// warnIfTruncated tells the user on stderr when a list was cut at the
+// default --limit and the API has more results. List commands call it right
+// after fetching, whatever the output mode, so stdout stays clean for --json.
+func warnIfTruncated(cmd *cobra.Command, pageInfo api.PageInfo, shown int) {
+	writeTruncationWarning(os.Stderr, cmd, pageInfo, shown)
+}
+
+// writeTruncationWarning writes the warning to w and reports whether it did.
+// An explicit --limit means the user chose the page size, so it stays quiet.
+func writeTruncationWarning(w io.Writer, cmd *cobra.Command, pageInfo api.PageInfo, shown int) bool {
+	if !pageInfo.HasNextPage || cmd.Flags().Changed("limit") {
+		return false
+	}
+	fmt.Fprintf(w, "%s showing first %d of more results; increase --limit to see more\n",
+		color.New(color.FgYellow).Sprint("⚠"), shown)
+	return true
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+func TestWriteTruncationWarning(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		pageInfo api.PageInfo
+		want     bool
+	}{
+		{"default limit with more pages", nil, api.PageInfo{HasNextPage: true, EndCursor: "c"}, true},
+		{"default limit, last page", nil, api.PageInfo{}, false},
+		{"explicit limit with more pages", []string{"--limit", "50"}, api.PageInfo{HasNextPage: true}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "list", Run: func(*cobra.Command, []string) {}}
+			cmd.Flags().IntP("limit", "l", 50, "")
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			got := writeTruncationWarning(&buf, cmd, tt.pageInfo, 50)
+			if got != tt.want {
+				t.Errorf("warned = %v, want %v", got, tt.want)
+			}
+			if tt.want && !strings.Contains(buf.String(), "showing first 50 of more results; increase --limit") {
+				t.Errorf("warning = %q", buf.String())
+			}
+			if !tt.want && buf.Len() != 0 {
+				t.Errorf("expected no output, got %q", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+// addURLOnlyFlag registers --url-only on a create or update command
+func addURLOnlyFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("url-only", false, "Print nothing but the URL of the result (one per line), for $(...) in scripts")
+}
+
+// urlOnly reports whether the command was asked for --url-only output
+func urlOnly(cmd *cobra.Command) bool {
+	if cmd.Flags().Lookup("url-only") == nil {
+		return false
+	}
+	only, _ := cmd.Flags().GetBool("url-only")
+	return only
+}
+
+// printURLOnly prints url on its own line for --url-only. The change has
+// already been made, so a missing URL is only noted on stderr.
+func printURLOnly(url string, what string) {
+	if url == "" {
+		fmt.Fprintf(os.Stderr, "No URL available for the %s\n", what)
+		return
+	}
+	fmt.Println(url)
+}
+
+// printResultURL adds the URL line under a create or update success message
+func printResultURL(url string, plaintext bool) {
+	if url == "" {
+		return
+	}
+	if plaintext {
+		fmt.Printf("URL: %s\n", url)
+		return
+	}
+	fmt.Printf("  URL: %s\n", color.New(color.FgBlue, color.Underline).Sprint(url))
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConstructEntityURLs(t *testing.T) {
+	const project = "https://linear.app/acme/project/checkout-v2-0a1b2c3d4e5f"
+	const issue = "https://linear.app/acme/issue/ENG-42/fix-parse-date"
+	tests := []struct {
+		entity string
+		got    string
+		want   string
+	}{
+		{"project", constructProjectURL("proj-1", project), "https://linear.app/acme/project/proj-1"},
+		{"project without URL", constructProjectURL("proj-1", ""), ""},
+		{"milestone", constructMilestoneURL(project, "ms-1"), project + "/overview#milestone-ms-1"},
+		{"milestone on an overview URL", constructMilestoneURL(project+"/overview/", "ms-1"), project + "/overview#milestone-ms-1"},
+		{"milestone without project URL", constructMilestoneURL("", "ms-1"), ""},
+		{"comment", constructCommentURL(issue, "3f2a1b9c-0000-4000-8000-000000000001"), issue + "#comment-3f2a1b9c"},
+		{"comment on an anchored URL", constructCommentURL(issue+"#comment-aaaaaaaa", "3f2a1b9c-0000"), issue + "#comment-3f2a1b9c"},
+		{"comment without issue URL", constructCommentURL("", "3f2a1b9c"), ""},
+		{"view", constructViewURL("acme", "9f8e7d6c5b4a"), "https://linear.app/acme/view/9f8e7d6c5b4a"},
+		{"view without workspace", constructViewURL("", "9f8e7d6c5b4a"), ""},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s URL = %q, want %q", tt.entity, tt.got, tt.want)
+		}
+	}
+}
+
+func TestURLOnly(t *testing.T) {
+	s := newMockLinear(t)
+	issue := mockFixture(t, "issue_get")
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("CreateIssue", `{"issueCreate":{"issue":`+issue+`}}`)
+	s.Data("Issue", `{"issue":`+issue+`}`)
+	s.Data("Project", `{"project":{"id":"p1","name":"Checkout","url":"https://linear.app/acme/project/checkout-abc"}}`)
+	s.Data("CreateComment", `{"commentCreate":{"comment":{"id":"3f2a1b9c-0000-4000-8000-000000000001","body":"Shipped"}}}`)
+	s.Data("ProjectMilestones", `{"projectMilestones":{"nodes":[],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("ProjectMilestoneCreate", `{"projectMilestoneCreate":{"projectMilestone":{"id":"ms-1","name":"Alpha",
+		"project":{"id":"p1","name":"Checkout","url":"https://linear.app/acme/project/checkout-abc"}}}}`)
+	s.Data("CustomViewCreate", `{"customViewCreate":{"success":true,"customView":{"id":"v1","name":"Bugs","slugId":"9f8e7d6c5b4a",
+		"organization":{"id":"o1","name":"Acme","urlKey":"acme"}}}}`)
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--url-only"}, "https://linear.app/acme/issue/ENG-42\n"},
+		{[]string{"issue", "create", "--title", "Fix parse_date", "--team", "ENG", "--url-only", "--json"}, "https://linear.app/acme/issue/ENG-42\n"},
+		// The API left out the comment's URL, so it is built from the issue's
+		{[]string{"issue", "comment", "create", "ENG-42", "--body", "Shipped", "--url-only"}, "https://linear.app/acme/issue/ENG-42#comment-3f2a1b9c\n"},
+		{[]string{"project", "milestone", "create", "p1", "--bulk", "Alpha:2026-11-01,Beta:2026-12-01", "--url-only"},
+			"https://linear.app/acme/project/checkout-abc/overview#milestone-ms-1\nhttps://linear.app/acme/project/checkout-abc/overview#milestone-ms-1\n"},
+		{[]string{"view", "create", "--name", "Bugs", "--url-only"}, "https://linear.app/acme/view/9f8e7d6c5b4a\n"},
+	}
+	for _, tt := range tests {
+		r := runMocked(t, tt.args...)
+		if r.Exit != 0 || r.Stdout != tt.want {
+			t.Errorf("%s exited %d: stdout %q, want %q (stderr %q)", strings.Join(tt.args, " "), r.Exit, r.Stdout, tt.want, r.Stderr)
+		}
+	}
+
+	r := runMocked(t, "project", "milestone", "create", "p1", "--name", "Alpha")
+	if !strings.Contains(r.Stdout, "URL: https://linear.app/acme/project/checkout-abc/overview#milestone-ms-1") {
+		t.Errorf("milestone create doesn't show the URL:\n%s", r.Stdout)
+	}
+}
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
@@ -40,7 +39,7 @@ var userListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -52,28 +51,19 @@ var userListCmd = &cobra.Command{
 
 		// Get sort option
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, err := sortOrderBy(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
 		}
 
 		// Get users
 		users, err := client.GetUsers(context.Background(), limit, "", orderBy)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, users.PageInfo, len(users.Nodes))
 
 		// Filter active users if requested
 		filteredUsers := users.Nodes
@@ -190,7 +180,7 @@ var userGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -200,7 +190,7 @@ var userGetCmd = &cobra.Command{
 		user, err := client.GetUser(context.Background(), email)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Handle output
@@ -233,7 +223,7 @@ var userGetCmd = &cobra.Command{
 				fmt.Printf("Avatar: %s\n", user.AvatarURL)
 			}
 			if user.LastSeen != nil {
-				fmt.Printf("Last Seen: %s\n", user.LastSeen.Format("2006-01-02 15:04:05"))
+				fmt.Printf("Last Seen: %s\n", output.FormatTime(*user.LastSeen, output.DateTime))
 			}
 			fmt.Printf("Issues Created: %d\n", user.CreatedIssueCount)
 		} else {
@@ -282,7 +272,7 @@ var userGetCmd = &cobra.Command{
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), userStatus)
 				if user.StatusUntilAt != nil {
 					fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status Until:"),
-						user.StatusUntilAt.Format("2006-01-02 15:04"))
+						output.FormatTime(*user.StatusUntilAt, output.DateTimeShort))
 				}
 			}
 
@@ -297,7 +287,7 @@ var userGetCmd = &cobra.Command{
 
 			if user.LastSeen != nil {
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Last Seen:"),
-					user.LastSeen.Format("2006-01-02 15:04:05"))
+					output.FormatTime(*user.LastSeen, output.DateTime))
 			}
 
 			fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Issues Created:"), user.CreatedIssueCount)
@@ -328,7 +318,7 @@ var userMeCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -338,7 +328,7 @@ var userMeCmd = &cobra.Command{
 		user, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Handle output
@@ -371,7 +361,7 @@ var userMeCmd = &cobra.Command{
 				fmt.Printf("Avatar: %s\n", user.AvatarURL)
 			}
 			if user.LastSeen != nil {
-				fmt.Printf("Last Seen: %s\n", user.LastSeen.Format("2006-01-02 15:04:05"))
+				fmt.Printf("Last Seen: %s\n", output.FormatTime(*user.LastSeen, output.DateTime))
 			}
 			fmt.Printf("Issues Created: %d\n", user.CreatedIssueCount)
 		} else {
@@ -416,7 +406,7 @@ var userMeCmd = &cobra.Command{
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), userStatus)
 				if user.StatusUntilAt != nil {
 					fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status Until:"),
-						user.StatusUntilAt.Format("2006-01-02 15:04"))
+						output.FormatTime(*user.StatusUntilAt, output.DateTimeShort))
 				}
 			}
 
@@ -431,7 +421,7 @@ var userMeCmd = &cobra.Command{
 
 			if user.LastSeen != nil {
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Last Seen:"),
-					user.LastSeen.Format("2006-01-02 15:04:05"))
+					output.FormatTime(*user.LastSeen, output.DateTime))
 			}
 
 			fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Issues Created:"), user.CreatedIssueCount)
@@ -471,7 +461,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Create API client
@@ -481,7 +471,7 @@ Examples:
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Build update input
@@ -498,8 +488,12 @@ Examples:
 			input.DisplayName = &displayName
 			hasUpdates = true
 		}
-		if cmd.Flags().Changed("description") {
-			description, _ := cmd.Flags().GetString("description")
+		description, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input.Description = &description
 			hasUpdates = true
 		}
@@ -536,7 +530,7 @@ Examples:
 				}
 				if parseErr != nil {
 					output.Error(fmt.Sprintf("Invalid status-until format: %v. Use YYYY-MM-DD or RFC3339 format.", parseErr), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input.StatusUntilAt = &t
 			}
@@ -545,15 +539,16 @@ Examples:
 
 		if !hasUpdates {
 			output.Error("No updates specified. Use --help to see available flags.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		// Update user
 		user, err := client.UpdateUser(context.Background(), viewer.ID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "user", user.ID, user.Email, nil)
 
 		// Handle output
 		if jsonOut {
@@ -596,7 +591,7 @@ Examples:
 				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status:"), userStatus)
 				if user.StatusUntilAt != nil {
 					fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Status Until:"),
-						user.StatusUntilAt.Format("2006-01-02 15:04"))
+						output.FormatTime(*user.StatusUntilAt, output.DateTimeShort))
 				}
 			}
 			fmt.Println()
@@ -620,6 +615,7 @@ func init() {
 	userUpdateCmd.Flags().String("name", "", "Update name")
 	userUpdateCmd.Flags().String("display-name", "", "Update display name")
 	userUpdateCmd.Flags().String("description", "", "Update description/bio")
+	userUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	userUpdateCmd.Flags().String("avatar-url", "", "Update avatar URL")
 	userUpdateCmd.Flags().String("timezone", "", "Update timezone (e.g., America/New_York)")
 	userUpdateCmd.Flags().String("status-emoji", "", "Set status emoji (empty string to clear)")
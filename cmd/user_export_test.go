@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func stubUserExport(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("Users", `{"users":{"nodes":[
+		{"id":"u2","name":"=HYPERLINK(\"x\")","email":"zed@example.com","active":false,"guest":true,"createdAt":"2025-01-02T03:04:05Z"},
+		{"id":"u1","name":"Ada Lovelace","email":"ada@example.com","active":true,"admin":true,"createdAt":"2024-05-06T07:08:09Z","lastSeen":"2026-03-01T12:00:00Z"},
+		{"id":"u3","name":"Grace Hopper","email":"grace@example.com","active":true,"createdAt":"2024-05-06T07:08:09Z"}
+	],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Teams", `{"teams":{"nodes":[{"id":"t1","key":"ENG","name":"Engineering"},{"id":"t2","key":"OPS","name":"Operations"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.DataFor("TeamMembers", map[string]interface{}{"key": "ENG"}, `{"team":{"members":{"nodes":[{"id":"u1"},{"id":"u3"}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.DataFor("TeamMembers", map[string]interface{}{"key": "OPS"}, `{"team":{"members":{"nodes":[{"id":"u1"}],"pageInfo":{"hasNextPage":false}}}}`)
+}
+
+func TestHermeticUserExportCSV(t *testing.T) {
+	stubUserExport(t)
+	path := filepath.Join(t.TempDir(), "users.csv")
+
+	r := runMocked(t, "user", "export", "--output", path)
+	if r.Exit != 0 || r.Stdout != "" || !strings.Contains(r.Stderr, "Wrote 3 users to "+path) {
+		t.Fatalf("user export exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `name,email,role,active,createdAt,lastSeen,teams
+Ada Lovelace,ada@example.com,admin,true,2024-05-06T07:08:09Z,2026-03-01T12:00:00Z,ENG;OPS
+Grace Hopper,grace@example.com,member,true,2024-05-06T07:08:09Z,,ENG
+"'=HYPERLINK(""x"")",zed@example.com,guest,false,2025-01-02T03:04:05Z,,
+`
+	if string(data) != want {
+		t.Errorf("CSV:\n%s\nwant:\n%s", data, want)
+	}
+}
+
+func TestHermeticUserExportScoped(t *testing.T) {
+	stubUserExport(t)
+
+	r := runMocked(t, "user", "export", "--teams", "ops", "--json")
+	var rows []userExportRow
+	if err := json.Unmarshal([]byte(r.Stdout), &rows); r.Exit != 0 || err != nil {
+		t.Fatalf("--teams ops exited %d (%v): %s%s", r.Exit, err, r.Stdout, r.Stderr)
+	}
+	if len(rows) != 3 || strings.Join(rows[0].Teams, ";") != "OPS" || len(rows[1].Teams) != 0 {
+		t.Errorf("rows = %+v", rows)
+	}
+
+	if r := runMocked(t, "user", "export", "--teams", "NOPE"); r.Exit != 1 || !strings.Contains(r.Stderr, "team 'NOPE' not found") {
+		t.Errorf("unknown team exited %d: %s", r.Exit, r.Stderr)
+	}
+	r = runMocked(t, "user", "export", "--teams", "OSP", "--json")
+	var resp struct {
+		Error       string
+		Suggestions []string
+	}
+	if r.Exit != 1 || json.Unmarshal([]byte(r.Stdout), &resp) != nil ||
+		resp.Error != "team 'OSP' not found; did you mean: OPS?" || strings.Join(resp.Suggestions, ",") != "OPS" {
+		t.Errorf("misspelled team exited %d: %s", r.Exit, r.Stdout)
+	}
+	if r := runMocked(t, "user", "export", "--format", "xml"); r.Exit != 1 || !strings.Contains(r.Stderr, "Invalid format") {
+		t.Errorf("--format xml exited %d: %s", r.Exit, r.Stderr)
+	}
+}
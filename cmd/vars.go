@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// addVarFlags registers --var, --allow-missing-vars, and --allow-env for
+// commands that fill {{KEY}} placeholders with substituteVarFlags
+func addVarFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("var", nil, "Fill {{KEY}} placeholders in the title and description (KEY=VALUE, repeatable)")
+	cmd.Flags().Bool("allow-missing-vars", false, "Leave placeholders without a value as they are instead of failing")
+	cmd.Flags().Bool("allow-env", false, "Fill {{env.NAME}} placeholders from environment variables")
+}
+
+// substituteVarFlags replaces placeholders in each text using the command's
+// --var flags. Texts are only touched when one of the flags was given, so
+// braces in ordinary titles and descriptions are left alone.
+func substituteVarFlags(cmd *cobra.Command, texts ...*string) error {
+	pairs, _ := cmd.Flags().GetStringArray("var")
+	allowMissing, _ := cmd.Flags().GetBool("allow-missing-vars")
+	allowEnv, _ := cmd.Flags().GetBool("allow-env")
+	if len(pairs) == 0 && !allowEnv {
+		return nil
+	}
+	vars, err := utils.ParseVars(pairs)
+	if err != nil {
+		return err
+	}
+	opts := utils.VarOptions{AllowMissing: allowMissing, AllowEnv: allowEnv}
+	var missing []string
+	for _, text := range texts {
+		result, err := utils.SubstituteVars(*text, vars, opts)
+		var missingErr *utils.MissingVarsError
+		if errors.As(err, &missingErr) {
+			missing = append(missing, missingErr.Names...)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		*text = result
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	slices.Sort(missing)
+	missing = slices.Compact(missing)
+	hint := "pass --var KEY=VALUE or --allow-missing-vars"
+	for _, name := range missing {
+		if strings.HasPrefix(name, "env.") && !allowEnv {
+			hint = "pass --allow-env for {{env.NAME}}, --var KEY=VALUE, or --allow-missing-vars"
+			break
+		}
+	}
+	return errors.New((&utils.MissingVarsError{Names: missing}).Error() + " (" + hint + ")")
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestIssueCreateVars(t *testing.T) {
+	s := newMockLinear(t)
+	issue := mockFixture(t, "issue_get")
+	s.Data("Team", `{"team":{"id":"team-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("CreateIssue", `{"issueCreate":{"issue":`+issue+`}}`)
+	t.Setenv("DEPLOYER", "ada")
+
+	tmpl := filepath.Join(t.TempDir(), "deploy.md.tmpl")
+	if err := os.WriteFile(tmpl, []byte("Roll out {{SERVICE}} on {{DATE}}.\n\nRollback: redeploy {{SERVICE}}. By {{env.DEPLOYER}}."), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	create := func(extra ...string) serveResponse {
+		args := append([]string{"issue", "create", "--title", "Deploy {{SERVICE}}", "--team", "ENG", "--description-file", tmpl, "--json"}, extra...)
+		return runMocked(t, args...)
+	}
+
+	r := create("--var", "SERVICE=payments", "--var", "DATE=2026-10-16", "--allow-env")
+	if r.Exit != 0 {
+		t.Fatalf("issue create exited %d: %s", r.Exit, r.Stdout+r.Stderr)
+	}
+	input := s.Requests()[1].Variables["input"].(map[string]interface{})
+	if input["title"] != "Deploy payments" || input["description"] != "Roll out payments on 2026-10-16.\n\nRollback: redeploy payments. By ada.\n" {
+		t.Errorf("issueCreate input = %v", input)
+	}
+
+	s.Reset()
+	r = create("--var", "SERVICE=payments")
+	if r.Exit != 1 || !strings.Contains(r.Stdout, "no value for {{DATE}}, {{env.DEPLOYER}}") || !strings.Contains(r.Stdout, "--allow-env") || len(s.Requests()) != 0 {
+		t.Errorf("missing vars exited %d after %v: %s", r.Exit, s.Operations(), r.Stdout)
+	}
+
+	r = create("--var", "SERVICE=payments", "--allow-missing-vars")
+	input = s.Requests()[1].Variables["input"].(map[string]interface{})
+	if r.Exit != 0 || !strings.Contains(input["description"].(string), "on {{DATE}}.") {
+		t.Errorf("--allow-missing-vars exited %d: %v", r.Exit, input)
+	}
+
+	// Without --var, braces are ordinary text
+	s.Reset()
+	r = runMocked(t, "issue", "create", "--title", "Fix {{name}} rendering", "--team", "ENG", "--json")
+	if r.Exit != 0 || s.Requests()[1].Variables["input"].(map[string]interface{})["title"] != "Fix {{name}} rendering" {
+		t.Errorf("plain create exited %d: %s", r.Exit, r.Stdout+r.Stderr)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
 	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
 	"github.com/roboalchemist/linear-cli/pkg/output"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -45,7 +47,7 @@ var viewListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -68,8 +70,8 @@ var viewListCmd = &cobra.Command{
 		if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			filter["team"] = map[string]interface{}{"id": map[string]interface{}{"eq": team.ID}}
 		}
@@ -82,8 +84,9 @@ var viewListCmd = &cobra.Command{
 		views, err := client.GetCustomViews(context.Background(), filterArg, limit, "")
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list views: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		warnIfTruncated(cmd, views.PageInfo, len(views.Nodes))
 
 		if len(views.Nodes) == 0 {
 			output.Info("No custom views found", plaintext, jsonOut)
@@ -108,7 +111,7 @@ var viewListCmd = &cobra.Command{
 				if v.Team != nil {
 					fmt.Printf("- **Team**: %s\n", v.Team.Key)
 				}
-				fmt.Printf("- **Updated**: %s\n", v.UpdatedAt.Format("2006-01-02"))
+				fmt.Printf("- **Updated**: %s\n", output.FormatTime(v.UpdatedAt, output.DateOnly))
 				if v.Description != nil && *v.Description != "" {
 					fmt.Printf("- **Description**: %s\n", *v.Description)
 				}
@@ -150,7 +153,7 @@ var viewListCmd = &cobra.Command{
 				shared,
 				creator,
 				team,
-				v.UpdatedAt.Format("2006-01-02"),
+				output.FormatTime(v.UpdatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 				v.ID,
 			}
 		}
@@ -163,11 +166,6 @@ var viewListCmd = &cobra.Command{
 		fmt.Printf("\n%s %d views\n",
 			color.New(color.FgGreen).Sprint("✓"),
 			len(views.Nodes))
-
-		if views.PageInfo.HasNextPage {
-			fmt.Printf("%s Use --limit to see more results\n",
-				color.New(color.FgYellow).Sprint("i"))
-		}
 	},
 }
 
@@ -175,23 +173,39 @@ var viewGetCmd = &cobra.Command{
 	Use:     "get [view-id]",
 	Aliases: []string{"show"},
 	Short:   "Get view details",
-	Long:    `Get detailed information about a custom view including its filter configuration.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a custom view including its filter configuration.
+
+The filter is shown as readable conditions, one per line, with the users,
+labels, teams, and states it refers to looked up by name:
+
+  state type is one of: started, unstarted
+  assignee is Alice (alice@example.com)
+  label is any of: bug, regression
+  created after 2024-01-01
+
+--raw-filter shows the filter JSON instead. --json output always carries the
+raw filter.
+
+Examples:
+  linear-cli view get VIEW-ID
+  linear-cli view get VIEW-ID --raw-filter`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		rawFilter, _ := cmd.Flags().GetBool("raw-filter")
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 		view, err := client.GetCustomView(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch view: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		if jsonOut {
@@ -230,23 +244,19 @@ var viewGetCmd = &cobra.Command{
 			if view.Team != nil {
 				fmt.Printf("- **Team**: %s (%s)\n", view.Team.Name, view.Team.Key)
 			}
-			fmt.Printf("- **Created**: %s\n", view.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", view.UpdatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(view.CreatedAt, output.DateTime))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(view.UpdatedAt, output.DateTime))
 			if view.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", view.ArchivedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("- **Archived**: %s\n", output.FormatTime(*view.ArchivedAt, output.DateTime))
 			}
 
-			if len(view.FilterData) > 0 {
-				filterJSON, _ := json.MarshalIndent(view.FilterData, "", "  ")
-				fmt.Printf("\n## Filter Data\n```json\n%s\n```\n", string(filterJSON))
-			}
-			if len(view.ProjectFilterData) > 0 {
-				filterJSON, _ := json.MarshalIndent(view.ProjectFilterData, "", "  ")
-				fmt.Printf("\n## Project Filter Data\n```json\n%s\n```\n", string(filterJSON))
-			}
-			if len(view.InitiativeFilterData) > 0 {
-				filterJSON, _ := json.MarshalIndent(view.InitiativeFilterData, "", "  ")
-				fmt.Printf("\n## Initiative Filter Data\n```json\n%s\n```\n", string(filterJSON))
+			if rawFilter {
+				for _, section := range viewFilterSections(view) {
+					filterJSON, _ := json.MarshalIndent(section.Filter, "", "  ")
+					fmt.Printf("\n## %s Data\n```json\n%s\n```\n", section.Heading, string(filterJSON))
+				}
+			} else {
+				printViewFilters(client, view, true)
 			}
 			return
 		}
@@ -291,23 +301,19 @@ var viewGetCmd = &cobra.Command{
 			fmt.Printf("%s %s (%s)\n", color.New(color.Bold).Sprint("Team:"), view.Team.Name, view.Team.Key)
 		}
 
-		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), view.CreatedAt.Format("2006-01-02 15:04:05"))
-		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Updated:"), view.UpdatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), output.FormatTime(view.CreatedAt, output.DateTime))
+		fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Updated:"), output.FormatTime(view.UpdatedAt, output.DateTime))
 		if view.ArchivedAt != nil {
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Archived:"), view.ArchivedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Archived:"), output.FormatTime(*view.ArchivedAt, output.DateTime))
 		}
 
-		if len(view.FilterData) > 0 {
-			filterJSON, _ := json.MarshalIndent(view.FilterData, "", "  ")
-			fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Filter Data:"), string(filterJSON))
-		}
-		if len(view.ProjectFilterData) > 0 {
-			filterJSON, _ := json.MarshalIndent(view.ProjectFilterData, "", "  ")
-			fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Project Filter Data:"), string(filterJSON))
-		}
-		if len(view.InitiativeFilterData) > 0 {
-			filterJSON, _ := json.MarshalIndent(view.InitiativeFilterData, "", "  ")
-			fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Initiative Filter Data:"), string(filterJSON))
+		if rawFilter {
+			for _, section := range viewFilterSections(view) {
+				filterJSON, _ := json.MarshalIndent(section.Filter, "", "  ")
+				fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint(section.Heading+" Data:"), string(filterJSON))
+			}
+		} else {
+			printViewFilters(client, view, false)
 		}
 
 		fmt.Println()
@@ -326,7 +332,31 @@ The view's modelName determines whether issues or projects are returned.
 Examples:
   linear-cli view run VIEW-ID
   linear-cli view run VIEW-ID --limit 100
-  linear-cli view run VIEW-ID --json`,
+  linear-cli view run VIEW-ID --json
+  linear-cli view run VIEW-ID --save-snapshot morning
+
+--save-snapshot fetches every matching issue (ignoring --limit) and stores the
+identifiers, states, and assignees for a later 'view diff'.
+
+For issue views, --sort priority|estimate|due|title|state|created|updated
+reorders the results (--asc/--desc flip the direction). The sort applies to
+the fetched issues only; add --all to fetch and sort every match.
+
+  linear-cli view run VIEW-ID --sort priority --all
+
+--ids-only prints one issue identifier (or project ID) per line and --count
+just the number of matches, counting every match regardless of --limit.
+Neither can be combined with --json or --plaintext.
+
+  linear-cli view run VIEW-ID --ids-only | xargs -n1 linear-cli issue get
+
+--compact prints one line per item sized to the terminal: a colored state
+glyph, the identifier, the title cut to fit, and the assignee's (or lead's)
+initials, with no headers or footers. --watch reruns the view every
+--interval seconds (default 30) and repaints it in place until Ctrl-C, under
+a one-line header unless --compact is given too.
+
+  linear-cli view run VIEW-ID --watch --compact --interval 60`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -335,7 +365,7 @@ Examples:
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -344,7 +374,7 @@ Examples:
 		view, err := client.GetCustomView(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch view: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
@@ -352,31 +382,145 @@ Examples:
 			limit = 50
 		}
 
+		snapshotName, _ := cmd.Flags().GetString("save-snapshot")
+		issueSorting, err := issueSortFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		issueSorting = issueSorting.withoutOrderBy()
+		fetchAll, _ := cmd.Flags().GetBool("all")
+		script, err := scriptOutputFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		// --count counts every match, not just a page
+		fetchAll = fetchAll || script.Count
+		dash, err := dashboardFromFlags(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		dash.Title = view.Name
+
 		switch strings.ToLower(view.ModelName) {
 		case "issue":
-			issues, err := client.GetCustomViewIssues(context.Background(), view.ID, limit, "")
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to run view: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+			if dash.enabled() {
+				dash.Noun = "issues"
+				dash.show(func(ctx context.Context) ([]compactItem, error) {
+					issues, err := fetchViewIssues(cmd, client, view.ID, fetchAll)
+					if err != nil {
+						return nil, err
+					}
+					issueSorting.apply(issues.Nodes)
+					return issueCompactItems(issues.Nodes), nil
+				}, plaintext)
+				return
+			}
+			var issues *api.Issues
+			if snapshotName != "" {
+				snap, all, err := saveViewSnapshot(client, view, snapshotName)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to save snapshot: %v", err), plaintext, jsonOut)
+					exit(1)
+				}
+				if !jsonOut {
+					fmt.Fprintf(os.Stderr, "Saved snapshot %q (%d issues)\n", snap.Name, len(snap.Items))
+				}
+				issues = &api.Issues{Nodes: all}
+			} else {
+				issues, err = fetchViewIssues(cmd, client, view.ID, fetchAll)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to run view: %v", err), plaintext, jsonOut)
+					exit(1)
+				}
+				warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
+			}
+			issueSorting.apply(issues.Nodes)
+			issueSorting.warnSortedWindow(issues.PageInfo, len(issues.Nodes))
+			if script.enabled() {
+				script.printIssues(issues.Nodes)
+				return
 			}
 			viewLabel := fmt.Sprintf("issues in view %q", view.Name)
-			renderIssueCollection(issues, plaintext, jsonOut, "No issues match this view", viewLabel, fmt.Sprintf("# %s", view.Name))
+			renderIssueCollection(issues, plaintext, jsonOut, "No issues match this view", viewLabel, fmt.Sprintf("# %s", view.Name), defaultDescriptionLines)
 
 		case "project":
-			projects, err := client.GetCustomViewProjects(context.Background(), view.ID, limit, "")
+			if snapshotName != "" {
+				output.Error("Snapshots are only supported for issue views", plaintext, jsonOut)
+				exit(1)
+			}
+			if cmd.Flags().Changed("sort") || cmd.Flags().Changed("asc") || cmd.Flags().Changed("desc") || cmd.Flags().Changed("all") {
+				output.Error("--sort, --asc, --desc, and --all are only supported for issue views", plaintext, jsonOut)
+				exit(1)
+			}
+			if dash.enabled() {
+				dash.Noun = "projects"
+				dash.show(func(ctx context.Context) ([]compactItem, error) {
+					projects, err := client.GetCustomViewProjects(ctx, view.ID, limit, "")
+					if err != nil {
+						return nil, err
+					}
+					items := make([]compactItem, len(projects.Nodes))
+					for i, project := range projects.Nodes {
+						items[i] = projectCompactItem(project)
+					}
+					return items, nil
+				}, plaintext)
+				return
+			}
+			var projects *api.Projects
+			if script.Count {
+				projects, err = fetchAllViewProjects(client, view.ID)
+			} else {
+				projects, err = client.GetCustomViewProjects(context.Background(), view.ID, limit, "")
+			}
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to run view: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
+			}
+			warnIfTruncated(cmd, projects.PageInfo, len(projects.Nodes))
+			if script.enabled() {
+				script.printProjects(projects.Nodes)
+				return
 			}
 			renderViewProjects(projects, view.Name, plaintext, jsonOut)
 
 		default:
 			output.Error(fmt.Sprintf("Unsupported view model type: %s", view.ModelName), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 	},
 }
 
+// fetchAllViewProjects follows a project view's results to the end
+func fetchAllViewProjects(client *api.Client, viewID string) (*api.Projects, error) {
+	return fetchViewProjectsUpTo(client, viewID, 0)
+}
+
+// fetchViewProjectsUpTo follows a project view's results until they end or
+// max projects were fetched; max 0 means no limit
+func fetchViewProjectsUpTo(client *api.Client, viewID string, max int) (*api.Projects, error) {
+	all := &api.Projects{}
+	after := ""
+	for {
+		page, err := client.GetCustomViewProjects(context.Background(), viewID, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		all.Nodes = append(all.Nodes, page.Nodes...)
+		if max > 0 && len(all.Nodes) >= max {
+			all.Nodes = all.Nodes[:max]
+			return all, nil
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return all, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
 func renderViewProjects(projects *api.Projects, viewName string, plaintext, jsonOut bool) {
 	if len(projects.Nodes) == 0 {
 		output.Info("No projects match this view", plaintext, jsonOut)
@@ -408,8 +552,8 @@ func renderViewProjects(projects *api.Projects, viewName string, plaintext, json
 				}
 				fmt.Printf("- **Teams**: %s\n", teams)
 			}
-			fmt.Printf("- **Created**: %s\n", project.CreatedAt.Format("2006-01-02"))
-			fmt.Printf("- **Updated**: %s\n", project.UpdatedAt.Format("2006-01-02"))
+			fmt.Printf("- **Created**: %s\n", output.FormatTime(project.CreatedAt, output.DateOnly))
+			fmt.Printf("- **Updated**: %s\n", output.FormatTime(project.UpdatedAt, output.DateOnly))
 			fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL))
 			fmt.Println()
 		}
@@ -436,27 +580,13 @@ func renderViewProjects(projects *api.Projects, viewName string, plaintext, json
 			}
 		}
 
-		stateColor := color.New(color.FgGreen)
-		switch project.State {
-		case "planned":
-			stateColor = color.New(color.FgCyan)
-		case "started":
-			stateColor = color.New(color.FgBlue)
-		case "paused":
-			stateColor = color.New(color.FgYellow)
-		case "completed":
-			stateColor = color.New(color.FgGreen)
-		case "canceled":
-			stateColor = color.New(color.FgRed)
-		}
-
 		rows = append(rows, []string{
 			truncateString(project.Name, 25),
-			stateColor.Sprint(project.State),
+			projectStateText(project.State),
 			lead,
 			teams,
-			project.CreatedAt.Format("2006-01-02"),
-			project.UpdatedAt.Format("2006-01-02"),
+			output.FormatTime(project.CreatedAt, output.TableTimeFormat(plaintext, jsonOut)),
+			output.FormatTime(project.UpdatedAt, output.TableTimeFormat(plaintext, jsonOut)),
 			constructProjectURL(project.ID, project.URL),
 		})
 	}
@@ -470,11 +600,136 @@ func renderViewProjects(projects *api.Projects, viewName string, plaintext, json
 		color.New(color.FgGreen).Sprint("✓"),
 		len(projects.Nodes),
 		viewName)
+}
+
+var viewPreviewCmd = &cobra.Command{
+	Use:     "preview",
+	Aliases: []string{"try"},
+	Short:   "Run an issue filter without saving a view",
+	Long: `Run an issue filter built from --filter-* flags and/or --filter-json
+and show the matching issues, without creating a view.
 
-	if projects.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
-			color.New(color.FgYellow).Sprint("i"))
+Accepts the same filter flags as 'view create'.
+
+Examples:
+  linear-cli view preview --filter-assignee me --filter-state "In Progress"
+  linear-cli view preview --filter-team ENG --filter-label Bug --filter-label Regression
+  linear-cli view preview --filter-json '{"priority":{"lte":2}}' --filter-newer-than 1_month_ago --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		filter, err := viewFilterFromFlags(cmd)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid filter: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if filter == nil {
+			output.Error("Specify at least one --filter-* flag or --filter-json", plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		if limit == 0 {
+			limit = 50
+		}
+
+		issues, err := client.GetIssuesWithFields(context.Background(), filter, limit, "", "", issueListFields(plaintext, jsonOut))
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to run filter: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		warnIfTruncated(cmd, issues.PageInfo, len(issues.Nodes))
+
+		renderIssueCollection(issues, plaintext, jsonOut, "No issues match this filter", "issues", "# Preview", defaultDescriptionLines)
+	},
+}
+
+// addViewFilterFlags registers the issue filter-building flags shared by view create and preview
+func addViewFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String("filter-json", "", "Raw JSON filter (IssueFilter, ProjectFilter, or InitiativeFilter schema)")
+	cmd.Flags().String("filter-state", "", "Filter by state name")
+	cmd.Flags().String("filter-assignee", "", "Filter by assignee (email or 'me')")
+	cmd.Flags().String("filter-team", "", "Filter by team key")
+	cmd.Flags().StringSlice("filter-label", nil, "Filter by label name (repeatable, matches any)")
+	cmd.Flags().Int("filter-priority", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	cmd.Flags().String("filter-newer-than", "", "Only issues created after this time (e.g. 2_weeks_ago, 2025-01-01)")
+}
+
+var viewFilterFlagNames = []string{
+	"filter-state", "filter-assignee", "filter-team",
+	"filter-label", "filter-priority", "filter-newer-than",
+}
+
+// hasViewFilterFlags reports whether any --filter-* flag other than --filter-json was set
+func hasViewFilterFlags(cmd *cobra.Command) bool {
+	for _, name := range viewFilterFlagNames {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
 	}
+	return false
+}
+
+// viewFilterFromFlags builds a filter from --filter-json and the --filter-* flags.
+// Returns nil when no filter was requested.
+func viewFilterFromFlags(cmd *cobra.Command) (map[string]interface{}, error) {
+	var base map[string]interface{}
+	if filterJSON, _ := cmd.Flags().GetString("filter-json"); filterJSON != "" {
+		if err := json.Unmarshal([]byte(filterJSON), &base); err != nil {
+			return nil, fmt.Errorf("--filter-json: %v", err)
+		}
+	}
+
+	if !hasViewFilterFlags(cmd) {
+		return base, nil
+	}
+
+	opts := issueFilterOptions{IncludeCompleted: true}
+	opts.State, _ = cmd.Flags().GetString("filter-state")
+	opts.Assignee, _ = cmd.Flags().GetString("filter-assignee")
+	opts.Team, _ = cmd.Flags().GetString("filter-team")
+	opts.Labels, _ = cmd.Flags().GetStringSlice("filter-label")
+	opts.Priority, _ = cmd.Flags().GetInt("filter-priority")
+	opts.NewerThan, _ = cmd.Flags().GetString("filter-newer-than")
+
+	built, err := buildIssueFilter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("--filter-newer-than: %v", err)
+	}
+
+	merged, conflicts := mergeIssueFilters(base, built)
+	for _, key := range conflicts {
+		fmt.Fprintf(os.Stderr, "Warning: --filter-json field '%s' is overridden by a --filter-* flag\n", key)
+	}
+	return merged, nil
+}
+
+// mergeIssueFilters overlays the flag-built filter onto the raw JSON filter.
+// Top-level fields present in both are taken from flags and returned as conflicts.
+func mergeIssueFilters(base, flags map[string]interface{}) (map[string]interface{}, []string) {
+	merged := make(map[string]interface{}, len(base)+len(flags))
+	for k, v := range base {
+		merged[k] = v
+	}
+	var conflicts []string
+	for k, v := range flags {
+		if _, ok := merged[k]; ok {
+			conflicts = append(conflicts, k)
+		}
+		merged[k] = v
+	}
+	sort.Strings(conflicts)
+	return merged, conflicts
 }
 
 var viewCreateCmd = &cobra.Command{
@@ -485,8 +740,16 @@ var viewCreateCmd = &cobra.Command{
 
 The --filter-json flag accepts raw JSON matching Linear's IssueFilter, ProjectFilter, or InitiativeFilter schema.
 
+For issue views, the --filter-* flags build the IssueFilter the same way
+'issue list' does. They are merged with --filter-json; when both set the
+same field, the flag wins and a warning is printed. Unlike 'issue list',
+completed and canceled issues are not excluded unless --filter-state is set.
+Use 'view preview' to check the results before saving.
+
 Examples:
   linear-cli view create --name "My Bugs" --model issue
+  linear-cli view create --name "My Urgent Bugs" --filter-assignee me --filter-label Bug --filter-priority 1
+  linear-cli view create --name "Recent ENG" --filter-team ENG --filter-newer-than 2_weeks_ago
   linear-cli view create --name "Active Projects" --model project --shared
   linear-cli view create --name "Urgent Issues" --filter-json '{"priority":{"eq":1}}'
   linear-cli view create --name "Team Bugs" --team ENG --filter-json '{"state":{"type":{"eq":"started"}}}'
@@ -494,22 +757,25 @@ Examples:
   linear-cli view create --name "Team View" --owner me --team ENG`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
 
 		name, _ := cmd.Flags().GetString("name")
-		description, _ := cmd.Flags().GetString("description")
+		description, _, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
 		modelName, _ := cmd.Flags().GetString("model")
 		teamKey, _ := cmd.Flags().GetString("team")
 		shared, _ := cmd.Flags().GetBool("shared")
-		filterJSON, _ := cmd.Flags().GetString("filter-json")
 		icon, _ := cmd.Flags().GetString("icon")
 		colorVal, _ := cmd.Flags().GetString("color")
 		ownerFlag, _ := cmd.Flags().GetString("owner")
@@ -518,7 +784,7 @@ Examples:
 
 		if name == "" {
 			output.Error("Name is required (--name)", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		input := map[string]interface{}{
@@ -541,8 +807,8 @@ Examples:
 		if teamKey != "" {
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			input["teamId"] = team.ID
 		}
@@ -552,14 +818,14 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = viewer.ID
 			} else {
 				user, err := client.GetUser(context.Background(), ownerFlag)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to find user '%s': %v", ownerFlag, err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = user.ID
 			}
@@ -573,12 +839,17 @@ Examples:
 			input["initiativeId"] = initiativeID
 		}
 
-		if filterJSON != "" {
-			var filterData map[string]interface{}
-			if err := json.Unmarshal([]byte(filterJSON), &filterData); err != nil {
-				output.Error(fmt.Sprintf("Invalid filter JSON: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		filterData, err := viewFilterFromFlags(cmd)
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid filter: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasViewFilterFlags(cmd) && modelName != "" && modelName != "issue" {
+			output.Error("--filter-* flags build an IssueFilter and require --model issue; use --filter-json for other models", plaintext, jsonOut)
+			exit(1)
+		}
+
+		if filterData != nil {
 			switch modelName {
 			case "project":
 				input["projectFilterData"] = filterData
@@ -592,18 +863,24 @@ Examples:
 		view, err := client.CreateCustomView(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create view: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "view", view.ID, view.Name, &journal.Inverse{Action: "delete"})
 
+		if printCreated(cmd, createdEntity{Type: "view", ID: view.ID, Name: view.Name, URL: viewURL(view)}) {
+			return
+		}
 		if jsonOut {
 			output.JSON(view)
 		} else if plaintext {
 			fmt.Printf("Created view: %s (ID: %s)\n", view.Name, view.ID)
+			printResultURL(viewURL(view), plaintext)
 		} else {
 			fmt.Printf("%s Created view %s (ID: %s)\n",
 				color.New(color.FgGreen).Sprint("✓"),
 				color.New(color.FgCyan, color.Bold).Sprint(view.Name),
 				color.New(color.FgWhite, color.Faint).Sprint(view.ID))
+			printResultURL(viewURL(view), plaintext)
 		}
 	},
 }
@@ -623,12 +900,12 @@ Examples:
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
-		jsonOut := viper.GetBool("json")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
 
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -640,8 +917,12 @@ Examples:
 			input["name"] = name
 		}
 
-		if cmd.Flags().Changed("description") {
-			description, _ := cmd.Flags().GetString("description")
+		description, hasDescription, err := proseFromFlags(cmd, "description")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if hasDescription {
 			input["description"] = description
 		}
 
@@ -664,8 +945,8 @@ Examples:
 			teamKey, _ := cmd.Flags().GetString("team")
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut, err)
+				exit(1)
 			}
 			input["teamId"] = team.ID
 		}
@@ -676,14 +957,14 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = viewer.ID
 			} else {
 				user, err := client.GetUser(context.Background(), ownerFlag)
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to find user '%s': %v", ownerFlag, err), plaintext, jsonOut)
-					os.Exit(1)
+					exit(1)
 				}
 				input["ownerId"] = user.ID
 			}
@@ -704,14 +985,14 @@ Examples:
 			var filterData map[string]interface{}
 			if err := json.Unmarshal([]byte(filterJSON), &filterData); err != nil {
 				output.Error(fmt.Sprintf("Invalid filter JSON: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 
 			// Need to check the view's model to decide which filter field to use
 			view, err := client.GetCustomView(context.Background(), args[0])
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to fetch view: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				exit(1)
 			}
 			switch strings.ToLower(view.ModelName) {
 			case "project":
@@ -725,22 +1006,26 @@ Examples:
 
 		if len(input) == 0 {
 			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		view, err := client.UpdateCustomView(context.Background(), args[0], input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update view: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "view", view.ID, view.Name, nil)
 
-		if jsonOut {
+		if urlOnly(cmd) {
+			printURLOnly(viewURL(view), "view")
+		} else if jsonOut {
 			output.JSON(view)
 		} else if plaintext {
 			fmt.Printf("Updated view: %s\n", view.Name)
 			fmt.Printf("ID: %s\n", view.ID)
 			fmt.Printf("Model: %s\n", view.ModelName)
 			fmt.Printf("Shared: %v\n", view.Shared)
+			printResultURL(viewURL(view), plaintext)
 		} else {
 			fmt.Printf("%s Updated view %s\n",
 				color.New(color.FgGreen).Sprint("✓"),
@@ -752,10 +1037,19 @@ Examples:
 				shared = color.New(color.FgGreen).Sprint("Yes")
 			}
 			fmt.Printf("  Shared: %s\n", shared)
+			printResultURL(viewURL(view), plaintext)
 		}
 	},
 }
 
+// viewURL links to a custom view in its workspace
+func viewURL(view *api.CustomView) string {
+	if view.Organization == nil {
+		return ""
+	}
+	return constructViewURL(view.Organization.URLKey, view.SlugId)
+}
+
 var viewDeleteCmd = &cobra.Command{
 	Use:     "delete [view-id]",
 	Aliases: []string{"rm"},
@@ -769,7 +1063,7 @@ var viewDeleteCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
 
 		client := api.NewClient(authHeader)
@@ -777,8 +1071,9 @@ var viewDeleteCmd = &cobra.Command{
 		err = client.DeleteCustomView(context.Background(), args[0])
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to delete view: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			exit(1)
 		}
+		recordOperation(cmd, "view", args[0], "", nil)
 
 		if jsonOut {
 			output.JSON(map[string]interface{}{"success": true, "id": args[0], "action": "deleted"})
@@ -798,6 +1093,7 @@ func init() {
 	viewCmd.AddCommand(viewGetCmd)
 	viewCmd.AddCommand(viewRunCmd)
 	viewCmd.AddCommand(viewCreateCmd)
+	viewCmd.AddCommand(viewPreviewCmd)
 	viewCmd.AddCommand(viewUpdateCmd)
 	viewCmd.AddCommand(viewDeleteCmd)
 
@@ -807,26 +1103,41 @@ func init() {
 	viewListCmd.Flags().StringP("model", "m", "", "Filter by model type (issue, project)")
 	viewListCmd.Flags().StringP("team", "t", "", "Filter by team key")
 
+	// Get flags
+	viewGetCmd.Flags().Bool("raw-filter", false, "Show the filter as JSON instead of readable conditions")
+
 	// Run flags
 	viewRunCmd.Flags().IntP("limit", "l", 50, "Maximum number of results to fetch")
+	viewRunCmd.Flags().String("save-snapshot", "", "Save the full result set as a named snapshot for 'view diff'")
+	addDashboardFlags(viewRunCmd)
+	addScriptFlags(viewRunCmd, "issue identifiers (project IDs for a project view)")
+	addIssueSortFlags(viewRunCmd)
 
 	// Create flags
 	viewCreateCmd.Flags().String("name", "", "View name (required)")
 	viewCreateCmd.Flags().StringP("description", "d", "", "View description")
+	viewCreateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	viewCreateCmd.Flags().StringP("model", "m", "issue", "Model type: issue (default), project, initiative")
 	viewCreateCmd.Flags().StringP("team", "t", "", "Team key")
 	viewCreateCmd.Flags().Bool("shared", false, "Make the view shared")
-	viewCreateCmd.Flags().String("filter-json", "", "Raw JSON filter (IssueFilter, ProjectFilter, or InitiativeFilter schema)")
+	addViewFilterFlags(viewCreateCmd)
 	viewCreateCmd.Flags().String("icon", "", "View icon (emoji)")
 	viewCreateCmd.Flags().String("color", "", "View color (hex code)")
 	viewCreateCmd.Flags().String("owner", "", "Owner email or 'me'")
 	viewCreateCmd.Flags().String("project-id", "", "Associated project ID")
 	viewCreateCmd.Flags().String("initiative-id", "", "Associated initiative ID")
+	addURLOnlyFlag(viewCreateCmd)
+	addPorcelainFlag(viewCreateCmd)
 	_ = viewCreateCmd.MarkFlagRequired("name")
 
+	// Preview flags
+	addViewFilterFlags(viewPreviewCmd)
+	viewPreviewCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
+
 	// Update flags
 	viewUpdateCmd.Flags().String("name", "", "New name for the view")
 	viewUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	viewUpdateCmd.Flags().String("description-file", "", "Read description from a markdown file or https:// URL (use - for stdin)")
 	viewUpdateCmd.Flags().Bool("shared", false, "Set shared status")
 	viewUpdateCmd.Flags().String("filter-json", "", "New raw JSON filter")
 	viewUpdateCmd.Flags().String("icon", "", "View icon (emoji)")
@@ -835,4 +1146,5 @@ func init() {
 	viewUpdateCmd.Flags().StringP("team", "t", "", "Team key")
 	viewUpdateCmd.Flags().String("project-id", "", "Associated project ID")
 	viewUpdateCmd.Flags().String("initiative-id", "", "Associated initiative ID")
+	addURLOnlyFlag(viewUpdateCmd)
 }
@@ -0,0 +1,480 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// viewApplyIssueFlags and viewApplyProjectFlags are the changes view apply
+// can make to an issue view's issues and a project view's projects; --state
+// works for both
+var (
+	viewApplyIssueFlags   = []string{"state", "assignee", "priority", "estimate", "due-date", "cycle", "add-label", "remove-label", "snooze-until"}
+	viewApplyProjectFlags = []string{"state", "lead"}
+)
+
+// projectStates are the states a project can be moved to
+var projectStates = []string{"backlog", "planned", "started", "paused", "completed", "canceled"}
+
+// viewApplyResult is the outcome of updating one issue or project
+type viewApplyResult struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+
+	input   map[string]interface{}
+	inverse *journal.Inverse
+}
+
+// viewApplySummary is the JSON output of view apply
+type viewApplySummary struct {
+	View    string            `json:"view"`
+	Model   string            `json:"model"`
+	Matched int               `json:"matched"`
+	Updated int               `json:"updated"`
+	Failed  int               `json:"failed"`
+	Results []viewApplyResult `json:"results"`
+}
+
+var viewApplyCmd = &cobra.Command{
+	Use:   "apply [view-id]",
+	Short: "Apply a change to everything a view matches",
+	Long: `Run a view and apply the same change to every issue or project it returns.
+
+For issue views the changes mirror 'issue update': --state (looked up in each
+issue's team), --assignee, --priority, --estimate, --due-date, --cycle,
+--add-label, --remove-label, and --snooze-until. For project views, --state
+(planned, started, paused, completed, canceled, backlog) and --lead. At least
+one change is required.
+
+The matched items are listed and you're asked to confirm; pass --yes in
+scripts or with --json. --limit caps how many items are touched (default:
+every match). Updates run a few at a time, each item's result is reported,
+and the command exits 1 if any failed. Every update is recorded in
+'linear-cli history'.
+
+Examples:
+  linear-cli view apply VIEW-ID --add-label needs-triage --assignee me --state Backlog
+  linear-cli view apply VIEW-ID --priority 2 --limit 20 --yes
+  linear-cli view apply PROJECT-VIEW-ID --state paused --lead me --yes --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		if len(changedFlags(cmd, viewApplyIssueFlags, viewApplyProjectFlags)) == 0 {
+			output.Error("No changes specified; pass at least one of --"+strings.Join(append(viewApplyIssueFlags, "lead"), ", --"), plaintext, jsonOut)
+			exit(1)
+		}
+		if limit < 0 {
+			output.Error(fmt.Sprintf("invalid --limit value %d: must be 0 (every match) or more", limit), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		view, err := client.GetCustomView(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch view: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		summary := viewApplySummary{View: view.Name, Model: strings.ToLower(view.ModelName)}
+		var apply func(result *viewApplyResult) error
+		switch summary.Model {
+		case "issue":
+			summary.Results, apply, err = planViewIssueChanges(cmd, client, view.ID, limit)
+		case "project":
+			summary.Results, apply, err = planViewProjectChanges(cmd, client, view.ID, limit)
+		default:
+			err = fmt.Errorf("view apply doesn't support %s views", view.ModelName)
+		}
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut, err)
+			exit(1)
+		}
+		summary.Matched = len(summary.Results)
+		if summary.Matched == 0 {
+			output.Info(fmt.Sprintf("No %ss match view %q; nothing to change", summary.Model, view.Name), plaintext, jsonOut)
+			return
+		}
+
+		if err := confirmViewApply(cmd, summary, plaintext, jsonOut); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+
+		runViewApply(summary.Results, apply)
+		for _, result := range summary.Results {
+			if result.Status == "updated" {
+				summary.Updated++
+				recordOperation(cmd, summary.Model, result.ID, result.Name, result.inverse)
+			} else {
+				summary.Failed++
+			}
+		}
+
+		if jsonOut {
+			output.JSON(summary)
+		} else {
+			printViewApplySummary(summary, plaintext)
+		}
+		if summary.Failed > 0 {
+			exit(1)
+		}
+	},
+}
+
+// changedFlags lists the flags of the given sets that were passed, in order
+// and without repeats
+func changedFlags(cmd *cobra.Command, sets ...[]string) []string {
+	var changed []string
+	seen := map[string]bool{}
+	for _, set := range sets {
+		for _, name := range set {
+			if !seen[name] && cmd.Flags().Changed(name) {
+				changed = append(changed, name)
+			}
+			seen[name] = true
+		}
+	}
+	return changed
+}
+
+// refuseFlags errors if any of the named flags was passed, since it doesn't
+// apply to this kind of view
+func refuseFlags(cmd *cobra.Command, names []string, kind string) error {
+	for _, name := range names {
+		if cmd.Flags().Changed(name) {
+			return fmt.Errorf("--%s only applies to %s views", name, kind)
+		}
+	}
+	return nil
+}
+
+// planViewIssueChanges fetches the view's issues and builds each one's
+// update. Names are resolved up front, so a typo fails before anything
+// changes.
+func planViewIssueChanges(cmd *cobra.Command, client *api.Client, viewID string, limit int) ([]viewApplyResult, func(*viewApplyResult) error, error) {
+	if err := refuseFlags(cmd, []string{"lead"}, "project"); err != nil {
+		return nil, nil, err
+	}
+	ctx := context.Background()
+	input := map[string]interface{}{}
+
+	if cmd.Flags().Changed("assignee") {
+		assignee, _ := cmd.Flags().GetString("assignee")
+		id, err := resolveUserRef(client, assignee)
+		if err != nil {
+			return nil, nil, err
+		}
+		input["assigneeId"] = id
+	}
+	if cmd.Flags().Changed("priority") {
+		priority, _ := cmd.Flags().GetInt("priority")
+		if priority < 0 || priority > 4 {
+			return nil, nil, fmt.Errorf("invalid --priority value %d: use 0-4", priority)
+		}
+		input["priority"] = priority
+	}
+	if cmd.Flags().Changed("estimate") {
+		estimate, _ := cmd.Flags().GetInt("estimate")
+		if estimate < 0 {
+			input["estimate"] = nil
+		} else {
+			input["estimate"] = estimate
+		}
+	}
+	if cmd.Flags().Changed("due-date") {
+		dueDate, _ := cmd.Flags().GetString("due-date")
+		input["dueDate"] = nullIfEmpty(dueDate)
+	}
+	if cmd.Flags().Changed("cycle") {
+		cycle, _ := cmd.Flags().GetString("cycle")
+		if strings.EqualFold(cycle, "none") {
+			cycle = ""
+		}
+		input["cycleId"] = nullIfEmpty(cycle)
+	}
+	if cmd.Flags().Changed("snooze-until") {
+		until, _ := cmd.Flags().GetString("snooze-until")
+		input["snoozedUntilAt"] = nullIfEmpty(until)
+	}
+	// Labels can be per team, so like --state they are picked per issue's
+	// team below; a name no label has fails here
+	labelFlags := map[string]string{"add-label": "addedLabelIds", "remove-label": "removedLabelIds"}
+	labelNames := map[string][]string{}
+	var labels []api.Label
+	for flag := range labelFlags {
+		names, _ := cmd.Flags().GetStringSlice(flag)
+		if len(names) == 0 {
+			continue
+		}
+		if labels == nil {
+			var err error
+			if labels, err = fetchAllLabels(client); err != nil {
+				return nil, nil, fmt.Errorf("failed to fetch labels: %w", err)
+			}
+		}
+		for _, name := range names {
+			if _, err := findLabel(labels, name); err != nil {
+				return nil, nil, err
+			}
+		}
+		labelNames[flag] = names
+	}
+
+	issues, err := fetchIssuePagesUpTo(func(after string) (*api.Issues, error) {
+		return client.GetCustomViewIssues(ctx, viewID, 100, after)
+	}, limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to run view: %w", err)
+	}
+
+	// Workflow states belong to teams, so --state is looked up once per team,
+	// and so are labels
+	stateIDs := map[string]string{}
+	labelIDs := map[string]string{}
+	stateName, _ := cmd.Flags().GetString("state")
+	results := make([]viewApplyResult, 0, len(issues.Nodes))
+	for i := range issues.Nodes {
+		issue := &issues.Nodes[i]
+		issueInput := make(map[string]interface{}, len(input)+1)
+		for k, v := range input {
+			issueInput[k] = v
+		}
+		if cmd.Flags().Changed("state") {
+			if issue.Team == nil {
+				return nil, nil, fmt.Errorf("issue %s has no team to look --state up in", issue.Identifier)
+			}
+			id, ok := stateIDs[issue.Team.Key]
+			if !ok {
+				states, err := client.GetTeamStates(ctx, issue.Team.Key)
+				if err != nil {
+					return nil, nil, fmt.Errorf("failed to get %s states: %w", issue.Team.Key, err)
+				}
+				state, err := findState(states, stateName)
+				if err != nil {
+					return nil, nil, fmt.Errorf("team %s: %w", issue.Team.Key, err)
+				}
+				id = state.ID
+				stateIDs[issue.Team.Key] = id
+			}
+			issueInput["stateId"] = id
+		}
+		teamKey := ""
+		if issue.Team != nil {
+			teamKey = issue.Team.Key
+		}
+		for flag, names := range labelNames {
+			var ids []string
+			for _, name := range names {
+				cacheKey := teamKey + "\x00" + strings.ToLower(name)
+				id, ok := labelIDs[cacheKey]
+				if !ok {
+					label, err := pickLabel(labelsNamedIn(labels, name), name, teamKey)
+					if err != nil {
+						return nil, nil, fmt.Errorf("issue %s: %w", issue.Identifier, err)
+					}
+					id = label.ID
+					labelIDs[cacheKey] = id
+				}
+				ids = append(ids, id)
+			}
+			issueInput[labelFlags[flag]] = ids
+		}
+		results = append(results, viewApplyResult{
+			ID:      issue.ID,
+			Name:    issue.Identifier,
+			input:   issueInput,
+			inverse: issueUpdateInverse(issue, issueInput),
+		})
+	}
+
+	apply := func(result *viewApplyResult) error {
+		_, err := client.UpdateIssue(ctx, result.ID, result.input)
+		return err
+	}
+	return results, apply, nil
+}
+
+// planViewProjectChanges fetches the view's projects and builds each one's
+// update
+func planViewProjectChanges(cmd *cobra.Command, client *api.Client, viewID string, limit int) ([]viewApplyResult, func(*viewApplyResult) error, error) {
+	// Everything but --state, which comes first, is for issues only
+	if err := refuseFlags(cmd, viewApplyIssueFlags[1:], "issue"); err != nil {
+		return nil, nil, err
+	}
+	ctx := context.Background()
+	input := map[string]interface{}{}
+
+	if cmd.Flags().Changed("state") {
+		state, _ := cmd.Flags().GetString("state")
+		state = strings.ToLower(state)
+		if !slices.Contains(projectStates, state) {
+			return nil, nil, fmt.Errorf("invalid project state %q: use %s", state, strings.Join(projectStates, ", "))
+		}
+		input["state"] = state
+	}
+	if cmd.Flags().Changed("lead") {
+		lead, _ := cmd.Flags().GetString("lead")
+		id, err := resolveUserRef(client, lead)
+		if err != nil {
+			return nil, nil, err
+		}
+		input["leadId"] = id
+	}
+
+	projects, err := fetchViewProjectsUpTo(client, viewID, limit)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to run view: %w", err)
+	}
+	results := make([]viewApplyResult, 0, len(projects.Nodes))
+	for i := range projects.Nodes {
+		project := &projects.Nodes[i]
+		results = append(results, viewApplyResult{
+			ID:      project.ID,
+			Name:    project.Name,
+			input:   input,
+			inverse: restoreInverse(project, input),
+		})
+	}
+
+	apply := func(result *viewApplyResult) error {
+		_, err := client.UpdateProject(ctx, result.ID, result.input)
+		return err
+	}
+	return results, apply, nil
+}
+
+// resolveUserRef turns 'me', 'none', or an email or name into a user ID,
+// with nil for none
+func resolveUserRef(client *api.Client, ref string) (interface{}, error) {
+	switch strings.ToLower(ref) {
+	case "me":
+		viewer, err := client.GetViewer(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current user: %w", err)
+		}
+		return viewer.ID, nil
+	case "none", "unassigned", "":
+		return nil, nil
+	}
+	user, err := newUserLookup(client).find(ref)
+	if err != nil {
+		return nil, err
+	}
+	return user.ID, nil
+}
+
+// nullIfEmpty maps an empty flag value to null, which unsets the field
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+// confirmViewApply lists what will change and asks before going ahead,
+// unless --yes was given. Without a terminal to ask on it refuses.
+func confirmViewApply(cmd *cobra.Command, summary viewApplySummary, plaintext, jsonOut bool) error {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return nil
+	}
+	var changes []string
+	for _, name := range changedFlags(cmd, viewApplyIssueFlags, viewApplyProjectFlags) {
+		changes = append(changes, fmt.Sprintf("--%s %s", name, cmd.Flags().Lookup(name).Value.String()))
+	}
+	question := fmt.Sprintf("Apply %s to %d %s(s) in view %q?", strings.Join(changes, " "), summary.Matched, summary.Model, summary.View)
+	if jsonOut || !stdinIsTerminal() {
+		return fmt.Errorf("refusing to change %d %s(s) in view %q without confirmation; pass --yes", summary.Matched, summary.Model, summary.View)
+	}
+	for _, result := range summary.Results {
+		fmt.Fprintf(os.Stderr, "  %s\n", result.Name)
+	}
+	if !confirmPrompt(question) {
+		return errors.New("aborted")
+	}
+	return nil
+}
+
+// runViewApply applies every planned update on the shared pool, filling in
+// each result's status
+func runViewApply(results []viewApplyResult, apply func(*viewApplyResult) error) {
+	entity := func(i int) string { return results[i].Name }
+	errs := runWithProgress("Applying changes", len(results), entity, func(ctx context.Context, i int) error {
+		return apply(&results[i])
+	})
+	for i, err := range errs {
+		results[i].Status = "updated"
+		if err != nil {
+			results[i].Status = "failed"
+			results[i].Error = err.Error()
+		}
+	}
+}
+
+// printViewApplySummary prints each item's outcome and the totals
+func printViewApplySummary(summary viewApplySummary, plaintext bool) {
+	for _, result := range summary.Results {
+		switch {
+		case plaintext && result.Error != "":
+			fmt.Printf("- %s: failed (%s)\n", result.Name, result.Error)
+		case plaintext:
+			fmt.Printf("- %s: updated\n", result.Name)
+		case result.Error != "":
+			fmt.Printf("  %s %s: %s\n", color.New(color.FgRed).Sprint("✗"), result.Name, result.Error)
+		default:
+			fmt.Printf("  %s %s\n", color.New(color.FgGreen).Sprint("✓"), result.Name)
+		}
+	}
+	line := fmt.Sprintf("Updated %d of %d %ss in view %q", summary.Updated, summary.Matched, summary.Model, summary.View)
+	if summary.Failed > 0 {
+		line += fmt.Sprintf(", %d failed", summary.Failed)
+	}
+	if plaintext {
+		fmt.Printf("\n%s\n", line)
+		return
+	}
+	mark := color.New(color.FgGreen).Sprint("✓")
+	if summary.Failed > 0 {
+		mark = color.New(color.FgYellow).Sprint("⚠")
+	}
+	fmt.Printf("\n%s %s\n", mark, line)
+}
+
+func init() {
+	viewCmd.AddCommand(viewApplyCmd)
+
+	viewApplyCmd.Flags().StringP("state", "s", "", "Workflow state name (issue views) or project state (project views)")
+	viewApplyCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'none')")
+	viewApplyCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	viewApplyCmd.Flags().IntP("estimate", "e", -1, "Estimate points (or -1 to remove)")
+	viewApplyCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD, or empty to remove)")
+	viewApplyCmd.Flags().String("cycle", "", "Cycle ID (or 'none' to remove from cycle)")
+	viewApplyCmd.Flags().StringSlice("add-label", nil, "Add labels by name (repeatable)")
+	viewApplyCmd.Flags().StringSlice("remove-label", nil, "Remove labels by name (repeatable)")
+	viewApplyCmd.Flags().String("snooze-until", "", "Snooze until date/time (or empty to unsnooze)")
+	viewApplyCmd.Flags().StringP("lead", "L", "", "Project lead for project views (email, name, 'me', or 'none')")
+	viewApplyCmd.Flags().IntP("limit", "l", 0, "Change at most this many matches (default: every match)")
+	viewApplyCmd.Flags().Bool("yes", false, "Don't ask for confirmation")
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+func TestHermeticViewApplyIssues(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"Untriaged","modelName":"Issue"}}`)
+	s.Data("CustomViewIssues", `{"customView":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","team":{"id":"t1","key":"ENG"},"labels":{"nodes":[]}},
+		{"id":"i2","identifier":"OPS-2","team":{"id":"t2","key":"OPS"},"labels":{"nodes":[]}}],
+		"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`)
+	s.DataFor("CustomViewIssues", map[string]interface{}{"after": "c1"}, `{"customView":{"issues":{"nodes":[
+		{"id":"i3","identifier":"ENG-3","team":{"id":"t1","key":"ENG"}}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("Labels", `{"issueLabels":{"nodes":[{"id":"l1","name":"needs-triage"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Me", `{"viewer":{"id":"u1","name":"Ann"}}`)
+	s.DataFor("TeamStates", map[string]interface{}{"key": "ENG"}, `{"team":{"states":{"nodes":[{"id":"s1","name":"Backlog"}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.DataFor("TeamStates", map[string]interface{}{"key": "OPS"}, `{"team":{"states":{"nodes":[{"id":"s2","name":"Backlog"}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"x"}}}`)
+	s.Add(linearmock.Stub{Operation: "UpdateIssue", Variables: map[string]interface{}{"id": "i2"},
+		Response: json.RawMessage(`{"errors":[{"message":"issue is archived"}]}`)})
+
+	r := runMocked(t, "view", "apply", "v1", "--add-label", "needs-triage", "--assignee", "me", "--state", "Backlog", "--yes", "--json")
+	var got viewApplySummary
+	if json.Unmarshal([]byte(r.Stdout), &got) != nil {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if r.Exit != 1 || got.Matched != 3 || got.Updated != 2 || got.Failed != 1 ||
+		got.Results[1].Name != "OPS-2" || !strings.Contains(got.Results[1].Error, "archived") {
+		t.Errorf("exited %d: %s", r.Exit, r.Stdout)
+	}
+
+	inputs := map[string]string{}
+	for _, req := range s.Requests() {
+		if strings.Contains(req.Query, "mutation UpdateIssue") {
+			inputs[req.Variables["id"].(string)] = mustJSON(t, req.Variables["input"])
+		}
+	}
+	if len(inputs) != 3 {
+		t.Fatalf("updated %d issues, want 3", len(inputs))
+	}
+	for id, state := range map[string]string{"i1": "s1", "i2": "s2", "i3": "s1"} {
+		for _, want := range []string{`"stateId":"` + state + `"`, `"assigneeId":"u1"`, `"addedLabelIds":["l1"]`} {
+			if !strings.Contains(inputs[id], want) {
+				t.Errorf("%s input %s lacks %s", id, inputs[id], want)
+			}
+		}
+	}
+
+	// --limit caps what's touched
+	s.Reset()
+	r = runMocked(t, "view", "apply", "v1", "--priority", "2", "--limit", "1", "--yes", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "- ENG-1: updated\n\nUpdated 1 of 1 issues in view \"Untriaged\"") {
+		t.Errorf("--limit exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	if got := strings.Join(s.Operations(), ","); got != "CustomView,CustomViewIssues,UpdateIssue" {
+		t.Errorf("operations = %s", got)
+	}
+
+	// Nothing changes without --yes when there's no terminal to ask on
+	s.Reset()
+	if r := runMocked(t, "view", "apply", "v1", "--priority", "2"); r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "pass --yes") {
+		t.Errorf("unconfirmed exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "UpdateIssue" {
+			t.Error("updated an issue without confirmation")
+		}
+	}
+
+	for _, args := range [][]string{
+		{"view", "apply", "v1", "--yes"},
+		{"view", "apply", "v1", "--lead", "me", "--yes"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+}
+
+func TestHermeticViewApplyTeamLabels(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"Untriaged","modelName":"Issue"}}`)
+	s.Data("CustomViewIssues", `{"customView":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","team":{"id":"t1","key":"ENG"}},
+		{"id":"i2","identifier":"OPS-2","team":{"id":"t2","key":"OPS"}},
+		{"id":"i3","identifier":"ENG-3","team":{"id":"t1","key":"ENG"}}],"pageInfo":{"hasNextPage":false}}}}`)
+	// Each team has its own "bug", on the second page of labels
+	s.Data("Labels", `{"issueLabels":{"nodes":[{"id":"l1","name":"needs-triage"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	s.DataFor("Labels", map[string]interface{}{"after": "c1"}, `{"issueLabels":{"nodes":[
+		{"id":"l2","name":"bug","team":{"id":"t1","key":"ENG"}},
+		{"id":"l3","name":"bug","team":{"id":"t2","key":"OPS"}}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"x"}}}`)
+
+	r := runMocked(t, "view", "apply", "v1", "--add-label", "Bug", "--remove-label", "needs-triage", "--yes", "--json")
+	if r.Exit != 0 {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	labelPages := 0
+	inputs := map[string]string{}
+	for _, req := range s.Requests() {
+		switch req.Operation {
+		case "Labels":
+			labelPages++
+		case "UpdateIssue":
+			inputs[req.Variables["id"].(string)] = mustJSON(t, req.Variables["input"])
+		}
+	}
+	if labelPages != 2 {
+		t.Errorf("fetched %d label pages, want each of the 2 once", labelPages)
+	}
+	for id, label := range map[string]string{"i1": "l2", "i2": "l3", "i3": "l2"} {
+		for _, want := range []string{`"addedLabelIds":["` + label + `"]`, `"removedLabelIds":["l1"]`} {
+			if !strings.Contains(inputs[id], want) {
+				t.Errorf("%s input %s lacks %s", id, inputs[id], want)
+			}
+		}
+	}
+
+	// A team without the label can't have another team's applied
+	s.Reset()
+	s.Data("CustomViewIssues", `{"customView":{"issues":{"nodes":[
+		{"id":"i4","identifier":"WEB-4","team":{"id":"t3","key":"WEB"}}],"pageInfo":{"hasNextPage":false}}}}`)
+	r = runMocked(t, "view", "apply", "v1", "--add-label", "bug", "--yes")
+	if r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "WEB-4") {
+		t.Errorf("WEB issue exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	for _, op := range s.Operations() {
+		if op == "UpdateIssue" {
+			t.Error("updated an issue with no matching label")
+		}
+	}
+
+	if r := runMocked(t, "view", "apply", "v1", "--add-label", "feature", "--yes"); r.Exit != 1 || !strings.Contains(r.Stdout+r.Stderr, "feature") {
+		t.Errorf("unknown label exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
+
+func TestHermeticViewApplyProjects(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v2","name":"Stalled","modelName":"Project"}}`)
+	s.Data("CustomViewProjects", `{"customView":{"projects":{"nodes":[{"id":"p1","name":"Auth","state":"started","lead":{"id":"u9"}}],
+		"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("Me", `{"viewer":{"id":"u1","name":"Ann"}}`)
+	s.Data("ProjectUpdate", `{"projectUpdate":{"success":true,"project":{"id":"p1","name":"Auth"}}}`)
+
+	r := runMocked(t, "view", "apply", "v2", "--state", "Paused", "--lead", "me", "--yes", "--json")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, `"updated": 1`) {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	reqs := s.Requests()
+	if input := mustJSON(t, reqs[len(reqs)-1].Variables["input"]); input != `{"leadId":"u1","state":"paused"}` {
+		t.Errorf("project input = %s", input)
+	}
+
+	for _, args := range [][]string{
+		{"view", "apply", "v2", "--state", "stalled", "--yes"},
+		{"view", "apply", "v2", "--add-label", "x", "--yes"},
+	} {
+		if r := runMocked(t, args...); r.Exit != 1 {
+			t.Errorf("%q exited %d", args, r.Exit)
+		}
+	}
+}
+
+func TestHermeticViewApplyProgress(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"Untriaged","modelName":"Issue"}}`)
+	s.Data("CustomViewIssues", `{"customView":{"issues":{"nodes":[
+		{"id":"i1","identifier":"ENG-1","team":{"id":"t1","key":"ENG"}},
+		{"id":"i2","identifier":"ENG-2","team":{"id":"t1","key":"ENG"}}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("UpdateIssue", `{"issueUpdate":{"success":true,"issue":{"id":"x"}}}`)
+
+	r := runMocked(t, "view", "apply", "v1", "--priority", "2", "--yes", "--json", "--progress", "json")
+	if r.Exit != 0 {
+		t.Fatalf("exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(r.Stderr), "\n")
+	if len(lines) != 4 || lines[0] != `{"event":"start","done":0,"total":2,"label":"Applying changes"}` ||
+		lines[3] != `{"event":"done","done":2,"total":2}` {
+		t.Fatalf("progress events:\n%s", r.Stderr)
+	}
+	for _, line := range lines[1:3] {
+		if !strings.HasPrefix(line, `{"event":"progress"`) || !strings.Contains(line, `"entity":"ENG-`) {
+			t.Errorf("progress event = %s", line)
+		}
+	}
+}
@@ -0,0 +1,516 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+)
+
+// filterResolver looks up the entities a view filter refers to by ID, one
+// batch per kind
+type filterResolver interface {
+	LookupNames(ctx context.Context, kind string, ids []string) (map[string]api.EntityName, error)
+}
+
+// filterIDKinds is the kind of entity an "id" comparator refers to, by the
+// field it sits under
+var filterIDKinds = map[string]string{
+	"assignee":    "user",
+	"creator":     "user",
+	"subscribers": "user",
+	"snoozedBy":   "user",
+	"lead":        "user",
+	"members":     "user",
+	"user":        "user",
+	"labels":      "label",
+	"team":        "team",
+	"teams":       "team",
+	"state":       "state",
+	"project":     "project",
+	"projects":    "project",
+	"cycle":       "cycle",
+}
+
+// filterQuantifiers are the collection filters that wrap a condition on
+// each member, e.g. labels.some
+var filterQuantifiers = map[string]bool{"some": true, "every": true, "none": true}
+
+// filterConditionLine is one line of a described filter; Depth nests the
+// conditions of an "any of" or "all of" group
+type filterConditionLine struct {
+	Depth int
+	Text  string
+}
+
+// filterIDRefs lists the IDs a filter refers to, by kind, sorted
+func filterIDRefs(filter map[string]interface{}) map[string][]string {
+	seen := map[string]map[string]bool{}
+	var walk func(path []string, v interface{})
+	walk = func(path []string, v interface{}) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, child := range v {
+				walk(append(path, key), child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(path, child)
+			}
+		case string:
+			kind := filterIDKind(path)
+			if kind == "" {
+				return
+			}
+			if seen[kind] == nil {
+				seen[kind] = map[string]bool{}
+			}
+			seen[kind][v] = true
+		}
+	}
+	walk(nil, filter)
+
+	refs := map[string][]string{}
+	for kind, ids := range seen {
+		for id := range ids {
+			refs[kind] = append(refs[kind], id)
+		}
+		sort.Strings(refs[kind])
+	}
+	return refs
+}
+
+// filterIDKind is the kind of entity the value at path names, or "" when it
+// isn't an ID comparison. path ends in the comparator, e.g.
+// [labels some id in].
+func filterIDKind(path []string) string {
+	if len(path) < 3 || path[len(path)-2] != "id" {
+		return ""
+	}
+	switch path[len(path)-1] {
+	case "eq", "neq", "in", "nin":
+	default:
+		return ""
+	}
+	for i := len(path) - 3; i >= 0; i-- {
+		if filterQuantifiers[path[i]] || path[i] == "and" || path[i] == "or" {
+			continue
+		}
+		return filterIDKinds[path[i]]
+	}
+	return ""
+}
+
+// resolveFilterNames names every entity the filters refer to, with one
+// lookup per kind. The result maps each ID to how it is shown.
+func resolveFilterNames(ctx context.Context, r filterResolver, filters ...map[string]interface{}) (map[string]string, error) {
+	refs := map[string][]string{}
+	for _, filter := range filters {
+		for kind, ids := range filterIDRefs(filter) {
+			refs[kind] = append(refs[kind], ids...)
+		}
+	}
+	kinds := make([]string, 0, len(refs))
+	for kind := range refs {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	names := map[string]string{}
+	for _, kind := range kinds {
+		found, err := r.LookupNames(ctx, kind, refs[kind])
+		if err != nil {
+			return names, fmt.Errorf("failed to look up %s names: %w", kind, err)
+		}
+		for id, e := range found {
+			names[id] = entityDisplayName(kind, e)
+		}
+	}
+	return names, nil
+}
+
+// entityDisplayName is how a filter description shows an entity
+func entityDisplayName(kind string, e api.EntityName) string {
+	switch {
+	case kind == "user" && e.Email != "":
+		return fmt.Sprintf("%s (%s)", e.Name, e.Email)
+	case kind == "team" && e.Key != "":
+		return fmt.Sprintf("%s (%s)", e.Name, e.Key)
+	case kind == "cycle" && e.Name == "":
+		return fmt.Sprintf("Cycle %d", e.Number)
+	}
+	return e.Name
+}
+
+// describeFilter turns a view's filter into one readable line per
+// condition, e.g. "state type is one of: started, unstarted". names maps
+// the IDs in the filter to display names (see resolveFilterNames); IDs
+// without one are shown as they are. Anything it doesn't understand is
+// shown as its raw JSON.
+func describeFilter(filter map[string]interface{}, names map[string]string) []filterConditionLine {
+	d := &filterDescriber{names: names}
+	d.node(filter, 0)
+	return d.lines
+}
+
+type filterDescriber struct {
+	names map[string]string
+	lines []filterConditionLine
+}
+
+func (d *filterDescriber) line(depth int, format string, args ...interface{}) {
+	d.lines = append(d.lines, filterConditionLine{Depth: depth, Text: fmt.Sprintf(format, args...)})
+}
+
+// node describes a filter object: each key is a condition, all of which
+// must hold
+func (d *filterDescriber) node(filter map[string]interface{}, depth int) {
+	for _, key := range sortedKeys(filter) {
+		d.field(nil, key, filter[key], depth)
+	}
+}
+
+// field describes the value under key, with path the fields above it
+func (d *filterDescriber) field(path []string, key string, value interface{}, depth int) {
+	switch key {
+	case "and", "or":
+		branches, ok := value.([]interface{})
+		if !ok {
+			d.raw(path, key, value, depth)
+			return
+		}
+		if key == "or" {
+			d.line(depth, "any of:")
+			d.branches(path, branches, depth+1, true)
+		} else {
+			d.branches(path, branches, depth, false)
+		}
+		return
+	}
+
+	if m, ok := value.(map[string]interface{}); ok {
+		for _, k := range sortedKeys(m) {
+			d.field(append(path[:len(path):len(path)], key), k, m[k], depth)
+		}
+		return
+	}
+	if text, ok := d.condition(path, key, value); ok {
+		d.line(depth, "%s", text)
+		return
+	}
+	d.raw(path, key, value, depth)
+}
+
+// branches describes the filters of an and/or list. In an "any of" group a
+// branch with several conditions becomes an "all of" group of its own.
+func (d *filterDescriber) branches(path []string, branches []interface{}, depth int, group bool) {
+	for _, branch := range branches {
+		m, ok := branch.(map[string]interface{})
+		if !ok {
+			d.raw(path, "", branch, depth)
+			continue
+		}
+		sub := &filterDescriber{names: d.names}
+		for _, k := range sortedKeys(m) {
+			sub.field(path, k, m[k], depth+1)
+		}
+		top := 0
+		for _, l := range sub.lines {
+			if l.Depth == depth+1 {
+				top++
+			}
+		}
+		if group && top > 1 {
+			d.line(depth, "all of:")
+			d.lines = append(d.lines, sub.lines...)
+			continue
+		}
+		for _, l := range sub.lines {
+			d.lines = append(d.lines, filterConditionLine{Depth: l.Depth - 1, Text: l.Text})
+		}
+	}
+}
+
+// raw shows a fragment the describer doesn't understand as its JSON
+func (d *filterDescriber) raw(path []string, key string, value interface{}, depth int) {
+	var fragment interface{} = value
+	if key != "" {
+		fragment = map[string]interface{}{key: value}
+	}
+	data, err := json.Marshal(fragment)
+	if err != nil {
+		data = []byte(fmt.Sprint(fragment))
+	}
+	if subject := filterSubject(path); subject != "" {
+		d.line(depth, "%s: %s", subject, data)
+		return
+	}
+	d.line(depth, "%s", data)
+}
+
+// condition phrases one comparison, e.g. path [state type], op "in". ok is
+// false for an operator it doesn't know.
+func (d *filterDescriber) condition(path []string, op string, value interface{}) (string, bool) {
+	if len(path) == 0 {
+		return "", false
+	}
+	subject := filterSubject(path)
+	last := path[len(path)-1]
+	if last == "isMe" && op == "eq" {
+		if value == true {
+			return subject + " is me", true
+		}
+		return subject + " is not me", true
+	}
+	isDate := filterIsDate(last)
+	someOf := len(path) >= 2 && path[len(path)-2] == "some"
+	values := func() string { return d.values(path, value, isDate) }
+
+	switch op {
+	case "null":
+		if value == true {
+			return subject + " is not set", true
+		}
+		return subject + " is set", true
+	case "eq":
+		if isDate {
+			return subject + " on " + values(), true
+		}
+		return subject + " is " + values(), true
+	case "neq":
+		if isDate {
+			return subject + " not on " + values(), true
+		}
+		return subject + " is not " + values(), true
+	case "in":
+		if someOf {
+			return subject + " is any of: " + values(), true
+		}
+		return subject + " is one of: " + values(), true
+	case "nin":
+		return subject + " is none of: " + values(), true
+	case "gt", "gte", "lt", "lte":
+		phrases := map[string][2]string{
+			"gt":  {"after", "is greater than"},
+			"gte": {"on or after", "is at least"},
+			"lt":  {"before", "is less than"},
+			"lte": {"on or before", "is at most"},
+		}[op]
+		if isDate {
+			return subject + " " + phrases[0] + " " + values(), true
+		}
+		return subject + " " + phrases[1] + " " + values(), true
+	case "eqIgnoreCase":
+		return subject + " is " + values() + " (ignoring case)", true
+	case "neqIgnoreCase":
+		return subject + " is not " + values() + " (ignoring case)", true
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return "", false
+	}
+	verbs := map[string]string{
+		"contains":                    "contains %q",
+		"containsIgnoreCase":          "contains %q (ignoring case)",
+		"containsIgnoreCaseAndAccent": "contains %q (ignoring case and accents)",
+		"notContains":                 "does not contain %q",
+		"notContainsIgnoreCase":       "does not contain %q (ignoring case)",
+		"startsWith":                  "starts with %q",
+		"startsWithIgnoreCase":        "starts with %q (ignoring case)",
+		"notStartsWith":               "does not start with %q",
+		"endsWith":                    "ends with %q",
+		"notEndsWith":                 "does not end with %q",
+	}
+	verb, ok := verbs[op]
+	if !ok {
+		return "", false
+	}
+	return subject + " " + fmt.Sprintf(verb, text), true
+}
+
+// values formats a comparison's operand, naming IDs and priorities
+func (d *filterDescriber) values(path []string, value interface{}, isDate bool) string {
+	one := func(v interface{}) string {
+		switch v := v.(type) {
+		case string:
+			if name, ok := d.names[v]; ok {
+				return name
+			}
+			if isDate {
+				return filterDate(v)
+			}
+			return v
+		case float64:
+			if path[len(path)-1] == "priority" && v == float64(int(v)) {
+				return priorityToString(int(v))
+			}
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		case nil:
+			return "none"
+		}
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return one(value)
+	}
+	parts := make([]string, len(list))
+	for i, v := range list {
+		parts[i] = one(v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// filterSubject names the field a path leads to, e.g. [labels some id] is
+// "label" and [project lead id] is "project lead"
+func filterSubject(path []string) string {
+	var words []string
+	prefix := ""
+	for i, segment := range path {
+		last := i == len(path)-1
+		switch {
+		case filterQuantifiers[segment]:
+			if len(words) > 0 {
+				words[len(words)-1] = singular(words[len(words)-1])
+			}
+			switch segment {
+			case "every":
+				prefix = "every "
+			case "none":
+				prefix = "no "
+			}
+		case segment == "isMe":
+		case (segment == "id" || segment == "name" || segment == "key") && last && i > 0:
+		case segment == "length" && len(words) > 0:
+			words[len(words)-1] = "number of " + words[len(words)-1]
+		default:
+			words = append(words, humanizeField(segment))
+		}
+	}
+	return prefix + strings.Join(words, " ")
+}
+
+// humanizeField turns a camelCase field name into words: dueDate is
+// "due date", createdAt is "created"
+func humanizeField(field string) string {
+	if field == "children" {
+		return "sub-issues"
+	}
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) && i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.TrimSuffix(b.String(), " at")
+}
+
+// singular names one member of a collection field
+func singular(word string) string {
+	return strings.TrimSuffix(word, "s")
+}
+
+// filterIsDate reports whether a field holds a date or time
+func filterIsDate(field string) bool {
+	return strings.HasSuffix(field, "At") || strings.HasSuffix(field, "Date") || field == "date"
+}
+
+// relativeDurationPattern matches the ISO 8601 durations Linear filters use
+// for relative dates, e.g. -P2W for two weeks ago
+var relativeDurationPattern = regexp.MustCompile(`^(-?)P(\d+)([DWMY])$`)
+
+// filterDate shows a date operand: a midnight timestamp as its date, a
+// relative duration in words
+func filterDate(value string) string {
+	if m := relativeDurationPattern.FindStringSubmatch(value); m != nil {
+		unit := map[string]string{"D": "day", "W": "week", "M": "month", "Y": "year"}[m[3]]
+		if m[2] != "1" {
+			unit += "s"
+		}
+		if m[1] == "-" {
+			return fmt.Sprintf("%s %s ago", m[2], unit)
+		}
+		return fmt.Sprintf("%s %s from now", m[2], unit)
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil && t.UTC().Format("15:04:05.000000000") == "00:00:00.000000000" {
+		return t.UTC().Format("2006-01-02")
+	}
+	return value
+}
+
+// sortedKeys returns a filter object's keys in a stable order
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// viewFilterSection is one of a view's filters and its heading
+type viewFilterSection struct {
+	Heading string
+	Filter  map[string]interface{}
+}
+
+// viewFilterSections lists a view's non-empty filters: issue, project, and
+// initiative
+func viewFilterSections(view *api.CustomView) []viewFilterSection {
+	var sections []viewFilterSection
+	for _, s := range []viewFilterSection{
+		{"Filter", view.FilterData},
+		{"Project Filter", view.ProjectFilterData},
+		{"Initiative Filter", view.InitiativeFilterData},
+	} {
+		if len(s.Filter) > 0 {
+			sections = append(sections, s)
+		}
+	}
+	return sections
+}
+
+// printViewFilters prints a view's filters as readable conditions, naming
+// the users, labels, teams, and states they refer to. If the names can't be
+// looked up the IDs are shown instead, with a warning.
+func printViewFilters(r filterResolver, view *api.CustomView, plaintext bool) {
+	sections := viewFilterSections(view)
+	if len(sections) == 0 {
+		return
+	}
+	filters := make([]map[string]interface{}, len(sections))
+	for i, s := range sections {
+		filters[i] = s.Filter
+	}
+	names, err := resolveFilterNames(context.Background(), r, filters...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v; showing IDs\n", err)
+	}
+
+	for _, s := range sections {
+		if plaintext {
+			fmt.Printf("\n## %s\n", s.Heading)
+		} else {
+			fmt.Printf("\n%s\n", color.New(color.Bold).Sprint(s.Heading+":"))
+		}
+		for _, l := range describeFilter(s.Filter, names) {
+			if plaintext {
+				fmt.Printf("%s- %s\n", strings.Repeat("  ", l.Depth), l.Text)
+			} else {
+				fmt.Printf("  %s• %s\n", strings.Repeat("  ", l.Depth), l.Text)
+			}
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// fakeFilterResolver answers lookups from a fixed set of entities and
+// records each batch it was asked for
+type fakeFilterResolver struct {
+	entities map[string]api.EntityName
+	calls    []string
+	err      error
+}
+
+func (f *fakeFilterResolver) LookupNames(ctx context.Context, kind string, ids []string) (map[string]api.EntityName, error) {
+	f.calls = append(f.calls, kind+":"+strings.Join(ids, ","))
+	if f.err != nil {
+		return nil, f.err
+	}
+	found := map[string]api.EntityName{}
+	for _, id := range ids {
+		if e, ok := f.entities[id]; ok {
+			found[id] = e
+		}
+	}
+	return found, nil
+}
+
+func newFakeFilterResolver() *fakeFilterResolver {
+	return &fakeFilterResolver{entities: map[string]api.EntityName{
+		"u-alice": {ID: "u-alice", Name: "Alice", Email: "alice@example.com"},
+		"u-bob":   {ID: "u-bob", Name: "Bob", Email: "bob@example.com"},
+		"l-bug":   {ID: "l-bug", Name: "bug"},
+		"l-regr":  {ID: "l-regr", Name: "regression"},
+		"t-eng":   {ID: "t-eng", Name: "Engineering", Key: "ENG"},
+		"s-todo":  {ID: "s-todo", Name: "Todo"},
+		"c-12":    {ID: "c-12", Number: 12},
+		"p-web":   {ID: "p-web", Name: "Website"},
+	}}
+}
+
+// describeFilterText resolves and describes a filter given as JSON, one
+// line per condition indented two spaces per level
+func describeFilterText(t *testing.T, r filterResolver, filterJSON string) string {
+	t.Helper()
+	var filter map[string]interface{}
+	if err := json.Unmarshal([]byte(filterJSON), &filter); err != nil {
+		t.Fatalf("bad filter JSON: %v", err)
+	}
+	names, err := resolveFilterNames(context.Background(), r, filter)
+	if err != nil {
+		t.Fatalf("resolveFilterNames: %v", err)
+	}
+	var lines []string
+	for _, l := range describeFilter(filter, names) {
+		lines = append(lines, strings.Repeat("  ", l.Depth)+l.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestDescribeFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+		want   string
+	}{
+		{
+			name: "active bugs for a user",
+			filter: `{"and":[
+				{"state":{"type":{"in":["started","unstarted"]}}},
+				{"assignee":{"id":{"eq":"u-alice"}}},
+				{"labels":{"some":{"id":{"in":["l-bug","l-regr"]}}}},
+				{"createdAt":{"gt":"2024-01-01T00:00:00.000Z"}}]}`,
+			want: `state type is one of: started, unstarted
+assignee is Alice (alice@example.com)
+label is any of: bug, regression
+created after 2024-01-01`,
+		},
+		{
+			name:   "team default view",
+			filter: `{"team":{"id":{"eq":"t-eng"}},"state":{"type":{"nin":["completed","canceled"]}}}`,
+			want: `state type is none of: completed, canceled
+team is Engineering (ENG)`,
+		},
+		{
+			name: "or of conditions with a nested group",
+			filter: `{"or":[
+				{"assignee":{"isMe":{"eq":true}}},
+				{"and":[{"priority":{"lte":2}},{"priority":{"gt":0}}]},
+				{"subscribers":{"some":{"id":{"eq":"u-bob"}}}}]}`,
+			want: `any of:
+  assignee is me
+  all of:
+    priority is at most High
+    priority is greater than None
+  subscriber is Bob (bob@example.com)`,
+		},
+		{
+			name:   "empty and unset fields",
+			filter: `{"assignee":{"null":true},"project":{"null":false},"cycle":{"id":{"eq":"c-12"}}}`,
+			want: `assignee is not set
+cycle is Cycle 12
+project is set`,
+		},
+		{
+			name:   "relative dates and text",
+			filter: `{"dueDate":{"lt":"P1W"},"updatedAt":{"gte":"-P2W"},"title":{"containsIgnoreCase":"login"}}`,
+			want: `due date before 1 week from now
+title contains "login" (ignoring case)
+updated on or after 2 weeks ago`,
+		},
+		{
+			name:   "names, keys, and nested relations",
+			filter: `{"state":{"name":{"eq":"In Review"}},"team":{"key":{"in":["ENG","OPS"]}},"project":{"lead":{"id":{"eq":"u-bob"}}}}`,
+			want: `project lead is Bob (bob@example.com)
+state is In Review
+team is one of: ENG, OPS`,
+		},
+		{
+			name:   "quantifiers and collection length",
+			filter: `{"labels":{"every":{"name":{"neq":"wontfix"}}},"children":{"length":{"gte":3}}}`,
+			want: `number of sub-issues is at least 3
+every label is not wontfix`,
+		},
+		{
+			name:   "unknown IDs are shown as they are",
+			filter: `{"state":{"id":{"in":["s-todo","s-gone"]}},"project":{"id":{"eq":"p-web"}}}`,
+			want: `project is Website
+state is one of: Todo, s-gone`,
+		},
+		{
+			name:   "unknown operators fall back to JSON",
+			filter: `{"estimate":{"between":[1,3]},"sla":"breached","priority":{"eq":1}}`,
+			want: `estimate: {"between":[1,3]}
+priority is Urgent
+{"sla":"breached"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeFilterText(t, newFakeFilterResolver(), tt.filter); got != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveFilterNamesBatchesByKind(t *testing.T) {
+	var issues, projects map[string]interface{}
+	_ = json.Unmarshal([]byte(`{"or":[{"assignee":{"id":{"in":["u-bob","u-alice"]}}},{"creator":{"id":{"eq":"u-alice"}}},{"labels":{"some":{"id":{"eq":"l-bug"}}}}]}`), &issues)
+	_ = json.Unmarshal([]byte(`{"lead":{"id":{"eq":"u-carol"}},"title":{"eq":"u-alice"}}`), &projects)
+
+	r := newFakeFilterResolver()
+	names, err := resolveFilterNames(context.Background(), r, issues, projects)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"label:l-bug", "user:u-alice,u-bob,u-carol"}
+	if !reflect.DeepEqual(r.calls, want) {
+		t.Errorf("lookups = %v, want %v", r.calls, want)
+	}
+	if names["u-alice"] != "Alice (alice@example.com)" || names["l-bug"] != "bug" {
+		t.Errorf("names = %v", names)
+	}
+
+	failing := &fakeFilterResolver{err: fmt.Errorf("boom")}
+	if _, err := resolveFilterNames(context.Background(), failing, issues); err == nil || !strings.Contains(err.Error(), "failed to look up label names: boom") {
+		t.Errorf("lookup failure: %v", err)
+	}
+}
+
+func TestHermeticViewGetFilter(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"My bugs","shared":true,"slugId":"abc","modelName":"Issue",
+		"createdAt":"2026-01-01T00:00:00Z","updatedAt":"2026-01-02T00:00:00Z",
+		"filterData":{"and":[{"assignee":{"id":{"eq":"u-alice"}}},{"labels":{"some":{"id":{"in":["l-bug"]}}}}]}}}`)
+	s.Data("UserNames", `{"nodes":{"nodes":[{"id":"u-alice","name":"Alice","email":"alice@example.com"}]}}`)
+	s.Data("LabelNames", `{"nodes":{"nodes":[{"id":"l-bug","name":"bug"}]}}`)
+
+	r := runMocked(t, "view", "get", "v1", "--plaintext")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "## Filter\n- assignee is Alice (alice@example.com)\n- label is any of: bug\n") {
+		t.Fatalf("view get exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+
+	r = runMocked(t, "view", "get", "v1", "--plaintext", "--raw-filter")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "## Filter Data\n```json\n") || strings.Contains(r.Stdout, "Alice") {
+		t.Errorf("--raw-filter exited %d:\n%s", r.Exit, r.Stdout)
+	}
+
+	// A failed lookup still shows the conditions, with IDs
+	s.Error("UserNames", 500, "internal error", "")
+	r = runMocked(t, "view", "get", "v1")
+	if r.Exit != 0 || !strings.Contains(r.Stdout, "assignee is u-alice") || !strings.Contains(r.Stderr, "showing IDs") {
+		t.Errorf("failed lookup exited %d:\n%s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+}
@@ -0,0 +1,698 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/journal"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// viewDefinitionVersion is the format version of 'view export' files
+const viewDefinitionVersion = 1
+
+// viewDefinition is a custom view as written by 'view export': what's
+// needed to recreate it in any workspace, with the IDs in its filters
+// replaced by viewRefs wherever the entity could be named
+type viewDefinition struct {
+	Version              int                    `json:"version"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description,omitempty"`
+	Icon                 string                 `json:"icon,omitempty"`
+	Color                string                 `json:"color,omitempty"`
+	Shared               bool                   `json:"shared"`
+	Model                string                 `json:"model"`
+	Team                 string                 `json:"team,omitempty"` // key of the view's team
+	FilterData           map[string]interface{} `json:"filterData,omitempty"`
+	ProjectFilterData    map[string]interface{} `json:"projectFilterData,omitempty"`
+	InitiativeFilterData map[string]interface{} `json:"initiativeFilterData,omitempty"`
+}
+
+// filters lists the definition's filters, for walking all of them
+func (d *viewDefinition) filters() []map[string]interface{} {
+	return []map[string]interface{}{d.FilterData, d.ProjectFilterData, d.InitiativeFilterData}
+}
+
+// viewRef stands in for an ID in an exported filter, naming the entity in a
+// way that holds across workspaces:
+//
+//	{"$ref": "team", "key": "ENG"}
+//	{"$ref": "user", "email": "ada@example.com"}
+//	{"$ref": "label", "name": "Bug"}                  (workspace label)
+//	{"$ref": "label", "team": "ENG", "name": "Bug"}   (team label)
+//	{"$ref": "state", "team": "ENG", "name": "In Review"}
+//	{"$ref": "project", "name": "Checkout v2"}
+//	{"$ref": "cycle", "team": "ENG", "number": 42}
+type viewRef struct {
+	Kind   string `json:"$ref"`
+	Key    string `json:"key,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Team   string `json:"team,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Number int    `json:"number,omitempty"`
+}
+
+// String is how errors name the reference, e.g. "state ENG/In Review"
+func (r viewRef) String() string {
+	var name string
+	switch r.Kind {
+	case "team":
+		name = r.Key
+	case "user":
+		name = r.Email
+	case "cycle":
+		name = strconv.Itoa(r.Number)
+	default:
+		name = r.Name
+	}
+	if r.Team != "" {
+		name = r.Team + "/" + name
+	}
+	return r.Kind + " " + name
+}
+
+// value is the ref as it appears in a filter, like a decoded JSON object
+func (r viewRef) value() map[string]interface{} {
+	v := map[string]interface{}{"$ref": r.Kind}
+	for key, field := range map[string]string{"key": r.Key, "email": r.Email, "team": r.Team, "name": r.Name} {
+		if field != "" {
+			v[key] = field
+		}
+	}
+	if r.Number != 0 {
+		v["number"] = float64(r.Number)
+	}
+	return v
+}
+
+// parseViewRef reads a viewRef from a filter value; ok is false for
+// anything that isn't one
+func parseViewRef(v interface{}) (ref viewRef, ok bool) {
+	m, isMap := v.(map[string]interface{})
+	if !isMap {
+		return viewRef{}, false
+	}
+	if _, has := m["$ref"]; !has {
+		return viewRef{}, false
+	}
+	data, err := json.Marshal(m)
+	if err != nil || json.Unmarshal(data, &ref) != nil || ref.Kind == "" {
+		return viewRef{}, false
+	}
+	return ref, true
+}
+
+// entityRef is the viewRef for a looked-up entity of kind (see
+// filterIDKinds). ok is false when the entity can't be named portably, such
+// as a state whose team is unknown.
+func entityRef(kind string, e api.EntityName) (ref viewRef, ok bool) {
+	ref = viewRef{Kind: kind}
+	teamKey := ""
+	if e.Team != nil {
+		teamKey = e.Team.Key
+	}
+	switch kind {
+	case "team":
+		ref.Key = e.Key
+		return ref, ref.Key != ""
+	case "user":
+		ref.Email = e.Email
+		return ref, ref.Email != ""
+	case "label":
+		ref.Team, ref.Name = teamKey, e.Name
+		return ref, ref.Name != ""
+	case "state":
+		ref.Team, ref.Name = teamKey, e.Name
+		return ref, ref.Team != "" && ref.Name != ""
+	case "cycle":
+		ref.Team, ref.Number = teamKey, e.Number
+		return ref, ref.Team != "" && ref.Number != 0
+	case "project":
+		ref.Name = e.Name
+		return ref, ref.Name != ""
+	}
+	return viewRef{}, false
+}
+
+// symbolizeFilter returns a copy of filter with each ID that refs has a
+// viewRef for replaced by it. Other IDs are kept as they are.
+func symbolizeFilter(filter map[string]interface{}, refs map[string]viewRef) map[string]interface{} {
+	var walk func(path []string, v interface{}) interface{}
+	walk = func(path []string, v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			out := make(map[string]interface{}, len(v))
+			for key, child := range v {
+				out[key] = walk(append(path, key), child)
+			}
+			return out
+		case []interface{}:
+			out := make([]interface{}, len(v))
+			for i, child := range v {
+				out[i] = walk(path, child)
+			}
+			return out
+		case string:
+			if ref, ok := refs[v]; ok && filterIDKind(path) == ref.Kind {
+				return ref.value()
+			}
+		}
+		return v
+	}
+	if filter == nil {
+		return nil
+	}
+	return walk(nil, filter).(map[string]interface{})
+}
+
+// filterViewRefs lists the distinct viewRefs in filters, in the order they
+// are first found with map keys sorted
+func filterViewRefs(filters ...map[string]interface{}) []viewRef {
+	seen := map[viewRef]bool{}
+	var refs []viewRef
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		if ref, ok := parseViewRef(v); ok {
+			if !seen[ref] {
+				seen[ref] = true
+				refs = append(refs, ref)
+			}
+			return
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for _, key := range sortedKeys(v) {
+				walk(v[key])
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	for _, filter := range filters {
+		walk(filter)
+	}
+	return refs
+}
+
+// resolveFilterRefs returns a copy of filter with each viewRef replaced by
+// the ID ids has for it. Refs without one are left in place.
+func resolveFilterRefs(filter map[string]interface{}, ids map[viewRef]string) map[string]interface{} {
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		if ref, ok := parseViewRef(v); ok {
+			if id, found := ids[ref]; found {
+				return id
+			}
+			return v
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			out := make(map[string]interface{}, len(v))
+			for key, child := range v {
+				out[key] = walk(child)
+			}
+			return out
+		case []interface{}:
+			out := make([]interface{}, len(v))
+			for i, child := range v {
+				out[i] = walk(child)
+			}
+			return out
+		}
+		return v
+	}
+	if filter == nil {
+		return nil
+	}
+	return walk(filter).(map[string]interface{})
+}
+
+// retargetViewRefs points the refs to team from at team to instead, for
+// importing a view into another team
+func retargetViewRefs(filter map[string]interface{}, from, to string) map[string]interface{} {
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		if ref, ok := parseViewRef(v); ok {
+			if ref.Kind == "team" && strings.EqualFold(ref.Key, from) {
+				ref.Key = to
+			}
+			if strings.EqualFold(ref.Team, from) {
+				ref.Team = to
+			}
+			return ref.value()
+		}
+		switch v := v.(type) {
+		case map[string]interface{}:
+			out := make(map[string]interface{}, len(v))
+			for key, child := range v {
+				out[key] = walk(child)
+			}
+			return out
+		case []interface{}:
+			out := make([]interface{}, len(v))
+			for i, child := range v {
+				out[i] = walk(child)
+			}
+			return out
+		}
+		return v
+	}
+	if filter == nil {
+		return nil
+	}
+	return walk(filter).(map[string]interface{})
+}
+
+// exportViewRefs names every entity the filters refer to as a viewRef, with
+// one lookup per kind. unnamed lists the IDs that stay IDs because the
+// entity is gone or can't be named portably.
+func exportViewRefs(ctx context.Context, r filterResolver, filters ...map[string]interface{}) (refs map[string]viewRef, unnamed []string, err error) {
+	ids := map[string][]string{}
+	for _, filter := range filters {
+		for kind, kindIDs := range filterIDRefs(filter) {
+			ids[kind] = append(ids[kind], kindIDs...)
+		}
+	}
+	kinds := make([]string, 0, len(ids))
+	for kind := range ids {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	refs = map[string]viewRef{}
+	for _, kind := range kinds {
+		found, err := r.LookupNames(ctx, kind, ids[kind])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to look up %s names: %w", kind, err)
+		}
+		for _, id := range ids[kind] {
+			ref, ok := entityRef(kind, found[id])
+			if !ok {
+				unnamed = append(unnamed, id)
+				continue
+			}
+			refs[id] = ref
+		}
+	}
+	return refs, unnamed, nil
+}
+
+// exportView builds the portable definition of view
+func exportView(ctx context.Context, r filterResolver, view *api.CustomView) (*viewDefinition, []string, error) {
+	def := &viewDefinition{
+		Version: viewDefinitionVersion,
+		Name:    view.Name,
+		Shared:  view.Shared,
+		Model:   strings.ToLower(view.ModelName),
+	}
+	if view.Description != nil {
+		def.Description = *view.Description
+	}
+	if view.Icon != nil {
+		def.Icon = *view.Icon
+	}
+	if view.Color != nil {
+		def.Color = *view.Color
+	}
+	if view.Team != nil {
+		def.Team = view.Team.Key
+	}
+
+	refs, unnamed, err := exportViewRefs(ctx, r, view.FilterData, view.ProjectFilterData, view.InitiativeFilterData)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(view.FilterData) > 0 {
+		def.FilterData = symbolizeFilter(view.FilterData, refs)
+	}
+	if len(view.ProjectFilterData) > 0 {
+		def.ProjectFilterData = symbolizeFilter(view.ProjectFilterData, refs)
+	}
+	if len(view.InitiativeFilterData) > 0 {
+		def.InitiativeFilterData = symbolizeFilter(view.InitiativeFilterData, refs)
+	}
+	return def, unnamed, nil
+}
+
+// unresolvedViewRef is a reference in an imported view that matches nothing
+// in the workspace
+type unresolvedViewRef struct {
+	Ref         viewRef  `json:"ref"`
+	Reason      string   `json:"reason"`
+	Suggestions []string `json:"suggestions,omitempty"`
+}
+
+// viewRefResolver finds the IDs of viewRefs in the current workspace,
+// listing labels and team states at most once each
+type viewRefResolver struct {
+	client     *api.Client
+	users      *userLookup
+	labels     []api.Label
+	labelsDone bool
+	states     map[string][]api.WorkflowState
+}
+
+func newViewRefResolver(client *api.Client) *viewRefResolver {
+	return &viewRefResolver{client: client, users: newUserLookup(client), states: map[string][]api.WorkflowState{}}
+}
+
+// resolveAll resolves every ref, collecting the ones that fail
+func (r *viewRefResolver) resolveAll(refs []viewRef) (map[viewRef]string, []unresolvedViewRef) {
+	ids := map[viewRef]string{}
+	var unresolved []unresolvedViewRef
+	for _, ref := range refs {
+		id, err := r.resolve(ref)
+		if err != nil {
+			u := unresolvedViewRef{Ref: ref, Reason: err.Error()}
+			var notFound *utils.NotFoundError
+			if errors.As(err, &notFound) {
+				u.Reason, u.Suggestions = notFound.Message, notFound.Suggestions
+			}
+			unresolved = append(unresolved, u)
+			continue
+		}
+		ids[ref] = id
+	}
+	return ids, unresolved
+}
+
+// resolve returns the ID of the entity ref names
+func (r *viewRefResolver) resolve(ref viewRef) (string, error) {
+	ctx := context.Background()
+	switch ref.Kind {
+	case "team":
+		team, err := r.client.GetTeam(ctx, ref.Key)
+		if err != nil {
+			return "", err
+		}
+		return team.ID, nil
+	case "user":
+		user, err := r.users.find(ref.Email)
+		if err != nil {
+			return "", err
+		}
+		return user.ID, nil
+	case "label":
+		return r.label(ref)
+	case "state":
+		if _, ok := r.states[ref.Team]; !ok {
+			states, err := r.client.GetTeamStates(ctx, ref.Team)
+			if err != nil {
+				return "", fmt.Errorf("failed to get team %s states: %w", ref.Team, err)
+			}
+			r.states[ref.Team] = states
+		}
+		state, err := findState(r.states[ref.Team], ref.Name)
+		if err != nil {
+			return "", err
+		}
+		return state.ID, nil
+	case "project":
+		filter := map[string]interface{}{"name": map[string]interface{}{"eqIgnoreCase": ref.Name}}
+		projects, err := r.client.GetProjects(ctx, filter, 2, "", "")
+		if err != nil {
+			return "", fmt.Errorf("failed to look up project: %w", err)
+		}
+		switch len(projects.Nodes) {
+		case 0:
+			return "", fmt.Errorf("project not found")
+		case 1:
+			return projects.Nodes[0].ID, nil
+		}
+		// Two are enough to know the name can't pick one
+		return "", fmt.Errorf("several projects are named %q; rename all but one, or edit the definition's filter to use a project ID", ref.Name)
+	case "cycle":
+		filter := map[string]interface{}{
+			"team":   map[string]interface{}{"key": map[string]interface{}{"eq": ref.Team}},
+			"number": map[string]interface{}{"eq": ref.Number},
+		}
+		cycles, err := r.client.GetCycles(ctx, filter, 1, "")
+		if err != nil {
+			return "", fmt.Errorf("failed to look up cycle: %w", err)
+		}
+		if len(cycles.Nodes) == 0 {
+			return "", fmt.Errorf("cycle not found in team %s", ref.Team)
+		}
+		return cycles.Nodes[0].ID, nil
+	}
+	return "", fmt.Errorf("unknown reference kind %q", ref.Kind)
+}
+
+// label finds a workspace label, or a team's label when ref has a team
+func (r *viewRefResolver) label(ref viewRef) (string, error) {
+	if !r.labelsDone {
+		labels, err := fetchAllLabels(r.client)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch labels: %w", err)
+		}
+		r.labels = labels
+		r.labelsDone = true
+	}
+
+	var candidates []api.Label
+	for _, label := range r.labels {
+		teamKey := ""
+		if label.Team != nil {
+			teamKey = label.Team.Key
+		}
+		if strings.EqualFold(teamKey, ref.Team) {
+			candidates = append(candidates, label)
+		}
+	}
+	label, err := findLabel(candidates, ref.Name)
+	if err != nil {
+		return "", err
+	}
+	return label.ID, nil
+}
+
+// readViewDefinition reads a 'view export' file from a path, - (stdin), or
+// an https:// URL
+func readViewDefinition(path string) (*viewDefinition, error) {
+	content, err := readContentFromFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var def viewDefinition
+	if err := json.Unmarshal([]byte(content), &def); err != nil {
+		return nil, fmt.Errorf("%s is not a view definition: %v", path, err)
+	}
+	if def.Version != viewDefinitionVersion {
+		return nil, fmt.Errorf("%s has view definition version %d; this version of linear-cli reads version %d", path, def.Version, viewDefinitionVersion)
+	}
+	if def.Name == "" {
+		return nil, fmt.Errorf("%s has no view name", path)
+	}
+	return &def, nil
+}
+
+// reportUnresolvedViewRefs prints the references an import couldn't resolve
+// and exits
+func reportUnresolvedViewRefs(unresolved []unresolvedViewRef, plaintext, jsonOut bool) {
+	summary := fmt.Sprintf("%d reference(s) in the view could not be resolved in this workspace", len(unresolved))
+	if jsonOut {
+		output.JSON(map[string]interface{}{
+			"error":      summary,
+			"unresolved": unresolved,
+		})
+		exit(1)
+	}
+	lines := []string{summary + ":"}
+	for _, u := range unresolved {
+		line := fmt.Sprintf("  %s: %s", u.Ref, u.Reason)
+		if len(u.Suggestions) > 0 {
+			line += fmt.Sprintf(" (did you mean: %s?)", strings.Join(u.Suggestions, ", "))
+		}
+		lines = append(lines, line)
+	}
+	output.Error(strings.Join(lines, "\n"), plaintext, jsonOut)
+	exit(1)
+}
+
+var viewExportCmd = &cobra.Command{
+	Use:   "export VIEW-ID",
+	Short: "Export a custom view as a portable JSON definition",
+	Long: `Write a custom view's name, description, icon, color, model, team, and
+filters as JSON that 'view import' can recreate in any workspace.
+
+IDs in the filters are replaced by symbolic references: teams by key, users
+by email, labels and states by name (with their team's key for team labels
+and states), projects by name, and cycles by team key and number, e.g.
+{"$ref": "label", "team": "ENG", "name": "Bug"}. An ID whose entity no longer
+exists is kept as it is, with a warning. The owner is not exported.
+
+Examples:
+  linear-cli view export VIEW-ID --output triage.json
+  linear-cli view export VIEW-ID > triage.json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		outPath, _ := cmd.Flags().GetString("output")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		view, err := client.GetCustomView(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get view: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		def, unnamed, err := exportView(context.Background(), client, view)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if len(unnamed) > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: kept %d ID(s) that could not be named, which won't resolve in another workspace: %s\n", len(unnamed), strings.Join(unnamed, ", "))
+		}
+
+		err = writeExport(outPath, func(w io.Writer) error {
+			enc := json.NewEncoder(w)
+			enc.SetIndent("", "  ")
+			return enc.Encode(def)
+		})
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if outPath != "" && outPath != "-" {
+			fmt.Fprintf(os.Stderr, "Wrote view '%s' to %s\n", def.Name, outPath)
+		}
+	},
+}
+
+var viewImportCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "Create a custom view from a 'view export' definition",
+	Long: `Create a custom view from a file written by 'view export' (a path, - for
+stdin, or an https:// URL).
+
+Every symbolic reference in the filters is resolved in this workspace
+first; if any match nothing, they are all listed and no view is created.
+--team puts the view in another team, and references to the exported view's
+team (its key, and its team labels, states, and cycles) are pointed at the
+new team too. --name renames the view.
+
+Examples:
+  linear-cli view import triage.json
+  linear-cli view import triage.json --team OPS --name "OPS triage"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json") && !scriptedOutput(cmd)
+
+		def, err := readViewDefinition(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			exit(1)
+		}
+		if name, _ := cmd.Flags().GetString("name"); name != "" {
+			def.Name = name
+		}
+		if teamKey, _ := cmd.Flags().GetString("team"); teamKey != "" {
+			if def.Team != "" {
+				def.FilterData = retargetViewRefs(def.FilterData, def.Team, teamKey)
+				def.ProjectFilterData = retargetViewRefs(def.ProjectFilterData, def.Team, teamKey)
+				def.InitiativeFilterData = retargetViewRefs(def.InitiativeFilterData, def.Team, teamKey)
+			}
+			def.Team = teamKey
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		client := api.NewClient(authHeader)
+
+		// Resolve everything before creating the view so every bad
+		// reference is reported together
+		resolver := newViewRefResolver(client)
+		refs := filterViewRefs(def.filters()...)
+		if teamRef := (viewRef{Kind: "team", Key: def.Team}); def.Team != "" && !slices.Contains(refs, teamRef) {
+			refs = append(refs, teamRef)
+		}
+		ids, unresolved := resolver.resolveAll(refs)
+		if len(unresolved) > 0 {
+			reportUnresolvedViewRefs(unresolved, plaintext, jsonOut)
+		}
+
+		input := map[string]interface{}{
+			"name":   def.Name,
+			"shared": def.Shared,
+		}
+		for key, value := range map[string]string{"description": def.Description, "icon": def.Icon, "color": def.Color} {
+			if value != "" {
+				input[key] = value
+			}
+		}
+		if def.Team != "" {
+			input["teamId"] = ids[viewRef{Kind: "team", Key: def.Team}]
+		}
+		if len(def.FilterData) > 0 {
+			input["filterData"] = resolveFilterRefs(def.FilterData, ids)
+		}
+		if len(def.ProjectFilterData) > 0 {
+			input["projectFilterData"] = resolveFilterRefs(def.ProjectFilterData, ids)
+		}
+		if len(def.InitiativeFilterData) > 0 {
+			input["initiativeFilterData"] = resolveFilterRefs(def.InitiativeFilterData, ids)
+		}
+
+		view, err := client.CreateCustomView(context.Background(), input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to create view: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		recordOperation(cmd, "view", view.ID, view.Name, &journal.Inverse{Action: "delete"})
+
+		if printCreated(cmd, createdEntity{Type: "view", ID: view.ID, Name: view.Name, URL: viewURL(view)}) {
+			return
+		}
+		if jsonOut {
+			output.JSON(view)
+		} else if plaintext {
+			fmt.Printf("Imported view: %s (ID: %s)\n", view.Name, view.ID)
+			printResultURL(viewURL(view), plaintext)
+		} else {
+			fmt.Printf("%s Imported view %s (ID: %s)\n",
+				color.New(color.FgGreen).Sprint("✓"),
+				color.New(color.FgCyan, color.Bold).Sprint(view.Name),
+				color.New(color.FgWhite, color.Faint).Sprint(view.ID))
+			printResultURL(viewURL(view), plaintext)
+		}
+	},
+}
+
+func init() {
+	viewCmd.AddCommand(viewExportCmd)
+	viewCmd.AddCommand(viewImportCmd)
+
+	viewExportCmd.Flags().StringP("output", "o", "", "Write the definition to a file instead of stdout")
+
+	viewImportCmd.Flags().StringP("team", "t", "", "Create the view in this team (key), moving references to the exported team with it")
+	viewImportCmd.Flags().String("name", "", "Name for the new view (default: the exported name)")
+	addURLOnlyFlag(viewImportCmd)
+	addPorcelainFlag(viewImportCmd)
+}
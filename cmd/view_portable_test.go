@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// capturedViewFilter is filterData as Linear returns it for a triage view,
+// with one assignee that has since been deleted
+const capturedViewFilter = `{"and":[
+	{"team":{"id":{"eq":"t-eng"}}},
+	{"labels":{"some":{"id":{"in":["l-bug","l-ws"]}}}},
+	{"state":{"id":{"nin":["s-done"]}}},
+	{"or":[{"assignee":{"id":{"eq":"u-alice"}}},{"assignee":{"id":{"eq":"u-gone"}}}]},
+	{"cycle":{"id":{"eq":"c-12"}}},
+	{"project":{"id":{"eq":"p-web"}}},
+	{"title":{"containsIgnoreCase":"l-bug"}},
+	{"priority":{"lte":2}}
+]}`
+
+// symbolicViewFilter is capturedViewFilter as 'view export' writes it
+const symbolicViewFilter = `{"and":[
+	{"team":{"id":{"eq":{"$ref":"team","key":"ENG"}}}},
+	{"labels":{"some":{"id":{"in":[{"$ref":"label","team":"ENG","name":"Bug"},{"$ref":"label","name":"Security"}]}}}},
+	{"state":{"id":{"nin":[{"$ref":"state","team":"ENG","name":"Done"}]}}},
+	{"or":[{"assignee":{"id":{"eq":{"$ref":"user","email":"alice@example.com"}}}},{"assignee":{"id":{"eq":"u-gone"}}}]},
+	{"cycle":{"id":{"eq":{"$ref":"cycle","team":"ENG","number":12}}}},
+	{"project":{"id":{"eq":{"$ref":"project","name":"Website"}}}},
+	{"title":{"containsIgnoreCase":"l-bug"}},
+	{"priority":{"lte":2}}
+]}`
+
+func newPortableFilterResolver() *fakeFilterResolver {
+	eng := &api.EntityName{ID: "t-eng", Name: "Engineering", Key: "ENG"}
+	return &fakeFilterResolver{entities: map[string]api.EntityName{
+		"t-eng":   *eng,
+		"u-alice": {ID: "u-alice", Name: "Alice", Email: "alice@example.com"},
+		"l-bug":   {ID: "l-bug", Name: "Bug", Team: eng},
+		"l-ws":    {ID: "l-ws", Name: "Security"},
+		"s-done":  {ID: "s-done", Name: "Done", Team: eng},
+		"c-12":    {ID: "c-12", Number: 12, Team: eng},
+		"p-web":   {ID: "p-web", Name: "Website"},
+	}}
+}
+
+func decodeFilter(t *testing.T, filterJSON string) map[string]interface{} {
+	t.Helper()
+	var filter map[string]interface{}
+	if err := json.Unmarshal([]byte(filterJSON), &filter); err != nil {
+		t.Fatalf("bad filter JSON: %v", err)
+	}
+	return filter
+}
+
+func TestSymbolizeViewFilter(t *testing.T) {
+	filter := decodeFilter(t, capturedViewFilter)
+	refs, unnamed, err := exportViewRefs(context.Background(), newPortableFilterResolver(), filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(unnamed, []string{"u-gone"}) {
+		t.Errorf("unnamed = %v, want [u-gone]", unnamed)
+	}
+
+	got := symbolizeFilter(filter, refs)
+	if want := decodeFilter(t, symbolicViewFilter); !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.Marshal(got)
+		t.Errorf("symbolized filter:\n%s", gotJSON)
+	}
+	if !reflect.DeepEqual(filter, decodeFilter(t, capturedViewFilter)) {
+		t.Error("symbolizeFilter modified its input")
+	}
+}
+
+func TestEntityRef(t *testing.T) {
+	eng := &api.EntityName{Key: "ENG"}
+	tests := []struct {
+		kind string
+		e    api.EntityName
+		want string
+		ok   bool
+	}{
+		{"team", api.EntityName{Key: "ENG"}, "team ENG", true},
+		{"user", api.EntityName{Name: "Alice", Email: "alice@example.com"}, "user alice@example.com", true},
+		{"label", api.EntityName{Name: "Bug", Team: eng}, "label ENG/Bug", true},
+		{"label", api.EntityName{Name: "Security"}, "label Security", true},
+		{"state", api.EntityName{Name: "Done", Team: eng}, "state ENG/Done", true},
+		{"cycle", api.EntityName{Number: 12, Team: eng}, "cycle ENG/12", true},
+		{"project", api.EntityName{Name: "Website"}, "project Website", true},
+		// Missing entities and states or cycles without a team can't be named
+		{"user", api.EntityName{}, "", false},
+		{"state", api.EntityName{Name: "Done"}, "", false},
+		{"cycle", api.EntityName{Number: 12}, "", false},
+		{"initiative", api.EntityName{Name: "Q3"}, "", false},
+	}
+	for _, tt := range tests {
+		ref, ok := entityRef(tt.kind, tt.e)
+		if ok != tt.ok || (ok && ref.String() != tt.want) {
+			t.Errorf("entityRef(%s, %+v) = %q, %v; want %q, %v", tt.kind, tt.e, ref, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestResolveFilterRefs(t *testing.T) {
+	symbolic := decodeFilter(t, symbolicViewFilter)
+	refs := filterViewRefs(symbolic)
+	var names []string
+	for _, ref := range refs {
+		names = append(names, ref.String())
+	}
+	want := "team ENG, label ENG/Bug, label Security, state ENG/Done, user alice@example.com, cycle ENG/12, project Website"
+	if got := strings.Join(names, ", "); got != want {
+		t.Errorf("filterViewRefs = %s\nwant %s", got, want)
+	}
+
+	ids := map[viewRef]string{}
+	for id, e := range newPortableFilterResolver().entities {
+		kind := strings.SplitN(id, "-", 2)[0]
+		kind = map[string]string{"t": "team", "u": "user", "l": "label", "s": "state", "c": "cycle", "p": "project"}[kind]
+		ref, _ := entityRef(kind, e)
+		ids[ref] = id
+	}
+	if got := resolveFilterRefs(symbolic, ids); !reflect.DeepEqual(got, decodeFilter(t, capturedViewFilter)) {
+		gotJSON, _ := json.Marshal(got)
+		t.Errorf("resolved filter is not the captured one:\n%s", gotJSON)
+	}
+
+	// A ref that resolved to nothing stays a ref
+	partial := resolveFilterRefs(decodeFilter(t, `{"project":{"id":{"eq":{"$ref":"project","name":"Gone"}}}}`), ids)
+	if ref, ok := parseViewRef(partial["project"].(map[string]interface{})["id"].(map[string]interface{})["eq"]); !ok || ref.Name != "Gone" {
+		t.Errorf("unresolved ref = %v", partial)
+	}
+}
+
+func TestRetargetViewRefs(t *testing.T) {
+	got := retargetViewRefs(decodeFilter(t, symbolicViewFilter), "ENG", "OPS")
+	var names []string
+	for _, ref := range filterViewRefs(got) {
+		names = append(names, ref.String())
+	}
+	want := "team OPS, label OPS/Bug, label Security, state OPS/Done, user alice@example.com, cycle OPS/12, project Website"
+	if got := strings.Join(names, ", "); got != want {
+		t.Errorf("retargeted refs = %s\nwant %s", got, want)
+	}
+}
+
+func TestParseViewRef(t *testing.T) {
+	for _, v := range []interface{}{
+		"t-eng",
+		map[string]interface{}{"eq": "t-eng"},
+		map[string]interface{}{"$ref": ""},
+		map[string]interface{}{"$ref": 7},
+	} {
+		if ref, ok := parseViewRef(v); ok {
+			t.Errorf("parseViewRef(%v) = %v, want no ref", v, ref)
+		}
+	}
+	if ref, ok := parseViewRef(viewRef{Kind: "cycle", Team: "ENG", Number: 12}.value()); !ok || ref != (viewRef{Kind: "cycle", Team: "ENG", Number: 12}) {
+		t.Errorf("value() doesn't round-trip: %v", ref)
+	}
+}
+
+func TestViewExportImport(t *testing.T) {
+	s := newMockLinear(t)
+	s.Data("CustomView", `{"customView":{"id":"v1","name":"Triage","description":"Open bugs","icon":"Bug","shared":true,
+		"modelName":"Issue","filterData":`+capturedViewFilter+`,"team":{"id":"t-eng","key":"ENG","name":"Engineering"}}}`)
+	s.Data("TeamNames", `{"nodes":{"nodes":[{"id":"t-eng","name":"Engineering","key":"ENG"}]}}`)
+	s.Data("UserNames", `{"nodes":{"nodes":[{"id":"u-alice","name":"Alice","email":"alice@example.com"}]}}`)
+	s.Data("LabelNames", `{"nodes":{"nodes":[{"id":"l-bug","name":"Bug","team":{"id":"t-eng","key":"ENG","name":"Engineering"}},{"id":"l-ws","name":"Security"}]}}`)
+	s.Data("StateNames", `{"nodes":{"nodes":[{"id":"s-done","name":"Done","team":{"id":"t-eng","key":"ENG","name":"Engineering"}}]}}`)
+	s.Data("CycleNames", `{"nodes":{"nodes":[{"id":"c-12","number":12,"team":{"id":"t-eng","key":"ENG","name":"Engineering"}}]}}`)
+	s.Data("ProjectNames", `{"nodes":{"nodes":[{"id":"p-web","name":"Website"}]}}`)
+
+	path := filepath.Join(t.TempDir(), "triage.json")
+	r := runMocked(t, "view", "export", "v1", "--output", path)
+	if r.Exit != 0 || !strings.Contains(r.Stderr, "could not be named") || !strings.Contains(r.Stderr, "u-gone") {
+		t.Fatalf("view export exited %d: %s", r.Exit, r.Stderr)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var def viewDefinition
+	if err := json.Unmarshal(data, &def); err != nil {
+		t.Fatal(err)
+	}
+	if def.Version != 1 || def.Name != "Triage" || def.Model != "issue" || def.Team != "ENG" || !def.Shared ||
+		!reflect.DeepEqual(def.FilterData, decodeFilter(t, symbolicViewFilter)) {
+		t.Errorf("exported definition:\n%s", data)
+	}
+
+	// Import into the same workspace
+	s.Reset()
+	s.Data("Team", `{"team":{"id":"t-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("FindUsers", `{"users":{"nodes":[{"id":"u-alice","name":"Alice","email":"alice@example.com","active":true}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Labels", `{"issueLabels":{"nodes":[{"id":"l-bug","name":"Bug","team":{"id":"t-eng","key":"ENG","name":"Engineering"}},
+		{"id":"l-ops-bug","name":"Bug","team":{"id":"t-ops","key":"OPS","name":"Operations"}},{"id":"l-ws","name":"Security"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("TeamStates", `{"team":{"states":{"nodes":[{"id":"s-todo","name":"Todo","type":"unstarted"},{"id":"s-done","name":"Done","type":"completed"}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("Projects", `{"projects":{"nodes":[{"id":"p-web","name":"Website"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Cycles", `{"cycles":{"nodes":[{"id":"c-12","number":12}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("CustomViewCreate", `{"customViewCreate":{"success":true,"customView":{"id":"v2","name":"Triage","slugId":"abc123",
+		"organization":{"id":"o1","name":"Acme","urlKey":"acme"}}}}`)
+
+	r = runMocked(t, "view", "import", path, "--url-only")
+	if r.Exit != 0 || r.Stdout != "https://linear.app/acme/view/abc123\n" {
+		t.Fatalf("view import exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	var input map[string]interface{}
+	for _, req := range s.Requests() {
+		if req.Operation == "CustomViewCreate" {
+			input, _ = req.Variables["input"].(map[string]interface{})
+		}
+	}
+	if input["name"] != "Triage" || input["teamId"] != "t-eng" || input["description"] != "Open bugs" || input["shared"] != true {
+		t.Errorf("created view with %v", input)
+	}
+	if !reflect.DeepEqual(input["filterData"], decodeFilter(t, capturedViewFilter)) {
+		got, _ := json.Marshal(input["filterData"])
+		t.Errorf("round-tripped filter differs from the original:\n%s", got)
+	}
+
+	// Every reference that matches nothing is listed, and nothing is created
+	s.Reset()
+	s.Data("Team", `{"team":{"id":"t-eng","key":"ENG","name":"Engineering"}}`)
+	s.Data("FindUsers", `{"users":{"nodes":[{"id":"u-alice","name":"Alice","email":"alice@example.com","active":true}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Labels", `{"issueLabels":{"nodes":[{"id":"l-ws","name":"Security"}],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("TeamStates", `{"team":{"states":{"nodes":[{"id":"s-done","name":"Done","type":"completed"}],"pageInfo":{"hasNextPage":false}}}}`)
+	s.Data("Projects", `{"projects":{"nodes":[],"pageInfo":{"hasNextPage":false}}}`)
+	s.Data("Cycles", `{"cycles":{"nodes":[{"id":"c-12","number":12}],"pageInfo":{"hasNextPage":false}}}`)
+
+	r = runMocked(t, "view", "import", path, "--json")
+	var failure struct {
+		Error      string              `json:"error"`
+		Unresolved []unresolvedViewRef `json:"unresolved"`
+	}
+	if err := json.Unmarshal([]byte(r.Stdout), &failure); err != nil || r.Exit != 1 {
+		t.Fatalf("import with missing refs exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	var missing []string
+	for _, u := range failure.Unresolved {
+		missing = append(missing, u.Ref.String())
+	}
+	if got := strings.Join(missing, ", "); got != "label ENG/Bug, project Website" {
+		t.Errorf("unresolved = %s", got)
+	}
+	for _, op := range s.Operations() {
+		if op == "CustomViewCreate" {
+			t.Error("import created a view despite unresolved references")
+		}
+	}
+
+	// A project name shared by several projects can't be resolved either
+	s.Data("Projects", `{"projects":{"nodes":[{"id":"p-web","name":"Website"},{"id":"p-web2","name":"website"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}`)
+	r = runMocked(t, "view", "import", path, "--json")
+	failure.Unresolved = nil
+	if err := json.Unmarshal([]byte(r.Stdout), &failure); err != nil || r.Exit != 1 {
+		t.Fatalf("import with an ambiguous project exited %d: %s%s", r.Exit, r.Stdout, r.Stderr)
+	}
+	var ambiguous bool
+	for _, u := range failure.Unresolved {
+		if u.Ref.Kind == "project" && strings.Contains(u.Reason, `several projects are named "Website"`) {
+			ambiguous = true
+		}
+	}
+	if !ambiguous {
+		t.Errorf("unresolved = %+v, want project Website reported as ambiguous", failure.Unresolved)
+	}
+}
@@ -0,0 +1,259 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/roboalchemist/linear-cli/pkg/snapshot"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// exitViewChanged is the exit code for view diff --fail-on-change when the
+// view's results differ from the snapshot
+const exitViewChanged = 2
+
+var viewDiffCmd = &cobra.Command{
+	Use:   "diff [view-id]",
+	Short: "Compare a view's current results with a saved snapshot",
+	Long: `Re-run an issue view and report issues added, removed, or changed (state or
+assignee) since a snapshot saved with 'view run VIEW-ID --save-snapshot NAME'.
+
+Snapshots live in ~/.local/state/linear-cli/snapshots (or
+$XDG_STATE_HOME/linear-cli/snapshots). Diffing against a snapshot taken from a
+different view is refused unless --force is given.
+
+With --fail-on-change the command exits with code 2 when anything differs,
+which makes it usable as a CI check.
+
+Examples:
+  linear-cli view run VIEW-ID --save-snapshot morning
+  linear-cli view diff VIEW-ID --against morning
+  linear-cli view diff VIEW-ID --against morning --fail-on-change --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		against, _ := cmd.Flags().GetString("against")
+		force, _ := cmd.Flags().GetBool("force")
+		failOnChange, _ := cmd.Flags().GetBool("fail-on-change")
+
+		snap, err := snapshot.Load(against)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to load snapshot: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linear-cli auth' first.", plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		view, err := client.GetCustomView(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch view: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+		if !strings.EqualFold(view.ModelName, "issue") {
+			output.Error(fmt.Sprintf("Snapshots are only supported for issue views (view model is %s)", view.ModelName), plaintext, jsonOut)
+			exit(1)
+		}
+		if snap.ViewID != view.ID && !force {
+			output.Error(fmt.Sprintf("Snapshot %q was taken from view %s, not %s (use --force to compare anyway)", snap.Name, snap.ViewID, view.ID), plaintext, jsonOut)
+			exit(1)
+		}
+
+		issues, err := fetchAllViewIssues(client, view.ID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to run view: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		diff := snapshot.Compare(snap.Items, snapshotItems(issues))
+
+		if jsonOut {
+			output.JSON(diff)
+		} else {
+			printViewDiff(diff, snap, view.Name, plaintext)
+		}
+
+		if failOnChange && !diff.Empty() {
+			exit(exitViewChanged)
+		}
+	},
+}
+
+// fetchAllViewIssues pages through every issue in a custom view so snapshots
+// are not cut short by a page limit
+func fetchAllViewIssues(client *api.Client, viewID string) ([]api.Issue, error) {
+	all, err := fetchAllIssuePages(func(after string) (*api.Issues, error) {
+		return client.GetCustomViewIssues(context.Background(), viewID, 100, after)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all.Nodes, nil
+}
+
+// fetchViewIssues runs a view for a list command: the first --limit issues,
+// or every issue with all
+func fetchViewIssues(cmd *cobra.Command, client *api.Client, viewID string, all bool) (*api.Issues, error) {
+	if all {
+		return fetchAllIssuePages(func(after string) (*api.Issues, error) {
+			return client.GetCustomViewIssues(context.Background(), viewID, 100, after)
+		})
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+	if limit == 0 {
+		limit = 50
+	}
+	return client.GetCustomViewIssues(context.Background(), viewID, limit, "")
+}
+
+// snapshotItems reduces issues to the fields a snapshot keeps
+func snapshotItems(issues []api.Issue) []snapshot.Item {
+	items := make([]snapshot.Item, 0, len(issues))
+	for _, issue := range issues {
+		item := snapshot.Item{
+			Identifier: issue.Identifier,
+			Title:      issue.Title,
+			Priority:   issue.Priority,
+			URL:        issue.URL,
+		}
+		if issue.State != nil {
+			item.State = issue.State.Name
+		}
+		if issue.Assignee != nil {
+			item.Assignee = issue.Assignee.Name
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// saveViewSnapshot stores the view's full result set under name
+func saveViewSnapshot(client *api.Client, view *api.CustomView, name string) (*snapshot.Snapshot, []api.Issue, error) {
+	issues, err := fetchAllViewIssues(client, view.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	snap := &snapshot.Snapshot{
+		Name:     name,
+		ViewID:   view.ID,
+		ViewName: view.Name,
+		Items:    snapshotItems(issues),
+	}
+	if err := snapshot.Save(snap); err != nil {
+		return nil, nil, err
+	}
+	return snap, issues, nil
+}
+
+func printViewDiff(diff snapshot.Diff, snap *snapshot.Snapshot, viewName string, plaintext bool) {
+	since := output.FormatTime(snap.CreatedAt, output.DateTimeShort)
+
+	if plaintext {
+		fmt.Printf("# %s vs snapshot %s (%s)\n", viewName, snap.Name, since)
+		printViewDiffSection("Added", diff.Added, plaintext)
+		printViewDiffSection("Removed", diff.Removed, plaintext)
+		fmt.Printf("\n## Changed (%d)\n", len(diff.Changed))
+		for _, c := range diff.Changed {
+			fmt.Printf("- %s %s: %s\n", c.Identifier, c.Title, formatFieldChanges(c.Changes))
+		}
+		return
+	}
+
+	fmt.Printf("%s %s vs snapshot %s %s\n",
+		color.New(color.FgCyan, color.Bold).Sprint("👁 View:"),
+		viewName,
+		color.New(color.Bold).Sprint(snap.Name),
+		color.New(color.FgWhite, color.Faint).Sprintf("(%s)", since))
+
+	if diff.Empty() {
+		fmt.Printf("\n%s No changes\n", color.New(color.FgGreen).Sprint("✓"))
+		return
+	}
+
+	printViewDiffSection("Added", diff.Added, plaintext)
+	printViewDiffSection("Removed", diff.Removed, plaintext)
+
+	if len(diff.Changed) > 0 {
+		fmt.Printf("\n%s\n", color.New(color.FgYellow, color.Bold).Sprintf("~ Changed (%d)", len(diff.Changed)))
+		rows := [][]string{}
+		for _, c := range diff.Changed {
+			rows = append(rows, []string{
+				color.New(color.FgCyan).Sprint(c.Identifier),
+				truncateString(c.Title, 40),
+				formatFieldChanges(c.Changes),
+			})
+		}
+		output.Table(output.TableData{Headers: []string{"ID", "Title", "Change"}, Rows: rows}, false, false)
+	}
+}
+
+func printViewDiffSection(title string, items []snapshot.Item, plaintext bool) {
+	if plaintext {
+		fmt.Printf("\n## %s (%d)\n", title, len(items))
+		for _, item := range items {
+			fmt.Printf("- %s %s [%s]\n", item.Identifier, item.Title, item.State)
+		}
+		return
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	heading := color.New(color.FgGreen, color.Bold).Sprintf("+ %s (%d)", title, len(items))
+	if title == "Removed" {
+		heading = color.New(color.FgRed, color.Bold).Sprintf("- %s (%d)", title, len(items))
+	}
+	fmt.Printf("\n%s\n", heading)
+
+	rows := [][]string{}
+	for _, item := range items {
+		assignee := item.Assignee
+		if assignee == "" {
+			assignee = "Unassigned"
+		}
+		rows = append(rows, []string{
+			color.New(color.FgCyan).Sprint(item.Identifier),
+			truncateString(item.Title, 40),
+			item.State,
+			assignee,
+		})
+	}
+	output.Table(output.TableData{Headers: []string{"ID", "Title", "State", "Assignee"}, Rows: rows}, false, false)
+}
+
+func formatFieldChanges(changes []snapshot.FieldChange) string {
+	parts := make([]string, 0, len(changes))
+	for _, c := range changes {
+		from, to := c.From, c.To
+		if from == "" {
+			from = "none"
+		}
+		if to == "" {
+			to = "none"
+		}
+		parts = append(parts, fmt.Sprintf("%s: %s → %s", c.Field, from, to))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func init() {
+	viewCmd.AddCommand(viewDiffCmd)
+
+	viewDiffCmd.Flags().String("against", "", "Snapshot name to compare with (required)")
+	viewDiffCmd.Flags().Bool("fail-on-change", false, "Exit with code 2 when the results differ from the snapshot")
+	viewDiffCmd.Flags().Bool("force", false, "Compare even if the snapshot was taken from a different view")
+	_ = viewDiffCmd.MarkFlagRequired("against")
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newViewFilterTestCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "test"}
+	addViewFilterFlags(cmd)
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatalf("failed to parse flags %v: %v", args, err)
+	}
+	return cmd
+}
+
+func TestBuildIssueFilter(t *testing.T) {
+	tests := []struct {
+		name string
+		opts issueFilterOptions
+		want string
+	}{
+		{
+			name: "defaults exclude completed",
+			opts: issueFilterOptions{Priority: -1},
+			want: `{"state":{"type":{"nin":["completed","canceled"]}}}`,
+		},
+		{
+			name: "include completed",
+			opts: issueFilterOptions{Priority: -1, IncludeCompleted: true},
+			want: `{}`,
+		},
+		{
+			name: "assignee me with state and team",
+			opts: issueFilterOptions{Assignee: "me", State: "In Progress", Team: "ENG", Priority: -1},
+			want: `{"assignee":{"isMe":{"eq":true}},"state":{"name":{"eq":"In Progress"}},"team":{"key":{"eq":"ENG"}}}`,
+		},
+		{
+			name: "assignee email, labels, priority and date",
+			opts: issueFilterOptions{
+				Assignee:         "dev@example.com",
+				Labels:           []string{"Bug", "Regression"},
+				Priority:         0,
+				NewerThan:        "2025-01-01",
+				IncludeCompleted: true,
+			},
+			want: `{"assignee":{"email":{"eq":"dev@example.com"}},"createdAt":{"gte":"2025-01-01T00:00:00Z"},"labels":{"some":{"name":{"in":["Bug","Regression"]}}},"priority":{"eq":0}}`,
+		},
+		{
+			name: "all_time adds no createdAt",
+			opts: issueFilterOptions{Priority: -1, NewerThan: "all_time", IncludeCompleted: true},
+			want: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := buildIssueFilter(tt.opts)
+			if err != nil {
+				t.Fatalf("buildIssueFilter returned error: %v", err)
+			}
+			got, _ := json.Marshal(filter)
+			if string(got) != tt.want {
+				t.Errorf("filter mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterInvalidNewerThan(t *testing.T) {
+	if _, err := buildIssueFilter(issueFilterOptions{Priority: -1, NewerThan: "yesterday"}); err == nil {
+		t.Fatal("expected error for invalid newer-than expression")
+	}
+}
+
+func TestViewFilterFromFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{
+			name: "no flags",
+			args: nil,
+			want: `null`,
+		},
+		{
+			name: "raw json only",
+			args: []string{"--filter-json", `{"priority":{"lte":2}}`},
+			want: `{"priority":{"lte":2}}`,
+		},
+		{
+			name: "state and assignee",
+			args: []string{"--filter-state", "Todo", "--filter-assignee", "me"},
+			want: `{"assignee":{"isMe":{"eq":true}},"state":{"name":{"eq":"Todo"}}}`,
+		},
+		{
+			name: "team, repeated labels and date",
+			args: []string{"--filter-team", "ENG", "--filter-label", "Bug", "--filter-label", "UI", "--filter-newer-than", "2025-06-30"},
+			want: `{"createdAt":{"gte":"2025-06-30T00:00:00Z"},"labels":{"some":{"name":{"in":["Bug","UI"]}}},"team":{"key":{"eq":"ENG"}}}`,
+		},
+		{
+			name: "json merged with flags",
+			args: []string{"--filter-json", `{"project":{"id":{"eq":"p1"}}}`, "--filter-priority", "1"},
+			want: `{"priority":{"eq":1},"project":{"id":{"eq":"p1"}}}`,
+		},
+		{
+			name: "flags win on conflict",
+			args: []string{"--filter-json", `{"priority":{"lte":2},"team":{"key":{"eq":"OPS"}}}`, "--filter-team", "ENG"},
+			want: `{"priority":{"lte":2},"team":{"key":{"eq":"ENG"}}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, err := viewFilterFromFlags(newViewFilterTestCmd(t, tt.args...))
+			if err != nil {
+				t.Fatalf("viewFilterFromFlags returned error: %v", err)
+			}
+			got, _ := json.Marshal(filter)
+			if string(got) != tt.want {
+				t.Errorf("filter mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeIssueFiltersReportsConflicts(t *testing.T) {
+	base := map[string]interface{}{"team": "a", "state": "b", "priority": "c"}
+	flags := map[string]interface{}{"team": "x", "state": "y", "labels": "z"}
+
+	_, conflicts := mergeIssueFilters(base, flags)
+	got, _ := json.Marshal(conflicts)
+	if string(got) != `["state","team"]` {
+		t.Errorf("conflicts = %s, want [\"state\",\"team\"]", got)
+	}
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/auth"
+	"github.com/roboalchemist/linear-cli/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var workspaceCmd = &cobra.Command{
+	Use:     "workspace",
+	Aliases: []string{"org", "organization"},
+	Short:   "Show the current workspace",
+	Long: `Show the Linear workspace (organization) the current credentials belong to.
+
+Includes the URL key, user count, creation date, allowed auth services,
+SAML/SCIM status and, when visible to the token, the subscription plan.
+
+Examples:
+  linear-cli workspace
+  linear-cli org --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		org, err := client.GetOrganization(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get workspace: %v", err), plaintext, jsonOut)
+			exit(1)
+		}
+
+		// The subscription is not exposed to every token; leave it out on error
+		if sub, err := client.GetOrganizationSubscription(context.Background()); err == nil {
+			org.Subscription = sub
+		}
+
+		authServices := strings.Join(org.AllowedAuthServices, ", ")
+		if authServices == "" {
+			authServices = "all"
+		}
+		plan := "unknown"
+		if org.Subscription != nil && org.Subscription.Type != "" {
+			plan = org.Subscription.Type
+		}
+
+		if jsonOut {
+			output.JSON(org)
+		} else if plaintext {
+			fmt.Printf("ID: %s\n", org.ID)
+			fmt.Printf("Name: %s\n", org.Name)
+			fmt.Printf("URL Key: %s\n", org.URLKey)
+			fmt.Printf("URL: https://linear.app/%s\n", org.URLKey)
+			if org.LogoURL != nil && *org.LogoURL != "" {
+				fmt.Printf("Logo: %s\n", *org.LogoURL)
+			}
+			fmt.Printf("Users: %d\n", org.UserCount)
+			if org.CreatedAt != nil {
+				fmt.Printf("Created: %s\n", output.FormatTime(*org.CreatedAt, output.DateOnly))
+			}
+			fmt.Printf("Allowed Auth Services: %s\n", authServices)
+			fmt.Printf("SAML Enabled: %v\n", org.SamlEnabled)
+			fmt.Printf("SCIM Enabled: %v\n", org.ScimEnabled)
+			fmt.Printf("Plan: %s\n", plan)
+			if org.Subscription != nil && org.Subscription.Seats > 0 {
+				fmt.Printf("Seats: %.0f\n", org.Subscription.Seats)
+			}
+		} else {
+			fmt.Println()
+			fmt.Printf("%s %s\n",
+				color.New(color.FgCyan, color.Bold).Sprint("🏢 Workspace:"),
+				org.Name)
+			fmt.Println(strings.Repeat("─", 50))
+
+			fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("URL:"),
+				color.New(color.FgBlue, color.Underline).Sprintf("https://linear.app/%s", org.URLKey))
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("URL Key:"), org.URLKey)
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"),
+				color.New(color.FgWhite, color.Faint).Sprint(org.ID))
+			if org.LogoURL != nil && *org.LogoURL != "" {
+				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Logo:"), *org.LogoURL)
+			}
+			fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Users:"), org.UserCount)
+			if org.CreatedAt != nil {
+				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), output.FormatTime(*org.CreatedAt, output.DateOnly))
+			}
+
+			fmt.Printf("\n%s\n", color.New(color.Bold, color.FgYellow).Sprint("Security"))
+			fmt.Printf("  %s %s\n", color.New(color.Bold).Sprint("Auth Services:"), authServices)
+			fmt.Printf("  %s %s\n", color.New(color.Bold).Sprint("SAML:"), enabledLabel(org.SamlEnabled))
+			fmt.Printf("  %s %s\n", color.New(color.Bold).Sprint("SCIM:"), enabledLabel(org.ScimEnabled))
+
+			fmt.Printf("\n%s\n", color.New(color.Bold, color.FgYellow).Sprint("Subscription"))
+			fmt.Printf("  %s %s\n", color.New(color.Bold).Sprint("Plan:"), plan)
+			if org.Subscription != nil && org.Subscription.Seats > 0 {
+				fmt.Printf("  %s %.0f\n", color.New(color.Bold).Sprint("Seats:"), org.Subscription.Seats)
+			}
+			fmt.Println()
+		}
+	},
+}
+
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return color.New(color.FgGreen).Sprint("enabled")
+	}
+	return color.New(color.FgWhite, color.Faint).Sprint("disabled")
+}
+
+func init() {
+	rootCmd.AddCommand(workspaceCmd)
+}
@@ -0,0 +1,6 @@
+//go:build live
+
+package main
+
+// liveCRUD runs every TestCRUD subtest against the Linear API
+const liveCRUD = true
@@ -0,0 +1,6 @@
+//go:build !live
+
+package main
+
+// liveCRUD is off, so TestCRUD replays its cassettes offline
+const liveCRUD = false
@@ -5,18 +5,29 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
 )
 
-// Package-level state set in TestMain
+// cassettePrefix is testPrefix in cassettes: recording replaces the run's
+// prefix with it, and replays use it as theirs
+const cassettePrefix = "crud-test-cassette"
+
+// Package-level state set in TestMain and the Setup subtest
 var (
 	binaryPath string
 	teamKey    string
 	teamUUID   string
 	testPrefix string
 
+	// crudScrubber scrubs every cassette a run records, so an entity one
+	// cassette creates has the same placeholder ID in the next
+	crudScrubber = linearmock.NewScrubber()
+
 	// Cross-subtest shared IDs
 	testProjectID string
 	testLabelID   string
@@ -163,7 +174,8 @@ func assertNotEmpty(t *testing.T, s string) {
 func TestMain(m *testing.M) {
 	// Build binary
 	fmt.Println("Building linear-cli test binary...")
-	build := exec.Command("go", "build", "-o", "linear-cli.test", ".")
+	// -tags linearmock lets the binary talk to a replay server
+	build := exec.Command("go", "build", "-tags", "linearmock", "-o", "linear-cli.test", ".")
 	build.Stdout = os.Stdout
 	build.Stderr = os.Stderr
 	if err := build.Run(); err != nil {
@@ -174,37 +186,11 @@ func TestMain(m *testing.M) {
 	wd, _ := os.Getwd()
 	binaryPath = wd + "/linear-cli.test"
 	testPrefix = fmt.Sprintf("crud-test-%d", time.Now().Unix())
-
-	// Verify auth
-	fmt.Println("Verifying authentication...")
-	cmd := exec.Command(binaryPath, "auth", "status", "--json")
-	out, err := cmd.Output()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "FATAL: not authenticated. Run 'linear-cli auth' first.\n")
-		os.Exit(1)
-	}
-	if !strings.Contains(string(out), "true") {
-		fmt.Fprintf(os.Stderr, "FATAL: auth status check failed: %s\n", string(out))
-		os.Exit(1)
-	}
-
-	// Discover team
-	fmt.Println("Discovering team...")
-	cmd = exec.Command(binaryPath, "team", "list", "--json")
-	out, err = cmd.Output()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "FATAL: failed to list teams: %v\n", err)
-		os.Exit(1)
-	}
-	var teams []map[string]interface{}
-	if err := json.Unmarshal(out, &teams); err != nil || len(teams) == 0 {
-		fmt.Fprintf(os.Stderr, "FATAL: no teams found or JSON parse error: %v\n", err)
-		os.Exit(1)
+	if crudReplay() {
+		testPrefix = cassettePrefix
+	} else {
+		crudScrubber.Replace(testPrefix, cassettePrefix)
 	}
-	teamKey = fmt.Sprintf("%v", teams[0]["key"])
-	teamUUID = fmt.Sprintf("%v", teams[0]["id"])
-
-	fmt.Printf("Test config: team=%s (%s), prefix=%s\n", teamKey, teamUUID, testPrefix)
 
 	exitCode := m.Run()
 
@@ -213,10 +199,80 @@ func TestMain(m *testing.M) {
 	os.Exit(exitCode)
 }
 
+// =============================================================================
+// Cassettes - recorded API traffic
+// =============================================================================
+
+// crudReplay reports whether TestCRUD replays cassettes instead of talking
+// to the Linear API: without -tags live, unless recording
+func crudReplay() bool {
+	return !liveCRUD && os.Getenv(linearmock.RecordEnv) == ""
+}
+
+// recorded wraps a subtest that has a cassette, at
+// testdata/cassettes/TestCRUD/<name>.json. Replaying, the binary talks to a
+// linearmock server serving the cassette through LINEARMOCK_API_URL, and the
+// subtest skips if there's no cassette yet. Recording, the same server
+// proxies to the API and rewrites the cassette when the subtest ends. Live,
+// the subtest talks to the API directly.
+func recorded(test func(t *testing.T)) func(t *testing.T) {
+	return func(t *testing.T) {
+		path := filepath.Join("testdata", "cassettes", filepath.FromSlash(t.Name())+".json")
+		switch {
+		case crudReplay():
+			if _, err := os.Stat(path); err != nil {
+				t.Skipf("no cassette to replay: %v", err)
+			}
+		case os.Getenv(linearmock.RecordEnv) == "":
+			test(t)
+			return
+		}
+		s := linearmock.New(t)
+		s.UseCassette(path, crudScrubber)
+		t.Setenv(linearmock.APIURLEnv, s.URL)
+		test(t)
+	}
+}
+
+// liveOnly wraps a subtest that has no cassette yet, so it only runs with
+// -tags live
+func liveOnly(test func(t *testing.T)) func(t *testing.T) {
+	return func(t *testing.T) {
+		if !liveCRUD {
+			t.Skip("no cassette; runs with -tags live")
+		}
+		test(t)
+	}
+}
+
 // =============================================================================
 // TestCRUD - ordered subtests
 // =============================================================================
 
+// TestCRUD creates, reads, updates, and archives entities through the
+// built binary. It runs three ways:
+//
+//   - go test . replays the subtests wrapped in recorded() from their
+//     cassettes, offline and with throwaway credentials, and skips the
+//     rest. This is what CI runs.
+//   - go test -tags live -run TestCRUD . (make test-crud) runs every
+//     subtest against the Linear API with your credentials.
+//   - LINEARMOCK_RECORD=1 go test -tags live -run TestCRUD .
+//     (make test-crud-record) runs them live too, and rewrites the
+//     cassettes of the recorded() subtests from their traffic.
+//
+// Replaying and recording use a throwaway HOME, so your config can't
+// change what commands send, and recording takes its credentials from
+// LINEAR_API_KEY. Record the whole TestCRUD at once: the scrubber numbers
+// placeholder IDs across cassettes, and later cassettes use entities
+// earlier ones created. Re-record when a recorded command changes the
+// requests it sends; an unmatched request fails the replay. Scrubbing
+// replaces IDs, cursors, timestamps, and the run's name prefix, but names
+// and identifiers from the workspace stay, so review the diff before
+// committing.
+//
+// To give another subtest a cassette, swap liveOnly for recorded below and
+// record.
 func TestCRUD(t *testing.T) {
 	// Ordered subtests - some produce IDs consumed by later ones.
 	// Go runs subtests sequentially within a parent test.
@@ -224,9 +280,34 @@ func TestCRUD(t *testing.T) {
 	// Shared resource cleanup is registered at THIS level so resources
 	// persist across all subtests, not just the one that created them.
 
+	// Cassettes are recorded and replayed with a throwaway HOME, so local
+	// config can't change the requests commands send. Replays need no real
+	// credentials.
+	if crudReplay() || os.Getenv(linearmock.RecordEnv) != "" {
+		switch {
+		case crudReplay():
+			t.Setenv("LINEAR_API_KEY", "lin_api_replay")
+		case os.Getenv("LINEAR_API_KEY") == "":
+			t.Fatal("recording needs LINEAR_API_KEY")
+		}
+		t.Setenv("LINCTL_API_KEY", "")
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv("XDG_STATE_HOME", t.TempDir())
+	}
+	if !t.Run("Setup", recorded(testSetup)) {
+		t.FailNow()
+	}
+	if teamKey == "" {
+		t.Skip("no team to test in: Setup has no cassette")
+	}
+
 	// Register cleanup for shared resources at parent level.
-	// These run in LIFO order after all subtests complete.
+	// These run in LIFO order after all subtests complete. Replayed
+	// entities were never created.
 	t.Cleanup(func() {
+		if crudReplay() {
+			return
+		}
 		// Clean up issues (archive)
 		if testIssueID != "" {
 			runCLI(t, "issue", "archive", testIssueID)
@@ -249,25 +330,52 @@ func TestCRUD(t *testing.T) {
 		}
 	})
 
-	t.Run("Team", testTeam)
-	t.Run("User", testUser)
-	t.Run("Label", testLabel)
-	t.Run("Project", testProject)
-	t.Run("Initiative", testInitiative)
-	t.Run("Milestone", testMilestone)
-	t.Run("Document", testDocument)
-	t.Run("Cycle", testCycle)
-	t.Run("Issue", testIssue)
-	t.Run("IssueTree", testIssueTree)
-	t.Run("Comment", testComment)
-	t.Run("Relation", testRelation)
-	t.Run("Attachment", testAttachment)
-	t.Run("View", testView)
-	t.Run("Favorite", testFavorite)
-	t.Run("StatusUpdate", testStatusUpdate)
-	t.Run("Inbox", testInbox)
-	t.Run("GraphQL", testGraphQL)
-	t.Run("ErrorHandling", testErrorHandling)
+	t.Run("Team", liveOnly(testTeam))
+	t.Run("User", liveOnly(testUser))
+	t.Run("Label", liveOnly(testLabel))
+	t.Run("Project", recorded(testProject))
+	t.Run("Initiative", liveOnly(testInitiative))
+	t.Run("Milestone", liveOnly(testMilestone))
+	t.Run("Document", liveOnly(testDocument))
+	t.Run("Cycle", liveOnly(testCycle))
+	t.Run("Issue", recorded(testIssue))
+	t.Run("IssueTree", liveOnly(testIssueTree))
+	t.Run("Comment", liveOnly(testComment))
+	t.Run("Relation", liveOnly(testRelation))
+	t.Run("Attachment", liveOnly(testAttachment))
+	t.Run("View", liveOnly(testView))
+	t.Run("Favorite", liveOnly(testFavorite))
+	t.Run("StatusUpdate", liveOnly(testStatusUpdate))
+	t.Run("Inbox", liveOnly(testInbox))
+	t.Run("History", liveOnly(testHistory))
+	t.Run("GraphQL", liveOnly(testGraphQL))
+	t.Run("ErrorHandling", liveOnly(testErrorHandling))
+}
+
+// =============================================================================
+// Setup - credentials and team
+// =============================================================================
+
+// testSetup checks the credentials and picks the team the other subtests
+// work in
+func testSetup(t *testing.T) {
+	out, _, exitCode := runCLI(t, "auth", "status", "--json")
+	if exitCode != 0 {
+		t.Fatal("not authenticated. Run 'linear-cli auth' first.")
+	}
+	if !strings.Contains(out, "true") {
+		t.Fatalf("auth status check failed: %s", out)
+	}
+
+	var teams []map[string]interface{}
+	out = runCLISuccess(t, "team", "list", "--json")
+	if err := json.Unmarshal([]byte(out), &teams); err != nil || len(teams) == 0 {
+		t.Fatalf("no teams found or JSON parse error: %v", err)
+	}
+	teamKey = fmt.Sprintf("%v", teams[0]["key"])
+	teamUUID = fmt.Sprintf("%v", teams[0]["id"])
+
+	t.Logf("Test config: team=%s (%s), prefix=%s", teamKey, teamUUID, testPrefix)
 }
 
 // =============================================================================
@@ -318,6 +426,19 @@ func testTeam(t *testing.T) {
 		}
 	})
 
+	t.Run("Get_UUID", func(t *testing.T) {
+		out := runCLISuccess(t, "team", "get", teamUUID, "--json")
+		key := extractField(t, out, "key")
+		if key != teamKey {
+			t.Errorf("expected team key %s for %s, got %s", teamKey, teamUUID, key)
+		}
+	})
+
+	t.Run("Get_Unknown", func(t *testing.T) {
+		out := runCLIFail(t, "team", "get", testPrefix+"-no-such-team")
+		assertContains(t, out, "by UUID, key, or name")
+	})
+
 	t.Run("Members", func(t *testing.T) {
 		out := runCLISuccess(t, "team", "members", teamKey)
 		assertNotEmpty(t, out)
@@ -351,6 +472,32 @@ func testTeam(t *testing.T) {
 		out := runCLISuccess(t, "team", "states", teamKey, "-p")
 		assertNotEmpty(t, out)
 	})
+
+	// Flip each toggle and restore it with an explicit --flag=BOOL, so both
+	// directions (false included) round-trip through team get. private,
+	// cycles-enabled, and triage-enabled are left alone: they affect the
+	// whole workspace or fail while the team has an active cycle.
+	t.Run("Update_Toggles", func(t *testing.T) {
+		toggles := map[string]string{
+			"cycle-auto-assign-started":        "cycleIssueAutoAssignStarted",
+			"cycle-auto-assign-completed":      "cycleIssueAutoAssignCompleted",
+			"cycle-lock-to-active":             "cycleLockToActive",
+			"require-priority-to-leave-triage": "requirePriorityToLeaveTriage",
+			"issue-estimation-allow-zero":      "issueEstimationAllowZero",
+			"issue-estimation-extended":        "issueEstimationExtended",
+			"group-issue-history":              "groupIssueHistory",
+		}
+		for flag, field := range toggles {
+			orig := parseJSONObject(t, runCLISuccess(t, "team", "get", teamKey, "--json"))[field] == true
+			for _, v := range []bool{!orig, orig} {
+				runCLISuccess(t, "team", "update", teamKey, fmt.Sprintf("--%s=%v", flag, v))
+				got := parseJSONObject(t, runCLISuccess(t, "team", "get", teamKey, "--json"))[field]
+				if got != v {
+					t.Errorf("after --%s=%v, team get shows %s = %v", flag, v, field, got)
+				}
+			}
+		}
+	})
 }
 
 // =============================================================================
@@ -1028,6 +1175,55 @@ func testDocument(t *testing.T) {
 		}
 	})
 
+	t.Run("ProjectDocuments", func(t *testing.T) {
+		if docID == "" || testProjectID == "" {
+			t.Skip("no document or project created")
+		}
+		out := runCLISuccess(t, "project", "documents", testProjectID, "--json")
+		found := false
+		for _, d := range parseJSONArray(t, out) {
+			if fmt.Sprintf("%v", d["id"]) == docID {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("document %s not listed under project %s", docID, testProjectID)
+		}
+	})
+
+	t.Run("Move_NoParent", func(t *testing.T) {
+		if docID == "" || testProjectID == "" {
+			t.Skip("no document or project created")
+		}
+		out := runCLIFail(t, "document", "move", docID, "--to-project", "none")
+		assertContains(t, out, "--detach")
+	})
+
+	t.Run("Move_ToTeam", func(t *testing.T) {
+		if docID == "" || testProjectID == "" {
+			t.Skip("no document or project created")
+		}
+		out := runCLISuccess(t, "document", "move", docID, "--to-team", teamKey, "--json")
+		obj := parseJSONObject(t, out)
+		from, _ := obj["from"].([]interface{})
+		to, _ := obj["to"].([]interface{})
+		if len(from) == 0 || len(to) == 0 {
+			t.Fatalf("expected before/after parents, got %s", out)
+		}
+		if parent, _ := to[0].(map[string]interface{}); parent["type"] != "team" {
+			t.Errorf("expected document to be under team %s, got %v", teamKey, to)
+		}
+	})
+
+	t.Run("Move_ToProject", func(t *testing.T) {
+		if docID == "" || testProjectID == "" {
+			t.Skip("no document or project created")
+		}
+		out := runCLISuccess(t, "document", "move", docID, "--to-project", testProjectID, "-p")
+		assertContains(t, out, "From: team "+teamKey)
+		assertContains(t, out, "To: project ")
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		if docID == "" {
 			t.Skip("no document created")
@@ -1297,6 +1493,36 @@ func testIssue(t *testing.T) {
 		// Test combining --parent with --team filter
 		runCLISuccess(t, "issue", "list", "--parent", parentID, "--team", teamKey, "--json")
 
+		// --top-level keeps the parent, drops the child, and --with-children counts it
+		out = runCLISuccess(t, "issue", "list", "--team", teamKey, "--top-level", "--with-children",
+			"--newer-than", "1_day_ago", "--limit", "250", "--json")
+		arr = parseJSONArray(t, out)
+		var parentCount interface{}
+		for _, issue := range arr {
+			switch fmt.Sprintf("%v", issue["identifier"]) {
+			case childID:
+				t.Errorf("child issue %s should be excluded by --top-level", childID)
+			case parentID:
+				parentCount = issue["childCount"]
+			}
+		}
+		if parentCount != float64(1) {
+			t.Errorf("expected parent %s childCount 1 with --with-children, got %v", parentID, parentCount)
+		}
+
+		// --parent composes with --with-children; the child itself has no children
+		out = runCLISuccess(t, "issue", "list", "--parent", parentID, "--team", teamKey, "--with-children", "--json")
+		for _, issue := range parseJSONArray(t, out) {
+			if fmt.Sprintf("%v", issue["identifier"]) == childID && issue["childCount"] != float64(0) {
+				t.Errorf("expected child %s childCount 0, got %v", childID, issue["childCount"])
+			}
+		}
+
+		// --parent and --top-level are mutually exclusive
+		if _, _, code := runCLI(t, "issue", "list", "--parent", parentID, "--top-level"); code == 0 {
+			t.Error("expected --parent with --top-level to fail")
+		}
+
 		// Cleanup
 		t.Cleanup(func() {
 			runCLI(t, "issue", "archive", childID)
@@ -2108,6 +2334,36 @@ func testInbox(t *testing.T) {
 	})
 }
 
+// =============================================================================
+// History tests (journal written by earlier mutating subtests)
+// =============================================================================
+
+func testHistory(t *testing.T) {
+	t.Run("List_JSON", func(t *testing.T) {
+		out := runCLISuccess(t, "history", "--json", "--limit", "100")
+		entries := parseJSONArray(t, out)
+		if len(entries) == 0 {
+			t.Fatal("expected journal entries from earlier mutating subtests")
+		}
+		for _, e := range entries {
+			if !strings.HasPrefix(fmt.Sprintf("%v", e["command"]), "linear-cli") {
+				t.Errorf("unexpected command in journal entry: %v", e["command"])
+			}
+		}
+	})
+
+	t.Run("List_Plaintext", func(t *testing.T) {
+		out := runCLISuccess(t, "history", "-p")
+		assertContains(t, out, "Command")
+	})
+
+	t.Run("Undo_DryRun", func(t *testing.T) {
+		// Last operation may or may not be undoable; either way nothing is changed
+		stdout, stderr, _ := runCLI(t, "undo", "--dry-run")
+		assertNotEmpty(t, stdout+stderr)
+	})
+}
+
 // =============================================================================
 // GraphQL tests
 // =============================================================================
@@ -0,0 +1,21 @@
+//go:build linearmock
+
+package main
+
+import (
+	"os"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// Test binaries built with -tags linearmock, like the one crud_test.go runs,
+// send API requests to $LINEARMOCK_API_URL when it is set. Release builds
+// have no such override, so credentials only ever go to api.BaseURL.
+func init() {
+	if url := os.Getenv(linearmock.APIURLEnv); url != "" {
+		api.NewClientWithBaseURL = func(_, authHeader string) *api.Client {
+			return api.NewClientWithURL(url, authHeader)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// The request budget and rate-limit snapshot are process-wide: commands
+// create several clients and fetch concurrently, and the cap applies to all
+// of them together.
+var (
+	requestsMade  atomic.Int64
+	requestBudget atomic.Int64
+
+	rateLimitMu     sync.Mutex
+	latestRateLimit *RateLimit
+)
+
+// BudgetExceededError is returned instead of sending a request once the
+// request budget set with SetRequestBudget is spent
+type BudgetExceededError struct {
+	Max int64
+}
+
+func (e *BudgetExceededError) Error() string {
+	return fmt.Sprintf("request budget exhausted: %d API requests allowed (--max-requests); aborting before spending more rate-limit quota", e.Max)
+}
+
+// SetRequestBudget caps the number of API requests from now on and resets
+// the request count. Zero or less means no cap.
+func SetRequestBudget(max int) {
+	requestBudget.Store(int64(max))
+	requestsMade.Store(0)
+}
+
+// RequestBudget returns the current request cap, or 0 when there is none
+func RequestBudget() int64 {
+	return requestBudget.Load()
+}
+
+// RequestsMade returns the number of API requests attempted since the last
+// SetRequestBudget call, including any refused for exceeding the budget
+func RequestsMade() int64 {
+	return requestsMade.Load()
+}
+
+// RequestBudgetExceeded reports whether a request has been refused because
+// the budget was spent
+func RequestBudgetExceeded() bool {
+	max := requestBudget.Load()
+	return max > 0 && requestsMade.Load() > max
+}
+
+// takeRequest counts one API request, refusing it when the budget is spent
+func takeRequest() error {
+	n := requestsMade.Add(1)
+	if max := requestBudget.Load(); max > 0 && n > max {
+		return &BudgetExceededError{Max: max}
+	}
+	return nil
+}
+
+// LatestRateLimit returns the rate-limit headers from the most recent
+// response seen by any client, or nil before the first request
+func LatestRateLimit() *RateLimit {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+	return latestRateLimit
+}
+
+// recordRateLimit stores rl as this client's and the process's latest snapshot
+func (c *Client) recordRateLimit(rl *RateLimit) {
+	rateLimitMu.Lock()
+	defer rateLimitMu.Unlock()
+	c.LastRateLimit = rl
+	if rl.RequestLimit > 0 {
+		latestRateLimit = rl
+	}
+}
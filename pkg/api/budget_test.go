@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// countingServer answers every request with body and counts the hits
+func countingServer(t *testing.T, body string) (*httptest.Server, *atomic.Int64) {
+	t.Helper()
+	var hits atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		w.Header().Set("X-RateLimit-Requests-Limit", "1500")
+		w.Header().Set("X-RateLimit-Requests-Remaining", fmt.Sprint(1500-hits.Load()))
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &hits
+}
+
+func TestRequestBudgetStopsPagination(t *testing.T) {
+	// A label whose issues never stop paginating
+	server, hits := countingServer(t, `{"data":{"issueLabel":{"issues":{"nodes":[{"id":"i"}],"pageInfo":{"hasNextPage":true,"endCursor":"c"}}}}}`)
+	SetRequestBudget(3)
+	defer SetRequestBudget(0)
+
+	client := NewClientWithURL(server.URL, "key")
+	count, err := client.CountLabelIssues(context.Background(), "label")
+
+	var budgetErr *BudgetExceededError
+	if !errors.As(err, &budgetErr) || budgetErr.Max != 3 {
+		t.Fatalf("CountLabelIssues error = %v, want budget exceeded", err)
+	}
+	if count != 0 {
+		t.Errorf("count = %d, want 0 (no partial result)", count)
+	}
+	if hits.Load() != 3 {
+		t.Errorf("server saw %d requests, want 3", hits.Load())
+	}
+	if !RequestBudgetExceeded() {
+		t.Error("RequestBudgetExceeded = false after refusal")
+	}
+}
+
+func TestRequestBudgetConcurrent(t *testing.T) {
+	server, hits := countingServer(t, `{"data":{"viewer":{"id":"u1"}}}`)
+	SetRequestBudget(5)
+	defer SetRequestBudget(0)
+
+	client := NewClientWithURL(server.URL, "key")
+	var refused atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.ExecuteRaw(context.Background(), "query { viewer { id } }", nil); err != nil {
+				refused.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if hits.Load() != 5 || refused.Load() != 15 {
+		t.Errorf("sent %d, refused %d; want 5 sent, 15 refused", hits.Load(), refused.Load())
+	}
+	if RequestsMade() != 20 {
+		t.Errorf("RequestsMade = %d, want 20", RequestsMade())
+	}
+}
+
+func TestRequestBudgetUnlimited(t *testing.T) {
+	server, hits := countingServer(t, `{"data":{"viewer":{"id":"u1"}}}`)
+	SetRequestBudget(0)
+
+	client := NewClientWithURL(server.URL, "key")
+	for i := 0; i < 3; i++ {
+		if err := client.Execute(context.Background(), "query { viewer { id } }", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if hits.Load() != 3 || RequestBudgetExceeded() {
+		t.Errorf("hits = %d, exceeded = %v", hits.Load(), RequestBudgetExceeded())
+	}
+
+	rl := LatestRateLimit()
+	if rl == nil || rl.RequestLimit != 1500 || rl.RequestRemaining != 1497 {
+		t.Errorf("LatestRateLimit = %+v", rl)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -19,7 +20,64 @@ type Client struct {
 	httpClient    *http.Client
 	authHeader    string
 	baseURL       string
-	LastRateLimit *RateLimit // Updated after each request
+	preflight     PreflightFunc
+	check         ResultCheckFunc
+	LastRateLimit *RateLimit // Updated after each request; see LatestRateLimit
+}
+
+// PreflightFunc inspects a GraphQL operation before it is sent.
+// Returning an error aborts the request without contacting the API.
+type PreflightFunc func(query string, isMutation bool) error
+
+// defaultPreflight is installed on every client created by NewClient/NewClientWithURL
+var defaultPreflight PreflightFunc
+
+// SetDefaultPreflight installs a pre-flight check used by all clients created afterwards
+func SetDefaultPreflight(f PreflightFunc) {
+	defaultPreflight = f
+}
+
+// SetPreflight installs a pre-flight check on this client, replacing the default
+func (c *Client) SetPreflight(f PreflightFunc) {
+	c.preflight = f
+}
+
+// ResultCheckFunc inspects a decoded response before Execute returns it.
+// Returning an error fails the request as if the API had.
+type ResultCheckFunc func(result interface{}) error
+
+// defaultResultCheck is installed on every client created by NewClient/NewClientWithURL
+var defaultResultCheck ResultCheckFunc
+
+// SetDefaultResultCheck installs a response check used by all clients created afterwards
+func SetDefaultResultCheck(f ResultCheckFunc) {
+	defaultResultCheck = f
+}
+
+// IsMutation reports whether a GraphQL document is a mutation operation
+func IsMutation(query string) bool {
+	q := query
+	for {
+		q = strings.TrimLeft(q, " \t\r\n,")
+		if !strings.HasPrefix(q, "#") {
+			break
+		}
+		// Skip comment line
+		if idx := strings.Index(q, "\n"); idx >= 0 {
+			q = q[idx+1:]
+		} else {
+			q = ""
+		}
+	}
+	return strings.HasPrefix(q, "mutation")
+}
+
+// runPreflight calls the client's pre-flight check, if any
+func (c *Client) runPreflight(query string) error {
+	if c.preflight == nil {
+		return nil
+	}
+	return c.preflight(query, IsMutation(query))
 }
 
 type GraphQLRequest struct {
@@ -33,9 +91,10 @@ type GraphQLResponse struct {
 }
 
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions GraphQLErrorExtensions `json:"extensions,omitempty"`
 }
 
 type GraphQLErrorLocation struct {
@@ -45,9 +104,13 @@ type GraphQLErrorLocation struct {
 
 // NewClient creates a new Linear API client
 func NewClient(authHeader string) *Client {
-	return NewClientWithURL(BaseURL, authHeader)
+	return NewClientWithBaseURL(BaseURL, authHeader)
 }
 
+// NewClientWithBaseURL builds the clients NewClient returns. Tests replace it
+// to point every command at a mock server (see pkg/linearmock).
+var NewClientWithBaseURL = NewClientWithURL
+
 // NewClientWithURL creates a new Linear API client with custom URL
 func NewClientWithURL(baseURL, authHeader string) *Client {
 	return &Client{
@@ -56,11 +119,23 @@ func NewClientWithURL(baseURL, authHeader string) *Client {
 		},
 		authHeader: authHeader,
 		baseURL:    baseURL,
+		preflight:  defaultPreflight,
+		check:      defaultResultCheck,
 	}
 }
 
 // Execute performs a GraphQL request
 func (c *Client) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if err := checkReadOnly(query); err != nil {
+		return err
+	}
+	if err := c.runPreflight(query); err != nil {
+		return err
+	}
+	if err := takeRequest(); err != nil {
+		return err
+	}
+
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -82,35 +157,32 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		return fmt.Errorf("request failed: %w", &TransportError{Err: err})
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Capture rate limit headers
-	c.LastRateLimit = parseRateLimit(resp)
+	c.recordRateLimit(parseRateLimit(resp))
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var gqlResp GraphQLResponse
-	if err := json.Unmarshal(body, &gqlResp); err != nil {
-		return fmt.Errorf("failed to parse response: %w", err)
+		return fmt.Errorf("failed to read response: %w", &TransportError{Err: err})
 	}
 
-	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+	gqlResp, err := responseError(resp.StatusCode, body)
+	if err != nil {
+		return err
 	}
 
 	if result != nil {
 		if err := json.Unmarshal(gqlResp.Data, result); err != nil {
 			return fmt.Errorf("failed to unmarshal data: %w", err)
 		}
+		if c.check != nil {
+			if err := c.check(result); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -118,6 +190,16 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 
 // ExecuteRaw performs a GraphQL request and returns the raw JSON response data
 func (c *Client) ExecuteRaw(ctx context.Context, query string, variables map[string]interface{}) (json.RawMessage, error) {
+	if err := checkReadOnly(query); err != nil {
+		return nil, err
+	}
+	if err := c.runPreflight(query); err != nil {
+		return nil, err
+	}
+	if err := takeRequest(); err != nil {
+		return nil, err
+	}
+
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -139,29 +221,21 @@ func (c *Client) ExecuteRaw(ctx context.Context, query string, variables map[str
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, fmt.Errorf("request failed: %w", &TransportError{Err: err})
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	// Capture rate limit headers
-	c.LastRateLimit = parseRateLimit(resp)
+	c.recordRateLimit(parseRateLimit(resp))
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
-	}
-
-	var gqlResp GraphQLResponse
-	if err := json.Unmarshal(body, &gqlResp); err != nil {
-		return nil, fmt.Errorf("failed to parse response: %w", err)
+		return nil, fmt.Errorf("failed to read response: %w", &TransportError{Err: err})
 	}
 
-	if len(gqlResp.Errors) > 0 {
-		return nil, fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+	gqlResp, err := responseError(resp.StatusCode, body)
+	if err != nil {
+		return nil, err
 	}
 
 	return gqlResp.Data, nil
@@ -221,10 +295,13 @@ func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
 	if err != nil {
 		return nil, err
 	}
-	if c.LastRateLimit == nil {
+	rateLimitMu.Lock()
+	rl := c.LastRateLimit
+	rateLimitMu.Unlock()
+	if rl == nil {
 		return nil, fmt.Errorf("no rate limit info available")
 	}
-	return c.LastRateLimit, nil
+	return rl, nil
 }
 
 // UploadFileToURL uploads file data to a presigned URL
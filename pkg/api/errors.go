@@ -0,0 +1,165 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// The last error response is process-wide, like the request budget, so a
+// failing command's exit code can say why whichever client got it
+var lastAPIError atomic.Pointer[APIError]
+
+// LastAPIError returns the most recent error response since
+// ResetLastAPIError, or nil when there has been none
+func LastAPIError() error {
+	if apiErr := lastAPIError.Load(); apiErr != nil {
+		return apiErr
+	}
+	return nil
+}
+
+// ResetLastAPIError forgets earlier error responses, as a new command starts
+func ResetLastAPIError() {
+	lastAPIError.Store(nil)
+}
+
+// GraphQLErrorExtensions carries Linear's machine-readable error details
+type GraphQLErrorExtensions struct {
+	Code                   string `json:"code,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	StatusCode             int    `json:"statusCode,omitempty"`
+	UserError              bool   `json:"userError,omitempty"`
+	UserPresentableMessage string `json:"userPresentableMessage,omitempty"`
+}
+
+// APIError is returned when the API answers with GraphQL errors. It keeps
+// every error from the response so callers can inspect codes with errors.As.
+type APIError struct {
+	StatusCode int            `json:"statusCode"`
+	Errors     []GraphQLError `json:"errors"`
+}
+
+// errorHints maps Linear error codes to advice shown after the message
+var errorHints = map[string]string{
+	"AUTHENTICATION_ERROR": "run 'linear-cli auth' or check LINEAR_API_KEY",
+	"FORBIDDEN":            "the API key lacks permission for this operation; check its scopes and your workspace role",
+	"RATELIMITED":          "rate limit reached; wait for the window to reset and retry",
+	"ENTITY_NOT_FOUND":     "check that the ID is a UUID, not a slug or identifier",
+	"INVALID_INPUT":        "an input value was rejected; check formats (dates as YYYY-MM-DD, IDs as UUIDs)",
+}
+
+// Error lists every GraphQL error, numbered when there is more than one
+func (e *APIError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].describe()
+	}
+	parts := make([]string, len(e.Errors))
+	for i, gqlErr := range e.Errors {
+		parts[i] = fmt.Sprintf("(%d) %s", i+1, gqlErr.describe())
+	}
+	return fmt.Sprintf("%d API errors: %s", len(e.Errors), strings.Join(parts, "; "))
+}
+
+// Codes returns the distinct error codes in the response
+func (e *APIError) Codes() []string {
+	var codes []string
+	seen := make(map[string]bool)
+	for _, gqlErr := range e.Errors {
+		if code := gqlErr.Code(); code != "" && !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// HasCode reports whether any error in the response has the given code
+func (e *APIError) HasCode(code string) bool {
+	for _, gqlErr := range e.Errors {
+		if gqlErr.Code() == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Code returns the error's code. Linear reports missing entities as a
+// generic input error, so those are normalized to ENTITY_NOT_FOUND.
+func (e GraphQLError) Code() string {
+	if strings.HasPrefix(e.Message, "Entity not found") {
+		return "ENTITY_NOT_FOUND"
+	}
+	if e.Message == "Argument Validation Error" {
+		return "INVALID_INPUT"
+	}
+	return e.Extensions.Code
+}
+
+// PathString joins the error path, e.g. "projectUpdate.id"
+func (e GraphQLError) PathString() string {
+	parts := make([]string, len(e.Path))
+	for i, p := range e.Path {
+		parts[i] = fmt.Sprint(p)
+	}
+	return strings.Join(parts, ".")
+}
+
+// describe renders one error as "message (CODE on path x.y) — hint",
+// preferring the user-presentable message over the raw one
+func (e GraphQLError) describe() string {
+	msg := e.Message
+	if e.Extensions.UserPresentableMessage != "" {
+		msg = e.Extensions.UserPresentableMessage
+	}
+
+	code := e.Code()
+	path := e.PathString()
+	switch {
+	case code != "" && path != "":
+		msg = fmt.Sprintf("%s (%s on path %s)", msg, code, path)
+	case code != "":
+		msg = fmt.Sprintf("%s (%s)", msg, code)
+	case path != "":
+		msg = fmt.Sprintf("%s (on path %s)", msg, path)
+	}
+
+	if hint := errorHints[code]; hint != "" {
+		msg += " — " + hint
+	}
+	return msg
+}
+
+// responseError turns a non-successful HTTP response or a response carrying
+// GraphQL errors into an error. It returns nil and the parsed response when
+// the request succeeded.
+func responseError(statusCode int, body []byte) (*GraphQLResponse, error) {
+	var gqlResp GraphQLResponse
+	parseErr := json.Unmarshal(body, &gqlResp)
+
+	// Linear answers validation failures with 400 and a GraphQL errors body
+	if parseErr == nil && len(gqlResp.Errors) > 0 {
+		apiErr := &APIError{StatusCode: statusCode, Errors: gqlResp.Errors}
+		lastAPIError.Store(apiErr)
+		return nil, apiErr
+	}
+	// A throttled request may come back without a GraphQL body; it is still
+	// an APIError so IsRateLimited recognizes it
+	if statusCode == http.StatusTooManyRequests {
+		apiErr := &APIError{StatusCode: statusCode, Errors: []GraphQLError{{
+			Message:    "Too many requests",
+			Extensions: GraphQLErrorExtensions{Code: "RATELIMITED"},
+		}}}
+		lastAPIError.Store(apiErr)
+		return nil, apiErr
+	}
+	if statusCode != http.StatusOK {
+		return nil, fmt.Errorf("API request failed with status %d: %s", statusCode, string(body))
+	}
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", parseErr)
+	}
+	return &gqlResp, nil
+}
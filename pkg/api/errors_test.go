@@ -0,0 +1,135 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// Payloads captured from the Linear API
+const (
+	entityNotFoundPayload = `{"errors":[{"message":"Entity not found: ProjectUpdate","path":["projectUpdate"],"locations":[{"line":2,"column":3}],"extensions":{"type":"invalid input","code":"INPUT_ERROR","statusCode":400,"userError":true,"userPresentableMessage":"Could not find referenced ProjectUpdate."}}],"data":null}`
+
+	argumentValidationPayload = `{"errors":[{"message":"Argument Validation Error","locations":[{"line":2,"column":3}],"path":["issueUpdate"],"extensions":{"code":"INVALID_INPUT","type":"invalid input","userError":true,"userPresentableMessage":"dueDate must be a valid ISO 8601 date string"}}],"data":null}`
+
+	authenticationPayload = `{"errors":[{"message":"Authentication required, not authenticated","extensions":{"type":"authentication error","code":"AUTHENTICATION_ERROR","statusCode":401,"userError":true,"userPresentableMessage":"You need to authenticate to access this operation."}}]}`
+
+	multipleErrorsPayload = `{"errors":[{"message":"Entity not found: Issue","path":["a","issue"],"extensions":{"code":"INPUT_ERROR","userError":true}},{"message":"Forbidden","path":["b"],"extensions":{"code":"FORBIDDEN","userPresentableMessage":"You don't have access to this team."}}],"data":{"a":null,"b":null}}`
+)
+
+func TestResponseErrorMessages(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   []string
+		codes  []string
+	}{
+		{
+			name:   "entity not found",
+			status: 200,
+			body:   entityNotFoundPayload,
+			want: []string{
+				"Could not find referenced ProjectUpdate.",
+				"ENTITY_NOT_FOUND on path projectUpdate",
+				"check that the ID is a UUID, not a slug or identifier",
+			},
+			codes: []string{"ENTITY_NOT_FOUND"},
+		},
+		{
+			name:   "argument validation on HTTP 400",
+			status: 400,
+			body:   argumentValidationPayload,
+			want: []string{
+				"dueDate must be a valid ISO 8601 date string",
+				"INVALID_INPUT on path issueUpdate",
+			},
+			codes: []string{"INVALID_INPUT"},
+		},
+		{
+			name:   "authentication",
+			status: 400,
+			body:   authenticationPayload,
+			want: []string{
+				"You need to authenticate to access this operation. (AUTHENTICATION_ERROR)",
+				"run 'linear-cli auth'",
+			},
+			codes: []string{"AUTHENTICATION_ERROR"},
+		},
+		{
+			name:   "multiple errors",
+			status: 200,
+			body:   multipleErrorsPayload,
+			want: []string{
+				"2 API errors: (1) Entity not found: Issue (ENTITY_NOT_FOUND on path a.issue)",
+				"; (2) You don't have access to this team. (FORBIDDEN on path b)",
+			},
+			codes: []string{"ENTITY_NOT_FOUND", "FORBIDDEN"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := responseError(tt.status, []byte(tt.body))
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected *APIError, got %T: %v", err, err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q missing %q", err.Error(), want)
+				}
+			}
+			if got := strings.Join(apiErr.Codes(), ","); got != strings.Join(tt.codes, ",") {
+				t.Errorf("Codes() = %s, want %s", got, strings.Join(tt.codes, ","))
+			}
+			for _, code := range tt.codes {
+				if !apiErr.HasCode(code) {
+					t.Errorf("HasCode(%s) = false", code)
+				}
+			}
+		})
+	}
+}
+
+func TestResponseErrorWithoutGraphQLErrors(t *testing.T) {
+	_, err := responseError(502, []byte("<html>Bad Gateway</html>"))
+	var apiErr *APIError
+	if err == nil || errors.As(err, &apiErr) {
+		t.Fatalf("expected plain status error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "status 502") {
+		t.Errorf("error = %q", err.Error())
+	}
+
+	resp, err := responseError(200, []byte(`{"data":{"viewer":{"id":"u1"}}}`))
+	if err != nil || string(resp.Data) != `{"viewer":{"id":"u1"}}` {
+		t.Errorf("success response = %s, %v", resp.Data, err)
+	}
+}
+
+func TestExecuteReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(argumentValidationPayload))
+	}))
+	defer server.Close()
+
+	client := NewClientWithURL(server.URL, "key")
+	err := client.Execute(context.Background(), "mutation { issueUpdate }", nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.HasCode("INVALID_INPUT") {
+		t.Fatalf("Execute error = %v", err)
+	}
+
+	_, err = client.ExecuteRaw(context.Background(), "mutation { issueUpdate }", nil)
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("ExecuteRaw error = %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package api
+
+import (
+	"regexp"
+	"strings"
+)
+
+// fragment is a named GraphQL fragment. Queries spread it (...Name) instead
+// of repeating its fields, so adding a field to, say, every project listing
+// is a one-line change here. buildQuery appends the definitions a query
+// needs.
+type fragment struct {
+	name   string
+	on     string
+	fields string
+}
+
+// definition returns the fragment as GraphQL source
+func (f *fragment) definition() string {
+	return "\t\tfragment " + f.name + " on " + f.on + " {" + f.fields + "\t\t}\n"
+}
+
+var userRefFragment = &fragment{name: "UserRef", on: "User", fields: `
+			id
+			name
+			email
+`}
+
+var teamRefFragment = &fragment{name: "TeamRef", on: "Team", fields: `
+			id
+			key
+			name
+`}
+
+var workflowStateRefFragment = &fragment{name: "WorkflowStateRef", on: "WorkflowState", fields: `
+			id
+			name
+			type
+			color
+`}
+
+var labelRefFragment = &fragment{name: "LabelRef", on: "IssueLabel", fields: `
+			id
+			name
+			color
+`}
+
+// issueCoreFragment is every issue field shown by issue JSON output
+var issueCoreFragment = &fragment{name: "IssueCore", on: "Issue", fields: `
+			id
+			identifier
+			title
+			description
+			priority
+			priorityLabel
+			estimate
+			createdAt
+			updatedAt
+			startedAt
+			completedAt
+			canceledAt
+			archivedAt
+			triagedAt
+			snoozedUntilAt
+			dueDate
+			branchName
+			customerTicketCount
+			url
+			slaStartedAt
+			slaMediumRiskAt
+			slaHighRiskAt
+			slaBreachesAt
+			slaType
+			state {
+				...WorkflowStateRef
+			}
+			assignee {
+				...UserRef
+			}
+			creator {
+				...UserRef
+			}
+			team {
+				...TeamRef
+			}
+			labels {
+				nodes {
+					...LabelRef
+				}
+			}
+			cycle {
+				id
+				number
+				name
+			}
+			project {
+				id
+				name
+				state
+			}
+			projectMilestone {
+				id
+				name
+				status
+			}
+			parent {
+				id
+				identifier
+				title
+			}
+`}
+
+// projectCoreFragment is the project fields shown by project listings
+var projectCoreFragment = &fragment{name: "ProjectCore", on: "Project", fields: `
+			id
+			name
+			description
+			state
+			progress
+			startDate
+			targetDate
+			url
+			icon
+			color
+			createdAt
+			updatedAt
+			completedAt
+			canceledAt
+			health
+			healthUpdatedAt
+			priority
+			priorityLabel
+			scope
+			lead {
+				...UserRef
+			}
+			teams {
+				nodes {
+					...TeamRef
+				}
+			}
+			initiatives {
+				nodes {
+					id
+					name
+					status
+				}
+			}
+`}
+
+// fragments indexes every fragment by name for buildQuery
+var fragments = indexFragments(
+	userRefFragment,
+	teamRefFragment,
+	workflowStateRefFragment,
+	labelRefFragment,
+	issueCoreFragment,
+	projectCoreFragment,
+)
+
+func indexFragments(list ...*fragment) map[string]*fragment {
+	byName := make(map[string]*fragment, len(list))
+	for _, f := range list {
+		byName[f.name] = f
+	}
+	return byName
+}
+
+var fragmentSpreadPattern = regexp.MustCompile(`\.\.\.\s*([_A-Za-z][_0-9A-Za-z]*)`)
+
+// fragmentSpreads returns the fragment names spread in source, in order of
+// first use. Inline fragments (... on Type) are not spreads.
+func fragmentSpreads(source string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, m := range fragmentSpreadPattern.FindAllStringSubmatch(source, -1) {
+		if name := m[1]; name != "on" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// buildQuery appends to operation the definition of every fragment it
+// spreads, directly or through other fragments, each exactly once. GraphQL
+// rejects documents with unused fragments, so only those spreads are added.
+// An unknown spread is left for the server to reject; TestBuiltQueries
+// catches it first.
+func buildQuery(operation string) string {
+	var b strings.Builder
+	b.WriteString(operation)
+	added := map[string]bool{}
+	pending := fragmentSpreads(operation)
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+		f, ok := fragments[name]
+		if !ok || added[name] {
+			continue
+		}
+		added[name] = true
+		b.WriteString(f.definition())
+		pending = append(pending, fragmentSpreads(f.fields)...)
+	}
+	return b.String()
+}
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	gqlast "github.com/vektah/gqlparser/v2/ast"
+)
+
+// fragmentTypes maps each fragment to the struct its fields decode into
+var fragmentTypes = map[string]reflect.Type{
+	"UserRef":          reflect.TypeOf(User{}),
+	"TeamRef":          reflect.TypeOf(Team{}),
+	"WorkflowStateRef": reflect.TypeOf(WorkflowState{}),
+	"LabelRef":         reflect.TypeOf(Label{}),
+	"IssueCore":        reflect.TypeOf(Issue{}),
+	"ProjectCore":      reflect.TypeOf(Project{}),
+}
+
+// jsonFieldTypes returns a struct's fields by JSON name, including those of
+// embedded structs
+func jsonFieldTypes(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range jsonFieldTypes(ft) {
+					fields[k] = v
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// checkSelections reports every selected field with no matching JSON field
+// in t, following fragment spreads and nested selections. Such a field would
+// be fetched and then silently dropped, or a renamed one would decode as null.
+func checkSelections(doc *gqlast.QueryDocument, t reflect.Type, sels gqlast.SelectionSet, path string) []string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == reflect.TypeOf(time.Time{}) {
+		// Maps, interfaces, and raw JSON accept anything
+		return nil
+	}
+
+	var problems []string
+	fields := jsonFieldTypes(t)
+	for _, sel := range sels {
+		switch sel := sel.(type) {
+		case *gqlast.FragmentSpread:
+			problems = append(problems, checkSelections(doc, t, doc.Fragments.ForName(sel.Name).SelectionSet, path)...)
+		case *gqlast.InlineFragment:
+			problems = append(problems, checkSelections(doc, t, sel.SelectionSet, path)...)
+		case *gqlast.Field:
+			if sel.Name == "__typename" {
+				continue
+			}
+			ft, ok := fields[sel.Alias]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s.%s: %s has no json field %q", path, sel.Alias, t.Name(), sel.Alias))
+				continue
+			}
+			if len(sel.SelectionSet) > 0 {
+				problems = append(problems, checkSelections(doc, ft, sel.SelectionSet, path+"."+sel.Alias)...)
+			}
+		}
+	}
+	return problems
+}
+
+func TestBuildQuery(t *testing.T) {
+	q := buildQuery(`
+		query Q {
+			a { ...IssueCore }
+			b { ...UserRef }
+		}
+	`)
+	for name := range fragments {
+		want := 1
+		if name == "ProjectCore" {
+			want = 0
+		}
+		if got := strings.Count(q, "fragment "+name+" on"); got != want {
+			t.Errorf("fragment %s defined %d times, want %d", name, got, want)
+		}
+	}
+
+	plain := `query Q { viewer { id } }`
+	if got := buildQuery(plain); got != plain {
+		t.Errorf("buildQuery changed a query without spreads: %q", got)
+	}
+	if got := fragmentSpreads(`{ ... on Issue { id } ...TeamRef ...TeamRef }`); len(got) != 1 || got[0] != "TeamRef" {
+		t.Errorf("fragmentSpreads = %v, want [TeamRef]", got)
+	}
+}
+
+func TestFragmentsMatchStructs(t *testing.T) {
+	for name, f := range fragments {
+		typ, ok := fragmentTypes[name]
+		if !ok {
+			t.Errorf("fragment %s has no entry in fragmentTypes", name)
+			continue
+		}
+		doc, err := gqlLoad(buildQuery("query Q { x { ..." + name + " } }"))
+		if doc == nil {
+			t.Errorf("fragment %s: %v", name, err)
+			continue
+		}
+		if err != nil {
+			t.Errorf("fragment %s: %v", name, err)
+		}
+		for _, p := range checkSelections(doc, typ, doc.Fragments.ForName(name).SelectionSet, f.name) {
+			t.Error(p)
+		}
+	}
+}
+
+// TestBuiltQueriesMatchStructs runs each client method that builds its query
+// from fragments against a server that captures the query, then checks the
+// query parses and that its selections under the decoded field match the
+// Go type the method decodes into
+func TestBuiltQueriesMatchStructs(t *testing.T) {
+	var captured string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query string `json:"query"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		captured = req.Query
+		w.Write([]byte(`{"data":{}}`))
+	}))
+	defer server.Close()
+	client := NewClientWithURL(server.URL, "key")
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() error
+		path string
+		typ  reflect.Type
+	}{
+		{"GetIssues", func() error { _, err := client.GetIssues(ctx, nil, 1, "", ""); return err }, "issues", reflect.TypeOf(Issues{})},
+		{"GetIssuesWithFields table+children", func() error {
+			_, err := client.GetIssuesWithFields(ctx, nil, 1, "", "", IssueFieldsTable|IssueFieldChildren)
+			return err
+		}, "issues", reflect.TypeOf(Issues{})},
+		{"GetIssuesWithFields minimal", func() error {
+			_, err := client.GetIssuesWithFields(ctx, nil, 1, "", "", IssueFieldsMinimal)
+			return err
+		}, "issues", reflect.TypeOf(Issues{})},
+		{"GetIssueWithFields table", func() error {
+			_, err := client.GetIssueWithFields(ctx, "ENG-1", IssueFieldsTable)
+			return err
+		}, "issue", reflect.TypeOf(Issue{})},
+		{"IssueSearch", func() error { _, err := client.IssueSearch(ctx, "x", nil, 1, "", "", false); return err }, "searchIssues", reflect.TypeOf(Issues{})},
+		{"GetProjects", func() error { _, err := client.GetProjects(ctx, nil, 1, "", ""); return err }, "projects", reflect.TypeOf(Projects{})},
+		{"GetCustomViewIssues", func() error { _, err := client.GetCustomViewIssues(ctx, "v", 1, ""); return err }, "customView.issues", reflect.TypeOf(Issues{})},
+		{"GetCustomViewProjects", func() error { _, err := client.GetCustomViewProjects(ctx, "v", 1, ""); return err }, "customView.projects", reflect.TypeOf(Projects{})},
+		{"GetInitiativeProjects", func() error { _, err := client.GetInitiativeProjects(ctx, "i", 1, ""); return err }, "initiative.projects", reflect.TypeOf(Projects{})},
+	}
+	for _, tt := range tests {
+		captured = ""
+		if err := tt.call(); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		doc, err := gqlLoad(captured)
+		if err != nil {
+			t.Errorf("%s: %v\n%s", tt.name, err, captured)
+			continue
+		}
+
+		sels := doc.Operations[0].SelectionSet
+		for _, key := range strings.Split(tt.path, ".") {
+			var next gqlast.SelectionSet
+			for _, sel := range sels {
+				if field, ok := sel.(*gqlast.Field); ok && field.Alias == key {
+					next = field.SelectionSet
+				}
+			}
+			if next == nil {
+				t.Fatalf("%s: no %q in the query", tt.name, tt.path)
+			}
+			sels = next
+		}
+		for _, p := range checkSelections(doc, tt.typ, sels, tt.path) {
+			t.Errorf("%s: %s", tt.name, p)
+		}
+	}
+}
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2"
+	gqlast "github.com/vektah/gqlparser/v2/ast"
+	gqlparse "github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+	"github.com/vektah/gqlparser/v2/validator/rules"
+)
+
+// linearSchemaPath is Linear's schema SDL, which isn't checked in. Drop a
+// copy there (e.g. from Linear's introspection endpoint) and every query is
+// validated against it with the full rule set: field names, argument and
+// variable types, and fragment type conditions.
+const linearSchemaPath = "testdata/linear-schema.graphql"
+
+// stubSchema stands in for Linear's when it is absent. It only gives the
+// validator root types to walk from, so queries are held to the rules that
+// don't depend on the schema; field names are checked against the Go structs
+// instead.
+var stubSchema = gqlparser.MustLoadSchema(&gqlast.Source{
+	Name:  "stub.graphql",
+	Input: "type Query { stub: Int }\ntype Mutation { stub: Int }",
+})
+
+var structuralRules = rules.NewRules(
+	rules.KnownFragmentNamesRule,
+	rules.LoneAnonymousOperationRule,
+	rules.NoFragmentCyclesRule,
+	rules.NoUndefinedVariablesRule,
+	rules.NoUnusedFragmentsRule,
+	rules.NoUnusedVariablesRule,
+	rules.UniqueArgumentNamesRule,
+	rules.UniqueFragmentNamesRule,
+	rules.UniqueOperationNamesRule,
+	rules.UniqueVariableNamesRule,
+)
+
+var linearSchema = sync.OnceValues(func() (*gqlast.Schema, error) {
+	sdl, err := os.ReadFile(linearSchemaPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return gqlparser.LoadSchema(&gqlast.Source{Name: linearSchemaPath, Input: string(sdl)})
+})
+
+// gqlLoad parses an executable GraphQL document and validates it against
+// Linear's schema when present, or the schema-independent rules otherwise
+func gqlLoad(src string) (*gqlast.QueryDocument, error) {
+	doc, err := gqlparse.ParseQuery(&gqlast.Source{Input: src})
+	if err != nil {
+		return nil, err
+	}
+	schema, err := linearSchema()
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	if schema != nil {
+		for _, e := range validator.ValidateWithRules(schema, doc, rules.NewDefaultRules()) {
+			errs = append(errs, e)
+		}
+	} else {
+		for _, e := range validator.ValidateWithRules(stubSchema, doc, structuralRules) {
+			errs = append(errs, e)
+		}
+	}
+	return doc, errors.Join(errs...)
+}
+
+func TestGQLLoad(t *testing.T) {
+	valid := buildQuery(`
+		query Issues($filter: IssueFilter, $first: Int) {
+			issues(filter: $filter, first: $first, orderBy: updatedAt) {
+				nodes { ...IssueCore children(first: 250) { nodes { id } } }
+				alias: pageInfo { hasNextPage }
+			}
+		}
+	`)
+	doc, err := gqlLoad(valid)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	if len(doc.Fragments) != 5 {
+		t.Errorf("got %d fragments, want IssueCore and the 4 it spreads", len(doc.Fragments))
+	}
+
+	invalid := map[string]string{
+		"unbalanced":         `query { issues { nodes { id } }`,
+		"empty selection":    `query { issues { } }`,
+		"unknown fragment":   `query { viewer { ...Nope } }`,
+		"unused fragment":    `query { viewer { id } } fragment F on User { id }`,
+		"undeclared var":     `query Q { issue(id: $id) { id } }`,
+		"unused var":         `query Q($id: String!) { viewer { id } }`,
+		"self spread":        `query { viewer { ...F } } fragment F on User { ...F }`,
+		"bad argument":       `query { issue(id) { id } }`,
+		"unterminated str":   `query { issue(id: "abc) { id } }`,
+		"duplicate fragment": `query { viewer { ...F } } fragment F on User { id } fragment F on User { id }`,
+		"duplicate argument": `query { issue(id: "a", id: "b") { id } }`,
+	}
+	for name, src := range invalid {
+		if _, err := gqlLoad(src); err == nil {
+			t.Errorf("%s: accepted %q", name, src)
+		}
+	}
+}
+
+// TestQueriesParse parses every complete GraphQL document literal in the
+// package, expanding fragments for those passed to buildQuery. Literals
+// concatenated with other strings are covered through their builders in
+// TestBuiltQueriesMatchStructs.
+func TestQueriesParse(t *testing.T) {
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	checked := 0
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		concatenated := map[*ast.BasicLit]bool{}
+		built := map[*ast.BasicLit]bool{}
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.BinaryExpr:
+				for _, side := range []ast.Expr{n.X, n.Y} {
+					if lit, ok := side.(*ast.BasicLit); ok {
+						concatenated[lit] = true
+					}
+				}
+			case *ast.CallExpr:
+				if fn, ok := n.Fun.(*ast.Ident); ok && fn.Name == "buildQuery" && len(n.Args) == 1 {
+					if lit, ok := n.Args[0].(*ast.BasicLit); ok {
+						built[lit] = true
+					}
+				}
+			case *ast.BasicLit:
+				if n.Kind != token.STRING || concatenated[n] {
+					return true
+				}
+				src, err := strconv.Unquote(n.Value)
+				if err != nil {
+					return true
+				}
+				head := strings.TrimSpace(src)
+				if !strings.HasPrefix(head, "query ") && !strings.HasPrefix(head, "query{") && !strings.HasPrefix(head, "query {") && !strings.HasPrefix(head, "mutation ") {
+					return true
+				}
+				if built[n] {
+					src = buildQuery(src)
+				}
+				checked++
+				if _, err := gqlLoad(src); err != nil {
+					t.Errorf("%s: %v", fset.Position(n.Pos()), err)
+				}
+			}
+			return true
+		})
+	}
+	if checked < 100 {
+		t.Errorf("only %d queries found; is the literal scan broken?", checked)
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// createRetries is how many times a create mutation is sent again after it
+// failed in transit
+const createRetries = 2
+
+// createRetryDelay is the pause before the first resend; it doubles after
+// each one
+var createRetryDelay = 250 * time.Millisecond
+
+// TransportError is a request that failed before a response arrived, such
+// as a timeout or a dropped connection. Linear may or may not have acted on
+// it.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string { return e.Err.Error() }
+
+func (e *TransportError) Unwrap() error { return e.Err }
+
+// errCreatedEarlier means a resent create was refused because its ID is
+// taken: the attempt that seemed to fail went through after all
+var errCreatedEarlier = errors.New("created by an earlier attempt")
+
+// NewUUID returns a random (version 4) UUID
+func NewUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("crypto/rand failed: %v", err))
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// withClientID returns a copy of a create input carrying an "id", and the
+// id. Linear creates the entity with that ID, which makes the create safe to
+// resend. An id the caller set is kept.
+func withClientID(input map[string]interface{}) (map[string]interface{}, string) {
+	withID := make(map[string]interface{}, len(input)+1)
+	for k, v := range input {
+		withID[k] = v
+	}
+	id, _ := withID["id"].(string)
+	if id == "" {
+		id = NewUUID()
+		withID["id"] = id
+	}
+	return withID, id
+}
+
+// executeCreate runs a create mutation whose input has a client-chosen ID
+// (see withClientID). When the request fails in transit it is sent again,
+// unchanged, so Linear either creates the entity then or refuses the ID as
+// already used because an earlier attempt went through; that refusal is
+// returned as errCreatedEarlier for the caller to fetch the entity by ID.
+// Either way only one entity is created.
+func (c *Client) executeCreate(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	err := c.Execute(ctx, query, variables, result)
+	delay := createRetryDelay
+	for attempt := 0; attempt < createRetries && isTransportError(err) && ctx.Err() == nil; attempt++ {
+		time.Sleep(delay)
+		delay *= 2
+		err = c.Execute(ctx, query, variables, result)
+		if isIDTaken(err) {
+			return errCreatedEarlier
+		}
+	}
+	return err
+}
+
+// isTransportError reports whether err is a request that failed in transit
+func isTransportError(err error) bool {
+	var transportErr *TransportError
+	return errors.As(err, &transportErr)
+}
+
+// isIDTaken reports whether Linear refused a create because an entity with
+// its ID already exists
+func isIDTaken(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, gqlErr := range apiErr.Errors {
+		msg := strings.ToLower(gqlErr.Message + " " + gqlErr.Extensions.UserPresentableMessage)
+		if strings.Contains(msg, "already exists") || strings.Contains(msg, "duplicate key") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithClientID(t *testing.T) {
+	input := map[string]interface{}{"title": "x"}
+	withID, id := withClientID(input)
+	if !uuidPattern.MatchString(id) || withID["id"] != id || withID["title"] != "x" {
+		t.Errorf("withClientID = %v, %q", withID, id)
+	}
+	if _, ok := input["id"]; ok {
+		t.Error("withClientID changed its input")
+	}
+	if _, again := withClientID(input); again == id {
+		t.Error("two creates got the same ID")
+	}
+	if _, kept := withClientID(map[string]interface{}{"id": "mine"}); kept != "mine" {
+		t.Errorf("caller's id replaced with %q", kept)
+	}
+}
+
+func TestExecuteCreate(t *testing.T) {
+	defer func(d time.Duration) { createRetryDelay = d }(createRetryDelay)
+	createRetryDelay = time.Millisecond
+
+	var bodies []string
+	var answers []func(w http.ResponseWriter)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		answers[len(bodies)-1](w)
+	}))
+	defer server.Close()
+	drop := func(w http.ResponseWriter) {
+		conn, _, _ := http.NewResponseController(w).Hijack()
+		_ = conn.Close()
+	}
+	answer := func(body string) func(w http.ResponseWriter) {
+		return func(w http.ResponseWriter) { _, _ = io.WriteString(w, body) }
+	}
+	client := NewClientWithURL(server.URL, "key")
+	query := `mutation CreateIssue($input: IssueCreateInput!) { issueCreate(input: $input) { issue { id } } }`
+	input, _ := withClientID(map[string]interface{}{"title": "x"})
+	variables := map[string]interface{}{"input": input}
+
+	// The first attempt went through but its answer was lost
+	answers = []func(w http.ResponseWriter){drop, answer(`{"errors":[{"message":"Entity already exists"}]}`)}
+	if err := client.executeCreate(context.Background(), query, variables, nil); !errors.Is(err, errCreatedEarlier) {
+		t.Errorf("resent create = %v, want errCreatedEarlier", err)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Errorf("resent create differs:\n%s", strings.Join(bodies, "\n"))
+	}
+
+	// The first attempt never arrived
+	bodies = nil
+	answers = []func(w http.ResponseWriter){drop, answer(`{"data":{"issueCreate":{"issue":{"id":"i1"}}}}`)}
+	if err := client.executeCreate(context.Background(), query, variables, nil); err != nil || len(bodies) != 2 {
+		t.Errorf("create after a drop = %v in %d requests", err, len(bodies))
+	}
+
+	// An answered error isn't resent, and resending gives up eventually
+	bodies = nil
+	answers = []func(w http.ResponseWriter){answer(`{"errors":[{"message":"Entity already exists"}]}`)}
+	if err := client.executeCreate(context.Background(), query, variables, nil); errors.Is(err, errCreatedEarlier) || len(bodies) != 1 {
+		t.Errorf("answered error = %v in %d requests", err, len(bodies))
+	}
+	bodies = nil
+	answers = []func(w http.ResponseWriter){drop, drop, drop}
+	if err := client.executeCreate(context.Background(), query, variables, nil); !isTransportError(err) || len(bodies) != 3 {
+		t.Errorf("repeated drops = %v in %d requests", err, len(bodies))
+	}
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// EntityName is just enough of an entity to name it: what LookupNames returns.
+// Team is the owning team of a team label, state, or cycle.
+type EntityName struct {
+	ID     string      `json:"id"`
+	Name   string      `json:"name"`
+	Email  string      `json:"email,omitempty"`
+	Key    string      `json:"key,omitempty"`
+	Number int         `json:"number,omitempty"`
+	Team   *EntityName `json:"team,omitempty"`
+}
+
+// nameQueries look up entities of each kind by ID, for LookupNames. Each
+// selects its connection as "nodes".
+var nameQueries = map[string]string{
+	"user": `
+		query UserNames($filter: UserFilter, $first: Int) {
+			nodes: users(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name email }
+			}
+		}
+	`,
+	"label": `
+		query LabelNames($filter: IssueLabelFilter, $first: Int) {
+			nodes: issueLabels(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name team { id name key } }
+			}
+		}
+	`,
+	"team": `
+		query TeamNames($filter: TeamFilter, $first: Int) {
+			nodes: teams(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name key }
+			}
+		}
+	`,
+	"state": `
+		query StateNames($filter: WorkflowStateFilter, $first: Int) {
+			nodes: workflowStates(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name team { id name key } }
+			}
+		}
+	`,
+	"project": `
+		query ProjectNames($filter: ProjectFilter, $first: Int) {
+			nodes: projects(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name }
+			}
+		}
+	`,
+	"cycle": `
+		query CycleNames($filter: CycleFilter, $first: Int) {
+			nodes: cycles(filter: $filter, first: $first, includeArchived: true) {
+				nodes { id name number team { id name key } }
+			}
+		}
+	`,
+}
+
+// LookupNames fetches the names of the entities of one kind (user, label,
+// team, state, project, or cycle) with the given IDs in a single request.
+// IDs that match nothing, such as deleted entities, are missing from the
+// result.
+func (c *Client) LookupNames(ctx context.Context, kind string, ids []string) (map[string]EntityName, error) {
+	query, ok := nameQueries[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown entity kind %q", kind)
+	}
+	names := make(map[string]EntityName, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	variables := map[string]interface{}{
+		"filter": map[string]interface{}{"id": map[string]interface{}{"in": ids}},
+		"first":  len(ids),
+	}
+
+	var response struct {
+		Nodes struct {
+			Nodes []EntityName `json:"nodes"`
+		} `json:"nodes"`
+	}
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+	for _, node := range response.Nodes.Nodes {
+		names[node.ID] = node
+	}
+	return names, nil
+}
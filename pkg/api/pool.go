@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultParallel is how many tasks a pool runs at once unless --parallel
+// says otherwise
+const DefaultParallel = 4
+
+// Backoff and ramp-up tuning. Variables so tests can run on a faster clock.
+var (
+	// poolBackoffMin is the first pause after a rate-limit response; each
+	// further one while throttled doubles it, up to poolBackoffMax
+	poolBackoffMin = time.Second
+	poolBackoffMax = 30 * time.Second
+	// poolRampUp is how many successes in a row let one more task run
+	poolRampUp = 5
+	// poolMaxAttempts is how often a rate-limited task is tried in all
+	poolMaxAttempts = 5
+)
+
+// Pool runs API tasks concurrently under one limit. When a task is rate
+// limited the whole pool slows down: it halves how many tasks may run at
+// once, pauses before starting any more, and retries the throttled task.
+// Successes ramp the limit back up one task at a time.
+type Pool struct {
+	mu        sync.Mutex
+	max       int
+	limit     int       // tasks allowed to run at once right now
+	active    int       // tasks running
+	resumeAt  time.Time // no task starts before this while backing off
+	backoff   time.Duration
+	successes int           // successes in a row since the limit last changed
+	changed   chan struct{} // closed (and replaced) when a waiting task may start
+}
+
+// NewPool returns a pool running at most max tasks at once
+func NewPool(max int) *Pool {
+	if max < 1 {
+		max = DefaultParallel
+	}
+	return &Pool{max: max, limit: max, changed: make(chan struct{})}
+}
+
+// defaultPool is shared by every bulk command in the process, so tasks from
+// concurrent fan-outs back off together
+var defaultPool = NewPool(DefaultParallel)
+
+// DefaultPool returns the process-wide pool
+func DefaultPool() *Pool {
+	return defaultPool
+}
+
+// SetParallel sets the process-wide pool's limit (--parallel) and clears
+// any backoff. Less than 1 means DefaultParallel.
+func SetParallel(n int) {
+	if n < 1 {
+		n = DefaultParallel
+	}
+	p := defaultPool
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.max, p.limit = n, n
+	p.resumeAt, p.backoff, p.successes = time.Time{}, 0, 0
+	p.notify()
+}
+
+// Limit returns how many tasks the pool lets run at once right now, which
+// is below its maximum while it is backing off
+func (p *Pool) Limit() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.limit
+}
+
+// Run calls task for each index in [0, n) concurrently and returns their
+// errors by index. Tasks start in index order. A rate-limited task is
+// retried after the backoff, up to poolMaxAttempts tries in all. Once ctx is
+// canceled no more tasks start; those that didn't get ctx's error.
+func (p *Pool) Run(ctx context.Context, n int, task func(ctx context.Context, i int) error) []error {
+	return p.RunEach(ctx, n, task, nil)
+}
+
+// RunEach is Run that also calls done, if set, as each task finishes with
+// its final error, retries included. done may be called from several
+// goroutines at once; tasks that never started are not reported.
+func (p *Pool) RunEach(ctx context.Context, n int, task func(ctx context.Context, i int) error, done func(i int, err error)) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		if err := p.acquire(ctx); err != nil {
+			for j := i; j < n; j++ {
+				errs[j] = err
+			}
+			break
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = p.run(ctx, i, task)
+			if done != nil {
+				done(i, errs[i])
+			}
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// run calls task holding a slot acquired by the caller, retrying it while it
+// is rate limited
+func (p *Pool) run(ctx context.Context, i int, task func(ctx context.Context, i int) error) error {
+	for attempt := 1; ; attempt++ {
+		err := task(ctx, i)
+		throttled := IsRateLimited(err)
+		p.release(err == nil, throttled)
+		if !throttled || attempt == poolMaxAttempts {
+			return err
+		}
+		if err := p.acquire(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// acquire waits for a free slot outside any backoff pause and takes it
+func (p *Pool) acquire(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p.mu.Lock()
+		wait := time.Until(p.resumeAt)
+		if wait <= 0 && p.active < p.limit {
+			p.active++
+			p.mu.Unlock()
+			return nil
+		}
+		changed := p.changed
+		p.mu.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = time.NewTimer(wait)
+			expired = timer.C
+		}
+		select {
+		case <-ctx.Done():
+		case <-changed:
+		case <-expired:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// release gives back a slot and adapts the limit to how the task went. All
+// the tasks throttled by one burst land in the same pause, so only the first
+// of them halves the limit and lengthens the backoff.
+func (p *Pool) release(ok, throttled bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.active--
+	switch {
+	case throttled:
+		p.successes = 0
+		now := time.Now()
+		if now.Before(p.resumeAt) {
+			break
+		}
+		p.limit = max(1, p.limit/2)
+		if p.backoff == 0 {
+			p.backoff = poolBackoffMin
+		} else {
+			p.backoff = min(2*p.backoff, poolBackoffMax)
+		}
+		p.resumeAt = now.Add(p.backoff)
+	case ok:
+		p.backoff = 0
+		p.successes++
+		if p.limit < p.max && p.successes >= poolRampUp {
+			p.limit++
+			p.successes = 0
+		}
+	}
+	p.notify()
+}
+
+// notify wakes the tasks waiting in acquire. Callers hold p.mu.
+func (p *Pool) notify() {
+	close(p.changed)
+	p.changed = make(chan struct{})
+}
+
+// IsRateLimited reports whether err is Linear refusing a request for
+// exceeding its rate limit
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.HasCode("RATELIMITED")
+}
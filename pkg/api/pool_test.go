@@ -0,0 +1,236 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fastPoolClock shortens the pool's backoff for the test
+func fastPoolClock(t *testing.T) {
+	t.Helper()
+	backoffMin, backoffMax, rampUp := poolBackoffMin, poolBackoffMax, poolRampUp
+	poolBackoffMin, poolBackoffMax, poolRampUp = 20*time.Millisecond, 80*time.Millisecond, 2
+	t.Cleanup(func() { poolBackoffMin, poolBackoffMax, poolRampUp = backoffMin, backoffMax, rampUp })
+}
+
+// throttlingServer echoes the request's "n" variable back as {"n": n},
+// answering 429 to the requests numbered in throttle (counting from 1). It
+// records the most requests it was ever handling at once, and how many it
+// was handling as each request arrived once the first 429 had been out for
+// half the minimum backoff, by which time every earlier request is done.
+type throttlingServer struct {
+	*httptest.Server
+	mu          sync.Mutex
+	requests    int
+	inFlight    int
+	maxInFlight int
+	throttle    map[int]bool
+	afterPause  []int
+	throttledAt time.Time
+}
+
+func newThrottlingServer(t *testing.T, throttle ...int) *throttlingServer {
+	t.Helper()
+	s := &throttlingServer{throttle: map[int]bool{}}
+	for _, n := range throttle {
+		s.throttle[n] = true
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		s.mu.Lock()
+		s.requests++
+		s.inFlight++
+		s.maxInFlight = max(s.maxInFlight, s.inFlight)
+		if !s.throttledAt.IsZero() && time.Since(s.throttledAt) > poolBackoffMin/2 {
+			s.afterPause = append(s.afterPause, s.inFlight)
+		}
+		throttle := s.throttle[s.requests]
+		s.mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+		s.mu.Lock()
+		s.inFlight--
+		if throttle && s.throttledAt.IsZero() {
+			s.throttledAt = time.Now()
+		}
+		s.mu.Unlock()
+
+		if throttle {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`))
+			return
+		}
+		fmt.Fprintf(w, `{"data":{"n":%v}}`, req.Variables["n"])
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestPoolOrderedResults(t *testing.T) {
+	fastPoolClock(t)
+	server := newThrottlingServer(t)
+	client := NewClientWithURL(server.URL, "key")
+
+	results := make([]int, 20)
+	errs := NewPool(4).Run(context.Background(), len(results), func(ctx context.Context, i int) error {
+		var out struct{ N int }
+		err := client.Execute(ctx, `query Echo($n: Int) { n }`, map[string]interface{}{"n": i * 10}, &out)
+		results[i] = out.N
+		return err
+	})
+	for i := range results {
+		if errs[i] != nil || results[i] != i*10 {
+			t.Errorf("task %d = %d, %v; want %d", i, results[i], errs[i], i*10)
+		}
+	}
+	if server.maxInFlight > 4 {
+		t.Errorf("%d requests ran at once, want at most 4", server.maxInFlight)
+	}
+}
+
+func TestPoolBacksOffWhenThrottled(t *testing.T) {
+	fastPoolClock(t)
+	// The 5th through 8th requests all hit the rate limit together
+	server := newThrottlingServer(t, 5, 6, 7, 8)
+	client := NewClientWithURL(server.URL, "key")
+	pool := NewPool(8)
+
+	var limits []int
+	var mu sync.Mutex
+	results := make([]int, 40)
+	errs := pool.Run(context.Background(), len(results), func(ctx context.Context, i int) error {
+		var out struct{ N int }
+		err := client.Execute(ctx, `query Echo($n: Int) { n }`, map[string]interface{}{"n": i}, &out)
+		results[i] = out.N
+		mu.Lock()
+		limits = append(limits, pool.Limit())
+		mu.Unlock()
+		return err
+	})
+
+	for i := range results {
+		if errs[i] != nil || results[i] != i {
+			t.Errorf("task %d = %d, %v; want %d after retrying", i, results[i], errs[i], i)
+		}
+	}
+	if server.requests != 44 {
+		t.Errorf("server saw %d requests, want 40 plus 4 retries", server.requests)
+	}
+	// One burst of 429s halves the limit once, not once per throttled task
+	lowest := pool.max
+	for _, limit := range limits {
+		lowest = min(lowest, limit)
+	}
+	if lowest != 4 {
+		t.Errorf("lowest limit = %d, want 4 (half of 8)", lowest)
+	}
+	if pool.Limit() <= lowest {
+		t.Errorf("limit stayed at %d after the throttling passed; want it ramping back up", pool.Limit())
+	}
+	// Right after the pause only the halved limit runs at once
+	if len(server.afterPause) < 4 {
+		t.Fatalf("only %d requests after the pause", len(server.afterPause))
+	}
+	for _, inFlight := range server.afterPause[:4] {
+		if inFlight > 4 {
+			t.Errorf("%d requests in flight right after the pause, want at most 4: %v", inFlight, server.afterPause)
+			break
+		}
+	}
+}
+
+func TestPoolRunEachReportsOncePerTask(t *testing.T) {
+	fastPoolClock(t)
+	server := newThrottlingServer(t, 2, 3)
+	client := NewClientWithURL(server.URL, "key")
+
+	var mu sync.Mutex
+	reported := map[int]int{}
+	errs := NewPool(4).RunEach(context.Background(), 6, func(ctx context.Context, i int) error {
+		return client.Execute(ctx, `query Echo($n: Int) { n }`, map[string]interface{}{"n": i}, nil)
+	}, func(i int, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		reported[i]++
+		if err != nil {
+			t.Errorf("task %d reported %v, want its retry's success", i, err)
+		}
+	})
+
+	// Throttled tasks are retried but still reported once
+	for i := range errs {
+		if reported[i] != 1 {
+			t.Errorf("task %d reported %d times, want once", i, reported[i])
+		}
+	}
+}
+
+func TestPoolGivesUpOnPersistentThrottling(t *testing.T) {
+	fastPoolClock(t)
+	server := newThrottlingServer(t, 1, 2, 3, 4, 5, 6)
+	client := NewClientWithURL(server.URL, "key")
+
+	errs := NewPool(2).Run(context.Background(), 1, func(ctx context.Context, i int) error {
+		return client.Execute(ctx, `query Echo($n: Int) { n }`, nil, nil)
+	})
+	if !IsRateLimited(errs[0]) {
+		t.Errorf("error = %v, want the rate-limit error", errs[0])
+	}
+	if server.requests != poolMaxAttempts {
+		t.Errorf("server saw %d requests, want %d attempts", server.requests, poolMaxAttempts)
+	}
+}
+
+func TestPoolCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var started atomic.Int64
+	errs := NewPool(2).Run(ctx, 10, func(ctx context.Context, i int) error {
+		started.Add(1)
+		if i == 1 {
+			cancel()
+		}
+		<-ctx.Done()
+		return nil
+	})
+	if n := started.Load(); n > 3 {
+		t.Errorf("%d tasks started after cancellation, want scheduling to stop", n)
+	}
+	for i := 3; i < 10; i++ {
+		if !errors.Is(errs[i], context.Canceled) {
+			t.Errorf("task %d error = %v, want context.Canceled", i, errs[i])
+		}
+	}
+}
+
+func TestIsRateLimited(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   bool
+	}{
+		{429, `{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`, true},
+		{429, `Too Many Requests`, true},
+		{400, `{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATELIMITED"}}]}`, true},
+		{400, `{"errors":[{"message":"Argument Validation Error"}]}`, false},
+		{500, `oops`, false},
+	}
+	for _, tt := range tests {
+		_, err := responseError(tt.status, []byte(tt.body))
+		if got := IsRateLimited(fmt.Errorf("issue ENG-1: %w", err)); got != tt.want {
+			t.Errorf("IsRateLimited(%d %s) = %v, want %v", tt.status, tt.body, got, tt.want)
+		}
+	}
+	if IsRateLimited(nil) {
+		t.Error("IsRateLimited(nil) = true")
+	}
+}
@@ -2,7 +2,10 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -116,6 +119,7 @@ type Issue struct {
 	Team                *Team             `json:"team"`
 	Labels              *Labels           `json:"labels"`
 	Children            *Issues           `json:"children"`
+	ChildCount          *int              `json:"childCount,omitempty"` // Set by callers that count children
 	Parent              *Issue            `json:"parent"`
 	URL                 string            `json:"url"`
 	BranchName          string            `json:"branchName"`
@@ -150,6 +154,7 @@ type Issue struct {
 	Creator               *User            `json:"creator"`
 	Subscribers           *Users           `json:"subscribers"`
 	Relations             *IssueRelations  `json:"relations"`
+	InverseRelations      *IssueRelations  `json:"inverseRelations,omitempty"`
 	History               *IssueHistory    `json:"history"`
 	Reactions             []Reaction       `json:"reactions"`
 	SlackIssueComments    []SlackComment   `json:"slackIssueComments"`
@@ -197,6 +202,7 @@ type Project struct {
 	LastAppliedTemplate  *Template       `json:"lastAppliedTemplate"`
 	ProjectUpdates       *ProjectUpdates `json:"projectUpdates"`
 	Documents            *Documents      `json:"documents"`
+	Initiatives          *Initiatives    `json:"initiatives"`
 	Health               string          `json:"health"`
 	Scope                float64         `json:"scope"`
 	SlackNewIssue        bool            `json:"slackNewIssue"`
@@ -257,6 +263,7 @@ type Label struct {
 	LastAppliedAt *time.Time `json:"lastAppliedAt"`
 	RetiredAt     *time.Time `json:"retiredAt"`
 	RetiredBy     *User      `json:"retiredBy"`
+	IssueCount    *int       `json:"issueCount,omitempty"` // set by label list --counts
 }
 
 // ProjectLabel represents a label for projects (distinct from issue labels)
@@ -325,12 +332,14 @@ type Attachment struct {
 	ArchivedAt          *time.Time             `json:"archivedAt"`
 	Creator             *User                  `json:"creator"`
 	ExternalUserCreator *ExternalUser          `json:"externalUserCreator"`
+	PullRequestState    string                 `json:"pullRequestState,omitempty"` // Set by callers from Metadata
 }
 
 
 // Attachments represents a paginated list of attachments
 type Attachments struct {
-	Nodes []Attachment `json:"nodes"`
+	Nodes    []Attachment `json:"nodes"`
+	PageInfo PageInfo     `json:"pageInfo"`
 }
 
 // UploadFileHeader represents a header for file upload
@@ -395,10 +404,11 @@ type IssueRelations struct {
 }
 
 type IssueRelation struct {
-	ID           string `json:"id"`
-	Type         string `json:"type"`
-	Issue        *Issue `json:"issue"`
-	RelatedIssue *Issue `json:"relatedIssue"`
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	CreatedAt    *time.Time `json:"createdAt,omitempty"`
+	Issue        *Issue     `json:"issue"`
+	RelatedIssue *Issue     `json:"relatedIssue"`
 }
 
 type IssueHistory struct {
@@ -488,9 +498,15 @@ type CustomerTicket struct {
 }
 
 type Template struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	ID           string          `json:"id"`
+	Name         string          `json:"name"`
+	Description  string          `json:"description"`
+	Type         string          `json:"type,omitempty"`
+	TemplateData json.RawMessage `json:"templateData,omitempty"`
+	Team         *Team           `json:"team,omitempty"`
+	Creator      *User           `json:"creator,omitempty"`
+	CreatedAt    *time.Time      `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time      `json:"updatedAt,omitempty"`
 }
 
 // Milestone is the legacy workspace-level milestone (deprecated by Linear)
@@ -538,7 +554,8 @@ type Roadmap struct {
 }
 
 type ProjectUpdates struct {
-	Nodes []ProjectUpdate `json:"nodes"`
+	Nodes    []ProjectUpdate `json:"nodes"`
+	PageInfo PageInfo        `json:"pageInfo"`
 }
 
 type ProjectUpdate struct {
@@ -584,6 +601,7 @@ type Document struct {
 	Release    *Release    `json:"release"`
 	CreatedAt  time.Time   `json:"createdAt"`
 	UpdatedAt  time.Time   `json:"updatedAt"`
+	Snippet    string      `json:"snippet,omitempty"` // set by document search --snippets
 }
 
 type ProjectLinks struct {
@@ -599,10 +617,32 @@ type ProjectLink struct {
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
+// Organization represents the Linear workspace
+type Organization struct {
+	ID                  string                    `json:"id"`
+	Name                string                    `json:"name"`
+	URLKey              string                    `json:"urlKey"`
+	LogoURL             *string                   `json:"logoUrl"`
+	UserCount           int                       `json:"userCount"`
+	CreatedAt           *time.Time                `json:"createdAt"`
+	AllowedAuthServices []string                  `json:"allowedAuthServices"`
+	SamlEnabled         bool                      `json:"samlEnabled"`
+	ScimEnabled         bool                      `json:"scimEnabled"`
+	Subscription        *OrganizationSubscription `json:"subscription,omitempty"`
+}
+
+// OrganizationSubscription is the workspace's paid plan, when visible to the token
+type OrganizationSubscription struct {
+	Type          string     `json:"type"`
+	Seats         float64    `json:"seats"`
+	NextBillingAt *time.Time `json:"nextBillingAt"`
+}
+
 // CustomViewOrganization represents minimal organization info for a custom view
 type CustomViewOrganization struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	URLKey string `json:"urlKey,omitempty"`
 }
 
 // CustomView represents a Linear custom view (saved filter)
@@ -676,92 +716,187 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 	return &response.Viewer, nil
 }
 
-// GetIssues returns a list of issues with optional filtering
-func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+// GetOrganization returns the workspace the current token belongs to
+func (c *Client) GetOrganization(ctx context.Context) (*Organization, error) {
 	query := `
-		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
-				nodes {
+		query Organization {
+			organization {
+				id
+				name
+				urlKey
+				logoUrl
+				userCount
+				createdAt
+				allowedAuthServices
+				samlEnabled
+				scimEnabled
+			}
+		}
+	`
+
+	var response struct {
+		Organization Organization `json:"organization"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Organization, nil
+}
+
+// GetOrganizationSubscription returns the workspace's subscription plan.
+// Kept separate from GetOrganization because the field is not available to
+// every token (e.g. non-admins or free workspaces); returns nil when unset.
+func (c *Client) GetOrganizationSubscription(ctx context.Context) (*OrganizationSubscription, error) {
+	query := `
+		query OrganizationSubscription {
+			organization {
+				subscription {
+					type
+					seats
+					nextBillingAt
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Organization struct {
+			Subscription *OrganizationSubscription `json:"subscription"`
+		} `json:"organization"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Organization.Subscription, nil
+}
+
+// IssueFields controls how much of each issue GetIssuesWithFields requests
+type IssueFields int
+
+const (
+	// IssueFieldsFull requests every field shown by issue JSON output
+	IssueFieldsFull IssueFields = iota
+	// IssueFieldsTable requests only the fields rendered by issue tables
+	IssueFieldsTable
+	// IssueFieldsMinimal requests just enough to identify each issue
+	IssueFieldsMinimal
+	// IssueFieldsIDs requests only the identifiers, plus the timestamps
+	// lists are ordered and merged by
+	IssueFieldsIDs
+
+	// IssueFieldChildren can be OR'd into any level to also request each
+	// issue's child IDs, e.g. IssueFieldsTable|IssueFieldChildren
+	IssueFieldChildren IssueFields = 1 << 8
+	// IssueFieldNoAttachments can be OR'd into IssueFieldsFull for a single
+	// issue to leave out its attachments
+	IssueFieldNoAttachments IssueFields = 1 << 9
+)
+
+const issueSelectionChildren = `
+					children(first: 250) {
+						nodes {
+							id
+						}
+					}
+`
+
+const issueSelectionMinimal = `
+					id
+					identifier
+					title
+					url
+					state {
+						name
+						type
+					}
+`
+
+const issueSelectionIDs = `
+					id
+					identifier
+					createdAt
+					updatedAt
+`
+
+const issueSelectionTable = `
 					id
 					identifier
 					title
-					description
 					priority
-					priorityLabel
 					estimate
 					createdAt
 					updatedAt
-					startedAt
-					completedAt
-					canceledAt
-					archivedAt
-					triagedAt
-					snoozedUntilAt
 					dueDate
-					branchName
-					customerTicketCount
+					snoozedUntilAt
 					url
-					slaStartedAt
-					slaMediumRiskAt
-					slaHighRiskAt
-					slaBreachesAt
-					slaType
 					state {
 						id
 						name
 						type
-						color
 					}
 					assignee {
 						id
 						name
-						email
-					}
-					creator {
-						id
-						name
-						email
 					}
 					team {
 						id
 						key
-						name
-					}
-					labels {
-						nodes {
-							id
-							name
-							color
-						}
-					}
-					cycle {
-						id
-						number
-						name
 					}
-					project {
-						id
-						name
-						state
-					}
-					projectMilestone {
-						id
-						name
-						status
-					}
-					parent {
-						id
-						identifier
-						title
-					}
-				}
+`
+
+const issueSelectionFull = `
+					...IssueCore
+`
+
+// issueSelection returns the GraphQL selection set for the given field level
+func issueSelection(fields IssueFields) string {
+	var selection string
+	switch fields &^ IssueFieldChildren {
+	case IssueFieldsMinimal:
+		selection = issueSelectionMinimal
+	case IssueFieldsIDs:
+		selection = issueSelectionIDs
+	case IssueFieldsTable:
+		selection = issueSelectionTable
+	default:
+		selection = issueSelectionFull
+	}
+	if fields&IssueFieldChildren != 0 {
+		selection += issueSelectionChildren
+	}
+	return selection
+}
+
+// issuesQuery builds the issues list query for the given field level
+func issuesQuery(fields IssueFields) string {
+	return buildQuery(`
+		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+				nodes {` + issueSelection(fields) + `				}
 				pageInfo {
 					hasNextPage
 					endCursor
 				}
 			}
 		}
-	`
+	`)
+}
+
+// GetIssues returns a list of issues with optional filtering
+func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+	return c.GetIssuesWithFields(ctx, filter, first, after, orderBy, IssueFieldsFull)
+}
+
+// GetIssuesWithFields is GetIssues with control over the requested fields.
+// Lighter field sets make large listings considerably faster.
+func (c *Client) GetIssuesWithFields(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, fields IssueFields) (*Issues, error) {
+	query := issuesQuery(fields)
 
 	variables := map[string]interface{}{
 		"first": first,
@@ -790,82 +925,11 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 
 // IssueSearch returns issues that match a full-text query
 func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*Issues, error) {
-	query := `
+	query := buildQuery(`
 		query IssueSearch($term: String!, $filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean) {
 			searchIssues(term: $term, filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived) {
 				nodes {
-					id
-					identifier
-					title
-					description
-					priority
-					priorityLabel
-					estimate
-					createdAt
-					updatedAt
-					startedAt
-					completedAt
-					canceledAt
-					archivedAt
-					triagedAt
-					snoozedUntilAt
-					dueDate
-					branchName
-					customerTicketCount
-					url
-					slaStartedAt
-					slaMediumRiskAt
-					slaHighRiskAt
-					slaBreachesAt
-					slaType
-					state {
-						id
-						name
-						type
-						color
-					}
-					assignee {
-						id
-						name
-						email
-					}
-					creator {
-						id
-						name
-						email
-					}
-					team {
-						id
-						key
-						name
-					}
-					labels {
-						nodes {
-							id
-							name
-							color
-						}
-					}
-					cycle {
-						id
-						number
-						name
-					}
-					project {
-						id
-						name
-						state
-					}
-					projectMilestone {
-						id
-						name
-						status
-					}
-					parent {
-						id
-						identifier
-						title
-					}
+					...IssueCore
 				}
 				pageInfo {
 					hasNextPage
@@ -873,7 +937,7 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 				}
 			}
 		}
-	`
+	`)
 
 	variables := map[string]interface{}{
 		"term":            term,
@@ -910,8 +974,13 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 
 // GetIssue returns a single issue by ID
 func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
+	return c.getIssue(ctx, id, true)
+}
+
+// getIssue is GetIssue, leaving out the attachments unless attachments is set
+func (c *Client) getIssue(ctx context.Context, id string, attachments bool) (*Issue, error) {
 	query := `
-		query Issue($id: String!) {
+		query Issue($id: String!, $attachments: Boolean = true) {
 			issue(id: $id) {
 				id
 				identifier
@@ -1056,12 +1125,13 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 					targetDate
 					status
 				}
-				attachments(first: 20) {
+				attachments(first: 20) @include(if: $attachments) {
 					nodes {
 						id
 						title
 						subtitle
 						url
+						sourceType
 						metadata
 						createdAt
 						creator {
@@ -1195,6 +1265,161 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 		}
 	`
 
+	variables := map[string]interface{}{
+		"id": id,
+	}
+	if !attachments {
+		variables["attachments"] = false
+	}
+
+	var response struct {
+		Issue Issue `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issue, nil
+}
+
+// GetIssueWithFields is GetIssue with control over the requested fields
+func (c *Client) GetIssueWithFields(ctx context.Context, id string, fields IssueFields) (*Issue, error) {
+	if fields&^(IssueFieldChildren|IssueFieldNoAttachments) == IssueFieldsFull {
+		return c.getIssue(ctx, id, fields&IssueFieldNoAttachments == 0)
+	}
+	query := buildQuery(`
+		query IssueWithFields($id: String!) {
+			issue(id: $id) {` + issueSelection(fields) + `			}
+		}
+	`)
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Issue Issue `json:"issue"`
+	}
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issue, nil
+}
+
+// IssueFieldsFor returns the lightest field level that selects every one of
+// paths, dot-paths into issue JSON such as "state.name", or IssueFieldsFull
+// when none of the lighter levels does
+func IssueFieldsFor(paths []string) IssueFields {
+	for _, level := range []IssueFields{IssueFieldsIDs, IssueFieldsMinimal, IssueFieldsTable} {
+		selected := selectionPaths(issueSelection(level))
+		covered := true
+		for _, path := range paths {
+			if !selected[path] {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return level
+		}
+	}
+	return IssueFieldsFull
+}
+
+// selectionPaths lists the leaf fields a selection set without arguments
+// requests, as dot-paths
+func selectionPaths(selection string) map[string]bool {
+	paths := map[string]bool{}
+	var parents []string
+	last := ""
+	for _, tok := range strings.Fields(strings.NewReplacer("{", " { ", "}", " } ").Replace(selection)) {
+		switch tok {
+		case "{":
+			parents = append(parents, last)
+			delete(paths, strings.Join(parents, "."))
+		case "}":
+			if len(parents) > 0 {
+				parents = parents[:len(parents)-1]
+			}
+		default:
+			last = tok
+			paths[strings.Join(append(parents[:len(parents):len(parents)], tok), ".")] = true
+		}
+	}
+	return paths
+}
+
+// GetIssueRelations returns an issue with just its parent, children, and
+// relations in both directions, for walking the dependency graph
+func (c *Client) GetIssueRelations(ctx context.Context, id string) (*Issue, error) {
+	query := `
+		query IssueRelations($id: String!) {
+			issue(id: $id) {
+				id
+				identifier
+				title
+				state {
+					name
+					type
+				}
+				parent {
+					id
+					identifier
+					title
+					state {
+						name
+						type
+					}
+				}
+				children(first: 100) {
+					nodes {
+						id
+						identifier
+						title
+						state {
+							name
+							type
+						}
+					}
+				}
+				relations(first: 100) {
+					nodes {
+						id
+						type
+						relatedIssue {
+							id
+							identifier
+							title
+							state {
+								name
+								type
+							}
+						}
+					}
+				}
+				inverseRelations(first: 100) {
+					nodes {
+						id
+						type
+						issue {
+							id
+							identifier
+							title
+							state {
+								name
+								type
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
 	variables := map[string]interface{}{
 		"id": id,
 	}
@@ -1260,6 +1485,11 @@ func (c *Client) GetTeams(ctx context.Context, first int, after string, orderBy
 					updatedAt
 					archivedAt
 					retiredAt
+					parent {
+						id
+						key
+						name
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -1293,40 +1523,52 @@ func (c *Client) GetTeams(ctx context.Context, first int, after string, orderBy
 
 // GetProjects returns a list of projects
 func (c *Client) GetProjects(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Projects, error) {
-	query := `
+	query := buildQuery(`
 		query Projects($filter: ProjectFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			projects(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+				nodes {
+					...ProjectCore
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`)
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+	if orderBy != "" {
+		variables["orderBy"] = orderBy
+	}
+
+	var response struct {
+		Projects Projects `json:"projects"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Projects, nil
+}
+
+// GetProjectIDs is GetProjects selecting only each project's ID
+func (c *Client) GetProjectIDs(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Projects, error) {
+	query := `
+		query ProjectIDs($filter: ProjectFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
 			projects(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
 				nodes {
 					id
-					name
-					description
-					state
-					progress
-					startDate
-					targetDate
-					url
-					icon
-					color
-					createdAt
-					updatedAt
-					completedAt
-					canceledAt
-					health
-					priority
-					priorityLabel
-					scope
-					lead {
-						id
-						name
-						email
-					}
-					teams {
-						nodes {
-							id
-							key
-							name
-						}
-					}
 				}
 				pageInfo {
 					hasNextPage
@@ -1350,15 +1592,56 @@ func (c *Client) GetProjects(ctx context.Context, filter map[string]interface{},
 	}
 
 	var response struct {
-		Projects Projects `json:"projects"`
+		Projects Projects `json:"projects"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Projects, nil
+}
+
+// ProjectScopeHistory is a project's weekly scope and completed scope, in
+// estimate points, one entry per week since the project was created
+type ProjectScopeHistory struct {
+	ID                    string     `json:"id"`
+	CreatedAt             time.Time  `json:"createdAt"`
+	StartedAt             *time.Time `json:"startedAt"`
+	StartDate             *string    `json:"startDate"`
+	TargetDate            *string    `json:"targetDate"`
+	ScopeHistory          []float64  `json:"scopeHistory"`
+	CompletedScopeHistory []float64  `json:"completedScopeHistory"`
+}
+
+// GetProjectScopeHistory returns a project's scope history arrays, which
+// GetProject leaves out
+func (c *Client) GetProjectScopeHistory(ctx context.Context, id string) (*ProjectScopeHistory, error) {
+	query := `
+		query ProjectScopeHistory($id: String!) {
+			project(id: $id) {
+				id
+				createdAt
+				startedAt
+				startDate
+				targetDate
+				scopeHistory
+				completedScopeHistory
+			}
+		}
+	`
+
+	var response struct {
+		Project ProjectScopeHistory `json:"project"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.Execute(ctx, query, map[string]interface{}{"id": id}, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return &response.Projects, nil
+	return &response.Project, nil
 }
 
 // GetProject returns a single project by ID
@@ -1434,6 +1717,14 @@ func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
 						cyclesEnabled
 					}
 				}
+				initiatives {
+					nodes {
+						id
+						name
+						status
+						url
+					}
+				}
 				members {
 					nodes {
 						id
@@ -1616,6 +1907,7 @@ func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]in
 					id
 					identifier
 					title
+					url
 					description
 					priority
 					estimate
@@ -1678,6 +1970,7 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 					id
 					identifier
 					title
+					url
 					description
 					priority
 					estimate
@@ -1712,6 +2005,7 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 		}
 	`
 
+	input, id := withClientID(input)
 	variables := map[string]interface{}{
 		"input": input,
 	}
@@ -1722,7 +2016,10 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 		} `json:"issueCreate"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.executeCreate(ctx, query, variables, &response)
+	if errors.Is(err, errCreatedEarlier) {
+		return c.GetIssue(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1730,11 +2027,12 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 	return &response.IssueCreate.Issue, nil
 }
 
-// GetTeam returns a single team by key
-func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
+// getTeamByID fetches a single team with the team(id:) query, which takes
+// a UUID or a team key
+func (c *Client) getTeamByID(ctx context.Context, id string) (*Team, error) {
 	query := `
-		query Team($key: String!) {
-			team(id: $key) {
+		query Team($id: String!) {
+			team(id: $id) {
 				id
 				key
 				name
@@ -1826,7 +2124,7 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 	`
 
 	variables := map[string]interface{}{
-		"key": key,
+		"id": id,
 	}
 
 	var response struct {
@@ -1916,12 +2214,12 @@ type WorkflowState struct {
 	Position    float64 `json:"position"`
 }
 
-// GetTeamStates returns workflow states for a team
+// GetTeamStates returns all workflow states for a team, following pagination
 func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowState, error) {
 	query := `
-		query TeamStates($key: String!) {
+		query TeamStates($key: String!, $first: Int, $after: String) {
 			team(id: $key) {
-				states {
+				states(first: $first, after: $after) {
 					nodes {
 						id
 						name
@@ -1930,37 +2228,55 @@ func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowS
 						description
 						position
 					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
 				}
 			}
 		}
 	`
 
-	variables := map[string]interface{}{
-		"key": teamKey,
-	}
+	var states []WorkflowState
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"key":   teamKey,
+			"first": 250,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
 
-	var response struct {
-		Team struct {
-			States struct {
-				Nodes []WorkflowState `json:"nodes"`
-			} `json:"states"`
-		} `json:"team"`
-	}
+		var response struct {
+			Team struct {
+				States struct {
+					Nodes    []WorkflowState `json:"nodes"`
+					PageInfo PageInfo        `json:"pageInfo"`
+				} `json:"states"`
+			} `json:"team"`
+		}
 
-	err := c.Execute(ctx, query, variables, &response)
-	if err != nil {
-		return nil, err
-	}
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return nil, err
+		}
 
-	return response.Team.States.Nodes, nil
+		states = append(states, response.Team.States.Nodes...)
+		pageInfo := response.Team.States.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return states, nil
+		}
+		after = pageInfo.EndCursor
+	}
 }
 
-// GetTeamMembers returns members of a specific team
+// GetTeamMembers returns all members of a specific team, following pagination
 func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, error) {
 	query := `
-		query TeamMembers($key: String!) {
+		query TeamMembers($key: String!, $first: Int, $after: String) {
 			team(id: $key) {
-				members {
+				members(first: $first, after: $after) {
 					nodes {
 						id
 						name
@@ -1979,22 +2295,35 @@ func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, er
 		}
 	`
 
-	variables := map[string]interface{}{
-		"key": teamKey,
-	}
+	members := &Users{}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"key":   teamKey,
+			"first": 250,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
 
-	var response struct {
-		Team struct {
-			Members Users `json:"members"`
-		} `json:"team"`
-	}
+		var response struct {
+			Team struct {
+				Members Users `json:"members"`
+			} `json:"team"`
+		}
 
-	err := c.Execute(ctx, query, variables, &response)
-	if err != nil {
-		return nil, err
-	}
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return nil, err
+		}
 
-	return &response.Team.Members, nil
+		members.Nodes = append(members.Nodes, response.Team.Members.Nodes...)
+		members.PageInfo = response.Team.Members.PageInfo
+		if !members.PageInfo.HasNextPage || members.PageInfo.EndCursor == "" {
+			return members, nil
+		}
+		after = members.PageInfo.EndCursor
+	}
 }
 
 // GetUsers returns a list of all users
@@ -2055,6 +2384,47 @@ func (c *Client) GetUsers(ctx context.Context, first int, after string, orderBy
 	return &response.Users, nil
 }
 
+// FindUsers returns the users matching a UserFilter, with just the fields
+// needed to resolve a user reference
+func (c *Client) FindUsers(ctx context.Context, filter map[string]interface{}, first int, after string) (*Users, error) {
+	query := `
+		query FindUsers($filter: UserFilter, $first: Int, $after: String) {
+			users(filter: $filter, first: $first, after: $after) {
+				nodes {
+					id
+					name
+					email
+					displayName
+					active
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"filter": filter,
+		"first":  first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Users Users `json:"users"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Users, nil
+}
+
 // GetUser returns a specific user by email or ID
 func (c *Client) GetUser(ctx context.Context, emailOrID string) (*User, error) {
 	// Check if it looks like an email (contains @)
@@ -2365,7 +2735,9 @@ func (c *Client) CreateComment(ctx context.Context, issueID string, body string,
 		}
 	`
 
+	id := NewUUID()
 	input := map[string]interface{}{
+		"id":      id,
 		"issueId": issueID,
 		"body":    body,
 	}
@@ -2392,7 +2764,10 @@ func (c *Client) CreateComment(ctx context.Context, issueID string, body string,
 		} `json:"commentCreate"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.executeCreate(ctx, query, variables, &response)
+	if errors.Is(err, errCreatedEarlier) {
+		return c.GetComment(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -2400,6 +2775,64 @@ func (c *Client) CreateComment(ctx context.Context, issueID string, body string,
 	return &response.CommentCreate.Comment, nil
 }
 
+// GetComment fetches a single comment by ID, with its parent and replies.
+// Each reply lists the ID of its own first reply, if any, so a caller can
+// tell which replies need fetching in turn.
+func (c *Client) GetComment(ctx context.Context, id string) (*Comment, error) {
+	query := `
+		query Comment($id: String!) {
+			comment(id: $id) {
+				id
+				body
+				createdAt
+				updatedAt
+				editedAt
+				url
+				parentId
+				quotedText
+				resolvedAt
+				user {
+					id
+					name
+					email
+				}
+				parent {
+					id
+					parentId
+				}
+				children(first: 250) {
+					nodes {
+						id
+						body
+						createdAt
+						user {
+							id
+							name
+						}
+						children(first: 1) {
+							nodes {
+								id
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Comment Comment `json:"comment"`
+	}
+	if err := c.Execute(ctx, query, map[string]interface{}{"id": id}, &response); err != nil {
+		return nil, err
+	}
+	return &response.Comment, nil
+}
+
 // GetDocuments returns a list of documents with optional filtering
 func (c *Client) GetDocuments(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Documents, error) {
 	query := `
@@ -2562,14 +2995,16 @@ func (c *Client) GetDocument(ctx context.Context, id string) (*Document, error)
 	return &response.Document, nil
 }
 
-// SearchDocuments returns documents matching a search query
-func (c *Client) SearchDocuments(ctx context.Context, term string, first int, after string, orderBy string, teamID string, includeComments bool) (*Documents, error) {
+// SearchDocuments returns documents matching a search query. withContent
+// also fetches each document's content, for client-side match snippets.
+func (c *Client) SearchDocuments(ctx context.Context, term string, first int, after string, orderBy string, teamID string, includeComments bool, withContent bool) (*Documents, error) {
 	query := `
-		query SearchDocuments($term: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy, $teamId: String, $includeComments: Boolean) {
+		query SearchDocuments($term: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy, $teamId: String, $includeComments: Boolean, $withContent: Boolean!) {
 			searchDocuments(term: $term, first: $first, after: $after, orderBy: $orderBy, teamId: $teamId, includeComments: $includeComments) {
 				nodes {
 					id
 					title
+					content @include(if: $withContent)
 					icon
 					color
 					slugId
@@ -2627,6 +3062,7 @@ func (c *Client) SearchDocuments(ctx context.Context, term string, first int, af
 		"term":            term,
 		"first":           first,
 		"includeComments": includeComments,
+		"withContent":     withContent,
 	}
 	if after != "" {
 		variables["after"] = after
@@ -2709,6 +3145,7 @@ func (c *Client) CreateDocument(ctx context.Context, input map[string]interface{
 		}
 	`
 
+	input, id := withClientID(input)
 	variables := map[string]interface{}{
 		"input": input,
 	}
@@ -2719,7 +3156,10 @@ func (c *Client) CreateDocument(ctx context.Context, input map[string]interface{
 		} `json:"documentCreate"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.executeCreate(ctx, query, variables, &response)
+	if errors.Is(err, errCreatedEarlier) {
+		return c.GetDocument(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -2898,6 +3338,8 @@ func (c *Client) GetProjectMilestone(ctx context.Context, id string) (*ProjectMi
 					name
 					state
 					progress
+					startDate
+					targetDate
 				}
 				issues(first: 50) {
 					nodes {
@@ -2952,6 +3394,11 @@ func (c *Client) CreateProjectMilestone(ctx context.Context, input map[string]in
 					sortOrder
 					createdAt
 					updatedAt
+					project {
+						id
+						name
+						url
+					}
 				}
 			}
 		}
@@ -2990,20 +3437,155 @@ func (c *Client) UpdateProjectMilestone(ctx context.Context, id string, input ma
 					sortOrder
 					createdAt
 					updatedAt
+					project {
+						id
+						name
+						url
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		ProjectMilestoneUpdate struct {
+			ProjectMilestone ProjectMilestone `json:"projectMilestone"`
+		} `json:"projectMilestoneUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.ProjectMilestoneUpdate.ProjectMilestone, nil
+}
+
+// GetProjectUpdates returns status updates for a project
+func (c *Client) GetProjectUpdates(ctx context.Context, projectID string, first int, after string) (*ProjectUpdates, error) {
+	query := `
+		query ProjectUpdates($id: String!, $first: Int, $after: String) {
+			project(id: $id) {
+				projectUpdates(first: $first, after: $after) {
+					nodes {
+						id
+						slugId
+						body
+						health
+						url
+						createdAt
+						updatedAt
+						editedAt
+						archivedAt
+						isDiffHidden
+						isStale
+						diff
+						diffMarkdown
+						infoSnapshot
+						commentCount
+						user {
+							id
+							name
+							email
+							avatarUrl
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    projectID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Project struct {
+			ProjectUpdates struct {
+				Nodes    []ProjectUpdate `json:"nodes"`
+				PageInfo PageInfo        `json:"pageInfo"`
+			} `json:"projectUpdates"`
+		} `json:"project"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProjectUpdates{
+		Nodes:    response.Project.ProjectUpdates.Nodes,
+		PageInfo: response.Project.ProjectUpdates.PageInfo,
+	}, nil
+}
+
+// GetRecentProjectUpdates returns status updates across every project
+// created at or after since, newest first. Each update carries its project
+// with the initiatives the project belongs to.
+func (c *Client) GetRecentProjectUpdates(ctx context.Context, since time.Time, first int, after string) (*ProjectUpdates, error) {
+	query := `
+		query RecentProjectUpdates($filter: ProjectUpdateFilter, $first: Int, $after: String) {
+			projectUpdates(filter: $filter, first: $first, after: $after, orderBy: createdAt) {
+				nodes {
+					id
+					body
+					health
+					url
+					createdAt
+					updatedAt
+					editedAt
+					user {
+						id
+						name
+						email
+					}
+					project {
+						id
+						name
+						url
+						health
+						initiatives {
+							nodes {
+								id
+								name
+								url
+							}
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
 				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"id":    id,
-		"input": input,
+		"filter": map[string]interface{}{
+			"createdAt": map[string]interface{}{"gte": since.UTC().Format(time.RFC3339)},
+		},
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
 	}
 
 	var response struct {
-		ProjectMilestoneUpdate struct {
-			ProjectMilestone ProjectMilestone `json:"projectMilestone"`
-		} `json:"projectMilestoneUpdate"`
+		ProjectUpdates ProjectUpdates `json:"projectUpdates"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -3011,72 +3593,47 @@ func (c *Client) UpdateProjectMilestone(ctx context.Context, id string, input ma
 		return nil, err
 	}
 
-	return &response.ProjectMilestoneUpdate.ProjectMilestone, nil
+	return &response.ProjectUpdates, nil
 }
 
-// GetProjectUpdates returns status updates for a project
-func (c *Client) GetProjectUpdates(ctx context.Context, projectID string, first int, after string) (*ProjectUpdates, error) {
+// GetLatestProjectUpdate returns a project's most recent status update, or
+// nil if it has none. Only the ID, health, creation time, and author are
+// filled in; Linear orders the connection newest first.
+func (c *Client) GetLatestProjectUpdate(ctx context.Context, projectID string) (*ProjectUpdate, error) {
 	query := `
-		query ProjectUpdates($id: String!, $first: Int, $after: String) {
+		query LatestProjectUpdate($id: String!) {
 			project(id: $id) {
-				projectUpdates(first: $first, after: $after) {
+				projectUpdates(first: 1, orderBy: createdAt) {
 					nodes {
 						id
-						slugId
-						body
 						health
-						url
 						createdAt
-						updatedAt
-						editedAt
-						archivedAt
-						isDiffHidden
-						isStale
-						diff
-						diffMarkdown
-						infoSnapshot
-						commentCount
 						user {
 							id
 							name
-							email
-							avatarUrl
 						}
 					}
-					pageInfo {
-						hasNextPage
-						endCursor
-					}
 				}
 			}
 		}
 	`
 
-	variables := map[string]interface{}{
-		"id":    projectID,
-		"first": first,
-	}
-	if after != "" {
-		variables["after"] = after
-	}
-
 	var response struct {
 		Project struct {
 			ProjectUpdates struct {
-				Nodes    []ProjectUpdate `json:"nodes"`
-				PageInfo PageInfo        `json:"pageInfo"`
+				Nodes []ProjectUpdate `json:"nodes"`
 			} `json:"projectUpdates"`
 		} `json:"project"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.Execute(ctx, query, map[string]interface{}{"id": projectID}, &response)
 	if err != nil {
 		return nil, err
 	}
-
-	return &ProjectUpdates{
-		Nodes: response.Project.ProjectUpdates.Nodes,
-	}, nil
+	if len(response.Project.ProjectUpdates.Nodes) == 0 {
+		return nil, nil
+	}
+	return &response.Project.ProjectUpdates.Nodes[0], nil
 }
 
 // GetProjectUpdate returns a single project status update by ID
@@ -3587,7 +4144,7 @@ func (c *Client) GetCustomView(ctx context.Context, id string) (*CustomView, err
 
 // GetCustomViewIssues returns issues matching a custom view's filters
 func (c *Client) GetCustomViewIssues(ctx context.Context, viewID string, first int, after string) (*Issues, error) {
-	query := `
+	query := buildQuery(`
 		query CustomViewIssues($id: String!, $first: Int, $after: String) {
 			customView(id: $id) {
 				issues(first: $first, after: $after) {
@@ -3603,26 +4160,17 @@ func (c *Client) GetCustomViewIssues(ctx context.Context, viewID string, first i
 						dueDate
 						url
 						state {
-							id
-							name
-							type
-							color
+							...WorkflowStateRef
 						}
 						assignee {
-							id
-							name
-							email
+							...UserRef
 						}
 						team {
-							id
-							key
-							name
+							...TeamRef
 						}
 						labels {
 							nodes {
-								id
-								name
-								color
+								...LabelRef
 							}
 						}
 					}
@@ -3633,7 +4181,7 @@ func (c *Client) GetCustomViewIssues(ctx context.Context, viewID string, first i
 				}
 			}
 		}
-	`
+	`)
 
 	variables := map[string]interface{}{
 		"id":    viewID,
@@ -3659,33 +4207,12 @@ func (c *Client) GetCustomViewIssues(ctx context.Context, viewID string, first i
 
 // GetCustomViewProjects returns projects matching a custom view's filters
 func (c *Client) GetCustomViewProjects(ctx context.Context, viewID string, first int, after string) (*Projects, error) {
-	query := `
+	query := buildQuery(`
 		query CustomViewProjects($id: String!, $first: Int, $after: String) {
 			customView(id: $id) {
 				projects(first: $first, after: $after) {
 					nodes {
-						id
-						name
-						description
-						state
-						progress
-						startDate
-						targetDate
-						url
-						createdAt
-						updatedAt
-						lead {
-							id
-							name
-							email
-						}
-						teams {
-							nodes {
-								id
-								key
-								name
-							}
-						}
+						...ProjectCore
 					}
 					pageInfo {
 						hasNextPage
@@ -3694,7 +4221,7 @@ func (c *Client) GetCustomViewProjects(ctx context.Context, viewID string, first
 				}
 			}
 		}
-	`
+	`)
 
 	variables := map[string]interface{}{
 		"id":    viewID,
@@ -3758,6 +4285,7 @@ func (c *Client) CreateCustomView(ctx context.Context, input map[string]interfac
 					organization {
 						id
 						name
+						urlKey
 					}
 					createdAt
 					updatedAt
@@ -3831,6 +4359,7 @@ func (c *Client) UpdateCustomView(ctx context.Context, id string, input map[stri
 					organization {
 						id
 						name
+						urlKey
 					}
 					createdAt
 					updatedAt
@@ -3931,6 +4460,10 @@ func (c *Client) GetInitiatives(ctx context.Context, filter map[string]interface
 						name
 						email
 					}
+					parentInitiative {
+						id
+						name
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -4013,6 +4546,10 @@ func (c *Client) GetInitiative(ctx context.Context, id string) (*Initiative, err
 						progress
 						url
 					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
 				}
 				parentInitiative {
 					id
@@ -4083,6 +4620,7 @@ func (c *Client) CreateInitiative(ctx context.Context, input map[string]interfac
 		}
 	`
 
+	input, id := withClientID(input)
 	variables := map[string]interface{}{
 		"input": input,
 	}
@@ -4094,7 +4632,10 @@ func (c *Client) CreateInitiative(ctx context.Context, input map[string]interfac
 		} `json:"initiativeCreate"`
 	}
 
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.executeCreate(ctx, query, variables, &response)
+	if errors.Is(err, errCreatedEarlier) {
+		return c.GetInitiative(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -4227,6 +4768,10 @@ func (c *Client) GetIssueAttachments(ctx context.Context, issueID string, first
 							email
 						}
 					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
 				}
 			}
 		}
@@ -4561,6 +5106,7 @@ func (c *Client) GetIssueActivity(ctx context.Context, issueID string, historyFi
 					nodes {
 						id
 						type
+						createdAt
 						relatedIssue {
 							id
 							identifier
@@ -4572,7 +5118,7 @@ func (c *Client) GetIssueActivity(ctx context.Context, issueID string, historyFi
 						}
 					}
 				}
-				comments(first: 10) {
+				comments(first: 50) {
 					nodes {
 						id
 						body
@@ -4734,6 +5280,7 @@ func (c *Client) GetCycles(ctx context.Context, filter map[string]interface{}, f
 						id
 						key
 						name
+						issueEstimationType
 					}
 				}
 				pageInfo {
@@ -5032,6 +5579,121 @@ func (c *Client) GetLabels(ctx context.Context, filter map[string]interface{}, f
 	return &response.IssueLabels, nil
 }
 
+// CountProjects returns the number of projects matching filter. Like
+// CountLabelIssues it pages through IDs, as the API has no count field.
+func (c *Client) CountProjects(ctx context.Context, filter map[string]interface{}) (int, error) {
+	query := `
+		query ProjectCount($filter: ProjectFilter, $after: String) {
+			projects(filter: $filter, first: 250, after: $after) {
+				nodes {
+					id
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	count := 0
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"filter": filter,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var response struct {
+			Projects struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+				PageInfo PageInfo `json:"pageInfo"`
+			} `json:"projects"`
+		}
+
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(response.Projects.Nodes)
+		pageInfo := response.Projects.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return count, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// CountLabelIssues returns the number of non-archived issues carrying a label.
+// The API has no count field, so it pages through issue IDs.
+func (c *Client) CountLabelIssues(ctx context.Context, labelID string) (int, error) {
+	ids, err := c.GetLabelIssueIDs(ctx, labelID)
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// GetLabelIssueIDs returns the IDs of every non-archived issue carrying a label
+func (c *Client) GetLabelIssueIDs(ctx context.Context, labelID string) ([]string, error) {
+	query := `
+		query LabelIssueCount($id: String!, $after: String) {
+			issueLabel(id: $id) {
+				issues(first: 250, after: $after) {
+					nodes {
+						id
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	var ids []string
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"id": labelID,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+
+		var response struct {
+			IssueLabel struct {
+				Issues struct {
+					Nodes []struct {
+						ID string `json:"id"`
+					} `json:"nodes"`
+					PageInfo PageInfo `json:"pageInfo"`
+				} `json:"issues"`
+			} `json:"issueLabel"`
+		}
+
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range response.IssueLabel.Issues.Nodes {
+			ids = append(ids, node.ID)
+		}
+		pageInfo := response.IssueLabel.Issues.PageInfo
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return ids, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
 // CreateLabel creates a new label
 func (c *Client) CreateLabel(ctx context.Context, input map[string]interface{}) (*Label, error) {
 	query := `
@@ -5081,28 +5743,12 @@ func (c *Client) CreateLabel(ctx context.Context, input map[string]interface{})
 
 // GetInitiativeProjects returns projects for a specific initiative
 func (c *Client) GetInitiativeProjects(ctx context.Context, initiativeID string, first int, after string) (*Projects, error) {
-	query := `
+	query := buildQuery(`
 		query InitiativeProjects($id: String!, $first: Int, $after: String) {
 			initiative(id: $id) {
 				projects(first: $first, after: $after) {
 					nodes {
-						id
-						name
-						state
-						progress
-						startDate
-						targetDate
-						lead {
-							id
-							name
-						}
-						teams {
-							nodes {
-								id
-								key
-								name
-							}
-						}
+						...ProjectCore
 					}
 					pageInfo {
 						hasNextPage
@@ -5111,7 +5757,7 @@ func (c *Client) GetInitiativeProjects(ctx context.Context, initiativeID string,
 				}
 			}
 		}
-	`
+	`)
 
 	variables := map[string]interface{}{
 		"id":    initiativeID,
@@ -5306,21 +5952,95 @@ func (c *Client) GetInitiativeLinksForProject(ctx context.Context, projectID str
 	`
 
 	variables := map[string]interface{}{
-		"first": 250,
+		"first": 250,
+	}
+
+	var response struct {
+		InitiativeToProjects struct {
+			Nodes []struct {
+				ID         string `json:"id"`
+				Initiative struct {
+					ID string `json:"id"`
+				} `json:"initiative"`
+				Project struct {
+					ID string `json:"id"`
+				} `json:"project"`
+			} `json:"nodes"`
+		} `json:"initiativeToProjects"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	var initiativeIDs []string
+	for _, link := range response.InitiativeToProjects.Nodes {
+		if link.Project.ID == projectID {
+			initiativeIDs = append(initiativeIDs, link.Initiative.ID)
+		}
+	}
+
+	return initiativeIDs, nil
+}
+
+// GetProjectIssues returns issues for a specific project, optionally narrowed by an IssueFilter
+func (c *Client) GetProjectIssues(ctx context.Context, projectID string, filter map[string]interface{}, first int, after string) (*Issues, error) {
+	query := `
+		query ProjectIssues($id: String!, $filter: IssueFilter, $first: Int, $after: String) {
+			project(id: $id) {
+				issues(filter: $filter, first: $first, after: $after) {
+					nodes {
+						id
+						identifier
+						title
+						priority
+						priorityLabel
+						estimate
+						createdAt
+						updatedAt
+						dueDate
+						state {
+							id
+							name
+							type
+							color
+						}
+						assignee {
+							id
+							name
+							email
+						}
+						projectMilestone {
+							id
+							name
+							targetDate
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    projectID,
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
 	}
 
 	var response struct {
-		InitiativeToProjects struct {
-			Nodes []struct {
-				ID         string `json:"id"`
-				Initiative struct {
-					ID string `json:"id"`
-				} `json:"initiative"`
-				Project struct {
-					ID string `json:"id"`
-				} `json:"project"`
-			} `json:"nodes"`
-		} `json:"initiativeToProjects"`
+		Project struct {
+			Issues Issues `json:"issues"`
+		} `json:"project"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -5328,30 +6048,28 @@ func (c *Client) GetInitiativeLinksForProject(ctx context.Context, projectID str
 		return nil, err
 	}
 
-	var initiativeIDs []string
-	for _, link := range response.InitiativeToProjects.Nodes {
-		if link.Project.ID == projectID {
-			initiativeIDs = append(initiativeIDs, link.Initiative.ID)
-		}
-	}
-
-	return initiativeIDs, nil
+	return &response.Project.Issues, nil
 }
 
-// GetProjectIssues returns issues for a specific project
-func (c *Client) GetProjectIssues(ctx context.Context, projectID string, first int, after string) (*Issues, error) {
+// GetIssueChildren returns an issue's project and a page of its sub-issues
+// (in Children), with the fields needed to total their completion and the
+// project each sub-issue belongs to
+func (c *Client) GetIssueChildren(ctx context.Context, issueID string, first int, after string) (*Issue, error) {
 	query := `
-		query ProjectIssues($id: String!, $first: Int, $after: String) {
-			project(id: $id) {
-				issues(first: $first, after: $after) {
+		query IssueChildren($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				id
+				project {
+					id
+				}
+				children(first: $first, after: $after) {
 					nodes {
 						id
 						identifier
 						title
 						priority
 						priorityLabel
-						createdAt
-						updatedAt
+						estimate
 						state {
 							id
 							name
@@ -5363,6 +6081,10 @@ func (c *Client) GetProjectIssues(ctx context.Context, projectID string, first i
 							name
 							email
 						}
+						project {
+							id
+							name
+						}
 					}
 					pageInfo {
 						hasNextPage
@@ -5374,7 +6096,7 @@ func (c *Client) GetProjectIssues(ctx context.Context, projectID string, first i
 	`
 
 	variables := map[string]interface{}{
-		"id":    projectID,
+		"id":    issueID,
 		"first": first,
 	}
 	if after != "" {
@@ -5382,9 +6104,7 @@ func (c *Client) GetProjectIssues(ctx context.Context, projectID string, first i
 	}
 
 	var response struct {
-		Project struct {
-			Issues Issues `json:"issues"`
-		} `json:"project"`
+		Issue Issue `json:"issue"`
 	}
 
 	err := c.Execute(ctx, query, variables, &response)
@@ -5392,7 +6112,7 @@ func (c *Client) GetProjectIssues(ctx context.Context, projectID string, first i
 		return nil, err
 	}
 
-	return &response.Project.Issues, nil
+	return &response.Issue, nil
 }
 
 // CommentUpdateOptions contains optional parameters for updating a comment
@@ -5591,6 +6311,56 @@ func (c *Client) ArchiveIssue(ctx context.Context, issueID string) (*Issue, erro
 	return nil, nil
 }
 
+// CreateIssueReminder schedules a reminder notification about an issue.
+// Linear delivers reminders to the authenticated user only.
+func (c *Client) CreateIssueReminder(ctx context.Context, issueID string, at time.Time) (*Issue, error) {
+	query := `
+		mutation IssueReminder($id: String!, $reminderAt: DateTime!) {
+			issueReminder(id: $id, reminderAt: $reminderAt) {
+				success
+				issue {
+					id
+					identifier
+					title
+					url
+				}
+			}
+		}
+	`
+	variables := map[string]interface{}{
+		"id":         issueID,
+		"reminderAt": at.UTC().Format(time.RFC3339),
+	}
+	var response struct {
+		IssueReminder struct {
+			Success bool  `json:"success"`
+			Issue   Issue `json:"issue"`
+		} `json:"issueReminder"`
+	}
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return nil, err
+	}
+	return &response.IssueReminder.Issue, nil
+}
+
+// UnarchiveIssue restores an archived issue
+func (c *Client) UnarchiveIssue(ctx context.Context, issueID string) error {
+	query := `
+		mutation UnarchiveIssue($id: String!) {
+			issueUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+	variables := map[string]interface{}{"id": issueID}
+	var response struct {
+		IssueUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"issueUnarchive"`
+	}
+	return c.Execute(ctx, query, variables, &response)
+}
+
 // CreateProject creates a new project
 func (c *Client) CreateProject(ctx context.Context, input map[string]interface{}) (*Project, error) {
 	query := `
@@ -5642,6 +6412,7 @@ func (c *Client) CreateProject(ctx context.Context, input map[string]interface{}
 			}
 		}
 	`
+	input, id := withClientID(input)
 	variables := map[string]interface{}{
 		"input": input,
 	}
@@ -5651,7 +6422,10 @@ func (c *Client) CreateProject(ctx context.Context, input map[string]interface{}
 			Success bool    `json:"success"`
 		} `json:"projectCreate"`
 	}
-	err := c.Execute(ctx, query, variables, &response)
+	err := c.executeCreate(ctx, query, variables, &response)
+	if errors.Is(err, errCreatedEarlier) {
+		return c.GetProject(ctx, id)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -5676,6 +6450,24 @@ func (c *Client) ArchiveProject(ctx context.Context, id string) error {
 	return c.Execute(ctx, query, variables, &response)
 }
 
+// UnarchiveProject restores an archived project
+func (c *Client) UnarchiveProject(ctx context.Context, id string) error {
+	query := `
+		mutation UnarchiveProject($id: String!) {
+			projectUnarchive(id: $id) {
+				success
+			}
+		}
+	`
+	variables := map[string]interface{}{"id": id}
+	var response struct {
+		ProjectUnarchive struct {
+			Success bool `json:"success"`
+		} `json:"projectUnarchive"`
+	}
+	return c.Execute(ctx, query, variables, &response)
+}
+
 // DeleteProject permanently deletes a project
 func (c *Client) DeleteProject(ctx context.Context, id string) error {
 	query := `
@@ -5760,6 +6552,80 @@ func (c *Client) ArchiveCycle(ctx context.Context, id string) error {
 	return c.Execute(ctx, query, variables, &response)
 }
 
+// DeleteCycle permanently deletes a cycle
+func (c *Client) DeleteCycle(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteCycle($id: String!) {
+			cycleDelete(id: $id) {
+				success
+			}
+		}
+	`
+	variables := map[string]interface{}{"id": id}
+	var response struct {
+		CycleDelete struct {
+			Success bool `json:"success"`
+		} `json:"cycleDelete"`
+	}
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	if !response.CycleDelete.Success {
+		return fmt.Errorf("failed to delete cycle")
+	}
+	return nil
+}
+
+// GetCycleIssues returns issues in a cycle, optionally narrowed by an IssueFilter
+func (c *Client) GetCycleIssues(ctx context.Context, cycleID string, filter map[string]interface{}, first int, after string) (*Issues, error) {
+	query := `
+		query CycleIssues($id: String!, $filter: IssueFilter, $first: Int, $after: String) {
+			cycle(id: $id) {
+				issues(filter: $filter, first: $first, after: $after) {
+					nodes {
+						id
+						identifier
+						title
+						state {
+							id
+							name
+							type
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    cycleID,
+		"first": first,
+	}
+	if filter != nil {
+		variables["filter"] = filter
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Cycle struct {
+			Issues Issues `json:"issues"`
+		} `json:"cycle"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Cycle.Issues, nil
+}
+
 // GetNotifications returns the user's notifications (inbox items)
 func (c *Client) GetNotifications(ctx context.Context, first int, after string, includeArchived bool) (*Notifications, error) {
 	query := `
@@ -6520,3 +7386,160 @@ func (c *Client) DeleteTeam(ctx context.Context, id string) error {
 	err := c.Execute(ctx, query, variables, &response)
 	return err
 }
+
+const templateFields = `
+	id
+	name
+	description
+	type
+	templateData
+	createdAt
+	updatedAt
+	team {
+		id
+		key
+		name
+	}
+	creator {
+		id
+		name
+		email
+	}
+`
+
+// GetTemplates returns all templates in the workspace, including team templates
+func (c *Client) GetTemplates(ctx context.Context) ([]Template, error) {
+	query := `
+		query Templates {
+			templates {
+				` + templateFields + `
+			}
+		}
+	`
+
+	var response struct {
+		Templates []Template `json:"templates"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Templates, nil
+}
+
+// GetTemplate returns a single template by ID
+func (c *Client) GetTemplate(ctx context.Context, id string) (*Template, error) {
+	query := `
+		query Template($id: String!) {
+			template(id: $id) {
+				` + templateFields + `
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		Template Template `json:"template"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Template, nil
+}
+
+// CreateTemplate creates a new issue, project or document template
+func (c *Client) CreateTemplate(ctx context.Context, input map[string]interface{}) (*Template, error) {
+	query := `
+		mutation CreateTemplate($input: TemplateCreateInput!) {
+			templateCreate(input: $input) {
+				template {
+					` + templateFields + `
+				}
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		TemplateCreate struct {
+			Template Template `json:"template"`
+			Success  bool     `json:"success"`
+		} `json:"templateCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TemplateCreate.Template, nil
+}
+
+// UpdateTemplate updates an existing template
+func (c *Client) UpdateTemplate(ctx context.Context, id string, input map[string]interface{}) (*Template, error) {
+	query := `
+		mutation UpdateTemplate($id: String!, $input: TemplateUpdateInput!) {
+			templateUpdate(id: $id, input: $input) {
+				template {
+					` + templateFields + `
+				}
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+
+	var response struct {
+		TemplateUpdate struct {
+			Template Template `json:"template"`
+			Success  bool     `json:"success"`
+		} `json:"templateUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.TemplateUpdate.Template, nil
+}
+
+// DeleteTemplate permanently deletes a template
+func (c *Client) DeleteTemplate(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteTemplate($id: String!) {
+			templateDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		TemplateDelete struct {
+			Success bool `json:"success"`
+		} `json:"templateDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	return err
+}
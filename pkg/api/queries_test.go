@@ -0,0 +1,76 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIssuesQueryFieldSelection(t *testing.T) {
+	minimal := issuesQuery(IssueFieldsMinimal)
+	for _, heavy := range []string{"description", "labels", "children", "attachments"} {
+		if strings.Contains(minimal, heavy) {
+			t.Errorf("minimal issues query should not select %q:\n%s", heavy, minimal)
+		}
+	}
+
+	ids := issuesQuery(IssueFieldsIDs)
+	for _, heavy := range []string{"title", "state", "assignee", "description", "labels"} {
+		if strings.Contains(ids, heavy) {
+			t.Errorf("IDs issues query should not select %q:\n%s", heavy, ids)
+		}
+	}
+
+	table := issuesQuery(IssueFieldsTable)
+	for _, heavy := range []string{"description", "labels"} {
+		if strings.Contains(table, heavy) {
+			t.Errorf("table issues query should not select %q:\n%s", heavy, table)
+		}
+	}
+	for _, needed := range []string{"identifier", "title", "state", "priority", "assignee", "updatedAt", "dueDate"} {
+		if !strings.Contains(table, needed) {
+			t.Errorf("table issues query should select %q", needed)
+		}
+	}
+
+	full := issuesQuery(IssueFieldsFull)
+	for _, needed := range []string{"description", "labels", "projectMilestone", "pageInfo"} {
+		if !strings.Contains(full, needed) {
+			t.Errorf("full issues query should select %q", needed)
+		}
+	}
+}
+
+func TestIssuesQueryChildrenSelection(t *testing.T) {
+	for _, level := range []IssueFields{IssueFieldsMinimal, IssueFieldsIDs, IssueFieldsTable, IssueFieldsFull} {
+		if strings.Contains(issuesQuery(level), "children") {
+			t.Errorf("level %d should not select children by default", level)
+		}
+		withChildren := issuesQuery(level | IssueFieldChildren)
+		if !strings.Contains(withChildren, "children(first: 250)") {
+			t.Errorf("level %d with IssueFieldChildren should select children", level)
+		}
+		if strings.Replace(withChildren, issueSelectionChildren, "", 1) != issuesQuery(level) {
+			t.Errorf("level %d with IssueFieldChildren should keep the base selection", level)
+		}
+	}
+}
+
+func TestIssueFieldsFor(t *testing.T) {
+	tests := []struct {
+		paths []string
+		want  IssueFields
+	}{
+		{[]string{"identifier"}, IssueFieldsIDs},
+		{[]string{"identifier", "title", "state.name"}, IssueFieldsMinimal},
+		{[]string{"identifier", "assignee.name", "team.key", "dueDate"}, IssueFieldsTable},
+		{[]string{"identifier", "description"}, IssueFieldsFull},
+		// state alone means the whole state, which only the full level has
+		{[]string{"state"}, IssueFieldsFull},
+		{[]string{"assignee.email"}, IssueFieldsFull},
+	}
+	for _, tt := range tests {
+		if got := IssueFieldsFor(tt.paths); got != tt.want {
+			t.Errorf("IssueFieldsFor(%q) = %d, want %d", tt.paths, got, tt.want)
+		}
+	}
+}
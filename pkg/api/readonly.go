@@ -0,0 +1,77 @@
+package api
+
+import (
+	"fmt"
+	"regexp"
+	"sync/atomic"
+)
+
+// Read-only mode is process-wide, like the request budget, so every client
+// a command creates refuses mutations, whatever preflight it was given
+var (
+	readOnlySource  atomic.Pointer[string]
+	readOnlyRefused atomic.Bool
+)
+
+// mutationPattern finds a mutation operation anywhere in a document, not
+// just first as IsMutation does, so a raw query can't tuck one in second.
+// The name, when there is one, is captured.
+var mutationPattern = regexp.MustCompile(`(?m)(?:^|})\s*mutation\b\s*([_A-Za-z][_0-9A-Za-z]*)?`)
+
+// ReadOnlyError is returned instead of sending a mutation while read-only
+// mode is on
+type ReadOnlyError struct {
+	Operation string // e.g. "CreateIssue"; empty for an anonymous mutation
+	Source    string // what turned read-only mode on, e.g. "--read-only"
+}
+
+func (e *ReadOnlyError) Error() string {
+	op := "this mutation"
+	if e.Operation != "" {
+		op = "mutation " + e.Operation
+	}
+	return fmt.Sprintf("read-only mode (%s): refusing to run %s; nothing was changed", e.Source, op)
+}
+
+// SetReadOnly turns read-only mode on, naming what asked for it, or off when
+// source is empty. Either way it forgets earlier refusals.
+func SetReadOnly(source string) {
+	if source == "" {
+		readOnlySource.Store(nil)
+	} else {
+		readOnlySource.Store(&source)
+	}
+	readOnlyRefused.Store(false)
+}
+
+// ReadOnly returns what turned read-only mode on, or "" when it is off
+func ReadOnly() string {
+	if source := readOnlySource.Load(); source != nil {
+		return *source
+	}
+	return ""
+}
+
+// ReadOnlyRefused reports whether a mutation has been refused since the last
+// SetReadOnly call
+func ReadOnlyRefused() bool {
+	return readOnlyRefused.Load()
+}
+
+// checkReadOnly refuses query when it is a mutation and read-only mode is on
+func checkReadOnly(query string) error {
+	source := ReadOnly()
+	if source == "" {
+		return nil
+	}
+	m := mutationPattern.FindStringSubmatch(query)
+	if m == nil && !IsMutation(query) {
+		return nil
+	}
+	readOnlyRefused.Store(true)
+	err := &ReadOnlyError{Source: source}
+	if m != nil {
+		err.Operation = m[1]
+	}
+	return err
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestReadOnlyRefusesMutations(t *testing.T) {
+	server, hits := countingServer(t, `{"data":{"viewer":{"id":"u1"}}}`)
+	SetReadOnly("--read-only")
+	defer SetReadOnly("")
+
+	client := NewClientWithURL(server.URL, "key")
+	// A replaced preflight can't let mutations through
+	client.SetPreflight(func(string, bool) error { return nil })
+	if _, err := client.GetViewer(context.Background()); err != nil {
+		t.Fatalf("query refused: %v", err)
+	}
+	if ReadOnlyRefused() {
+		t.Error("a query counted as refused")
+	}
+
+	for _, query := range []string{
+		`mutation CreateIssue($input: IssueCreateInput!) { issueCreate(input: $input) { success } }`,
+		"# comment\nmutation { issueDelete(id: \"x\") { success } }",
+		"query A { viewer { id } }\nmutation B { issueDelete(id: \"x\") { success } }",
+	} {
+		_, err := client.ExecuteRaw(context.Background(), query, nil)
+		var roErr *ReadOnlyError
+		if !errors.As(err, &roErr) || roErr.Source != "--read-only" {
+			t.Errorf("ExecuteRaw(%q) = %v, want a read-only refusal", query, err)
+		}
+	}
+	err := client.Execute(context.Background(), `mutation CreateIssue { issueCreate { success } }`, nil, nil)
+	if err == nil || err.Error() != "read-only mode (--read-only): refusing to run mutation CreateIssue; nothing was changed" {
+		t.Errorf("Execute = %v", err)
+	}
+	if hits.Load() != 1 {
+		t.Errorf("server saw %d requests, want only the query", hits.Load())
+	}
+	if !ReadOnlyRefused() {
+		t.Error("ReadOnlyRefused = false after a refusal")
+	}
+
+	SetReadOnly("")
+	if ReadOnlyRefused() {
+		t.Error("SetReadOnly should forget refusals")
+	}
+	if _, err := client.ExecuteRaw(context.Background(), `mutation M { x }`, nil); err != nil {
+		t.Errorf("mutation refused with read-only mode off: %v", err)
+	}
+}
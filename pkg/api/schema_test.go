@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/output"
+)
+
+var updateSchema = flag.Bool("update", false, "rewrite testdata/json_schema.golden")
+
+const schemaGolden = "testdata/json_schema.golden"
+
+// jsonSchemaFields lists the JSON fields of every exported struct type in the
+// package, one "Type.jsonName goType" line each, sorted
+func jsonSchemaFields(t *testing.T) []string {
+	t.Helper()
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields []string
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok || !spec.Name.IsExported() {
+				return true
+			}
+			st, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return true
+			}
+			for _, field := range st.Fields.List {
+				for _, name := range jsonFieldNames(field) {
+					fields = append(fields, fmt.Sprintf("%s.%s %s", spec.Name.Name, name, types.ExprString(field.Type)))
+				}
+			}
+			return true
+		})
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// jsonFieldNames returns the JSON names a struct field marshals under
+func jsonFieldNames(field *ast.Field) []string {
+	tag := ""
+	if field.Tag != nil {
+		tag = reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Get("json")
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "-" {
+		return nil
+	}
+
+	var names []string
+	if len(field.Names) == 0 {
+		// Embedded field: marshals under its own fields, recorded by type
+		return []string{"(embedded)"}
+	}
+	for _, ident := range field.Names {
+		if !ident.IsExported() {
+			continue
+		}
+		if name != "" {
+			names = append(names, name)
+		} else {
+			names = append(names, ident.Name)
+		}
+	}
+	return names
+}
+
+// schemaHash fingerprints a field list
+func schemaHash(fields []string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(fields, "\n"))))
+}
+
+// readSchemaGolden returns the schema version and fields recorded in the golden file
+func readSchemaGolden(t *testing.T) (version, hash string, fields []string) {
+	t.Helper()
+	f, err := os.Open(schemaGolden)
+	if err != nil {
+		t.Fatalf("%v (run go test ./pkg/api -run TestJSONSchema -update)", err)
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "# schemaVersion "):
+			version = strings.TrimPrefix(line, "# schemaVersion ")
+		case strings.HasPrefix(line, "# sha256 "):
+			hash = strings.TrimPrefix(line, "# sha256 ")
+		case line != "":
+			fields = append(fields, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return version, hash, fields
+}
+
+// TestJSONSchema fails when the JSON shape of the api types changes without
+// the golden file being updated, and refuses an update that renames or
+// removes fields unless output.SchemaVersion was bumped
+func TestJSONSchema(t *testing.T) {
+	current := jsonSchemaFields(t)
+	version, hash, recorded := readSchemaGolden(t)
+
+	present := map[string]bool{}
+	for _, f := range current {
+		present[f] = true
+	}
+	var removed []string
+	for _, f := range recorded {
+		if !present[f] {
+			removed = append(removed, f)
+		}
+	}
+
+	if *updateSchema {
+		if len(removed) > 0 && version == output.SchemaVersion {
+			t.Fatalf("fields renamed, removed, or retyped without bumping output.SchemaVersion (%s):\n  %s",
+				version, strings.Join(removed, "\n  "))
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "# schemaVersion %s\n# sha256 %s\n", output.SchemaVersion, schemaHash(current))
+		for _, f := range current {
+			b.WriteString(f + "\n")
+		}
+		if err := os.WriteFile(schemaGolden, []byte(b.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	if version != output.SchemaVersion {
+		t.Fatalf("golden file records schemaVersion %s but output.SchemaVersion is %s; run go test ./pkg/api -run TestJSONSchema -update",
+			version, output.SchemaVersion)
+	}
+	if got := schemaHash(current); got != hash {
+		if len(removed) > 0 {
+			t.Fatalf("JSON fields renamed, removed, or retyped; this is a breaking change, so bump output.SchemaVersion and run go test ./pkg/api -run TestJSONSchema -update:\n  %s",
+				strings.Join(removed, "\n  "))
+		}
+		t.Fatalf("JSON fields added; run go test ./pkg/api -run TestJSONSchema -update to record them (no version bump needed)")
+	}
+}
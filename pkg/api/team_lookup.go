@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/roboalchemist/linear-cli/pkg/utils"
+)
+
+// uuidPattern matches Linear's entity IDs
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// TeamLookupError is returned by GetTeam when a reference matches no team,
+// or matches more than one team by name
+type TeamLookupError struct {
+	Ref         string
+	Ambiguous   bool
+	Candidates  []string // "KEY (Name)" for each candidate team
+	Suggestions []string // keys of the teams closest to Ref by key or name
+}
+
+// ClosestMatches returns the suggested team keys, for output.Error; an
+// ambiguous name has none
+func (e *TeamLookupError) ClosestMatches() []string {
+	if e.Ambiguous {
+		return nil
+	}
+	return e.Suggestions
+}
+
+func (e *TeamLookupError) Error() string {
+	if e.Ambiguous {
+		return fmt.Sprintf("team name %q is ambiguous: matches %s; use the team key instead", e.Ref, strings.Join(e.Candidates, ", "))
+	}
+	if len(e.Suggestions) > 0 {
+		return fmt.Sprintf("no team matches %q by UUID, key, or name; %s", e.Ref, utils.DidYouMean(e.Suggestions))
+	}
+	if len(e.Candidates) == 0 {
+		return fmt.Sprintf("no team matches %q by UUID, key, or name", e.Ref)
+	}
+	return fmt.Sprintf("no team matches %q by UUID, key, or name; teams: %s", e.Ref, strings.Join(e.Candidates, ", "))
+}
+
+// suggestTeams returns the keys of the teams whose key or name is closest
+// to ref, so "ENB" and "Enginering" both suggest ENG
+func suggestTeams(teams []Team, ref string) []string {
+	keyOf := make(map[string]string, 2*len(teams))
+	var candidates []string
+	for _, t := range teams {
+		keyOf[strings.ToLower(t.Key)] = t.Key
+		keyOf[strings.ToLower(t.Name)] = t.Key
+		candidates = append(candidates, t.Key, t.Name)
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	for _, match := range utils.Suggest(ref, candidates, len(candidates)) {
+		key := keyOf[strings.ToLower(match)]
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) > utils.MaxSuggestions {
+		keys = keys[:utils.MaxSuggestions]
+	}
+	return keys
+}
+
+// GetTeam returns a single team by UUID, key, or name, tried in that order.
+// A name must match exactly one team, ignoring case.
+func (c *Client) GetTeam(ctx context.Context, ref string) (*Team, error) {
+	if uuidPattern.MatchString(ref) {
+		return c.getTeamByID(ctx, ref)
+	}
+
+	// team(id:) also accepts a key, which saves listing every team
+	team, err := c.getTeamByID(ctx, ref)
+	if err == nil && team.ID != "" && strings.EqualFold(team.Key, ref) {
+		return team, nil
+	}
+	var apiErr *APIError
+	if err != nil && !errors.As(err, &apiErr) {
+		return nil, err
+	}
+
+	var teams []Team
+	after := ""
+	for {
+		page, err := c.GetTeams(ctx, 250, after, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list teams: %w", err)
+		}
+		teams = append(teams, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+
+	id, err := matchTeam(teams, ref)
+	if err != nil {
+		return nil, err
+	}
+	return c.getTeamByID(ctx, id)
+}
+
+// matchTeam picks the team whose key matches ref, or else the only team
+// whose name matches it, ignoring case
+func matchTeam(teams []Team, ref string) (string, error) {
+	for _, t := range teams {
+		if strings.EqualFold(t.Key, ref) {
+			return t.ID, nil
+		}
+	}
+
+	var matches []Team
+	for _, t := range teams {
+		if strings.EqualFold(t.Name, ref) {
+			matches = append(matches, t)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0].ID, nil
+	}
+
+	lookupErr := &TeamLookupError{Ref: ref, Ambiguous: len(matches) > 1}
+	if !lookupErr.Ambiguous {
+		matches = teams
+		lookupErr.Suggestions = suggestTeams(teams, ref)
+	}
+	for _, t := range matches {
+		lookupErr.Candidates = append(lookupErr.Candidates, fmt.Sprintf("%s (%s)", t.Key, t.Name))
+	}
+	return "", lookupErr
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	engID  = "11111111-1111-1111-1111-111111111111"
+	plaID  = "22222222-2222-2222-2222-222222222222"
+	plbID  = "33333333-3333-3333-3333-333333333333"
+	desgID = "44444444-4444-4444-4444-444444444444"
+)
+
+// teamServer answers team(id:) for UUIDs and keys, and teams() with every
+// team, recording the queries it saw
+func teamServer(t *testing.T, seen *[]string) *Client {
+	t.Helper()
+	teams := []Team{
+		{ID: engID, Key: "ENG", Name: "Engineering"},
+		{ID: plaID, Key: "PLA", Name: "Platform"},
+		{ID: plbID, Key: "PLB", Name: "platform"},
+		{ID: desgID, Key: "DES", Name: "Design"},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+			return
+		}
+		if strings.Contains(req.Query, "query Teams(") {
+			*seen = append(*seen, "teams")
+			data, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"teams": Teams{Nodes: teams}}})
+			w.Write(data)
+			return
+		}
+		id := fmt.Sprint(req.Variables["id"])
+		*seen = append(*seen, "team:"+id)
+		for _, team := range teams {
+			if team.ID == id || team.Key == id {
+				data, _ := json.Marshal(map[string]interface{}{"data": map[string]interface{}{"team": team}})
+				w.Write(data)
+				return
+			}
+		}
+		w.Write([]byte(`{"data":null,"errors":[{"message":"Entity not found: Team","extensions":{"code":"INPUT_ERROR","userPresentableMessage":"Could not find referenced Team."}}]}`))
+	}))
+	t.Cleanup(server.Close)
+	return NewClientWithURL(server.URL, "key")
+}
+
+func TestGetTeamResolution(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		wantID  string
+		queries string
+	}{
+		{"uuid", engID, engID, "team:" + engID},
+		{"key", "ENG", engID, "team:ENG"},
+		{"lowercase key", "eng", engID, "team:eng,teams,team:" + engID},
+		{"name", "design", desgID, "team:design,teams,team:" + desgID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen []string
+			team, err := teamServer(t, &seen).GetTeam(context.Background(), tt.ref)
+			if err != nil {
+				t.Fatalf("GetTeam(%q): %v", tt.ref, err)
+			}
+			if team.ID != tt.wantID {
+				t.Errorf("GetTeam(%q) = %s, want %s", tt.ref, team.ID, tt.wantID)
+			}
+			if got := strings.Join(seen, ","); got != tt.queries {
+				t.Errorf("queries = %s, want %s", got, tt.queries)
+			}
+		})
+	}
+}
+
+func TestGetTeamAmbiguousName(t *testing.T) {
+	var seen []string
+	_, err := teamServer(t, &seen).GetTeam(context.Background(), "Platform")
+	var lookupErr *TeamLookupError
+	if !errors.As(err, &lookupErr) || !lookupErr.Ambiguous {
+		t.Fatalf("err = %v, want ambiguous TeamLookupError", err)
+	}
+	want := `team name "Platform" is ambiguous: matches PLA (Platform), PLB (platform); use the team key instead`
+	if err.Error() != want {
+		t.Errorf("error = %q\nwant %q", err.Error(), want)
+	}
+}
+
+func TestGetTeamNotFound(t *testing.T) {
+	var seen []string
+	_, err := teamServer(t, &seen).GetTeam(context.Background(), "Marketing")
+	var lookupErr *TeamLookupError
+	if !errors.As(err, &lookupErr) || lookupErr.Ambiguous {
+		t.Fatalf("err = %v, want not-found TeamLookupError", err)
+	}
+	if len(lookupErr.Candidates) != 4 || lookupErr.Candidates[0] != "ENG (Engineering)" {
+		t.Errorf("candidates = %v", lookupErr.Candidates)
+	}
+}
+
+func TestGetTeamNotFoundSuggestions(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"ENB", `no team matches "ENB" by UUID, key, or name; did you mean: ENG?`},
+		{"Desgn", `no team matches "Desgn" by UUID, key, or name; did you mean: DES?`},
+		{"Marketing", `no team matches "Marketing" by UUID, key, or name; teams: ENG (Engineering), PLA (Platform), PLB (platform), DES (Design)`},
+	}
+	for _, tt := range tests {
+		var seen []string
+		_, err := teamServer(t, &seen).GetTeam(context.Background(), tt.ref)
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("GetTeam(%q) error = %v\nwant %s", tt.ref, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Package atomicfile writes the CLI's credential and state files so that
+// concurrent linear-cli processes never leave one truncated or interleaved.
+//
+// A write goes to a temporary file in the same directory, is synced, and is
+// renamed over the target, so readers see either the old or the new contents.
+// Writers also take an advisory lock on a "<file>.lock" sidecar, which keeps
+// read-modify-write updates (such as appending to the journal) from losing
+// each other's changes. Readers never take the lock; the lock file is left in
+// place until the file itself is deleted, and a lock held by a process that
+// died is released by the OS.
+package atomicfile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LockTimeout is how long a writer waits for another process to finish
+var LockTimeout = 3 * time.Second
+
+// lockPollInterval is how often a waiting writer retries the lock
+const lockPollInterval = 20 * time.Millisecond
+
+// ErrLocked is returned when the lock can't be taken within LockTimeout
+var ErrLocked = errors.New("another linear-cli process is writing")
+
+// Lock takes the write lock for path, waiting up to LockTimeout. The
+// returned function releases it.
+func Lock(path string) (unlock func(), err error) {
+	f, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(LockTimeout)
+	for {
+		locked, err := tryLock(f)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if locked {
+			return func() {
+				unlockFile(f)
+				f.Close()
+			}, nil
+		}
+		if time.Now().After(deadline) {
+			f.Close()
+			return nil, fmt.Errorf("%w %s (waited %s); try again", ErrLocked, path, LockTimeout)
+		}
+		time.Sleep(lockPollInterval)
+	}
+}
+
+// RemoveLock deletes path's lock file. Callers deleting path call it while
+// still holding the lock, so the lock file doesn't outlive what it guarded.
+func RemoveLock(path string) error {
+	if err := os.Remove(path + ".lock"); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// WriteFile atomically replaces path with data while holding its lock
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	unlock, err := Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return Write(path, data, perm)
+}
+
+// Write atomically replaces path with data. Callers updating a file based on
+// its current contents hold Lock around the read and the Write.
+func Write(path string, data []byte, perm os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmp.Name()
+	fail := func(err error) error {
+		tmp.Close()
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		return fail(err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		return fail(err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fail(err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
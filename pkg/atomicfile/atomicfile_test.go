@@ -0,0 +1,54 @@
+package atomicfile
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWriteFileReplacesAtomically(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "state.json")
+	if err := os.WriteFile(path, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := WriteFile(path, []byte("new"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "new" {
+		t.Errorf("contents = %q", data)
+	}
+	if info, _ := os.Stat(path); info.Mode().Perm() != 0600 {
+		t.Errorf("mode = %v", info.Mode())
+	}
+	entries, _ := os.ReadDir(dir)
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ".tmp") {
+			t.Errorf("temp file left behind: %s", e.Name())
+		}
+	}
+}
+
+func TestLockTimesOut(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth.json")
+	defer func(d time.Duration) { LockTimeout = d }(LockTimeout)
+	LockTimeout = 50 * time.Millisecond
+
+	unlock, err := Lock(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = WriteFile(path, []byte("{}"), 0600)
+	if !errors.Is(err, ErrLocked) || !strings.Contains(err.Error(), "another linear-cli process is writing "+path) {
+		t.Errorf("write while locked: %v", err)
+	}
+
+	// Once released, the lock file left behind doesn't get in the way
+	unlock()
+	if err := WriteFile(path, []byte("{}"), 0600); err != nil {
+		t.Errorf("write after unlock: %v", err)
+	}
+}
@@ -4,12 +4,18 @@ import (
 	"bufio"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/roboalchemist/linear-cli/pkg/atomicfile"
 	"github.com/fatih/color"
 )
 
@@ -18,10 +24,79 @@ type User struct {
 	Name      string `json:"name"`
 	Email     string `json:"email"`
 	AvatarURL string `json:"avatarUrl,omitempty"`
+	Admin     bool   `json:"admin"`
 }
 
+// AuthConfig is the on-disk credential store. An OAuth access token (with its
+// granted scopes and expiry) may be stored instead of a Personal API Key.
 type AuthConfig struct {
-	APIKey string `json:"api_key,omitempty"`
+	APIKey      string     `json:"api_key,omitempty"`
+	AccessToken string     `json:"access_token,omitempty"`
+	Scopes      []string   `json:"scopes,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
+
+// Auth methods reported by GetCapabilities
+const (
+	MethodAPIKey = "api_key"
+	MethodOAuth  = "oauth"
+)
+
+// ExpiryWarningWindow is how long before expiry an OAuth token is flagged as expiring soon
+const ExpiryWarningWindow = 7 * 24 * time.Hour
+
+// ErrReadOnlyToken is returned by the pre-flight check when a mutation is
+// attempted with a token that lacks write scope.
+var ErrReadOnlyToken = errors.New("token is read-only")
+
+// Capabilities describes what the current credentials are allowed to do
+type Capabilities struct {
+	Method    string     `json:"method"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ReadOnly reports whether the granted scopes only allow reads.
+// Unknown scopes (e.g. Personal API Keys) are assumed to allow writes.
+func (c *Capabilities) ReadOnly() bool {
+	if len(c.Scopes) == 0 {
+		return false
+	}
+	for _, scope := range c.Scopes {
+		if scope != "read" {
+			return false
+		}
+	}
+	return true
+}
+
+// ExpiresIn returns the time remaining until the token expires, or nil if it does not expire
+func (c *Capabilities) ExpiresIn(now time.Time) *time.Duration {
+	if c.ExpiresAt == nil {
+		return nil
+	}
+	d := c.ExpiresAt.Sub(now)
+	return &d
+}
+
+// ExpiringSoon reports whether the token expires within ExpiryWarningWindow
+func (c *Capabilities) ExpiringSoon(now time.Time) bool {
+	d := c.ExpiresIn(now)
+	return d != nil && *d < ExpiryWarningWindow
+}
+
+// isOAuthToken reports whether a raw token is an OAuth access token rather than a Personal API Key
+func isOAuthToken(token string) bool {
+	return strings.HasPrefix(token, "Bearer ") || strings.HasPrefix(token, "lin_oauth_")
+}
+
+// headerForToken returns the Authorization header value for a raw token.
+// OAuth access tokens are sent as Bearer tokens; Personal API Keys are sent as-is.
+func headerForToken(token string) string {
+	if strings.HasPrefix(token, "lin_oauth_") {
+		return "Bearer " + token
+	}
+	return token
 }
 
 // getConfigPath returns the path to the auth config file.
@@ -42,7 +117,13 @@ func getConfigPath() (string, error) {
 	return newPath, nil
 }
 
-// saveAuth saves authentication credentials
+// ConfigPath returns the auth config file in use, or where it would be written
+func ConfigPath() (string, error) {
+	return getConfigPath()
+}
+
+// saveAuth saves authentication credentials. The file is replaced
+// atomically, so a parallel linear-cli never reads it half-written.
 func saveAuth(config AuthConfig) error {
 	configPath, err := getConfigPath()
 	if err != nil {
@@ -54,11 +135,40 @@ func saveAuth(config AuthConfig) error {
 		return err
 	}
 
-	return os.WriteFile(configPath, data, 0600)
+	dropCachedConfig()
+	return atomicfile.WriteFile(configPath, data, 0600)
+}
+
+// cacheCredentials makes loadAuth keep the first config it reads; see CacheCredentials
+var (
+	cacheMu          sync.Mutex
+	cacheCredentials bool
+	cachedConfig     *AuthConfig
+)
+
+func dropCachedConfig() {
+	cacheMu.Lock()
+	cachedConfig = nil
+	cacheMu.Unlock()
+}
+
+// CacheCredentials makes later lookups reuse the credentials read from the
+// config file instead of reading it on every command. Long-running modes such
+// as 'serve --stdio' enable it. Saving or clearing credentials drops the cache.
+func CacheCredentials() {
+	cacheMu.Lock()
+	cacheCredentials = true
+	cacheMu.Unlock()
 }
 
 // loadAuth loads authentication credentials
 func loadAuth() (*AuthConfig, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+	if cachedConfig != nil {
+		return cachedConfig, nil
+	}
+
 	configPath, err := getConfigPath()
 	if err != nil {
 		return nil, err
@@ -67,7 +177,7 @@ func loadAuth() (*AuthConfig, error) {
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil, fmt.Errorf("not authenticated")
+			return nil, ErrNotAuthenticated
 		}
 		return nil, err
 	}
@@ -78,6 +188,9 @@ func loadAuth() (*AuthConfig, error) {
 		return nil, err
 	}
 
+	if cacheCredentials {
+		cachedConfig = &config
+	}
 	return &config, nil
 }
 
@@ -91,7 +204,7 @@ func GetAuthSource() string {
 		return "env:LINCTL_API_KEY"
 	}
 	config, err := loadAuth()
-	if err == nil && config.APIKey != "" {
+	if err == nil && (config.APIKey != "" || config.AccessToken != "") {
 		return "config"
 	}
 	return ""
@@ -102,26 +215,93 @@ func GetAuthSource() string {
 //  1. LINEAR_API_KEY environment variable
 //  2. LINCTL_API_KEY environment variable (legacy alias)
 //  3. Config file (~/.linear-cli-auth.json or ~/.linctl-auth.json)
+//
+// With none of them it runs the first-run step, onboarding or setup help,
+// if one is set up.
 func GetAuthHeader() (string, error) {
 	if key := os.Getenv("LINEAR_API_KEY"); key != "" {
-		return key, nil
+		return headerForToken(key), nil
 	}
 	if key := os.Getenv("LINCTL_API_KEY"); key != "" {
-		return key, nil
+		return headerForToken(key), nil
 	}
 
 	config, err := loadAuth()
+	if errors.Is(err, ErrNotAuthenticated) {
+		stored, onboardErr := handleMissingCredentials()
+		if onboardErr != nil {
+			return "", onboardErr
+		}
+		if stored {
+			config, err = loadAuth()
+		}
+	}
 	if err != nil {
 		return "", err
 	}
 
+	if config.AccessToken != "" {
+		return "Bearer " + strings.TrimPrefix(config.AccessToken, "Bearer "), nil
+	}
 	if config.APIKey != "" {
-		return config.APIKey, nil
+		return headerForToken(config.APIKey), nil
 	}
 
 	return "", fmt.Errorf("no valid authentication found")
 }
 
+// GetCapabilities returns the auth method, scopes and expiry of the current credentials.
+// Scopes and expiry are only known for OAuth tokens stored in the config file.
+func GetCapabilities() (*Capabilities, error) {
+	for _, env := range []string{"LINEAR_API_KEY", "LINCTL_API_KEY"} {
+		if key := os.Getenv(env); key != "" {
+			caps := &Capabilities{Method: MethodAPIKey}
+			if isOAuthToken(key) {
+				caps.Method = MethodOAuth
+			}
+			return caps, nil
+		}
+	}
+
+	config, err := loadAuth()
+	if err != nil {
+		return nil, err
+	}
+	if config.AccessToken != "" {
+		return &Capabilities{
+			Method:    MethodOAuth,
+			Scopes:    config.Scopes,
+			ExpiresAt: config.ExpiresAt,
+		}, nil
+	}
+	if config.APIKey != "" {
+		caps := &Capabilities{Method: MethodAPIKey}
+		if isOAuthToken(config.APIKey) {
+			caps.Method = MethodOAuth
+		}
+		return caps, nil
+	}
+	return nil, fmt.Errorf("no valid authentication found")
+}
+
+// CheckWriteAccess is an api.PreflightFunc that rejects mutations when the
+// current token only has read scope, instead of letting the API return an
+// opaque permission error.
+func CheckWriteAccess(query string, isMutation bool) error {
+	if !isMutation {
+		return nil
+	}
+	caps, err := GetCapabilities()
+	if err != nil {
+		// Let the request proceed; the API reports missing credentials itself
+		return nil
+	}
+	if caps.ReadOnly() {
+		return fmt.Errorf("%w (scopes: %s); this command needs a token with write scope", ErrReadOnlyToken, strings.Join(caps.Scopes, ", "))
+	}
+	return nil
+}
+
 // Login handles the authentication flow
 func Login(plaintext, jsonOut bool) error {
 	return loginWithAPIKey(plaintext, jsonOut)
@@ -151,7 +331,7 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	}
 
 	// Test the API key
-	client := api.NewClient(apiKey)
+	client := api.NewClient(headerForToken(apiKey))
 	user, err := client.GetViewer(context.Background())
 	if err != nil {
 		return fmt.Errorf("invalid API key: %v", err)
@@ -195,20 +375,145 @@ func GetCurrentUser() (*User, error) {
 		Name:      apiUser.Name,
 		Email:     apiUser.Email,
 		AvatarURL: apiUser.AvatarURL,
+		Admin:     apiUser.Admin,
 	}, nil
 }
 
-// Logout clears stored credentials
-func Logout() error {
-	configPath, err := getConfigPath()
+// APIKeySettingsURL is where Personal API Keys are listed and revoked
+const APIKeySettingsURL = "https://linear.app/settings/api"
+
+// RevokeURL is Linear's OAuth token revocation endpoint
+var RevokeURL = "https://api.linear.app/oauth/revoke"
+
+// LogoutResult describes what Logout removed
+type LogoutResult struct {
+	// Removed lists the credential files deleted
+	Removed []string `json:"removed"`
+	// Credential is what the files held, nil when nothing was stored
+	Credential *AuthConfig `json:"-"`
+}
+
+// Method reports how the removed credential authenticated: MethodOAuth,
+// MethodAPIKey, or "" when nothing was stored
+func (r *LogoutResult) Method() string {
+	switch {
+	case r.Credential == nil:
+		return ""
+	case r.Credential.AccessToken != "" || isOAuthToken(r.Credential.APIKey):
+		return MethodOAuth
+	case r.Credential.APIKey != "":
+		return MethodAPIKey
+	}
+	return ""
+}
+
+// Token returns the removed credential's raw token
+func (r *LogoutResult) Token() string {
+	if r.Credential == nil {
+		return ""
+	}
+	if r.Credential.AccessToken != "" {
+		return strings.TrimPrefix(r.Credential.AccessToken, "Bearer ")
+	}
+	return strings.TrimPrefix(r.Credential.APIKey, "Bearer ")
+}
+
+// credentialPaths lists every file a credential may be stored in, the
+// current one first. Removing only the current one would let a legacy file
+// take over.
+func credentialPaths() ([]string, error) {
+	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return []string{
+		filepath.Join(homeDir, ".linear-cli-auth.json"),
+		filepath.Join(homeDir, ".linctl-auth.json"),
+	}, nil
+}
 
-	err = os.Remove(configPath)
-	if err != nil && !os.IsNotExist(err) {
-		return err
+// Logout deletes the stored credentials, overwriting each file before
+// removing it. A missing file is not an error.
+func Logout() (*LogoutResult, error) {
+	paths, err := credentialPaths()
+	if err != nil {
+		return nil, err
+	}
+
+	dropCachedConfig()
+	result := &LogoutResult{Removed: []string{}}
+	for _, path := range paths {
+		removed, config, err := removeCredentialFile(path)
+		if err != nil {
+			return result, err
+		}
+		if removed {
+			result.Removed = append(result.Removed, path)
+			if result.Credential == nil {
+				result.Credential = config
+			}
+		}
+	}
+	return result, nil
+}
+
+// removeCredentialFile reads, overwrites, and deletes one credential file,
+// returning what it held, then its lock file. A missing file is skipped
+// without locking, so logout leaves no lock files behind. The overwrite is
+// best effort: a file system that doesn't allow it still gets the file
+// removed.
+func removeCredentialFile(path string) (bool, *AuthConfig, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil, nil
+	}
+	unlock, err := atomicfile.Lock(path)
+	if err != nil {
+		return false, nil, err
+	}
+	defer unlock()
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	var config AuthConfig
+	if json.Unmarshal(data, &config) != nil {
+		config = AuthConfig{}
 	}
 
+	if f, err := os.OpenFile(path, os.O_WRONLY, 0); err == nil {
+		_, _ = f.Write(make([]byte, len(data)))
+		_ = f.Sync()
+		f.Close()
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return false, nil, err
+	}
+	if err := atomicfile.RemoveLock(path); err != nil {
+		return false, nil, err
+	}
+	return true, &config, nil
+}
+
+// RevokeToken asks Linear to revoke an OAuth access token, so it stops
+// working everywhere it was copied to
+func RevokeToken(ctx context.Context, token string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, RevokeURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimPrefix(token, "Bearer "))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("revocation failed: %s %s", resp.Status, strings.TrimSpace(string(body)))
+	}
 	return nil
 }
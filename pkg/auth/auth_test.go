@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// TestSaveAuthConcurrent hammers the credential store from many goroutines,
+// as parallel linear-cli processes would, and checks the file always parses
+func TestSaveAuthConcurrent(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(home, ".linear-cli-auth.json")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 20*25*2)
+	for g := 0; g < 20; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 25; i++ {
+				// Vary the length so a torn write would show up as bad JSON
+				key := fmt.Sprintf("lin_api_%d_%d_%s", g, i, strings.Repeat("x", (g*i)%64))
+				if err := saveAuth(AuthConfig{APIKey: key, Scopes: []string{"read", "write"}}); err != nil {
+					errs <- fmt.Errorf("save: %w", err)
+					continue
+				}
+				data, err := os.ReadFile(path)
+				if err != nil {
+					errs <- fmt.Errorf("read: %w", err)
+					continue
+				}
+				var config AuthConfig
+				if err := json.Unmarshal(data, &config); err != nil || config.APIKey == "" {
+					errs <- fmt.Errorf("file doesn't parse (%v): %q", err, data)
+				}
+				if _, err := loadAuth(); err != nil {
+					errs <- fmt.Errorf("loadAuth: %w", err)
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestLogoutRemovesEveryCredentialFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	current := filepath.Join(home, ".linear-cli-auth.json")
+	legacy := filepath.Join(home, ".linctl-auth.json")
+	if err := os.WriteFile(current, []byte(`{"access_token":"lin_oauth_abc","scopes":["read"]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(legacy, []byte(`{"api_key":"lin_api_old"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := Logout()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Removed) != 2 || result.Method() != MethodOAuth || result.Token() != "lin_oauth_abc" {
+		t.Errorf("result = %+v, method %q", result, result.Method())
+	}
+	for _, path := range []string{current, legacy, current + ".lock", legacy + ".lock"} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s still exists: %v", path, err)
+		}
+	}
+	if _, err := GetAuthHeader(); err == nil || err.Error() != "not authenticated" {
+		t.Errorf("after logout GetAuthHeader = %v", err)
+	}
+
+	// Logging out again finds nothing to remove
+	result, err = Logout()
+	if err != nil || len(result.Removed) != 0 || result.Method() != "" {
+		t.Errorf("second logout = %+v, %v", result, err)
+	}
+	if entries, _ := os.ReadDir(home); len(entries) != 0 {
+		t.Errorf("second logout left %d files in HOME, e.g. %s", len(entries), entries[0].Name())
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Method + " " + r.Header.Get("Authorization")
+		if strings.HasSuffix(got, "bad") {
+			http.Error(w, "invalid token", http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+	defer func(previous string) { RevokeURL = previous }(RevokeURL)
+	RevokeURL = srv.URL
+
+	if err := RevokeToken(context.Background(), "lin_oauth_abc"); err != nil || got != "POST Bearer lin_oauth_abc" {
+		t.Errorf("revoke = %v, request %q", err, got)
+	}
+	if err := RevokeToken(context.Background(), "bad"); err == nil || !strings.Contains(err.Error(), "invalid token") {
+		t.Errorf("bad token err = %v", err)
+	}
+}
@@ -0,0 +1,24 @@
+//go:build windows
+
+package auth
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// disableEcho stops the console on f from showing what is typed, and
+// returns the function that turns it back on
+func disableEcho(f *os.File) (func(), error) {
+	handle := windows.Handle(f.Fd())
+	var old uint32
+	if err := windows.GetConsoleMode(handle, &old); err != nil {
+		return nil, err
+	}
+	quiet := old&^windows.ENABLE_ECHO_INPUT | windows.ENABLE_PROCESSED_INPUT | windows.ENABLE_LINE_INPUT
+	if err := windows.SetConsoleMode(handle, quiet); err != nil {
+		return nil, err
+	}
+	return func() { _ = windows.SetConsoleMode(handle, old) }, nil
+}
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+	"github.com/fatih/color"
+)
+
+// onboardAttempts is how many keys Onboard accepts before giving up
+const onboardAttempts = 3
+
+// ErrNotAuthenticated means no credentials were found anywhere: no
+// LINEAR_API_KEY and no auth file
+var ErrNotAuthenticated = errors.New("not authenticated")
+
+// SetupHelp is the commands that fix ErrNotAuthenticated, for when
+// onboarding can't ask
+func SetupHelp() string {
+	return fmt.Sprintf(`To authenticate, create a Personal API Key at %s, then either:
+  linear-cli auth login                 # paste the key; it's stored in ~/.linear-cli-auth.json
+  export LINEAR_API_KEY=lin_api_...     # or set it for this shell (OAuth tokens work too)
+`, APIKeySettingsURL)
+}
+
+// Prompts is how Onboard talks to the user. TerminalPrompts is the real
+// thing; tests supply their own.
+type Prompts struct {
+	// Out receives the explanations and results
+	Out io.Writer
+	// Ask shows question and reads a line of input
+	Ask func(question string) (string, error)
+	// AskSecret is Ask without echoing the input
+	AskSecret func(question string) (string, error)
+	// OpenURL opens a page in the browser
+	OpenURL func(url string) error
+}
+
+// TerminalPrompts asks on stderr and reads stdin, hiding the key as it is
+// typed where the terminal allows
+func TerminalPrompts() Prompts {
+	reader := bufio.NewReader(os.Stdin)
+	readLine := func() (string, error) {
+		line, err := reader.ReadString('\n')
+		if err != nil && (line == "" || !errors.Is(err, io.EOF)) {
+			return "", err
+		}
+		return strings.TrimSpace(line), nil
+	}
+	return Prompts{
+		Out: os.Stderr,
+		Ask: func(question string) (string, error) {
+			fmt.Fprint(os.Stderr, question)
+			return readLine()
+		},
+		AskSecret: func(question string) (string, error) {
+			fmt.Fprint(os.Stderr, question)
+			restore, err := disableEcho(os.Stdin)
+			if err != nil {
+				// Can't hide it here, so say so rather than pretend
+				fmt.Fprint(os.Stderr, "(input will be visible) ")
+				return readLine()
+			}
+			line, err := readLine()
+			restore()
+			fmt.Fprintln(os.Stderr)
+			return line, err
+		},
+		OpenURL: openBrowser,
+	}
+}
+
+// Onboard walks a first-time user through signing in: it explains the two
+// ways to authenticate, offers to open the API settings page, reads a pasted
+// Personal API Key, checks it against Linear, and stores it. It returns the
+// user the key belongs to.
+func Onboard(p Prompts) (*User, error) {
+	configPath, _ := getConfigPath()
+	bold := color.New(color.Bold)
+	fmt.Fprintln(p.Out, color.New(color.FgCyan, color.Bold).Sprint("👋 Welcome to linear-cli! You're not signed in to Linear yet."))
+	fmt.Fprintln(p.Out)
+	fmt.Fprintln(p.Out, "There are two ways to authenticate:")
+	fmt.Fprintf(p.Out, "  %s create one under Settings > API and paste it here.\n", bold.Sprint("1. Personal API Key (recommended):"))
+	fmt.Fprintf(p.Out, "     It's stored in %s.\n", configPath)
+	fmt.Fprintf(p.Out, "  %s set LINEAR_API_KEY=lin_oauth_... in your environment\n", bold.Sprint("2. OAuth access token:"))
+	fmt.Fprintln(p.Out, "     and run the command again; nothing is stored.")
+	fmt.Fprintln(p.Out)
+
+	answer, err := p.Ask(fmt.Sprintf("Open %s in your browser? [Y/n] ", APIKeySettingsURL))
+	if err != nil {
+		return nil, err
+	}
+	if answer = strings.ToLower(answer); answer == "" || answer == "y" || answer == "yes" {
+		if err := p.OpenURL(APIKeySettingsURL); err != nil {
+			fmt.Fprintf(p.Out, "Couldn't open a browser (%v); visit %s\n", err, APIKeySettingsURL)
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		key, err := p.AskSecret("Paste your Personal API Key (empty to cancel): ")
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return nil, fmt.Errorf("sign-in cancelled")
+		}
+
+		viewer, err := api.NewClient(headerForToken(key)).GetViewer(context.Background())
+		if err != nil {
+			if attempt == onboardAttempts {
+				return nil, fmt.Errorf("invalid API key: %v", err)
+			}
+			fmt.Fprintf(p.Out, "%s That key didn't work: %v\n", color.New(color.FgRed).Sprint("❌"), err)
+			continue
+		}
+
+		config := AuthConfig{APIKey: key}
+		if isOAuthToken(key) {
+			config = AuthConfig{AccessToken: key}
+		}
+		if err := saveAuth(config); err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(p.Out, "%s Authenticated as %s (%s)\n\n",
+			color.New(color.FgGreen).Sprint("✅"),
+			color.New(color.FgCyan).Sprint(viewer.Name),
+			color.New(color.FgCyan).Sprint(viewer.Email))
+		return &User{ID: viewer.ID, Name: viewer.Name, Email: viewer.Email, AvatarURL: viewer.AvatarURL, Admin: viewer.Admin}, nil
+	}
+}
+
+// firstRun is what GetAuthHeader does when there are no credentials; see
+// EnableOnboarding and ShowSetupHelp, which each allow it once more.
+var firstRun struct {
+	sync.Mutex
+	prompts *Prompts
+	help    io.Writer
+	done    bool
+}
+
+// EnableOnboarding makes a command that finds no credentials run Onboard
+// with p and then carry on with the stored key
+func EnableOnboarding(p Prompts) {
+	firstRun.Lock()
+	defer firstRun.Unlock()
+	firstRun.prompts, firstRun.help, firstRun.done = &p, nil, false
+}
+
+// ShowSetupHelp makes a command that finds no credentials write SetupHelp
+// to w before failing, or nothing when w is nil
+func ShowSetupHelp(w io.Writer) {
+	firstRun.Lock()
+	defer firstRun.Unlock()
+	firstRun.prompts, firstRun.help, firstRun.done = nil, w, false
+}
+
+// handleMissingCredentials runs the first-run step for ErrNotAuthenticated.
+// It reports whether credentials were stored, so the caller can look again.
+func handleMissingCredentials() (bool, error) {
+	firstRun.Lock()
+	defer firstRun.Unlock()
+	if firstRun.done {
+		return false, nil
+	}
+	firstRun.done = true
+	if firstRun.prompts == nil {
+		if firstRun.help != nil {
+			fmt.Fprint(firstRun.help, SetupHelp())
+		}
+		return false, nil
+	}
+	if _, err := Onboard(*firstRun.prompts); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// openBrowser opens url with the desktop's handler for it
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
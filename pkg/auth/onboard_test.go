@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/linearmock"
+)
+
+// scriptedPrompts answers Onboard from canned lines
+func scriptedPrompts(out *strings.Builder, opened *[]string, answers ...string) Prompts {
+	next := func(question string) (string, error) {
+		out.WriteString(question)
+		if len(answers) == 0 {
+			return "", errors.New("unexpected question: " + question)
+		}
+		answer := answers[0]
+		answers = answers[1:]
+		return answer, nil
+	}
+	return Prompts{
+		Out:       out,
+		Ask:       next,
+		AskSecret: next,
+		OpenURL: func(url string) error {
+			*opened = append(*opened, url)
+			return nil
+		},
+	}
+}
+
+func TestOnboardingStoresKeyAndCarriesOn(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("LINEAR_API_KEY", "")
+	t.Setenv("LINCTL_API_KEY", "")
+	s := linearmock.New(t)
+	s.Install()
+	s.Data("Me", `{"viewer":{"id":"u1","name":"Ada Lovelace","email":"ada@example.com"}}`)
+	s.Add(linearmock.Stub{Operation: "Me", Times: 1,
+		Response: json.RawMessage(`{"errors":[{"message":"Authentication required, not authenticated"}]}`)})
+
+	var out strings.Builder
+	var opened []string
+	EnableOnboarding(scriptedPrompts(&out, &opened, "y", "lin_api_typo", "lin_api_good"))
+	defer ShowSetupHelp(nil)
+
+	header, err := GetAuthHeader()
+	if err != nil || header != "lin_api_good" {
+		t.Fatalf("GetAuthHeader after onboarding = %q, %v\n%s", header, err, out.String())
+	}
+	if len(opened) != 1 || opened[0] != APIKeySettingsURL {
+		t.Errorf("opened %v", opened)
+	}
+	for _, part := range []string{"Personal API Key", "OAuth", "didn't work", "Authenticated as"} {
+		if !strings.Contains(out.String(), part) {
+			t.Errorf("onboarding output is missing %q:\n%s", part, out.String())
+		}
+	}
+	data, err := os.ReadFile(filepath.Join(home, ".linear-cli-auth.json"))
+	if err != nil || !strings.Contains(string(data), `"api_key": "lin_api_good"`) {
+		t.Errorf("stored credentials = %s, %v", data, err)
+	}
+}
+
+func TestOnboardingCancelAndSetupHelp(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LINEAR_API_KEY", "")
+	t.Setenv("LINCTL_API_KEY", "")
+
+	var out strings.Builder
+	var opened []string
+	EnableOnboarding(scriptedPrompts(&out, &opened, "n", ""))
+	if _, err := GetAuthHeader(); err == nil || err.Error() != "sign-in cancelled" || len(opened) != 0 {
+		t.Errorf("cancelled onboarding = %v, opened %v", err, opened)
+	}
+	// It isn't offered twice in one command
+	if _, err := GetAuthHeader(); !errors.Is(err, ErrNotAuthenticated) {
+		t.Errorf("second lookup = %v", err)
+	}
+
+	var help strings.Builder
+	ShowSetupHelp(&help)
+	defer ShowSetupHelp(nil)
+	if _, err := GetAuthHeader(); !errors.Is(err, ErrNotAuthenticated) || err.Error() != "not authenticated" {
+		t.Errorf("without onboarding = %v", err)
+	}
+	if !strings.Contains(help.String(), "linear-cli auth login") || !strings.Contains(help.String(), "export LINEAR_API_KEY=") {
+		t.Errorf("setup help = %q", help.String())
+	}
+}
@@ -0,0 +1,164 @@
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/atomicfile"
+)
+
+// MaxEntries caps the number of records kept in the journal file.
+// Older records are dropped once the cap is exceeded.
+const MaxEntries = 500
+
+// Inverse describes how to revert a recorded operation.
+// Action is one of "unarchive", "archive", "delete" or "update"; for
+// "update" the Input holds the field values to restore.
+type Inverse struct {
+	Action string                 `json:"action"`
+	Input  map[string]interface{} `json:"input,omitempty"`
+}
+
+// Entry is a single journal record for a successful mutating command.
+type Entry struct {
+	ID         string    `json:"id"`
+	Timestamp  time.Time `json:"timestamp"`
+	Command    string    `json:"command"`
+	EntityType string    `json:"entityType"`
+	EntityID   string    `json:"entityId"`
+	Label      string    `json:"label,omitempty"`
+	Inverse    *Inverse  `json:"inverse,omitempty"`
+	UndoOf     string    `json:"undoOf,omitempty"`
+}
+
+// Path returns the location of the journal file.
+// Uses $XDG_STATE_HOME/linear-cli/history.jsonl, defaulting to ~/.local/state.
+func Path() (string, error) {
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		stateDir = filepath.Join(homeDir, ".local", "state")
+	}
+	return filepath.Join(stateDir, "linear-cli", "history.jsonl"), nil
+}
+
+// Load reads all journal entries, oldest first. A missing journal is not an error.
+func Load() ([]Entry, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var entries []Entry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			// Skip corrupt lines rather than losing the whole journal
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Append adds an entry to the journal, filling in ID and Timestamp when unset,
+// and trims the file to MaxEntries.
+func Append(entry Entry) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	if entry.ID == "" {
+		entry.ID = strconv.FormatInt(entry.Timestamp.UnixNano(), 36)
+	}
+
+	// Hold the lock across the read so parallel commands don't drop each
+	// other's entries
+	unlock, err := atomicfile.Lock(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	entries, err := Load()
+	if err != nil {
+		return err
+	}
+	entries = append(entries, entry)
+	if len(entries) > MaxEntries {
+		entries = entries[len(entries)-MaxEntries:]
+	}
+
+	var buf bytes.Buffer
+	for _, e := range entries {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("failed to encode journal entry: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	return atomicfile.Write(path, buf.Bytes(), 0600)
+}
+
+// LastUndoable returns the most recent entry that is neither an undo record
+// itself nor already undone. Returns nil if there is nothing to undo.
+func LastUndoable(entries []Entry) *Entry {
+	undone := make(map[string]bool)
+	for _, e := range entries {
+		if e.UndoOf != "" {
+			undone[e.UndoOf] = true
+		}
+	}
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if e.UndoOf != "" || undone[e.ID] {
+			continue
+		}
+		return &entries[i]
+	}
+	return nil
+}
+
+// IsUndone reports whether an entry has been reverted by a later undo record.
+func IsUndone(entries []Entry, id string) bool {
+	for _, e := range entries {
+		if e.UndoOf == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// useTempJournal points the journal at a fresh state directory and returns
+// the journal's path
+func useTempJournal(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", dir)
+	return filepath.Join(dir, "linear-cli", "history.jsonl")
+}
+
+func TestAppendLoadRoundTrip(t *testing.T) {
+	path := useTempJournal(t)
+
+	if entries, err := Load(); err != nil || entries != nil {
+		t.Fatalf("missing journal = %v, %v; want nothing and no error", entries, err)
+	}
+
+	at := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	first := Entry{
+		Timestamp:  at,
+		Command:    "issue update",
+		EntityType: "issue",
+		EntityID:   "i1",
+		Label:      "ENG-1",
+		Inverse:    &Inverse{Action: "update", Input: map[string]interface{}{"priority": float64(2)}},
+	}
+	if err := Append(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := Append(Entry{Command: "issue archive", EntityType: "issue", EntityID: "i2", Inverse: &Inverse{Action: "unarchive"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := Load()
+	if err != nil || len(entries) != 2 {
+		t.Fatalf("Load = %d entries, %v; want 2", len(entries), err)
+	}
+	got := entries[0]
+	if got.ID == "" || !got.Timestamp.Equal(at) || got.Command != "issue update" || got.Label != "ENG-1" ||
+		got.Inverse == nil || got.Inverse.Action != "update" || got.Inverse.Input["priority"] != float64(2) {
+		t.Errorf("first entry = %+v, inverse %+v", got, got.Inverse)
+	}
+	// ID and Timestamp are filled in when unset
+	if second := entries[1]; second.ID == "" || second.Timestamp.IsZero() || second.ID == got.ID {
+		t.Errorf("second entry = %+v", second)
+	}
+
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("journal file = %v, %v; want mode 0600", info, err)
+	}
+}
+
+func TestAppendTrimsToMaxEntries(t *testing.T) {
+	path := useTempJournal(t)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	for i := 0; i < MaxEntries; i++ {
+		line, _ := json.Marshal(Entry{ID: fmt.Sprintf("e%d", i), Command: "issue update"})
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Append(Entry{ID: "newest", Command: "issue delete"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := Load()
+	if err != nil || len(entries) != MaxEntries {
+		t.Fatalf("Load = %d entries, %v; want %d", len(entries), err, MaxEntries)
+	}
+	// The oldest entry is dropped to make room
+	if entries[0].ID != "e1" || entries[len(entries)-1].ID != "newest" {
+		t.Errorf("kept %s..%s, want e1..newest", entries[0].ID, entries[len(entries)-1].ID)
+	}
+}
+
+func TestLoadSkipsCorruptLines(t *testing.T) {
+	path := useTempJournal(t)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	journal := `{"id":"a","command":"issue update"}
+{"id":"b","comm
+not json at all
+
+{"id":"c","command":"issue archive"}
+`
+	if err := os.WriteFile(path, []byte(journal), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := Load()
+	if err != nil || len(entries) != 2 || entries[0].ID != "a" || entries[1].ID != "c" {
+		t.Fatalf("Load = %+v, %v; want a and c", entries, err)
+	}
+
+	// Appending keeps the good entries and drops the corrupt ones
+	if err := Append(Entry{ID: "d", Command: "issue delete"}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if bytes.Contains(data, []byte("not json")) || bytes.Count(data, []byte("\n")) != 3 {
+		t.Errorf("journal after append:\n%s", data)
+	}
+}
+
+func TestLastUndoable(t *testing.T) {
+	entries := []Entry{
+		{ID: "a"},
+		{ID: "b"},
+		{ID: "u1", UndoOf: "b"},
+	}
+	if got := LastUndoable(entries); got == nil || got.ID != "a" {
+		t.Errorf("LastUndoable = %+v, want a: b is undone and u1 is an undo record", got)
+	}
+	if !IsUndone(entries, "b") || IsUndone(entries, "a") {
+		t.Error("IsUndone should report only b")
+	}
+	entries = append(entries, Entry{ID: "u2", UndoOf: "a"})
+	if got := LastUndoable(entries); got != nil {
+		t.Errorf("LastUndoable = %+v, want nothing left to undo", got)
+	}
+}
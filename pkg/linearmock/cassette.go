@@ -0,0 +1,167 @@
+package linearmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// Cassette is the GraphQL traffic of a test, recorded in order with volatile
+// values scrubbed
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is one recorded request and the response it got
+type Interaction struct {
+	Operation string                 `json:"operation"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// Status is the HTTP status; 0 means 200
+	Status   int             `json:"status,omitempty"`
+	Response json.RawMessage `json:"response"`
+
+	used bool
+}
+
+// UseCassette replays the cassette at path. With LINEARMOCK_RECORD=1 it
+// records it instead, from api.BaseURL with the caller's credentials,
+// scrubbing every interaction with scrub.
+//
+// To record, run the test with LINEARMOCK_RECORD=1 and real credentials;
+// the cassette is rewritten when the test ends. Scrubbing replaces IDs,
+// cursors, and timestamps but not names or other workspace data, so review
+// the diff before committing.
+func (s *Server) UseCassette(path string, scrub *Scrubber) {
+	s.t.Helper()
+	if os.Getenv(RecordEnv) != "" {
+		s.RecordCassette(api.BaseURL, path, scrub)
+		return
+	}
+	s.ReplayCassette(path)
+}
+
+// ReplayCassette answers each request with the first unused interaction in
+// the cassette at path that has its operation and matching variables (see
+// scrubbedMatch), so requests may interleave but each recorded response is
+// served once. A request no interaction matches fails the test. Stubs are
+// ignored while replaying.
+func (s *Server) ReplayCassette(path string) {
+	s.t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		s.t.Fatalf("linearmock: %v", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		s.t.Fatalf("linearmock: cassette %s: %v", path, err)
+	}
+	s.mu.Lock()
+	s.cassette = &cassette
+	s.cassettePath = path
+	s.mu.Unlock()
+	s.t.Cleanup(func() {
+		if n := s.unusedInteractions(); n > 0 {
+			s.t.Logf("linearmock: %d of %d interactions in %s were not replayed", n, len(cassette.Interactions), path)
+		}
+	})
+}
+
+// RecordCassette forwards every request to upstream, Authorization header
+// included, and writes the scrubbed traffic to path when the test ends
+func (s *Server) RecordCassette(upstream, path string, scrub *Scrubber) {
+	s.t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		s.t.Fatalf("linearmock: %v", err)
+	}
+	s.mu.Lock()
+	s.upstream = upstream
+	s.cassette = &Cassette{}
+	s.cassettePath = path
+	s.scrub = scrub
+	s.mu.Unlock()
+	s.t.Cleanup(func() {
+		if err := s.saveCassette(); err != nil {
+			s.t.Errorf("linearmock: saving cassette %s: %v", path, err)
+		}
+	})
+}
+
+// replay serves the first unused interaction matching req
+func (s *Server) replay(w http.ResponseWriter, req Request) {
+	got := normalize(req.Variables)
+	if got == nil {
+		got = map[string]interface{}{}
+	}
+	s.mu.Lock()
+	var found *Interaction
+	for i := range s.cassette.Interactions {
+		in := &s.cassette.Interactions[i]
+		if !in.used && in.Operation == req.Operation && scrubbedMatch(in.Variables, got) {
+			in.used = true
+			found = in
+			break
+		}
+	}
+	path := s.cassettePath
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if found == nil {
+		s.t.Errorf("linearmock: no interaction left in %s for operation %q with variables %v", path, req.Operation, req.Variables)
+		fmt.Fprintf(w, `{"errors":[{"message":%q}]}`, "linearmock: no recorded interaction for operation "+req.Operation)
+		return
+	}
+	if found.Status != 0 {
+		w.WriteHeader(found.Status)
+	}
+	_, _ = w.Write(found.Response)
+}
+
+// recordInteraction scrubs a forwarded request and its response and adds
+// them to the cassette
+func (s *Server) recordInteraction(req Request, status int, body []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	in := Interaction{Operation: req.Operation}
+	if req.Variables != nil {
+		in.Variables = s.scrub.Scrub(normalize(req.Variables)).(map[string]interface{})
+	}
+	scrubbed, err := s.scrub.ScrubJSON(body)
+	if err != nil {
+		return err
+	}
+	in.Response = scrubbed
+	if status != http.StatusOK {
+		in.Status = status
+	}
+	s.cassette.Interactions = append(s.cassette.Interactions, in)
+	return nil
+}
+
+// saveCassette writes the recorded cassette
+func (s *Server) saveCassette() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, err := json.MarshalIndent(s.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.cassettePath, append(data, '\n'), 0o644)
+}
+
+// unusedInteractions counts the interactions not replayed yet
+func (s *Server) unusedInteractions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, in := range s.cassette.Interactions {
+		if !in.used {
+			n++
+		}
+	}
+	return n
+}
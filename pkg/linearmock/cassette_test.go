@@ -0,0 +1,192 @@
+package linearmock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestScrubber(t *testing.T) {
+	s := NewScrubber()
+	s.Replace("crud-test-1760000000", "crud-test-cassette")
+	got := s.Scrub(map[string]interface{}{
+		"id":        "5F0C2A4E-9B1D-4E7A-8C3F-2D6E1A0B9C77",
+		"name":      "crud-test-1760000000-project",
+		"createdAt": "2026-10-16T09:41:07.512Z",
+		"dueDate":   "2026-10-30",
+		"url":       "https://linear.app/acme/project/crud-test-1760000000-project-1a2b3c4d5e6f",
+		"team":      map[string]interface{}{"id": "7d3b9e2a-1c4f-4a8b-9e6d-0f2a4c6e8b1d"},
+		"members":   []interface{}{"5f0c2a4e-9b1d-4e7a-8c3f-2d6e1a0b9c77", "00000000-0000-4000-8000-000000000009"},
+		"pageInfo":  map[string]interface{}{"hasNextPage": true, "endCursor": "b3f1c0de"},
+		"after":     "b3f1c0de",
+		"priority":  float64(2),
+	})
+	want := map[string]interface{}{
+		"id":        "00000000-0000-4000-8000-000000000001",
+		"name":      "crud-test-cassette-project",
+		"createdAt": ScrubbedTime,
+		"dueDate":   "2026-10-30",
+		"url":       "https://linear.app/acme/project/crud-test-cassette-project-1a2b3c4d5e6f",
+		// Numbered in key order, so "team" comes after "id" and "members"
+		"team": map[string]interface{}{"id": "00000000-0000-4000-8000-000000000002"},
+		// The same ID in any case maps to the same placeholder, and
+		// placeholders stay as they are
+		"members":  []interface{}{"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000009"},
+		"pageInfo": map[string]interface{}{"hasNextPage": true, "endCursor": "cursor-1"},
+		"after":    "cursor-1",
+		"priority": float64(2),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scrub =\n%v\nwant\n%v", got, want)
+	}
+	if again := s.Scrub("5f0c2a4e-9b1d-4e7a-8c3f-2d6e1a0b9c77"); again != want["id"] {
+		t.Errorf("a later Scrub gave the ID %v, want the placeholder %v", again, want["id"])
+	}
+}
+
+func TestScrubbedMatch(t *testing.T) {
+	placeholder := "00000000-0000-4000-8000-000000000001"
+	tests := []struct {
+		want, got interface{}
+		match     bool
+	}{
+		{"ENG-1", "ENG-1", true},
+		{"ENG-1", "ENG-2", false},
+		{ScrubbedTime, "2026-10-16T09:41:07Z", true},
+		{ScrubbedTime, "last week", false},
+		{placeholder, "9a1f5e2c-3b7d-4c8e-a0f6-1d2e3c4b5a69", true},
+		{placeholder, "ENG-1", false},
+		{float64(2), float64(2), true},
+		{nil, nil, true},
+		{map[string]interface{}{"input": map[string]interface{}{"id": placeholder, "title": "t"}},
+			map[string]interface{}{"input": map[string]interface{}{"id": "9a1f5e2c-3b7d-4c8e-a0f6-1d2e3c4b5a69", "title": "t"}}, true},
+		// Every recorded variable, and only those
+		{map[string]interface{}{"first": float64(5)}, map[string]interface{}{"first": float64(5), "after": "c"}, false},
+		{map[string]interface{}{"first": float64(5), "after": "c"}, map[string]interface{}{"first": float64(5)}, false},
+		{[]interface{}{"a", "b"}, []interface{}{"a"}, false},
+	}
+	for _, tt := range tests {
+		if got := scrubbedMatch(tt.want, tt.got); got != tt.match {
+			t.Errorf("scrubbedMatch(%v, %v) = %v, want %v", tt.want, tt.got, got, tt.match)
+		}
+	}
+}
+
+// failureRecorder collects a server's test failures instead of failing the
+// test
+type failureRecorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+// TestCassetteRecordAndReplay records a create, a get, and two pages of a
+// list from a mock server standing in for the API, and replays them with a
+// freshly generated ID, as a second run of the same test would send
+func TestCassetteRecordAndReplay(t *testing.T) {
+	const (
+		realID  = "5f0c2a4e-9b1d-4e7a-8c3f-2d6e1a0b9c77"
+		freshID = "9a1f5e2c-3b7d-4c8e-a0f6-1d2e3c4b5a69"
+		create  = `mutation CreateProject($input: ProjectCreateInput!) { projectCreate(input: $input) { project { id name } } }`
+		get     = `query Project($id: String!) { project(id: $id) { id name createdAt } }`
+		list    = `query Projects($after: String) { projects(after: $after) { nodes { id } pageInfo { endCursor } } }`
+	)
+	project := `{"id":"` + realID + `","name":"crud-1760000000-project","createdAt":"2026-10-16T09:41:07.512Z"}`
+	upstream := New(t)
+	upstream.Data("CreateProject", `{"projectCreate":{"project":`+project+`}}`)
+	upstream.Data("Project", `{"project":`+project+`}`)
+	upstream.Data("Projects", `{"projects":{"nodes":[{"id":"`+realID+`"}],"pageInfo":{"endCursor":"opaque-1"}}}`)
+	upstream.DataFor("Projects", map[string]interface{}{"after": "opaque-1"}, `{"projects":{"nodes":[],"pageInfo":{"endCursor":null}}}`)
+
+	path := filepath.Join(t.TempDir(), "cassettes", "Project.json")
+	scrub := NewScrubber()
+	scrub.Replace("crud-1760000000", "crud-cassette")
+	recording := &failureRecorder{TB: t}
+	recorder := New(recording)
+	recorder.RecordCassette(upstream.URL, path, scrub)
+
+	// run is the test being recorded and replayed: the ID it creates with
+	// and the name prefix differ between runs
+	run := func(url, id, prefix string) (names []string, cursor string) {
+		t.Helper()
+		client := api.NewClientWithURL(url, "lin_api_secret")
+		var created struct {
+			ProjectCreate struct{ Project struct{ ID, Name string } } `json:"projectCreate"`
+		}
+		input := map[string]interface{}{"id": id, "name": prefix + "-project"}
+		if err := client.Execute(context.Background(), create, map[string]interface{}{"input": input}, &created); err != nil {
+			t.Fatal(err)
+		}
+		var got struct {
+			Project struct{ ID, Name, CreatedAt string } `json:"project"`
+		}
+		if err := client.Execute(context.Background(), get, map[string]interface{}{"id": created.ProjectCreate.Project.ID}, &got); err != nil {
+			t.Fatal(err)
+		}
+		var page struct {
+			Projects struct {
+				PageInfo struct{ EndCursor string } `json:"pageInfo"`
+			} `json:"projects"`
+		}
+		if err := client.Execute(context.Background(), list, nil, &page); err != nil {
+			t.Fatal(err)
+		}
+		cursor = page.Projects.PageInfo.EndCursor
+		if err := client.Execute(context.Background(), list, map[string]interface{}{"after": cursor}, nil); err != nil {
+			t.Fatal(err)
+		}
+		return []string{created.ProjectCreate.Project.Name, got.Project.Name, got.Project.CreatedAt}, cursor
+	}
+	names, cursor := run(recorder.URL, realID, "crud-1760000000")
+	if names[1] != "crud-1760000000-project" || cursor != "opaque-1" {
+		t.Errorf("recording got %v, cursor %q; want the API's own values", names, cursor)
+	}
+	if err := recorder.saveCassette(); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, leak := range []string{realID, "crud-1760000000", "opaque-1", "2026-10-16", "lin_api_secret"} {
+		if strings.Contains(string(data), leak) {
+			t.Errorf("cassette contains %q:\n%s", leak, data)
+		}
+	}
+
+	replay := New(t)
+	replay.ReplayCassette(path)
+	names, cursor = run(replay.URL, freshID, "crud-cassette")
+	want := []string{"crud-cassette-project", "crud-cassette-project", ScrubbedTime}
+	if !reflect.DeepEqual(names, want) || cursor != "cursor-1" {
+		t.Errorf("replay got %v, cursor %q; want %v, cursor-1", names, cursor, want)
+	}
+	if ops := strings.Join(replay.Operations(), ","); ops != "CreateProject,Project,Projects,Projects" {
+		t.Errorf("replayed operations = %s", ops)
+	}
+
+	// Each interaction answers once, and a request the cassette doesn't
+	// have fails the test
+	unmatched := &failureRecorder{TB: t}
+	strict := New(unmatched)
+	strict.ReplayCassette(path)
+	client := api.NewClientWithURL(strict.URL, "key")
+	for _, id := range []string{"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000001"} {
+		_ = client.Execute(context.Background(), get, map[string]interface{}{"id": id}, nil)
+	}
+	if len(unmatched.failures) != 1 || !strings.Contains(unmatched.failures[0], `no interaction left`) {
+		t.Errorf("replaying a get twice failed with %q, want one failure for the second", unmatched.failures)
+	}
+	if len(recording.failures) != 0 {
+		t.Errorf("recording failed: %v", recording.failures)
+	}
+}
@@ -0,0 +1,318 @@
+package linearmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// Stub is a canned response to one GraphQL operation. It is also the format
+// of a fixture file.
+type Stub struct {
+	// Operation is the operation name, e.g. "Teams" for "query Teams(...)"
+	Operation string `json:"operation"`
+	// Variables must all be present in the request with equal values;
+	// variables not listed here are ignored. Empty matches any request.
+	Variables map[string]interface{} `json:"variables,omitempty"`
+	// Status is the HTTP status; 0 means 200
+	Status int `json:"status,omitempty"`
+	// Response is the whole response body, e.g. {"data": {...}}
+	Response json.RawMessage `json:"response"`
+	// Drop closes the connection without answering, like a request that
+	// timed out after reaching the server
+	Drop bool `json:"drop,omitempty"`
+	// Times is how many requests the stub answers before it stops
+	// matching; 0 means every one
+	Times int `json:"times,omitempty"`
+
+	used int
+}
+
+// Request is a GraphQL request the server received
+type Request struct {
+	Operation string                 `json:"operation"`
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// Server is a GraphQL server answering with stubs. Requests no stub matches
+// get a GraphQL error and fail the test.
+type Server struct {
+	URL string
+
+	t        testing.TB
+	server   *httptest.Server
+	mu       sync.Mutex
+	stubs    []Stub
+	requests []Request
+
+	// recording mode, see Record and RecordCassette
+	upstream string
+	dir      string
+	recorded map[string]int
+
+	// cassette mode, see UseCassette
+	cassette     *Cassette
+	cassettePath string
+	scrub        *Scrubber
+}
+
+// New starts a server that is closed when the test ends
+func New(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{t: t}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+	return s
+}
+
+// Install points api.NewClient, and so every command, at the server until
+// the test ends
+func (s *Server) Install() {
+	previous := api.NewClientWithBaseURL
+	api.NewClientWithBaseURL = func(_, authHeader string) *api.Client {
+		return api.NewClientWithURL(s.URL, authHeader)
+	}
+	s.t.Cleanup(func() { api.NewClientWithBaseURL = previous })
+}
+
+// Add registers stubs. When several match a request, the one added last
+// wins, so tests can override fixtures.
+func (s *Server) Add(stubs ...Stub) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stubs = append(s.stubs, stubs...)
+}
+
+// Data stubs operation with a successful response carrying data, given as
+// JSON text or any value that marshals to it
+func (s *Server) Data(operation string, data interface{}) {
+	s.t.Helper()
+	s.Add(Stub{Operation: operation, Response: s.body(map[string]interface{}{"data": raw(s.t, data)})})
+}
+
+// DataFor is Data restricted to requests carrying these variables
+func (s *Server) DataFor(operation string, variables map[string]interface{}, data interface{}) {
+	s.t.Helper()
+	s.Add(Stub{Operation: operation, Variables: variables, Response: s.body(map[string]interface{}{"data": raw(s.t, data)})})
+}
+
+// Error stubs operation with a GraphQL error response
+func (s *Server) Error(operation string, status int, message, code string) {
+	s.t.Helper()
+	gqlErr := map[string]interface{}{"message": message}
+	if code != "" {
+		gqlErr["extensions"] = map[string]interface{}{"code": code}
+	}
+	s.Add(Stub{Operation: operation, Status: status, Response: s.body(map[string]interface{}{"errors": []interface{}{gqlErr}})})
+}
+
+// LoadFixtures adds every *.json stub in dir, in file name order
+func (s *Server) LoadFixtures(dir string) {
+	s.t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		s.t.Fatalf("linearmock: %v", err)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			s.t.Fatalf("linearmock: %v", err)
+		}
+		var stub Stub
+		if err := json.Unmarshal(data, &stub); err != nil {
+			s.t.Fatalf("linearmock: fixture %s: %v", file, err)
+		}
+		s.Add(stub)
+	}
+}
+
+// Requests returns the requests received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Operations returns the operation names received so far, in order
+func (s *Server) Operations() []string {
+	var ops []string
+	for _, r := range s.Requests() {
+		ops = append(ops, r.Operation)
+	}
+	return ops
+}
+
+// Reset forgets the received requests, keeping the stubs
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var gqlReq struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &gqlReq); err != nil {
+		http.Error(w, "linearmock: request is not JSON", http.StatusBadRequest)
+		return
+	}
+	req := Request{Operation: gqlReq.OperationName, Query: gqlReq.Query, Variables: gqlReq.Variables}
+	if req.Operation == "" {
+		req.Operation = OperationName(gqlReq.Query)
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	recording := s.upstream != ""
+	replaying := s.cassette != nil
+	s.mu.Unlock()
+
+	if recording {
+		s.forward(w, r, body, req)
+		return
+	}
+	if replaying {
+		s.replay(w, req)
+		return
+	}
+
+	stub, ok := s.match(req)
+	if ok && stub.Drop {
+		if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+			_ = conn.Close()
+			return
+		}
+	}
+	if !ok {
+		s.t.Errorf("linearmock: no stub for operation %q with variables %v", req.Operation, req.Variables)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"errors":[{"message":%q}]}`, "linearmock: no stub for operation "+req.Operation)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if stub.Status != 0 {
+		w.WriteHeader(stub.Status)
+	}
+	_, _ = w.Write(stub.Response)
+}
+
+// match returns the last stub matching req, counting it as used
+func (s *Server) match(req Request) (Stub, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.stubs) - 1; i >= 0; i-- {
+		stub := &s.stubs[i]
+		if stub.Times > 0 && stub.used >= stub.Times {
+			continue
+		}
+		if stub.Operation == req.Operation && variablesMatch(stub.Variables, req.Variables) {
+			stub.used++
+			return *stub, true
+		}
+	}
+	return Stub{}, false
+}
+
+// variablesMatch reports whether every wanted variable is in got with an
+// equal value, comparing both as decoded JSON
+func variablesMatch(want, got map[string]interface{}) bool {
+	for k, v := range want {
+		g, ok := got[k]
+		if !ok || !reflect.DeepEqual(normalize(v), normalize(g)) {
+			return false
+		}
+	}
+	return true
+}
+
+// normalize round-trips v through JSON so ints and float64s compare equal
+func normalize(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+	var out interface{}
+	_ = json.Unmarshal(data, &out)
+	return out
+}
+
+var operationPattern = regexp.MustCompile(`\b(?:query|mutation|subscription)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+// OperationName returns the name of the first named operation in a GraphQL
+// document, or "" for an anonymous one
+func OperationName(query string) string {
+	if m := operationPattern.FindStringSubmatch(query); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// raw turns JSON text or a value into a json.RawMessage
+func raw(t testing.TB, v interface{}) json.RawMessage {
+	t.Helper()
+	switch v := v.(type) {
+	case string:
+		if !json.Valid([]byte(v)) {
+			t.Fatalf("linearmock: invalid JSON: %s", v)
+		}
+		return json.RawMessage(v)
+	case json.RawMessage:
+		return v
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("linearmock: %v", err)
+	}
+	return data
+}
+
+// body marshals a response body
+func (s *Server) body(v interface{}) json.RawMessage {
+	s.t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.t.Fatalf("linearmock: %v", err)
+	}
+	return data
+}
+
+// fixtureName is the file a recorded stub is saved to: the operation name,
+// numbered from the second request on, e.g. "Teams.json", "Teams-2.json"
+func fixtureName(operation string, n int) string {
+	if operation == "" {
+		operation = "anonymous"
+	}
+	operation = strings.Map(func(r rune) rune {
+		if r == '/' || r == '\\' || r == os.PathSeparator {
+			return '_'
+		}
+		return r
+	}, operation)
+	if n <= 1 {
+		return operation + ".json"
+	}
+	return fmt.Sprintf("%s-%d.json", operation, n)
+}
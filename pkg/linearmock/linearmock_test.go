@@ -0,0 +1,140 @@
+package linearmock
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+func TestOperationName(t *testing.T) {
+	tests := map[string]string{
+		"query Teams($first: Int) { teams { nodes { id } } }":              "Teams",
+		"\n\t\tmutation CreateIssue($input: IssueCreateInput!) { x }":      "CreateIssue",
+		"# comment\nquery Me { viewer { id } }\nfragment F on User { id }": "Me",
+		"query { viewer { id } }":                                          "",
+		"{ viewer { id } }":                                                "",
+	}
+	for query, want := range tests {
+		if got := OperationName(query); got != want {
+			t.Errorf("OperationName(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestServerMatchesStubs(t *testing.T) {
+	s := New(t)
+	s.Data("Issue", `{"issue":{"id":"generic"}}`)
+	s.DataFor("Issue", map[string]interface{}{"id": "ENG-1"}, `{"issue":{"id":"eng-1"}}`)
+	s.Error("Viewer", http.StatusUnauthorized, "Authentication required", "AUTHENTICATION_ERROR")
+	client := api.NewClientWithURL(s.URL, "key")
+
+	var resp struct {
+		Issue struct{ ID string } `json:"issue"`
+	}
+	query := `query Issue($id: String!) { issue(id: $id) { id } }`
+	for id, want := range map[string]string{"ENG-1": "eng-1", "ENG-2": "generic"} {
+		if err := client.Execute(context.Background(), query, map[string]interface{}{"id": id}, &resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Issue.ID != want {
+			t.Errorf("issue %s answered with %q, want %q", id, resp.Issue.ID, want)
+		}
+	}
+
+	err := client.Execute(context.Background(), `query Viewer { viewer { id } }`, nil, nil)
+	var apiErr *api.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized || !apiErr.HasCode("AUTHENTICATION_ERROR") {
+		t.Errorf("error stub = %v, want a 401 AUTHENTICATION_ERROR", err)
+	}
+
+	if got := strings.Join(s.Operations(), ","); got != "Issue,Issue,Viewer" {
+		t.Errorf("operations = %s", got)
+	}
+}
+
+func TestServerDropAndTimes(t *testing.T) {
+	s := New(t)
+	s.Data("Me", `{"viewer":{"id":"u1"}}`)
+	s.Add(Stub{Operation: "Me", Drop: true, Times: 1})
+	client := api.NewClientWithURL(s.URL, "key")
+
+	var transportErr *api.TransportError
+	if err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil); !errors.As(err, &transportErr) {
+		t.Errorf("dropped request = %v, want a transport error", err)
+	}
+	if err := client.Execute(context.Background(), `query Me { viewer { id } }`, nil, nil); err != nil {
+		t.Errorf("stub used up but still matched: %v", err)
+	}
+}
+
+func TestInstall(t *testing.T) {
+	s := New(t)
+	s.Data("Me", `{"viewer":{"id":"u1"}}`)
+	s.Install()
+
+	var resp struct {
+		Viewer struct{ ID string } `json:"viewer"`
+	}
+	if err := api.NewClient("key").Execute(context.Background(), `query Me { viewer { id } }`, nil, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Viewer.ID != "u1" {
+		t.Errorf("viewer = %q, want the stubbed u1", resp.Viewer.ID)
+	}
+}
+
+// TestRecordAndReplay records from one mock server, standing in for the
+// API, and replays the fixtures from another
+func TestRecordAndReplay(t *testing.T) {
+	upstream := New(t)
+	upstream.Data("Teams", `{"teams":{"nodes":[{"id":"t1","key":"ENG"}]}}`)
+	upstream.Data("Team", `{"team":{"id":"t1"}}`)
+
+	dir := t.TempDir()
+	recorder := New(t)
+	recorder.Record(upstream.URL, dir)
+	client := api.NewClientWithURL(recorder.URL, "lin_api_secret")
+	for _, q := range []string{
+		`query Teams { teams { nodes { id key } } }`,
+		`query Team($id: String!) { team(id: $id) { id } }`,
+		`query Teams { teams { nodes { id key } } }`,
+	} {
+		if err := client.Execute(context.Background(), q, map[string]interface{}{"id": "t1"}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if req := upstream.Requests(); len(req) != 3 {
+		t.Fatalf("upstream got %d requests, want 3", len(req))
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	var names []string
+	for _, f := range files {
+		names = append(names, filepath.Base(f))
+		data, _ := os.ReadFile(f)
+		if strings.Contains(string(data), "lin_api_secret") {
+			t.Errorf("%s contains the credentials", f)
+		}
+	}
+	if got := strings.Join(names, ","); got != "Team.json,Teams-2.json,Teams.json" {
+		t.Errorf("fixtures = %s", got)
+	}
+
+	replay := New(t)
+	replay.LoadFixtures(dir)
+	var resp struct {
+		Teams struct {
+			Nodes []struct{ Key string } `json:"nodes"`
+		} `json:"teams"`
+	}
+	err := api.NewClientWithURL(replay.URL, "key").Execute(context.Background(), `query Teams { teams { nodes { id key } } }`, map[string]interface{}{"id": "t1"}, &resp)
+	if err != nil || len(resp.Teams.Nodes) != 1 || resp.Teams.Nodes[0].Key != "ENG" {
+		t.Errorf("replayed Teams = %+v, %v", resp, err)
+	}
+}
@@ -0,0 +1,114 @@
+package linearmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/api"
+)
+
+// RecordEnv names the environment variable that switches Fixtures from
+// replaying fixtures to recording them from the real API
+const RecordEnv = "LINEARMOCK_RECORD"
+
+// APIURLEnv names the environment variable that points a linear-cli binary
+// built with -tags linearmock at a Server, for tests that run the binary
+// rather than commands in-process. Other builds ignore it.
+const APIURLEnv = "LINEARMOCK_API_URL"
+
+// Fixtures replays the stubs saved in dir. With LINEARMOCK_RECORD=1 it
+// records instead: requests go to the Linear API with the caller's
+// credentials and every response is written to dir.
+func (s *Server) Fixtures(dir string) {
+	s.t.Helper()
+	if os.Getenv(RecordEnv) != "" {
+		s.Record(api.BaseURL, dir)
+		return
+	}
+	s.LoadFixtures(dir)
+}
+
+// Record forwards every request to upstream, Authorization header included,
+// and saves each response to dir as a fixture keyed by the request's
+// variables. Drop variables that change between runs (timestamps) from the
+// fixtures so they replay. Stubs are ignored while recording. Fixtures hold
+// real workspace data; review them before committing.
+func (s *Server) Record(upstream, dir string) {
+	s.t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		s.t.Fatalf("linearmock: %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.upstream = upstream
+	s.dir = dir
+	s.recorded = map[string]int{}
+}
+
+// forward proxies one request upstream and records the response
+func (s *Server) forward(w http.ResponseWriter, r *http.Request, body []byte, req Request) {
+	upReq, err := http.NewRequestWithContext(r.Context(), http.MethodPost, s.upstream, bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	upReq.Header.Set("Content-Type", "application/json")
+	upReq.Header.Set("Authorization", r.Header.Get("Authorization"))
+	upReq.Header.Set("User-Agent", r.Header.Get("User-Agent"))
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Do(upReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	// Saved before answering, so a cassette keeps the order commands sent
+	// their requests in
+	s.mu.Lock()
+	save := s.save
+	if s.scrub != nil {
+		save = s.recordInteraction
+	}
+	s.mu.Unlock()
+	if err := save(req, resp.StatusCode, respBody); err != nil {
+		s.t.Errorf("linearmock: recording %s: %v", req.Operation, err)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(respBody)
+}
+
+// save writes a recorded response as a fixture
+func (s *Server) save(req Request, status int, body []byte) error {
+	var pretty bytes.Buffer
+	if err := json.Indent(&pretty, body, "", "  "); err != nil {
+		return err
+	}
+	stub := Stub{Operation: req.Operation, Variables: req.Variables, Response: pretty.Bytes()}
+	if status != http.StatusOK {
+		stub.Status = status
+	}
+	data, err := json.MarshalIndent(stub, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.recorded[req.Operation]++
+	name := fixtureName(req.Operation, s.recorded[req.Operation])
+	s.mu.Unlock()
+	return os.WriteFile(filepath.Join(s.dir, name), append(data, '\n'), 0o644)
+}
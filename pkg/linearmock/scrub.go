@@ -0,0 +1,182 @@
+package linearmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ScrubbedTime replaces every timestamp in a cassette
+const ScrubbedTime = "2000-01-01T00:00:00.000Z"
+
+// placeholderIDPrefix starts every placeholder a Scrubber puts in place of a
+// UUID. Placeholders are still UUIDs, so commands that tell IDs from
+// identifiers by shape treat them the same.
+const placeholderIDPrefix = "00000000-0000-4000-8000-"
+
+var (
+	uuidPattern      = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+	fullUUIDPattern  = regexp.MustCompile(`^` + uuidPattern.String() + `$`)
+	timestampPattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+)
+
+// cursorKeys are the fields that hold pagination cursors, in responses
+// (pageInfo) and in variables
+var cursorKeys = map[string]bool{"endCursor": true, "startCursor": true, "after": true, "before": true}
+
+// Scrubber rewrites what changes from one recording to the next, so a
+// cassette replays no matter when it was recorded: UUIDs become numbered
+// placeholder UUIDs, pagination cursors numbered placeholder cursors, and
+// timestamps ScrubbedTime. Literals registered with Replace, like a run's
+// unique name prefix, are swapped first.
+//
+// A real value always maps to the same placeholder, in requests and
+// responses alike, so the ID a create returns is still the one the next
+// request sends. Share one Scrubber between cassettes recorded together to
+// keep that true across them.
+type Scrubber struct {
+	mu       sync.Mutex
+	ids      map[string]string
+	cursors  map[string]string
+	literals []string // old, new pairs
+}
+
+// NewScrubber returns a Scrubber with no placeholders handed out yet
+func NewScrubber() *Scrubber {
+	return &Scrubber{ids: map[string]string{}, cursors: map[string]string{}}
+}
+
+// Replace swaps old for new wherever it appears in a string
+func (s *Scrubber) Replace(old, new string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.literals = append(s.literals, old, new)
+}
+
+// Scrub returns a copy of v, decoded JSON, with volatile values replaced
+func (s *Scrubber) Scrub(v interface{}) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.scrub("", v)
+}
+
+// ScrubJSON is Scrub for JSON text
+func (s *Scrubber) ScrubJSON(data []byte) ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(s.Scrub(v))
+}
+
+// scrub replaces the volatile values in v, found under key
+func (s *Scrubber) scrub(key string, v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		// In key order, so placeholders are numbered the same every
+		// recording
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		out := make(map[string]interface{}, len(v))
+		for _, k := range keys {
+			out[k] = s.scrub(k, v[k])
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = s.scrub(key, value)
+		}
+		return out
+	case string:
+		return s.scrubString(key, v)
+	}
+	return v
+}
+
+func (s *Scrubber) scrubString(key, v string) string {
+	for i := 0; i < len(s.literals); i += 2 {
+		v = strings.ReplaceAll(v, s.literals[i], s.literals[i+1])
+	}
+	switch {
+	case cursorKeys[key] && v != "":
+		return placeholder(s.cursors, v, "cursor-%d")
+	case timestampPattern.MatchString(v):
+		return ScrubbedTime
+	}
+	return uuidPattern.ReplaceAllStringFunc(v, func(id string) string {
+		if isPlaceholderID(id) {
+			return id
+		}
+		return placeholder(s.ids, strings.ToLower(id), placeholderIDPrefix+"%012d")
+	})
+}
+
+// placeholder returns the placeholder for value in seen, numbering a new
+// one with format when value hasn't been seen
+func placeholder(seen map[string]string, value, format string) string {
+	if p, ok := seen[value]; ok {
+		return p
+	}
+	p := fmt.Sprintf(format, len(seen)+1)
+	seen[value] = p
+	return p
+}
+
+// isPlaceholderID reports whether id is a placeholder a Scrubber handed out
+func isPlaceholderID(id string) bool {
+	return strings.HasPrefix(id, placeholderIDPrefix)
+}
+
+// scrubbedMatch reports whether a request's value got matches want, a
+// scrubbed value from a cassette. Besides equal values, a timestamp matches
+// ScrubbedTime and any UUID matches a placeholder, since the clock and the
+// IDs commands generate for new entities differ on every run. Objects must
+// have the same keys.
+func scrubbedMatch(want, got interface{}) bool {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		got, ok := got.(map[string]interface{})
+		if !ok || len(got) != len(want) {
+			return false
+		}
+		for k, w := range want {
+			g, ok := got[k]
+			if !ok || !scrubbedMatch(w, g) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		got, ok := got.([]interface{})
+		if !ok || len(got) != len(want) {
+			return false
+		}
+		for i := range want {
+			if !scrubbedMatch(want[i], got[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		got, ok := got.(string)
+		switch {
+		case !ok:
+			return false
+		case want == got:
+			return true
+		case want == ScrubbedTime:
+			return timestampPattern.MatchString(got)
+		case isPlaceholderID(want):
+			return fullUUIDPattern.MatchString(got)
+		}
+		return false
+	}
+	return want == got
+}
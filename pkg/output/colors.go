@@ -0,0 +1,174 @@
+package output
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// ColorLevel is how many colors the terminal can show
+type ColorLevel int
+
+const (
+	// ColorBasic is the 16 named colors, too few for Linear's hex colors
+	ColorBasic ColorLevel = iota
+	// Color256 is the xterm 256-color palette
+	Color256
+	// ColorTrue is 24-bit color
+	ColorTrue
+)
+
+// colorLevel is the terminal's level, read from the environment at start
+var colorLevel = DetectColorLevel(os.Getenv)
+
+// DetectColorLevel reads the color level from COLORTERM and TERM, the way
+// terminals advertise it: COLORTERM=truecolor (or 24bit) for 24-bit color,
+// a TERM ending in -256color for the palette
+func DetectColorLevel(getenv func(string) string) ColorLevel {
+	switch strings.ToLower(getenv("COLORTERM")) {
+	case "truecolor", "24bit":
+		return ColorTrue
+	}
+	term := getenv("TERM")
+	switch {
+	case strings.HasSuffix(term, "-direct"):
+		return ColorTrue
+	case strings.Contains(term, "256color"):
+		return Color256
+	}
+	return ColorBasic
+}
+
+// RGB is a 24-bit color
+type RGB struct {
+	R, G, B uint8
+}
+
+// ParseHexColor parses "#rrggbb" or "#rgb", with or without the "#"
+func ParseHexColor(hex string) (RGB, bool) {
+	hex = strings.TrimPrefix(strings.TrimSpace(hex), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return RGB{}, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return RGB{}, false
+	}
+	return RGB{uint8(v >> 16), uint8(v >> 8), uint8(v)}, true
+}
+
+// cubeLevels are the channel values of the 256-color palette's 6x6x6 cube
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// Xterm256 returns the closest color in the xterm 256-color palette, from
+// the 6x6x6 cube (16-231) or the gray ramp (232-255)
+func (c RGB) Xterm256() int {
+	cubeIndex := func(v uint8) int {
+		switch {
+		case v < 48:
+			return 0
+		case v < 115:
+			return 1
+		default:
+			return (int(v) - 35) / 40
+		}
+	}
+	r, g, b := cubeIndex(c.R), cubeIndex(c.G), cubeIndex(c.B)
+	cube := RGB{uint8(cubeLevels[r]), uint8(cubeLevels[g]), uint8(cubeLevels[b])}
+
+	avg := (int(c.R) + int(c.G) + int(c.B)) / 3
+	grayIndex := 23
+	if avg < 238 {
+		grayIndex = max(avg-3, 0) / 10
+	}
+	grayValue := uint8(8 + 10*grayIndex)
+	gray := RGB{grayValue, grayValue, grayValue}
+
+	if c.distance(gray) < c.distance(cube) {
+		return 232 + grayIndex
+	}
+	return 16 + 36*r + 6*g + b
+}
+
+// xterm256RGB is the color of palette entry n, for n from 16
+func xterm256RGB(n int) RGB {
+	if n >= 232 {
+		v := uint8(8 + 10*(n-232))
+		return RGB{v, v, v}
+	}
+	n -= 16
+	return RGB{uint8(cubeLevels[n/36]), uint8(cubeLevels[n/6%6]), uint8(cubeLevels[n%6])}
+}
+
+func (c RGB) distance(o RGB) int {
+	dr, dg, db := int(c.R)-int(o.R), int(c.G)-int(o.G), int(c.B)-int(o.B)
+	return dr*dr + dg*dg + db*db
+}
+
+// luminance is the WCAG relative luminance, from 0 (black) to 1 (white)
+func (c RGB) luminance() float64 {
+	channel := func(v uint8) float64 {
+		s := float64(v) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*channel(c.R) + 0.7152*channel(c.G) + 0.0722*channel(c.B)
+}
+
+// ContrastText returns black or white, whichever reads better on c by the
+// WCAG contrast ratio
+func (c RGB) ContrastText() RGB {
+	l := c.luminance()
+	onBlack := (l + 0.05) / 0.05
+	onWhite := 1.05 / (l + 0.05)
+	if onBlack >= onWhite {
+		return RGB{0, 0, 0}
+	}
+	return RGB{255, 255, 255}
+}
+
+// ColorChip renders text as a chip on the hex background color Linear gives
+// a label or state, with black or white text for contrast. When colors are
+// off (--no-color, NO_COLOR, or not a terminal), the terminal can't show
+// the color, or hex doesn't parse, it falls back to fallback, or plain text
+// when fallback is nil.
+func ColorChip(text, hex string, fallback *color.Color) string {
+	if chip, ok := colorChip(colorLevel, text, hex); ok && !color.NoColor {
+		return chip
+	}
+	if fallback == nil {
+		return text
+	}
+	return fallback.Sprint(text)
+}
+
+// colorChip renders the chip at a color level, reporting false when it can't
+func colorChip(level ColorLevel, text, hex string) (string, bool) {
+	bg, ok := ParseHexColor(hex)
+	if !ok {
+		return "", false
+	}
+	switch level {
+	case ColorTrue:
+		fg := bg.ContrastText()
+		return fmt.Sprintf("\x1b[48;2;%d;%d;%dm\x1b[38;2;%d;%d;%dm %s \x1b[0m", bg.R, bg.G, bg.B, fg.R, fg.G, fg.B, text), true
+	case Color256:
+		n := bg.Xterm256()
+		// Pick the text color against the palette color actually shown
+		fg := 16 // black
+		if xterm256RGB(n).ContrastText() != (RGB{}) {
+			fg = 231 // white
+		}
+		return fmt.Sprintf("\x1b[48;5;%dm\x1b[38;5;%dm %s \x1b[0m", n, fg, text), true
+	}
+	return "", false
+}
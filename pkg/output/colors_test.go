@@ -0,0 +1,111 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestParseHexColor(t *testing.T) {
+	tests := map[string]struct {
+		want RGB
+		ok   bool
+	}{
+		"#5e6ad2": {RGB{0x5e, 0x6a, 0xd2}, true},
+		"F2C94C":  {RGB{0xf2, 0xc9, 0x4c}, true},
+		"#fff":    {RGB{255, 255, 255}, true},
+		"#12345":  {RGB{}, false},
+		"#zzzzzz": {RGB{}, false},
+		"":        {RGB{}, false},
+	}
+	for hex, tt := range tests {
+		if got, ok := ParseHexColor(hex); got != tt.want || ok != tt.ok {
+			t.Errorf("ParseHexColor(%q) = %v, %v; want %v, %v", hex, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestXterm256(t *testing.T) {
+	tests := []struct {
+		c    RGB
+		want int
+	}{
+		{RGB{0, 0, 0}, 16},
+		{RGB{255, 255, 255}, 231},
+		{RGB{255, 0, 0}, 196},
+		{RGB{0, 0, 255}, 21},
+		{RGB{95, 135, 175}, 67},   // exactly on the cube
+		{RGB{128, 128, 128}, 244}, // nearer the gray ramp than the cube
+		{RGB{0x5e, 0x6a, 0xd2}, 62},
+	}
+	for _, tt := range tests {
+		if got := tt.c.Xterm256(); got != tt.want {
+			t.Errorf("%v.Xterm256() = %d, want %d", tt.c, got, tt.want)
+		}
+	}
+	for n := 16; n < 256; n++ {
+		if got := xterm256RGB(n).Xterm256(); got != n && xterm256RGB(got) != xterm256RGB(n) {
+			t.Errorf("palette color %d maps to %d", n, got)
+		}
+	}
+}
+
+func TestContrastText(t *testing.T) {
+	black, white := RGB{0, 0, 0}, RGB{255, 255, 255}
+	tests := map[string]RGB{"#ffffff": black, "#f2c94c": black, "#bec2c8": black, "#000000": white, "#5e6ad2": white, "#eb5757": black, "#4ea7fc": black, "#26b5ce": black, "#95a2b3": black, "#0f783c": white}
+	for hex, want := range tests {
+		c, _ := ParseHexColor(hex)
+		if got := c.ContrastText(); got != want {
+			t.Errorf("ContrastText(%s) = %v, want %v", hex, got, want)
+		}
+	}
+}
+
+func TestDetectColorLevel(t *testing.T) {
+	tests := []struct {
+		env  map[string]string
+		want ColorLevel
+	}{
+		{map[string]string{"COLORTERM": "truecolor", "TERM": "xterm-256color"}, ColorTrue},
+		{map[string]string{"COLORTERM": "24bit"}, ColorTrue},
+		{map[string]string{"TERM": "xterm-direct"}, ColorTrue},
+		{map[string]string{"TERM": "xterm-256color"}, Color256},
+		{map[string]string{"TERM": "screen-256color"}, Color256},
+		{map[string]string{"TERM": "xterm"}, ColorBasic},
+		{map[string]string{"TERM": "dumb"}, ColorBasic},
+		{map[string]string{}, ColorBasic},
+	}
+	for _, tt := range tests {
+		if got := DetectColorLevel(func(k string) string { return tt.env[k] }); got != tt.want {
+			t.Errorf("DetectColorLevel(%v) = %d, want %d", tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestColorChip(t *testing.T) {
+	if got, _ := colorChip(ColorTrue, "Bug", "#eb5757"); got != "\x1b[48;2;235;87;87m\x1b[38;2;0;0;0m Bug \x1b[0m" {
+		t.Errorf("truecolor chip = %q", got)
+	}
+	if got, _ := colorChip(Color256, "Done", "#5e6ad2"); got != "\x1b[48;5;62m\x1b[38;5;231m Done \x1b[0m" {
+		t.Errorf("256-color chip = %q", got)
+	}
+	for _, level := range []ColorLevel{ColorBasic, Color256, ColorTrue} {
+		if _, ok := colorChip(level, "x", "not a color"); ok {
+			t.Errorf("level %d rendered a chip for a bad color", level)
+		}
+	}
+	if _, ok := colorChip(ColorBasic, "x", "#ffffff"); ok {
+		t.Error("basic terminal got a chip")
+	}
+
+	// Colors off: plain text, whatever the terminal supports
+	defer func(level ColorLevel, noColor bool) { colorLevel, color.NoColor = level, noColor }(colorLevel, color.NoColor)
+	colorLevel, color.NoColor = ColorTrue, true
+	if got := ColorChip("Bug", "#eb5757", nil); got != "Bug" {
+		t.Errorf("ColorChip with colors off = %q", got)
+	}
+	color.NoColor = false
+	if got := ColorChip("Bug", "#eb5757", nil); got != "\x1b[48;2;235;87;87m\x1b[38;2;0;0;0m Bug \x1b[0m" {
+		t.Errorf("ColorChip with colors on = %q", got)
+	}
+}
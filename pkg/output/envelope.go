@@ -0,0 +1,47 @@
+package output
+
+import "time"
+
+// SchemaVersion versions the JSON shapes commands emit, reported in the
+// --json-envelope wrapper so consumers can detect breaking changes.
+//
+// Bump policy: increment it whenever a field of a pkg/api type is renamed,
+// removed, or changes type. Adding fields is not breaking and needs no bump.
+// TestJSONSchema in pkg/api enforces this against testdata/json_schema.golden.
+const SchemaVersion = "1"
+
+// Envelope wraps JSON output when --json-envelope is set
+type Envelope struct {
+	SchemaVersion string      `json:"schemaVersion"`
+	CLIVersion    string      `json:"cliVersion"`
+	Command       string      `json:"command"`
+	GeneratedAt   time.Time   `json:"generatedAt"`
+	Data          interface{} `json:"data,omitempty"`
+	Error         string      `json:"error,omitempty"`
+	Suggestions   []string    `json:"suggestions,omitempty"`
+}
+
+var envelope *Envelope
+
+// SetEnvelope makes JSON and Error wrap their output in an Envelope naming
+// command (e.g. "issue list") and the CLI version
+func SetEnvelope(command, cliVersion string) {
+	envelope = &Envelope{SchemaVersion: SchemaVersion, CLIVersion: cliVersion, Command: command}
+}
+
+// ClearEnvelope turns envelope wrapping off again
+func ClearEnvelope() {
+	envelope = nil
+}
+
+// wrap returns data inside the current envelope, or data unchanged when
+// envelopes are off
+func wrap(data interface{}) interface{} {
+	if envelope == nil {
+		return data
+	}
+	e := *envelope
+	e.GeneratedAt = time.Now().UTC()
+	e.Data = data
+	return e
+}
@@ -0,0 +1,43 @@
+package output
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	data := []string{"a"}
+	if got := wrap(data); !isSameSlice(got, data) {
+		t.Fatalf("without an envelope wrap should return data unchanged, got %#v", got)
+	}
+
+	SetEnvelope("issue list", "1.2.3")
+	defer ClearEnvelope()
+	raw, err := json.Marshal(wrap(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[string]string{"schemaVersion": SchemaVersion, "cliVersion": "1.2.3", "command": "issue list"} {
+		if got[key] != want {
+			t.Errorf("%s = %v, want %q", key, got[key], want)
+		}
+	}
+	if _, ok := got["generatedAt"].(string); !ok {
+		t.Errorf("generatedAt missing: %v", got)
+	}
+	if items, ok := got["data"].([]interface{}); !ok || len(items) != 1 || items[0] != "a" {
+		t.Errorf("data = %v, want [a]", got["data"])
+	}
+	if _, ok := got["error"]; ok {
+		t.Errorf("error should be omitted on success: %v", got)
+	}
+}
+
+func isSameSlice(v interface{}, want []string) bool {
+	s, ok := v.([]string)
+	return ok && len(s) == len(want) && &s[0] == &want[0]
+}
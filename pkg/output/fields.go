@@ -0,0 +1,149 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// fieldTree is a set of dot-paths split into a tree by path segment. A node
+// with no children keeps its whole value.
+type fieldTree map[string]fieldTree
+
+// ParseFields splits a --fields value such as "identifier,state.name" into
+// dot-paths, dropping blanks and surrounding spaces
+func ParseFields(value string) []string {
+	var paths []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// SelectFields returns data's JSON form with only the given dot-paths kept,
+// e.g. "identifier" or "state.name". Arrays are filtered element by element,
+// so "labels.nodes.name" keeps each label's name. A null on the way down a
+// path is kept as null. Paths found nowhere are left out, or with strict
+// reported as an error.
+func SelectFields(data interface{}, paths []string, strict bool) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var doc interface{}
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	tree := fieldTree{}
+	for _, path := range paths {
+		node := tree
+		for _, key := range strings.Split(path, ".") {
+			if node[key] == nil {
+				node[key] = fieldTree{}
+			}
+			node = node[key]
+		}
+	}
+	// A path asked for whole covers any longer ones under it, so the
+	// shortest paths are cut back last
+	byDepth := append([]string(nil), paths...)
+	sort.SliceStable(byDepth, func(i, j int) bool {
+		return strings.Count(byDepth[i], ".") > strings.Count(byDepth[j], ".")
+	})
+	for _, path := range byDepth {
+		keys := strings.Split(path, ".")
+		node := tree
+		for _, key := range keys[:len(keys)-1] {
+			node = node[key]
+		}
+		node[keys[len(keys)-1]] = fieldTree{}
+	}
+
+	found := map[string]bool{}
+	selected, ok := tree.selectFrom(doc, "", found)
+	if !ok {
+		selected = nil
+	}
+	if strict {
+		var missing []string
+		for _, path := range paths {
+			if !pathFound(path, found) {
+				missing = append(missing, path)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("unknown field(s): %s", strings.Join(missing, ", "))
+		}
+	}
+	return selected, nil
+}
+
+// selectFrom keeps the parts of v the tree names, recording the full paths
+// it found under prefix. It reports false for a scalar, which can't have the
+// nested fields asked of it.
+func (t fieldTree) selectFrom(v interface{}, prefix string, found map[string]bool) (interface{}, bool) {
+	switch v := v.(type) {
+	case []interface{}:
+		out := []interface{}{}
+		for _, elem := range v {
+			if kept, ok := t.selectFrom(elem, prefix, found); ok {
+				out = append(out, kept)
+			}
+		}
+		return out, true
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for key, sub := range t {
+			value, ok := v[key]
+			if !ok {
+				continue
+			}
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			if len(sub) == 0 || value == nil {
+				out[key] = value
+				sub.markFound(path, found)
+			} else if kept, ok := sub.selectFrom(value, path, found); ok {
+				out[key] = kept
+			}
+		}
+		return out, true
+	default:
+		return nil, false
+	}
+}
+
+// markFound records every path at or under prefix as found, for a value
+// kept whole or a null that stands in for everything below it
+func (t fieldTree) markFound(prefix string, found map[string]bool) {
+	if len(t) == 0 {
+		found[prefix] = true
+	}
+	for key, sub := range t {
+		sub.markFound(prefix+"."+key, found)
+	}
+}
+
+// pathFound reports whether path, or a path above it that was kept whole,
+// was found
+func pathFound(path string, found map[string]bool) bool {
+	for {
+		if found[path] {
+			return true
+		}
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return false
+		}
+		path = path[:i]
+	}
+}
@@ -0,0 +1,78 @@
+package output
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSelectFields(t *testing.T) {
+	type state struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+	type label struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	issue := struct {
+		Identifier string  `json:"identifier"`
+		Title      string  `json:"title"`
+		Estimate   float64 `json:"estimate"`
+		State      *state  `json:"state"`
+		Assignee   *state  `json:"assignee"`
+		Labels     struct {
+			Nodes []label `json:"nodes"`
+		} `json:"labels"`
+	}{Identifier: "ENG-1", Title: "Fix", Estimate: 2, State: &state{"Todo", "unstarted"}}
+	issue.Labels.Nodes = []label{{"l1", "bug"}, {"l2", "ui"}}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  string
+	}{
+		{"top level", []string{"identifier", "estimate"}, `{"estimate":2,"identifier":"ENG-1"}`},
+		{"nested", []string{"state.name"}, `{"state":{"name":"Todo"}}`},
+		{"null on the way down", []string{"assignee.name"}, `{"assignee":null}`},
+		{"arrays element-wise", []string{"labels.nodes.name"}, `{"labels":{"nodes":[{"name":"bug"},{"name":"ui"}]}}`},
+		{"whole object", []string{"state"}, `{"state":{"name":"Todo","type":"unstarted"}}`},
+		{"whole object covers its fields", []string{"state.name", "state"}, `{"state":{"name":"Todo","type":"unstarted"}}`},
+		{"missing omitted", []string{"identifier", "nope", "state.nope", "title.nope"}, `{"identifier":"ENG-1","state":{}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectFields(issue, tt.paths, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			raw, _ := json.Marshal(got)
+			if string(raw) != tt.want {
+				t.Errorf("got  %s\nwant %s", raw, tt.want)
+			}
+		})
+	}
+
+	// A list is filtered item by item
+	got, err := SelectFields([]interface{}{issue, issue}, []string{"identifier"}, false)
+	if raw, _ := json.Marshal(got); err != nil || string(raw) != `[{"identifier":"ENG-1"},{"identifier":"ENG-1"}]` {
+		t.Errorf("list = %s, %v", raw, err)
+	}
+}
+
+func TestSelectFieldsStrict(t *testing.T) {
+	data := map[string]interface{}{"id": "x", "state": map[string]string{"name": "Todo"}, "lead": nil}
+	if _, err := SelectFields(data, []string{"id", "state.name", "lead.email", "state"}, true); err != nil {
+		t.Errorf("all paths present: %v", err)
+	}
+	_, err := SelectFields(data, []string{"id", "state.color", "nope"}, true)
+	if err == nil || err.Error() != "unknown field(s): state.color, nope" {
+		t.Errorf("err = %v", err)
+	}
+}
+
+func TestParseFields(t *testing.T) {
+	if got := ParseFields(" identifier, state.name,,title "); !reflect.DeepEqual(got, []string{"identifier", "state.name", "title"}) {
+		t.Errorf("ParseFields = %q", got)
+	}
+}
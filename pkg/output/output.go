@@ -2,9 +2,11 @@ package output
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
@@ -16,9 +18,14 @@ type TableData struct {
 	Rows    [][]string
 }
 
-// JSON outputs data as JSON
+// JSON outputs data as JSON, inside an Envelope when one is set
 func JSON(data interface{}) {
-	jsonData, err := json.MarshalIndent(data, "", "  ")
+	writeJSON(wrap(data))
+}
+
+// writeJSON prints v as indented JSON
+func writeJSON(v interface{}) {
+	jsonData, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
 		os.Exit(1)
@@ -26,12 +33,43 @@ func JSON(data interface{}) {
 	fmt.Println(string(jsonData))
 }
 
-// Error outputs an error message
-func Error(message string, plaintext, jsonOut bool) {
-	if jsonOut {
-		JSON(map[string]interface{}{
+// suggester is an error for a reference (team key, user, label, ...) that
+// matched nothing, carrying the closest candidates
+type suggester interface {
+	ClosestMatches() []string
+}
+
+// Suggestions returns the closest matches carried by the first of errs, or
+// anything it wraps, for a reference that matched nothing
+func Suggestions(errs ...error) []string {
+	for _, err := range errs {
+		var s suggester
+		if errors.As(err, &s) {
+			return s.ClosestMatches()
+		}
+	}
+	return nil
+}
+
+// Error outputs an error message. causes are the errors behind it: when one
+// is a reference that matched nothing, JSON output also lists the closest
+// matches under "suggestions"; the message already names them.
+func Error(message string, plaintext, jsonOut bool, causes ...error) {
+	suggestions := Suggestions(causes...)
+	if jsonOut && envelope != nil {
+		e := *envelope
+		e.GeneratedAt = time.Now().UTC()
+		e.Error = message
+		e.Suggestions = suggestions
+		writeJSON(e)
+	} else if jsonOut {
+		resp := map[string]interface{}{
 			"error": message,
-		})
+		}
+		if len(suggestions) > 0 {
+			resp["suggestions"] = suggestions
+		}
+		JSON(resp)
 	} else if plaintext {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", message)
 	} else {
@@ -0,0 +1,127 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// ProgressMode selects how a Progress reports on stderr
+type ProgressMode string
+
+const (
+	// ProgressAuto shows a spinner and percentage when stderr is a terminal
+	ProgressAuto ProgressMode = "auto"
+	// ProgressJSON writes one NDJSON event per line
+	ProgressJSON ProgressMode = "json"
+	// ProgressNone reports nothing
+	ProgressNone ProgressMode = "none"
+)
+
+// ParseProgressMode validates a --progress value
+func ParseProgressMode(s string) (ProgressMode, error) {
+	switch mode := ProgressMode(strings.ToLower(s)); mode {
+	case ProgressAuto, ProgressJSON, ProgressNone:
+		return mode, nil
+	case "":
+		return ProgressAuto, nil
+	default:
+		return "", fmt.Errorf("invalid progress mode %q (must be auto, json, or none)", s)
+	}
+}
+
+// ProgressEvent is one NDJSON line in json mode. Event is "start",
+// "progress", or "done".
+type ProgressEvent struct {
+	Event  string `json:"event"`
+	Done   int    `json:"done"`
+	Total  int    `json:"total"`
+	Entity string `json:"entity,omitempty"`
+	Label  string `json:"label,omitempty"`
+}
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Progress reports how far a long-running command has got. A nil *Progress
+// is valid and reports nothing, so callers need not check the mode.
+type Progress struct {
+	mu    sync.Mutex
+	w     io.Writer
+	mode  ProgressMode
+	label string
+	total int
+	done  int
+	frame int
+}
+
+// NewProgress starts a reporter for total items and emits the start event.
+// In auto mode it returns nil unless isTTY is set.
+func NewProgress(w io.Writer, mode ProgressMode, isTTY bool, label string, total int) *Progress {
+	if mode == ProgressNone || (mode == ProgressAuto && !isTTY) {
+		return nil
+	}
+	p := &Progress{w: w, mode: mode, label: label, total: total}
+	if mode == ProgressJSON {
+		p.emit(ProgressEvent{Event: "start", Total: total, Label: label})
+	}
+	return p
+}
+
+// Step records one finished item, named by entity (e.g. "ROB-57")
+func (p *Progress) Step(entity string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.done++
+	if p.mode == ProgressJSON {
+		p.emit(ProgressEvent{Event: "progress", Done: p.done, Total: p.total, Entity: entity})
+		return
+	}
+
+	frame := spinnerFrames[p.frame%len(spinnerFrames)]
+	p.frame++
+	pct := 100
+	if p.total > 0 {
+		pct = p.done * 100 / p.total
+	}
+	fmt.Fprintf(p.w, "\r\033[K%s %s %d/%d (%d%%) %s", frame, p.label, p.done, p.total, pct, entity)
+}
+
+// Clear erases the spinner line so regular output can be printed; it is a
+// no-op in json mode
+func (p *Progress) Clear() {
+	if p == nil || p.mode == ProgressJSON {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprint(p.w, "\r\033[K")
+}
+
+// Finish emits the done event, or clears the spinner line
+func (p *Progress) Finish() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.mode == ProgressJSON {
+		p.emit(ProgressEvent{Event: "done", Done: p.done, Total: p.total})
+		return
+	}
+	fmt.Fprint(p.w, "\r\033[K")
+}
+
+func (p *Progress) emit(e ProgressEvent) {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(p.w, "%s\n", data)
+}
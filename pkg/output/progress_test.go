@@ -0,0 +1,85 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseProgressMode(t *testing.T) {
+	for in, want := range map[string]ProgressMode{"": ProgressAuto, "auto": ProgressAuto, "JSON": ProgressJSON, "none": ProgressNone} {
+		got, err := ParseProgressMode(in)
+		if err != nil || got != want {
+			t.Errorf("ParseProgressMode(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	if _, err := ParseProgressMode("verbose"); err == nil {
+		t.Error("expected error for unknown mode")
+	}
+}
+
+func TestProgressJSONEvents(t *testing.T) {
+	var buf bytes.Buffer
+	p := NewProgress(&buf, ProgressJSON, false, "Moving issues", 2)
+	p.Step("ROB-1")
+	p.Step("ROB-2")
+	p.Finish()
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	want := []ProgressEvent{
+		{Event: "start", Total: 2, Label: "Moving issues"},
+		{Event: "progress", Done: 1, Total: 2, Entity: "ROB-1"},
+		{Event: "progress", Done: 2, Total: 2, Entity: "ROB-2"},
+		{Event: "done", Done: 2, Total: 2},
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(want), buf.String())
+	}
+	for i, line := range lines {
+		var got ProgressEvent
+		if err := json.Unmarshal([]byte(line), &got); err != nil {
+			t.Fatalf("line %d is not JSON: %q", i, line)
+		}
+		if got != want[i] {
+			t.Errorf("line %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	// The documented wire format
+	if lines[1] != `{"event":"progress","done":1,"total":2,"entity":"ROB-1"}` {
+		t.Errorf("progress line = %s", lines[1])
+	}
+}
+
+func TestProgressAutoAndNone(t *testing.T) {
+	var buf bytes.Buffer
+	if p := NewProgress(&buf, ProgressAuto, false, "x", 3); p != nil {
+		t.Error("auto mode without a TTY should report nothing")
+	}
+	if p := NewProgress(&buf, ProgressNone, true, "x", 3); p != nil {
+		t.Error("none mode should report nothing")
+	}
+
+	// A nil reporter is safe to use
+	var p *Progress
+	p.Step("ROB-1")
+	p.Finish()
+	if buf.Len() != 0 {
+		t.Errorf("expected no output, got %q", buf.String())
+	}
+
+	p = NewProgress(&buf, ProgressAuto, true, "Moving", 4)
+	p.Step("ROB-9")
+	if got := buf.String(); !strings.Contains(got, "Moving 1/4 (25%) ROB-9") {
+		t.Errorf("spinner line = %q", got)
+	}
+	p.Clear()
+	if !strings.HasSuffix(buf.String(), "\r\033[K") {
+		t.Errorf("Clear should erase the spinner line, got %q", buf.String())
+	}
+	p.Finish()
+	if !strings.HasSuffix(buf.String(), "\r\033[K") {
+		t.Errorf("Finish should clear the spinner line, got %q", buf.String())
+	}
+}
@@ -0,0 +1,83 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONArrayWriter writes a JSON array one element at a time, so a long list
+// can be printed as its pages arrive. The output matches what JSON prints
+// for the whole slice. Close ends the array, so output cut short by an
+// error is still valid JSON.
+//
+// An Envelope needs the whole array before it can be written, so with one
+// set the writer holds the elements back and Close prints them wrapped.
+type JSONArrayWriter struct {
+	w         io.Writer
+	count     int
+	held      []json.RawMessage
+	enveloped bool
+}
+
+// NewJSONArrayWriter starts an array on w. Nothing is written until the
+// first element or Close.
+func NewJSONArrayWriter(w io.Writer) *JSONArrayWriter {
+	return &JSONArrayWriter{w: w, enveloped: envelope != nil}
+}
+
+// Write adds v to the array
+func (a *JSONArrayWriter) Write(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	a.count++
+	if a.enveloped {
+		a.held = append(a.held, data)
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "  ", "  "); err != nil {
+		return err
+	}
+	sep := ",\n  "
+	if a.count == 1 {
+		sep = "[\n  "
+	}
+	_, err = fmt.Fprint(a.w, sep, buf.String())
+	return err
+}
+
+// Count is how many elements were written
+func (a *JSONArrayWriter) Count() int {
+	return a.count
+}
+
+// Started reports whether any of the array has reached w yet
+func (a *JSONArrayWriter) Started() bool {
+	return !a.enveloped && a.count > 0
+}
+
+// Close ends the array, printing [] when it is empty
+func (a *JSONArrayWriter) Close() error {
+	if a.enveloped {
+		held := a.held
+		if held == nil {
+			held = []json.RawMessage{}
+		}
+		data, err := json.MarshalIndent(wrap(held), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(a.w, string(data))
+		return err
+	}
+	if a.count == 0 {
+		_, err := fmt.Fprintln(a.w, "[]")
+		return err
+	}
+	_, err := fmt.Fprint(a.w, "\n]\n")
+	return err
+}
@@ -0,0 +1,49 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONArrayWriter(t *testing.T) {
+	items := []map[string]interface{}{{"id": "a", "tags": []string{"x"}}, {"id": "b"}}
+	want, _ := json.MarshalIndent(items, "", "  ")
+
+	var buf bytes.Buffer
+	a := NewJSONArrayWriter(&buf)
+	for _, item := range items {
+		if err := a.Write(item); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(want)+"\n" || a.Count() != 2 {
+		t.Errorf("streamed %d elements as:\n%s\nwant:\n%s", a.Count(), buf.String(), want)
+	}
+
+	buf.Reset()
+	if NewJSONArrayWriter(&buf).Close(); buf.String() != "[]\n" {
+		t.Errorf("empty array = %q", buf.String())
+	}
+
+	// With an envelope the elements are held back and printed wrapped
+	SetEnvelope("issue list", "1.2.3")
+	defer ClearEnvelope()
+	buf.Reset()
+	a = NewJSONArrayWriter(&buf)
+	_ = a.Write(items[0])
+	if buf.Len() != 0 {
+		t.Errorf("wrote before Close under an envelope: %s", buf.String())
+	}
+	_ = a.Close()
+	var got Envelope
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil || got.Command != "issue list" {
+		t.Fatalf("enveloped output %s (%v)", buf.String(), err)
+	}
+	if data, _ := got.Data.([]interface{}); len(data) != 1 {
+		t.Errorf("enveloped data = %v", got.Data)
+	}
+}
@@ -0,0 +1,39 @@
+package output
+
+import (
+	"os"
+	"strconv"
+)
+
+// Default terminal size when neither the terminal nor the environment says
+const (
+	DefaultWidth  = 80
+	DefaultHeight = 24
+)
+
+// TerminalSize returns the size of the terminal on stdout in columns and
+// rows. When stdout isn't a terminal, COLUMNS and LINES are used, and then
+// DefaultWidth and DefaultHeight. It asks the terminal every time, so a
+// resized window or tmux pane is picked up on the next call.
+func TerminalSize() (width, height int) {
+	if w, h, ok := terminalSize(os.Stdout); ok && w > 0 {
+		if h <= 0 {
+			h = DefaultHeight
+		}
+		return w, h
+	}
+	return envSize(os.Getenv)
+}
+
+// envSize reads the size from COLUMNS and LINES, with the defaults for
+// whichever is missing or invalid
+func envSize(getenv func(string) string) (width, height int) {
+	width, height = DefaultWidth, DefaultHeight
+	if n, err := strconv.Atoi(getenv("COLUMNS")); err == nil && n > 0 {
+		width = n
+	}
+	if n, err := strconv.Atoi(getenv("LINES")); err == nil && n > 0 {
+		height = n
+	}
+	return width, height
+}
@@ -0,0 +1,10 @@
+//go:build !unix && !windows
+
+package output
+
+import "os"
+
+// terminalSize can't ask the terminal on this platform
+func terminalSize(f *os.File) (width, height int, ok bool) {
+	return 0, 0, false
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package output
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// terminalSize asks the console on f for the size of its visible window
+func terminalSize(f *os.File) (width, height int, ok bool) {
+	var info windows.ConsoleScreenBufferInfo
+	if err := windows.GetConsoleScreenBufferInfo(windows.Handle(f.Fd()), &info); err != nil {
+		return 0, 0, false
+	}
+	return int(info.Window.Right-info.Window.Left) + 1, int(info.Window.Bottom-info.Window.Top) + 1, true
+}
@@ -0,0 +1,103 @@
+package output
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeFormat selects how FormatTime renders a timestamp
+type TimeFormat int
+
+const (
+	// DateOnly renders "2006-01-02"
+	DateOnly TimeFormat = iota
+	// DateTime renders "2006-01-02 15:04:05"
+	DateTime
+	// DateTimeShort renders "2006-01-02 15:04"
+	DateTimeShort
+	// Relative renders "3h ago", "2d ago", or "in 5m" for future times
+	Relative
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	dateTimeLayout = "2006-01-02 15:04:05"
+	shortLayout    = "2006-01-02 15:04"
+)
+
+var forceUTC bool
+
+// SetUTC makes FormatTime render in UTC instead of the local timezone
+func SetUTC(utc bool) {
+	forceUTC = utc
+}
+
+// Location returns the timezone timestamps are rendered in
+func Location() *time.Location {
+	if forceUTC {
+		return time.UTC
+	}
+	return time.Local
+}
+
+// FormatTime renders a timestamp for display in the configured timezone.
+// Zero times render as an empty string.
+func FormatTime(t time.Time, format TimeFormat) string {
+	return formatTimeAt(t, format, time.Now())
+}
+
+func formatTimeAt(t time.Time, format TimeFormat, now time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	switch format {
+	case Relative:
+		return RelativeTime(t, now)
+	case DateTime:
+		return t.In(Location()).Format(dateTimeLayout)
+	case DateTimeShort:
+		return t.In(Location()).Format(shortLayout)
+	default:
+		return t.In(Location()).Format(dateLayout)
+	}
+}
+
+// TableTimeFormat returns the format for Created/Updated table columns:
+// relative in the rich table, absolute dates in plaintext and JSON.
+func TableTimeFormat(plaintext, jsonOut bool) TimeFormat {
+	if plaintext || jsonOut {
+		return DateOnly
+	}
+	return Relative
+}
+
+// RelativeTime renders the distance between t and now in compact units,
+// e.g. "just now", "45m ago", "3h ago", "2d ago", "4mo ago", "1y ago".
+func RelativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+
+	var span string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		span = fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		span = fmt.Sprintf("%dd", int(d.Hours()/24))
+	case d < 365*24*time.Hour:
+		span = fmt.Sprintf("%dmo", int(d.Hours()/(24*30)))
+	default:
+		span = fmt.Sprintf("%dy", int(d.Hours()/(24*365)))
+	}
+
+	if future {
+		return "in " + span
+	}
+	return span + " ago"
+}
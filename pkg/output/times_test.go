@@ -0,0 +1,93 @@
+package output
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		ago  time.Duration
+		want string
+	}{
+		{"seconds", 30 * time.Second, "just now"},
+		{"one minute", time.Minute, "1m ago"},
+		{"under an hour", 59*time.Minute + 59*time.Second, "59m ago"},
+		{"one hour", time.Hour, "1h ago"},
+		{"under a day", 23*time.Hour + 59*time.Minute, "23h ago"},
+		{"one day", 24 * time.Hour, "1d ago"},
+		{"under thirty days", 29 * 24 * time.Hour, "29d ago"},
+		{"thirty days", 30 * 24 * time.Hour, "1mo ago"},
+		{"under a year", 364 * 24 * time.Hour, "12mo ago"},
+		{"one year", 365 * 24 * time.Hour, "1y ago"},
+		{"future", -3 * time.Hour, "in 3h"},
+		{"near future", -20 * time.Second, "just now"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeTime(now.Add(-tt.ago), now); got != tt.want {
+				t.Errorf("RelativeTime(-%s) = %q, want %q", tt.ago, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatTimeTimezone(t *testing.T) {
+	origLocal := time.Local
+	defer func() {
+		time.Local = origLocal
+		SetUTC(false)
+	}()
+	time.Local = time.FixedZone("UTC-7", -7*60*60)
+
+	ts := time.Date(2024, 6, 1, 3, 30, 0, 0, time.UTC)
+	now := ts.Add(2 * time.Hour)
+
+	SetUTC(false)
+	if got := formatTimeAt(ts, DateTime, now); got != "2024-05-31 20:30:00" {
+		t.Errorf("local DateTime = %q, want %q", got, "2024-05-31 20:30:00")
+	}
+	if got := formatTimeAt(ts, DateTimeShort, now); got != "2024-05-31 20:30" {
+		t.Errorf("local DateTimeShort = %q, want %q", got, "2024-05-31 20:30")
+	}
+	if got := formatTimeAt(ts, DateOnly, now); got != "2024-05-31" {
+		t.Errorf("local DateOnly = %q, want %q", got, "2024-05-31")
+	}
+
+	SetUTC(true)
+	if got := formatTimeAt(ts, DateTime, now); got != "2024-06-01 03:30:00" {
+		t.Errorf("UTC DateTime = %q, want %q", got, "2024-06-01 03:30:00")
+	}
+	if got := formatTimeAt(ts, DateOnly, now); got != "2024-06-01" {
+		t.Errorf("UTC DateOnly = %q, want %q", got, "2024-06-01")
+	}
+
+	// Relative output does not depend on the timezone
+	if got := formatTimeAt(ts, Relative, now); got != "2h ago" {
+		t.Errorf("Relative = %q, want %q", got, "2h ago")
+	}
+}
+
+func TestFormatTimeZero(t *testing.T) {
+	for _, f := range []TimeFormat{DateOnly, DateTime, DateTimeShort, Relative} {
+		if got := FormatTime(time.Time{}, f); got != "" {
+			t.Errorf("FormatTime(zero, %d) = %q, want empty", f, got)
+		}
+	}
+}
+
+func TestTableTimeFormat(t *testing.T) {
+	if got := TableTimeFormat(false, false); got != Relative {
+		t.Errorf("rich table format = %d, want Relative", got)
+	}
+	if got := TableTimeFormat(true, false); got != DateOnly {
+		t.Errorf("plaintext table format = %d, want DateOnly", got)
+	}
+	if got := TableTimeFormat(false, true); got != DateOnly {
+		t.Errorf("JSON table format = %d, want DateOnly", got)
+	}
+}
@@ -0,0 +1,101 @@
+package output
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Ellipsis ends text cut by TruncateWidth
+const Ellipsis = "…"
+
+// TextWidth returns how many terminal columns s takes: two for wide
+// characters like CJK and most emoji, none for combining marks, and an
+// emoji sequence as wide as its first emoji
+func TextWidth(s string) int {
+	width, prev := 0, rune(0)
+	for _, r := range s {
+		width += charWidth(r, prev)
+		prev = r
+	}
+	return width
+}
+
+// charWidth is the width r adds after prev: none when it extends the
+// character before it
+func charWidth(r, prev rune) int {
+	if extendsCluster(r) || prev == zeroWidthJoiner {
+		return 0
+	}
+	return runewidth.RuneWidth(r)
+}
+
+// TruncateWidth cuts s to at most width columns, ending it with an ellipsis
+// when anything was cut. It only cuts between whole characters: an emoji
+// keeps its skin tone, variation selector, and zero-width-joined parts, or
+// goes entirely, and a flag's two letters stay together.
+func TruncateWidth(s string, width int) string {
+	if TextWidth(s) <= width {
+		return s
+	}
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(s)
+	room := width - TextWidth(Ellipsis)
+	cut, used := 0, 0
+	for cut < len(runes) {
+		prev := rune(0)
+		if cut > 0 {
+			prev = runes[cut-1]
+		}
+		w := charWidth(runes[cut], prev)
+		if used+w > room {
+			break
+		}
+		used += w
+		cut++
+	}
+	cut = clusterStart(runes, cut)
+	return strings.TrimRight(string(runes[:cut]), " ") + Ellipsis
+}
+
+// PadWidth pads s with spaces to width columns
+func PadWidth(s string, width int) string {
+	if pad := width - TextWidth(s); pad > 0 {
+		return s + strings.Repeat(" ", pad)
+	}
+	return s
+}
+
+// clusterStart moves a cut before runes[cut] back so it doesn't split a
+// flag. Parts that extend an emoji take no width, so they are never cut off.
+func clusterStart(runes []rune, cut int) int {
+	if cut == len(runes) || !isRegionalIndicator(runes[cut]) {
+		return cut
+	}
+	letters := 0
+	for i := cut - 1; i >= 0 && isRegionalIndicator(runes[i]); i-- {
+		letters++
+	}
+	return cut - letters%2
+}
+
+const zeroWidthJoiner = '\u200d'
+
+// extendsCluster reports whether r belongs to the character before it
+func extendsCluster(r rune) bool {
+	switch {
+	case r == zeroWidthJoiner,
+		r >= 0xfe00 && r <= 0xfe0f,   // variation selectors
+		r >= 0x1f3fb && r <= 0x1f3ff, // skin tones
+		r == 0x20e3,                  // combining keycap
+		r >= 0xe0020 && r <= 0xe007f: // tag sequences
+		return true
+	}
+	return runewidth.RuneWidth(r) == 0 && r >= 0x300
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1f1e6 && r <= 0x1f1ff
+}
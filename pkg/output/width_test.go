@@ -0,0 +1,70 @@
+package output
+
+import "testing"
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		want int
+	}{
+		{"Fix login", 9},
+		{"日本語", 6},
+		{"café", 4},
+		{"café", 4},
+		{"👩‍💻 pairing", 10},
+		{"👍🏽", 2},
+		{"❤️", 1},
+		{"🇺🇸", 2},
+	}
+	for _, tt := range tests {
+		if got := TextWidth(tt.s); got != tt.want {
+			t.Errorf("TextWidth(%q) = %d, want %d", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestTruncateWidth(t *testing.T) {
+	tests := []struct {
+		s     string
+		width int
+		want  string
+	}{
+		{"Fix login", 9, "Fix login"},
+		{"Fix login", 8, "Fix log…"},
+		{"Fix login", 4, "Fix…"},
+		{"Fix login", 1, "…"},
+		{"Fix login", 0, ""},
+		// Wide characters never end up half shown
+		{"日本語のタイトル", 6, "日本…"},
+		{"日本語のタイトル", 5, "日本…"},
+		// An emoji keeps its joined parts, skin tone, and selector, or goes
+		{"ok 👩‍💻 pairing", 6, "ok 👩‍💻…"},
+		{"ok 👩‍💻 pairing", 5, "ok…"},
+		{"ok 👍🏽 thanks", 6, "ok 👍🏽…"},
+		{"ok 👍🏽 thanks", 5, "ok…"},
+		{"love ❤️ it", 7, "love ❤️…"},
+		{"café menu", 5, "café…"},
+		// A flag's two letters stay together
+		{"ship 🇺🇸🇬🇧 release", 8, "ship 🇺🇸…"},
+		{"ship 🇺🇸🇬🇧 release", 9, "ship 🇺🇸…"},
+	}
+	for _, tt := range tests {
+		got := TruncateWidth(tt.s, tt.width)
+		if got != tt.want {
+			t.Errorf("TruncateWidth(%q, %d) = %q, want %q", tt.s, tt.width, got, tt.want)
+		}
+		if TextWidth(got) > tt.width {
+			t.Errorf("TruncateWidth(%q, %d) = %q is %d columns", tt.s, tt.width, got, TextWidth(got))
+		}
+	}
+}
+
+func TestEnvSize(t *testing.T) {
+	env := map[string]string{"COLUMNS": "48", "LINES": "x"}
+	if w, h := envSize(func(k string) string { return env[k] }); w != 48 || h != DefaultHeight {
+		t.Errorf("envSize = %dx%d, want 48x%d", w, h, DefaultHeight)
+	}
+	if w, h := envSize(func(string) string { return "" }); w != DefaultWidth || h != DefaultHeight {
+		t.Errorf("envSize with nothing set = %dx%d", w, h)
+	}
+}
@@ -0,0 +1,128 @@
+package repoconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the repo-local config file looked up from the working directory.
+const FileName = ".linear-cli.yaml"
+
+// Mapping is the team (and optional project) used for a directory.
+type Mapping struct {
+	Path    string `yaml:"-"`
+	Team    string `yaml:"team"`
+	Project string `yaml:"project,omitempty"`
+}
+
+// Config is a repo-local .linear-cli.yaml with directory mappings, e.g.
+//
+//	paths:
+//	  services/api: {team: API, project: "..."}
+//	  web: {team: WEB}
+type Config struct {
+	// File is the path the config was read from; paths are relative to its directory
+	File  string             `yaml:"-"`
+	Paths map[string]Mapping `yaml:"paths"`
+}
+
+// Find looks for FileName in dir and its parents, stopping at the repository
+// root (the first directory containing .git). It returns nil when no config
+// with path mappings is found.
+func Find(dir string) (*Config, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		path := filepath.Join(dir, FileName)
+		if _, err := os.Stat(path); err == nil {
+			cfg, err := Load(path)
+			if err != nil {
+				return nil, err
+			}
+			if len(cfg.Paths) > 0 {
+				return cfg, nil
+			}
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return nil, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return nil, nil
+		}
+		dir = parent
+	}
+}
+
+// Load reads a config file.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	cfg.File = path
+	return &cfg, nil
+}
+
+// Resolve returns the mapping for dir, preferring the most specific (longest)
+// matching path. It returns nil when dir is outside every mapped path.
+func (c *Config) Resolve(dir string) (*Mapping, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := filepath.Rel(filepath.Dir(c.File), abs)
+	if err != nil {
+		return nil, nil
+	}
+	return Match(c.Paths, filepath.ToSlash(rel)), nil
+}
+
+// Match finds the longest key of paths that equals rel or is a parent
+// directory of it. Keys are slash-separated and relative to the config file;
+// "." maps the whole repository.
+func Match(paths map[string]Mapping, rel string) *Mapping {
+	rel = strings.Trim(rel, "/")
+	if rel == "" || rel == "." {
+		rel = "."
+	}
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return nil
+	}
+
+	keys := make([]string, 0, len(paths))
+	for k := range paths {
+		keys = append(keys, k)
+	}
+	// Longest first so nested mappings win over their parents
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	for _, k := range keys {
+		key := strings.Trim(filepath.ToSlash(k), "/")
+		if key == "" {
+			key = "."
+		}
+		if key == "." || rel == key || strings.HasPrefix(rel, key+"/") {
+			m := paths[k]
+			m.Path = key
+			return &m
+		}
+	}
+	return nil
+}
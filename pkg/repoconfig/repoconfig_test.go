@@ -0,0 +1,114 @@
+package repoconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	paths := map[string]Mapping{
+		"services":     {Team: "PLAT"},
+		"services/api": {Team: "API", Project: "proj-1"},
+		"web/":         {Team: "WEB"},
+	}
+
+	tests := []struct {
+		rel      string
+		wantTeam string
+		wantPath string
+	}{
+		{"services/api", "API", "services/api"},
+		{"services/api/handlers/v2", "API", "services/api"},
+		{"services/worker", "PLAT", "services"},
+		{"services", "PLAT", "services"},
+		{"web/src", "WEB", "web"},
+		{"services/apiary", "PLAT", "services"},
+		{"docs", "", ""},
+		{".", "", ""},
+		{"../elsewhere", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rel, func(t *testing.T) {
+			m := Match(paths, tt.rel)
+			if tt.wantTeam == "" {
+				if m != nil {
+					t.Fatalf("Match(%q) = %+v, want no match", tt.rel, m)
+				}
+				return
+			}
+			if m == nil {
+				t.Fatalf("Match(%q) = nil, want team %s", tt.rel, tt.wantTeam)
+			}
+			if m.Team != tt.wantTeam || m.Path != tt.wantPath {
+				t.Errorf("Match(%q) = %s at %s, want %s at %s", tt.rel, m.Team, m.Path, tt.wantTeam, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestMatchRootMapping(t *testing.T) {
+	paths := map[string]Mapping{".": {Team: "ALL"}, "api": {Team: "API"}}
+	if m := Match(paths, "docs"); m == nil || m.Team != "ALL" {
+		t.Errorf("root mapping not applied: %+v", m)
+	}
+	if m := Match(paths, "api/x"); m == nil || m.Team != "API" {
+		t.Errorf("nested mapping should beat root: %+v", m)
+	}
+}
+
+func TestFindAndResolve(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	cfg := "paths:\n  services/api:\n    team: API\n    project: proj-1\n"
+	if err := os.WriteFile(filepath.Join(root, FileName), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	nested := filepath.Join(root, "services", "api", "internal")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := Find(nested)
+	if err != nil || found == nil {
+		t.Fatalf("Find() = %v, %v; want config", found, err)
+	}
+	m, err := found.Resolve(nested)
+	if err != nil || m == nil {
+		t.Fatalf("Resolve() = %v, %v; want mapping", m, err)
+	}
+	if m.Team != "API" || m.Project != "proj-1" {
+		t.Errorf("Resolve() = %+v", m)
+	}
+
+	other := filepath.Join(root, "docs")
+	if err := os.Mkdir(other, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if m, _ := found.Resolve(other); m != nil {
+		t.Errorf("Resolve(docs) = %+v, want nil", m)
+	}
+}
+
+func TestFindStopsAtRepoRoot(t *testing.T) {
+	outer := t.TempDir()
+	cfg := "paths:\n  .:\n    team: OUT\n"
+	if err := os.WriteFile(filepath.Join(outer, FileName), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	repo := filepath.Join(outer, "repo")
+	if err := os.MkdirAll(filepath.Join(repo, ".git"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := Find(repo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != nil {
+		t.Errorf("Find() crossed the repo root and returned %s", found.File)
+	}
+}
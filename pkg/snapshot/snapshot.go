@@ -0,0 +1,171 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboalchemist/linear-cli/pkg/atomicfile"
+)
+
+// Item is the part of an issue a snapshot keeps: enough to tell whether it
+// was added, removed, or moved between states or assignees.
+type Item struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state,omitempty"`
+	Assignee   string `json:"assignee,omitempty"`
+	Priority   int    `json:"priority"`
+	URL        string `json:"url,omitempty"`
+}
+
+// Snapshot is the stored result set of a custom view at a point in time.
+type Snapshot struct {
+	Name      string    `json:"name"`
+	ViewID    string    `json:"viewId"`
+	ViewName  string    `json:"viewName,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Items     []Item    `json:"items"`
+}
+
+// FieldChange records one field that differs between snapshot and now.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Change is an issue present in both result sets whose state or assignee moved.
+type Change struct {
+	Item
+	Changes []FieldChange `json:"changes"`
+}
+
+// Diff is the difference between a snapshot and a fresh run of the view.
+type Diff struct {
+	Added   []Item   `json:"added"`
+	Removed []Item   `json:"removed"`
+	Changed []Change `json:"changed"`
+}
+
+// Empty reports whether nothing changed.
+func (d Diff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Dir returns the directory snapshots are stored in.
+// Uses $XDG_STATE_HOME/linear-cli/snapshots, defaulting to ~/.local/state.
+func Dir() (string, error) {
+	stateDir := os.Getenv("XDG_STATE_HOME")
+	if stateDir == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		stateDir = filepath.Join(homeDir, ".local", "state")
+	}
+	return filepath.Join(stateDir, "linear-cli", "snapshots"), nil
+}
+
+// Path returns the file a named snapshot is stored in.
+func Path(name string) (string, error) {
+	if err := validateName(name); err != nil {
+		return "", err
+	}
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name+".json"), nil
+}
+
+func validateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("invalid snapshot name %q", name)
+	}
+	return nil
+}
+
+// Save writes a snapshot, replacing any existing one with the same name.
+func Save(s *Snapshot) error {
+	path, err := Path(s.Name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = time.Now().UTC()
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return atomicfile.WriteFile(path, data, 0600)
+}
+
+// Load reads a named snapshot.
+func Load(name string) (*Snapshot, error) {
+	path, err := Path(name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("snapshot %q not found (save one with 'view run VIEW-ID --save-snapshot %s')", name, name)
+		}
+		return nil, err
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("snapshot %q is corrupt: %w", name, err)
+	}
+	return &s, nil
+}
+
+// Compare reports the issues added, removed, or changed (state or assignee)
+// in current relative to previous. Each list is sorted by identifier.
+func Compare(previous, current []Item) Diff {
+	before := make(map[string]Item, len(previous))
+	for _, item := range previous {
+		before[item.Identifier] = item
+	}
+	after := make(map[string]bool, len(current))
+
+	diff := Diff{Added: []Item{}, Removed: []Item{}, Changed: []Change{}}
+	for _, item := range current {
+		after[item.Identifier] = true
+		old, ok := before[item.Identifier]
+		if !ok {
+			diff.Added = append(diff.Added, item)
+			continue
+		}
+		var changes []FieldChange
+		if old.State != item.State {
+			changes = append(changes, FieldChange{Field: "state", From: old.State, To: item.State})
+		}
+		if old.Assignee != item.Assignee {
+			changes = append(changes, FieldChange{Field: "assignee", From: old.Assignee, To: item.Assignee})
+		}
+		if len(changes) > 0 {
+			diff.Changed = append(diff.Changed, Change{Item: item, Changes: changes})
+		}
+	}
+	for _, item := range previous {
+		if !after[item.Identifier] {
+			diff.Removed = append(diff.Removed, item)
+		}
+	}
+
+	sort.Slice(diff.Added, func(i, j int) bool { return diff.Added[i].Identifier < diff.Added[j].Identifier })
+	sort.Slice(diff.Removed, func(i, j int) bool { return diff.Removed[i].Identifier < diff.Removed[j].Identifier })
+	sort.Slice(diff.Changed, func(i, j int) bool { return diff.Changed[i].Identifier < diff.Changed[j].Identifier })
+	return diff
+}
@@ -0,0 +1,67 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	previous := []Item{
+		{Identifier: "ENG-1", Title: "Keep", State: "Todo", Assignee: "Ada"},
+		{Identifier: "ENG-2", Title: "Moves", State: "Todo", Assignee: "Ada"},
+		{Identifier: "ENG-3", Title: "Goes away", State: "Todo"},
+	}
+	current := []Item{
+		{Identifier: "ENG-4", Title: "New", State: "Triage"},
+		{Identifier: "ENG-2", Title: "Moves", State: "In Progress", Assignee: "Grace"},
+		{Identifier: "ENG-1", Title: "Keep (renamed)", State: "Todo", Assignee: "Ada"},
+	}
+
+	diff := Compare(previous, current)
+	got, _ := json.Marshal(diff)
+	want := `{"added":[{"identifier":"ENG-4","title":"New","state":"Triage","priority":0}],` +
+		`"removed":[{"identifier":"ENG-3","title":"Goes away","state":"Todo","priority":0}],` +
+		`"changed":[{"identifier":"ENG-2","title":"Moves","state":"In Progress","assignee":"Grace","priority":0,` +
+		`"changes":[{"field":"state","from":"Todo","to":"In Progress"},{"field":"assignee","from":"Ada","to":"Grace"}]}]}`
+	if string(got) != want {
+		t.Errorf("diff mismatch\n got: %s\nwant: %s", got, want)
+	}
+	if diff.Empty() {
+		t.Error("Empty() = true, want false")
+	}
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	items := []Item{{Identifier: "ENG-1", State: "Todo"}}
+	diff := Compare(items, items)
+	if !diff.Empty() {
+		t.Errorf("expected empty diff, got %+v", diff)
+	}
+	got, _ := json.Marshal(diff)
+	if string(got) != `{"added":[],"removed":[],"changed":[]}` {
+		t.Errorf("empty diff JSON = %s", got)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", t.TempDir())
+
+	s := &Snapshot{Name: "morning", ViewID: "view-1", Items: []Item{{Identifier: "ENG-1"}}}
+	if err := Save(s); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := Load("morning")
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if loaded.ViewID != "view-1" || len(loaded.Items) != 1 || loaded.CreatedAt.IsZero() {
+		t.Errorf("loaded snapshot = %+v", loaded)
+	}
+
+	if _, err := Load("missing"); err == nil {
+		t.Error("expected error for missing snapshot")
+	}
+	if err := Save(&Snapshot{Name: "../escape"}); err == nil {
+		t.Error("expected error for snapshot name with a path separator")
+	}
+}
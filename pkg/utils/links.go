@@ -0,0 +1,170 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// LinkInfo is what can be derived about a URL from its shape alone
+type LinkInfo struct {
+	Kind     string                 // e.g. "github-pull", "figma", "google-doc"
+	Title    string                 // e.g. "org/repo#123"
+	Subtitle string                 // e.g. "pull request"
+	Metadata map[string]interface{} // attachment metadata
+}
+
+// LinkDescriber recognizes one family of URLs. It returns false for URLs it
+// does not handle. Describers are pure URL parsers today; one that fetches
+// real titles can be put ahead of them in LinkDescribers.
+type LinkDescriber func(u *url.URL) (LinkInfo, bool)
+
+// LinkDescribers are tried in order by DescribeLink
+var LinkDescribers = []LinkDescriber{
+	describeGitHubLink,
+	describeFigmaLink,
+	describeGoogleDocsLink,
+}
+
+// DescribeLink derives a title and subtitle for well-known URL shapes
+// (GitHub pull requests and issues, Figma files, Google Docs)
+func DescribeLink(rawURL string) (LinkInfo, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return LinkInfo{}, false
+	}
+	for _, describe := range LinkDescribers {
+		if info, ok := describe(u); ok {
+			return info, true
+		}
+	}
+	return LinkInfo{}, false
+}
+
+// urlSegments returns the non-empty path segments of u
+func urlSegments(u *url.URL) []string {
+	var segments []string
+	for _, s := range strings.Split(u.Path, "/") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+	return segments
+}
+
+// hostIs reports whether u's host is domain or www.domain
+func hostIs(u *url.URL, domain string) bool {
+	host := strings.ToLower(u.Hostname())
+	return host == domain || host == "www."+domain
+}
+
+// describeGitHubLink handles github.com/{org}/{repo}/pull/{n} and /issues/{n}
+func describeGitHubLink(u *url.URL) (LinkInfo, bool) {
+	if !hostIs(u, "github.com") {
+		return LinkInfo{}, false
+	}
+	s := urlSegments(u)
+	if len(s) < 4 || !isDigits(s[3]) {
+		return LinkInfo{}, false
+	}
+
+	var kind, subtitle string
+	switch s[2] {
+	case "pull":
+		kind, subtitle = "github-pull", "pull request"
+	case "issues":
+		kind, subtitle = "github-issue", "issue"
+	default:
+		return LinkInfo{}, false
+	}
+
+	return LinkInfo{
+		Kind:     kind,
+		Title:    s[0] + "/" + s[1] + "#" + s[3],
+		Subtitle: subtitle,
+		Metadata: map[string]interface{}{
+			"owner":  s[0],
+			"repo":   s[1],
+			"number": s[3],
+		},
+	}, true
+}
+
+// describeFigmaLink handles figma.com/{file,design,proto,board}/{key}/{slug}
+func describeFigmaLink(u *url.URL) (LinkInfo, bool) {
+	if !hostIs(u, "figma.com") {
+		return LinkInfo{}, false
+	}
+	s := urlSegments(u)
+	if len(s) < 2 {
+		return LinkInfo{}, false
+	}
+	switch s[0] {
+	case "file", "design", "proto", "board":
+	default:
+		return LinkInfo{}, false
+	}
+
+	title := "Figma file"
+	if len(s) >= 3 {
+		if slug := titleFromSlug(s[2]); slug != "" {
+			title = slug
+		}
+	}
+	return LinkInfo{
+		Kind:     "figma",
+		Title:    title,
+		Subtitle: "Figma",
+		Metadata: map[string]interface{}{"fileKey": s[1]},
+	}, true
+}
+
+// googleDocKinds maps docs.google.com path prefixes to display names
+var googleDocKinds = map[string]string{
+	"document":     "Google Doc",
+	"spreadsheets": "Google Sheet",
+	"presentation": "Google Slides",
+	"forms":        "Google Form",
+}
+
+// describeGoogleDocsLink handles docs.google.com/{document,...}/d/{id}/...
+// Google URLs carry no name, so the title is the document type.
+func describeGoogleDocsLink(u *url.URL) (LinkInfo, bool) {
+	if strings.ToLower(u.Hostname()) != "docs.google.com" {
+		return LinkInfo{}, false
+	}
+	s := urlSegments(u)
+	if len(s) < 3 || s[1] != "d" {
+		return LinkInfo{}, false
+	}
+	name, ok := googleDocKinds[s[0]]
+	if !ok {
+		return LinkInfo{}, false
+	}
+	return LinkInfo{
+		Kind:     "google-" + s[0],
+		Title:    name,
+		Subtitle: "Google Drive",
+		Metadata: map[string]interface{}{"documentId": s[2]},
+	}, true
+}
+
+// titleFromSlug turns "Checkout-Flow-v2" into "Checkout Flow v2"
+func titleFromSlug(slug string) string {
+	if unescaped, err := url.PathUnescape(slug); err == nil {
+		slug = unescaped
+	}
+	slug = strings.NewReplacer("-", " ", "_", " ").Replace(slug)
+	return strings.Join(strings.Fields(slug), " ")
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package utils
+
+import "testing"
+
+func TestDescribeLink(t *testing.T) {
+	tests := []struct {
+		url      string
+		ok       bool
+		kind     string
+		title    string
+		subtitle string
+	}{
+		{"https://github.com/acme/api/pull/123", true, "github-pull", "acme/api#123", "pull request"},
+		{"https://github.com/acme/api/pull/123/files", true, "github-pull", "acme/api#123", "pull request"},
+		{"https://www.github.com/acme/api/issues/7#issuecomment-1", true, "github-issue", "acme/api#7", "issue"},
+		{"https://github.com/acme/api/tree/main", false, "", "", ""},
+		{"https://github.com/acme/api/pull/new", false, "", "", ""},
+		{"https://github.com/acme", false, "", "", ""},
+		{"https://www.figma.com/file/AbC123/Checkout-Flow-v2?node-id=1", true, "figma", "Checkout Flow v2", "Figma"},
+		{"https://www.figma.com/design/AbC123/Onboarding%20Screens", true, "figma", "Onboarding Screens", "Figma"},
+		{"https://figma.com/file/AbC123", true, "figma", "Figma file", "Figma"},
+		{"https://www.figma.com/community", false, "", "", ""},
+		{"https://docs.google.com/document/d/1a2B3c/edit", true, "google-document", "Google Doc", "Google Drive"},
+		{"https://docs.google.com/spreadsheets/d/1a2B3c/edit#gid=0", true, "google-spreadsheets", "Google Sheet", "Google Drive"},
+		{"https://docs.google.com/presentation/d/1a2B3c", true, "google-presentation", "Google Slides", "Google Drive"},
+		{"https://docs.google.com/document/u/0/", false, "", "", ""},
+		{"https://example.com/pull/1", false, "", "", ""},
+		{"not a url", false, "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			info, ok := DescribeLink(tt.url)
+			if ok != tt.ok {
+				t.Fatalf("DescribeLink ok = %v, want %v", ok, tt.ok)
+			}
+			if info.Kind != tt.kind || info.Title != tt.title || info.Subtitle != tt.subtitle {
+				t.Errorf("DescribeLink = {%q %q %q}, want {%q %q %q}",
+					info.Kind, info.Title, info.Subtitle, tt.kind, tt.title, tt.subtitle)
+			}
+		})
+	}
+}
+
+func TestDescribeLinkGitHubMetadata(t *testing.T) {
+	info, _ := DescribeLink("https://github.com/acme/api/pull/123")
+	if info.Metadata["owner"] != "acme" || info.Metadata["repo"] != "api" || info.Metadata["number"] != "123" {
+		t.Errorf("metadata = %v", info.Metadata)
+	}
+}
@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// listItemPattern matches a markdown list item after its indentation:
+// "- ", "* ", "+ ", "1. ", "1) ", or a bare marker at the end of the line
+var listItemPattern = regexp.MustCompile(`^([-*+]|\d{1,9}[.)])(\s|$)`)
+
+// htmlTagPattern matches an HTML tag or comment. Autolinks such as
+// <https://example.com> and <ada@example.com> don't match.
+var htmlTagPattern = regexp.MustCompile(`<!--|</?([A-Za-z][A-Za-z0-9-]*)(\s[^<>]*)?/?>`)
+
+// inlineCodePattern matches a `code span`
+var inlineCodePattern = regexp.MustCompile("`[^`\n]*`")
+
+// NormalizeMarkdown tidies prose piped in from other tools before it is sent
+// to Linear:
+//
+//   - CRLF and lone CR line endings become LF, and a UTF-8 BOM is dropped
+//   - trailing spaces and tabs are stripped from every line
+//   - leading tabs on list items (and the indented lines that continue them)
+//     become spaces, to the next multiple of four columns
+//   - runs of more than two blank lines are collapsed to two
+//   - text of more than one line ends with exactly one newline; a single
+//     line gets one only if it had any
+//
+// Fenced code blocks are left exactly as written.
+func NormalizeMarkdown(s string) string {
+	s = strings.TrimPrefix(s, "\ufeff")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+
+	lines := strings.Split(s, "\n")
+	out := make([]string, 0, len(lines))
+	fence := ""
+	inList := false
+	blanks := 0
+	for _, line := range lines {
+		if fence != "" {
+			out = append(out, line)
+			if isFenceClose(line, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if f := fenceOpen(line); f != "" {
+			fence = f
+			blanks = 0
+			out = append(out, strings.TrimRight(line, " \t"))
+			continue
+		}
+
+		line = strings.TrimRight(line, " \t")
+		if line == "" {
+			blanks++
+			if blanks <= 2 {
+				out = append(out, line)
+			}
+			continue
+		}
+		blanks = 0
+
+		body := strings.TrimLeft(line, " \t")
+		indented := len(body) < len(line)
+		switch {
+		case listItemPattern.MatchString(body):
+			inList = true
+		case !indented:
+			inList = false
+		}
+		if inList && indented {
+			line = expandTabs(line[:len(line)-len(body)]) + body
+		}
+		out = append(out, line)
+	}
+
+	joined := strings.Join(out, "\n")
+	s = strings.TrimRight(joined, "\n")
+	if s != "" && (strings.Contains(s, "\n") || len(s) < len(joined)) {
+		s += "\n"
+	}
+	return s
+}
+
+// expandTabs turns the tabs in an indentation into spaces, each tab
+// reaching the next multiple of four columns as markdown reads it
+func expandTabs(indent string) string {
+	var b strings.Builder
+	col := 0
+	for _, r := range indent {
+		if r == '\t' {
+			n := 4 - col%4
+			b.WriteString(strings.Repeat(" ", n))
+			col += n
+			continue
+		}
+		b.WriteRune(r)
+		col++
+	}
+	return b.String()
+}
+
+// fenceOpen returns the fence (``` or ~~~, possibly longer) that line opens,
+// or "" when it doesn't open a fenced code block
+func fenceOpen(line string) string {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return ""
+	}
+	for _, c := range []byte{'`', '~'} {
+		n := 0
+		for n < len(trimmed) && trimmed[n] == c {
+			n++
+		}
+		if n >= 3 {
+			// A backtick fence's info string can't contain backticks
+			if c == '`' && strings.Contains(trimmed[n:], "`") {
+				return ""
+			}
+			return trimmed[:n]
+		}
+	}
+	return ""
+}
+
+// isFenceClose reports whether line closes a block opened with fence
+func isFenceClose(line string, fence string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// DetectHTML lists the raw HTML tags in markdown, which Linear strips, as
+// sorted unique names like "<div>" (or "<!-- -->" for comments). Code spans
+// and fenced code blocks are ignored. The text is not modified.
+func DetectHTML(s string) []string {
+	seen := map[string]bool{}
+	fence := ""
+	for _, line := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		if fence != "" {
+			if isFenceClose(line, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if fence = fenceOpen(line); fence != "" {
+			continue
+		}
+		line = inlineCodePattern.ReplaceAllString(line, "")
+		for _, m := range htmlTagPattern.FindAllStringSubmatch(line, -1) {
+			if m[0] == "<!--" {
+				seen["<!-- -->"] = true
+			} else {
+				seen["<"+strings.ToLower(m[1])+">"] = true
+			}
+		}
+	}
+	tags := make([]string, 0, len(seen))
+	for tag := range seen {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
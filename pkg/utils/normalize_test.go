@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"single line untouched", "Fix the login", "Fix the login"},
+		{"single line trailing space", "Fix the login  ", "Fix the login"},
+		{"single line newlines", "Fix the login\n\n\n", "Fix the login\n"},
+		{"empty", "", ""},
+		{"only blank lines", "\n\n \n", ""},
+		{"CRLF", "One\r\nTwo\r\n", "One\nTwo\n"},
+		{"lone CR", "One\rTwo", "One\nTwo\n"},
+		{"BOM", "\ufeffOne\nTwo", "One\nTwo\n"},
+		{"trailing whitespace", "One \t\nTwo  \n", "One\nTwo\n"},
+		{"single trailing newline", "One\nTwo\n\n\n\n", "One\nTwo\n"},
+		{"blank runs collapsed to two", "One\n\n\n\n\nTwo", "One\n\n\nTwo\n"},
+		{"two blank lines kept", "One\n\n\nTwo", "One\n\n\nTwo\n"},
+		{"tab-indented list", "- a\n\t- b\n\t\t- c\n", "- a\n    - b\n        - c\n"},
+		{"tab-indented ordered list", "1. a\n\t1) b\n", "1. a\n    1) b\n"},
+		{"tab after spaces", "- a\n  \t- b", "- a\n    - b\n"},
+		{"list continuation", "- a\n\tmore about a\n- b", "- a\n    more about a\n- b\n"},
+		{"tabs outside lists kept", "Para\n\tcode line\n", "Para\n\tcode line\n"},
+		{"list ends at a paragraph", "- a\n\nPara\n\tcode", "- a\n\nPara\n\tcode\n"},
+		{"inline tabs kept", "- a\tb", "- a\tb"},
+		{"fenced code untouched", "```make\nall:\n\tgo build  \n\n\n\n```\n- a\n\t- b", "```make\nall:\n\tgo build  \n\n\n\n```\n- a\n    - b\n"},
+		{"tilde fence", "~~~\n\t- x \n~~~", "~~~\n\t- x \n~~~\n"},
+		{"unclosed fence", "```\n\t- x  \n", "```\n\t- x  \n"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeMarkdown(tt.in); got != tt.want {
+			t.Errorf("%s: NormalizeMarkdown(%q) = %q, want %q", tt.name, tt.in, got, tt.want)
+		}
+		if got := NormalizeMarkdown(NormalizeMarkdown(tt.in)); got != NormalizeMarkdown(tt.in) {
+			t.Errorf("%s: normalizing twice changed %q to %q", tt.name, NormalizeMarkdown(tt.in), got)
+		}
+	}
+}
+
+func TestDetectHTML(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Plain **markdown**", []string{}},
+		{"<div align=\"center\">Logo</div><br/>", []string{"<br>", "<div>"}},
+		{"Line<BR>break <span class='x'>", []string{"<br>", "<span>"}},
+		{"<!-- hidden -->", []string{"<!-- -->"}},
+		{"Links <https://linear.app> and <ada@example.com>", []string{}},
+		{"Comparisons a < b > c and x<y", []string{}},
+		{"In code `<div>` and\n```html\n<p>hi</p>\n```\nafter <p>", []string{"<p>"}},
+	}
+	for _, tt := range tests {
+		if got := DetectHTML(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("DetectHTML(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
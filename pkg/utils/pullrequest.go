@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Pull request states returned by PullRequestState
+const (
+	PullRequestOpen   = "open"
+	PullRequestDraft  = "draft"
+	PullRequestMerged = "merged"
+	PullRequestClosed = "closed"
+)
+
+// pullRequestStatuses maps the status strings Linear's GitHub and GitLab
+// integrations store (lowercased, without spaces or underscores) to a state
+var pullRequestStatuses = map[string]string{
+	"open":             PullRequestOpen,
+	"opened":           PullRequestOpen,
+	"inreview":         PullRequestOpen,
+	"reviewrequired":   PullRequestOpen,
+	"approved":         PullRequestOpen,
+	"changesrequested": PullRequestOpen,
+	"draft":            PullRequestDraft,
+	"merged":           PullRequestMerged,
+	"closed":           PullRequestClosed,
+	"locked":           PullRequestClosed,
+}
+
+// PullRequestState is the state of the pull or merge request an attachment
+// links to, read from the metadata the GitHub and GitLab integrations keep
+// on it: one of the PullRequest* constants. It returns "" when the
+// attachment isn't a pull request or its metadata doesn't say; unexpected
+// metadata shapes are never an error.
+func PullRequestState(sourceType, rawURL string, metadata map[string]interface{}) string {
+	if !isPullRequestAttachment(sourceType, rawURL) {
+		return ""
+	}
+
+	draft := metadataBool(metadata, "draft") || metadataBool(metadata, "isDraft") || metadataBool(metadata, "workInProgress")
+	for _, key := range []string{"status", "state"} {
+		status := strings.ToLower(metadataString(metadata, key))
+		status = strings.NewReplacer(" ", "", "_", "", "-", "").Replace(status)
+		if state, ok := pullRequestStatuses[status]; ok {
+			if state == PullRequestOpen && draft {
+				return PullRequestDraft
+			}
+			return state
+		}
+	}
+
+	// Older payloads carry only timestamps
+	switch {
+	case metadataString(metadata, "mergedAt") != "":
+		return PullRequestMerged
+	case metadataString(metadata, "closedAt") != "":
+		return PullRequestClosed
+	case draft:
+		return PullRequestDraft
+	}
+	return ""
+}
+
+// isPullRequestAttachment reports whether an attachment comes from a code
+// host integration or links to a GitHub pull or GitLab merge request
+func isPullRequestAttachment(sourceType, rawURL string) bool {
+	source := strings.ToLower(sourceType)
+	if strings.Contains(source, "github") || strings.Contains(source, "gitlab") {
+		return true
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	s := urlSegments(u)
+	for i := range s {
+		if i+1 < len(s) && isDigits(s[i+1]) && (s[i] == "pull" || s[i] == "merge_requests") {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataString returns a string metadata value, or "" for a missing or
+// non-string one
+func metadataString(metadata map[string]interface{}, key string) string {
+	s, _ := metadata[key].(string)
+	return s
+}
+
+// metadataBool returns a boolean metadata value, or false for a missing or
+// non-boolean one
+func metadataBool(metadata map[string]interface{}, key string) bool {
+	b, _ := metadata[key].(bool)
+	return b
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPullRequestState(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceType string
+		url        string
+		metadata   string
+		want       string
+	}{
+		{
+			name:       "github merged",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/412",
+			metadata: `{"id":"PR_kwDOAbc","url":"https://github.com/acme/api/pull/412","draft":false,"title":"Fix login redirect",
+				"branch":"eng-42-fix-login","number":412,"repoId":"123","status":"merged","reviews":[{"state":"approved","reviewerLogin":"ada"}],
+				"repoName":"api","repoLogin":"acme","userLogin":"grace","createdAt":"2026-09-30T10:00:00Z","mergedAt":"2026-10-01T12:00:00Z"}`,
+			want: PullRequestMerged,
+		},
+		{
+			name:       "github in review",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/413",
+			metadata:   `{"draft":false,"number":413,"status":"inReview","reviews":[]}`,
+			want:       PullRequestOpen,
+		},
+		{
+			name:       "github draft by status",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/414",
+			metadata:   `{"draft":true,"number":414,"status":"draft"}`,
+			want:       PullRequestDraft,
+		},
+		{
+			name:       "github draft flag on an open status",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/415",
+			metadata:   `{"draft":true,"number":415,"status":"open"}`,
+			want:       PullRequestDraft,
+		},
+		{
+			name:       "github closed without merging",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/416",
+			metadata:   `{"number":416,"status":"closed","closedAt":"2026-10-02T08:00:00Z"}`,
+			want:       PullRequestClosed,
+		},
+		{
+			name:       "gitlab opened",
+			sourceType: "gitlab",
+			url:        "https://gitlab.com/acme/web/-/merge_requests/88",
+			metadata:   `{"iid":88,"state":"opened","title":"Checkout v2","workInProgress":false,"sourceBranch":"eng-7"}`,
+			want:       PullRequestOpen,
+		},
+		{
+			name:       "gitlab draft",
+			sourceType: "gitlab",
+			url:        "https://gitlab.com/acme/web/-/merge_requests/89",
+			metadata:   `{"iid":89,"state":"opened","workInProgress":true}`,
+			want:       PullRequestDraft,
+		},
+		{
+			name:       "gitlab merged",
+			sourceType: "gitlab",
+			url:        "https://gitlab.com/acme/web/-/merge_requests/90",
+			metadata:   `{"iid":90,"state":"merged","mergedAt":"2026-10-03T09:00:00Z"}`,
+			want:       PullRequestMerged,
+		},
+		{
+			name:     "pull request linked by URL, timestamps only",
+			url:      "https://github.com/acme/api/pull/417",
+			metadata: `{"mergedAt":"2026-10-04T09:00:00Z"}`,
+			want:     PullRequestMerged,
+		},
+		{
+			name:       "unknown status",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/418",
+			metadata:   `{"status":"queued"}`,
+			want:       "",
+		},
+		{
+			name:       "status of the wrong type",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/419",
+			metadata:   `{"status":{"name":"merged"},"draft":"yes","mergedAt":7}`,
+			want:       "",
+		},
+		{
+			name:       "no metadata",
+			sourceType: "github",
+			url:        "https://github.com/acme/api/pull/420",
+			metadata:   `null`,
+			want:       "",
+		},
+		{
+			name:       "github issue is not a pull request",
+			sourceType: "",
+			url:        "https://github.com/acme/api/issues/12",
+			metadata:   `{"status":"closed"}`,
+			want:       "",
+		},
+		{
+			name:       "slack message",
+			sourceType: "slack",
+			url:        "https://acme.slack.com/archives/C01/p1",
+			metadata:   `{"status":"merged"}`,
+			want:       "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var metadata map[string]interface{}
+			if err := json.Unmarshal([]byte(tt.metadata), &metadata); err != nil {
+				t.Fatal(err)
+			}
+			if got := PullRequestState(tt.sourceType, tt.url, metadata); got != tt.want {
+				t.Errorf("PullRequestState = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"strings"
+	"unicode"
+)
+
+// similarityStopwords are ignored when comparing titles; they carry no signal
+// about whether two issues describe the same problem
+var similarityStopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "to": true, "of": true,
+	"in": true, "on": true, "for": true, "is": true, "it": true, "with": true,
+	"when": true, "not": true, "be": true, "or": true, "at": true, "by": true,
+}
+
+// NormalizeTokens lowercases s, splits it on anything that is not a letter or
+// digit, and drops stopwords. Duplicate tokens are removed, order is kept.
+func NormalizeTokens(s string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	seen := make(map[string]bool, len(fields))
+	var tokens []string
+	for _, f := range fields {
+		if similarityStopwords[f] || seen[f] {
+			continue
+		}
+		seen[f] = true
+		tokens = append(tokens, f)
+	}
+	return tokens
+}
+
+// TokenSimilarity returns the Jaccard overlap of the normalized tokens of a
+// and b: 1.0 for the same set of words, 0.0 for nothing in common
+func TokenSimilarity(a, b string) float64 {
+	ta := NormalizeTokens(a)
+	tb := NormalizeTokens(b)
+	if len(ta) == 0 || len(tb) == 0 {
+		return 0
+	}
+
+	set := make(map[string]bool, len(ta))
+	for _, t := range ta {
+		set[t] = true
+	}
+	shared := 0
+	for _, t := range tb {
+		if set[t] {
+			shared++
+		}
+	}
+	union := len(ta) + len(tb) - shared
+	return float64(shared) / float64(union)
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeTokens(t *testing.T) {
+	got := NormalizeTokens("The Login button is broken on Safari -- login/SSO!")
+	want := []string{"login", "button", "broken", "safari", "sso"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NormalizeTokens() = %v, want %v", got, want)
+	}
+}
+
+func TestTokenSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"identical", "Login fails on Safari", "Login fails on Safari", 1},
+		{"case and punctuation", "Login fails on Safari", "login FAILS, safari!", 1},
+		{"partial overlap", "Login fails on Safari", "Login fails on Firefox", 0.5},
+		{"no overlap", "Login fails on Safari", "Update billing copy", 0},
+		{"only stopwords", "the and of", "Login fails", 0},
+		{"empty", "", "Login fails", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TokenSimilarity(tt.a, tt.b)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("TokenSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+			if rev := TokenSimilarity(tt.b, tt.a); math.Abs(rev-got) > 1e-9 {
+				t.Errorf("TokenSimilarity is not symmetric: %v vs %v", got, rev)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+)
+
+// MaxSuggestions caps the "did you mean" list for a reference that matched
+// nothing
+const MaxSuggestions = 3
+
+// NotFoundError is a reference (team key, user, label, ...) that matched
+// nothing, with the closest candidates as suggestions. Its message ends in
+// "did you mean: ...?" when there are any; commands also list them in JSON
+// errors.
+type NotFoundError struct {
+	Message     string
+	Suggestions []string
+}
+
+// NewNotFoundError builds a NotFoundError suggesting the candidates closest
+// to value
+func NewNotFoundError(message, value string, candidates []string) *NotFoundError {
+	return &NotFoundError{Message: message, Suggestions: Suggest(value, candidates, MaxSuggestions)}
+}
+
+// ClosestMatches returns the suggestions, for output.Error
+func (e *NotFoundError) ClosestMatches() []string {
+	return e.Suggestions
+}
+
+func (e *NotFoundError) Error() string {
+	if len(e.Suggestions) == 0 {
+		return e.Message
+	}
+	return e.Message + "; " + DidYouMean(e.Suggestions)
+}
+
+// DidYouMean formats suggestions for the end of an error message, e.g.
+// "did you mean: ENG, ENGR?"
+func DidYouMean(suggestions []string) string {
+	return "did you mean: " + strings.Join(suggestions, ", ") + "?"
+}
+
+// Levenshtein returns the edit distance between a and b, counting runes
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// typoDistance is the Levenshtein distance with a swap of two adjacent runes
+// counted as one edit, so "bgu" is one typo away from "bug"
+func typoDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// d[i][j] is the distance between ra[:i] and rb[:j]
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
+
+// Suggest returns up to limit candidates that look like value, closest first.
+// Matching is case-insensitive: a candidate qualifies if one contains the
+// other or the typo distance is at most a third of the longer string (and
+// always at least one typo, so a three-letter team key like "ENB" still
+// finds ENG without also suggesting PLB).
+func Suggest(value string, candidates []string, limit int) []string {
+	v := strings.ToLower(strings.TrimSpace(value))
+	if v == "" {
+		return nil
+	}
+
+	type scored struct {
+		name  string
+		score int
+	}
+	var matches []scored
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		lc := strings.ToLower(c)
+		if c == "" || seen[lc] {
+			continue
+		}
+		seen[lc] = true
+
+		dist := typoDistance(v, lc)
+		switch {
+		case lc == v:
+			dist = 0
+		case strings.Contains(lc, v) || strings.Contains(v, lc):
+			// Substring hits rank just behind exact-ish typos
+			dist = min(dist, 1+abs(len(lc)-len(v))/4)
+		case dist > max(1, max(len(v), len(lc))/3):
+			continue
+		}
+		matches = append(matches, scored{c, dist})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score < matches[j].score
+		}
+		return matches[i].name < matches[j].name
+	})
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.name
+	}
+	return out
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"bug", "bug", 0},
+		{"bgu", "bug", 2},
+	}
+	for _, tt := range tests {
+		if got := Levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"Bug", "Feature", "Improvement", "bug-triage", "Docs", "alice@example.com", "bob@example.com"}
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"bgu", []string{"Bug"}},
+		{"Bgu-traige", []string{"bug-triage"}},
+		{"doc", []string{"Docs"}},
+		{"Fxx", []string{}},
+		{"BUG", []string{"Bug", "bug-triage"}},
+		{"featur", []string{"Feature"}},
+		{"alice@exmaple.com", []string{"alice@example.com"}},
+		{"zzz", []string{}},
+		{"", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got := Suggest(tt.value, candidates, 3)
+			if got == nil {
+				got = []string{}
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Suggest(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	if got := Suggest("e", candidates, 2); len(got) != 2 {
+		t.Errorf("Suggest limit not applied: %v", got)
+	}
+}
+
+func TestSuggestShortKeys(t *testing.T) {
+	keys := []string{"ENG", "PLB", "OPS", "DES"}
+	// One typo qualifies; two don't, so unrelated keys aren't suggested
+	if got := Suggest("ENB", keys, 3); !reflect.DeepEqual(got, []string{"ENG"}) {
+		t.Errorf("Suggest(ENB) = %v, want [ENG]", got)
+	}
+	if got := Suggest("OSP", keys, 3); !reflect.DeepEqual(got, []string{"OPS"}) {
+		t.Errorf("Suggest(OSP) = %v, want [OPS]", got)
+	}
+}
+
+func TestNotFoundError(t *testing.T) {
+	err := NewNotFoundError("Label 'bgu' not found", "bgu", []string{"Bug", "Feature"})
+	if got := err.Error(); got != "Label 'bgu' not found; did you mean: Bug?" {
+		t.Errorf("Error() = %q", got)
+	}
+	err = NewNotFoundError("Label 'zzz' not found", "zzz", []string{"Bug", "Feature"})
+	if got := err.Error(); got != "Label 'zzz' not found" || len(err.Suggestions) != 0 {
+		t.Errorf("Error() = %q, suggestions %v", got, err.Suggestions)
+	}
+}
@@ -7,10 +7,19 @@ import (
 	"time"
 )
 
+// TimeExpressionUnits are the units accepted in relative time expressions
+// such as "3_weeks_ago"; a trailing "s" is optional
+var TimeExpressionUnits = []string{"minute", "hour", "day", "week", "month", "year"}
+
 // ParseTimeExpression converts time expressions like "3_weeks_ago" into ISO8601 datetime strings
 // Returns empty string for "all_time"
 // Default is "6_months_ago" if empty string is provided
+// Spaces may stand in for underscores ("3 weeks ago"); negative amounts and
+// dates in the future are rejected, since they would filter out everything.
 func ParseTimeExpression(expr string) (string, error) {
+	expr = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(expr)), " ", "_")
+	expr = strings.ReplaceAll(expr, "-", "_")
+
 	// Handle empty input - use default
 	if expr == "" {
 		expr = "6_months_ago"
@@ -21,14 +30,22 @@ func ParseTimeExpression(expr string) (string, error) {
 		return "", nil
 	}
 
-	// Try to parse as a date first (YYYY-MM-DD)
-	if _, err := time.Parse("2006-01-02", expr); err == nil {
-		return expr + "T00:00:00Z", nil
+	now := time.Now()
+
+	// Try to parse as a date first (YYYY-MM-DD); the dashes were normalized above
+	if t, err := time.Parse("2006_01_02", expr); err == nil {
+		if t.After(now) {
+			return "", fmt.Errorf("time expression %s is in the future", t.Format("2006-01-02"))
+		}
+		return t.Format("2006-01-02") + "T00:00:00Z", nil
 	}
 
 	// Try to parse as ISO8601
-	if _, err := time.Parse(time.RFC3339, expr); err == nil {
-		return expr, nil
+	if t, err := time.Parse(time.RFC3339, strings.ToUpper(strings.ReplaceAll(expr, "_", "-"))); err == nil {
+		if t.After(now) {
+			return "", fmt.Errorf("time expression %s is in the future", t.Format(time.RFC3339))
+		}
+		return t.Format(time.RFC3339), nil
 	}
 
 	// Parse relative time expressions
@@ -39,7 +56,7 @@ func ParseTimeExpression(expr string) (string, error) {
 
 	// Get the number
 	num, err := strconv.Atoi(parts[0])
-	if err != nil {
+	if err != nil || num < 0 {
 		return "", fmt.Errorf("invalid number in time expression: %s", parts[0])
 	}
 
@@ -47,7 +64,6 @@ func ParseTimeExpression(expr string) (string, error) {
 	unit := strings.Join(parts[1:len(parts)-1], "_")
 
 	// Calculate the time
-	now := time.Now()
 	var targetTime time.Time
 
 	switch strings.TrimSuffix(unit, "s") {
@@ -64,9 +80,127 @@ func ParseTimeExpression(expr string) (string, error) {
 	case "year":
 		targetTime = now.AddDate(-num, 0, 0)
 	default:
-		return "", fmt.Errorf("invalid time unit: %s (valid units: minute, hour, day, week, month, year)", unit)
+		return "", fmt.Errorf("invalid time unit: %s (valid units: %s)", unit, strings.Join(TimeExpressionUnits, ", "))
 	}
 
 	// Return as ISO8601 string
 	return targetTime.Format(time.RFC3339), nil
 }
+
+// DueDateOverdue reports whether a due date (YYYY-MM-DD) has passed and by how
+// many calendar days. Due dates carry no time of day, so the breach threshold is
+// the end of that day in now's location: an issue due today is not overdue.
+func DueDateOverdue(dueDate string, now time.Time) (days int, overdue bool, err error) {
+	due, err := time.ParseInLocation("2006-01-02", dueDate, now.Location())
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid due date: %s", dueDate)
+	}
+
+	// Compare calendar dates in UTC so DST transitions don't skew the day count
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	dueDay := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+
+	days = int(today.Sub(dueDay).Hours() / 24)
+	if days <= 0 {
+		return 0, false, nil
+	}
+	return days, true, nil
+}
+
+// AddRelative moves now forward by a relative duration: "3d", "2w", "4h",
+// or anything time.ParseDuration accepts ("90m", "1h30m"). Days and weeks
+// are calendar days, so they keep the time of day across DST changes.
+func AddRelative(now time.Time, expr string) (time.Time, error) {
+	for suffix, days := range map[string]int{"d": 1, "w": 7} {
+		if n, ok := strings.CutSuffix(expr, suffix); ok {
+			num, err := strconv.Atoi(n)
+			if err != nil || num < 0 {
+				return time.Time{}, fmt.Errorf("invalid duration: %s (expected e.g. 3d, 2w, 4h, 90m)", expr)
+			}
+			return now.AddDate(0, 0, num*days), nil
+		}
+	}
+	d, err := time.ParseDuration(expr)
+	if err != nil || d < 0 {
+		return time.Time{}, fmt.Errorf("invalid duration: %s (expected e.g. 3d, 2w, 4h, 90m)", expr)
+	}
+	return now.Add(d), nil
+}
+
+// ParseFutureTime reads a point in time relative to now: "tomorrow" (9am),
+// a date (YYYY-MM-DD, at 9am), a local date and time (YYYY-MM-DDTHH:MM),
+// an RFC3339 timestamp, or a relative duration understood by AddRelative.
+// Dates without a zone are in now's location.
+func ParseFutureTime(expr string, now time.Time) (time.Time, error) {
+	if expr == "tomorrow" {
+		y, m, d := now.AddDate(0, 0, 1).Date()
+		return time.Date(y, m, d, 9, 0, 0, 0, now.Location()), nil
+	}
+	if t, err := time.ParseInLocation("2006-01-02", expr, now.Location()); err == nil {
+		return t.Add(9 * time.Hour), nil
+	}
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, expr, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339, expr); err == nil {
+		return t, nil
+	}
+	t, err := AddRelative(now, expr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time: %s (expected YYYY-MM-DD, YYYY-MM-DDTHH:MM, tomorrow, or a duration like 3d)", expr)
+	}
+	return t, nil
+}
+
+// ParseDateExpression reads a calendar date and returns it as YYYY-MM-DD:
+// a date, "today", "tomorrow", a distance ahead of now such as "+2_weeks"
+// or "3 days" (days, weeks, months, years), or the end of the current
+// period: "end_of_week" (Sunday), "end_of_month", "end_of_quarter", or
+// "end_of_year". Dates are taken in now's location.
+func ParseDateExpression(expr string, now time.Time) (string, error) {
+	expr = strings.ToLower(strings.TrimSpace(expr))
+	if t, err := time.Parse("2006-01-02", expr); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+	expr = strings.NewReplacer(" ", "_", "-", "_").Replace(expr)
+
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	var date time.Time
+	switch expr {
+	case "today":
+		date = today
+	case "tomorrow":
+		date = today.AddDate(0, 0, 1)
+	case "end_of_week":
+		date = today.AddDate(0, 0, (7-int(today.Weekday()))%7)
+	case "end_of_month":
+		date = time.Date(y, m+1, 0, 0, 0, 0, 0, now.Location())
+	case "end_of_quarter":
+		date = time.Date(y, m+3-(m-1)%3, 0, 0, 0, 0, 0, now.Location())
+	case "end_of_year":
+		date = time.Date(y, 12, 31, 0, 0, 0, 0, now.Location())
+	default:
+		parts := strings.SplitN(strings.TrimPrefix(expr, "+"), "_", 2)
+		num, err := strconv.Atoi(parts[0])
+		if len(parts) != 2 || err != nil || num < 0 {
+			return "", fmt.Errorf("invalid date: %s (expected YYYY-MM-DD, today, tomorrow, +N_days/weeks/months/years, or end_of_week/month/quarter/year)", expr)
+		}
+		switch strings.TrimSuffix(parts[1], "s") {
+		case "day":
+			date = today.AddDate(0, 0, num)
+		case "week":
+			date = today.AddDate(0, 0, num*7)
+		case "month":
+			date = today.AddDate(0, num, 0)
+		case "year":
+			date = today.AddDate(num, 0, 0)
+		default:
+			return "", fmt.Errorf("invalid date unit: %s (valid units: day, week, month, year)", parts[1])
+		}
+	}
+	return date.Format("2006-01-02"), nil
+}
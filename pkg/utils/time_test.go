@@ -0,0 +1,233 @@
+package utils
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDueDateOverdue(t *testing.T) {
+	pacific := time.FixedZone("UTC-7", -7*60*60)
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+
+	tests := []struct {
+		name        string
+		dueDate     string
+		now         time.Time
+		wantDays    int
+		wantOverdue bool
+	}{
+		{
+			name:    "due later today",
+			dueDate: "2024-06-01",
+			now:     time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "last minute of due day",
+			dueDate: "2024-06-01",
+			now:     time.Date(2024, 6, 1, 23, 59, 59, 0, time.UTC),
+		},
+		{
+			name:        "first second after due day",
+			dueDate:     "2024-06-01",
+			now:         time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC),
+			wantDays:    1,
+			wantOverdue: true,
+		},
+		{
+			name:    "not yet due",
+			dueDate: "2024-06-10",
+			now:     time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			// Already June 2 in UTC, but still June 1 locally
+			name:    "west of UTC still on due day",
+			dueDate: "2024-06-01",
+			now:     time.Date(2024, 6, 1, 23, 30, 0, 0, pacific),
+		},
+		{
+			// Still June 1 in UTC, but already June 2 locally
+			name:        "east of UTC past due day",
+			dueDate:     "2024-06-01",
+			now:         time.Date(2024, 6, 2, 0, 30, 0, 0, tokyo),
+			wantDays:    1,
+			wantOverdue: true,
+		},
+		{
+			name:        "several days overdue",
+			dueDate:     "2024-06-01",
+			now:         time.Date(2024, 6, 4, 8, 0, 0, 0, pacific),
+			wantDays:    3,
+			wantOverdue: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, overdue, err := DueDateOverdue(tt.dueDate, tt.now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if days != tt.wantDays || overdue != tt.wantOverdue {
+				t.Errorf("DueDateOverdue(%s, %s) = (%d, %v), want (%d, %v)",
+					tt.dueDate, tt.now.Format(time.RFC3339), days, overdue, tt.wantDays, tt.wantOverdue)
+			}
+		})
+	}
+}
+
+func TestDueDateOverdueAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// The 2024 spring-forward day is only 23 hours long
+	days, overdue, err := DueDateOverdue("2024-03-09", time.Date(2024, 3, 11, 0, 15, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if days != 2 || !overdue {
+		t.Errorf("got (%d, %v), want (2, true)", days, overdue)
+	}
+}
+
+func TestDueDateOverdueInvalid(t *testing.T) {
+	if _, _, err := DueDateOverdue("06/01/2024", time.Now()); err == nil {
+		t.Fatal("expected error for malformed due date")
+	}
+}
+
+func TestAddRelative(t *testing.T) {
+	now := time.Date(2024, 3, 9, 15, 30, 0, 0, time.UTC)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"3d", time.Date(2024, 3, 12, 15, 30, 0, 0, time.UTC)},
+		{"2w", time.Date(2024, 3, 23, 15, 30, 0, 0, time.UTC)},
+		{"4h", time.Date(2024, 3, 9, 19, 30, 0, 0, time.UTC)},
+		{"1h30m", time.Date(2024, 3, 9, 17, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := AddRelative(now, tt.expr)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("AddRelative(%q) = %v, %v; want %v", tt.expr, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "xd", "-2d", "soon", "-1h"} {
+		if _, err := AddRelative(now, bad); err == nil {
+			t.Errorf("AddRelative(%q) should fail", bad)
+		}
+	}
+}
+
+func TestParseFutureTime(t *testing.T) {
+	pacific := time.FixedZone("UTC-7", -7*60*60)
+	now := time.Date(2024, 6, 30, 22, 0, 0, 0, pacific)
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"tomorrow", time.Date(2024, 7, 1, 9, 0, 0, 0, pacific)},
+		{"2024-07-04", time.Date(2024, 7, 4, 9, 0, 0, 0, pacific)},
+		{"2024-07-01T09:15", time.Date(2024, 7, 1, 9, 15, 0, 0, pacific)},
+		{"2024-07-01T09:00:00Z", time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)},
+		{"3d", time.Date(2024, 7, 3, 22, 0, 0, 0, pacific)},
+	}
+	for _, tt := range tests {
+		got, err := ParseFutureTime(tt.expr, now)
+		if err != nil || !got.Equal(tt.want) {
+			t.Errorf("ParseFutureTime(%q) = %v, %v; want %v", tt.expr, got, err, tt.want)
+		}
+	}
+	if _, err := ParseFutureTime("next tuesday", now); err == nil {
+		t.Error("ParseFutureTime should reject unknown expressions")
+	}
+}
+
+func TestParseTimeExpression(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string // "" for all time; "*" for any relative timestamp
+	}{
+		{"all_time", ""},
+		{"All Time", ""},
+		{"all-time", ""},
+		{"2024-01-15", "2024-01-15T00:00:00Z"},
+		{"2024-01-15T10:00:00-05:00", "2024-01-15T10:00:00-05:00"},
+		{"3_weeks_ago", "*"},
+		{"3 weeks ago", "*"},
+		{"1_year_ago", "*"},
+		{"", "*"},
+	}
+	for _, tt := range tests {
+		got, err := ParseTimeExpression(tt.expr)
+		if err != nil {
+			t.Errorf("ParseTimeExpression(%q) error: %v", tt.expr, err)
+			continue
+		}
+		if tt.want == "*" {
+			if _, err := time.Parse(time.RFC3339, got); err != nil {
+				t.Errorf("ParseTimeExpression(%q) = %q, want an RFC3339 time", tt.expr, got)
+			}
+		} else if got != tt.want {
+			t.Errorf("ParseTimeExpression(%q) = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+
+	future := time.Now().AddDate(1, 0, 0).Format("2006-01-02")
+	for _, bad := range []string{"-3_days_ago", "3_days", "three_days_ago", "3_fortnights_ago", "soon", future} {
+		if _, err := ParseTimeExpression(bad); err == nil {
+			t.Errorf("ParseTimeExpression(%q) should fail", bad)
+		}
+	}
+}
+
+// TestTimeExpressionUnits keeps the documented unit list in step with the parser
+func TestTimeExpressionUnits(t *testing.T) {
+	for _, unit := range TimeExpressionUnits {
+		for _, expr := range []string{"2_" + unit + "s_ago", "1_" + unit + "_ago"} {
+			if _, err := ParseTimeExpression(expr); err != nil {
+				t.Errorf("ParseTimeExpression(%q): %v", expr, err)
+			}
+		}
+	}
+}
+
+func TestParseDateExpression(t *testing.T) {
+	// A Wednesday in the middle of Q2
+	now := time.Date(2025, 5, 14, 15, 30, 0, 0, time.UTC)
+	tests := map[string]string{
+		"2025-12-31":     "2025-12-31",
+		"today":          "2025-05-14",
+		"Tomorrow":       "2025-05-15",
+		"+2_weeks":       "2025-05-28",
+		"3 days":         "2025-05-17",
+		"+1_month":       "2025-06-14",
+		"1-year":         "2026-05-14",
+		"end_of_week":    "2025-05-18",
+		"end_of_month":   "2025-05-31",
+		"end_of_quarter": "2025-06-30",
+		"end of year":    "2025-12-31",
+	}
+	for expr, want := range tests {
+		if got, err := ParseDateExpression(expr, now); err != nil || got != want {
+			t.Errorf("ParseDateExpression(%q) = %q, %v; want %q", expr, got, err, want)
+		}
+	}
+
+	// Quarter ends in each quarter, and end_of_week on a Sunday is that day
+	for month, want := range map[time.Month]string{time.January: "2025-03-31", time.September: "2025-09-30", time.December: "2025-12-31"} {
+		if got, _ := ParseDateExpression("end_of_quarter", time.Date(2025, month, 1, 0, 0, 0, 0, time.UTC)); got != want {
+			t.Errorf("end_of_quarter in %s = %s, want %s", month, got, want)
+		}
+	}
+	if got, _ := ParseDateExpression("end_of_week", time.Date(2025, 5, 18, 9, 0, 0, 0, time.UTC)); got != "2025-05-18" {
+		t.Errorf("end_of_week on a Sunday = %s", got)
+	}
+
+	for _, bad := range []string{"31/12/2025", "+2_fortnights", "-3_days", "soon", "2025-02-30"} {
+		if got, err := ParseDateExpression(bad, now); err == nil {
+			t.Errorf("ParseDateExpression(%q) = %q, want an error", bad, got)
+		}
+	}
+}
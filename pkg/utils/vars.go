@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// placeholderPattern matches {{KEY}} and {{env.NAME}}, with optional spaces
+// inside the braces. A backslash before the braces keeps them literal.
+var placeholderPattern = regexp.MustCompile(`\\?\{\{\s*((?:env\.)?[A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+var varNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// VarOptions control SubstituteVars
+type VarOptions struct {
+	AllowMissing bool                        // leave unknown placeholders as they are
+	AllowEnv     bool                        // resolve {{env.NAME}} from the environment
+	LookupEnv    func(string) (string, bool) // os.LookupEnv when nil
+}
+
+// MissingVarsError lists the placeholders SubstituteVars had no value for
+type MissingVarsError struct {
+	Names []string // sorted, without braces, e.g. "DATE" or "env.HOME"
+}
+
+func (e *MissingVarsError) Error() string {
+	placeholders := make([]string, len(e.Names))
+	for i, name := range e.Names {
+		placeholders[i] = "{{" + name + "}}"
+	}
+	return "no value for " + strings.Join(placeholders, ", ")
+}
+
+// ParseVars turns repeated KEY=VALUE pairs into a map. A later pair for the
+// same key wins.
+func ParseVars(pairs []string) (map[string]string, error) {
+	vars := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid variable %q: expected KEY=VALUE", pair)
+		}
+		if !varNamePattern.MatchString(key) {
+			return nil, fmt.Errorf("invalid variable name %q: use letters, digits, and underscores", key)
+		}
+		vars[key] = value
+	}
+	return vars, nil
+}
+
+// SubstituteVars replaces {{KEY}} placeholders in text with values from vars,
+// and {{env.NAME}} with environment variables when opts.AllowEnv is set.
+// Every occurrence is replaced in a single pass, so a value that itself looks
+// like a placeholder is left alone. \{{KEY}} is written out as a literal
+// {{KEY}}. Placeholders without a value are an error unless
+// opts.AllowMissing is set, in which case they are kept verbatim.
+func SubstituteVars(text string, vars map[string]string, opts VarOptions) (string, error) {
+	lookupEnv := opts.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+	missing := map[string]bool{}
+	result := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		if strings.HasPrefix(match, `\`) {
+			return match[1:]
+		}
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		if envName, ok := strings.CutPrefix(name, "env."); ok {
+			if opts.AllowEnv {
+				if value, ok := lookupEnv(envName); ok {
+					return value
+				}
+			}
+		} else if value, ok := vars[name]; ok {
+			return value
+		}
+		missing[name] = true
+		return match
+	})
+	if len(missing) > 0 && !opts.AllowMissing {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", &MissingVarsError{Names: names}
+	}
+	return result, nil
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSubstituteVars(t *testing.T) {
+	vars := map[string]string{"SERVICE": "payments", "DATE": "2026-10-16", "LOOP": "{{SERVICE}}"}
+	env := func(name string) (string, bool) {
+		if name == "HOME" {
+			return "/home/ada", true
+		}
+		return "", false
+	}
+	tests := []struct {
+		text string
+		opts VarOptions
+		want string
+	}{
+		{"Deploy {{SERVICE}}", VarOptions{}, "Deploy payments"},
+		{"{{SERVICE}} on {{DATE}}, again {{ SERVICE }}", VarOptions{}, "payments on 2026-10-16, again payments"},
+		{"Values aren't expanded: {{LOOP}}", VarOptions{}, "Values aren't expanded: {{SERVICE}}"},
+		{`Literal \{{SERVICE}} and {{SERVICE}}`, VarOptions{}, "Literal {{SERVICE}} and payments"},
+		{"Not a placeholder: {{ .Name }} {{}} {SERVICE}", VarOptions{}, "Not a placeholder: {{ .Name }} {{}} {SERVICE}"},
+		{"Home {{env.HOME}}", VarOptions{AllowEnv: true, LookupEnv: env}, "Home /home/ada"},
+		{"{{OWNER}} keeps {{env.HOME}}", VarOptions{AllowMissing: true, LookupEnv: env}, "{{OWNER}} keeps {{env.HOME}}"},
+	}
+	for _, tt := range tests {
+		got, err := SubstituteVars(tt.text, vars, tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("SubstituteVars(%q) = %q, %v, want %q", tt.text, got, err, tt.want)
+		}
+	}
+}
+
+func TestSubstituteVarsMissing(t *testing.T) {
+	env := func(string) (string, bool) { return "/home/ada", true }
+	_, err := SubstituteVars("{{OWNER}} {{SERVICE}} {{OWNER}} {{env.HOME}} {{env.NOPE}}", map[string]string{"SERVICE": "x"},
+		VarOptions{LookupEnv: env})
+	var missing *MissingVarsError
+	if !errors.As(err, &missing) {
+		t.Fatalf("err = %v, want MissingVarsError", err)
+	}
+	// env references need AllowEnv even when the variable is set
+	if want := []string{"OWNER", "env.HOME", "env.NOPE"}; !reflect.DeepEqual(missing.Names, want) {
+		t.Errorf("missing = %v, want %v", missing.Names, want)
+	}
+	if err.Error() != "no value for {{OWNER}}, {{env.HOME}}, {{env.NOPE}}" {
+		t.Errorf("message = %q", err.Error())
+	}
+}
+
+func TestParseVars(t *testing.T) {
+	vars, err := ParseVars([]string{"SERVICE=payments", "URL=https://x.dev/?a=b", "EMPTY=", "SERVICE=billing"})
+	want := map[string]string{"SERVICE": "billing", "URL": "https://x.dev/?a=b", "EMPTY": ""}
+	if err != nil || !reflect.DeepEqual(vars, want) {
+		t.Errorf("ParseVars = %v, %v", vars, err)
+	}
+	for _, bad := range []string{"SERVICE", "=x", "env.HOME=x", "MY-VAR=x"} {
+		if _, err := ParseVars([]string{bad}); err == nil {
+			t.Errorf("ParseVars(%q) should fail", bad)
+		}
+	}
+}